@@ -18,6 +18,10 @@ const (
 	ExitNotFound = 4 // Resource not found
 	ExitConflict = 5 // Conflict / resource already exists
 
+	// Review outcomes reported by watch commands such as "asc submissions watch".
+	ExitReviewRejected = shared.ExitCodeReviewRejected // 6: App Store review rejected
+	ExitReviewCanceled = shared.ExitCodeReviewCanceled // 7: Submission canceled before a decision
+
 	// HTTP 4xx range: 10 + (status - 400)
 	// Note: 404 and 409 are mapped to ExitNotFound and ExitConflict above.
 	ExitHTTPBadRequest    = 10 // 400
@@ -38,6 +42,11 @@ func ExitCodeFromError(err error) int {
 		return ExitSuccess
 	}
 
+	// Commands may choose their own exit code for an outcome
+	if exitErr, ok := errors.AsType[shared.ExitCoder](err); ok {
+		return exitErr.ExitCode()
+	}
+
 	// Usage errors
 	if errors.Is(err, flag.ErrHelp) {
 		return ExitUsage
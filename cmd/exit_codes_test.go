@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"os/exec"
@@ -58,6 +59,16 @@ func TestExitCodeFromError(t *testing.T) {
 			err:      asc.ErrConflict,
 			expected: ExitConflict,
 		},
+		{
+			name:     "review rejection returns its exit code",
+			err:      shared.NewExitCodeError(errors.New("rejected"), shared.ExitCodeReviewRejected),
+			expected: ExitReviewRejected,
+		},
+		{
+			name:     "wrapped exit code error keeps its exit code",
+			err:      fmt.Errorf("submissions watch: %w", shared.NewExitCodeError(errors.New("canceled"), shared.ExitCodeReviewCanceled)),
+			expected: ExitReviewCanceled,
+		},
 		{
 			name:     "generic error returns generic error",
 			err:      errors.New("something went wrong"),
@@ -106,6 +117,12 @@ func TestExitCodeConstants(t *testing.T) {
 	if ExitConflict != 5 {
 		t.Errorf("ExitConflict = %d, want 5", ExitConflict)
 	}
+	if ExitReviewRejected != 6 {
+		t.Errorf("ExitReviewRejected = %d, want 6", ExitReviewRejected)
+	}
+	if ExitReviewCanceled != 7 {
+		t.Errorf("ExitReviewCanceled = %d, want 7", ExitReviewCanceled)
+	}
 }
 
 func TestAPIErrorCodeToExitCode(t *testing.T) {
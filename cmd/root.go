@@ -33,6 +33,12 @@ func RootCommand(version string) *ffcli.Command {
 
 	for _, subcommand := range subcommands {
 		shared.WrapCommandOutputValidation(subcommand)
+		// --patch wraps the command before --id - does, so each stdin ID gets
+		// its own patch target.
+		shared.WrapUpdatePatch(subcommand)
+		shared.WrapStdinIDs(subcommand)
+		shared.WrapFieldsSelection(subcommand)
+		shared.WrapAppLookupFlags(subcommand)
 	}
 
 	root.FlagSet.BoolVar(&versionRequested, "version", false, "Print version and exit")
@@ -19,7 +19,7 @@ type rootCommandGroup struct {
 var rootUsageGroups = []rootCommandGroup{
 	{
 		title:    "GETTING STARTED COMMANDS",
-		commands: []string{"auth", "doctor", "install-skills", "init", "docs"},
+		commands: []string{"auth", "config", "doctor", "install-skills", "init", "docs", "help"},
 	},
 	{
 		title:    "EXPERIMENTAL COMMANDS",
@@ -34,7 +34,7 @@ var rootUsageGroups = []rootCommandGroup{
 		commands: []string{
 			"apps", "app-setup", "app-tags", "versions",
 			"localizations", "metadata", "screenshots", "video-previews", "background-assets", "product-pages",
-			"routing-coverage", "pricing", "pre-orders", "categories", "age-rating",
+			"routing-coverage", "pricing", "pre-orders", "categories", "age-rating", "privacy",
 			"accessibility", "encryption", "eula", "agreements", "app-clips",
 			"android-ios-mapping", "marketplace", "alternative-distribution",
 			"nominations", "game-center",
@@ -50,11 +50,11 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "REVIEW & RELEASE COMMANDS",
-		commands: []string{"release", "review", "reviews", "submit", "validate", "publish"},
+		commands: []string{"release", "review", "review-details", "reviews", "submit", "submissions", "resolution-center", "validate", "publish"},
 	},
 	{
 		title:    "MONETIZATION COMMANDS",
-		commands: []string{"iap", "app-events", "subscriptions"},
+		commands: []string{"iap", "app-events", "subscriptions", "notifications-config"},
 	},
 	{
 		title:    "SIGNING COMMANDS",
@@ -66,11 +66,11 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "AUTOMATION COMMANDS",
-		commands: []string{"webhooks", "xcode-cloud", "notify", "migrate"},
+		commands: []string{"run", "webhooks", "exporter", "serve", "xcode-cloud", "notify", "migrate"},
 	},
 	{
 		title:    "UTILITY COMMANDS",
-		commands: []string{"version", "completion", "schema"},
+		commands: []string{"version", "completion", "schema", "deprecations", "describe", "enums", "stats", "audit", "verify-binary"},
 	},
 }
 
@@ -12,12 +12,17 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/install"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/errfmt"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/stats"
 )
 
-var maybeCheckForSkillUpdates = install.MaybeCheckForSkillUpdates
+var (
+	maybeCheckForSkillUpdates = install.MaybeCheckForSkillUpdates
+	recordCommandUsage        = stats.RecordUsage
+)
 
 // Run executes the CLI using the provided args (not including argv[0]) and version string.
 // It returns the intended process exit code.
@@ -35,6 +40,25 @@ func Run(args []string, versionInfo string) int {
 	runCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer stopSignals()
 
+	args, err := shared.ExpandCommandAliases(root, args)
+	if err == nil {
+		args, err = shared.ExpandFlagArgs(root, args)
+	}
+	if err == nil {
+		args, err = shared.ApplyProjectConfig(root, args)
+	}
+	if err == nil {
+		args, err = shared.ApplyCommandDefaults(root, args)
+	}
+	args = shared.HoistRootFlags(root, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitUsage
+		}
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitCodeFromError(err)
+	}
+
 	if err := root.Parse(args); err != nil {
 		if errors.Is(err, flag.ErrHelp) {
 			return ExitSuccess
@@ -48,6 +72,11 @@ func Run(args []string, versionInfo string) int {
 		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
 		return ExitUsage
 	}
+	if err := shared.ValidateOutputFilter(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitUsage
+	}
+	shared.ApplyConsoleSettings()
 
 	if versionRequested {
 		if err := root.Run(runCtx); err != nil {
@@ -68,10 +97,16 @@ func Run(args []string, versionInfo string) int {
 	}
 
 	commandName := getCommandName(root, args)
+	asc.SetActiveCommand(commandName)
 
 	start := time.Now()
 	runErr := root.Run(runCtx)
 	elapsed := time.Since(start)
+	if errors.Is(runErr, asc.ErrDryRun) {
+		// The withheld request was already printed; nothing failed.
+		runErr = nil
+	}
+	recordCommandUsage(commandName, elapsed, ExitCodeFromError(runErr))
 
 	if commandName != "asc" && commandName != "asc install-skills" {
 		maybeCheckForSkillUpdates(runCtx)
@@ -185,6 +185,33 @@ func TestRun_SkipsSkillsUpdateCheckForRootInvocation(t *testing.T) {
 	}
 }
 
+func TestRun_RecordsCommandUsage(t *testing.T) {
+	resetReportFlags(t)
+
+	origRecord := recordCommandUsage
+	t.Cleanup(func() { recordCommandUsage = origRecord })
+
+	var (
+		gotCommand string
+		gotCode    = -1
+	)
+	recordCommandUsage = func(command string, elapsed time.Duration, exitCode int) {
+		gotCommand = command
+		gotCode = exitCode
+	}
+
+	_, _ = captureCommandOutput(t, func() {
+		code := Run([]string{"completion", "--shell", "bash"}, "1.0.0")
+		if code != ExitSuccess {
+			t.Fatalf("Run() exit code = %d, want %d", code, ExitSuccess)
+		}
+	})
+
+	if gotCommand != "asc completion" || gotCode != ExitSuccess {
+		t.Fatalf("recorded %q with exit code %d, want %q with %d", gotCommand, gotCode, "asc completion", ExitSuccess)
+	}
+}
+
 func TestRun_HelpSkipsAuthResolution(t *testing.T) {
 	resetReportFlags(t)
 
@@ -1045,3 +1072,29 @@ func TestRunHelpHelperProcess(t *testing.T) {
 	code := Run(os.Args[sep+1:], "1.0.0")
 	os.Exit(code)
 }
+
+func TestRun_ExpandsShortAliasesAndFlagPrefixes(t *testing.T) {
+	resetReportFlags(t)
+
+	stdout, stderr := captureCommandOutput(t, func() {
+		code := Run([]string{"enums", "list", "--ty", "deviceClass", "-o", "json"}, "1.0.0")
+		if code != ExitSuccess {
+			t.Fatalf("Run() exit code = %d, want %d", code, ExitSuccess)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var payload struct {
+		Data []struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Data) != 1 || payload.Data[0].Name != "deviceClass" {
+		t.Fatalf("expected deviceClass only, got %+v", payload.Data)
+	}
+}
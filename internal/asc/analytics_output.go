@@ -24,6 +24,8 @@ type AnalyticsReportRequestResult struct {
 	AccessType  string `json:"accessType"`
 	State       string `json:"state,omitempty"`
 	CreatedDate string `json:"createdDate,omitempty"`
+	// ReportsReady is set by --wait once at least one report instance exists.
+	ReportsReady bool `json:"reportsReady,omitempty"`
 }
 
 // AnalyticsReportRequestDeleteResult represents CLI output for deleted requests.
@@ -44,6 +46,14 @@ type AnalyticsReportDownloadResult struct {
 	DecompressedSize int64  `json:"decompressedSize,omitempty"`
 }
 
+// AnalyticsReportSegmentsDownloadResult represents CLI output for downloading
+// every segment of a multi-part analytics report instance.
+type AnalyticsReportSegmentsDownloadResult struct {
+	RequestID  string                          `json:"requestId"`
+	InstanceID string                          `json:"instanceId"`
+	Files      []AnalyticsReportDownloadResult `json:"files"`
+}
+
 // AnalyticsReportGetResult represents CLI output for report metadata with instances.
 type AnalyticsReportGetResult struct {
 	RequestID string                     `json:"requestId"`
@@ -99,8 +109,12 @@ func salesReportResultRows(result *SalesReportResult) ([]string, [][]string) {
 
 func analyticsReportRequestResultRows(result *AnalyticsReportRequestResult) ([]string, [][]string) {
 	headers := []string{"Request ID", "App ID", "Access Type", "State", "Created Date"}
-	rows := [][]string{{result.RequestID, result.AppID, result.AccessType, result.State, result.CreatedDate}}
-	return headers, rows
+	row := []string{result.RequestID, result.AppID, result.AccessType, result.State, result.CreatedDate}
+	if result.ReportsReady {
+		headers = append(headers, "Reports Ready")
+		row = append(row, "true")
+	}
+	return headers, [][]string{row}
 }
 
 func analyticsReportRequestDeleteResultRows(result *AnalyticsReportRequestDeleteResult) ([]string, [][]string) {
@@ -130,7 +144,21 @@ func analyticsReportRequestsRows(resp *AnalyticsReportRequestsResponse) ([]strin
 
 func analyticsReportDownloadResultRows(result *AnalyticsReportDownloadResult) ([]string, [][]string) {
 	headers := []string{"Request ID", "Instance ID", "Segment ID", "Compressed File", "Compressed Size", "Decompressed File", "Decompressed Size"}
-	rows := [][]string{{
+	rows := [][]string{analyticsReportDownloadRow(result)}
+	return headers, rows
+}
+
+func analyticsReportSegmentsDownloadResultRows(result *AnalyticsReportSegmentsDownloadResult) ([]string, [][]string) {
+	headers := []string{"Request ID", "Instance ID", "Segment ID", "Compressed File", "Compressed Size", "Decompressed File", "Decompressed Size"}
+	rows := make([][]string, 0, len(result.Files))
+	for i := range result.Files {
+		rows = append(rows, analyticsReportDownloadRow(&result.Files[i]))
+	}
+	return headers, rows
+}
+
+func analyticsReportDownloadRow(result *AnalyticsReportDownloadResult) []string {
+	return []string{
 		result.RequestID,
 		result.InstanceID,
 		result.SegmentID,
@@ -138,8 +166,7 @@ func analyticsReportDownloadResultRows(result *AnalyticsReportDownloadResult) ([
 		fmt.Sprintf("%d", result.FileSize),
 		result.DecompressedPath,
 		fmt.Sprintf("%d", result.DecompressedSize),
-	}}
-	return headers, rows
+	}
 }
 
 func analyticsReportGetResultRows(result *AnalyticsReportGetResult) ([]string, [][]string) {
@@ -33,6 +33,10 @@ type AssetUploadResultItem struct {
 	AssetID  string `json:"assetId"`
 	State    string `json:"state,omitempty"`
 	Skipped  bool   `json:"skipped,omitempty"`
+	// Checksum and ChecksumVerified are set when the upload was re-fetched
+	// and its server-side checksum matched the local file.
+	Checksum         string `json:"checksum,omitempty"`
+	ChecksumVerified bool   `json:"checksumVerified,omitempty"`
 }
 
 // AppScreenshotUploadResult represents screenshot upload output.
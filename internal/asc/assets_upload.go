@@ -12,15 +12,13 @@ import (
 	_ "image/jpeg"
 	_ "image/png"
 	"io"
-	"net/http"
 	"os"
-	"strings"
 )
 
 const maxAssetFileSize = int64(1024 * 1024 * 1024) // 1GB safety guardrail
 
 // UploadAsset uploads a file using the provided upload operations.
-func UploadAsset(ctx context.Context, filePath string, operations []UploadOperation) error {
+func UploadAsset(ctx context.Context, filePath string, operations []UploadOperation, opts ...UploadOption) error {
 	file, err := openUploadSourceFile(filePath)
 	if err != nil {
 		return err
@@ -32,55 +30,14 @@ func UploadAsset(ctx context.Context, filePath string, operations []UploadOperat
 		return err
 	}
 
-	return UploadAssetFromFile(ctx, file, info.Size(), operations)
+	return UploadAssetFromFile(ctx, file, info.Size(), operations, opts...)
 }
 
 // UploadAssetFromFile uploads a file using the provided upload operations.
-func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, operations []UploadOperation) error {
-	if len(operations) == 0 {
-		return fmt.Errorf("no upload operations provided")
-	}
-
-	client := &http.Client{Timeout: ResolveUploadTimeout()}
-
-	for i, op := range operations {
-		method := strings.ToUpper(strings.TrimSpace(op.Method))
-		if method == "" {
-			return fmt.Errorf("upload operation %d missing method", i)
-		}
-		if strings.TrimSpace(op.URL) == "" {
-			return fmt.Errorf("upload operation %d missing url", i)
-		}
-		if op.Offset < 0 || op.Length < 0 {
-			return fmt.Errorf("upload operation %d has negative offset/length", i)
-		}
-		if op.Offset+op.Length > fileSize {
-			return fmt.Errorf("upload operation %d exceeds file size", i)
-		}
-
-		reader := io.NewSectionReader(file, op.Offset, op.Length)
-		req, err := http.NewRequestWithContext(ctx, method, op.URL, reader)
-		if err != nil {
-			return fmt.Errorf("upload operation %d: %w", i, err)
-		}
-		req.ContentLength = op.Length
-		for _, header := range op.RequestHeaders {
-			req.Header.Set(header.Name, header.Value)
-		}
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return fmt.Errorf("upload operation %d failed: %w", i, err)
-		}
-		_, _ = io.Copy(io.Discard, resp.Body)
-		_ = resp.Body.Close()
-
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return fmt.Errorf("upload operation %d failed with status %d", i, resp.StatusCode)
-		}
-	}
-
-	return nil
+// Parts are sent concurrently (see ResolveUploadConcurrency) and retried
+// individually.
+func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, operations []UploadOperation, opts ...UploadOption) error {
+	return uploadFileParts(ctx, file, fileSize, operations, opts...)
 }
 
 // ValidateAssetFile validates that a file exists and is safe to read.
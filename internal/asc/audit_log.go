@@ -0,0 +1,200 @@
+package asc
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	auditLogEnabledEnv = "ASC_AUDIT_LOG"
+	auditLogPathEnv    = "ASC_AUDIT_LOG_PATH"
+)
+
+// AuditEntry is one line of the local audit log. It records a mutating
+// request the CLI sent, never its body.
+type AuditEntry struct {
+	Time         time.Time `json:"time"`
+	Command      string    `json:"command"`
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	ResourceType string    `json:"resourceType,omitempty"`
+	ResourceID   string    `json:"resourceId,omitempty"`
+	KeyID        string    `json:"keyId,omitempty"`
+	User         string    `json:"user,omitempty"`
+	Host         string    `json:"host,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// AuditLogEnabled reports whether mutating requests are recorded.
+// Recording is on by default; ASC_AUDIT_LOG=0 disables it.
+func AuditLogEnabled() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(auditLogEnabledEnv))) {
+	case "0", "false", "no", "off":
+		return false
+	default:
+		return true
+	}
+}
+
+// AuditLogPath returns the local audit log path. ASC_AUDIT_LOG_PATH overrides
+// the default of ~/.asc/audit.jsonl.
+func AuditLogPath() (string, error) {
+	if custom := strings.TrimSpace(os.Getenv(auditLogPathEnv)); custom != "" {
+		return custom, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".asc", "audit.jsonl"), nil
+}
+
+// ReadAuditEntries loads all entries from the audit log in the order they
+// were written. A missing log is treated as empty, and malformed lines are
+// skipped.
+func ReadAuditEntries(path string) ([]AuditEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var entry AuditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.Method == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// recordAudit appends a sent mutating request to the audit log. Only requests
+// made while a CLI command is active are recorded, and failures to write the
+// log are ignored so they cannot affect the command that ran.
+func recordAudit(keyID, method, path string, response []byte, requestErr error) {
+	if shouldRetryMethod(method) || !AuditLogEnabled() {
+		return
+	}
+	command := activeCommand()
+	if command == "" {
+		return
+	}
+	apiPath, ok := auditAPIPath(path)
+	if !ok {
+		return
+	}
+	logPath, err := AuditLogPath()
+	if err != nil {
+		return
+	}
+
+	entry := AuditEntry{
+		Time:    time.Now().UTC(),
+		Command: command,
+		Method:  strings.ToUpper(method),
+		Path:    apiPath,
+		KeyID:   strings.TrimSpace(keyID),
+		User:    auditUser(),
+	}
+	entry.Host, _ = os.Hostname()
+	entry.ResourceType, entry.ResourceID = auditResource(apiPath, response)
+	if requestErr != nil {
+		entry.Error = requestErr.Error()
+	}
+	_ = appendAuditEntry(logPath, entry)
+}
+
+// auditAPIPath strips the base URL and query from a request path. Requests to
+// hosts other than App Store Connect (such as upload URLs) are not audited.
+func auditAPIPath(path string) (string, bool) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		trimmed, ok := strings.CutPrefix(path, BaseURL)
+		if !ok {
+			return "", false
+		}
+		path = trimmed
+	}
+	path, _, _ = strings.Cut(path, "?")
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path, true
+}
+
+// auditResource derives the resource type and ID from an API path such as
+// /v1/apps/123 or /v1/apps/123/relationships/builds. Creates carry no ID in
+// the path, so the ID is taken from the response document instead.
+func auditResource(path string, response []byte) (string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && len(segments[0]) > 1 && segments[0][0] == 'v' {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 {
+		return "", ""
+	}
+	resourceType := segments[0]
+	if len(segments) > 1 {
+		return resourceType, segments[1]
+	}
+
+	var document struct {
+		Data struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"data"`
+	}
+	if len(response) > 0 && json.Unmarshal(response, &document) == nil && document.Data.ID != "" {
+		if document.Data.Type != "" {
+			resourceType = document.Data.Type
+		}
+		return resourceType, document.Data.ID
+	}
+	return resourceType, ""
+}
+
+func auditUser() string {
+	if current, err := user.Current(); err == nil && current.Username != "" {
+		return current.Username
+	}
+	return strings.TrimSpace(os.Getenv("USER"))
+}
+
+func appendAuditEntry(path string, entry AuditEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,58 @@
+package asc
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestAuditResource(t *testing.T) {
+	tests := []struct {
+		path     string
+		response string
+		wantType string
+		wantID   string
+	}{
+		{path: "/v1/apps/123", wantType: "apps", wantID: "123"},
+		{path: "/v1/apps/123/relationships/builds", wantType: "apps", wantID: "123"},
+		{path: "/v1/betaGroups", response: `{"data":{"type":"betaGroups","id":"group-1"}}`, wantType: "betaGroups", wantID: "group-1"},
+		{path: "/v1/betaGroups", wantType: "betaGroups"},
+	}
+	for _, test := range tests {
+		gotType, gotID := auditResource(test.path, []byte(test.response))
+		if gotType != test.wantType || gotID != test.wantID {
+			t.Fatalf("auditResource(%q) = (%q, %q), want (%q, %q)", test.path, gotType, gotID, test.wantType, test.wantID)
+		}
+	}
+}
+
+func TestRecordAuditSkipsReadsAndInactiveCommands(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	t.Setenv(auditLogEnabledEnv, "")
+	t.Setenv(auditLogPathEnv, path)
+	t.Cleanup(func() { SetActiveCommand("") })
+
+	recordAudit("KEY", "DELETE", "/v1/apps/1", nil, nil)
+	SetActiveCommand("asc builds expire")
+	recordAudit("KEY", "GET", "/v1/builds/1", nil, nil)
+	recordAudit("KEY", "PATCH", "https://uploads.example.com/part?token=1", nil, nil)
+	recordAudit("KEY", "PATCH", BaseURL+"/v1/builds/1?include=app", nil, errors.New("boom"))
+
+	entries, err := ReadAuditEntries(path)
+	if err != nil {
+		t.Fatalf("ReadAuditEntries() error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected one entry, got %+v", entries)
+	}
+	entry := entries[0]
+	if entry.Command != "asc builds expire" || entry.Path != "/v1/builds/1" || entry.ResourceID != "1" || entry.KeyID != "KEY" || entry.Error != "boom" {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+
+	t.Setenv(auditLogEnabledEnv, "0")
+	recordAudit("KEY", "DELETE", "/v1/builds/2", nil, nil)
+	if entries, _ := ReadAuditEntries(path); len(entries) != 1 {
+		t.Fatalf("expected no new entries when disabled, got %d", len(entries))
+	}
+}
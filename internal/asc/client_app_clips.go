@@ -626,13 +626,21 @@ func (c *Client) GetAppClips(ctx context.Context, appID string, opts ...AppClips
 }
 
 // GetAppClip retrieves an App Clip by ID.
-func (c *Client) GetAppClip(ctx context.Context, appClipID string) (*AppClipResponse, error) {
+func (c *Client) GetAppClip(ctx context.Context, appClipID string, opts ...AppClipOption) (*AppClipResponse, error) {
 	appClipID = strings.TrimSpace(appClipID)
 	if appClipID == "" {
 		return nil, fmt.Errorf("appClipID is required")
 	}
 
+	query := &appClipQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	path := fmt.Sprintf("/v1/appClips/%s", appClipID)
+	if queryString := buildAppClipQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,12 @@ type AppAttributes struct {
 	SKU                      string                    `json:"sku"`
 	PrimaryLocale            string                    `json:"primaryLocale,omitempty"`
 	ContentRightsDeclaration *ContentRightsDeclaration `json:"contentRightsDeclaration,omitempty"`
+
+	// App Store Server Notifications endpoints.
+	SubscriptionStatusURL                  string `json:"subscriptionStatusUrl,omitempty"`
+	SubscriptionStatusURLVersion           string `json:"subscriptionStatusUrlVersion,omitempty"`
+	SubscriptionStatusURLForSandbox        string `json:"subscriptionStatusUrlForSandbox,omitempty"`
+	SubscriptionStatusURLVersionForSandbox string `json:"subscriptionStatusUrlVersionForSandbox,omitempty"`
 }
 
 // AppUpdateAttributes describes fields for updating an app.
@@ -29,6 +35,11 @@ type AppUpdateAttributes struct {
 	BundleID                 *string                   `json:"bundleId,omitempty"`
 	PrimaryLocale            *string                   `json:"primaryLocale,omitempty"`
 	ContentRightsDeclaration *ContentRightsDeclaration `json:"contentRightsDeclaration,omitempty"`
+
+	SubscriptionStatusURL                  *string `json:"subscriptionStatusUrl,omitempty"`
+	SubscriptionStatusURLVersion           *string `json:"subscriptionStatusUrlVersion,omitempty"`
+	SubscriptionStatusURLForSandbox        *string `json:"subscriptionStatusUrlForSandbox,omitempty"`
+	SubscriptionStatusURLVersionForSandbox *string `json:"subscriptionStatusUrlVersionForSandbox,omitempty"`
 }
 
 // AppCreateAttributes describes attributes for creating an app.
@@ -100,9 +111,17 @@ func (c *Client) GetApps(ctx context.Context, opts ...AppsOption) (*AppsResponse
 }
 
 // GetApp retrieves a single app by ID.
-func (c *Client) GetApp(ctx context.Context, appID string) (*AppResponse, error) {
+func (c *Client) GetApp(ctx context.Context, appID string, opts ...AppOption) (*AppResponse, error) {
+	query := &appQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	appID = strings.TrimSpace(appID)
 	path := fmt.Sprintf("/v1/apps/%s", appID)
+	if queryString := buildAppQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
@@ -272,7 +291,7 @@ func (c *Client) UpdateApp(ctx context.Context, appID string, attrs AppUpdateAtt
 			ID:   appID,
 		},
 	}
-	if attrs.BundleID != nil || attrs.PrimaryLocale != nil || attrs.ContentRightsDeclaration != nil {
+	if attrs != (AppUpdateAttributes{}) {
 		payload.Data.Attributes = &attrs
 	}
 
@@ -248,8 +248,16 @@ func (c *Client) GetBuilds(ctx context.Context, appID string, opts ...BuildsOpti
 }
 
 // GetBuild retrieves a single build by ID.
-func (c *Client) GetBuild(ctx context.Context, buildID string) (*BuildResponse, error) {
+func (c *Client) GetBuild(ctx context.Context, buildID string, opts ...BuildOption) (*BuildResponse, error) {
+	query := &buildQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	path := fmt.Sprintf("/v1/builds/%s", buildID)
+	if queryString := buildBuildQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
@@ -334,6 +342,45 @@ func (c *Client) ExpireBuild(ctx context.Context, buildID string) (*BuildRespons
 	return &response, nil
 }
 
+// UpdateBuildUsesNonExemptEncryption sets a build's export compliance answer.
+func (c *Client) UpdateBuildUsesNonExemptEncryption(ctx context.Context, buildID string, usesNonExemptEncryption bool) (*BuildResponse, error) {
+	buildID = strings.TrimSpace(buildID)
+	if buildID == "" {
+		return nil, fmt.Errorf("buildID is required")
+	}
+
+	payload := struct {
+		Data struct {
+			Type       ResourceType `json:"type"`
+			ID         string       `json:"id"`
+			Attributes struct {
+				UsesNonExemptEncryption bool `json:"usesNonExemptEncryption"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}{}
+	payload.Data.Type = ResourceTypeBuilds
+	payload.Data.ID = buildID
+	payload.Data.Attributes.UsesNonExemptEncryption = usesNonExemptEncryption
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/builds/%s", buildID)
+	data, err := c.do(ctx, "PATCH", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response BuildResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // AddBetaGroupsToBuild adds beta groups to a build for TestFlight distribution.
 func (c *Client) AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error {
 	return c.AddBetaGroupsToBuildWithNotify(ctx, buildID, groupIDs, false)
@@ -65,6 +65,11 @@ var retryLogOverride struct {
 	val *bool
 }
 
+var maxRetriesOverride struct {
+	mu  sync.RWMutex
+	val *int
+}
+
 var debugOverride struct {
 	mu          sync.RWMutex
 	enabled     *bool
@@ -91,6 +96,14 @@ func SetRetryLogOverride(value *bool) {
 	retryLogOverride.val = value
 }
 
+// SetMaxRetriesOverride sets an explicit max-retries override (0 disables retries).
+// When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
+func SetMaxRetriesOverride(value *int) {
+	maxRetriesOverride.mu.Lock()
+	defer maxRetriesOverride.mu.Unlock()
+	maxRetriesOverride.val = value
+}
+
 // SetDebugOverride sets an explicit debug override.
 // When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
 func SetDebugOverride(value *bool) {
@@ -268,6 +281,7 @@ type RetryOptions struct {
 }
 
 // ResolveRetryOptions returns retry options, optionally overridden by config/env.
+// MaxRetries precedence: explicit override > env > config.
 func ResolveRetryOptions() RetryOptions {
 	opts := RetryOptions{
 		MaxRetries: DefaultMaxRetries,
@@ -277,7 +291,15 @@ func ResolveRetryOptions() RetryOptions {
 
 	cfg := loadConfig()
 
-	if override, ok := envValue("ASC_MAX_RETRIES"); ok {
+	maxRetriesOverride.mu.RLock()
+	maxRetries := maxRetriesOverride.val
+	maxRetriesOverride.mu.RUnlock()
+
+	if maxRetries != nil {
+		if *maxRetries >= 0 {
+			opts.MaxRetries = *maxRetries
+		}
+	} else if override, ok := envValue("ASC_MAX_RETRIES"); ok {
 		if override != "" {
 			if parsed, err := strconv.Atoi(override); err == nil && parsed >= 0 {
 				opts.MaxRetries = parsed
@@ -481,6 +503,19 @@ type Client struct {
 	jwtMu              sync.Mutex
 	cachedJWT          string
 	cachedJWTExpiresAt time.Time
+	cachedJWTOffset    time.Duration
+	tokenCachePath     string
+
+	// probeClock enables the pre-flight Date check before the first token is
+	// signed; see EnableClockProbe.
+	probeClock bool
+
+	gcDetailIDs sync.Map // app ID -> Game Center detail ID
+}
+
+// IssuerID returns the issuer (team) ID the client signs tokens for.
+func (c *Client) IssuerID() string {
+	return c.issuerID
 }
 
 // NewClient creates a new ASC client.
@@ -509,7 +544,7 @@ func newDefaultHTTPClient(timeout time.Duration) *http.Client {
 		// Keep that behavior and skip transport tuning in that case.
 		return &http.Client{
 			Timeout:   timeout,
-			Transport: http.DefaultTransport,
+			Transport: withMockTransport(http.DefaultTransport),
 		}
 	}
 
@@ -519,7 +554,7 @@ func newDefaultHTTPClient(timeout time.Duration) *http.Client {
 
 	return &http.Client{
 		Timeout:   timeout,
-		Transport: clonedTransport,
+		Transport: withMockTransport(clonedTransport),
 	}
 }
 
@@ -10,8 +10,15 @@ import (
 )
 
 // GetGameCenterDetailID retrieves the Game Center detail ID for an app.
+// Non-empty IDs are remembered for the lifetime of the client, so repeated
+// lookups for the same app (such as `--id -` batches) hit the API once.
 func (c *Client) GetGameCenterDetailID(ctx context.Context, appID string) (string, error) {
-	path := fmt.Sprintf("/v1/apps/%s/gameCenterDetail", strings.TrimSpace(appID))
+	appID = strings.TrimSpace(appID)
+	if cached, ok := c.gcDetailIDs.Load(appID); ok {
+		return cached.(string), nil
+	}
+
+	path := fmt.Sprintf("/v1/apps/%s/gameCenterDetail", appID)
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return "", err
@@ -22,6 +29,11 @@ func (c *Client) GetGameCenterDetailID(ctx context.Context, appID string) (strin
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	// An empty ID means the app has no detail yet; don't cache it so a
+	// detail created later in the same process is picked up.
+	if id := strings.TrimSpace(response.Data.ID); id != "" {
+		c.gcDetailIDs.Store(appID, response.Data.ID)
+	}
 	return response.Data.ID, nil
 }
 
@@ -1671,7 +1683,7 @@ func (c *Client) UploadGameCenterLeaderboardSetImage(ctx context.Context, locali
 
 // GetGameCenterAchievementV2 retrieves a v2 Game Center achievement by ID.
 func (c *Client) GetGameCenterAchievementV2(ctx context.Context, achievementID string) (*GameCenterAchievementResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterAchievements/%s", strings.TrimSpace(achievementID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievements", strings.TrimSpace(achievementID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1725,7 +1737,7 @@ func (c *Client) CreateGameCenterAchievementV2(ctx context.Context, gcDetailID s
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterAchievements", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterAchievements"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1753,7 +1765,7 @@ func (c *Client) UpdateGameCenterAchievementV2(ctx context.Context, achievementI
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievements/%s", strings.TrimSpace(achievementID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievements", strings.TrimSpace(achievementID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -1769,14 +1781,14 @@ func (c *Client) UpdateGameCenterAchievementV2(ctx context.Context, achievementI
 
 // DeleteGameCenterAchievementV2 deletes a v2 Game Center achievement.
 func (c *Client) DeleteGameCenterAchievementV2(ctx context.Context, achievementID string) error {
-	path := fmt.Sprintf("/v2/gameCenterAchievements/%s", strings.TrimSpace(achievementID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievements", strings.TrimSpace(achievementID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
 
 // GetGameCenterLeaderboardV2 retrieves a v2 Game Center leaderboard by ID.
 func (c *Client) GetGameCenterLeaderboardV2(ctx context.Context, leaderboardID string) (*GameCenterLeaderboardResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s", strings.TrimSpace(leaderboardID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", strings.TrimSpace(leaderboardID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1830,7 +1842,7 @@ func (c *Client) CreateGameCenterLeaderboardV2(ctx context.Context, gcDetailID s
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboards", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterLeaderboards"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1858,7 +1870,7 @@ func (c *Client) UpdateGameCenterLeaderboardV2(ctx context.Context, leaderboardI
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s", strings.TrimSpace(leaderboardID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", strings.TrimSpace(leaderboardID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -1874,7 +1886,7 @@ func (c *Client) UpdateGameCenterLeaderboardV2(ctx context.Context, leaderboardI
 
 // DeleteGameCenterLeaderboardV2 deletes a v2 Game Center leaderboard.
 func (c *Client) DeleteGameCenterLeaderboardV2(ctx context.Context, leaderboardID string) error {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s", strings.TrimSpace(leaderboardID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", strings.TrimSpace(leaderboardID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
@@ -186,7 +186,7 @@ func (c *Client) UpdateGameCenterAchievementActivityRelationshipV2(ctx context.C
 		return err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievements/%s/relationships/activity", achievementID)
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievements", achievementID, "relationships", "activity")
 	_, err = c.do(ctx, http.MethodPatch, path, body)
 	return err
 }
@@ -209,7 +209,7 @@ func (c *Client) getGameCenterAchievementLinkagesV2(ctx context.Context, achieve
 		achievementID,
 		relationship,
 		"achievementID",
-		"/v2/gameCenterAchievements/%s/relationships/%s",
+		versionedResourcePath(apiVersionV2, "gameCenterAchievements", "%s", "relationships", "%s"),
 		"gameCenterAchievementRelationshipsV2",
 		opts...,
 	)
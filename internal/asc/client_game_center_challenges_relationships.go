@@ -20,6 +20,12 @@ type GameCenterChallengeVersionDefaultImageLinkageResponse struct {
 	Links Links        `json:"links"`
 }
 
+// GameCenterChallengeLeaderboardLinkageResponse is the response for challenge leaderboard relationships.
+type GameCenterChallengeLeaderboardLinkageResponse struct {
+	Data  ResourceData `json:"data"`
+	Links Links        `json:"links"`
+}
+
 // GetGameCenterChallengeVersionsRelationships retrieves version linkages for a challenge.
 func (c *Client) GetGameCenterChallengeVersionsRelationships(ctx context.Context, challengeID string, opts ...LinkagesOption) (*LinkagesResponse, error) {
 	return c.getGameCenterChallengeLinkages(ctx, challengeID, "versions", opts...)
@@ -72,6 +78,27 @@ func (c *Client) GetGameCenterChallengeVersionLocalizationsRelationships(ctx con
 	return c.getGameCenterChallengeVersionLinkages(ctx, versionID, "localizations", opts...)
 }
 
+// GetGameCenterChallengeLeaderboardRelationship retrieves the leaderboard linkage for a challenge.
+func (c *Client) GetGameCenterChallengeLeaderboardRelationship(ctx context.Context, challengeID string) (*GameCenterChallengeLeaderboardLinkageResponse, error) {
+	challengeID = strings.TrimSpace(challengeID)
+	if challengeID == "" {
+		return nil, fmt.Errorf("challengeID is required")
+	}
+
+	path := fmt.Sprintf("/v1/gameCenterChallenges/%s/relationships/leaderboard", challengeID)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GameCenterChallengeLeaderboardLinkageResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse leaderboard relationship response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // UpdateGameCenterChallengeLeaderboardRelationship updates the leaderboard relationship on a challenge.
 func (c *Client) UpdateGameCenterChallengeLeaderboardRelationship(ctx context.Context, challengeID, leaderboardID string) error {
 	challengeID = strings.TrimSpace(challengeID)
@@ -22,7 +22,7 @@ func (c *Client) AddGameCenterLeaderboardSetMembersV2(ctx context.Context, setID
 		return err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID), "relationships", "gameCenterLeaderboards")
 	_, err = c.do(ctx, http.MethodPost, path, body)
 	return err
 }
@@ -37,7 +37,7 @@ func (c *Client) RemoveGameCenterLeaderboardSetMembersV2(ctx context.Context, se
 		return err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID), "relationships", "gameCenterLeaderboards")
 	_, err = c.do(ctx, http.MethodDelete, path, body)
 	return err
 }
@@ -76,7 +76,7 @@ func (c *Client) UpdateGameCenterLeaderboardActivityRelationshipV2(ctx context.C
 		return err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s/relationships/activity", leaderboardID)
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", leaderboardID, "relationships", "activity")
 	_, err = c.do(ctx, http.MethodPatch, path, body)
 	return err
 }
@@ -105,7 +105,7 @@ func (c *Client) UpdateGameCenterLeaderboardChallengeRelationshipV2(ctx context.
 		return err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s/relationships/challenge", leaderboardID)
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", leaderboardID, "relationships", "challenge")
 	_, err = c.do(ctx, http.MethodPatch, path, body)
 	return err
 }
@@ -116,7 +116,7 @@ func (c *Client) getGameCenterLeaderboardSetLinkagesV2(ctx context.Context, setI
 		setID,
 		relationship,
 		"setID",
-		"/v2/gameCenterLeaderboardSets/%s/relationships/%s",
+		versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", "%s", "relationships", "%s"),
 		"gameCenterLeaderboardSetRelationshipsV2",
 		opts...,
 	)
@@ -128,7 +128,7 @@ func (c *Client) getGameCenterLeaderboardLinkagesV2(ctx context.Context, leaderb
 		leaderboardID,
 		relationship,
 		"leaderboardID",
-		"/v2/gameCenterLeaderboards/%s/relationships/%s",
+		versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", "%s", "relationships", "%s"),
 		"gameCenterLeaderboardRelationshipsV2",
 		opts...,
 	)
@@ -67,7 +67,7 @@ func (c *Client) GetGameCenterAchievementVersions(ctx context.Context, achieveme
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievements/%s/versions", strings.TrimSpace(achievementID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievements", strings.TrimSpace(achievementID), "versions")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-versions: %w", err)
@@ -92,7 +92,7 @@ func (c *Client) GetGameCenterAchievementVersions(ctx context.Context, achieveme
 
 // GetGameCenterAchievementVersion retrieves a v2 achievement version by ID.
 func (c *Client) GetGameCenterAchievementVersion(ctx context.Context, versionID string) (*GameCenterAchievementVersionResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterAchievementVersions/%s", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterAchievementVersions", strings.TrimSpace(versionID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -127,7 +127,7 @@ func (c *Client) CreateGameCenterAchievementVersion(ctx context.Context, achieve
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterAchievementVersions", body)
+	data, err := c.do(ctx, http.MethodPost, resourcePath("gameCenterAchievementVersions"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +147,7 @@ func (c *Client) GetGameCenterAchievementVersionLocalizations(ctx context.Contex
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievementVersions/%s/localizations", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterAchievementVersions", strings.TrimSpace(versionID), "localizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-version-localizations: %w", err)
@@ -177,7 +177,7 @@ func (c *Client) GetGameCenterAchievementVersionLocalizationsRelationships(ctx c
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievementVersions/%s/relationships/localizations", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterAchievementVersions", strings.TrimSpace(versionID), "relationships", "localizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-version-localizations-relationships: %w", err)
@@ -202,7 +202,7 @@ func (c *Client) GetGameCenterAchievementVersionLocalizationsRelationships(ctx c
 
 // GetGameCenterAchievementLocalizationV2 retrieves a v2 achievement localization by ID.
 func (c *Client) GetGameCenterAchievementLocalizationV2(ctx context.Context, localizationID string) (*GameCenterAchievementLocalizationResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterAchievementLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementLocalizations", strings.TrimSpace(localizationID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -238,7 +238,7 @@ func (c *Client) CreateGameCenterAchievementLocalizationV2(ctx context.Context,
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterAchievementLocalizations", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterAchievementLocalizations"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +266,7 @@ func (c *Client) UpdateGameCenterAchievementLocalizationV2(ctx context.Context,
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievementLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementLocalizations", strings.TrimSpace(localizationID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -282,14 +282,14 @@ func (c *Client) UpdateGameCenterAchievementLocalizationV2(ctx context.Context,
 
 // DeleteGameCenterAchievementLocalizationV2 deletes a v2 achievement localization.
 func (c *Client) DeleteGameCenterAchievementLocalizationV2(ctx context.Context, localizationID string) error {
-	path := fmt.Sprintf("/v2/gameCenterAchievementLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementLocalizations", strings.TrimSpace(localizationID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
 
 // GetGameCenterAchievementLocalizationImageV2 retrieves the image for a v2 achievement localization.
 func (c *Client) GetGameCenterAchievementLocalizationImageV2(ctx context.Context, localizationID string) (*GameCenterAchievementImageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterAchievementLocalizations/%s/image", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementLocalizations", strings.TrimSpace(localizationID), "image")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -305,7 +305,7 @@ func (c *Client) GetGameCenterAchievementLocalizationImageV2(ctx context.Context
 
 // GetGameCenterAchievementLocalizationImageRelationshipV2 retrieves the image linkage for a v2 achievement localization.
 func (c *Client) GetGameCenterAchievementLocalizationImageRelationshipV2(ctx context.Context, localizationID string) (*GameCenterAchievementLocalizationV2ImageLinkageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterAchievementLocalizations/%s/relationships/image", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementLocalizations", strings.TrimSpace(localizationID), "relationships", "image")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -321,7 +321,7 @@ func (c *Client) GetGameCenterAchievementLocalizationImageRelationshipV2(ctx con
 
 // GetGameCenterAchievementImageV2 retrieves a v2 achievement image by ID.
 func (c *Client) GetGameCenterAchievementImageV2(ctx context.Context, imageID string) (*GameCenterAchievementImageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterAchievementImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementImages", strings.TrimSpace(imageID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -360,7 +360,7 @@ func (c *Client) CreateGameCenterAchievementImageV2(ctx context.Context, localiz
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterAchievementImages", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterAchievementImages"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -390,7 +390,7 @@ func (c *Client) UpdateGameCenterAchievementImageV2(ctx context.Context, imageID
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterAchievementImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementImages", strings.TrimSpace(imageID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -406,7 +406,7 @@ func (c *Client) UpdateGameCenterAchievementImageV2(ctx context.Context, imageID
 
 // DeleteGameCenterAchievementImageV2 deletes a v2 achievement image.
 func (c *Client) DeleteGameCenterAchievementImageV2(ctx context.Context, imageID string) error {
-	path := fmt.Sprintf("/v2/gameCenterAchievementImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterAchievementImages", strings.TrimSpace(imageID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
@@ -499,7 +499,7 @@ func (c *Client) GetGameCenterLeaderboardVersions(ctx context.Context, leaderboa
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s/versions", strings.TrimSpace(leaderboardID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboards", strings.TrimSpace(leaderboardID), "versions")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-versions: %w", err)
@@ -524,7 +524,7 @@ func (c *Client) GetGameCenterLeaderboardVersions(ctx context.Context, leaderboa
 
 // GetGameCenterLeaderboardVersion retrieves a v2 leaderboard version by ID.
 func (c *Client) GetGameCenterLeaderboardVersion(ctx context.Context, versionID string) (*GameCenterLeaderboardVersionResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardVersions/%s", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterLeaderboardVersions", strings.TrimSpace(versionID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -559,7 +559,7 @@ func (c *Client) CreateGameCenterLeaderboardVersion(ctx context.Context, leaderb
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardVersions", body)
+	data, err := c.do(ctx, http.MethodPost, resourcePath("gameCenterLeaderboardVersions"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -579,7 +579,7 @@ func (c *Client) GetGameCenterLeaderboardVersionLocalizations(ctx context.Contex
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardVersions/%s/localizations", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterLeaderboardVersions", strings.TrimSpace(versionID), "localizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-version-localizations: %w", err)
@@ -609,7 +609,7 @@ func (c *Client) GetGameCenterLeaderboardVersionLocalizationsRelationships(ctx c
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardVersions/%s/relationships/localizations", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterLeaderboardVersions", strings.TrimSpace(versionID), "relationships", "localizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-version-localizations-relationships: %w", err)
@@ -634,7 +634,7 @@ func (c *Client) GetGameCenterLeaderboardVersionLocalizationsRelationships(ctx c
 
 // GetGameCenterLeaderboardLocalizationV2 retrieves a v2 leaderboard localization by ID.
 func (c *Client) GetGameCenterLeaderboardLocalizationV2(ctx context.Context, localizationID string) (*GameCenterLeaderboardLocalizationResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardLocalizations", strings.TrimSpace(localizationID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -670,7 +670,7 @@ func (c *Client) CreateGameCenterLeaderboardLocalizationV2(ctx context.Context,
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardLocalizations", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterLeaderboardLocalizations"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -698,7 +698,7 @@ func (c *Client) UpdateGameCenterLeaderboardLocalizationV2(ctx context.Context,
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardLocalizations", strings.TrimSpace(localizationID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -714,14 +714,14 @@ func (c *Client) UpdateGameCenterLeaderboardLocalizationV2(ctx context.Context,
 
 // DeleteGameCenterLeaderboardLocalizationV2 deletes a v2 leaderboard localization.
 func (c *Client) DeleteGameCenterLeaderboardLocalizationV2(ctx context.Context, localizationID string) error {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardLocalizations", strings.TrimSpace(localizationID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
 
 // GetGameCenterLeaderboardLocalizationImageV2 retrieves the image for a v2 leaderboard localization.
 func (c *Client) GetGameCenterLeaderboardLocalizationImageV2(ctx context.Context, localizationID string) (*GameCenterLeaderboardImageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardLocalizations/%s/image", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardLocalizations", strings.TrimSpace(localizationID), "image")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -737,7 +737,7 @@ func (c *Client) GetGameCenterLeaderboardLocalizationImageV2(ctx context.Context
 
 // GetGameCenterLeaderboardLocalizationImageRelationshipV2 retrieves the image linkage for a v2 leaderboard localization.
 func (c *Client) GetGameCenterLeaderboardLocalizationImageRelationshipV2(ctx context.Context, localizationID string) (*GameCenterLeaderboardLocalizationV2ImageLinkageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardLocalizations/%s/relationships/image", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardLocalizations", strings.TrimSpace(localizationID), "relationships", "image")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -753,7 +753,7 @@ func (c *Client) GetGameCenterLeaderboardLocalizationImageRelationshipV2(ctx con
 
 // GetGameCenterLeaderboardImageV2 retrieves a v2 leaderboard image by ID.
 func (c *Client) GetGameCenterLeaderboardImageV2(ctx context.Context, imageID string) (*GameCenterLeaderboardImageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardImages", strings.TrimSpace(imageID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -792,7 +792,7 @@ func (c *Client) CreateGameCenterLeaderboardImageV2(ctx context.Context, localiz
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardImages", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterLeaderboardImages"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -822,7 +822,7 @@ func (c *Client) UpdateGameCenterLeaderboardImageV2(ctx context.Context, imageID
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardImages", strings.TrimSpace(imageID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -838,7 +838,7 @@ func (c *Client) UpdateGameCenterLeaderboardImageV2(ctx context.Context, imageID
 
 // DeleteGameCenterLeaderboardImageV2 deletes a v2 leaderboard image.
 func (c *Client) DeleteGameCenterLeaderboardImageV2(ctx context.Context, imageID string) error {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardImages", strings.TrimSpace(imageID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
@@ -926,7 +926,7 @@ func (c *Client) GetGameCenterLeaderboardSetsV2(ctx context.Context, gcDetailID,
 
 // GetGameCenterLeaderboardSetV2 retrieves a v2 leaderboard set by ID.
 func (c *Client) GetGameCenterLeaderboardSetV2(ctx context.Context, setID string) (*GameCenterLeaderboardSetResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -980,7 +980,7 @@ func (c *Client) CreateGameCenterLeaderboardSetV2(ctx context.Context, gcDetailI
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardSets", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,7 +1008,7 @@ func (c *Client) UpdateGameCenterLeaderboardSetV2(ctx context.Context, setID str
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -1024,7 +1024,7 @@ func (c *Client) UpdateGameCenterLeaderboardSetV2(ctx context.Context, setID str
 
 // DeleteGameCenterLeaderboardSetV2 deletes a v2 leaderboard set.
 func (c *Client) DeleteGameCenterLeaderboardSetV2(ctx context.Context, setID string) error {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
@@ -1036,7 +1036,7 @@ func (c *Client) GetGameCenterLeaderboardSetMembersV2(ctx context.Context, setID
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/gameCenterLeaderboards", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID), "gameCenterLeaderboards")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-members-v2: %w", err)
@@ -1078,7 +1078,7 @@ func (c *Client) UpdateGameCenterLeaderboardSetMembersV2(ctx context.Context, se
 		return err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID), "relationships", "gameCenterLeaderboards")
 	_, err = c.do(ctx, http.MethodPatch, path, body)
 	return err
 }
@@ -1100,7 +1100,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersions(ctx context.Context, setID
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/versions", strings.TrimSpace(setID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSets", strings.TrimSpace(setID), "versions")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-versions: %w", err)
@@ -1125,7 +1125,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersions(ctx context.Context, setID
 
 // GetGameCenterLeaderboardSetVersion retrieves a v2 leaderboard set version by ID.
 func (c *Client) GetGameCenterLeaderboardSetVersion(ctx context.Context, versionID string) (*GameCenterLeaderboardSetVersionResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetVersions/%s", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterLeaderboardSetVersions", strings.TrimSpace(versionID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1160,7 +1160,7 @@ func (c *Client) CreateGameCenterLeaderboardSetVersion(ctx context.Context, setI
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardSetVersions", body)
+	data, err := c.do(ctx, http.MethodPost, resourcePath("gameCenterLeaderboardSetVersions"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1180,7 +1180,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersionLocalizations(ctx context.Con
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetVersions/%s/localizations", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterLeaderboardSetVersions", strings.TrimSpace(versionID), "localizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-version-localizations: %w", err)
@@ -1210,7 +1210,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersionLocalizationsRelationships(ct
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetVersions/%s/relationships/localizations", strings.TrimSpace(versionID))
+	path := resourcePath("gameCenterLeaderboardSetVersions", strings.TrimSpace(versionID), "relationships", "localizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-version-localizations-relationships: %w", err)
@@ -1235,7 +1235,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersionLocalizationsRelationships(ct
 
 // GetGameCenterLeaderboardSetLocalizationV2 retrieves a v2 leaderboard set localization by ID.
 func (c *Client) GetGameCenterLeaderboardSetLocalizationV2(ctx context.Context, localizationID string) (*GameCenterLeaderboardSetLocalizationResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetLocalizations", strings.TrimSpace(localizationID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1271,7 +1271,7 @@ func (c *Client) CreateGameCenterLeaderboardSetLocalizationV2(ctx context.Contex
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardSetLocalizations", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetLocalizations"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1299,7 +1299,7 @@ func (c *Client) UpdateGameCenterLeaderboardSetLocalizationV2(ctx context.Contex
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetLocalizations", strings.TrimSpace(localizationID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -1315,14 +1315,14 @@ func (c *Client) UpdateGameCenterLeaderboardSetLocalizationV2(ctx context.Contex
 
 // DeleteGameCenterLeaderboardSetLocalizationV2 deletes a v2 leaderboard set localization.
 func (c *Client) DeleteGameCenterLeaderboardSetLocalizationV2(ctx context.Context, localizationID string) error {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetLocalizations/%s", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetLocalizations", strings.TrimSpace(localizationID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
 
 // GetGameCenterLeaderboardSetLocalizationImageV2 retrieves the image for a v2 leaderboard set localization.
 func (c *Client) GetGameCenterLeaderboardSetLocalizationImageV2(ctx context.Context, localizationID string) (*GameCenterLeaderboardSetImageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetLocalizations/%s/image", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetLocalizations", strings.TrimSpace(localizationID), "image")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1338,7 +1338,7 @@ func (c *Client) GetGameCenterLeaderboardSetLocalizationImageV2(ctx context.Cont
 
 // GetGameCenterLeaderboardSetLocalizationImageRelationshipV2 retrieves the image linkage for a v2 leaderboard set localization.
 func (c *Client) GetGameCenterLeaderboardSetLocalizationImageRelationshipV2(ctx context.Context, localizationID string) (*GameCenterLeaderboardSetLocalizationV2ImageLinkageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetLocalizations/%s/relationships/image", strings.TrimSpace(localizationID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetLocalizations", strings.TrimSpace(localizationID), "relationships", "image")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1354,7 +1354,7 @@ func (c *Client) GetGameCenterLeaderboardSetLocalizationImageRelationshipV2(ctx
 
 // GetGameCenterLeaderboardSetImageV2 retrieves a v2 leaderboard set image by ID.
 func (c *Client) GetGameCenterLeaderboardSetImageV2(ctx context.Context, imageID string) (*GameCenterLeaderboardSetImageResponse, error) {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetImages", strings.TrimSpace(imageID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1393,7 +1393,7 @@ func (c *Client) CreateGameCenterLeaderboardSetImageV2(ctx context.Context, loca
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/gameCenterLeaderboardSetImages", body)
+	data, err := c.do(ctx, http.MethodPost, versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetImages"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1423,7 +1423,7 @@ func (c *Client) UpdateGameCenterLeaderboardSetImageV2(ctx context.Context, imag
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetImages", strings.TrimSpace(imageID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -1439,7 +1439,7 @@ func (c *Client) UpdateGameCenterLeaderboardSetImageV2(ctx context.Context, imag
 
 // DeleteGameCenterLeaderboardSetImageV2 deletes a v2 leaderboard set image.
 func (c *Client) DeleteGameCenterLeaderboardSetImageV2(ctx context.Context, imageID string) error {
-	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetImages/%s", strings.TrimSpace(imageID))
+	path := versionedResourcePath(apiVersionV2, "gameCenterLeaderboardSetImages", strings.TrimSpace(imageID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
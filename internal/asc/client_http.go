@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -24,6 +25,8 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 		return nil, err
 	}
 
+	c.probeServerClock(ctx)
+
 	// Generate JWT token
 	token, err := c.generateJWT()
 	if err != nil {
@@ -42,21 +45,32 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	applyRequestFields(req)
 
 	return req, nil
 }
 
 // generateJWT generates a JWT for ASC API authentication
 func (c *Client) generateJWT() (string, error) {
-	now := time.Now()
+	offset := currentClockOffset()
+	now := time.Now().Add(offset)
 
 	c.jwtMu.Lock()
 	defer c.jwtMu.Unlock()
 
-	if c.cachedJWT != "" && now.Before(c.cachedJWTExpiresAt.Add(-jwtRefreshSkew)) {
+	if c.cachedJWT != "" && c.cachedJWTOffset == offset && now.Before(c.cachedJWTExpiresAt.Add(-jwtRefreshSkew)) {
 		return c.cachedJWT, nil
 	}
 
+	if c.tokenCachePath != "" {
+		if token, expiresAt, ok := loadPersistedToken(c.tokenCachePath, c.keyID, c.issuerID, c.privateKey, now, offset); ok {
+			c.cachedJWT = token
+			c.cachedJWTExpiresAt = expiresAt
+			c.cachedJWTOffset = offset
+			return token, nil
+		}
+	}
+
 	signedToken, err := GenerateJWT(c.keyID, c.issuerID, c.privateKey)
 	if err != nil {
 		return "", err
@@ -64,12 +78,18 @@ func (c *Client) generateJWT() (string, error) {
 
 	c.cachedJWT = signedToken
 	c.cachedJWTExpiresAt = now.Add(tokenLifetime)
+	c.cachedJWTOffset = offset
+	if c.tokenCachePath != "" {
+		// Best effort: a failed write only costs a re-sign on the next run.
+		_ = storePersistedToken(c.tokenCachePath, signedToken, c.cachedJWTExpiresAt, offset)
+	}
 	return signedToken, nil
 }
 
 // GenerateJWT generates a JWT for ASC API authentication.
+// Claims use server-corrected time once a clock offset has been observed.
 func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string, error) {
-	now := time.Now()
+	now := signingTime()
 	claims := jwt.RegisteredClaims{
 		Issuer:    issuerID,
 		Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
@@ -90,7 +110,8 @@ func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string,
 }
 
 // do performs an HTTP request and returns the response.
-// GET/HEAD requests use retry logic for rate limiting by default.
+// GET/HEAD requests retry rate limiting and transient server errors by default.
+// Other requests are recorded in the local audit log once sent.
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	var bodyBytes []byte
 	if body != nil {
@@ -100,13 +121,27 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 			return nil, fmt.Errorf("failed to read request body: %w", err)
 		}
 	}
+	bodyBytes, err := applyRequestPatch(ctx, method, path, bodyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := interceptDryRun(method, path, bodyBytes); err != nil {
+		return nil, err
+	}
 
 	request := func() ([]byte, error) {
 		var reader io.Reader
 		if bodyBytes != nil {
 			reader = bytes.NewReader(bodyBytes)
 		}
-		return c.doOnce(ctx, method, path, reader)
+		data, err := c.doOnce(ctx, method, path, reader)
+		if errors.Is(err, errClockSkewCorrected) {
+			if bodyBytes != nil {
+				reader = bytes.NewReader(bodyBytes)
+			}
+			return c.doOnce(ctx, method, path, reader)
+		}
+		return data, err
 	}
 
 	if shouldRetryMethod(method) {
@@ -114,10 +149,50 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		return WithRetry(ctx, request, retryOpts)
 	}
 
-	return request()
+	data, err := request()
+	recordAudit(c.keyID, method, path, data, err)
+	return data, err
+}
+
+// Do performs an authenticated request against an API path such as
+// "/v1/apps?limit=1" and returns the raw response body. It shares the
+// client's token cache, retries, rate limiting, and dry-run handling.
+func (c *Client) Do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return nil, fmt.Errorf("API path must be relative: %q", path)
+	}
+	return c.do(ctx, method, path, body)
+}
+
+type responseStatusContextKey struct{}
+
+// WithResponseStatus returns a context that records the HTTP status of
+// successful responses to requests sent with it; read it with ResponseStatus.
+func WithResponseStatus(ctx context.Context) context.Context {
+	return context.WithValue(ctx, responseStatusContextKey{}, new(atomic.Int64))
+}
+
+// ResponseStatus returns the HTTP status of the last successful response
+// recorded on ctx, or 0 when none was recorded.
+func ResponseStatus(ctx context.Context) int {
+	status, _ := ctx.Value(responseStatusContextKey{}).(*atomic.Int64)
+	if status == nil {
+		return 0
+	}
+	return int(status.Load())
+}
+
+func recordResponseStatus(ctx context.Context, code int) {
+	if status, _ := ctx.Value(responseStatusContextKey{}).(*atomic.Int64); status != nil {
+		status.Store(int64(code))
+	}
 }
 
 func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	if err := waitForRateLimit(ctx); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
 	start := time.Now()
 	debugSettings := resolveDebugSettings()
 
@@ -137,6 +212,7 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 
 	resp, err := c.httpClient.Do(req)
 	elapsed := time.Since(start)
+	clockSkewChanged := err == nil && observeServerDate(resp.Header, start, start.Add(elapsed))
 
 	if err != nil {
 		if debugSettings.verboseHTTP {
@@ -148,6 +224,7 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	observeRateLimitHeader(resp.Header)
 
 	if debugSettings.verboseHTTP {
 		debugLogger.Info("← HTTP Response",
@@ -161,8 +238,10 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 
-		// Check for rate limiting (429) or service unavailable (503)
-		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		// Check for rate limiting (429), service unavailable (503), or a
+		// transient server error on an idempotent request (500/502/504).
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable ||
+			(isTransientServerError(resp.StatusCode) && shouldRetryMethod(method)) {
 			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
 			return nil, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
@@ -170,12 +249,19 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 			}
 		}
 
-		if err := ParseErrorWithStatus(respBody, resp.StatusCode); err != nil {
-			return nil, err
+		err := ParseErrorWithStatus(respBody, resp.StatusCode)
+		if err == nil {
+			err = fmt.Errorf("API request failed with status %d", resp.StatusCode)
 		}
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		observeDeprecation(method, req.URL.Path, resp.Header, err)
+		if resp.StatusCode == http.StatusUnauthorized && clockSkewChanged {
+			return nil, fmt.Errorf("%w: %w", errClockSkewCorrected, err)
+		}
+		return nil, err
 	}
 
+	observeDeprecation(method, req.URL.Path, resp.Header, nil)
+	recordResponseStatus(ctx, resp.StatusCode)
 	return io.ReadAll(resp.Body)
 }
 
@@ -203,6 +289,17 @@ func shouldRetryMethod(method string) bool {
 	}
 }
 
+// isTransientServerError reports whether a 5xx status is usually transient.
+// 501 and 505 are excluded because retrying them never helps.
+func isTransientServerError(statusCode int) bool {
+	switch statusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
 func buildRetryableError(statusCode int, retryAfter time.Duration, respBody []byte) error {
 	base := "API request failed"
 	switch statusCode {
@@ -210,18 +307,23 @@ func buildRetryableError(statusCode int, retryAfter time.Duration, respBody []by
 		base = "rate limited by App Store Connect"
 	case http.StatusServiceUnavailable:
 		base = "App Store Connect service unavailable"
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		base = "App Store Connect server error"
 	}
 
 	message := fmt.Sprintf("%s (status %d)", base, statusCode)
+	var apiErr error
 	if len(respBody) > 0 {
-		if err := ParseErrorWithStatus(respBody, statusCode); err != nil {
-			message = fmt.Sprintf("%s: %s", message, err)
-		}
+		apiErr = ParseErrorWithStatus(respBody, statusCode)
 	}
+	suffix := ""
 	if retryAfter > 0 {
-		message = fmt.Sprintf("%s (retry after %s)", message, retryAfter)
+		suffix = fmt.Sprintf(" (retry after %s)", retryAfter)
+	}
+	if apiErr != nil {
+		return fmt.Errorf("%s: %w%s", message, apiErr, suffix)
 	}
-	return errors.New(message)
+	return errors.New(message + suffix)
 }
 
 // parseRetryAfterHeader parses the Retry-After header value.
@@ -447,6 +549,7 @@ func ParseError(body []byte) error {
 func ParseErrorWithStatus(body []byte, statusCode int) error {
 	var errResp struct {
 		Errors []struct {
+			ID     string          `json:"id"`
 			Code   string          `json:"code"`
 			Title  string          `json:"title"`
 			Detail string          `json:"detail"`
@@ -461,6 +564,7 @@ func ParseErrorWithStatus(body []byte, statusCode int) error {
 			Title:            errResp.Errors[0].Title,
 			Detail:           errResp.Errors[0].Detail,
 			StatusCode:       statusCode,
+			RequestID:        errResp.Errors[0].ID,
 			AssociatedErrors: associatedErrors,
 		}
 	}
@@ -28,6 +28,27 @@ func TestGetGameCenterDetailID(t *testing.T) {
 	}
 }
 
+func TestGetGameCenterDetailID_MemoizesPerClient(t *testing.T) {
+	calls := 0
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"gameCenterDetails","id":"gc-detail-1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		calls++
+	}, response)
+
+	for range 2 {
+		id, err := client.GetGameCenterDetailID(context.Background(), "app-1")
+		if err != nil {
+			t.Fatalf("GetGameCenterDetailID() error: %v", err)
+		}
+		if id != "gc-detail-1" {
+			t.Fatalf("expected gc-detail-1, got %s", id)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected one request, got %d", calls)
+	}
+}
+
 func TestGetGameCenterAchievements_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"gameCenterAchievements","id":"ach-1","attributes":{"referenceName":"First Win","vendorIdentifier":"com.example.firstwin","points":10,"showBeforeEarned":true,"repeatable":false}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -9862,3 +9862,82 @@ func TestNewRequest_AcceptsSafePath(t *testing.T) {
 		}
 	}
 }
+
+func TestGetApps_RetriesTransientServerError(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "2")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "2ms")
+	resetConfigCacheForTest()
+	t.Cleanup(resetConfigCacheForTest)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+
+	attempts := 0
+	client := &Client{
+		httpClient: &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+				switch attempts {
+				case 1:
+					return jsonResponse(http.StatusInternalServerError, `{"errors":[{"status":"500","code":"UNEXPECTED_ERROR","title":"An unexpected error occurred."}]}`), nil
+				case 2:
+					return jsonResponse(http.StatusBadGateway, ``), nil
+				}
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"}]}`), nil
+			}),
+		},
+		keyID:      "KEY123",
+		issuerID:   "ISS456",
+		privateKey: key,
+	}
+
+	apps, err := client.GetApps(context.Background())
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if attempts != 3 || len(apps.Data) != 1 {
+		t.Fatalf("expected success after 3 attempts, got attempts=%d apps=%d", attempts, len(apps.Data))
+	}
+}
+
+func TestGetApps_ServerErrorKeepsAPIErrorAfterRetries(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "0")
+	resetConfigCacheForTest()
+	t.Cleanup(resetConfigCacheForTest)
+
+	client := newTestClient(t, nil, jsonResponse(http.StatusGatewayTimeout, `{"errors":[{"status":"504","code":"GATEWAY_TIMEOUT","title":"Gateway Timeout","detail":"upstream timed out"}]}`))
+
+	_, err := client.GetApps(context.Background())
+	if !IsRetryable(err) {
+		t.Fatalf("expected retryable error, got %v", err)
+	}
+	if _, ok := errors.AsType[*APIError](err); !ok {
+		t.Fatalf("expected wrapped APIError, got %T: %v", err, err)
+	}
+	if !strings.Contains(err.Error(), "App Store Connect server error (status 504)") {
+		t.Fatalf("unexpected error message %q", err.Error())
+	}
+}
+
+func TestDo_PostDoesNotRetryServerError(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "3")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	resetConfigCacheForTest()
+	t.Cleanup(resetConfigCacheForTest)
+
+	attempts := 0
+	client := newTestClient(t, func(req *http.Request) {
+		attempts++
+	}, jsonResponse(http.StatusInternalServerError, `{"errors":[{"status":"500","title":"Server error"}]}`))
+
+	_, err := client.do(context.Background(), http.MethodPost, "/v1/apps", strings.NewReader(`{}`))
+	if err == nil || IsRetryable(err) {
+		t.Fatalf("expected non-retryable POST error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
@@ -70,7 +70,7 @@ func (c *Client) GetInAppPurchases(ctx context.Context, appID string, opts ...IA
 
 // GetInAppPurchaseV2 retrieves an in-app purchase by ID.
 func (c *Client) GetInAppPurchaseV2(ctx context.Context, iapID string) (*InAppPurchaseV2Response, error) {
-	path := fmt.Sprintf("/v2/inAppPurchases/%s", strings.TrimSpace(iapID))
+	path := resourcePath("inAppPurchases", strings.TrimSpace(iapID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -86,7 +86,7 @@ func (c *Client) GetInAppPurchaseV2(ctx context.Context, iapID string) (*InAppPu
 
 // GetInAppPurchase retrieves a legacy in-app purchase by ID.
 func (c *Client) GetInAppPurchase(ctx context.Context, iapID string) (*InAppPurchaseResponse, error) {
-	path := fmt.Sprintf("/v1/inAppPurchases/%s", strings.TrimSpace(iapID))
+	path := versionedResourcePath(apiVersionV1, "inAppPurchases", strings.TrimSpace(iapID))
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -122,7 +122,7 @@ func (c *Client) CreateInAppPurchaseV2(ctx context.Context, appID string, attrs
 		return nil, err
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/v2/inAppPurchases", body)
+	data, err := c.do(ctx, http.MethodPost, resourcePath("inAppPurchases"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +150,7 @@ func (c *Client) UpdateInAppPurchaseV2(ctx context.Context, iapID string, attrs
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s", strings.TrimSpace(iapID))
+	path := resourcePath("inAppPurchases", strings.TrimSpace(iapID))
 	data, err := c.do(ctx, http.MethodPatch, path, body)
 	if err != nil {
 		return nil, err
@@ -166,7 +166,7 @@ func (c *Client) UpdateInAppPurchaseV2(ctx context.Context, iapID string, attrs
 
 // DeleteInAppPurchaseV2 deletes an in-app purchase.
 func (c *Client) DeleteInAppPurchaseV2(ctx context.Context, iapID string) error {
-	path := fmt.Sprintf("/v2/inAppPurchases/%s", strings.TrimSpace(iapID))
+	path := resourcePath("inAppPurchases", strings.TrimSpace(iapID))
 	_, err := c.do(ctx, http.MethodDelete, path, nil)
 	return err
 }
@@ -178,7 +178,7 @@ func (c *Client) GetInAppPurchaseLocalizations(ctx context.Context, iapID string
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/inAppPurchaseLocalizations", strings.TrimSpace(iapID))
+	path := resourcePath("inAppPurchases", strings.TrimSpace(iapID), "inAppPurchaseLocalizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-localizations: %w", err)
@@ -140,7 +140,7 @@ func (c *Client) GetInAppPurchaseImages(ctx context.Context, iapID string, opts
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/images", iapID)
+	path := resourcePath("inAppPurchases", iapID, "images")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-images: %w", err)
@@ -286,7 +286,7 @@ func (c *Client) GetInAppPurchaseAppStoreReviewScreenshotForIAP(ctx context.Cont
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/appStoreReviewScreenshot", iapID)
+	path := resourcePath("inAppPurchases", iapID, "appStoreReviewScreenshot")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -423,7 +423,7 @@ func (c *Client) GetInAppPurchaseAvailability(ctx context.Context, iapID string)
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/inAppPurchaseAvailability", iapID)
+	path := resourcePath("inAppPurchases", iapID, "inAppPurchaseAvailability")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -556,7 +556,7 @@ func (c *Client) GetInAppPurchaseContent(ctx context.Context, iapID string) (*In
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/content", iapID)
+	path := resourcePath("inAppPurchases", iapID, "content")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -603,7 +603,7 @@ func (c *Client) GetInAppPurchasePricePoints(ctx context.Context, iapID string,
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/pricePoints", iapID)
+	path := resourcePath("inAppPurchases", iapID, "pricePoints")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-price-points: %w", err)
@@ -659,7 +659,7 @@ func (c *Client) GetInAppPurchasePriceSchedule(ctx context.Context, iapID string
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/iapPriceSchedule", iapID)
+	path := resourcePath("inAppPurchases", iapID, "iapPriceSchedule")
 	if queryString := buildIAPPriceScheduleQuery(query); queryString != "" {
 		path += "?" + queryString
 	}
@@ -712,7 +712,7 @@ func (c *Client) GetInAppPurchasePromotedPurchase(ctx context.Context, iapID str
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/promotedPurchase", iapID)
+	path := resourcePath("inAppPurchases", iapID, "promotedPurchase")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -919,7 +919,7 @@ func (c *Client) GetInAppPurchaseOfferCodes(ctx context.Context, iapID string, o
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/offerCodes", iapID)
+	path := resourcePath("inAppPurchases", iapID, "offerCodes")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-codes: %w", err)
@@ -1728,7 +1728,7 @@ func (c *Client) GetInAppPurchaseAppStoreReviewScreenshotRelationship(ctx contex
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/appStoreReviewScreenshot", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "appStoreReviewScreenshot")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1749,7 +1749,7 @@ func (c *Client) GetInAppPurchaseContentRelationship(ctx context.Context, iapID
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/content", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "content")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1770,7 +1770,7 @@ func (c *Client) GetInAppPurchaseIapPriceScheduleRelationship(ctx context.Contex
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/iapPriceSchedule", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "iapPriceSchedule")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1796,7 +1796,7 @@ func (c *Client) GetInAppPurchaseImagesRelationships(ctx context.Context, iapID
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/images", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "images")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseImagesRelationships: %w", err)
@@ -1826,7 +1826,7 @@ func (c *Client) GetInAppPurchaseInAppPurchaseAvailabilityRelationship(ctx conte
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/inAppPurchaseAvailability", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "inAppPurchaseAvailability")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -1852,7 +1852,7 @@ func (c *Client) GetInAppPurchaseInAppPurchaseLocalizationsRelationships(ctx con
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/inAppPurchaseLocalizations", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "inAppPurchaseLocalizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseLocalizationsRelationships: %w", err)
@@ -1887,7 +1887,7 @@ func (c *Client) GetInAppPurchaseOfferCodesRelationships(ctx context.Context, ia
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/offerCodes", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "offerCodes")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseOfferCodesRelationships: %w", err)
@@ -1922,7 +1922,7 @@ func (c *Client) GetInAppPurchasePricePointsRelationships(ctx context.Context, i
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/pricePoints", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "pricePoints")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchasePricePointsRelationships: %w", err)
@@ -1952,7 +1952,7 @@ func (c *Client) GetInAppPurchasePromotedPurchaseRelationship(ctx context.Contex
 		return nil, fmt.Errorf("iapID is required")
 	}
 
-	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/promotedPurchase", iapID)
+	path := resourcePath("inAppPurchases", iapID, "relationships", "promotedPurchase")
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -14,12 +14,18 @@ type ReviewOption func(*reviewQuery)
 // AppsOption is a functional option for GetApps.
 type AppsOption func(*appsQuery)
 
+// AppOption is a functional option for GetApp.
+type AppOption func(*appQuery)
+
 // AppSearchKeywordsOption is a functional option for GetAppSearchKeywords.
 type AppSearchKeywordsOption func(*appSearchKeywordsQuery)
 
 // AppClipsOption is a functional option for GetAppClips.
 type AppClipsOption func(*appClipsQuery)
 
+// AppClipOption is a functional option for GetAppClip.
+type AppClipOption func(*appClipQuery)
+
 // AppClipDefaultExperiencesOption is a functional option for GetAppClipDefaultExperiences.
 type AppClipDefaultExperiencesOption func(*appClipDefaultExperiencesQuery)
 
@@ -44,6 +50,9 @@ type NominationsOption func(*nominationsQuery)
 // BuildsOption is a functional option for GetBuilds.
 type BuildsOption func(*buildsQuery)
 
+// BuildOption is a functional option for GetBuild.
+type BuildOption func(*buildQuery)
+
 // BuildBundlesOption is a functional option for GetBuildBundlesForBuild.
 type BuildBundlesOption func(*buildBundlesQuery)
 
@@ -275,6 +284,9 @@ type LinkagesOption func(*linkagesQuery)
 // PricePointsOption is a functional option for GetAppPricePoints.
 type PricePointsOption func(*pricePointsQuery)
 
+// AppPriceSchedulePricesOption is a functional option for app price schedule manual/automatic prices.
+type AppPriceSchedulePricesOption func(*appPriceSchedulePricesQuery)
+
 // AccessibilityDeclarationsOption is a functional option for accessibility declarations.
 type AccessibilityDeclarationsOption func(*accessibilityDeclarationsQuery)
 
@@ -1082,6 +1094,20 @@ func WithAppsSKUs(skus []string) AppsOption {
 	}
 }
 
+// WithAppsInclude includes related resources for apps.
+func WithAppsInclude(include []string) AppsOption {
+	return func(q *appsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithAppInclude includes related resources for an app.
+func WithAppInclude(include []string) AppOption {
+	return func(q *appQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithAppSearchKeywordsLimit sets the max number of app keywords to return.
 func WithAppSearchKeywordsLimit(limit int) AppSearchKeywordsOption {
 	return func(q *appSearchKeywordsQuery) {
@@ -1139,6 +1165,20 @@ func WithAppClipsBundleIDs(bundleIDs []string) AppClipsOption {
 	}
 }
 
+// WithAppClipsInclude includes related resources for App Clips.
+func WithAppClipsInclude(include []string) AppClipsOption {
+	return func(q *appClipsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
+// WithAppClipInclude includes related resources for an App Clip.
+func WithAppClipInclude(include []string) AppClipOption {
+	return func(q *appClipQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithAppClipDefaultExperiencesLimit sets the max number of default experiences to return.
 func WithAppClipDefaultExperiencesLimit(limit int) AppClipDefaultExperiencesOption {
 	return func(q *appClipDefaultExperiencesQuery) {
@@ -1504,6 +1544,13 @@ func WithBuildsInclude(include []string) BuildsOption {
 	}
 }
 
+// WithBuildInclude includes related resources for a build.
+func WithBuildInclude(include []string) BuildOption {
+	return func(q *buildQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithBuildBundlesLimit sets the max number of included build bundles to return.
 func WithBuildBundlesLimit(limit int) BuildBundlesOption {
 	return func(q *buildBundlesQuery) {
@@ -3208,6 +3255,31 @@ func WithPricePointsTerritory(territory string) PricePointsOption {
 	}
 }
 
+// WithAppPriceSchedulePricesLimit sets the max number of schedule prices to return.
+func WithAppPriceSchedulePricesLimit(limit int) AppPriceSchedulePricesOption {
+	return func(q *appPriceSchedulePricesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithAppPriceSchedulePricesNextURL uses a next page URL directly.
+func WithAppPriceSchedulePricesNextURL(next string) AppPriceSchedulePricesOption {
+	return func(q *appPriceSchedulePricesQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithAppPriceSchedulePricesInclude includes related resources (appPricePoint, territory).
+func WithAppPriceSchedulePricesInclude(include []string) AppPriceSchedulePricesOption {
+	return func(q *appPriceSchedulePricesQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithAppCustomProductPagesLimit sets the max number of custom product pages to return.
 func WithAppCustomProductPagesLimit(limit int) AppCustomProductPagesOption {
 	return func(q *appCustomProductPagesQuery) {
@@ -24,6 +24,10 @@ type PaginateFunc func(ctx context.Context, nextURL string) (PaginatedResponse,
 type PageConsumer func(page PaginatedResponse) error
 
 // PaginateAll fetches all pages and aggregates results.
+// When the first page reports meta.paging.total, remaining pages are fetched
+// ahead by a bounded worker pool while results keep their page order. This is
+// opt-in (--paginate-workers, ASC_PAGINATE_WORKERS, or paginate_workers in
+// config); by default pages are fetched sequentially.
 // It uses reflection to create an empty result container of the same type as
 // firstPage, eliminating the need for a type switch per response type.
 func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc) (PaginatedResponse, error) {
@@ -45,9 +49,17 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 
 	page := 1
 	seenNext := make(map[string]struct{})
+	// Included resources are collected across pages and written to the result
+	// once, so large multi-page exports stay linear instead of re-parsing the
+	// accumulated array on every page.
+	included := &includedAccumulator{}
+	// When meta.paging reports a total, later pages are fetched concurrently
+	// and consumed here in link order.
+	prefetch := startPagePrefetch(ctx, firstPage, fetchNext)
+	defer prefetch.stop()
 	for {
 		// Aggregate data from current page using reflection over the Data field.
-		if err := aggregatePageData(result, firstPage); err != nil {
+		if err := aggregatePageData(result, firstPage, included); err != nil {
 			return nil, fmt.Errorf("page %d: %w", page, err)
 		}
 
@@ -58,25 +70,38 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		}
 
 		if _, ok := seenNext[links.Next]; ok {
+			if err := included.apply(result); err != nil {
+				return nil, err
+			}
 			return result, fmt.Errorf("page %d: %w", page+1, ErrRepeatedPaginationURL)
 		}
 		seenNext[links.Next] = struct{}{}
 		page++
 
 		// Fetch next page
-		nextPage, err := fetchNext(ctx, links.Next)
+		warnIfRateLimitBudgetLow()
+		nextPage, err := prefetch.fetch(ctx, links.Next, fetchNext)
 		if err != nil {
+			if applyErr := included.apply(result); applyErr != nil {
+				return nil, applyErr
+			}
 			return result, fmt.Errorf("page %d: %w", page, err)
 		}
 
 		// Validate that the response type matches
 		if reflect.TypeOf(nextPage) != reflect.TypeOf(firstPage) {
+			if applyErr := included.apply(result); applyErr != nil {
+				return nil, applyErr
+			}
 			return result, fmt.Errorf("page %d: unexpected response type (expected %T, got %T)", page, firstPage, nextPage)
 		}
 
 		firstPage = nextPage
 	}
 
+	if err := included.apply(result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
@@ -113,6 +138,7 @@ func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext Pa
 		}
 		seenNext[links.Next] = struct{}{}
 
+		warnIfRateLimitBudgetLow()
 		nextPage, err := fetchNext(ctx, links.Next)
 		if err != nil {
 			return fmt.Errorf("page %d: %w", page+1, err)
@@ -146,9 +172,10 @@ func newEmptyPaginatedResponse(src PaginatedResponse) (PaginatedResponse, error)
 	return result, nil
 }
 
-// aggregatePageData appends page data to result by reflecting on the shared Data field.
+// aggregatePageData appends page data to result by reflecting on the shared
+// Data field and hands the page's Included array to included.
 // This keeps pagination aggregation generic while still validating type compatibility.
-func aggregatePageData(result, page PaginatedResponse) error {
+func aggregatePageData(result, page PaginatedResponse, included *includedAccumulator) error {
 	if result == nil || page == nil {
 		return fmt.Errorf("page aggregation received nil result or page")
 	}
@@ -187,67 +214,88 @@ func aggregatePageData(result, page PaginatedResponse) error {
 	}
 
 	resultData.Set(reflect.AppendSlice(resultData, pageData))
-	if err := aggregateJSONRawArrayField(resultElem, pageElem, "Included"); err != nil {
-		return err
+	if raw, ok := rawJSONField(pageElem, "Included"); ok && included != nil {
+		if err := included.add(raw); err != nil {
+			return fmt.Errorf("merge Included: %w", err)
+		}
 	}
 	return nil
 }
 
-func aggregateJSONRawArrayField(resultElem, pageElem reflect.Value, fieldName string) error {
-	resultField := resultElem.FieldByName(fieldName)
-	pageField := pageElem.FieldByName(fieldName)
-	if !resultField.IsValid() || !pageField.IsValid() {
-		return nil
+// rawJSONField returns a json.RawMessage field by name, if the struct has one.
+func rawJSONField(elem reflect.Value, fieldName string) (json.RawMessage, bool) {
+	field := elem.FieldByName(fieldName)
+	if !field.IsValid() || field.Type() != reflect.TypeFor[json.RawMessage]() {
+		return nil, false
 	}
+	return field.Interface().(json.RawMessage), true
+}
+
+// includedAccumulator merges the Included arrays of successive pages,
+// dropping resources already seen. A single contributing page is kept
+// byte-for-byte; otherwise the merged array is encoded once by apply.
+type includedAccumulator struct {
+	first json.RawMessage
+	pages int
+	items []json.RawMessage
+	seen  map[string]struct{}
+}
 
-	rawMessageType := reflect.TypeOf(json.RawMessage{})
-	if resultField.Type() != rawMessageType || pageField.Type() != rawMessageType {
+func (a *includedAccumulator) add(src json.RawMessage) error {
+	if len(src) == 0 {
 		return nil
 	}
-
-	merged, err := mergeRawJSONArray(resultField.Interface().(json.RawMessage), pageField.Interface().(json.RawMessage))
-	if err != nil {
-		return fmt.Errorf("merge %s: %w", fieldName, err)
+	if a.pages == 0 {
+		a.first = append(json.RawMessage(nil), src...)
+		a.pages = 1
+		return nil
+	}
+	if a.pages == 1 {
+		a.seen = make(map[string]struct{})
+		if err := a.appendUnique(a.first); err != nil {
+			return fmt.Errorf("parse existing array: %w", err)
+		}
+		a.first = nil
+	}
+	if err := a.appendUnique(src); err != nil {
+		return fmt.Errorf("parse incoming array: %w", err)
 	}
-	resultField.Set(reflect.ValueOf(merged))
+	a.pages++
 	return nil
 }
 
-func mergeRawJSONArray(dst, src json.RawMessage) (json.RawMessage, error) {
-	switch {
-	case len(src) == 0:
-		return dst, nil
-	case len(dst) == 0:
-		return append(json.RawMessage(nil), src...), nil
-	}
-
-	var dstItems []json.RawMessage
-	if err := json.Unmarshal(dst, &dstItems); err != nil {
-		return nil, fmt.Errorf("parse existing array: %w", err)
+func (a *includedAccumulator) appendUnique(raw json.RawMessage) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return err
 	}
-	var srcItems []json.RawMessage
-	if err := json.Unmarshal(src, &srcItems); err != nil {
-		return nil, fmt.Errorf("parse incoming array: %w", err)
+	for _, item := range items {
+		key := string(item)
+		if _, ok := a.seen[key]; ok {
+			continue
+		}
+		a.seen[key] = struct{}{}
+		a.items = append(a.items, item)
 	}
+	return nil
+}
 
-	merged := make([]json.RawMessage, 0, len(dstItems)+len(srcItems))
-	seen := make(map[string]struct{}, len(dstItems)+len(srcItems))
-	appendUnique := func(items []json.RawMessage) {
-		for _, item := range items {
-			key := string(item)
-			if _, ok := seen[key]; ok {
-				continue
-			}
-			seen[key] = struct{}{}
-			merged = append(merged, item)
+// apply writes the merged Included array onto result.
+func (a *includedAccumulator) apply(result PaginatedResponse) error {
+	if a.pages == 0 {
+		return nil
+	}
+	merged := a.first
+	if a.pages > 1 {
+		encoded, err := json.Marshal(a.items)
+		if err != nil {
+			return fmt.Errorf("merge Included: marshal merged array: %w", err)
 		}
+		merged = encoded
 	}
-	appendUnique(dstItems)
-	appendUnique(srcItems)
-
-	result, err := json.Marshal(merged)
-	if err != nil {
-		return nil, fmt.Errorf("marshal merged array: %w", err)
+	field := reflect.ValueOf(result).Elem().FieldByName("Included")
+	if field.IsValid() && field.CanSet() {
+		field.Set(reflect.ValueOf(merged))
 	}
-	return result, nil
+	return nil
 }
@@ -2,6 +2,9 @@ package asc
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -58,3 +61,38 @@ func BenchmarkPaginateEachStreaming(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkPaginateAllIncludedAggregation(b *testing.B) {
+	const totalPages = 100
+	const perPage = 200
+
+	pages := make([]*BuildsResponse, totalPages+1)
+	for page := 1; page <= totalPages; page++ {
+		resp := &BuildsResponse{Data: make([]Resource[BuildAttributes], 0, perPage)}
+		included := make([]string, 0, perPage)
+		for i := range perPage {
+			resp.Data = append(resp.Data, Resource[BuildAttributes]{Type: ResourceTypeBuilds, ID: fmt.Sprintf("build-%d-%d", page, i)})
+			included = append(included, fmt.Sprintf(`{"type":"apps","id":"app-%d-%d"}`, page, i))
+		}
+		resp.Included = json.RawMessage("[" + strings.Join(included, ",") + "]")
+		if page < totalPages {
+			resp.Links.Next = fmt.Sprintf("page=%d", page+1)
+		}
+		pages[page] = resp
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := PaginateAll(context.Background(), pages[1], func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+			page, err := parseMockPageNum(nextURL)
+			if err != nil {
+				return nil, err
+			}
+			return pages[page], nil
+		})
+		if err != nil {
+			b.Fatalf("PaginateAll() error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package asc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	paginateWorkersEnv = "ASC_PAGINATE_WORKERS"
+
+	// defaultPaginateWorkers keeps PaginateAll sequential unless prefetching
+	// is requested with --paginate-workers, ASC_PAGINATE_WORKERS, or the
+	// paginate_workers config key.
+	defaultPaginateWorkers = 0
+)
+
+var paginateWorkersOverride struct {
+	mu  sync.RWMutex
+	val *int
+}
+
+// SetPaginateWorkersOverride sets an explicit prefetch worker count.
+// When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
+func SetPaginateWorkersOverride(value *int) {
+	paginateWorkersOverride.mu.Lock()
+	defer paginateWorkersOverride.mu.Unlock()
+	paginateWorkersOverride.val = value
+}
+
+// offsetCursorPattern matches the offset inside a decoded App Store Connect
+// cursor such as {"offset":"200"}.
+var offsetCursorPattern = regexp.MustCompile(`("offset"\s*:\s*"?)(\d+)`)
+
+// ResolvePaginateWorkers returns the prefetch worker count. Values below 2
+// disable concurrent fetching.
+// Precedence: explicit override > env > config.
+func ResolvePaginateWorkers() int {
+	paginateWorkersOverride.mu.RLock()
+	override := paginateWorkersOverride.val
+	paginateWorkersOverride.mu.RUnlock()
+	if override != nil {
+		return *override
+	}
+	if raw, ok := envValue(paginateWorkersEnv); ok {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed >= 0 {
+			return parsed
+		}
+		return defaultPaginateWorkers
+	}
+	if cfg := loadConfig(); cfg != nil {
+		if parsed, err := strconv.Atoi(strings.TrimSpace(cfg.PaginateWorkers)); err == nil && parsed >= 0 {
+			return parsed
+		}
+	}
+	return defaultPaginateWorkers
+}
+
+// pagePrefetcher fetches the remaining pages of a collection concurrently
+// once meta.paging says how many there are. App Store Connect pages are
+// cursor-linked, so the prefetcher predicts each page's next link from the
+// offset cursor and PaginateAll only uses a prefetched page when the link it
+// is actually following matches the prediction. Ordering and the repeated-URL
+// and type checks therefore stay exactly as in the sequential loop; a wrong
+// prediction only costs the wasted request.
+//
+// Lookahead is capped at the worker count: a predicted page is only started
+// once it is at most that many pages past the last page PaginateAll used.
+type pagePrefetcher struct {
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	workers int
+	pages   map[string]*prefetchedPage
+	order   []*prefetchedPage
+	// started counts the pages in order whose start channel is closed. Only
+	// the goroutine running PaginateAll changes it.
+	started int
+}
+
+type prefetchedPage struct {
+	index int
+	start chan struct{}
+	done  chan struct{}
+	page  PaginatedResponse
+	err   error
+}
+
+// startPagePrefetch starts fetching pages 2..N in the background. It returns
+// nil when prefetching is disabled or the first page does not expose a total
+// count and a predictable offset cursor.
+func startPagePrefetch(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc) *pagePrefetcher {
+	workers := ResolvePaginateWorkers()
+	if workers < 2 {
+		return nil
+	}
+	urls := predictPageURLs(firstPage)
+	if len(urls) < 2 {
+		return nil
+	}
+
+	prefetchCtx, cancel := context.WithCancel(ctx)
+	p := &pagePrefetcher{
+		cancel:  cancel,
+		workers: workers,
+		pages:   make(map[string]*prefetchedPage, len(urls)),
+		order:   make([]*prefetchedPage, 0, len(urls)),
+	}
+	jobs := make(chan *prefetchedPage, len(urls))
+	for i, nextURL := range urls {
+		pending := &prefetchedPage{index: i, start: make(chan struct{}), done: make(chan struct{})}
+		p.pages[nextURL] = pending
+		p.order = append(p.order, pending)
+		jobs <- pending
+	}
+	close(jobs)
+
+	for range min(workers, len(urls)) {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for pending := range jobs {
+				select {
+				case <-pending.start:
+					pending.page, pending.err = fetchNext(prefetchCtx, urls[pending.index])
+				case <-prefetchCtx.Done():
+					pending.err = prefetchCtx.Err()
+				}
+				close(pending.done)
+			}
+		}()
+	}
+	p.release(workers)
+	return p
+}
+
+// release starts the predicted pages before index limit.
+func (p *pagePrefetcher) release(limit int) {
+	for p.started < limit && p.started < len(p.order) {
+		close(p.order[p.started].start)
+		p.started++
+	}
+}
+
+// fetch returns the prefetched page for nextURL when one was predicted and
+// succeeded, and otherwise fetches it directly.
+func (p *pagePrefetcher) fetch(ctx context.Context, nextURL string, fetchNext PaginateFunc) (PaginatedResponse, error) {
+	if p != nil {
+		if pending, ok := p.pages[nextURL]; ok {
+			// Start this page if a skipped prediction held it back, and
+			// slide the window past it once it has arrived.
+			p.release(pending.index + 1)
+			select {
+			case <-pending.done:
+				p.release(pending.index + 1 + p.workers)
+				if pending.err == nil {
+					return pending.page, nil
+				}
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return fetchNext(ctx, nextURL)
+}
+
+// stop cancels outstanding prefetches and waits for the workers to exit.
+func (p *pagePrefetcher) stop() {
+	if p == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+}
+
+// predictPageURLs returns the next links for pages 2..N, or nil when the
+// first page's next link cannot be reproduced from its offset cursor.
+func predictPageURLs(firstPage PaginatedResponse) []string {
+	links := firstPage.GetLinks()
+	if links == nil || links.Next == "" {
+		return nil
+	}
+	value := reflect.ValueOf(firstPage)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	meta, ok := rawJSONField(value.Elem(), "Meta")
+	if !ok {
+		return nil
+	}
+	total := ParsePagingTotal(meta)
+	if total <= 0 {
+		return nil
+	}
+
+	parsed, err := url.Parse(links.Next)
+	if err != nil {
+		return nil
+	}
+	rawCursor := parsed.Query().Get("cursor")
+	encoding, decoded, ok := decodeOffsetCursor(rawCursor)
+	if !ok {
+		return nil
+	}
+	match := offsetCursorPattern.FindStringSubmatchIndex(decoded)
+	if match == nil {
+		return nil
+	}
+	step, err := strconv.Atoi(decoded[match[4]:match[5]])
+	if err != nil || step <= 0 {
+		return nil
+	}
+
+	escapedCursor := "cursor=" + url.QueryEscape(rawCursor)
+	if !strings.Contains(links.Next, escapedCursor) {
+		return nil
+	}
+	buildURL := func(offset int) string {
+		cursor := decoded[:match[4]] + strconv.Itoa(offset) + decoded[match[5]:]
+		return strings.Replace(links.Next, escapedCursor, "cursor="+url.QueryEscape(encoding.EncodeToString([]byte(cursor))), 1)
+	}
+	if buildURL(step) != links.Next {
+		return nil
+	}
+
+	urls := make([]string, 0, (total+step-1)/step)
+	for offset := step; offset < total; offset += step {
+		urls = append(urls, buildURL(offset))
+	}
+	return urls
+}
+
+// decodeOffsetCursor decodes a base64 cursor whose payload is a JSON object,
+// returning the encoding that reproduces it.
+func decodeOffsetCursor(cursor string) (*base64.Encoding, string, bool) {
+	if cursor == "" {
+		return nil, "", false
+	}
+	for _, encoding := range []*base64.Encoding{base64.RawURLEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.StdEncoding} {
+		decoded, err := encoding.DecodeString(cursor)
+		if err != nil || !json.Valid(decoded) || encoding.EncodeToString(decoded) != cursor {
+			continue
+		}
+		return encoding, string(decoded), true
+	}
+	return nil, "", false
+}
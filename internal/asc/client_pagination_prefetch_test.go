@@ -0,0 +1,238 @@
+package asc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func offsetCursorURL(offset int) string {
+	cursor := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"offset":"%d"}`, offset))
+	return "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaTesters?cursor=" + cursor + "&limit=2"
+}
+
+func offsetPagedTesters(offset, total int, next string) *BetaTestersResponse {
+	resp := &BetaTestersResponse{Meta: json.RawMessage(fmt.Sprintf(`{"paging":{"total":%d,"limit":2}}`, total))}
+	for i := offset; i < offset+2 && i < total; i++ {
+		resp.Data = append(resp.Data, Resource[BetaTesterAttributes]{Type: ResourceTypeBetaTesters, ID: fmt.Sprintf("tester-%d", i)})
+	}
+	resp.Links.Next = next
+	return resp
+}
+
+func TestPredictPageURLsFromOffsetCursor(t *testing.T) {
+	first := offsetPagedTesters(0, 7, offsetCursorURL(2))
+
+	urls := predictPageURLs(first)
+	want := []string{offsetCursorURL(2), offsetCursorURL(4), offsetCursorURL(6)}
+	if len(urls) != len(want) {
+		t.Fatalf("expected %d urls, got %v", len(want), urls)
+	}
+	for i := range want {
+		if urls[i] != want[i] {
+			t.Fatalf("url %d = %q, want %q", i, urls[i], want[i])
+		}
+	}
+
+	opaque := offsetPagedTesters(0, 7, "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaTesters?cursor=AQ&limit=2")
+	if urls := predictPageURLs(opaque); urls != nil {
+		t.Fatalf("expected no prediction for opaque cursor, got %v", urls)
+	}
+
+	noTotal := offsetPagedTesters(0, 7, offsetCursorURL(2))
+	noTotal.Meta = nil
+	if urls := predictPageURLs(noTotal); urls != nil {
+		t.Fatalf("expected no prediction without paging total, got %v", urls)
+	}
+}
+
+func TestPaginateAll_FetchesPagesConcurrentlyInOrder(t *testing.T) {
+	t.Setenv("ASC_PAGINATE_WORKERS", "3")
+	const total = 7
+
+	var inFlight, maxInFlight atomic.Int32
+	var mu sync.Mutex
+	fetched := map[string]int{}
+
+	first := offsetPagedTesters(0, total, offsetCursorURL(2))
+	result, err := PaginateAll(context.Background(), first, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		mu.Lock()
+		fetched[nextURL]++
+		mu.Unlock()
+
+		// Hold each request briefly so overlapping workers are observable.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		for offset := 2; offset < total; offset += 2 {
+			if nextURL == offsetCursorURL(offset) {
+				next := ""
+				if offset+2 < total {
+					next = offsetCursorURL(offset + 2)
+				}
+				return offsetPagedTesters(offset, total, next), nil
+			}
+		}
+		return nil, fmt.Errorf("unexpected url %s", nextURL)
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	testers := result.(*BetaTestersResponse)
+	if len(testers.Data) != total {
+		t.Fatalf("expected %d testers, got %d", total, len(testers.Data))
+	}
+	for i, item := range testers.Data {
+		if want := fmt.Sprintf("tester-%d", i); item.ID != want {
+			t.Fatalf("index %d: expected %s, got %s", i, want, item.ID)
+		}
+	}
+	if maxInFlight.Load() < 2 {
+		t.Fatalf("expected concurrent page fetches, max in flight %d", maxInFlight.Load())
+	}
+	for nextURL, count := range fetched {
+		if count != 1 {
+			t.Fatalf("expected %s fetched once, got %d", nextURL, count)
+		}
+	}
+}
+
+func TestPaginateAll_FollowsServerLinksWhenPredictionDiffers(t *testing.T) {
+	t.Setenv("ASC_PAGINATE_WORKERS", "4")
+	const total = 6
+	detour := "https://api.appstoreconnect.apple.com/v1/apps/app-1/betaTesters?cursor=detour&limit=2"
+
+	first := offsetPagedTesters(0, total, offsetCursorURL(2))
+	result, err := PaginateAll(context.Background(), first, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		switch nextURL {
+		case offsetCursorURL(2):
+			return offsetPagedTesters(2, total, detour), nil
+		case detour:
+			return offsetPagedTesters(4, total, ""), nil
+		case offsetCursorURL(4):
+			return nil, fmt.Errorf("speculative page failed")
+		}
+		return nil, fmt.Errorf("unexpected url %s", nextURL)
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	if got := len(result.(*BetaTestersResponse).Data); got != total {
+		t.Fatalf("expected %d testers, got %d", total, got)
+	}
+}
+
+func TestPaginateAll_SequentialWhenWorkersDisabled(t *testing.T) {
+	for _, workers := range []string{"", "1"} {
+		t.Run("workers="+workers, func(t *testing.T) {
+			t.Setenv("ASC_PAGINATE_WORKERS", workers)
+			const total = 6
+
+			var requests []string
+			first := offsetPagedTesters(0, total, offsetCursorURL(2))
+			_, err := PaginateAll(context.Background(), first, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+				requests = append(requests, nextURL)
+				if nextURL == offsetCursorURL(2) {
+					return offsetPagedTesters(2, total, offsetCursorURL(4)), nil
+				}
+				return offsetPagedTesters(4, total, ""), nil
+			})
+			if err != nil {
+				t.Fatalf("PaginateAll() error: %v", err)
+			}
+			if len(requests) != 2 || requests[0] != offsetCursorURL(2) || requests[1] != offsetCursorURL(4) {
+				t.Fatalf("expected sequential requests, got %v", requests)
+			}
+		})
+	}
+}
+
+func TestPaginateAll_CapsLookaheadAtWorkerCount(t *testing.T) {
+	t.Setenv("ASC_PAGINATE_WORKERS", "2")
+	const total = 20
+
+	var started atomic.Int32
+	var startedDuringFirst int32
+	first := offsetPagedTesters(0, total, offsetCursorURL(2))
+	result, err := PaginateAll(context.Background(), first, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		started.Add(1)
+		for offset := 2; offset < total; offset += 2 {
+			if nextURL != offsetCursorURL(offset) {
+				continue
+			}
+			if offset == 2 {
+				// Hold the page PaginateAll needs next; without a cap the
+				// other worker would fetch every remaining page meanwhile.
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(100 * time.Millisecond):
+				}
+				startedDuringFirst = started.Load()
+			}
+			next := ""
+			if offset+2 < total {
+				next = offsetCursorURL(offset + 2)
+			}
+			return offsetPagedTesters(offset, total, next), nil
+		}
+		return nil, fmt.Errorf("unexpected url %s", nextURL)
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	if got := len(result.(*BetaTestersResponse).Data); got != total {
+		t.Fatalf("expected %d testers, got %d", total, got)
+	}
+	if startedDuringFirst != 2 {
+		t.Fatalf("expected 2 pages in flight before the first was used, got %d", startedDuringFirst)
+	}
+}
+
+func TestResolvePaginateWorkers_Precedence(t *testing.T) {
+	SetPaginateWorkersOverride(nil)
+	t.Cleanup(func() { SetPaginateWorkersOverride(nil) })
+	setConfigLoaderForTest(func() (*config.Config, error) {
+		return &config.Config{PaginateWorkers: "2"}, nil
+	})
+	t.Cleanup(resetConfigCacheForTest)
+
+	t.Setenv(paginateWorkersEnv, "")
+	if err := os.Unsetenv(paginateWorkersEnv); err != nil {
+		t.Fatalf("unset env: %v", err)
+	}
+	if got := ResolvePaginateWorkers(); got != 2 {
+		t.Fatalf("expected config value 2, got %d", got)
+	}
+
+	t.Setenv(paginateWorkersEnv, "5")
+	if got := ResolvePaginateWorkers(); got != 5 {
+		t.Fatalf("expected env value 5 to beat config, got %d", got)
+	}
+
+	override := 3
+	SetPaginateWorkersOverride(&override)
+	if got := ResolvePaginateWorkers(); got != 3 {
+		t.Fatalf("expected override 3 to beat env, got %d", got)
+	}
+}
@@ -0,0 +1,58 @@
+package asc
+
+import "strings"
+
+// apiVersion is an App Store Connect API version path segment.
+type apiVersion string
+
+const (
+	apiVersionV1 apiVersion = "v1"
+	apiVersionV2 apiVersion = "v2"
+	apiVersionV3 apiVersion = "v3"
+)
+
+// resourceAPIVersions declares the API version serving each resource
+// collection that is not on v1. Client methods build paths with resourcePath
+// so moving a collection to a newer version is a one-line change here.
+var resourceAPIVersions = map[string]apiVersion{
+	"appAvailabilities":                         apiVersionV2,
+	"appPricePoints":                            apiVersionV3,
+	"appStoreVersionExperiments":                apiVersionV2,
+	"gameCenterAchievementVersions":             apiVersionV2,
+	"gameCenterLeaderboardSetVersions":          apiVersionV2,
+	"gameCenterLeaderboardVersions":             apiVersionV2,
+	"inAppPurchases":                            apiVersionV2,
+	"sandboxTesters":                            apiVersionV2,
+	"sandboxTestersClearPurchaseHistoryRequest": apiVersionV2,
+}
+
+// resourceAPIVersion returns the API version used for a resource collection.
+func resourceAPIVersion(collection string) apiVersion {
+	if version, ok := resourceAPIVersions[collection]; ok {
+		return version
+	}
+	return apiVersionV1
+}
+
+// resourcePath builds "/{version}/{collection}/{segments...}" using the
+// collection's declared API version.
+func resourcePath(collection string, segments ...string) string {
+	return versionedResourcePath(resourceAPIVersion(collection), collection, segments...)
+}
+
+// versionedResourcePath builds a path on an explicit API version. Use it only
+// where a collection is deliberately called on another version than the one
+// declared in resourceAPIVersions, such as legacy v1 reads or the Game Center
+// v2 surface that lives alongside v1.
+func versionedResourcePath(version apiVersion, collection string, segments ...string) string {
+	var b strings.Builder
+	b.WriteString("/")
+	b.WriteString(string(version))
+	b.WriteString("/")
+	b.WriteString(collection)
+	for _, segment := range segments {
+		b.WriteString("/")
+		b.WriteString(segment)
+	}
+	return b.String()
+}
@@ -0,0 +1,151 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestResourcePathUsesDeclaredVersion(t *testing.T) {
+	tests := []struct {
+		collection string
+		segments   []string
+		want       string
+	}{
+		{collection: "apps", segments: []string{"app-1", "builds"}, want: "/v1/apps/app-1/builds"},
+		{collection: "inAppPurchases", segments: []string{"iap-1"}, want: "/v2/inAppPurchases/iap-1"},
+		{collection: "sandboxTesters", want: "/v2/sandboxTesters"},
+		{collection: "appPricePoints", segments: []string{"pp-1", "equalizations"}, want: "/v3/appPricePoints/pp-1/equalizations"},
+	}
+
+	for _, test := range tests {
+		if got := resourcePath(test.collection, test.segments...); got != test.want {
+			t.Fatalf("resourcePath(%q, %v) = %q, want %q", test.collection, test.segments, got, test.want)
+		}
+	}
+
+	if got := versionedResourcePath(apiVersionV1, "inAppPurchases", "iap-1"); got != "/v1/inAppPurchases/iap-1" {
+		t.Fatalf("versionedResourcePath() = %q, want /v1/inAppPurchases/iap-1", got)
+	}
+}
+
+func TestClientMethodsUseDeclaredAPIVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantPath string
+		call     func(*Client) error
+	}{
+		{
+			name:     "in-app purchase v2",
+			wantPath: "/v2/inAppPurchases/iap-1",
+			call: func(c *Client) error {
+				_, err := c.GetInAppPurchaseV2(context.Background(), "iap-1")
+				return err
+			},
+		},
+		{
+			name:     "legacy in-app purchase",
+			wantPath: "/v1/inAppPurchases/iap-1",
+			call: func(c *Client) error {
+				_, err := c.GetInAppPurchase(context.Background(), "iap-1")
+				return err
+			},
+		},
+		{
+			name:     "sandbox testers",
+			wantPath: "/v2/sandboxTesters",
+			call: func(c *Client) error {
+				_, err := c.GetSandboxTesters(context.Background())
+				return err
+			},
+		},
+		{
+			name:     "app price point",
+			wantPath: "/v3/appPricePoints/pp-1",
+			call: func(c *Client) error {
+				_, err := c.GetAppPricePoint(context.Background(), "pp-1")
+				return err
+			},
+		},
+		{
+			name:     "app availability",
+			wantPath: "/v2/appAvailabilities/av-1",
+			call: func(c *Client) error {
+				_, err := c.GetAppAvailabilityV2ByID(context.Background(), "av-1")
+				return err
+			},
+		},
+		{
+			name:     "experiment v2",
+			wantPath: "/v2/appStoreVersionExperiments/exp-1",
+			call: func(c *Client) error {
+				_, err := c.GetAppStoreVersionExperimentV2(context.Background(), "exp-1")
+				return err
+			},
+		},
+		{
+			name:     "legacy experiment",
+			wantPath: "/v1/appStoreVersionExperiments/exp-1",
+			call: func(c *Client) error {
+				_, err := c.GetAppStoreVersionExperiment(context.Background(), "exp-1")
+				return err
+			},
+		},
+		{
+			name:     "game center leaderboard version",
+			wantPath: "/v2/gameCenterLeaderboardVersions/ver-1",
+			call: func(c *Client) error {
+				_, err := c.GetGameCenterLeaderboardVersion(context.Background(), "ver-1")
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(t, func(req *http.Request) {
+				if req.URL.Path != test.wantPath {
+					t.Fatalf("expected path %s, got %s", test.wantPath, req.URL.Path)
+				}
+			}, jsonResponse(http.StatusOK, `{"data":{"type":"x","id":"1"}}`))
+			if err := test.call(client); err != nil && !strings.Contains(err.Error(), "parse") {
+				t.Fatalf("request error: %v", err)
+			}
+		})
+	}
+}
+
+// TestNoHardcodedNonDefaultVersionPaths keeps API versions declared in
+// client_paths.go: v2/v3 path literals, and v1 literals for collections that
+// default to a newer version, must go through resourcePath or
+// versionedResourcePath.
+func TestNoHardcodedNonDefaultVersionPaths(t *testing.T) {
+	literal := regexp.MustCompile(`"/(v[0-9]+)/([A-Za-z]+)`)
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Glob() error: %v", err)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || file == "client_paths.go" {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("ReadFile(%s) error: %v", file, err)
+		}
+		for i, line := range strings.Split(string(data), "\n") {
+			for _, match := range literal.FindAllStringSubmatch(line, -1) {
+				version, collection := apiVersion(match[1]), match[2]
+				if version == apiVersionV1 && resourceAPIVersion(collection) == apiVersionV1 {
+					continue
+				}
+				t.Errorf("%s:%d: hardcoded %s path for %s; use resourcePath", file, i+1, version, collection)
+			}
+		}
+	}
+}
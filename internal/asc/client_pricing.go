@@ -76,7 +76,7 @@ func (c *Client) GetAppPricePoints(ctx context.Context, appID string, opts ...Pr
 // GetAppPricePoint retrieves a single app price point by ID.
 func (c *Client) GetAppPricePoint(ctx context.Context, pricePointID string) (*AppPricePointsV3Response, error) {
 	pricePointID = strings.TrimSpace(pricePointID)
-	path := fmt.Sprintf("/v3/appPricePoints/%s", pricePointID)
+	path := resourcePath("appPricePoints", pricePointID)
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -99,7 +99,7 @@ func (c *Client) GetAppPricePoint(ctx context.Context, pricePointID string) (*Ap
 // GetAppPricePointEqualizations retrieves equalized price points for a price point.
 func (c *Client) GetAppPricePointEqualizations(ctx context.Context, pricePointID string) (*AppPricePointsV3Response, error) {
 	pricePointID = strings.TrimSpace(pricePointID)
-	path := fmt.Sprintf("/v3/appPricePoints/%s/equalizations", pricePointID)
+	path := resourcePath("appPricePoints", pricePointID, "equalizations")
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -126,7 +126,7 @@ func (c *Client) GetAppPricePointEqualizationsRelationships(ctx context.Context,
 		return nil, fmt.Errorf("pricePointID is required")
 	}
 
-	path := fmt.Sprintf("/v3/appPricePoints/%s/relationships/equalizations", pricePointID)
+	path := resourcePath("appPricePoints", pricePointID, "relationships", "equalizations")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPricePointEqualizationsRelationships: %w", err)
@@ -314,9 +314,22 @@ func (c *Client) GetAppPriceScheduleBaseTerritoryRelationship(ctx context.Contex
 }
 
 // GetAppPriceScheduleManualPrices retrieves manual prices for a schedule.
-func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID string) (*AppPricesResponse, error) {
+func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...AppPriceSchedulePricesOption) (*AppPricesResponse, error) {
+	query := &appPriceSchedulePricesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	scheduleID = strings.TrimSpace(scheduleID)
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/manualPrices", scheduleID)
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("appPriceScheduleManualPrices: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildAppPriceSchedulePricesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -367,9 +380,22 @@ func (c *Client) GetAppPriceScheduleManualPricesRelationships(ctx context.Contex
 }
 
 // GetAppPriceScheduleAutomaticPrices retrieves automatic prices for a schedule.
-func (c *Client) GetAppPriceScheduleAutomaticPrices(ctx context.Context, scheduleID string) (*AppPricesResponse, error) {
+func (c *Client) GetAppPriceScheduleAutomaticPrices(ctx context.Context, scheduleID string, opts ...AppPriceSchedulePricesOption) (*AppPricesResponse, error) {
+	query := &appPriceSchedulePricesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	scheduleID = strings.TrimSpace(scheduleID)
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/automaticPrices", scheduleID)
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("appPriceScheduleAutomaticPrices: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildAppPriceSchedulePricesQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -443,7 +469,7 @@ func (c *Client) GetAppAvailabilityV2ByID(ctx context.Context, availabilityID st
 	if availabilityID == "" {
 		return nil, fmt.Errorf("availabilityID is required")
 	}
-	path := fmt.Sprintf("/v2/appAvailabilities/%s", availabilityID)
+	path := resourcePath("appAvailabilities", availabilityID)
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -466,7 +492,7 @@ func (c *Client) GetTerritoryAvailabilities(ctx context.Context, availabilityID
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/appAvailabilities/%s/territoryAvailabilities", availabilityID)
+	path := resourcePath("appAvailabilities", availabilityID, "territoryAvailabilities")
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
 		if err := validateNextURL(query.nextURL); err != nil {
@@ -501,7 +527,7 @@ func (c *Client) GetAppAvailabilityV2TerritoryAvailabilitiesRelationships(ctx co
 		opt(query)
 	}
 
-	path := fmt.Sprintf("/v2/appAvailabilities/%s/relationships/territoryAvailabilities", strings.TrimSpace(availabilityID))
+	path := resourcePath("appAvailabilities", strings.TrimSpace(availabilityID), "relationships", "territoryAvailabilities")
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
 		if err := validateNextURL(query.nextURL); err != nil {
@@ -618,7 +644,7 @@ func (c *Client) CreateAppAvailabilityV2(ctx context.Context, appID string, attr
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "POST", "/v2/appAvailabilities", body)
+	data, err := c.do(ctx, "POST", resourcePath("appAvailabilities"), body)
 	if err != nil {
 		return nil, err
 	}
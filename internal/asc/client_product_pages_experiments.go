@@ -304,7 +304,7 @@ func (c *Client) GetAppStoreVersionExperiment(ctx context.Context, experimentID
 	if experimentID == "" {
 		return nil, fmt.Errorf("experimentID is required")
 	}
-	data, err := c.do(ctx, "GET", fmt.Sprintf("/v1/appStoreVersionExperiments/%s", experimentID), nil)
+	data, err := c.do(ctx, "GET", versionedResourcePath(apiVersionV1, "appStoreVersionExperiments", experimentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -323,7 +323,7 @@ func (c *Client) GetAppStoreVersionExperimentV2(ctx context.Context, experimentI
 	if experimentID == "" {
 		return nil, fmt.Errorf("experimentID is required")
 	}
-	data, err := c.do(ctx, "GET", fmt.Sprintf("/v2/appStoreVersionExperiments/%s", experimentID), nil)
+	data, err := c.do(ctx, "GET", resourcePath("appStoreVersionExperiments", experimentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +367,7 @@ func (c *Client) CreateAppStoreVersionExperiment(ctx context.Context, versionID,
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "POST", "/v1/appStoreVersionExperiments", body)
+	data, err := c.do(ctx, "POST", versionedResourcePath(apiVersionV1, "appStoreVersionExperiments"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +411,7 @@ func (c *Client) CreateAppStoreVersionExperimentV2(ctx context.Context, appID st
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "POST", "/v2/appStoreVersionExperiments", body)
+	data, err := c.do(ctx, "POST", resourcePath("appStoreVersionExperiments"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -446,7 +446,7 @@ func (c *Client) UpdateAppStoreVersionExperiment(ctx context.Context, experiment
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "PATCH", fmt.Sprintf("/v1/appStoreVersionExperiments/%s", experimentID), body)
+	data, err := c.do(ctx, "PATCH", versionedResourcePath(apiVersionV1, "appStoreVersionExperiments", experimentID), body)
 	if err != nil {
 		return nil, err
 	}
@@ -481,7 +481,7 @@ func (c *Client) UpdateAppStoreVersionExperimentV2(ctx context.Context, experime
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "PATCH", fmt.Sprintf("/v2/appStoreVersionExperiments/%s", experimentID), body)
+	data, err := c.do(ctx, "PATCH", resourcePath("appStoreVersionExperiments", experimentID), body)
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +500,7 @@ func (c *Client) DeleteAppStoreVersionExperiment(ctx context.Context, experiment
 	if experimentID == "" {
 		return fmt.Errorf("experimentID is required")
 	}
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/v1/appStoreVersionExperiments/%s", experimentID), nil)
+	_, err := c.do(ctx, "DELETE", versionedResourcePath(apiVersionV1, "appStoreVersionExperiments", experimentID), nil)
 	return err
 }
 
@@ -510,7 +510,7 @@ func (c *Client) DeleteAppStoreVersionExperimentV2(ctx context.Context, experime
 	if experimentID == "" {
 		return fmt.Errorf("experimentID is required")
 	}
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/v2/appStoreVersionExperiments/%s", experimentID), nil)
+	_, err := c.do(ctx, "DELETE", resourcePath("appStoreVersionExperiments", experimentID), nil)
 	return err
 }
 
@@ -525,7 +525,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatments(ctx context.Context, exp
 	if query.nextURL == "" && experimentID == "" {
 		return nil, fmt.Errorf("experimentID is required")
 	}
-	path := fmt.Sprintf("/v1/appStoreVersionExperiments/%s/appStoreVersionExperimentTreatments", experimentID)
+	path := versionedResourcePath(apiVersionV1, "appStoreVersionExperiments", experimentID, "appStoreVersionExperimentTreatments")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatments: %w", err)
@@ -559,7 +559,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentsV2(ctx context.Context, e
 	if query.nextURL == "" && experimentID == "" {
 		return nil, fmt.Errorf("experimentID is required")
 	}
-	path := fmt.Sprintf("/v2/appStoreVersionExperiments/%s/appStoreVersionExperimentTreatments", experimentID)
+	path := resourcePath("appStoreVersionExperiments", experimentID, "appStoreVersionExperimentTreatments")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatments: %w", err)
@@ -594,7 +594,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentsRelationships(ctx context
 		return nil, fmt.Errorf("experimentID is required")
 	}
 
-	path := fmt.Sprintf("/v1/appStoreVersionExperiments/%s/relationships/appStoreVersionExperimentTreatments", experimentID)
+	path := versionedResourcePath(apiVersionV1, "appStoreVersionExperiments", experimentID, "relationships", "appStoreVersionExperimentTreatments")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatmentsRelationships: %w", err)
@@ -629,7 +629,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentsV2Relationships(ctx conte
 		return nil, fmt.Errorf("experimentID is required")
 	}
 
-	path := fmt.Sprintf("/v2/appStoreVersionExperiments/%s/relationships/appStoreVersionExperimentTreatments", experimentID)
+	path := resourcePath("appStoreVersionExperiments", experimentID, "relationships", "appStoreVersionExperimentTreatments")
 	if query.nextURL != "" {
 		if err := validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatmentsV2Relationships: %w", err)
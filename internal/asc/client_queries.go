@@ -50,6 +50,11 @@ type appsQuery struct {
 	bundleIDs []string
 	names     []string
 	skus      []string
+	include   []string
+}
+
+type appQuery struct {
+	include []string
 }
 
 type appSearchKeywordsQuery struct {
@@ -61,6 +66,11 @@ type appSearchKeywordsQuery struct {
 type appClipsQuery struct {
 	listQuery
 	bundleIDs []string
+	include   []string
+}
+
+type appClipQuery struct {
+	include []string
 }
 
 type appClipDefaultExperiencesQuery struct {
@@ -119,6 +129,10 @@ type buildsQuery struct {
 	include              []string
 }
 
+type buildQuery struct {
+	include []string
+}
+
 type buildUploadsQuery struct {
 	listQuery
 	cfBundleShortVersions []string
@@ -555,6 +569,11 @@ type pricePointsQuery struct {
 	territory string
 }
 
+type appPriceSchedulePricesQuery struct {
+	listQuery
+	include []string
+}
+
 type accessibilityDeclarationsQuery struct {
 	listQuery
 	deviceFamilies []string
@@ -624,6 +643,7 @@ func buildAppsQuery(query *appsQuery) string {
 	addCSV(values, "filter[bundleId]", query.bundleIDs)
 	addCSV(values, "filter[name]", query.names)
 	addCSV(values, "filter[sku]", query.skus)
+	addCSV(values, "include", query.include)
 	if query.sort != "" {
 		values.Set("sort", query.sort)
 	}
@@ -631,13 +651,26 @@ func buildAppsQuery(query *appsQuery) string {
 	return values.Encode()
 }
 
+func buildAppQuery(query *appQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	return values.Encode()
+}
+
 func buildAppClipsQuery(query *appClipsQuery) string {
 	values := url.Values{}
 	addCSV(values, "filter[bundleId]", query.bundleIDs)
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
 
+func buildAppClipQuery(query *appClipQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	return values.Encode()
+}
+
 func buildAppClipDefaultExperiencesQuery(query *appClipDefaultExperiencesQuery) string {
 	values := url.Values{}
 	if query.releaseWithVersionExists != nil {
@@ -1117,6 +1150,12 @@ func buildBuildBundleFileSizesQuery(query *buildBundleFileSizesQuery) string {
 	return values.Encode()
 }
 
+func buildBuildQuery(query *buildQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	return values.Encode()
+}
+
 func buildBuildUploadsQuery(query *buildUploadsQuery) string {
 	values := url.Values{}
 	addCSV(values, "filter[cfBundleShortVersionString]", query.cfBundleShortVersions)
@@ -1540,6 +1579,13 @@ func buildLinkagesQuery(query *linkagesQuery) string {
 	return values.Encode()
 }
 
+func buildAppPriceSchedulePricesQuery(query *appPriceSchedulePricesQuery) string {
+	values := url.Values{}
+	addCSV(values, "include", query.include)
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
 func buildPricePointsQuery(query *pricePointsQuery) string {
 	values := url.Values{}
 	if strings.TrimSpace(query.territory) != "" {
@@ -0,0 +1,167 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	clockSkewCheckEnv = "ASC_CLOCK_SKEW_CHECK"
+
+	// clockSkewTolerance absorbs the one-second resolution of the Date header
+	// plus network latency before local time is considered skewed.
+	clockSkewTolerance = 10 * time.Second
+
+	// clockProbeTimeout bounds the pre-flight Date check so an unreachable
+	// API fails on the real request instead of stalling here.
+	clockProbeTimeout = 5 * time.Second
+)
+
+// errClockSkewCorrected marks a 401 response that revealed a new clock offset;
+// the request is retried once with a token signed against server time.
+var errClockSkewCorrected = errors.New("local clock skew detected")
+
+// clockProbeOnce guards the pre-flight Date check so it runs once per process,
+// before the first token is signed.
+var clockProbeOnce sync.Once
+
+var clockSkew struct {
+	sync.Mutex
+	offset time.Duration
+	warned bool
+}
+
+var warningOutput struct {
+	sync.RWMutex
+	w io.Writer
+}
+
+// SetWarningOutput sets where client warnings (such as clock skew) are written.
+// A nil writer restores the default of os.Stderr.
+func SetWarningOutput(w io.Writer) {
+	warningOutput.Lock()
+	defer warningOutput.Unlock()
+	warningOutput.w = w
+}
+
+func warningWriter() io.Writer {
+	warningOutput.RLock()
+	defer warningOutput.RUnlock()
+	if warningOutput.w == nil {
+		return os.Stderr
+	}
+	return warningOutput.w
+}
+
+// signingTime returns the local time corrected by the last observed offset
+// from App Store Connect, so JWT iat/exp claims line up with server time.
+func signingTime() time.Time {
+	return time.Now().Add(currentClockOffset())
+}
+
+func currentClockOffset() time.Duration {
+	clockSkew.Lock()
+	defer clockSkew.Unlock()
+	return clockSkew.offset
+}
+
+func clockSkewCheckEnabled() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(clockSkewCheckEnv))) {
+	case "0", "false", "no", "off":
+		return false
+	default:
+		return true
+	}
+}
+
+// EnableClockProbe makes the client check the App Store Connect clock with
+// one unauthenticated request before it signs its first token. Setting
+// ASC_CLOCK_SKEW_CHECK=0 disables it.
+func (c *Client) EnableClockProbe() {
+	c.probeClock = true
+}
+
+// probeServerClock sends one unauthenticated HEAD request to the API host
+// before the first token is signed and records the offset from its Date
+// header, so a skewed clock is corrected before any authenticated request
+// goes out. Failures are ignored: the 401 retry in do still catches skew
+// that appears later in a long run.
+func (c *Client) probeServerClock(ctx context.Context) {
+	if !c.probeClock || !clockSkewCheckEnabled() {
+		return
+	}
+	clockProbeOnce.Do(func() {
+		probeCtx, cancel := context.WithTimeout(ctx, clockProbeTimeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(probeCtx, http.MethodHead, BaseURL+"/", nil)
+		if err != nil {
+			return
+		}
+		sentAt := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return
+		}
+		receivedAt := time.Now()
+		_ = resp.Body.Close()
+		observeServerDate(resp.Header, sentAt, receivedAt)
+	})
+}
+
+// observeServerDate compares the response Date header against the local clock
+// at the midpoint of the request. It returns true when the recorded offset
+// changed, meaning tokens signed before the response used the wrong time.
+func observeServerDate(header http.Header, sentAt, receivedAt time.Time) bool {
+	if !clockSkewCheckEnabled() {
+		return false
+	}
+	serverTime, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return false
+	}
+	measured := serverTime.Sub(sentAt.Add(receivedAt.Sub(sentAt) / 2))
+
+	clockSkew.Lock()
+	defer clockSkew.Unlock()
+
+	if absDuration(measured-clockSkew.offset) <= clockSkewTolerance {
+		return false
+	}
+	if absDuration(measured) <= clockSkewTolerance {
+		clockSkew.offset = 0
+		return true
+	}
+
+	clockSkew.offset = measured.Round(time.Second)
+	if !clockSkew.warned {
+		clockSkew.warned = true
+		fmt.Fprintln(warningWriter(), formatClockSkewWarning(clockSkew.offset))
+	}
+	return true
+}
+
+func formatClockSkewWarning(offset time.Duration) string {
+	direction := "behind"
+	if offset < 0 {
+		direction = "ahead of"
+	}
+	return fmt.Sprintf(
+		"Warning: local clock is %s %s App Store Connect; signing API tokens with server time. Sync the system clock (for example, enable NTP) to avoid authentication failures.",
+		absDuration(offset), direction,
+	)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,240 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func resetClockSkew(t *testing.T) {
+	t.Helper()
+
+	reset := func() {
+		clockSkew.Lock()
+		clockSkew.offset = 0
+		clockSkew.warned = false
+		clockSkew.Unlock()
+		clockProbeOnce = sync.Once{}
+		SetWarningOutput(nil)
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func dateHeader(tm time.Time) http.Header {
+	return http.Header{"Date": []string{tm.UTC().Format(http.TimeFormat)}}
+}
+
+func TestObserveServerDate_IgnoresSmallOffsets(t *testing.T) {
+	resetClockSkew(t)
+
+	now := time.Now()
+	if observeServerDate(dateHeader(now.Add(3*time.Second)), now, now) {
+		t.Fatal("expected offset within tolerance to be ignored")
+	}
+	if got := currentClockOffset(); got != 0 {
+		t.Fatalf("expected zero offset, got %s", got)
+	}
+}
+
+func TestObserveServerDate_RecordsSkewAndWarnsOnce(t *testing.T) {
+	resetClockSkew(t)
+	var warnings bytes.Buffer
+	SetWarningOutput(&warnings)
+
+	now := time.Now()
+	if !observeServerDate(dateHeader(now.Add(5*time.Minute)), now, now) {
+		t.Fatal("expected skew to be recorded")
+	}
+	if got := currentClockOffset(); got < 4*time.Minute || got > 6*time.Minute {
+		t.Fatalf("expected ~5m offset, got %s", got)
+	}
+	if observeServerDate(dateHeader(now.Add(5*time.Minute)), now, now) {
+		t.Fatal("expected unchanged skew not to be reported again")
+	}
+
+	if strings.Count(warnings.String(), "Warning: local clock is") != 1 {
+		t.Fatalf("expected one warning, got %q", warnings.String())
+	}
+	if !strings.Contains(warnings.String(), "behind App Store Connect") {
+		t.Fatalf("expected behind warning, got %q", warnings.String())
+	}
+}
+
+func TestObserveServerDate_DisabledByEnv(t *testing.T) {
+	resetClockSkew(t)
+	t.Setenv(clockSkewCheckEnv, "0")
+
+	now := time.Now()
+	if observeServerDate(dateHeader(now.Add(time.Hour)), now, now) {
+		t.Fatal("expected skew check to be disabled")
+	}
+}
+
+func TestDo_RetriesUnauthorizedWithServerCorrectedToken(t *testing.T) {
+	resetClockSkew(t)
+	SetWarningOutput(&bytes.Buffer{})
+
+	serverNow := time.Now().Add(-30 * time.Minute)
+	var issuedAt []time.Time
+	calls := 0
+	client := newTestClient(t, nil, nil)
+	client.httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		claims := &jwt.RegisteredClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Fatalf("parse token: %v", err)
+		}
+		issuedAt = append(issuedAt, claims.IssuedAt.Time)
+
+		resp := jsonResponse(http.StatusOK, `{"data":[]}`)
+		if calls == 1 {
+			resp = jsonResponse(http.StatusUnauthorized, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED","title":"Authentication credentials are missing or invalid."}]}`)
+		}
+		resp.Header.Set("Date", serverNow.UTC().Format(http.TimeFormat))
+		return resp, nil
+	})}
+
+	if _, err := client.do(context.Background(), http.MethodPost, "/v1/apps", strings.NewReader(`{}`)); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected one retry after skew correction, got %d calls", calls)
+	}
+	if drift := issuedAt[1].Sub(serverNow); drift < -clockSkewTolerance || drift > clockSkewTolerance {
+		t.Fatalf("expected retried token iat near server time, got drift %s", drift)
+	}
+}
+
+func TestDo_PreflightProbeSignsFirstRequestWithServerTime(t *testing.T) {
+	resetClockSkew(t)
+	var warnings bytes.Buffer
+	SetWarningOutput(&warnings)
+
+	serverNow := time.Now().Add(-30 * time.Minute)
+	var requests []string
+	client := newTestClient(t, nil, nil)
+	client.probeClock = true
+	client.httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		resp := jsonResponse(http.StatusOK, `{"data":[]}`)
+		resp.Header.Set("Date", serverNow.UTC().Format(http.TimeFormat))
+		if req.Method == http.MethodHead {
+			if auth := req.Header.Get("Authorization"); auth != "" {
+				t.Fatalf("expected unauthenticated probe, got Authorization %q", auth)
+			}
+			return resp, nil
+		}
+
+		token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		claims := &jwt.RegisteredClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Fatalf("parse token: %v", err)
+		}
+		if drift := claims.IssuedAt.Time.Sub(serverNow); drift < -clockSkewTolerance || drift > clockSkewTolerance {
+			t.Fatalf("request sent with a token signed on the skewed local clock (drift %s)", drift)
+		}
+		return resp, nil
+	})}
+
+	for range 2 {
+		if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+			t.Fatalf("do() error: %v", err)
+		}
+	}
+
+	want := "HEAD /,GET /v1/apps,GET /v1/apps"
+	if got := strings.Join(requests, ","); got != want {
+		t.Fatalf("expected one probe before the first request, got %s", got)
+	}
+	if !strings.Contains(warnings.String(), "ahead of App Store Connect") {
+		t.Fatalf("expected skew warning, got %q", warnings.String())
+	}
+}
+
+func TestDo_PreflightProbeFailureStillSendsRequest(t *testing.T) {
+	resetClockSkew(t)
+
+	var requests []string
+	client := newTestClient(t, nil, nil)
+	client.probeClock = true
+	client.httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method)
+		if req.Method == http.MethodHead {
+			return nil, errors.New("connection refused")
+		}
+		return jsonResponse(http.StatusOK, `{"data":[]}`), nil
+	})}
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if got := strings.Join(requests, ","); got != "HEAD,GET" {
+		t.Fatalf("expected probe then request, got %s", got)
+	}
+	if got := currentClockOffset(); got != 0 {
+		t.Fatalf("expected no offset after failed probe, got %s", got)
+	}
+}
+
+func TestDo_SkewRetryDoesNotReusePersistedToken(t *testing.T) {
+	resetClockSkew(t)
+	SetWarningOutput(&bytes.Buffer{})
+	t.Setenv(tokenCacheDirEnv, t.TempDir())
+
+	serverNow := time.Now().Add(-30 * time.Minute)
+	var tokens []string
+	var issuedAt []time.Time
+	client := newTestClient(t, nil, nil)
+	client.EnablePersistentTokenCache()
+	client.httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		claims := &jwt.RegisteredClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+			t.Fatalf("parse token: %v", err)
+		}
+		tokens = append(tokens, token)
+		issuedAt = append(issuedAt, claims.IssuedAt.Time)
+
+		resp := jsonResponse(http.StatusOK, `{"data":[]}`)
+		if len(tokens) == 1 {
+			resp = jsonResponse(http.StatusUnauthorized, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED","title":"Authentication credentials are missing or invalid."}]}`)
+		}
+		resp.Header.Set("Date", serverNow.UTC().Format(http.TimeFormat))
+		return resp, nil
+	})}
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if len(tokens) != 2 {
+		t.Fatalf("expected one retry after skew correction, got %d requests", len(tokens))
+	}
+	if tokens[0] == tokens[1] {
+		t.Fatal("expected the retry to sign a new token instead of reusing the persisted one")
+	}
+	if drift := issuedAt[1].Sub(serverNow); drift < -clockSkewTolerance || drift > clockSkewTolerance {
+		t.Fatalf("expected retried token iat near server time, got drift %s", drift)
+	}
+
+	// A later client picks up the corrected token, not the rejected one.
+	next := newTestClient(t, nil, nil)
+	next.privateKey = client.privateKey
+	next.EnablePersistentTokenCache()
+	reused, err := next.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+	if reused != tokens[1] {
+		t.Fatal("expected the persisted cache to hold the server-corrected token")
+	}
+}
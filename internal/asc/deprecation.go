@@ -0,0 +1,78 @@
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+var deprecationState struct {
+	mu      sync.Mutex
+	command string
+	warned  map[string]struct{}
+}
+
+// SetActiveCommand records the command being run (for example "asc apps
+// list") so client warnings can name it. An empty name clears it.
+func SetActiveCommand(name string) {
+	deprecationState.mu.Lock()
+	defer deprecationState.mu.Unlock()
+	deprecationState.command = strings.TrimSpace(name)
+	deprecationState.warned = nil
+}
+
+func activeCommand() string {
+	deprecationState.mu.Lock()
+	defer deprecationState.mu.Unlock()
+	return deprecationState.command
+}
+
+// observeDeprecation warns once per endpoint when App Store Connect flags it
+// as deprecated, either through Deprecation/Sunset response headers or in an
+// error payload.
+func observeDeprecation(method, path string, header http.Header, apiErr error) {
+	deprecation := strings.TrimSpace(header.Get("Deprecation"))
+	sunset := strings.TrimSpace(header.Get("Sunset"))
+	if deprecation == "" && sunset == "" && !isDeprecationError(apiErr) {
+		return
+	}
+
+	endpoint := strings.ToUpper(method) + " " + path
+	deprecationState.mu.Lock()
+	if _, ok := deprecationState.warned[endpoint]; ok {
+		deprecationState.mu.Unlock()
+		return
+	}
+	if deprecationState.warned == nil {
+		deprecationState.warned = map[string]struct{}{}
+	}
+	deprecationState.warned[endpoint] = struct{}{}
+	command := deprecationState.command
+	deprecationState.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("Warning: ")
+	if command != "" {
+		fmt.Fprintf(&b, "%s: ", command)
+	}
+	fmt.Fprintf(&b, "App Store Connect reports %s as deprecated", endpoint)
+	if sunset != "" {
+		fmt.Fprintf(&b, " (sunset %s)", sunset)
+	}
+	b.WriteString("; run \"asc deprecations\" to review affected endpoints\n")
+	fmt.Fprint(warningWriter(), b.String())
+}
+
+func isDeprecationError(err error) bool {
+	apiErr, ok := errors.AsType[*APIError](err)
+	if !ok {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusGone {
+		return true
+	}
+	text := strings.ToLower(apiErr.Code + " " + apiErr.Title + " " + apiErr.Detail)
+	return strings.Contains(text, "deprecated")
+}
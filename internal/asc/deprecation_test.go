@@ -0,0 +1,74 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func captureWarnings(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var out bytes.Buffer
+	SetWarningOutput(&out)
+	SetActiveCommand("asc iap list")
+	t.Cleanup(func() {
+		SetWarningOutput(nil)
+		SetActiveCommand("")
+	})
+	return &out
+}
+
+func TestDeprecationHeadersWarnOncePerEndpoint(t *testing.T) {
+	out := captureWarnings(t)
+	response := func() *http.Response {
+		resp := jsonResponse(http.StatusOK, `{"data":[]}`)
+		resp.Header.Set("Deprecation", "true")
+		resp.Header.Set("Sunset", "Sun, 01 Nov 2026 00:00:00 GMT")
+		return resp
+	}
+	client := newTestClient(t, nil, response())
+	client.httpClient.Transport = roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return response(), nil
+	})
+
+	for range 2 {
+		if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps/app-1/inAppPurchases?limit=5", nil); err != nil {
+			t.Fatalf("do() error: %v", err)
+		}
+	}
+
+	want := "Warning: asc iap list: App Store Connect reports GET /v1/apps/app-1/inAppPurchases as deprecated (sunset Sun, 01 Nov 2026 00:00:00 GMT)"
+	if !strings.HasPrefix(out.String(), want) {
+		t.Fatalf("expected warning %q, got %q", want, out.String())
+	}
+	if strings.Count(out.String(), "Warning:") != 1 {
+		t.Fatalf("expected a single warning, got %q", out.String())
+	}
+}
+
+func TestDeprecationErrorPayloadWarns(t *testing.T) {
+	out := captureWarnings(t)
+	body := `{"errors":[{"code":"NOT_FOUND","title":"The specified resource does not exist","detail":"This endpoint is deprecated. Use gameCenterLeaderboardsV2 instead."}]}`
+	client := newTestClient(t, nil, jsonResponse(http.StatusNotFound, body))
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/gameCenterGroups/group-1/gameCenterLeaderboards", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(out.String(), "GET /v1/gameCenterGroups/group-1/gameCenterLeaderboards as deprecated") {
+		t.Fatalf("expected deprecation warning, got %q", out.String())
+	}
+}
+
+func TestNoDeprecationWarningWithoutSignals(t *testing.T) {
+	out := captureWarnings(t)
+	client := newTestClient(t, nil, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("expected no warning, got %q", out.String())
+	}
+}
@@ -0,0 +1,217 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// describeSpec describes how to fetch a resource and which of its
+// relationships are worth showing in an overview.
+type describeSpec struct {
+	collection    string
+	relationships []string
+}
+
+// describeRegistry maps resource types to their collection and primary
+// relationships. The API version comes from resourceAPIVersions. Relationship names are the ones used in the API's
+// /{type}/{id}/{relationship} related-resource endpoints.
+var describeRegistry = map[ResourceType]describeSpec{
+	ResourceTypeApps:                   {collection: "apps", relationships: []string{"appInfos", "appStoreVersions", "preReleaseVersions", "betaGroups", "subscriptionGroups", "gameCenterDetail"}},
+	ResourceTypeAppInfos:               {collection: "appInfos", relationships: []string{"app", "appInfoLocalizations", "ageRatingDeclaration", "primaryCategory"}},
+	ResourceTypeAppStoreVersions:       {collection: "appStoreVersions", relationships: []string{"app", "build", "appStoreVersionLocalizations", "appStoreReviewDetail", "appStoreVersionPhasedRelease"}},
+	ResourceTypeBuilds:                 {collection: "builds", relationships: []string{"app", "preReleaseVersion", "buildBetaDetail", "appStoreVersion", "betaBuildLocalizations", "individualTesters"}},
+	ResourceTypePreReleaseVersions:     {collection: "preReleaseVersions", relationships: []string{"app", "builds"}},
+	ResourceTypeBetaGroups:             {collection: "betaGroups", relationships: []string{"app", "betaTesters", "builds"}},
+	ResourceTypeBetaTesters:            {collection: "betaTesters", relationships: []string{"apps", "betaGroups", "builds"}},
+	ResourceTypeBundleIds:              {collection: "bundleIds", relationships: []string{"app", "profiles", "bundleIdCapabilities"}},
+	ResourceTypeProfiles:               {collection: "profiles", relationships: []string{"bundleId", "certificates", "devices"}},
+	ResourceTypeCertificates:           {collection: "certificates"},
+	ResourceTypeDevices:                {collection: "devices"},
+	ResourceTypeUsers:                  {collection: "users", relationships: []string{"visibleApps"}},
+	ResourceTypeReviewSubmissions:      {collection: "reviewSubmissions", relationships: []string{"app", "items", "appStoreVersionForReview"}},
+	ResourceTypeAppEvents:              {collection: "appEvents", relationships: []string{"localizations"}},
+	ResourceTypeInAppPurchases:         {collection: "inAppPurchases", relationships: []string{"inAppPurchaseLocalizations", "content", "iapPriceSchedule", "appStoreReviewScreenshot"}},
+	ResourceTypeSubscriptionGroups:     {collection: "subscriptionGroups", relationships: []string{"subscriptions", "subscriptionGroupLocalizations"}},
+	ResourceTypeSubscriptions:          {collection: "subscriptions", relationships: []string{"group", "subscriptionLocalizations", "introductoryOffers", "promotionalOffers", "subscriptionAvailability"}},
+	ResourceTypeGameCenterDetails:      {collection: "gameCenterDetails", relationships: []string{"app", "gameCenterGroup", "gameCenterLeaderboards", "gameCenterAchievements", "gameCenterAppVersions"}},
+	ResourceTypeGameCenterLeaderboards: {collection: "gameCenterLeaderboards", relationships: []string{"gameCenterDetail", "localizations", "releases"}},
+	ResourceTypeGameCenterAchievements: {collection: "gameCenterAchievements", relationships: []string{"gameCenterDetail", "localizations", "releases"}},
+	ResourceTypeWebhooks:               {collection: "webhooks", relationships: []string{"app", "deliveries"}},
+}
+
+// ResourceDescription is a resource with a summary of its related resources.
+type ResourceDescription struct {
+	Type          ResourceType           `json:"type"`
+	ID            string                 `json:"id"`
+	Attributes    map[string]any         `json:"attributes,omitempty"`
+	Relationships []DescribeRelationship `json:"relationships,omitempty"`
+}
+
+// DescribeRelationship summarizes one relationship of a described resource.
+// Count is the number of related resources on the first page; HasMore is set
+// when the API reports further pages.
+type DescribeRelationship struct {
+	Name    string                `json:"name"`
+	Count   int                   `json:"count"`
+	HasMore bool                  `json:"hasMore,omitempty"`
+	Items   []DescribeRelatedItem `json:"items,omitempty"`
+	Error   string                `json:"error,omitempty"`
+}
+
+// DescribeRelatedItem identifies a related resource with a readable label.
+type DescribeRelatedItem struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Label string `json:"label,omitempty"`
+}
+
+// describeLabelAttributes are tried in order to pick a readable label for a
+// related resource.
+var describeLabelAttributes = []string{
+	"name", "referenceName", "versionString", "version", "email", "locale",
+	"title", "bundleId", "identifier", "state", "platform",
+}
+
+// DescribableResourceTypes returns the resource types supported by
+// DescribeResource, sorted by name.
+func DescribableResourceTypes() []ResourceType {
+	types := make([]ResourceType, 0, len(describeRegistry))
+	for resourceType := range describeRegistry {
+		types = append(types, resourceType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// LookupDescribableResourceType resolves a resource type name
+// case-insensitively against the describe registry.
+func LookupDescribableResourceType(name string) (ResourceType, bool) {
+	trimmed := strings.TrimSpace(name)
+	for resourceType := range describeRegistry {
+		if strings.EqualFold(string(resourceType), trimmed) {
+			return resourceType, true
+		}
+	}
+	return "", false
+}
+
+// DescribeRelationshipsFor returns the primary relationships shown for a type.
+func DescribeRelationshipsFor(resourceType ResourceType) []string {
+	return append([]string(nil), describeRegistry[resourceType].relationships...)
+}
+
+// DescribeResource fetches a resource and the first page of each of the given
+// relationships. Relationship fetch failures are recorded on the relationship
+// instead of failing the whole description.
+func (c *Client) DescribeResource(ctx context.Context, resourceType ResourceType, id string, relationships []string) (*ResourceDescription, error) {
+	spec, ok := describeRegistry[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %q is not supported", resourceType)
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	basePath := resourcePath(spec.collection, url.PathEscape(id))
+
+	data, err := c.do(ctx, "GET", basePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		Data struct {
+			Type       ResourceType   `json:"type"`
+			ID         string         `json:"id"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	description := &ResourceDescription{
+		Type:       response.Data.Type,
+		ID:         response.Data.ID,
+		Attributes: response.Data.Attributes,
+	}
+	if description.Type == "" {
+		description.Type = resourceType
+	}
+	if description.ID == "" {
+		description.ID = id
+	}
+
+	for _, name := range relationships {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		description.Relationships = append(description.Relationships, c.describeRelationship(ctx, basePath, name))
+	}
+	return description, nil
+}
+
+func (c *Client) describeRelationship(ctx context.Context, basePath, name string) DescribeRelationship {
+	relationship := DescribeRelationship{Name: name}
+
+	data, err := c.do(ctx, "GET", basePath+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		relationship.Error = err.Error()
+		return relationship
+	}
+	var response struct {
+		Data  json.RawMessage `json:"data"`
+		Links Links           `json:"links"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		relationship.Error = fmt.Sprintf("failed to parse response: %v", err)
+		return relationship
+	}
+
+	type relatedResource struct {
+		Type       string         `json:"type"`
+		ID         string         `json:"id"`
+		Attributes map[string]any `json:"attributes"`
+	}
+	var related []relatedResource
+	trimmed := strings.TrimSpace(string(response.Data))
+	switch {
+	case trimmed == "" || trimmed == "null":
+	case strings.HasPrefix(trimmed, "["):
+		if err := json.Unmarshal(response.Data, &related); err != nil {
+			relationship.Error = fmt.Sprintf("failed to parse related resources: %v", err)
+			return relationship
+		}
+	default:
+		var single relatedResource
+		if err := json.Unmarshal(response.Data, &single); err != nil {
+			relationship.Error = fmt.Sprintf("failed to parse related resource: %v", err)
+			return relationship
+		}
+		related = append(related, single)
+	}
+
+	relationship.Count = len(related)
+	relationship.HasMore = strings.TrimSpace(response.Links.Next) != ""
+	for _, item := range related {
+		relationship.Items = append(relationship.Items, DescribeRelatedItem{
+			Type:  item.Type,
+			ID:    item.ID,
+			Label: describeLabel(item.Attributes),
+		})
+	}
+	return relationship
+}
+
+func describeLabel(attributes map[string]any) string {
+	for _, key := range describeLabelAttributes {
+		if value, ok := attributes[key].(string); ok && strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
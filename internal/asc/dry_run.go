@@ -0,0 +1,134 @@
+package asc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrDryRun is returned in place of sending a mutating request while dry-run
+// mode is enabled. The CLI treats it as success.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+var dryRunOverride struct {
+	mu  sync.RWMutex
+	val *bool
+	out io.Writer
+}
+
+// dryRunRequest is the plan printed for a request dry-run mode withholds.
+type dryRunRequest struct {
+	DryRun bool            `json:"dryRun"`
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// SetDryRunOverride sets an explicit dry-run override. When enabled, requests
+// other than GET/HEAD are printed to stdout instead of being sent and return
+// ErrDryRun. Reads still go to the API so lookups keep working.
+func SetDryRunOverride(value *bool) {
+	dryRunOverride.mu.Lock()
+	defer dryRunOverride.mu.Unlock()
+	dryRunOverride.val = value
+}
+
+// SetDryRunOutput sets where dry-run plans are written (tests only).
+// A nil writer restores the default of os.Stdout.
+func SetDryRunOutput(w io.Writer) {
+	dryRunOverride.mu.Lock()
+	defer dryRunOverride.mu.Unlock()
+	dryRunOverride.out = w
+}
+
+// DryRunEnabled reports whether dry-run mode is enabled.
+func DryRunEnabled() bool {
+	dryRunOverride.mu.RLock()
+	defer dryRunOverride.mu.RUnlock()
+	return dryRunOverride.val != nil && *dryRunOverride.val
+}
+
+func dryRunWriter() io.Writer {
+	dryRunOverride.mu.RLock()
+	defer dryRunOverride.mu.RUnlock()
+	if dryRunOverride.out == nil {
+		return os.Stdout
+	}
+	return dryRunOverride.out
+}
+
+// interceptDryRun prints the request and returns ErrDryRun when dry-run mode
+// withholds it, or nil when the request should be sent.
+func interceptDryRun(method, path string, body []byte) error {
+	if !DryRunEnabled() || shouldRetryMethod(method) {
+		return nil
+	}
+
+	url := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		url = BaseURL + path
+	}
+	plan := dryRunRequest{
+		DryRun: true,
+		Method: strings.ToUpper(method),
+		URL:    sanitizeURLForLog(url),
+		Body:   redactDryRunBody(body),
+	}
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("dry run: %w", err)
+	}
+	fmt.Fprintln(dryRunWriter(), string(data))
+	return ErrDryRun
+}
+
+// redactDryRunBody replaces secret-looking attribute values (passwords,
+// secrets, private keys, tokens) in a JSON body. Non-JSON bodies are omitted.
+func redactDryRunBody(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return json.RawMessage(fmt.Sprintf(`"[%d bytes]"`, len(body)))
+	}
+	redacted, err := json.Marshal(redactDryRunValue(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactDryRunValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			if isSecretBodyKey(key) {
+				if child != nil {
+					typed[key] = "[REDACTED]"
+				}
+				continue
+			}
+			typed[key] = redactDryRunValue(child)
+		}
+	case []any:
+		for i, child := range typed {
+			typed[i] = redactDryRunValue(child)
+		}
+	}
+	return value
+}
+
+func isSecretBodyKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, marker := range []string{"password", "secret", "privatekey"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return lower == "token" || strings.HasSuffix(lower, "_token") || lower == "authorization"
+}
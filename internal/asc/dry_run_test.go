@@ -0,0 +1,104 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func enableDryRun(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	enabled := true
+	var out bytes.Buffer
+	SetDryRunOverride(&enabled)
+	SetDryRunOutput(&out)
+	t.Cleanup(func() {
+		SetDryRunOverride(nil)
+		SetDryRunOutput(nil)
+	})
+	return &out
+}
+
+func TestDryRunPrintsMutatingRequestWithoutSending(t *testing.T) {
+	out := enableDryRun(t)
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+	}, jsonResponse(http.StatusOK, `{}`))
+
+	body := `{"data":{"type":"appStoreReviewDetails","attributes":{"demoAccountName":"demo","demoAccountPassword":"hunter2","notes":null}}}`
+	_, err := client.do(context.Background(), http.MethodPatch, "/v1/appStoreReviewDetails/detail-1", strings.NewReader(body))
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+
+	var plan struct {
+		DryRun bool   `json:"dryRun"`
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &plan); err != nil {
+		t.Fatalf("failed to parse dry-run output %q: %v", out.String(), err)
+	}
+	if !plan.DryRun || plan.Method != http.MethodPatch {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	if plan.URL != BaseURL+"/v1/appStoreReviewDetails/detail-1" {
+		t.Fatalf("unexpected url %q", plan.URL)
+	}
+	attrs := plan.Body.Data.Attributes
+	if attrs["demoAccountPassword"] != "[REDACTED]" {
+		t.Fatalf("expected password redacted, got %v", attrs["demoAccountPassword"])
+	}
+	if attrs["demoAccountName"] != "demo" {
+		t.Fatalf("expected name kept, got %v", attrs["demoAccountName"])
+	}
+	if strings.Contains(out.String(), "hunter2") {
+		t.Fatalf("secret leaked in dry-run output: %s", out.String())
+	}
+}
+
+func TestDryRunStillSendsReads(t *testing.T) {
+	out := enableDryRun(t)
+	called := false
+	client := newTestClient(t, func(req *http.Request) {
+		called = true
+	}, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected GET request to be sent")
+	}
+	if out.Len() != 0 {
+		t.Fatalf("expected no dry-run output, got %q", out.String())
+	}
+}
+
+func TestDryRunDeleteOmitsBody(t *testing.T) {
+	out := enableDryRun(t)
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+	}, jsonResponse(http.StatusNoContent, ``))
+
+	_, err := client.do(context.Background(), http.MethodDelete, "/v1/betaGroups/group-1", nil)
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+	var plan map[string]any
+	if err := json.Unmarshal(out.Bytes(), &plan); err != nil {
+		t.Fatalf("failed to parse dry-run output: %v", err)
+	}
+	if _, ok := plan["body"]; ok {
+		t.Fatalf("expected no body for delete, got %v", plan["body"])
+	}
+}
@@ -0,0 +1,634 @@
+package asc
+
+import (
+	"sort"
+	"strings"
+)
+
+// EnumDefinition describes a string enum defined by the client.
+type EnumDefinition struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// Enums returns every string enum defined by the client, sorted by name.
+func Enums() []EnumDefinition {
+	enums := make([]EnumDefinition, len(enumRegistry))
+	copy(enums, enumRegistry)
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].Name < enums[j].Name
+	})
+	return enums
+}
+
+// LookupEnum finds an enum by name, ignoring case.
+func LookupEnum(name string) (EnumDefinition, bool) {
+	name = strings.TrimSpace(name)
+	for _, enum := range enumRegistry {
+		if strings.EqualFold(enum.Name, name) {
+			return enum, true
+		}
+	}
+	return EnumDefinition{}, false
+}
+
+var enumRegistry = []EnumDefinition{
+	{
+		Name: "deviceFamily",
+		Values: []string{
+			string(DeviceFamilyIPhone),
+			string(DeviceFamilyIPad),
+			string(DeviceFamilyAppleTV),
+			string(DeviceFamilyAppleWatch),
+			string(DeviceFamilyMac),
+			string(DeviceFamilyVision),
+		},
+	},
+	{
+		Name: "deviceConnectionType",
+		Values: []string{
+			string(DeviceConnectionTypeWiFi),
+			string(DeviceConnectionTypeMobileData),
+			string(DeviceConnectionTypeWire),
+			string(DeviceConnectionTypeUnknown),
+			string(DeviceConnectionTypeNone),
+		},
+	},
+	{
+		Name: "accessibilityDeclarationState",
+		Values: []string{
+			string(AccessibilityDeclarationStateDraft),
+			string(AccessibilityDeclarationStatePublished),
+			string(AccessibilityDeclarationStateReplaced),
+		},
+	},
+	{
+		Name: "appStoreAgeRating",
+		Values: []string{
+			string(AppStoreAgeRatingL),
+			string(AppStoreAgeRatingAll),
+			string(AppStoreAgeRatingOnePlus),
+			string(AppStoreAgeRatingTwoPlus),
+			string(AppStoreAgeRatingThreePlus),
+			string(AppStoreAgeRatingFourPlus),
+			string(AppStoreAgeRatingFivePlus),
+			string(AppStoreAgeRatingSixPlus),
+			string(AppStoreAgeRatingSevenPlus),
+			string(AppStoreAgeRatingEightPlus),
+			string(AppStoreAgeRatingNinePlus),
+			string(AppStoreAgeRatingTenPlus),
+			string(AppStoreAgeRatingElevenPlus),
+			string(AppStoreAgeRatingTwelvePlus),
+			string(AppStoreAgeRatingThirteenPlus),
+			string(AppStoreAgeRatingFourteenPlus),
+			string(AppStoreAgeRatingFifteenPlus),
+			string(AppStoreAgeRatingSixteenPlus),
+			string(AppStoreAgeRatingSeventeenPlus),
+			string(AppStoreAgeRatingEighteenPlus),
+			string(AppStoreAgeRatingNineteenPlus),
+			string(AppStoreAgeRatingTwentyPlus),
+			string(AppStoreAgeRatingTwentyOnePlus),
+			string(AppStoreAgeRatingUnrated),
+		},
+	},
+	{
+		Name: "alternativeDistributionPackageVersionState",
+		Values: []string{
+			string(AlternativeDistributionPackageVersionStateCompleted),
+			string(AlternativeDistributionPackageVersionStateReplaced),
+		},
+	},
+	{
+		Name: "salesReportType",
+		Values: []string{
+			string(SalesReportTypeSales),
+			string(SalesReportTypePreOrder),
+			string(SalesReportTypeNewsstand),
+			string(SalesReportTypeSubscription),
+			string(SalesReportTypeSubscriptionEvent),
+		},
+	},
+	{
+		Name: "salesReportSubType",
+		Values: []string{
+			string(SalesReportSubTypeSummary),
+			string(SalesReportSubTypeDetailed),
+		},
+	},
+	{
+		Name: "salesReportFrequency",
+		Values: []string{
+			string(SalesReportFrequencyDaily),
+			string(SalesReportFrequencyWeekly),
+			string(SalesReportFrequencyMonthly),
+			string(SalesReportFrequencyYearly),
+		},
+	},
+	{
+		Name: "salesReportVersion",
+		Values: []string{
+			string(SalesReportVersion1_0),
+			string(SalesReportVersion1_1),
+			string(SalesReportVersion1_3),
+		},
+	},
+	{
+		Name: "analyticsAccessType",
+		Values: []string{
+			string(AnalyticsAccessTypeOngoing),
+			string(AnalyticsAccessTypeOneTimeSnapshot),
+		},
+	},
+	{
+		Name: "analyticsReportRequestState",
+		Values: []string{
+			string(AnalyticsReportRequestStateProcessing),
+			string(AnalyticsReportRequestStateCompleted),
+			string(AnalyticsReportRequestStateFailed),
+		},
+	},
+	{
+		Name: "appEventBadge",
+		Values: []string{
+			string(AppEventBadgeLiveEvent),
+			string(AppEventBadgePremiere),
+			string(AppEventBadgeChallenge),
+			string(AppEventBadgeCompetition),
+			string(AppEventBadgeNewSeason),
+			string(AppEventBadgeMajorUpdate),
+			string(AppEventBadgeSpecialEvent),
+		},
+	},
+	{
+		Name: "appEventPriority",
+		Values: []string{
+			string(AppEventPriorityHigh),
+			string(AppEventPriorityNormal),
+		},
+	},
+	{
+		Name: "appEventPurpose",
+		Values: []string{
+			string(AppEventPurposeAllUsers),
+			string(AppEventPurposeAttractNewUsers),
+			string(AppEventPurposeKeepInformed),
+			string(AppEventPurposeBringBackUsers),
+		},
+	},
+	{
+		Name: "appEventPurchaseRequirement",
+		Values: []string{
+			string(AppEventPurchaseRequirementNoCostAssociated),
+			string(AppEventPurchaseRequirementNoIAPRequired),
+			string(AppEventPurchaseRequirementIAPRequired),
+		},
+	},
+	{
+		Name: "appEventAssetType",
+		Values: []string{
+			string(AppEventAssetTypeEventCard),
+			string(AppEventAssetTypeEventDetailsPage),
+		},
+	},
+	{
+		Name: "backgroundAssetUploadFileAssetType",
+		Values: []string{
+			string(BackgroundAssetUploadFileAssetTypeAsset),
+			string(BackgroundAssetUploadFileAssetTypeManifest),
+		},
+	},
+	{
+		Name: "betaInviteType",
+		Values: []string{
+			string(BetaInviteTypeEmail),
+			string(BetaInviteTypePublicLink),
+		},
+	},
+	{
+		Name: "betaTesterState",
+		Values: []string{
+			string(BetaTesterStateNotInvited),
+			string(BetaTesterStateInvited),
+			string(BetaTesterStateAccepted),
+			string(BetaTesterStateInstalled),
+			string(BetaTesterStateRevoked),
+		},
+	},
+	{
+		Name: "appClipAction",
+		Values: []string{
+			string(AppClipActionOpen),
+			string(AppClipActionView),
+			string(AppClipActionPlay),
+		},
+	},
+	{
+		Name: "appClipAdvancedExperienceBusinessCategory",
+		Values: []string{
+			string(AppClipAdvancedExperienceBusinessCategoryAutomotive),
+			string(AppClipAdvancedExperienceBusinessCategoryBeauty),
+			string(AppClipAdvancedExperienceBusinessCategoryBikes),
+			string(AppClipAdvancedExperienceBusinessCategoryBooks),
+			string(AppClipAdvancedExperienceBusinessCategoryCasino),
+			string(AppClipAdvancedExperienceBusinessCategoryEducation),
+			string(AppClipAdvancedExperienceBusinessCategoryEducationJapan),
+			string(AppClipAdvancedExperienceBusinessCategoryEntertainment),
+			string(AppClipAdvancedExperienceBusinessCategoryEVCharger),
+			string(AppClipAdvancedExperienceBusinessCategoryFinancialUSD),
+			string(AppClipAdvancedExperienceBusinessCategoryFinancialCNY),
+			string(AppClipAdvancedExperienceBusinessCategoryFinancialGBP),
+			string(AppClipAdvancedExperienceBusinessCategoryFinancialJPY),
+			string(AppClipAdvancedExperienceBusinessCategoryFinancialEUR),
+			string(AppClipAdvancedExperienceBusinessCategoryFitness),
+			string(AppClipAdvancedExperienceBusinessCategoryFoodAndDrink),
+			string(AppClipAdvancedExperienceBusinessCategoryGas),
+			string(AppClipAdvancedExperienceBusinessCategoryGrocery),
+			string(AppClipAdvancedExperienceBusinessCategoryHealthAndMedical),
+			string(AppClipAdvancedExperienceBusinessCategoryHotelAndTravel),
+			string(AppClipAdvancedExperienceBusinessCategoryMusic),
+			string(AppClipAdvancedExperienceBusinessCategoryParking),
+			string(AppClipAdvancedExperienceBusinessCategoryPetServices),
+			string(AppClipAdvancedExperienceBusinessCategoryProfessionalServices),
+			string(AppClipAdvancedExperienceBusinessCategoryShopping),
+			string(AppClipAdvancedExperienceBusinessCategoryTicketing),
+			string(AppClipAdvancedExperienceBusinessCategoryTransit),
+		},
+	},
+	{
+		Name: "appClipAdvancedExperienceLanguage",
+		Values: []string{
+			string(AppClipAdvancedExperienceLanguageAR),
+			string(AppClipAdvancedExperienceLanguageCA),
+			string(AppClipAdvancedExperienceLanguageCS),
+			string(AppClipAdvancedExperienceLanguageDA),
+			string(AppClipAdvancedExperienceLanguageDE),
+			string(AppClipAdvancedExperienceLanguageEL),
+			string(AppClipAdvancedExperienceLanguageEN),
+			string(AppClipAdvancedExperienceLanguageES),
+			string(AppClipAdvancedExperienceLanguageFI),
+			string(AppClipAdvancedExperienceLanguageFR),
+			string(AppClipAdvancedExperienceLanguageHE),
+			string(AppClipAdvancedExperienceLanguageHI),
+			string(AppClipAdvancedExperienceLanguageHR),
+			string(AppClipAdvancedExperienceLanguageHU),
+			string(AppClipAdvancedExperienceLanguageID),
+			string(AppClipAdvancedExperienceLanguageIT),
+			string(AppClipAdvancedExperienceLanguageJA),
+			string(AppClipAdvancedExperienceLanguageKO),
+			string(AppClipAdvancedExperienceLanguageMS),
+			string(AppClipAdvancedExperienceLanguageNL),
+			string(AppClipAdvancedExperienceLanguageNO),
+			string(AppClipAdvancedExperienceLanguagePL),
+			string(AppClipAdvancedExperienceLanguagePT),
+			string(AppClipAdvancedExperienceLanguageRO),
+			string(AppClipAdvancedExperienceLanguageRU),
+			string(AppClipAdvancedExperienceLanguageSK),
+			string(AppClipAdvancedExperienceLanguageSV),
+			string(AppClipAdvancedExperienceLanguageTH),
+			string(AppClipAdvancedExperienceLanguageTR),
+			string(AppClipAdvancedExperienceLanguageUK),
+			string(AppClipAdvancedExperienceLanguageVI),
+			string(AppClipAdvancedExperienceLanguageZH),
+		},
+	},
+	{
+		Name: "contentRightsDeclaration",
+		Values: []string{
+			string(ContentRightsDeclarationDoesNotUseThirdPartyContent),
+			string(ContentRightsDeclarationUsesThirdPartyContent),
+		},
+	},
+	{
+		Name: "buildBundleType",
+		Values: []string{
+			string(BuildBundleTypeApp),
+			string(BuildBundleTypeAppClip),
+		},
+	},
+	{
+		Name: "iconAssetType",
+		Values: []string{
+			string(IconAssetTypeAppStore),
+			string(IconAssetTypeMessagesAppStore),
+			string(IconAssetTypeWatchAppStore),
+			string(IconAssetTypeTVOSHomeScreen),
+			string(IconAssetTypeTVOSTopShelf),
+			string(IconAssetTypeAlternateExperiment),
+		},
+	},
+	{
+		Name: "devicePlatform",
+		Values: []string{
+			string(DevicePlatformIOS),
+			string(DevicePlatformMacOS),
+		},
+	},
+	{
+		Name: "deviceStatus",
+		Values: []string{
+			string(DeviceStatusEnabled),
+			string(DeviceStatusDisabled),
+		},
+	},
+	{
+		Name: "deviceClass",
+		Values: []string{
+			string(DeviceClassAppleWatch),
+			string(DeviceClassIPad),
+			string(DeviceClassIPhone),
+			string(DeviceClassIPod),
+			string(DeviceClassAppleTV),
+			string(DeviceClassMac),
+		},
+	},
+	{
+		Name: "appEncryptionDeclarationState",
+		Values: []string{
+			string(AppEncryptionDeclarationStateCreated),
+			string(AppEncryptionDeclarationStateInReview),
+			string(AppEncryptionDeclarationStateApproved),
+			string(AppEncryptionDeclarationStateRejected),
+			string(AppEncryptionDeclarationStateInvalid),
+			string(AppEncryptionDeclarationStateExpired),
+		},
+	},
+	{
+		Name: "financeReportType",
+		Values: []string{
+			string(FinanceReportTypeFinancial),
+			string(FinanceReportTypeFinanceDetail),
+		},
+	},
+	{
+		Name: "gameCenterVersionState",
+		Values: []string{
+			string(GameCenterVersionStatePrepareForSubmission),
+			string(GameCenterVersionStateReadyForReview),
+			string(GameCenterVersionStateWaitingForReview),
+			string(GameCenterVersionStateInReview),
+			string(GameCenterVersionStateDeveloperRejected),
+			string(GameCenterVersionStateRejected),
+			string(GameCenterVersionStateAccepted),
+			string(GameCenterVersionStatePendingRelease),
+			string(GameCenterVersionStateLive),
+			string(GameCenterVersionStateReplacedWithNew),
+		},
+	},
+	{
+		Name: "inAppPurchaseType",
+		Values: []string{
+			string(InAppPurchaseTypeConsumable),
+			string(InAppPurchaseTypeNonConsumable),
+			string(InAppPurchaseTypeNonRenewingSubscription),
+		},
+	},
+	{
+		Name: "nominationType",
+		Values: []string{
+			string(NominationTypeAppLaunch),
+			string(NominationTypeAppEnhancements),
+			string(NominationTypeNewContent),
+		},
+	},
+	{
+		Name: "nominationState",
+		Values: []string{
+			string(NominationStateDraft),
+			string(NominationStateSubmitted),
+			string(NominationStateArchived),
+		},
+	},
+	{
+		Name: "notarySubmissionStatus",
+		Values: []string{
+			string(NotaryStatusAccepted),
+			string(NotaryStatusInProgress),
+			string(NotaryStatusInvalid),
+			string(NotaryStatusRejected),
+		},
+	},
+	{
+		Name: "perfPowerMetricType",
+		Values: []string{
+			string(PerfPowerMetricTypeDisk),
+			string(PerfPowerMetricTypeHang),
+			string(PerfPowerMetricTypeBattery),
+			string(PerfPowerMetricTypeLaunch),
+			string(PerfPowerMetricTypeMemory),
+			string(PerfPowerMetricTypeAnimation),
+			string(PerfPowerMetricTypeTermination),
+		},
+	},
+	{
+		Name: "diagnosticSignatureType",
+		Values: []string{
+			string(DiagnosticSignatureTypeDiskWrites),
+			string(DiagnosticSignatureTypeHangs),
+			string(DiagnosticSignatureTypeLaunches),
+		},
+	},
+	{
+		Name: "diagnosticInsightDirection",
+		Values: []string{
+			string(DiagnosticInsightDirectionUp),
+			string(DiagnosticInsightDirectionDown),
+			string(DiagnosticInsightDirectionUndefined),
+		},
+	},
+	{
+		Name: "diagnosticInsightType",
+		Values: []string{
+			string(DiagnosticInsightTypeTrend),
+		},
+	},
+	{
+		Name: "phasedReleaseState",
+		Values: []string{
+			string(PhasedReleaseStateInactive),
+			string(PhasedReleaseStateActive),
+			string(PhasedReleaseStatePaused),
+			string(PhasedReleaseStateComplete),
+		},
+	},
+	{
+		Name: "reviewSubmissionItemType",
+		Values: []string{
+			string(ReviewSubmissionItemTypeAppStoreVersion),
+			string(ReviewSubmissionItemTypeAppCustomProductPageVersion),
+			string(ReviewSubmissionItemTypeAppCustomProductPage),
+			string(ReviewSubmissionItemTypeAppEvent),
+			string(ReviewSubmissionItemTypeAppStoreVersionExperiment),
+			string(ReviewSubmissionItemTypeAppStoreVersionExperimentTreatment),
+			string(ReviewSubmissionItemTypeBackgroundAssetVersion),
+			string(ReviewSubmissionItemTypeGameCenterAchievementVersion),
+			string(ReviewSubmissionItemTypeGameCenterActivityVersion),
+			string(ReviewSubmissionItemTypeGameCenterChallengeVersion),
+			string(ReviewSubmissionItemTypeGameCenterLeaderboardSetVersion),
+			string(ReviewSubmissionItemTypeGameCenterLeaderboardVersion),
+		},
+	},
+	{
+		Name: "reviewSubmissionState",
+		Values: []string{
+			string(ReviewSubmissionStateReadyForReview),
+			string(ReviewSubmissionStateWaitingForReview),
+			string(ReviewSubmissionStateInReview),
+			string(ReviewSubmissionStateUnresolvedIssues),
+			string(ReviewSubmissionStateCanceling),
+			string(ReviewSubmissionStateCompleting),
+			string(ReviewSubmissionStateComplete),
+		},
+	},
+	{
+		Name: "sandboxTesterSubscriptionRenewalRate",
+		Values: []string{
+			string(SandboxTesterRenewalEveryOneHour),
+			string(SandboxTesterRenewalEveryThirtyMinutes),
+			string(SandboxTesterRenewalEveryFifteenMinutes),
+			string(SandboxTesterRenewalEveryFiveMinutes),
+			string(SandboxTesterRenewalEveryThreeMinutes),
+		},
+	},
+	{
+		Name: "profileState",
+		Values: []string{
+			string(ProfileStateActive),
+		},
+	},
+	{
+		Name: "subscriptionGracePeriodDuration",
+		Values: []string{
+			string(SubscriptionGracePeriodDurationThreeDays),
+			string(SubscriptionGracePeriodDurationSixteenDays),
+			string(SubscriptionGracePeriodDurationTwentyEightDays),
+		},
+	},
+	{
+		Name: "subscriptionGracePeriodRenewalType",
+		Values: []string{
+			string(SubscriptionGracePeriodRenewalTypeAllRenewals),
+			string(SubscriptionGracePeriodRenewalTypePaidToPaidOnly),
+		},
+	},
+	{
+		Name: "subscriptionPeriod",
+		Values: []string{
+			string(SubscriptionPeriodOneWeek),
+			string(SubscriptionPeriodOneMonth),
+			string(SubscriptionPeriodTwoMonths),
+			string(SubscriptionPeriodThreeMonths),
+			string(SubscriptionPeriodSixMonths),
+			string(SubscriptionPeriodOneYear),
+		},
+	},
+	{
+		Name: "webhookEventType",
+		Values: []string{
+			string(WebhookEventAlternativeDistributionPackageAvailableUpdated),
+			string(WebhookEventAlternativeDistributionPackageVersionCreated),
+			string(WebhookEventAlternativeDistributionTerritoryAvailabilityUpdated),
+			string(WebhookEventAppStoreVersionStateUpdated),
+			string(WebhookEventBackgroundAssetVersionAppStoreReleaseStateUpdated),
+			string(WebhookEventBackgroundAssetVersionExternalBetaReleaseStateUpdated),
+			string(WebhookEventBackgroundAssetVersionInternalBetaReleaseCreated),
+			string(WebhookEventBackgroundAssetVersionStateUpdated),
+			string(WebhookEventBetaFeedbackCrashSubmissionCreated),
+			string(WebhookEventBetaFeedbackScreenshotSubmissionCreated),
+			string(WebhookEventBuildBetaDetailExternalBuildStateUpdated),
+			string(WebhookEventBuildUploadStateUpdated),
+		},
+	},
+	{
+		Name: "subscriptionOfferDuration",
+		Values: []string{
+			string(SubscriptionOfferDurationThreeDays),
+			string(SubscriptionOfferDurationOneWeek),
+			string(SubscriptionOfferDurationTwoWeeks),
+			string(SubscriptionOfferDurationOneMonth),
+			string(SubscriptionOfferDurationTwoMonths),
+			string(SubscriptionOfferDurationThreeMonths),
+			string(SubscriptionOfferDurationSixMonths),
+			string(SubscriptionOfferDurationOneYear),
+		},
+	},
+	{
+		Name: "subscriptionOfferMode",
+		Values: []string{
+			string(SubscriptionOfferModePayAsYouGo),
+			string(SubscriptionOfferModePayUpFront),
+			string(SubscriptionOfferModeFreeTrial),
+		},
+	},
+	{
+		Name: "subscriptionOfferEligibility",
+		Values: []string{
+			string(SubscriptionOfferEligibilityStackWithIntroOffers),
+			string(SubscriptionOfferEligibilityReplaceIntroOffers),
+		},
+	},
+	{
+		Name: "subscriptionCustomerEligibility",
+		Values: []string{
+			string(SubscriptionCustomerEligibilityNew),
+			string(SubscriptionCustomerEligibilityExisting),
+			string(SubscriptionCustomerEligibilityExpired),
+		},
+	},
+	{
+		Name: "winBackOfferPriority",
+		Values: []string{
+			string(WinBackOfferPriorityHigh),
+			string(WinBackOfferPriorityNormal),
+		},
+	},
+	{
+		Name: "winBackOfferPromotionIntent",
+		Values: []string{
+			string(WinBackOfferPromotionNotPromoted),
+			string(WinBackOfferPromotionUseAutoGeneratedAssets),
+		},
+	},
+	{
+		Name: "ciBuildRunExecutionProgress",
+		Values: []string{
+			string(CiBuildRunExecutionProgressPending),
+			string(CiBuildRunExecutionProgressRunning),
+			string(CiBuildRunExecutionProgressComplete),
+		},
+	},
+	{
+		Name: "ciBuildRunCompletionStatus",
+		Values: []string{
+			string(CiBuildRunCompletionStatusSucceeded),
+			string(CiBuildRunCompletionStatusFailed),
+			string(CiBuildRunCompletionStatusErrored),
+			string(CiBuildRunCompletionStatusCanceled),
+			string(CiBuildRunCompletionStatusSkipped),
+		},
+	},
+	{
+		Name: "ciTestStatus",
+		Values: []string{
+			string(CiTestStatusSuccess),
+			string(CiTestStatusFailure),
+			string(CiTestStatusMixed),
+			string(CiTestStatusSkipped),
+			string(CiTestStatusExpectedFailure),
+		},
+	},
+	{
+		Name: "ciTestDestinationKind",
+		Values: []string{
+			string(CiTestDestinationKindSimulator),
+			string(CiTestDestinationKindMac),
+		},
+	},
+	{
+		Name: "bulkItemStatus",
+		Values: []string{
+			string(BulkItemSucceeded),
+			string(BulkItemFailed),
+			string(BulkItemSkipped),
+		},
+	},
+}
@@ -0,0 +1,86 @@
+package asc
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestEnumRegistryMatchesDeclaredConstants keeps the enum registry in sync with
+// the string enum types and constants declared in this package.
+func TestEnumRegistryMatchesDeclaredConstants(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("parse package: %v", err)
+	}
+
+	stringTypes := map[string]bool{}
+	declared := map[string][]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					switch typed := spec.(type) {
+					case *ast.TypeSpec:
+						if ident, ok := typed.Type.(*ast.Ident); ok && ident.Name == "string" && typed.Name.IsExported() {
+							stringTypes[typed.Name.Name] = true
+						}
+					case *ast.ValueSpec:
+						ident, ok := typed.Type.(*ast.Ident)
+						if gen.Tok != token.CONST || !ok {
+							continue
+						}
+						for _, value := range typed.Values {
+							lit, ok := value.(*ast.BasicLit)
+							if !ok || lit.Kind != token.STRING {
+								continue
+							}
+							unquoted, err := strconv.Unquote(lit.Value)
+							if err != nil {
+								t.Fatalf("unquote %s: %v", lit.Value, err)
+							}
+							declared[ident.Name] = append(declared[ident.Name], unquoted)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for typeName := range stringTypes {
+		values := declared[typeName]
+		if len(values) == 0 {
+			continue
+		}
+		name := strings.ToLower(typeName[:1]) + typeName[1:]
+		enum, ok := LookupEnum(name)
+		if !ok {
+			t.Errorf("enum registry is missing %s", name)
+			continue
+		}
+		if strings.Join(enum.Values, ",") != strings.Join(values, ",") {
+			t.Errorf("enum %s values = %v, want %v", name, enum.Values, values)
+		}
+	}
+}
+
+func TestLookupEnumIgnoresCase(t *testing.T) {
+	enum, ok := LookupEnum("DEVICECLASS")
+	if !ok || enum.Name != "deviceClass" {
+		t.Fatalf("expected deviceClass, got %+v (ok=%v)", enum, ok)
+	}
+	if _, ok := LookupEnum("notAnEnum"); ok {
+		t.Fatal("expected unknown enum lookup to fail")
+	}
+}
@@ -21,7 +21,8 @@ type APIError struct {
 	Code             string
 	Title            string
 	Detail           string
-	StatusCode       int // HTTP status code that triggered this error (0 if unknown)
+	StatusCode       int    // HTTP status code that triggered this error (0 if unknown)
+	RequestID        string // errors[].id, the identifier Apple support asks for
 	AssociatedErrors map[string][]APIAssociatedError
 }
 
@@ -100,6 +100,7 @@ type GCAchievementsOption func(*gcAchievementsQuery)
 
 type gcAchievementsQuery struct {
 	listQuery
+	include []string
 }
 
 // WithGCAchievementsLimit sets the max number of achievements to return.
@@ -120,8 +121,16 @@ func WithGCAchievementsNextURL(next string) GCAchievementsOption {
 	}
 }
 
+// WithGCAchievementsInclude includes related resources for achievements.
+func WithGCAchievementsInclude(include []string) GCAchievementsOption {
+	return func(q *gcAchievementsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 func buildGCAchievementsQuery(query *gcAchievementsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -331,6 +340,14 @@ type GameCenterAchievementReleaseDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// GameCenterAchievementGroupAchievementUpdateResult represents CLI output for
+// linking an achievement to a group achievement.
+type GameCenterAchievementGroupAchievementUpdateResult struct {
+	AchievementID      string `json:"achievementId"`
+	GroupAchievementID string `json:"groupAchievementId"`
+	Updated            bool   `json:"updated"`
+}
+
 // GCAchievementReleasesOption is a functional option for GetGameCenterAchievementReleases.
 type GCAchievementReleasesOption func(*gcAchievementReleasesQuery)
 
@@ -355,6 +355,14 @@ type GameCenterLeaderboardSetMembersUpdateResult struct {
 	Updated     bool     `json:"updated"`
 }
 
+// GameCenterLeaderboardSetGroupLeaderboardSetUpdateResult represents CLI output
+// for linking a leaderboard set to a group leaderboard set.
+type GameCenterLeaderboardSetGroupLeaderboardSetUpdateResult struct {
+	SetID                 string `json:"setId"`
+	GroupLeaderboardSetID string `json:"groupLeaderboardSetId"`
+	Updated               bool   `json:"updated"`
+}
+
 // GCLeaderboardSetMembersOption is a functional option for GetGameCenterLeaderboardSetMembers.
 type GCLeaderboardSetMembersOption func(*gcLeaderboardSetMembersQuery)
 
@@ -118,6 +118,7 @@ type GCLeaderboardsOption func(*gcLeaderboardsQuery)
 
 type gcLeaderboardsQuery struct {
 	listQuery
+	include []string
 }
 
 // WithGCLeaderboardsLimit sets the max number of leaderboards to return.
@@ -138,8 +139,16 @@ func WithGCLeaderboardsNextURL(next string) GCLeaderboardsOption {
 	}
 }
 
+// WithGCLeaderboardsInclude includes related resources for leaderboards.
+func WithGCLeaderboardsInclude(include []string) GCLeaderboardsOption {
+	return func(q *gcLeaderboardsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 func buildGCLeaderboardsQuery(query *gcLeaderboardsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -355,6 +364,14 @@ type GameCenterLeaderboardReleaseDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// GameCenterLeaderboardGroupLeaderboardUpdateResult represents CLI output for
+// linking a leaderboard to a group leaderboard.
+type GameCenterLeaderboardGroupLeaderboardUpdateResult struct {
+	LeaderboardID      string `json:"leaderboardId"`
+	GroupLeaderboardID string `json:"groupLeaderboardId"`
+	Updated            bool   `json:"updated"`
+}
+
 // GCLeaderboardReleasesOption is a functional option for GetGameCenterLeaderboardReleases.
 type GCLeaderboardReleasesOption func(*gcLeaderboardReleasesQuery)
 
@@ -0,0 +1,161 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// MergePatch applies an RFC 7386 JSON merge patch to target and returns the
+// merged document. Objects are merged recursively, null removes a member, and
+// any other patch value replaces the target value.
+func MergePatch(target, patch []byte) ([]byte, error) {
+	var targetValue any
+	if len(target) > 0 {
+		if err := json.Unmarshal(target, &targetValue); err != nil {
+			return nil, fmt.Errorf("invalid merge patch target: %w", err)
+		}
+	}
+	var patchValue any
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %w", err)
+	}
+	return json.Marshal(mergePatchValue(targetValue, patchValue))
+}
+
+func mergePatchValue(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatchValue(targetObject[key], value)
+	}
+	return targetObject
+}
+
+type requestPatchContextKey struct{}
+
+// requestPatch is a merge patch carried on a request context, together with
+// the resource it targets and how many requests it has been merged into.
+type requestPatch struct {
+	patch map[string]any
+
+	mu      sync.Mutex
+	target  string // "{type}/{id}" of the updated resource, once known
+	applied int
+}
+
+// WithRequestPatch returns a context that merges patch into the "data"
+// object of the PATCH request for the updated resource (/v1/{type}/{id}).
+// The first such PATCH sent with the context fixes the target type and ID;
+// PATCHes for other resources and relationship linkage PATCHes
+// (/v1/{type}/{id}/relationships/...) are sent unchanged.
+func WithRequestPatch(ctx context.Context, patch map[string]any) context.Context {
+	if patch == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, requestPatchContextKey{}, &requestPatch{patch: patch})
+}
+
+// HasRequestPatch reports whether ctx carries a request patch.
+func HasRequestPatch(ctx context.Context) bool {
+	return requestPatchFromContext(ctx) != nil
+}
+
+// RequestPatchApplied returns how many requests the patch on ctx has been
+// merged into.
+func RequestPatchApplied(ctx context.Context) int {
+	current := requestPatchFromContext(ctx)
+	if current == nil {
+		return 0
+	}
+	current.mu.Lock()
+	defer current.mu.Unlock()
+	return current.applied
+}
+
+func requestPatchFromContext(ctx context.Context) *requestPatch {
+	if ctx == nil {
+		return nil
+	}
+	current, _ := ctx.Value(requestPatchContextKey{}).(*requestPatch)
+	return current
+}
+
+// primaryResourceKey returns "{type}/{id}" when path addresses a single
+// resource, such as /v1/subscriptions/ID, rather than one of its
+// relationships.
+func primaryResourceKey(path string) (string, bool) {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != 3 || !strings.HasPrefix(segments[0], "v") || segments[1] == "" || segments[2] == "" {
+		return "", false
+	}
+	return segments[1] + "/" + segments[2], true
+}
+
+// claimTarget reports whether key is the patch's target resource, making it
+// the target when none has been seen yet.
+func (p *requestPatch) claimTarget(key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.target == "" {
+		p.target = key
+	}
+	return p.target == key
+}
+
+func applyRequestPatch(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	if method != http.MethodPatch {
+		return body, nil
+	}
+	key, ok := primaryResourceKey(path)
+	if !ok {
+		return body, nil
+	}
+	current := requestPatchFromContext(ctx)
+	if current == nil || !current.claimTarget(key) {
+		return body, nil
+	}
+
+	var document map[string]any
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &document); err != nil {
+			return nil, fmt.Errorf("failed to apply --patch: request body is not a JSON object: %w", err)
+		}
+	}
+	if document == nil {
+		document = map[string]any{}
+	}
+	// Round-trip the patch so the stored value is never mutated by merging.
+	patchBytes, err := json.Marshal(current.patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply --patch: %w", err)
+	}
+	var patchValue any
+	if err := json.Unmarshal(patchBytes, &patchValue); err != nil {
+		return nil, fmt.Errorf("failed to apply --patch: %w", err)
+	}
+	document["data"] = mergePatchValue(document["data"], patchValue)
+
+	patched, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply --patch: %w", err)
+	}
+	current.mu.Lock()
+	current.applied++
+	current.mu.Unlock()
+	return patched, nil
+}
@@ -0,0 +1,155 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMergePatchRFC7386Examples(t *testing.T) {
+	tests := []struct {
+		target string
+		patch  string
+		want   string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, test := range tests {
+		got, err := MergePatch([]byte(test.target), []byte(test.patch))
+		if err != nil {
+			t.Fatalf("MergePatch(%s, %s) error: %v", test.target, test.patch, err)
+		}
+		assertJSONEqual(t, string(got), test.want)
+	}
+}
+
+func TestMergePatchRejectsInvalidJSON(t *testing.T) {
+	if _, err := MergePatch([]byte(`{}`), []byte(`{`)); err == nil {
+		t.Fatal("expected error for invalid patch")
+	}
+}
+
+func TestApplyRequestPatchMergesIntoDataForPatchRequests(t *testing.T) {
+	ctx := WithRequestPatch(context.Background(), map[string]any{
+		"attributes": map[string]any{"reviewNote": "Notes", "name": nil},
+	})
+
+	body := []byte(`{"data":{"type":"subscriptions","id":"sub-1","attributes":{"name":"Old","familySharable":true}}}`)
+
+	unchanged, err := applyRequestPatch(ctx, http.MethodPost, "/v1/subscriptions", body)
+	if err != nil {
+		t.Fatalf("applyRequestPatch(POST) error: %v", err)
+	}
+	if string(unchanged) != string(body) {
+		t.Fatalf("expected POST body to be unchanged, got %s", unchanged)
+	}
+
+	patched, err := applyRequestPatch(ctx, http.MethodPatch, "/v1/subscriptions/sub-1", body)
+	if err != nil {
+		t.Fatalf("applyRequestPatch(PATCH) error: %v", err)
+	}
+	assertJSONEqual(t, string(patched), `{"data":{"type":"subscriptions","id":"sub-1","attributes":{"familySharable":true,"reviewNote":"Notes"}}}`)
+	if got := RequestPatchApplied(ctx); got != 1 {
+		t.Fatalf("RequestPatchApplied() = %d, want 1", got)
+	}
+
+	// The stored patch must not be mutated by a previous merge.
+	again, err := applyRequestPatch(ctx, http.MethodPatch, "/v1/subscriptions/sub-1", body)
+	if err != nil {
+		t.Fatalf("applyRequestPatch(PATCH) second call error: %v", err)
+	}
+	if string(again) != string(patched) {
+		t.Fatalf("expected identical result on second apply, got %s", again)
+	}
+}
+
+func TestApplyRequestPatchSkipsRelationshipLinkage(t *testing.T) {
+	ctx := WithRequestPatch(context.Background(), map[string]any{
+		"attributes": map[string]any{"copyright": "2026"},
+	})
+
+	for _, body := range []string{
+		`{"data":{"type":"builds","id":"build-1"}}`,
+		`{"data":[{"type":"betaGroups","id":"group-1"}]}`,
+	} {
+		got, err := applyRequestPatch(ctx, http.MethodPatch, "/v1/appStoreVersions/ver-1/relationships/build", []byte(body))
+		if err != nil {
+			t.Fatalf("applyRequestPatch() error: %v", err)
+		}
+		if string(got) != body {
+			t.Fatalf("expected linkage body to be unchanged, got %s", got)
+		}
+	}
+	if got := RequestPatchApplied(ctx); got != 0 {
+		t.Fatalf("RequestPatchApplied() = %d, want 0", got)
+	}
+}
+
+func TestApplyRequestPatchOnlyTargetsFirstPatchedResource(t *testing.T) {
+	ctx := WithRequestPatch(context.Background(), map[string]any{
+		"attributes": map[string]any{"copyright": "2026"},
+	})
+
+	target := `{"data":{"type":"appStoreVersions","id":"ver-1","attributes":{}}}`
+	patched, err := applyRequestPatch(ctx, http.MethodPatch, "/v1/appStoreVersions/ver-1", []byte(target))
+	if err != nil {
+		t.Fatalf("applyRequestPatch() error: %v", err)
+	}
+	assertJSONEqual(t, string(patched), `{"data":{"type":"appStoreVersions","id":"ver-1","attributes":{"copyright":"2026"}}}`)
+
+	for _, path := range []string{"/v1/appStoreVersions/ver-2", "/v1/appStoreVersionLocalizations/ver-1"} {
+		other := `{"data":{"type":"other","id":"x","attributes":{}}}`
+		got, err := applyRequestPatch(ctx, http.MethodPatch, path, []byte(other))
+		if err != nil {
+			t.Fatalf("applyRequestPatch(%s) error: %v", path, err)
+		}
+		if string(got) != other {
+			t.Fatalf("expected %s body to be unchanged, got %s", path, got)
+		}
+	}
+	if got := RequestPatchApplied(ctx); got != 1 {
+		t.Fatalf("RequestPatchApplied() = %d, want 1", got)
+	}
+}
+
+func TestApplyRequestPatchNoopWithoutPatch(t *testing.T) {
+	body := []byte(`{"data":{"type":"devices","id":"d"}}`)
+	got, err := applyRequestPatch(context.Background(), http.MethodPatch, "/v1/devices/d", body)
+	if err != nil {
+		t.Fatalf("applyRequestPatch() error: %v", err)
+	}
+	if string(got) != string(body) {
+		t.Fatalf("expected body to be unchanged, got %s", got)
+	}
+}
+
+func assertJSONEqual(t *testing.T, got, want string) {
+	t.Helper()
+	var gotValue, wantValue any
+	if err := json.Unmarshal([]byte(got), &gotValue); err != nil {
+		t.Fatalf("invalid JSON %q: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("invalid JSON %q: %v", want, err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
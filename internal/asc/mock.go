@@ -0,0 +1,326 @@
+package asc
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// MockDirEnvVar points the client at a directory of recorded responses.
+	MockDirEnvVar = "ASC_MOCK_DIR"
+	// MockModeEnvVar selects MockModeReplay (the default) or MockModeRecord.
+	MockModeEnvVar = "ASC_MOCK_MODE"
+
+	MockModeRecord = "record"
+	MockModeReplay = "replay"
+
+	maxMockSlugLength = 80
+)
+
+var mockSlugPattern = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// mockServed counts requests per recording file for the whole process, so
+// every client replays (or records) one shared sequence. recorded marks the
+// files written by this process, so the first response recorded in a run
+// replaces any older recording of the URL.
+var mockServed struct {
+	sync.Mutex
+	counts   map[string]int
+	recorded map[string]bool
+}
+
+// mockRecording is one recorded file: every response for a method and URL,
+// in the order the requests were made.
+type mockRecording struct {
+	Method    string         `json:"method"`
+	URL       string         `json:"url"`
+	Responses []mockResponse `json:"responses"`
+}
+
+type mockResponse struct {
+	Status      int             `json:"status"`
+	ContentType string          `json:"contentType,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	BodyText    string          `json:"bodyText,omitempty"`
+	// RequestBody is the redacted request body, for reference only.
+	RequestBody json.RawMessage `json:"requestBody,omitempty"`
+}
+
+// MockMode returns the active mock mode, or "" when ASC_MOCK_DIR is unset.
+func MockMode() (string, error) {
+	if strings.TrimSpace(os.Getenv(MockDirEnvVar)) == "" {
+		return "", nil
+	}
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv(MockModeEnvVar)))
+	switch mode {
+	case "":
+		return MockModeReplay, nil
+	case MockModeRecord, MockModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%s must be %s or %s (got %q)", MockModeEnvVar, MockModeRecord, MockModeReplay, mode)
+	}
+}
+
+// MockReplayEnabled reports whether requests are served from ASC_MOCK_DIR
+// without touching the network.
+func MockReplayEnabled() bool {
+	mode, err := MockMode()
+	return err == nil && mode == MockModeReplay
+}
+
+// NewMockReplayClient returns a client for replay mode that needs no
+// credentials; it signs with a throwaway key the recordings never see.
+func NewMockReplayClient() (*Client, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate mock signing key: %w", err)
+	}
+	return newClientWithPrivateKey("MOCK", "mock-issuer", key, newDefaultHTTPClient(ResolveTimeout())), nil
+}
+
+// withMockTransport wraps next in record or replay mode when ASC_MOCK_DIR is
+// set. An invalid ASC_MOCK_MODE fails every request rather than silently
+// reaching the network.
+func withMockTransport(next http.RoundTripper) http.RoundTripper {
+	dir := strings.TrimSpace(os.Getenv(MockDirEnvVar))
+	if dir == "" {
+		return next
+	}
+	mode, err := MockMode()
+	return &mockTransport{
+		dir:     dir,
+		mode:    mode,
+		modeErr: err,
+		next:    next,
+	}
+}
+
+type mockTransport struct {
+	dir     string
+	mode    string
+	modeErr error
+	next    http.RoundTripper
+}
+
+func (t *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.modeErr != nil {
+		return nil, t.modeErr
+	}
+
+	var requestBody []byte
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		requestBody = data
+		req.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	url := mockRequestURL(req)
+	path := filepath.Join(t.dir, mockFileName(req.Method, url))
+	if !isMockAPIHost(req.URL.Host) {
+		requestBody = nil
+	}
+
+	mockServed.Lock()
+	if mockServed.counts == nil {
+		mockServed.counts = map[string]int{}
+	}
+	index := mockServed.counts[path]
+	mockServed.counts[path] = index + 1
+	mockServed.Unlock()
+
+	if t.mode == MockModeReplay {
+		return t.replay(req, path, url, index)
+	}
+	return t.record(req, path, url, requestBody)
+}
+
+func (t *mockTransport) replay(req *http.Request, path, url string, index int) (*http.Response, error) {
+	recording, err := readMockRecording(path)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && len(recording.Responses) == 0) {
+		return nil, fmt.Errorf("no recorded response for %s %s in %s (record it with %s=%s)", req.Method, url, t.dir, MockModeEnvVar, MockModeRecord)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read recorded response %s: %w", path, err)
+	}
+
+	// Later requests than were recorded keep getting the last response, so
+	// polling loops settle on the final state.
+	recorded := recording.Responses[min(index, len(recording.Responses)-1)]
+	body := []byte(recorded.BodyText)
+	if len(recorded.Body) > 0 {
+		body = recorded.Body
+	}
+	header := http.Header{}
+	if recorded.ContentType != "" {
+		header.Set("Content-Type", recorded.ContentType)
+	}
+	status := recorded.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// record forwards req upstream without holding mockServed, so concurrent
+// requests are not serialized behind the network. Responses to the same URL
+// are appended in the order they complete.
+func (t *mockTransport) record(req *http.Request, path, url string, requestBody []byte) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorded := mockResponse{
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		RequestBody: redactDryRunBody(requestBody),
+	}
+	if len(body) > 0 && json.Valid(body) {
+		recorded.Body = json.RawMessage(body)
+	} else {
+		recorded.BodyText = string(body)
+	}
+
+	mockServed.Lock()
+	defer mockServed.Unlock()
+	recording := &mockRecording{Method: req.Method, URL: url}
+	if mockServed.recorded[path] {
+		if existing, err := readMockRecording(path); err == nil {
+			recording = existing
+		}
+	}
+	recording.Responses = append(recording.Responses, recorded)
+	if err := writeMockRecording(t.dir, path, recording); err != nil {
+		return nil, fmt.Errorf("record response to %s: %w", path, err)
+	}
+	if mockServed.recorded == nil {
+		mockServed.recorded = map[string]bool{}
+	}
+	mockServed.recorded[path] = true
+	return resp, nil
+}
+
+// mockRequestURL identifies a request by path and normalized query; the host
+// is left out so recordings stay readable. Requests to other hosts, such as
+// pre-signed upload URLs, drop the query so their signatures are never saved.
+func mockRequestURL(req *http.Request) string {
+	url := req.URL.EscapedPath()
+	if !isMockAPIHost(req.URL.Host) {
+		return url
+	}
+	if query := req.URL.Query().Encode(); query != "" {
+		url += "?" + query
+	}
+	return url
+}
+
+// isMockAPIHost reports whether host serves the App Store Connect or Notary
+// API, whose requests carry no credentials in the URL or body.
+func isMockAPIHost(host string) bool {
+	for _, base := range []string{BaseURL, NotaryBaseURL} {
+		if parsed, err := neturl.Parse(base); err == nil && strings.EqualFold(parsed.Host, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// mockFileName maps a request to a stable file name such as
+// GET_v1_apps_123.json; URLs with a query, or paths too long to spell out,
+// get a short hash suffix.
+func mockFileName(method, url string) string {
+	path, query, _ := strings.Cut(url, "?")
+	slug := strings.Trim(mockSlugPattern.ReplaceAllString(path, "_"), "_")
+	name := strings.ToUpper(method) + "_" + slug
+	if len(slug) > maxMockSlugLength {
+		name = strings.ToUpper(method) + "_" + slug[:maxMockSlugLength]
+		query = url
+	}
+	if query != "" {
+		sum := sha256.Sum256([]byte(query))
+		name += "_" + hex.EncodeToString(sum[:4])
+	}
+	return name + ".json"
+}
+
+// resetMockServed clears the per-process request state (tests only).
+func resetMockServed() {
+	mockServed.Lock()
+	defer mockServed.Unlock()
+	mockServed.counts = nil
+	mockServed.recorded = nil
+}
+
+func readMockRecording(path string) (*mockRecording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var recording mockRecording
+	if err := json.Unmarshal(data, &recording); err != nil {
+		return nil, err
+	}
+	return &recording, nil
+}
+
+func writeMockRecording(dir, path string, recording *mockRecording) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(recording, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".mock-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,199 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newMockTestClient(t *testing.T, next http.RoundTripper) *Client {
+	t.Helper()
+	client, err := NewMockReplayClient()
+	if err != nil {
+		t.Fatalf("NewMockReplayClient() error: %v", err)
+	}
+	client.httpClient = &http.Client{Transport: withMockTransport(next)}
+	return client
+}
+
+func TestMockTransportRecordsThenReplaysInOrder(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(MockDirEnvVar, dir)
+	t.Setenv(MockModeEnvVar, MockModeRecord)
+	resetMockServed()
+	t.Cleanup(resetMockServed)
+
+	states := []string{"PROCESSING", "VALID"}
+	calls := 0
+	recorder := newMockTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		state := states[min(calls, len(states)-1)]
+		calls++
+		return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"b1","attributes":{"processingState":"`+state+`"}}}`), nil
+	}))
+	for range states {
+		if _, err := recorder.Do(context.Background(), http.MethodGet, "/v1/builds/b1", nil); err != nil {
+			t.Fatalf("record request error: %v", err)
+		}
+	}
+
+	recorded := filepath.Join(dir, "GET_v1_builds_b1.json")
+	data, err := os.ReadFile(recorded)
+	if err != nil {
+		t.Fatalf("expected recording at %s: %v", recorded, err)
+	}
+	if !strings.Contains(string(data), "PROCESSING") || !strings.Contains(string(data), "VALID") {
+		t.Fatalf("expected both responses recorded, got %s", data)
+	}
+
+	t.Setenv(MockModeEnvVar, "")
+	resetMockServed()
+	replayer := newMockTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("replay must not reach the network: %s", req.URL)
+		return nil, nil
+	}))
+	var got []string
+	for range 3 {
+		body, err := replayer.Do(context.Background(), http.MethodGet, "/v1/builds/b1", nil)
+		if err != nil {
+			t.Fatalf("replay request error: %v", err)
+		}
+		got = append(got, string(body))
+	}
+	if !strings.Contains(got[0], "PROCESSING") || !strings.Contains(got[1], "VALID") || got[2] != got[1] {
+		t.Fatalf("expected recorded order with the last response repeated, got %q", got)
+	}
+}
+
+func TestMockTransportRecordDoesNotSerializeUpstreamRequests(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(MockDirEnvVar, dir)
+	t.Setenv(MockModeEnvVar, MockModeRecord)
+	resetMockServed()
+	t.Cleanup(resetMockServed)
+
+	slowStarted := make(chan struct{})
+	releaseSlow := make(chan struct{})
+	recorder := newMockTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/v1/builds/slow" {
+			close(slowStarted)
+			<-releaseSlow
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"b1"}}`), nil
+	}))
+
+	slowDone := make(chan error, 1)
+	go func() {
+		_, err := recorder.Do(context.Background(), http.MethodGet, "/v1/builds/slow", nil)
+		slowDone <- err
+	}()
+	<-slowStarted
+
+	fastDone := make(chan error, 1)
+	go func() {
+		_, err := recorder.Do(context.Background(), http.MethodGet, "/v1/builds/fast", nil)
+		fastDone <- err
+	}()
+	select {
+	case err := <-fastDone:
+		if err != nil {
+			t.Fatalf("fast request error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("fast request blocked behind an in-flight upstream request")
+	}
+
+	close(releaseSlow)
+	if err := <-slowDone; err != nil {
+		t.Fatalf("slow request error: %v", err)
+	}
+	for _, name := range []string{"GET_v1_builds_slow.json", "GET_v1_builds_fast.json"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Fatalf("expected recording %s: %v", name, err)
+		}
+	}
+}
+
+func TestMockTransportRecordOmitsUploadURLQueryAndBody(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(MockDirEnvVar, dir)
+	t.Setenv(MockModeEnvVar, MockModeRecord)
+	resetMockServed()
+	t.Cleanup(resetMockServed)
+
+	transport := withMockTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Header: http.Header{}}, nil
+	}))
+	uploadURL := "https://upload.example.com/chunk/1?X-Amz-Signature=secret-signature"
+	req, err := http.NewRequest(http.MethodPut, uploadURL, strings.NewReader(`{"chunk":"secret-body"}`))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("record upload error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "PUT_chunk_1.json"))
+	if err != nil {
+		t.Fatalf("expected upload recording: %v", err)
+	}
+	if strings.Contains(string(data), "secret-signature") || strings.Contains(string(data), "secret-body") {
+		t.Fatalf("expected upload query and body to be left out, got %s", data)
+	}
+}
+
+func TestMockTransportReplayReportsMissingRecording(t *testing.T) {
+	t.Setenv(MockDirEnvVar, t.TempDir())
+	t.Setenv(MockModeEnvVar, "")
+	resetMockServed()
+	t.Cleanup(resetMockServed)
+
+	client := newMockTestClient(t, nil)
+	_, err := client.Do(context.Background(), http.MethodGet, "/v1/apps?limit=1", nil)
+	if err == nil || !strings.Contains(err.Error(), "no recorded response for GET /v1/apps?limit=1") {
+		t.Fatalf("expected missing recording error, got %v", err)
+	}
+}
+
+func TestMockTransportReplaysErrorStatus(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(MockDirEnvVar, dir)
+	t.Setenv(MockModeEnvVar, MockModeReplay)
+	resetMockServed()
+	t.Cleanup(resetMockServed)
+
+	recording := `{"method":"GET","url":"/v1/apps/404","responses":[{"status":404,"body":{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found","detail":"No app"}]}}]}`
+	if err := os.WriteFile(filepath.Join(dir, "GET_v1_apps_404.json"), []byte(recording), 0o600); err != nil {
+		t.Fatalf("write recording: %v", err)
+	}
+
+	client := newMockTestClient(t, nil)
+	_, err := client.Do(context.Background(), http.MethodGet, "/v1/apps/404", nil)
+	if err == nil || !strings.Contains(err.Error(), "No app") {
+		t.Fatalf("expected recorded API error, got %v", err)
+	}
+}
+
+func TestMockModeRejectsUnknownMode(t *testing.T) {
+	t.Setenv(MockDirEnvVar, t.TempDir())
+	t.Setenv(MockModeEnvVar, "live")
+	if _, err := MockMode(); err == nil || !strings.Contains(err.Error(), "ASC_MOCK_MODE must be record or replay") {
+		t.Fatalf("expected mode error, got %v", err)
+	}
+	if MockReplayEnabled() {
+		t.Fatal("expected replay to be disabled for an invalid mode")
+	}
+}
+
+func TestMockFileName(t *testing.T) {
+	if got := mockFileName("get", "/v1/apps/123/builds"); got != "GET_v1_apps_123_builds.json" {
+		t.Fatalf("mockFileName() = %q", got)
+	}
+	withQuery := mockFileName("GET", "/v1/apps?limit=1")
+	if !strings.HasPrefix(withQuery, "GET_v1_apps_") || withQuery == mockFileName("GET", "/v1/apps?limit=2") {
+		t.Fatalf("expected query-specific names, got %q", withQuery)
+	}
+}
@@ -16,6 +16,8 @@ type BuildUploadResult struct {
 	Uploaded            *bool             `json:"uploaded,omitempty"`
 	ChecksumVerified    *bool             `json:"checksumVerified,omitempty"`
 	SourceFileChecksums *Checksums        `json:"sourceFileChecksums,omitempty"`
+	// UsesNonExemptEncryption is the export compliance answer set on the build, if any.
+	UsesNonExemptEncryption *bool `json:"usesNonExemptEncryption,omitempty"`
 }
 
 // BuildBetaGroupsUpdateResult represents CLI output for build beta group updates.
@@ -23,6 +25,8 @@ type BuildBetaGroupsUpdateResult struct {
 	BuildID  string   `json:"buildId"`
 	GroupIDs []string `json:"groupIds"`
 	Action   string   `json:"action"`
+	// UsesNonExemptEncryption is the export compliance answer set on the build, if any.
+	UsesNonExemptEncryption *bool `json:"usesNonExemptEncryption,omitempty"`
 }
 
 // BuildIndividualTestersUpdateResult represents CLI output for build individual tester updates.
@@ -0,0 +1,159 @@
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BulkItemStatus is the outcome of one item in a bulk, batch, or import command.
+type BulkItemStatus string
+
+const (
+	BulkItemSucceeded BulkItemStatus = "succeeded"
+	BulkItemFailed    BulkItemStatus = "failed"
+	BulkItemSkipped   BulkItemStatus = "skipped"
+)
+
+// BulkResultItem reports what happened to a single input item.
+type BulkResultItem struct {
+	Row       int            `json:"row,omitempty"`
+	Item      string         `json:"item,omitempty"`
+	ID        string         `json:"id,omitempty"`
+	Status    BulkItemStatus `json:"status"`
+	Detail    string         `json:"detail,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	RequestID string         `json:"requestId,omitempty"`
+}
+
+// BulkResult is the shared partial-failure report for bulk, batch, and import
+// commands. Commands embed it next to their own context fields so every one
+// reports total/succeeded/failed/skipped counts and a per-item list the same
+// way. CSV output writes one row per item.
+type BulkResult struct {
+	Total     int              `json:"total"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Skipped   int              `json:"skipped"`
+	Items     []BulkResultItem `json:"items"`
+}
+
+// Succeed records a successful item.
+func (r *BulkResult) Succeed(item BulkResultItem) {
+	item.Status = BulkItemSucceeded
+	r.Succeeded++
+	r.Items = append(r.Items, item)
+}
+
+// Skip records an item that was intentionally not changed.
+func (r *BulkResult) Skip(item BulkResultItem, detail string) {
+	item.Status = BulkItemSkipped
+	if detail != "" {
+		item.Detail = detail
+	}
+	r.Skipped++
+	r.Items = append(r.Items, item)
+}
+
+// Fail records a failed item. The App Store Connect request ID is copied from
+// err when it wraps an APIError. A request withheld by dry-run mode is
+// recorded as skipped, since it was previewed rather than failed.
+func (r *BulkResult) Fail(item BulkResultItem, err error) {
+	if errors.Is(err, ErrDryRun) {
+		r.Skip(item, err.Error())
+		return
+	}
+	item.Status = BulkItemFailed
+	if err != nil {
+		item.Error = err.Error()
+		if apiErr, ok := errors.AsType[*APIError](err); ok {
+			item.RequestID = strings.TrimSpace(apiErr.RequestID)
+		}
+	}
+	r.Failed++
+	r.Items = append(r.Items, item)
+}
+
+// FailedItems returns the items that failed, in the order they were recorded.
+func (r *BulkResult) FailedItems() []BulkResultItem {
+	failed := make([]BulkResultItem, 0, r.Failed)
+	for _, item := range r.Items {
+		if item.Status == BulkItemFailed {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// bulkItems lets PrintCSV find the items of a BulkResult, including one
+// embedded in a command-specific summary.
+func (r *BulkResult) bulkItems() []BulkResultItem {
+	return r.Items
+}
+
+// BulkResultRows returns the per-item table for a bulk result. Commands with
+// their own summary table render it after that table.
+func BulkResultRows(result *BulkResult) ([]string, [][]string) {
+	headers := []string{"Row", "Item", "ID", "Status", "Detail", "Error", "Request ID"}
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		row := ""
+		if item.Row > 0 {
+			row = fmt.Sprintf("%d", item.Row)
+		}
+		rows = append(rows, []string{
+			row,
+			item.Item,
+			item.ID,
+			string(item.Status),
+			item.Detail,
+			compactWhitespace(item.Error),
+			item.RequestID,
+		})
+	}
+	return headers, rows
+}
+
+func renderBulkResult(result *BulkResult, render func([]string, [][]string)) error {
+	render(
+		[]string{"Total", "Succeeded", "Failed", "Skipped"},
+		[][]string{{
+			fmt.Sprintf("%d", result.Total),
+			fmt.Sprintf("%d", result.Succeeded),
+			fmt.Sprintf("%d", result.Failed),
+			fmt.Sprintf("%d", result.Skipped),
+		}},
+	)
+	if len(result.Items) > 0 {
+		render(BulkResultRows(result))
+	}
+	return nil
+}
+
+var bulkCSVLeadingColumns = []string{"row", "item", "id", "status", "detail", "error", "requestId"}
+
+// bulkCSVRecords flattens the items of a bulk result into CSV records.
+func bulkCSVRecords(data any) ([]map[string]string, bool) {
+	provider, ok := data.(interface{ bulkItems() []BulkResultItem })
+	if !ok {
+		return nil, false
+	}
+	items := provider.bulkItems()
+	records := make([]map[string]string, 0, len(items))
+	for _, item := range items {
+		row := ""
+		if item.Row > 0 {
+			row = fmt.Sprintf("%d", item.Row)
+		}
+		records = append(records, map[string]string{
+			"row":       row,
+			"item":      item.Item,
+			"id":        item.ID,
+			"status":    string(item.Status),
+			"detail":    item.Detail,
+			"error":     item.Error,
+			"requestId": item.RequestID,
+		})
+	}
+	return records, true
+}
@@ -0,0 +1,63 @@
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type bulkSummaryForTest struct {
+	InputFile string `json:"inputFile"`
+	BulkResult
+}
+
+func TestBulkResult_RecordsRequestIDFromAPIError(t *testing.T) {
+	var result BulkResult
+	result.Succeed(BulkResultItem{Row: 1, Item: "a@example.com", ID: "tester-1"})
+	result.Skip(BulkResultItem{Row: 2, Item: "b@example.com"}, "already exists")
+	apiErr := ParseErrorWithStatus([]byte(`{"errors":[{"id":"req-123","code":"ENTITY_ERROR","title":"Invalid","detail":"bad email"}]}`), 409)
+	result.Fail(BulkResultItem{Row: 3, Item: "c@example.com"}, fmt.Errorf("create tester: %w", apiErr))
+	result.Fail(BulkResultItem{Row: 4}, errors.New("email is required"))
+
+	if result.Succeeded != 1 || result.Skipped != 1 || result.Failed != 2 {
+		t.Fatalf("unexpected counts %+v", result)
+	}
+	failed := result.FailedItems()
+	if len(failed) != 2 || failed[0].RequestID != "req-123" || failed[0].Status != BulkItemFailed {
+		t.Fatalf("expected request ID on API failure, got %+v", failed)
+	}
+	if failed[1].RequestID != "" || failed[1].Error != "email is required" {
+		t.Fatalf("unexpected local failure %+v", failed[1])
+	}
+}
+
+func TestBulkResult_RecordsDryRunAsSkipped(t *testing.T) {
+	var result BulkResult
+	result.Fail(BulkResultItem{Row: 1, Item: "a@example.com"}, fmt.Errorf("create tester: %w", ErrDryRun))
+
+	if result.Failed != 0 || result.Skipped != 1 {
+		t.Fatalf("expected withheld request to be skipped, got %+v", result)
+	}
+	if item := result.Items[0]; item.Status != BulkItemSkipped || item.Error != "" || item.Detail == "" {
+		t.Fatalf("unexpected dry-run item %+v", item)
+	}
+}
+
+func TestPrintCSV_EmbeddedBulkResultWritesOneRowPerItem(t *testing.T) {
+	summary := &bulkSummaryForTest{InputFile: "testers.csv"}
+	summary.Total = 2
+	summary.Succeed(BulkResultItem{Row: 1, Item: "a@example.com", ID: "tester-1", Detail: "created"})
+	summary.Fail(BulkResultItem{Row: 2, Item: "b@example.com"}, &APIError{Title: "Conflict", RequestID: "req-9"})
+
+	output := captureStdout(t, func() error { return PrintCSV(summary, nil) })
+	records := readCSVOutput(t, output)
+	want := [][]string{
+		{"row", "item", "id", "status", "detail", "error", "requestId"},
+		{"1", "a@example.com", "tester-1", "succeeded", "created", "", ""},
+		{"2", "b@example.com", "", "failed", "", "Conflict", "req-9"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("expected %v, got %v", want, records)
+	}
+}
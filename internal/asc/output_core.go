@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"reflect"
 )
 
 func printPrettyRawJSON(data json.RawMessage) error {
@@ -22,6 +24,21 @@ func PrintMarkdown(data any) error {
 	return renderByRegistry(data, RenderMarkdown)
 }
 
+// WriteMarkdown writes data as Markdown tables to w. It reports false, and
+// writes nothing, when data has no table renderer.
+func WriteMarkdown(w io.Writer, data any) (bool, error) {
+	t := reflect.TypeOf(data)
+	if _, ok := directRenderRegistry[t]; !ok {
+		if _, ok := outputRegistry[t]; !ok {
+			return false, nil
+		}
+	}
+	err := renderByRegistry(data, func(headers []string, rows [][]string) {
+		renderMarkdownTo(w, headers, rows)
+	})
+	return err == nil, err
+}
+
 // PrintTable prints data as a formatted table.
 func PrintTable(data any) error {
 	return renderByRegistry(data, RenderTable)
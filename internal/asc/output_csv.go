@@ -0,0 +1,223 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// PrintCSV prints data as CSV with resource attributes flattened into columns.
+//
+// List responses produce one row per item in "data"; single-resource responses
+// produce one row. Columns are ordered id, type, then the remaining flattened
+// keys alphabetically. When columns is non-empty only those columns are written,
+// in the order given.
+func PrintCSV(data any, columns []string) error {
+	headers, rows, err := csvRows(data, columns)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(os.Stdout)
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+func csvRows(data any, columns []string) ([]string, [][]string, error) {
+	leading := []string{"id", "type"}
+	var records []map[string]string
+	if metrics, ok := metricsResponseForCSV(data); ok {
+		leading = metricsCSVLeadingColumns
+		records = metricsCSVRecords(metrics.Data)
+	} else if bulk, ok := bulkCSVRecords(data); ok {
+		leading = bulkCSVLeadingColumns
+		records = bulk
+	} else {
+		var err error
+		records, err = csvRecords(data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	available := csvColumnOrder(records, leading)
+	headers := available
+	if len(columns) > 0 {
+		known := make(map[string]struct{}, len(available))
+		for _, column := range available {
+			known[column] = struct{}{}
+		}
+		headers = make([]string, 0, len(columns))
+		for _, column := range columns {
+			column = strings.TrimSpace(column)
+			if column == "" {
+				continue
+			}
+			if _, ok := known[column]; !ok && len(records) > 0 {
+				return nil, nil, fmt.Errorf("unknown column %q (available: %s)", column, strings.Join(available, ", "))
+			}
+			headers = append(headers, column)
+		}
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		row := make([]string, len(headers))
+		for i, column := range headers {
+			row[i] = record[column]
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows, nil
+}
+
+// csvRecords normalizes data into flattened records keyed by column name.
+func csvRecords(data any) ([]map[string]string, error) {
+	if records, ok := reflectCSVRecords(data); ok {
+		return records, nil
+	}
+	return csvRecordsFromJSON(data)
+}
+
+// csvRecordsFromJSON flattens data through its JSON encoding, which handles
+// any shape at the cost of a marshal/decode round trip.
+func csvRecordsFromJSON(data any) ([]map[string]string, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("csv: marshal output: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("csv: decode output: %w", err)
+	}
+
+	if object, ok := value.(map[string]any); ok {
+		if inner, ok := object["data"]; ok {
+			value = inner
+		}
+	}
+
+	switch typed := value.(type) {
+	case []any:
+		records := make([]map[string]string, 0, len(typed))
+		for _, item := range typed {
+			records = append(records, flattenCSVRecord(item))
+		}
+		return records, nil
+	case nil:
+		return nil, nil
+	default:
+		return []map[string]string{flattenCSVRecord(typed)}, nil
+	}
+}
+
+// flattenCSVRecord flattens a single item. Resource attributes are promoted to
+// top-level columns; relationships and links are dropped.
+func flattenCSVRecord(item any) map[string]string {
+	record := map[string]string{}
+	object, ok := item.(map[string]any)
+	if !ok {
+		record["value"] = csvScalar(item)
+		return record
+	}
+
+	for key, value := range object {
+		switch key {
+		case "relationships", "links":
+			continue
+		case "attributes":
+			if attributes, ok := value.(map[string]any); ok {
+				for attrKey, attrValue := range attributes {
+					flattenCSVValue(record, attrKey, attrValue)
+				}
+				continue
+			}
+		}
+		flattenCSVValue(record, key, value)
+	}
+	return record
+}
+
+func flattenCSVValue(record map[string]string, prefix string, value any) {
+	switch typed := value.(type) {
+	case map[string]any:
+		if len(typed) == 0 {
+			record[prefix] = ""
+			return
+		}
+		for key, nested := range typed {
+			flattenCSVValue(record, prefix+"."+key, nested)
+		}
+	case []any:
+		parts := make([]string, 0, len(typed))
+		for _, item := range typed {
+			switch item.(type) {
+			case map[string]any, []any:
+				encoded, _ := json.Marshal(typed)
+				record[prefix] = string(encoded)
+				return
+			}
+			parts = append(parts, csvScalar(item))
+		}
+		record[prefix] = strings.Join(parts, ";")
+	default:
+		record[prefix] = csvScalar(typed)
+	}
+}
+
+func csvScalar(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		if typed {
+			return "true"
+		}
+		return "false"
+	default:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(encoded)
+	}
+}
+
+func csvColumnOrder(records []map[string]string, leading []string) []string {
+	seen := map[string]struct{}{}
+	for _, record := range records {
+		for key := range record {
+			seen[key] = struct{}{}
+		}
+	}
+
+	columns := make([]string, 0, len(seen))
+	for _, column := range leading {
+		if _, ok := seen[column]; ok {
+			columns = append(columns, column)
+			delete(seen, column)
+		}
+	}
+	rest := make([]string, 0, len(seen))
+	for key := range seen {
+		rest = append(rest, key)
+	}
+	sort.Strings(rest)
+	return append(columns, rest...)
+}
@@ -0,0 +1,304 @@
+package asc
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// reflectCSVRecords builds CSV records straight from Go values for the common
+// response shapes (a struct whose "data" field holds plain resource structs),
+// skipping the marshal-then-decode round trip of csvRecords. It reports false
+// whenever a value might encode differently from what the flattening below
+// assumes (custom marshalers, floats, maps, interfaces, raw JSON, ...), and
+// callers then fall back to the JSON path so output stays identical.
+func reflectCSVRecords(data any) ([]map[string]string, bool) {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() || hasCustomJSONEncoding(value.Type()) {
+			return nil, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct || hasCustomJSONEncoding(value.Type()) {
+		return nil, false
+	}
+
+	dataField, ok := csvStructFieldByJSONName(value, "data")
+	if !ok {
+		return nil, false
+	}
+	for dataField.Kind() == reflect.Pointer {
+		if dataField.IsNil() {
+			return nil, true
+		}
+		dataField = dataField.Elem()
+	}
+
+	switch dataField.Kind() {
+	case reflect.Slice:
+		if hasCustomJSONEncoding(dataField.Type()) || dataField.Type().Elem().Kind() == reflect.Uint8 {
+			return nil, false
+		}
+		records := make([]map[string]string, 0, dataField.Len())
+		for i := 0; i < dataField.Len(); i++ {
+			record, ok := reflectCSVRecord(dataField.Index(i))
+			if !ok {
+				return nil, false
+			}
+			records = append(records, record)
+		}
+		return records, true
+	case reflect.Struct:
+		record, ok := reflectCSVRecord(dataField)
+		if !ok {
+			return nil, false
+		}
+		return []map[string]string{record}, true
+	default:
+		return nil, false
+	}
+}
+
+// reflectCSVRecord mirrors flattenCSVRecord for a single struct item.
+func reflectCSVRecord(item reflect.Value) (map[string]string, bool) {
+	for item.Kind() == reflect.Pointer {
+		if item.IsNil() || hasCustomJSONEncoding(item.Type()) {
+			return nil, false
+		}
+		item = item.Elem()
+	}
+	if item.Kind() != reflect.Struct || hasCustomJSONEncoding(item.Type()) {
+		return nil, false
+	}
+
+	record := map[string]string{}
+	ok := forEachCSVField(item, func(name string, field reflect.Value) bool {
+		switch name {
+		case "relationships", "links":
+			return true
+		case "attributes":
+			attributes := field
+			for attributes.Kind() == reflect.Pointer && !attributes.IsNil() && !hasCustomJSONEncoding(attributes.Type()) {
+				attributes = attributes.Elem()
+			}
+			if attributes.Kind() == reflect.Struct && !hasCustomJSONEncoding(attributes.Type()) {
+				return forEachCSVField(attributes, func(attrName string, attrValue reflect.Value) bool {
+					return reflectCSVValue(record, attrName, attrValue)
+				})
+			}
+		}
+		return reflectCSVValue(record, name, field)
+	})
+	return record, ok
+}
+
+// reflectCSVValue mirrors flattenCSVValue.
+func reflectCSVValue(record map[string]string, prefix string, value reflect.Value) bool {
+	if hasCustomJSONEncoding(value.Type()) {
+		return false
+	}
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			record[prefix] = ""
+			return true
+		}
+		return reflectCSVValue(record, prefix, value.Elem())
+	case reflect.Struct:
+		fields := 0
+		ok := forEachCSVField(value, func(name string, field reflect.Value) bool {
+			fields++
+			return reflectCSVValue(record, prefix+"."+name, field)
+		})
+		if ok && fields == 0 {
+			record[prefix] = ""
+		}
+		return ok
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+		parts := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			part, ok := reflectCSVScalar(value.Index(i))
+			if !ok {
+				return false
+			}
+			parts = append(parts, part)
+		}
+		record[prefix] = strings.Join(parts, ";")
+		return true
+	default:
+		scalar, ok := reflectCSVScalar(value)
+		if ok {
+			record[prefix] = scalar
+		}
+		return ok
+	}
+}
+
+// reflectCSVScalar mirrors csvScalar for values whose JSON encoding is known.
+func reflectCSVScalar(value reflect.Value) (string, bool) {
+	if hasCustomJSONEncoding(value.Type()) {
+		return "", false
+	}
+	switch value.Kind() {
+	case reflect.String:
+		if !utf8.ValidString(value.String()) {
+			return "", false
+		}
+		return value.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), true
+	case reflect.Pointer:
+		if value.IsNil() {
+			return "", true
+		}
+		return reflectCSVScalar(value.Elem())
+	default:
+		return "", false
+	}
+}
+
+// csvFieldPlan is one struct field as encoding/json would emit it.
+type csvFieldPlan struct {
+	index     int
+	name      string
+	omitEmpty bool
+}
+
+// csvStructPlan caches the emitted fields of a struct type; ok is false when
+// the type uses tag options or field shapes the reflective path does not model.
+type csvStructPlan struct {
+	fields []csvFieldPlan
+	ok     bool
+}
+
+var (
+	csvStructPlans     sync.Map // reflect.Type -> *csvStructPlan
+	csvCustomEncodings sync.Map // reflect.Type -> bool
+)
+
+func csvStructPlanFor(typ reflect.Type) *csvStructPlan {
+	if cached, ok := csvStructPlans.Load(typ); ok {
+		return cached.(*csvStructPlan)
+	}
+
+	plan := &csvStructPlan{ok: true}
+	seen := make(map[string]struct{}, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			plan = &csvStructPlan{}
+			break
+		}
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		omitEmpty := false
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "":
+			case "omitempty":
+				omitEmpty = true
+			default:
+				plan.ok = false
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := seen[name]; ok {
+			plan.ok = false
+		}
+		seen[name] = struct{}{}
+		if !plan.ok {
+			plan.fields = nil
+			break
+		}
+		plan.fields = append(plan.fields, csvFieldPlan{index: i, name: name, omitEmpty: omitEmpty})
+	}
+
+	actual, _ := csvStructPlans.LoadOrStore(typ, plan)
+	return actual.(*csvStructPlan)
+}
+
+// forEachCSVField visits the fields encoding/json would emit for a struct,
+// using their JSON names. It stops and reports false on tag options or field
+// shapes it does not model.
+func forEachCSVField(value reflect.Value, visit func(name string, field reflect.Value) bool) bool {
+	plan := csvStructPlanFor(value.Type())
+	if !plan.ok {
+		return false
+	}
+	for _, field := range plan.fields {
+		fieldValue := value.Field(field.index)
+		if field.omitEmpty && isEmptyJSONValue(fieldValue) {
+			continue
+		}
+		if !visit(field.name, fieldValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func csvStructFieldByJSONName(value reflect.Value, want string) (reflect.Value, bool) {
+	var found reflect.Value
+	ok := forEachCSVField(value, func(name string, field reflect.Value) bool {
+		if name == want {
+			found = field
+		}
+		return true
+	})
+	return found, ok && found.IsValid()
+}
+
+func isEmptyJSONValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+func hasCustomJSONEncoding(typ reflect.Type) bool {
+	if cached, ok := csvCustomEncodings.Load(typ); ok {
+		return cached.(bool)
+	}
+	pointer := reflect.PointerTo(typ)
+	custom := typ.Implements(jsonMarshalerType) || typ.Implements(textMarshalerType) ||
+		pointer.Implements(jsonMarshalerType) || pointer.Implements(textMarshalerType)
+	csvCustomEncodings.Store(typ, custom)
+	return custom
+}
@@ -0,0 +1,183 @@
+package asc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readCSVOutput(t *testing.T, output string) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v (%q)", err, output)
+	}
+	return records
+}
+
+func TestPrintCSV_FlattensListAttributes(t *testing.T) {
+	resp := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{Type: ResourceTypeApps, ID: "2", Attributes: AppAttributes{Name: "Beta, Inc", BundleID: "com.example.beta", SKU: "B"}},
+			{Type: ResourceTypeApps, ID: "1", Attributes: AppAttributes{Name: "Alpha", BundleID: "com.example.alpha", SKU: "A"}},
+		},
+	}
+
+	output := captureStdout(t, func() error { return PrintCSV(resp, nil) })
+	records := readCSVOutput(t, output)
+	if len(records) != 3 {
+		t.Fatalf("expected header + 2 rows, got %d", len(records))
+	}
+	header := records[0]
+	if header[0] != "id" || header[1] != "type" {
+		t.Fatalf("expected id,type leading columns, got %v", header)
+	}
+	for i := 3; i < len(header); i++ {
+		if header[i-1] > header[i] {
+			t.Fatalf("expected remaining columns sorted, got %v", header)
+		}
+	}
+	nameIndex := -1
+	for i, column := range header {
+		if column == "name" {
+			nameIndex = i
+		}
+		if strings.HasPrefix(column, "attributes.") || strings.HasPrefix(column, "relationships") {
+			t.Fatalf("expected attributes promoted and relationships dropped, got %v", header)
+		}
+	}
+	if nameIndex < 0 || records[1][nameIndex] != "Beta, Inc" {
+		t.Fatalf("expected name column with quoted value, got %v", records)
+	}
+}
+
+func TestPrintCSV_SelectsColumnsInOrder(t *testing.T) {
+	resp := &AppResponse{
+		Data: Resource[AppAttributes]{Type: ResourceTypeApps, ID: "1", Attributes: AppAttributes{Name: "Alpha", BundleID: "com.example.alpha"}},
+	}
+
+	output := captureStdout(t, func() error { return PrintCSV(resp, []string{"bundleId", "id"}) })
+	records := readCSVOutput(t, output)
+	if len(records) != 2 {
+		t.Fatalf("expected header + 1 row, got %v", records)
+	}
+	if strings.Join(records[0], ",") != "bundleId,id" || strings.Join(records[1], ",") != "com.example.alpha,1" {
+		t.Fatalf("unexpected csv: %v", records)
+	}
+}
+
+func TestPrintCSV_UnknownColumnErrors(t *testing.T) {
+	resp := &AppResponse{
+		Data: Resource[AppAttributes]{Type: ResourceTypeApps, ID: "1"},
+	}
+
+	_, _, err := csvRows(resp, []string{"nope"})
+	if err == nil || !strings.Contains(err.Error(), `unknown column "nope"`) {
+		t.Fatalf("expected unknown column error, got %v", err)
+	}
+}
+
+type csvReflectNested struct {
+	Label  string   `json:"label"`
+	Counts []int    `json:"counts,omitempty"`
+	Tags   []string `json:"tags"`
+	Empty  struct{} `json:"empty"`
+}
+
+type csvReflectAttributes struct {
+	Name     string            `json:"name"`
+	Count    *int              `json:"count"`
+	Nested   *csvReflectNested `json:"nested,omitempty"`
+	Disabled bool              `json:"disabled,omitempty"`
+	Hidden   string            `json:"-"`
+}
+
+func TestPrintCSV_ReflectRecordsMatchJSONRecords(t *testing.T) {
+	enabled := true
+	count := 7
+	values := []any{
+		&DevicesResponse{Data: []Resource[DeviceAttributes]{
+			{Type: ResourceTypeDevices, ID: "d-1", Attributes: DeviceAttributes{Name: "iPhone", Platform: DevicePlatformIOS, UDID: "UDID-1", Status: DeviceStatusEnabled}},
+			{Type: ResourceTypeDevices, ID: "d-2", Attributes: DeviceAttributes{Name: "Mac \"Studio\", 2"}},
+		}},
+		&BetaTestersResponse{Data: []Resource[BetaTesterAttributes]{
+			{Type: ResourceTypeBetaTesters, ID: "t-1", Attributes: BetaTesterAttributes{Email: "a@example.com", FirstName: "Ada"}},
+		}},
+		&BuildsResponse{Data: []Resource[BuildAttributes]{
+			{Type: ResourceTypeBuilds, ID: "b-1", Attributes: BuildAttributes{Version: "42", UsesNonExemptEncryption: &enabled, Expired: true}},
+		}},
+		&AppResponse{Data: Resource[AppAttributes]{Type: ResourceTypeApps, ID: "1", Attributes: AppAttributes{Name: "Alpha"}}},
+		&Response[csvReflectAttributes]{Data: []Resource[csvReflectAttributes]{
+			{Type: "things", ID: "x-1", Attributes: csvReflectAttributes{Name: "one", Count: &count, Nested: &csvReflectNested{Label: "n", Counts: []int{1, 2}, Tags: nil}}},
+			{Type: "things", ID: "x-2", Attributes: csvReflectAttributes{Name: "two", Hidden: "secret", Nested: &csvReflectNested{Tags: []string{"a", "b"}}}},
+		}},
+		&DevicesResponse{},
+	}
+
+	for _, value := range values {
+		fast, ok := reflectCSVRecords(value)
+		if !ok {
+			t.Fatalf("expected reflective CSV path for %T", value)
+		}
+		want, err := csvRecordsFromJSON(value)
+		if err != nil {
+			t.Fatalf("csvRecordsFromJSON(%T) error: %v", value, err)
+		}
+		if len(fast) != len(want) {
+			t.Fatalf("%T: expected %d records, got %d", value, len(want), len(fast))
+		}
+		for i := range want {
+			if !reflect.DeepEqual(fast[i], want[i]) {
+				t.Fatalf("%T record %d mismatch:\nreflect: %v\njson:    %v", value, i, fast[i], want[i])
+			}
+		}
+	}
+}
+
+func TestPrintCSV_ReflectRecordsFallBackForUnmodeledShapes(t *testing.T) {
+	type floatAttributes struct {
+		Score float64 `json:"score"`
+	}
+	type mapAttributes struct {
+		Extra map[string]string `json:"extra"`
+	}
+	values := []any{
+		&Response[floatAttributes]{Data: []Resource[floatAttributes]{{ID: "1", Attributes: floatAttributes{Score: 1.5}}}},
+		&Response[mapAttributes]{Data: []Resource[mapAttributes]{{ID: "1"}}},
+		&PerfPowerMetricsResponse{Data: json.RawMessage(`{"productData":[]}`)},
+		map[string]any{"data": []any{}},
+	}
+	for _, value := range values {
+		if _, ok := reflectCSVRecords(value); ok {
+			t.Fatalf("expected %T to fall back to the JSON path", value)
+		}
+	}
+}
+
+func BenchmarkPrintCSVDevices(b *testing.B) {
+	resp := &DevicesResponse{Data: make([]Resource[DeviceAttributes], 0, 20000)}
+	for i := range 20000 {
+		resp.Data = append(resp.Data, Resource[DeviceAttributes]{
+			Type: ResourceTypeDevices,
+			ID:   fmt.Sprintf("device-%d", i),
+			Attributes: DeviceAttributes{
+				Name:     fmt.Sprintf("Device %d", i),
+				Platform: DevicePlatformIOS,
+				UDID:     fmt.Sprintf("00008030-%012d", i),
+				Status:   DeviceStatusEnabled,
+				Model:    "iPhone 15",
+			},
+		})
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := csvRows(resp, nil); err != nil {
+			b.Fatalf("csvRows() error: %v", err)
+		}
+	}
+}
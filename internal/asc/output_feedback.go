@@ -84,3 +84,41 @@ func reviewsRows(resp *ReviewsResponse) ([]string, [][]string) {
 	}
 	return headers, rows
 }
+
+// BetaFeedbackDownloadResult lists the TestFlight feedback attachments saved locally.
+type BetaFeedbackDownloadResult struct {
+	AppID       string                     `json:"appId"`
+	Dir         string                     `json:"dir"`
+	Submissions int                        `json:"submissions"`
+	Files       []BetaFeedbackDownloadFile `json:"files"`
+}
+
+// BetaFeedbackDownloadFile describes one screenshot or crash log on disk.
+type BetaFeedbackDownloadFile struct {
+	SubmissionID string `json:"submissionId"`
+	Kind         string `json:"kind"`
+	CreatedDate  string `json:"createdDate,omitempty"`
+	DeviceModel  string `json:"deviceModel,omitempty"`
+	OSVersion    string `json:"osVersion,omitempty"`
+	FilePath     string `json:"filePath"`
+	FileSize     int64  `json:"fileSize"`
+	Skipped      bool   `json:"skipped,omitempty"`
+}
+
+func betaFeedbackDownloadResultRows(result *BetaFeedbackDownloadResult) ([]string, [][]string) {
+	headers := []string{"Submission ID", "Kind", "Created", "Device", "OS", "File", "Size", "Skipped"}
+	rows := make([][]string, 0, len(result.Files))
+	for _, item := range result.Files {
+		rows = append(rows, []string{
+			item.SubmissionID,
+			item.Kind,
+			sanitizeTerminal(item.CreatedDate),
+			sanitizeTerminal(item.DeviceModel),
+			sanitizeTerminal(item.OSVersion),
+			item.FilePath,
+			fmt.Sprintf("%d", item.FileSize),
+			fmt.Sprintf("%t", item.Skipped),
+		})
+	}
+	return headers, rows
+}
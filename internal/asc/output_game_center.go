@@ -112,6 +112,24 @@ func gameCenterLeaderboardReleaseDeleteResultRows(result *GameCenterLeaderboardR
 	return headers, rows
 }
 
+func gameCenterLeaderboardGroupLeaderboardUpdateResultRows(result *GameCenterLeaderboardGroupLeaderboardUpdateResult) ([]string, [][]string) {
+	headers := []string{"Leaderboard ID", "Group Leaderboard ID", "Updated"}
+	rows := [][]string{{result.LeaderboardID, result.GroupLeaderboardID, fmt.Sprintf("%t", result.Updated)}}
+	return headers, rows
+}
+
+func gameCenterLeaderboardSetGroupLeaderboardSetUpdateResultRows(result *GameCenterLeaderboardSetGroupLeaderboardSetUpdateResult) ([]string, [][]string) {
+	headers := []string{"Set ID", "Group Leaderboard Set ID", "Updated"}
+	rows := [][]string{{result.SetID, result.GroupLeaderboardSetID, fmt.Sprintf("%t", result.Updated)}}
+	return headers, rows
+}
+
+func gameCenterAchievementGroupAchievementUpdateResultRows(result *GameCenterAchievementGroupAchievementUpdateResult) ([]string, [][]string) {
+	headers := []string{"Achievement ID", "Group Achievement ID", "Updated"}
+	rows := [][]string{{result.AchievementID, result.GroupAchievementID, fmt.Sprintf("%t", result.Updated)}}
+	return headers, rows
+}
+
 func gameCenterAchievementReleasesRows(resp *GameCenterAchievementReleasesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Live"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -645,20 +663,7 @@ func gameCenterMatchmakingTeamDeleteResultRows(result *GameCenterMatchmakingTeam
 }
 
 func gameCenterMetricsRows(resp *GameCenterMetricsResponse) ([]string, [][]string) {
-	headers := []string{"Start", "End", "Granularity", "Values", "Dimensions"}
-	var rows [][]string
-	for _, item := range resp.Data {
-		for _, point := range item.DataPoints {
-			rows = append(rows, []string{
-				point.Start,
-				point.End,
-				formatMetricGranularity(item.Granularity),
-				formatMetricJSON(point.Values),
-				formatMetricJSON(item.Dimensions),
-			})
-		}
-	}
-	return headers, rows
+	return metricsPivotRows(resp.Data)
 }
 
 func gameCenterMatchmakingRuleSetTestRows(resp *GameCenterMatchmakingRuleSetTestResponse) ([]string, [][]string) {
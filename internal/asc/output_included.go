@@ -0,0 +1,250 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// includedColumnsMaxLabels caps how many related resources are listed in a
+// to-many column before the rest are summarised as "+N more".
+const includedColumnsMaxLabels = 3
+
+// includedLabelAttributes are the attributes tried, in order, to label an
+// included resource in a table column. The resource ID is used otherwise.
+var includedLabelAttributes = []string{
+	"name",
+	"referenceName",
+	"versionString",
+	"version",
+	"bundleId",
+	"locale",
+	"title",
+	"email",
+}
+
+// includedColumnsHandled lists relationships that a type's rows function
+// already renders from Included, so they are not added a second time.
+var includedColumnsHandled = map[reflect.Type][]string{
+	typeForPtr[BuildsResponse](): {"preReleaseVersion"},
+	typeForPtr[BuildResponse]():  {"preReleaseVersion"},
+}
+
+type includedResource struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id"`
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+type includedLinkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// appendIncludedColumns joins resources from a response's Included array onto
+// its table rows: each relationship that resolves to an included resource
+// becomes a column holding that resource's name (or version, locale, ...).
+// Responses without Included, or whose rows do not line up one-to-one with
+// Data, are returned unchanged.
+func appendIncludedColumns(data any, headers []string, rows [][]string) ([]string, [][]string) {
+	resources, included, ok := includedResponseParts(data)
+	if !ok || len(resources) != len(rows) {
+		return headers, rows
+	}
+
+	labels := includedLabels(included)
+	if len(labels) == 0 {
+		return headers, rows
+	}
+
+	handled := map[string]bool{}
+	for _, name := range includedColumnsHandled[reflect.TypeOf(data)] {
+		handled[name] = true
+	}
+
+	values := make([]map[string][]string, len(resources))
+	names := map[string]struct{}{}
+	for i, resource := range resources {
+		values[i] = map[string][]string{}
+		for name, linkages := range resourceRelationshipLinkages(resource) {
+			if handled[name] {
+				continue
+			}
+			for _, linkage := range linkages {
+				if label, ok := labels[linkage.Type+"/"+linkage.ID]; ok {
+					values[i][name] = append(values[i][name], label)
+					names[name] = struct{}{}
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return headers, rows
+	}
+
+	columns := make([]string, 0, len(names))
+	for name := range names {
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+
+	joinedHeaders := append(make([]string, 0, len(headers)+len(columns)), headers...)
+	for _, name := range columns {
+		joinedHeaders = append(joinedHeaders, includedColumnHeader(name))
+	}
+	joinedRows := make([][]string, len(rows))
+	for i, row := range rows {
+		joined := append(make([]string, 0, len(row)+len(columns)), row...)
+		for _, name := range columns {
+			joined = append(joined, includedColumnValue(values[i][name]))
+		}
+		joinedRows[i] = joined
+	}
+	return joinedHeaders, joinedRows
+}
+
+// includedResponseParts returns the Relationships of each resource in a
+// response's Data (a slice or a single resource) along with its Included
+// array.
+func includedResponseParts(data any) ([]json.RawMessage, json.RawMessage, bool) {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return nil, nil, false
+	}
+	value = value.Elem()
+	if value.Kind() != reflect.Struct {
+		return nil, nil, false
+	}
+
+	included, ok := rawJSONField(value, "Included")
+	if !ok || len(included) == 0 {
+		return nil, nil, false
+	}
+	dataField := value.FieldByName("Data")
+	if !dataField.IsValid() {
+		return nil, nil, false
+	}
+
+	var items []reflect.Value
+	switch dataField.Kind() {
+	case reflect.Slice:
+		for i := 0; i < dataField.Len(); i++ {
+			items = append(items, dataField.Index(i))
+		}
+	case reflect.Struct:
+		items = append(items, dataField)
+	default:
+		return nil, nil, false
+	}
+
+	relationships := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		if item.Kind() != reflect.Struct {
+			return nil, nil, false
+		}
+		raw, ok := rawJSONField(item, "Relationships")
+		if !ok {
+			return nil, nil, false
+		}
+		relationships = append(relationships, raw)
+	}
+	return relationships, included, true
+}
+
+// includedLabels maps "type/id" of each included resource to its label.
+func includedLabels(raw json.RawMessage) map[string]string {
+	var resources []includedResource
+	if err := json.Unmarshal(raw, &resources); err != nil {
+		return nil
+	}
+	labels := make(map[string]string, len(resources))
+	for _, resource := range resources {
+		if resource.Type == "" || resource.ID == "" {
+			continue
+		}
+		labels[resource.Type+"/"+resource.ID] = includedResourceLabel(resource)
+	}
+	return labels
+}
+
+func includedResourceLabel(resource includedResource) string {
+	for _, name := range includedLabelAttributes {
+		raw, ok := resource.Attributes[name]
+		if !ok {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			if strings.TrimSpace(v) != "" {
+				return v
+			}
+		case float64:
+			return fmt.Sprint(v)
+		}
+	}
+	return resource.ID
+}
+
+// resourceRelationshipLinkages decodes a resource's relationships into their
+// resource linkages; to-one and to-many relationships are both returned as
+// slices.
+func resourceRelationshipLinkages(raw json.RawMessage) map[string][]includedLinkage {
+	if len(raw) == 0 {
+		return nil
+	}
+	var relationships map[string]struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &relationships); err != nil {
+		return nil
+	}
+
+	linkages := make(map[string][]includedLinkage, len(relationships))
+	for name, relationship := range relationships {
+		data := strings.TrimSpace(string(relationship.Data))
+		switch {
+		case strings.HasPrefix(data, "["):
+			var many []includedLinkage
+			if err := json.Unmarshal(relationship.Data, &many); err == nil {
+				linkages[name] = many
+			}
+		case strings.HasPrefix(data, "{"):
+			var one includedLinkage
+			if err := json.Unmarshal(relationship.Data, &one); err == nil {
+				linkages[name] = []includedLinkage{one}
+			}
+		}
+	}
+	return linkages
+}
+
+// includedColumnHeader turns a relationship name such as "preReleaseVersion"
+// into a column header such as "Pre Release Version".
+func includedColumnHeader(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i == 0 {
+			b.WriteRune(unicode.ToUpper(r))
+			continue
+		}
+		if unicode.IsUpper(r) {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func includedColumnValue(labels []string) string {
+	if len(labels) <= includedColumnsMaxLabels {
+		return strings.Join(labels, ", ")
+	}
+	return fmt.Sprintf("%s, +%d more", strings.Join(labels[:includedColumnsMaxLabels], ", "), len(labels)-includedColumnsMaxLabels)
+}
@@ -0,0 +1,94 @@
+package asc
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAppendIncludedColumns(t *testing.T) {
+	resp := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{
+				Type: "apps",
+				ID:   "app-1",
+				Relationships: json.RawMessage(`{
+					"ciProduct":{"data":{"type":"ciProducts","id":"ci-1"}},
+					"appStoreVersions":{"data":[
+						{"type":"appStoreVersions","id":"v-1"},
+						{"type":"appStoreVersions","id":"v-2"},
+						{"type":"appStoreVersions","id":"v-3"},
+						{"type":"appStoreVersions","id":"v-4"}
+					]}
+				}`),
+			},
+			{
+				Type:          "apps",
+				ID:            "app-2",
+				Relationships: json.RawMessage(`{"appStoreVersions":{"data":[{"type":"appStoreVersions","id":"v-5"}]}}`),
+			},
+		},
+		Included: json.RawMessage(`[
+			{"type":"ciProducts","id":"ci-1","attributes":{"name":"Main"}},
+			{"type":"appStoreVersions","id":"v-1","attributes":{"versionString":"1.0"}},
+			{"type":"appStoreVersions","id":"v-2","attributes":{"versionString":"1.1"}},
+			{"type":"appStoreVersions","id":"v-3","attributes":{"versionString":"1.2"}},
+			{"type":"appStoreVersions","id":"v-4","attributes":{"versionString":"2.0"}},
+			{"type":"appStoreVersions","id":"v-5","attributes":{}}
+		]`),
+	}
+
+	headers, rows := appendIncludedColumns(resp, []string{"ID"}, [][]string{{"app-1"}, {"app-2"}})
+
+	wantHeaders := []string{"ID", "App Store Versions", "Ci Product"}
+	if !reflect.DeepEqual(headers, wantHeaders) {
+		t.Fatalf("headers = %v, want %v", headers, wantHeaders)
+	}
+	wantRows := [][]string{
+		{"app-1", "1.0, 1.1, 1.2, +1 more", "Main"},
+		{"app-2", "v-5", ""},
+	}
+	if !reflect.DeepEqual(rows, wantRows) {
+		t.Fatalf("rows = %v, want %v", rows, wantRows)
+	}
+}
+
+func TestAppendIncludedColumnsSingleResource(t *testing.T) {
+	resp := &AppClipResponse{
+		Data: Resource[AppClipAttributes]{
+			Type:          "appClips",
+			ID:            "clip-1",
+			Relationships: json.RawMessage(`{"app":{"data":{"type":"apps","id":"app-1"}}}`),
+		},
+		Included: json.RawMessage(`[{"type":"apps","id":"app-1","attributes":{"name":"Demo"}}]`),
+	}
+
+	headers, rows := appendIncludedColumns(resp, []string{"ID"}, [][]string{{"clip-1"}})
+	if !reflect.DeepEqual(headers, []string{"ID", "App"}) || !reflect.DeepEqual(rows, [][]string{{"clip-1", "Demo"}}) {
+		t.Fatalf("unexpected table: headers=%v rows=%v", headers, rows)
+	}
+}
+
+func TestAppendIncludedColumnsSkipsHandledAndUnmatched(t *testing.T) {
+	resp := &BuildsResponse{
+		Data: []Resource[BuildAttributes]{
+			{
+				Type:          "builds",
+				ID:            "build-1",
+				Relationships: json.RawMessage(`{"preReleaseVersion":{"data":{"type":"preReleaseVersions","id":"prv-1"}},"app":{"data":{"type":"apps","id":"app-1"}}}`),
+			},
+		},
+		Included: json.RawMessage(`[{"type":"preReleaseVersions","id":"prv-1","attributes":{"version":"1.0"}}]`),
+	}
+
+	headers, rows := appendIncludedColumns(resp, []string{"ID"}, [][]string{{"build-1"}})
+	if !reflect.DeepEqual(headers, []string{"ID"}) || !reflect.DeepEqual(rows, [][]string{{"build-1"}}) {
+		t.Fatalf("expected table unchanged, got headers=%v rows=%v", headers, rows)
+	}
+
+	// Rows that do not line up with Data are left alone.
+	headers, rows = appendIncludedColumns(resp, []string{"ID"}, [][]string{{"a"}, {"b"}})
+	if len(headers) != 1 || len(rows) != 2 {
+		t.Fatalf("expected table unchanged, got headers=%v rows=%v", headers, rows)
+	}
+}
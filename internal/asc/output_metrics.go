@@ -0,0 +1,188 @@
+package asc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// metricsCSVLeadingColumns are written before the sorted dimensions.* and
+// values.* columns in metrics CSV output.
+var metricsCSVLeadingColumns = []string{"start", "end", "granularity"}
+
+type metricsBucket struct {
+	start       string
+	end         string
+	granularity string
+}
+
+// metricsPivotRows renders metrics series as one row per time bucket and one
+// column per value (and group-by combination, when dimensions are present).
+func metricsPivotRows(series []GameCenterMetricsData) ([]string, [][]string) {
+	var buckets []metricsBucket
+	bucketIndex := map[metricsBucket]int{}
+	type columnKey struct {
+		group string
+		value string
+	}
+	columnSeen := map[columnKey]bool{}
+	var columns []columnKey
+	cells := map[metricsBucket]map[columnKey]string{}
+
+	for _, item := range series {
+		group := formatMetricDimensions(item.Dimensions)
+		granularity := formatMetricGranularity(item.Granularity)
+		for _, point := range item.DataPoints {
+			bucket := metricsBucket{start: point.Start, end: point.End, granularity: granularity}
+			if _, ok := bucketIndex[bucket]; !ok {
+				bucketIndex[bucket] = len(buckets)
+				buckets = append(buckets, bucket)
+				cells[bucket] = map[columnKey]string{}
+			}
+			for name, value := range point.Values {
+				key := columnKey{group: group, value: name}
+				if !columnSeen[key] {
+					columnSeen[key] = true
+					columns = append(columns, key)
+				}
+				cells[bucket][key] = formatMetricValue(value)
+			}
+		}
+	}
+
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if buckets[i].start != buckets[j].start {
+			return buckets[i].start < buckets[j].start
+		}
+		return buckets[i].end < buckets[j].end
+	})
+	sort.SliceStable(columns, func(i, j int) bool {
+		if columns[i].group != columns[j].group {
+			return columns[i].group < columns[j].group
+		}
+		return columns[i].value < columns[j].value
+	})
+
+	headers := []string{"Start", "End", "Granularity"}
+	for _, column := range columns {
+		if column.group == "" {
+			headers = append(headers, column.value)
+			continue
+		}
+		headers = append(headers, fmt.Sprintf("%s (%s)", column.value, column.group))
+	}
+
+	rows := make([][]string, 0, len(buckets))
+	for _, bucket := range buckets {
+		row := []string{bucket.start, bucket.end, bucket.granularity}
+		for _, column := range columns {
+			row = append(row, cells[bucket][column])
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+// formatMetricDimensions flattens group-by dimensions into a stable
+// "name=value" label. Relationship dimensions use the related resource ID.
+func formatMetricDimensions(dimensions map[string]GameCenterMetricsDimension) string {
+	if len(dimensions) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(dimensions))
+	for name := range dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		value := formatMetricDimensionValue(dimensions[name].Data)
+		if value == "" {
+			continue
+		}
+		parts = append(parts, name+"="+value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatMetricDimensionValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case map[string]any:
+		if id, ok := typed["id"].(string); ok {
+			return id
+		}
+		return formatMetricJSON(typed)
+	default:
+		return formatMetricValue(typed)
+	}
+}
+
+func formatMetricValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		return formatMetricJSON(typed)
+	}
+}
+
+func metricsResponseForCSV(data any) (*GameCenterMetricsResponse, bool) {
+	switch typed := data.(type) {
+	case *GameCenterMetricsResponse:
+		return ptrOrZero(typed), true
+	case GameCenterMetricsResponse:
+		return &typed, true
+	default:
+		return nil, false
+	}
+}
+
+// metricsCSVRecords flattens metrics into one record per data point, with
+// ISO-8601 timestamps and dimensions.<name> / values.<name> columns, so the
+// output can be loaded directly into time-series tools.
+func metricsCSVRecords(series []GameCenterMetricsData) []map[string]string {
+	var records []map[string]string
+	for _, item := range series {
+		granularity := formatMetricGranularity(item.Granularity)
+		for _, point := range item.DataPoints {
+			record := map[string]string{
+				"start":       formatMetricTimestamp(point.Start),
+				"end":         formatMetricTimestamp(point.End),
+				"granularity": granularity,
+			}
+			for name, dimension := range item.Dimensions {
+				record["dimensions."+name] = formatMetricDimensionValue(dimension.Data)
+			}
+			for name, value := range point.Values {
+				record["values."+name] = formatMetricValue(value)
+			}
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// formatMetricTimestamp normalizes API timestamps (full RFC 3339 or bare
+// dates) to RFC 3339 in UTC. Unrecognized values are returned unchanged.
+func formatMetricTimestamp(value string) string {
+	trimmed := strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if parsed, err := time.Parse(layout, trimmed); err == nil {
+			return parsed.UTC().Format(time.RFC3339)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,121 @@
+package asc
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func decodeMetricsResponse(t *testing.T, body string) *GameCenterMetricsResponse {
+	t.Helper()
+
+	var resp GameCenterMetricsResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode metrics: %v", err)
+	}
+	return &resp
+}
+
+func TestMetricsPivotRows_PivotsBucketsAndGroups(t *testing.T) {
+	resp := decodeMetricsResponse(t, `{"data":[
+		{"granularity":"P1D","dimensions":{"result":{"data":{"type":"results","id":"MATCHED"}}},"dataPoints":[
+			{"start":"2026-01-02T00:00:00Z","end":"2026-01-03T00:00:00Z","values":{"count":7}},
+			{"start":"2026-01-01T00:00:00Z","end":"2026-01-02T00:00:00Z","values":{"count":4}}
+		]},
+		{"granularity":"P1D","dimensions":{"result":{"data":"TIMED_OUT"}},"dataPoints":[
+			{"start":"2026-01-01T00:00:00Z","end":"2026-01-02T00:00:00Z","values":{"count":1.5}}
+		]}
+	]}`)
+
+	headers, rows := metricsPivotRows(resp.Data)
+
+	wantHeaders := []string{"Start", "End", "Granularity", "count (result=MATCHED)", "count (result=TIMED_OUT)"}
+	if !reflect.DeepEqual(headers, wantHeaders) {
+		t.Fatalf("expected headers %v, got %v", wantHeaders, headers)
+	}
+	wantRows := [][]string{
+		{"2026-01-01T00:00:00Z", "2026-01-02T00:00:00Z", "P1D", "4", "1.5"},
+		{"2026-01-02T00:00:00Z", "2026-01-03T00:00:00Z", "P1D", "7", ""},
+	}
+	if !reflect.DeepEqual(rows, wantRows) {
+		t.Fatalf("expected rows %v, got %v", wantRows, rows)
+	}
+}
+
+func TestMetricsPivotRows_UngroupedValuesBecomeColumns(t *testing.T) {
+	resp := decodeMetricsResponse(t, `{"data":[
+		{"granularity":"PT1H","dataPoints":[
+			{"start":"2026-01-01T00:00:00Z","end":"2026-01-01T01:00:00Z","values":{"count":10,"averageSecondsInQueue":2.25}}
+		]}
+	]}`)
+
+	headers, rows := metricsPivotRows(resp.Data)
+
+	wantHeaders := []string{"Start", "End", "Granularity", "averageSecondsInQueue", "count"}
+	if !reflect.DeepEqual(headers, wantHeaders) {
+		t.Fatalf("expected headers %v, got %v", wantHeaders, headers)
+	}
+	if len(rows) != 1 || rows[0][3] != "2.25" || rows[0][4] != "10" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+}
+
+func TestPrintMarkdown_GameCenterMetrics(t *testing.T) {
+	resp := decodeMetricsResponse(t, `{"data":[
+		{"granularity":"P1D","dataPoints":[{"start":"2026-01-01","end":"2026-01-02","values":{"count":3}}]}
+	]}`)
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(resp)
+	})
+
+	for _, want := range []string{"Granularity", "count", "P1D"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+	if strings.Contains(output, "Values") || strings.Contains(output, "Dimensions") {
+		t.Fatalf("expected pivoted columns instead of raw JSON, got: %s", output)
+	}
+}
+
+func TestPrintCSV_MetricsFlattensDataPointsWithISOTimestamps(t *testing.T) {
+	resp := decodeMetricsResponse(t, `{"data":[
+		{"granularity":"P1D","dimensions":{"result":{"data":{"type":"results","id":"MATCHED"}}},"dataPoints":[
+			{"start":"2026-01-01","end":"2026-01-02","values":{"count":4,"averageSecondsInQueue":1.5}}
+		]},
+		{"granularity":"P1D","dimensions":{"result":{"data":"TIMED_OUT"}},"dataPoints":[
+			{"start":"2026-01-01T00:00:00-08:00","end":"2026-01-02T00:00:00-08:00","values":{"count":1}}
+		]}
+	]}`)
+
+	output := captureStdout(t, func() error { return PrintCSV(resp, nil) })
+	records := readCSVOutput(t, output)
+
+	wantHeader := "start,end,granularity,dimensions.result,values.averageSecondsInQueue,values.count"
+	if got := strings.Join(records[0], ","); got != wantHeader {
+		t.Fatalf("expected header %q, got %q", wantHeader, got)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected header + 2 rows, got %v", records)
+	}
+	if got := strings.Join(records[1], ","); got != "2026-01-01T00:00:00Z,2026-01-02T00:00:00Z,P1D,MATCHED,1.5,4" {
+		t.Fatalf("unexpected first row: %q", got)
+	}
+	if got := strings.Join(records[2], ","); got != "2026-01-01T08:00:00Z,2026-01-02T08:00:00Z,P1D,TIMED_OUT,,1" {
+		t.Fatalf("unexpected second row: %q", got)
+	}
+}
+
+func TestPrintCSV_MetricsSelectsColumns(t *testing.T) {
+	resp := decodeMetricsResponse(t, `{"data":[
+		{"dataPoints":[{"start":"2026-01-01T00:00:00Z","end":"2026-01-01T01:00:00Z","values":{"count":2}}]}
+	]}`)
+
+	output := captureStdout(t, func() error { return PrintCSV(resp, []string{"start", "values.count"}) })
+	records := readCSVOutput(t, output)
+	if len(records) != 2 || strings.Join(records[1], ",") != "2026-01-01T00:00:00Z,2" {
+		t.Fatalf("unexpected csv: %v", records)
+	}
+}
@@ -0,0 +1,23 @@
+package asc
+
+// NotificationsConfigResult is the App Store Server Notifications setup for an app.
+type NotificationsConfigResult struct {
+	AppID        string                           `json:"appId"`
+	Environments []NotificationsConfigEnvironment `json:"environments"`
+}
+
+// NotificationsConfigEnvironment is the notification endpoint for one environment.
+type NotificationsConfigEnvironment struct {
+	Environment string `json:"environment"`
+	URL         string `json:"url,omitempty"`
+	Version     string `json:"version,omitempty"`
+}
+
+func notificationsConfigResultRows(result *NotificationsConfigResult) ([]string, [][]string) {
+	headers := []string{"Environment", "URL", "Version"}
+	rows := make([][]string, 0, len(result.Environments))
+	for _, item := range result.Environments {
+		rows = append(rows, []string{item.Environment, item.URL, item.Version})
+	}
+	return headers, rows
+}
@@ -3,6 +3,8 @@ package asc
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 )
 
 // PerformanceDownloadResult represents CLI output for performance downloads.
@@ -18,6 +20,119 @@ type PerformanceDownloadResult struct {
 	DecompressedSize      int64  `json:"decompressedSize,omitempty"`
 }
 
+// PerfPowerMetricRegressionsResult is the per-device regression summary
+// decoded from a perfPowerMetrics insights payload.
+type PerfPowerMetricRegressionsResult struct {
+	AppID       string                      `json:"appId,omitempty"`
+	BuildID     string                      `json:"buildId,omitempty"`
+	Version     string                      `json:"version,omitempty"`
+	Regressions []PerfPowerMetricRegression `json:"regressions"`
+}
+
+// PerfPowerMetricRegression is one regressed metric for one device class and percentile.
+type PerfPowerMetricRegression struct {
+	MetricCategory        string   `json:"metricCategory"`
+	Metric                string   `json:"metric"`
+	LatestVersion         string   `json:"latestVersion,omitempty"`
+	ReferenceVersions     string   `json:"referenceVersions,omitempty"`
+	Device                string   `json:"device,omitempty"`
+	Percentile            string   `json:"percentile,omitempty"`
+	ReferenceAverageValue *float64 `json:"referenceAverageValue,omitempty"`
+	LatestVersionValue    *float64 `json:"latestVersionValue,omitempty"`
+	DeltaPercentage       *float64 `json:"deltaPercentage,omitempty"`
+	HighImpact            bool     `json:"highImpact"`
+	Summary               string   `json:"summary,omitempty"`
+}
+
+type perfPowerMetricInsight struct {
+	MetricCategory    string `json:"metricCategory"`
+	Metric            string `json:"metric"`
+	LatestVersion     string `json:"latestVersion"`
+	ReferenceVersions string `json:"referenceVersions"`
+	SummaryString     string `json:"summaryString"`
+	HighImpact        bool   `json:"highImpact"`
+	Populations       []struct {
+		Device                string   `json:"device"`
+		Percentile            string   `json:"percentile"`
+		ReferenceAverageValue *float64 `json:"referenceAverageValue"`
+		LatestVersionValue    *float64 `json:"latestVersionValue"`
+		DeltaPercentage       *float64 `json:"deltaPercentage"`
+		SummaryString         string   `json:"summaryString"`
+	} `json:"populations"`
+}
+
+// SummarizePerfPowerMetricRegressions decodes the regression insights in a
+// perfPowerMetrics payload into one entry per device class and percentile,
+// largest regression first.
+func SummarizePerfPowerMetricRegressions(resp *PerfPowerMetricsResponse) (*PerfPowerMetricRegressionsResult, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("perf power metrics response is nil")
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("perf power metrics response is empty")
+	}
+
+	var payload struct {
+		Version  string `json:"version"`
+		Insights struct {
+			Regressions []perfPowerMetricInsight `json:"regressions"`
+		} `json:"insights"`
+	}
+	if err := json.Unmarshal(resp.Data, &payload); err != nil {
+		return nil, fmt.Errorf("decode perf power metrics: %w", err)
+	}
+
+	result := &PerfPowerMetricRegressionsResult{
+		Version:     payload.Version,
+		Regressions: []PerfPowerMetricRegression{},
+	}
+	for _, insight := range payload.Insights.Regressions {
+		base := PerfPowerMetricRegression{
+			MetricCategory:    insight.MetricCategory,
+			Metric:            insight.Metric,
+			LatestVersion:     insight.LatestVersion,
+			ReferenceVersions: insight.ReferenceVersions,
+			HighImpact:        insight.HighImpact,
+			Summary:           insight.SummaryString,
+		}
+		if len(insight.Populations) == 0 {
+			result.Regressions = append(result.Regressions, base)
+			continue
+		}
+		for _, population := range insight.Populations {
+			entry := base
+			entry.Device = population.Device
+			entry.Percentile = population.Percentile
+			entry.ReferenceAverageValue = population.ReferenceAverageValue
+			entry.LatestVersionValue = population.LatestVersionValue
+			entry.DeltaPercentage = population.DeltaPercentage
+			if population.SummaryString != "" {
+				entry.Summary = population.SummaryString
+			}
+			result.Regressions = append(result.Regressions, entry)
+		}
+	}
+	sort.SliceStable(result.Regressions, func(i, j int) bool {
+		return optionalFloat(result.Regressions[i].DeltaPercentage) > optionalFloat(result.Regressions[j].DeltaPercentage)
+	})
+
+	return result, nil
+}
+
+func optionalFloat(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func formatOptionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', 2, 64)
+}
+
 type perfPowerMetricsSummary struct {
 	Version         string
 	ProductCount    int
@@ -109,6 +224,25 @@ func perfPowerMetricsRows(resp *PerfPowerMetricsResponse) ([]string, [][]string,
 	return headers, rows, nil
 }
 
+func perfPowerMetricRegressionsRows(result *PerfPowerMetricRegressionsResult) ([]string, [][]string) {
+	headers := []string{"Category", "Metric", "Device", "Percentile", "Reference", "Latest", "Change %", "High Impact", "Latest Version"}
+	rows := make([][]string, 0, len(result.Regressions))
+	for _, item := range result.Regressions {
+		rows = append(rows, []string{
+			item.MetricCategory,
+			item.Metric,
+			item.Device,
+			item.Percentile,
+			formatOptionalFloat(item.ReferenceAverageValue),
+			formatOptionalFloat(item.LatestVersionValue),
+			formatOptionalFloat(item.DeltaPercentage),
+			fmt.Sprintf("%t", item.HighImpact),
+			item.LatestVersion,
+		})
+	}
+	return headers, rows
+}
+
 func diagnosticSignaturesRows(resp *DiagnosticSignaturesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Type", "Weight", "Insight", "Signature"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -0,0 +1,74 @@
+package asc
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSummarizePerfPowerMetricRegressions_FlattensPopulations(t *testing.T) {
+	resp := &PerfPowerMetricsResponse{Data: json.RawMessage(`{
+		"version": "1.0",
+		"insights": {
+			"trendingUp": [{"metricCategory": "MEMORY", "metric": "peakMemory"}],
+			"regressions": [
+				{
+					"metricCategory": "LAUNCH",
+					"metric": "launchTime",
+					"latestVersion": "2.1",
+					"referenceVersions": "1.9, 2.0",
+					"summaryString": "Launch time regressed",
+					"highImpact": true,
+					"populations": [
+						{"device": "all_iphones", "percentile": "p50", "referenceAverageValue": 1.2, "latestVersionValue": 1.5, "deltaPercentage": 25},
+						{"device": "iPhone15,2", "percentile": "p90", "referenceAverageValue": 2, "latestVersionValue": 3, "deltaPercentage": 50, "summaryString": "p90 launch regressed"}
+					]
+				},
+				{"metricCategory": "DISK", "metric": "logicalWrites", "latestVersion": "2.1"}
+			]
+		},
+		"productData": []
+	}`)}
+
+	result, err := SummarizePerfPowerMetricRegressions(resp)
+	if err != nil {
+		t.Fatalf("SummarizePerfPowerMetricRegressions() error: %v", err)
+	}
+	if result.Version != "1.0" {
+		t.Fatalf("expected version 1.0, got %q", result.Version)
+	}
+
+	headers, rows := perfPowerMetricRegressionsRows(result)
+	wantHeaders := []string{"Category", "Metric", "Device", "Percentile", "Reference", "Latest", "Change %", "High Impact", "Latest Version"}
+	if !reflect.DeepEqual(headers, wantHeaders) {
+		t.Fatalf("expected headers %v, got %v", wantHeaders, headers)
+	}
+	wantRows := [][]string{
+		{"LAUNCH", "launchTime", "iPhone15,2", "p90", "2.00", "3.00", "50.00", "true", "2.1"},
+		{"LAUNCH", "launchTime", "all_iphones", "p50", "1.20", "1.50", "25.00", "true", "2.1"},
+		{"DISK", "logicalWrites", "", "", "", "", "", "false", "2.1"},
+	}
+	if !reflect.DeepEqual(rows, wantRows) {
+		t.Fatalf("expected rows %v, got %v", wantRows, rows)
+	}
+	if got := result.Regressions[0].Summary; got != "p90 launch regressed" {
+		t.Fatalf("expected population summary, got %q", got)
+	}
+	if got := result.Regressions[1].Summary; got != "Launch time regressed" {
+		t.Fatalf("expected insight summary fallback, got %q", got)
+	}
+}
+
+func TestSummarizePerfPowerMetricRegressions_NoRegressions(t *testing.T) {
+	result, err := SummarizePerfPowerMetricRegressions(&PerfPowerMetricsResponse{Data: json.RawMessage(`{"version":"1.0","insights":{}}`)})
+	if err != nil {
+		t.Fatalf("SummarizePerfPowerMetricRegressions() error: %v", err)
+	}
+	if result.Regressions == nil || len(result.Regressions) != 0 {
+		t.Fatalf("expected empty non-nil regressions, got %#v", result.Regressions)
+	}
+
+	if _, err := SummarizePerfPowerMetricRegressions(&PerfPowerMetricsResponse{}); err == nil {
+		t.Fatal("expected error for empty payload")
+	}
+}
@@ -341,6 +341,7 @@ func registerDirect[T any](fn func(*T, func([]string, [][]string)) error) {
 
 // renderByRegistry looks up the rows function for the given value and renders
 // using the provided render function (RenderTable or RenderMarkdown).
+// Included resources are joined onto the rows as extra columns.
 // Falls back to JSON output for unregistered types.
 func renderByRegistry(data any, render func([]string, [][]string)) error {
 	t := reflect.TypeOf(data)
@@ -356,6 +357,7 @@ func renderByRegistry(data any, render func([]string, [][]string)) error {
 		if err != nil {
 			return err
 		}
+		h, r = appendIncludedColumns(data, h, r)
 		render(h, r)
 		return nil
 	}
@@ -4,6 +4,7 @@ package asc
 func init() {
 	registerRows(feedbackRows)
 	registerRows(crashesRows)
+	registerRows(betaFeedbackDownloadResultRows)
 	registerRowsWithSingleResourceAdapter(reviewsRows)
 	registerRows(customerReviewSummarizationsRows)
 	registerRowsWithSingleResourceAdapter(appsRows)
@@ -247,6 +248,7 @@ func init() {
 	registerRows(analyticsReportRequestDeleteResultRows)
 	registerRowsWithSingleToListAdapter[AnalyticsReportRequestResponse, AnalyticsReportRequestsResponse](analyticsReportRequestsRows)
 	registerRows(analyticsReportDownloadResultRows)
+	registerRows(analyticsReportSegmentsDownloadResultRows)
 	registerRows(analyticsReportGetResultRows)
 	registerRowsWithSingleToListAdapter[AnalyticsReportResponse, AnalyticsReportsResponse](analyticsReportsRows)
 	registerRowsWithSingleToListAdapter[AnalyticsReportInstanceResponse, AnalyticsReportInstancesResponse](analyticsReportInstancesRows)
@@ -254,6 +256,7 @@ func init() {
 	registerRows(appStoreVersionSubmissionRows)
 	registerRows(appStoreVersionSubmissionCreateRows)
 	registerRows(appStoreVersionSubmissionStatusRows)
+	registerRows(reviewSubmissionWatchRows)
 	registerRows(appStoreVersionSubmissionCancelRows)
 	registerRows(appStoreVersionDetailRows)
 	registerRows(appStoreVersionAttachBuildRows)
@@ -284,6 +287,9 @@ func init() {
 	registerRows(gameCenterLeaderboardLocalizationDeleteResultRows)
 	registerRowsWithSingleResourceAdapter(gameCenterLeaderboardReleasesRows)
 	registerRows(gameCenterLeaderboardReleaseDeleteResultRows)
+	registerRows(gameCenterLeaderboardGroupLeaderboardUpdateResultRows)
+	registerRows(gameCenterLeaderboardSetGroupLeaderboardSetUpdateResultRows)
+	registerRows(gameCenterAchievementGroupAchievementUpdateResultRows)
 	registerRows(gameCenterLeaderboardEntrySubmissionRows)
 	registerRows(gameCenterPlayerAchievementSubmissionRows)
 	registerRowsWithSingleResourceAdapter(gameCenterLeaderboardSetReleasesRows)
@@ -359,10 +365,12 @@ func init() {
 	registerRows(passTypeIDDeleteResultRows)
 	registerRows(bundleIDCapabilityDeleteResultRows)
 	registerRows(certificateRevokeResultRows)
+	registerRows(certificateDownloadResultRows)
 	registerRows(profileDeleteResultRows)
 	registerRows(endUserLicenseAgreementRows)
 	registerRows(endUserLicenseAgreementDeleteResultRows)
 	registerRows(profileDownloadResultRows)
+	registerRows(profileRegenerateResultRows)
 	registerRows(signingFetchResultRows)
 	registerRows(xcodeCloudRunResultRows)
 	registerRows(xcodeCloudStatusResultRows)
@@ -411,6 +419,9 @@ func init() {
 	registerRows(appStoreVersionExperimentTreatmentDeleteResultRows)
 	registerRows(appStoreVersionExperimentTreatmentLocalizationDeleteResultRows)
 	registerRowsErr(perfPowerMetricsRows)
+	registerRows(perfPowerMetricRegressionsRows)
+	registerRows(notificationsConfigResultRows)
+	registerDirect(renderBulkResult)
 	registerRows(diagnosticSignaturesRows)
 	registerRowsErr(diagnosticLogsRows)
 	registerRows(performanceDownloadResultRows)
@@ -380,3 +380,22 @@ func TestPrintTableAndMarkdown_BuildUploadResultIncludesOperations(t *testing.T)
 		})
 	}
 }
+
+func TestRenderTable_ASCIIMode(t *testing.T) {
+	SetTableASCII(true)
+	t.Cleanup(func() { SetTableASCII(false) })
+
+	output := captureStdout(t, func() error {
+		RenderTable([]string{"ID", "Name"}, [][]string{{"1", "Demo"}})
+		return nil
+	})
+
+	if !strings.Contains(output, "+") || !strings.Contains(output, "|") {
+		t.Fatalf("expected ASCII borders, got: %s", output)
+	}
+	for _, glyph := range []string{"┌", "│", "─"} {
+		if strings.Contains(output, glyph) {
+			t.Fatalf("expected no box-drawing glyph %q, got: %s", glyph, output)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package asc
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // AppStoreVersionSubmissionResult represents CLI output for submissions.
 type AppStoreVersionSubmissionResult struct {
@@ -26,6 +29,36 @@ type AppStoreVersionSubmissionStatusResult struct {
 	CreatedDate   *string `json:"createdDate,omitempty"`
 }
 
+// ReviewSubmissionWatchResult represents CLI output for submissions watch.
+type ReviewSubmissionWatchResult struct {
+	AppID           string                            `json:"appId"`
+	SubmissionID    string                            `json:"submissionId,omitempty"`
+	SubmissionState string                            `json:"submissionState,omitempty"`
+	VersionID       string                            `json:"versionId"`
+	VersionString   string                            `json:"versionString,omitempty"`
+	Platform        string                            `json:"platform,omitempty"`
+	VersionState    string                            `json:"versionState,omitempty"`
+	Outcome         string                            `json:"outcome"`
+	Elapsed         string                            `json:"elapsed"`
+	Transitions     []ReviewSubmissionWatchTransition `json:"transitions"`
+	Messages        []ReviewSubmissionWatchMessage    `json:"messages,omitempty"`
+}
+
+// ReviewSubmissionWatchTransition is a state change observed by submissions watch.
+type ReviewSubmissionWatchTransition struct {
+	Subject    string `json:"subject"` // version or submission
+	From       string `json:"from,omitempty"`
+	To         string `json:"to"`
+	ObservedAt string `json:"observedAt"`
+}
+
+// ReviewSubmissionWatchMessage is an App Review message from the Resolution Center.
+type ReviewSubmissionWatchMessage struct {
+	ID          string `json:"id"`
+	CreatedDate string `json:"createdDate,omitempty"`
+	Body        string `json:"body"`
+}
+
 // AppStoreVersionSubmissionCancelResult represents CLI output for submission cancellation.
 type AppStoreVersionSubmissionCancelResult struct {
 	ID        string `json:"id"`
@@ -59,6 +92,8 @@ type AppStoreVersionAttachBuildResult struct {
 	VersionID string `json:"versionId"`
 	BuildID   string `json:"buildId"`
 	Attached  bool   `json:"attached"`
+	// UsesNonExemptEncryption is the export compliance answer set on the build, if any.
+	UsesNonExemptEncryption *bool `json:"usesNonExemptEncryption,omitempty"`
 }
 
 // AppStoreVersionReleaseRequestResult represents CLI output for release requests.
@@ -129,6 +164,32 @@ func appStoreVersionSubmissionStatusRows(result *AppStoreVersionSubmissionStatus
 	return headers, rows
 }
 
+func reviewSubmissionWatchRows(result *ReviewSubmissionWatchResult) ([]string, [][]string) {
+	headers := []string{"Submission ID", "Version ID", "Version", "Platform", "Submission State", "Version State", "Outcome", "History", "Messages"}
+	var history []string
+	for _, transition := range result.Transitions {
+		if transition.Subject != "version" {
+			continue
+		}
+		if len(history) == 0 && transition.From != "" {
+			history = append(history, transition.From)
+		}
+		history = append(history, transition.To)
+	}
+	rows := [][]string{{
+		result.SubmissionID,
+		result.VersionID,
+		result.VersionString,
+		result.Platform,
+		result.SubmissionState,
+		result.VersionState,
+		result.Outcome,
+		strings.Join(history, " -> "),
+		fmt.Sprintf("%d", len(result.Messages)),
+	}}
+	return headers, rows
+}
+
 func appStoreVersionSubmissionCancelRows(result *AppStoreVersionSubmissionCancelResult) ([]string, [][]string) {
 	headers := []string{"Submission ID", "Cancelled"}
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Cancelled)}}
@@ -9,6 +9,9 @@ type TestFlightPublishResult struct {
 	Uploaded        bool     `json:"uploaded"`
 	ProcessingState string   `json:"processingState,omitempty"`
 	Notified        bool     `json:"notified,omitempty"`
+	// UsesNonExemptEncryption is the export compliance answer set on the build, if any.
+	UsesNonExemptEncryption *bool  `json:"usesNonExemptEncryption,omitempty"`
+	WhatsNewLocale          string `json:"whatsNewLocale,omitempty"`
 }
 
 // AppStorePublishResult captures the App Store publish workflow output.
@@ -19,6 +22,8 @@ type AppStorePublishResult struct {
 	Uploaded     bool   `json:"uploaded"`
 	Attached     bool   `json:"attached"`
 	Submitted    bool   `json:"submitted"`
+	// UsesNonExemptEncryption is the export compliance answer set on the build, if any.
+	UsesNonExemptEncryption *bool `json:"usesNonExemptEncryption,omitempty"`
 }
 
 // Build processing states to poll for.
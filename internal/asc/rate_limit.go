@@ -0,0 +1,203 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitEnv = "ASC_RATE_LIMIT"
+
+	// rateLimitHeader carries the hourly request budget for the API key,
+	// e.g. "user-hour-lim:3600;user-hour-rem:3542;".
+	rateLimitHeader = "X-Rate-Limit"
+
+	// rateLimitLowBudgetFraction is the share of the hourly budget left at
+	// which paginated runs warn that they may start hitting 429 responses.
+	rateLimitLowBudgetFraction = 0.1
+)
+
+var rateLimiter struct {
+	sync.Mutex
+	override *float64
+	rate     float64
+	bucket   *tokenBucket
+}
+
+var rateLimitBudget struct {
+	sync.Mutex
+	known     bool
+	limit     int
+	remaining int
+	warned    bool
+}
+
+// SetRateLimitOverride sets an explicit client-side request rate in requests
+// per second (0 disables throttling). When set, it takes precedence over env.
+// When unset (nil), behavior falls back to ASC_RATE_LIMIT.
+func SetRateLimitOverride(value *float64) {
+	rateLimiter.Lock()
+	defer rateLimiter.Unlock()
+	rateLimiter.override = value
+}
+
+// ParseRateLimit parses a requests-per-second value such as "5" or "0.5".
+// Zero disables throttling.
+func ParseRateLimit(raw string) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil || value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 0, fmt.Errorf("must be a non-negative number of requests per second")
+	}
+	return value, nil
+}
+
+// resolveRateLimit returns the configured requests per second.
+// Precedence: explicit override > env. Invalid env values disable throttling.
+func resolveRateLimit() float64 {
+	rateLimiter.Lock()
+	override := rateLimiter.override
+	rateLimiter.Unlock()
+	if override != nil {
+		return *override
+	}
+	if raw, ok := envValue(rateLimitEnv); ok && raw != "" {
+		if value, err := ParseRateLimit(raw); err == nil {
+			return value
+		}
+	}
+	return 0
+}
+
+// waitForRateLimit blocks until the shared token bucket allows another
+// request. The bucket is process-wide so concurrent workers and clients share
+// one budget.
+func waitForRateLimit(ctx context.Context) error {
+	rate := resolveRateLimit()
+	if rate <= 0 {
+		return nil
+	}
+
+	rateLimiter.Lock()
+	if rateLimiter.bucket == nil || rateLimiter.rate != rate {
+		rateLimiter.bucket = newTokenBucket(rate)
+		rateLimiter.rate = rate
+	}
+	bucket := rateLimiter.bucket
+	rateLimiter.Unlock()
+
+	return bucket.wait(ctx)
+}
+
+// tokenBucket is a token-bucket limiter. Callers reserve a token up front so
+// concurrent waiters are spaced out instead of waking together.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Floor(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+	}
+}
+
+// reserve takes one token and returns how long the caller must wait before
+// using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// observeRateLimitHeader records the hourly budget reported by App Store
+// Connect so long-running operations can warn before it runs out.
+func observeRateLimitHeader(header http.Header) {
+	limit, remaining, ok := parseRateLimitHeader(header.Get(rateLimitHeader))
+	if !ok {
+		return
+	}
+	rateLimitBudget.Lock()
+	defer rateLimitBudget.Unlock()
+	rateLimitBudget.known = true
+	rateLimitBudget.limit = limit
+	rateLimitBudget.remaining = remaining
+}
+
+// parseRateLimitHeader parses "user-hour-lim:3600;user-hour-rem:3542;".
+func parseRateLimitHeader(value string) (limit, remaining int, ok bool) {
+	var haveLimit, haveRemaining bool
+	for _, part := range strings.Split(value, ";") {
+		key, raw, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			continue
+		}
+		parsed, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || parsed < 0 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "user-hour-lim":
+			limit, haveLimit = parsed, true
+		case "user-hour-rem":
+			remaining, haveRemaining = parsed, true
+		}
+	}
+	return limit, remaining, haveLimit && haveRemaining && limit > 0
+}
+
+// warnIfRateLimitBudgetLow prints a one-time warning when the last observed
+// hourly budget is nearly exhausted. Pagination calls it between pages.
+func warnIfRateLimitBudgetLow() {
+	rateLimitBudget.Lock()
+	defer rateLimitBudget.Unlock()
+	if !rateLimitBudget.known || rateLimitBudget.warned {
+		return
+	}
+	if float64(rateLimitBudget.remaining) > float64(rateLimitBudget.limit)*rateLimitLowBudgetFraction {
+		return
+	}
+	rateLimitBudget.warned = true
+	fmt.Fprintf(warningWriter(),
+		"Warning: App Store Connect rate limit nearly exhausted (%d of %d requests left this hour); further pages may be throttled. Use --rate-limit to slow requests.\n",
+		rateLimitBudget.remaining, rateLimitBudget.limit,
+	)
+}
@@ -0,0 +1,148 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func resetRateLimitStateForTest(t *testing.T) {
+	t.Helper()
+	reset := func() {
+		SetRateLimitOverride(nil)
+		rateLimiter.Lock()
+		rateLimiter.bucket = nil
+		rateLimiter.rate = 0
+		rateLimiter.Unlock()
+		rateLimitBudget.Lock()
+		rateLimitBudget.known = false
+		rateLimitBudget.limit = 0
+		rateLimitBudget.remaining = 0
+		rateLimitBudget.warned = false
+		rateLimitBudget.Unlock()
+		SetWarningOutput(nil)
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestParseRateLimitHeader(t *testing.T) {
+	tests := []struct {
+		value         string
+		wantLimit     int
+		wantRemaining int
+		wantOK        bool
+	}{
+		{value: "user-hour-lim:3600;user-hour-rem:3542;", wantLimit: 3600, wantRemaining: 3542, wantOK: true},
+		{value: " user-hour-rem: 10 ; user-hour-lim: 3500 ", wantLimit: 3500, wantRemaining: 10, wantOK: true},
+		{value: "user-hour-lim:3600;", wantLimit: 3600},
+		{value: "user-hour-lim:x;user-hour-rem:1"},
+		{value: ""},
+	}
+	for _, test := range tests {
+		limit, remaining, ok := parseRateLimitHeader(test.value)
+		if ok != test.wantOK || (ok && (limit != test.wantLimit || remaining != test.wantRemaining)) {
+			t.Fatalf("parseRateLimitHeader(%q) = %d, %d, %v", test.value, limit, remaining, ok)
+		}
+	}
+}
+
+func TestTokenBucketSpacesRequestsAfterBurst(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(2)
+	bucket.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Fatalf("burst request %d delayed by %s", i, delay)
+		}
+	}
+	if delay := bucket.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("expected third request to wait 500ms, got %s", delay)
+	}
+	if delay := bucket.reserve(); delay != time.Second {
+		t.Fatalf("expected queued request to wait 1s, got %s", delay)
+	}
+
+	now = now.Add(2 * time.Second)
+	if delay := bucket.reserve(); delay != 0 {
+		t.Fatalf("expected refilled bucket to allow request, got %s", delay)
+	}
+}
+
+func TestResolveRateLimit_OverrideBeatsEnv(t *testing.T) {
+	resetRateLimitStateForTest(t)
+	t.Setenv("ASC_RATE_LIMIT", "4")
+
+	if got := resolveRateLimit(); got != 4 {
+		t.Fatalf("expected env rate 4, got %v", got)
+	}
+	zero := 0.0
+	SetRateLimitOverride(&zero)
+	if got := resolveRateLimit(); got != 0 {
+		t.Fatalf("expected override 0, got %v", got)
+	}
+
+	t.Setenv("ASC_RATE_LIMIT", "nope")
+	SetRateLimitOverride(nil)
+	if got := resolveRateLimit(); got != 0 {
+		t.Fatalf("expected invalid env to disable throttling, got %v", got)
+	}
+}
+
+func TestPaginateAll_WarnsWhenRateLimitBudgetLow(t *testing.T) {
+	resetRateLimitStateForTest(t)
+	var warnings bytes.Buffer
+	SetWarningOutput(&warnings)
+
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=2"}}`)
+	response.Header.Set("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:120;")
+	client := newTestClient(t, nil, response)
+
+	firstPage, err := client.GetApps(context.Background())
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+
+	fetches := 0
+	_, err = PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		fetches++
+		if fetches == 1 {
+			return &AppsResponse{Data: []Resource[AppAttributes]{{ID: "2"}}, Links: Links{Next: "https://api.appstoreconnect.apple.com/v1/apps?cursor=3"}}, nil
+		}
+		return &AppsResponse{Data: []Resource[AppAttributes]{{ID: "3"}}}, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	output := warnings.String()
+	if !strings.Contains(output, "120 of 3600 requests left this hour") {
+		t.Fatalf("expected low budget warning, got %q", output)
+	}
+	if strings.Count(output, "Warning:") != 1 {
+		t.Fatalf("expected a single warning, got %q", output)
+	}
+}
+
+func TestPaginateAll_NoWarningWithHealthyBudget(t *testing.T) {
+	resetRateLimitStateForTest(t)
+	var warnings bytes.Buffer
+	SetWarningOutput(&warnings)
+
+	observeRateLimitHeader(http.Header{"X-Rate-Limit": []string{"user-hour-lim:3600;user-hour-rem:3000;"}})
+
+	firstPage := &AppsResponse{Data: []Resource[AppAttributes]{{ID: "1"}}, Links: Links{Next: "https://api.appstoreconnect.apple.com/v1/apps?cursor=2"}}
+	_, err := PaginateAll(context.Background(), firstPage, func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		return &AppsResponse{Data: []Resource[AppAttributes]{{ID: "2"}}}, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	if warnings.Len() != 0 {
+		t.Fatalf("expected no warning, got %q", warnings.String())
+	}
+}
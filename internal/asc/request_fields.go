@@ -0,0 +1,88 @@
+package asc
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var requestFields struct {
+	mu      sync.Mutex
+	fields  map[string][]string
+	applied int
+}
+
+// SetRequestFields sets sparse fieldsets that are added as fields[TYPE]
+// query parameters to GET requests sent by the client, replacing any the
+// request already carries for the same type. Pass nil to clear them.
+//
+// The API rejects fields[TYPE] on requests that cannot return TYPE, so a
+// fieldset is only added when TYPE is the resource the path addresses or the
+// request includes related resources. Lookups a command makes along the way
+// (for example resolving an app by name) are left alone.
+func SetRequestFields(fields map[string][]string) {
+	requestFields.mu.Lock()
+	defer requestFields.mu.Unlock()
+	requestFields.fields = fields
+	requestFields.applied = 0
+}
+
+// RequestFieldsApplied returns how many GET requests the current sparse
+// fieldsets have been applied to since they were set.
+func RequestFieldsApplied() int {
+	requestFields.mu.Lock()
+	defer requestFields.mu.Unlock()
+	return requestFields.applied
+}
+
+func applyRequestFields(req *http.Request) {
+	if req.Method != http.MethodGet {
+		return
+	}
+	requestFields.mu.Lock()
+	defer requestFields.mu.Unlock()
+	if len(requestFields.fields) == 0 {
+		return
+	}
+
+	query := req.URL.Query()
+	pathType := requestPathResourceType(req.URL.Path)
+	hasInclude := query.Get("include") != ""
+	types := make([]string, 0, len(requestFields.fields))
+	for resourceType := range requestFields.fields {
+		if hasInclude || resourceTypeMatches(resourceType, pathType) {
+			types = append(types, resourceType)
+		}
+	}
+	if len(types) == 0 {
+		return
+	}
+	sort.Strings(types)
+	for _, resourceType := range types {
+		query.Set("fields["+resourceType+"]", strings.Join(requestFields.fields[resourceType], ","))
+	}
+	req.URL.RawQuery = query.Encode()
+	requestFields.applied++
+}
+
+// requestPathResourceType returns the collection or relationship a path
+// addresses: "/v1/apps/1/builds" is "builds" and "/v1/apps/1" is "apps".
+func requestPathResourceType(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	// Drop the API version, then pair collections with IDs.
+	segments = segments[1:]
+	if len(segments)%2 == 0 {
+		return segments[len(segments)-2]
+	}
+	return segments[len(segments)-1]
+}
+
+// resourceTypeMatches compares a resource type with a path segment, which is
+// singular for to-one relationships such as "/v1/builds/1/app".
+func resourceTypeMatches(resourceType, segment string) bool {
+	return segment != "" && (resourceType == segment || resourceType == segment+"s")
+}
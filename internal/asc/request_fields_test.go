@@ -0,0 +1,79 @@
+package asc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApplyRequestFieldsSetsFieldsOnGetRequests(t *testing.T) {
+	SetRequestFields(map[string][]string{
+		"apps":   {"name", "bundleId"},
+		"builds": {"version"},
+	})
+	t.Cleanup(func() { SetRequestFields(nil) })
+
+	post := httptest.NewRequest(http.MethodPost, BaseURL+"/v1/apps", nil)
+	applyRequestFields(post)
+	if post.URL.RawQuery != "" {
+		t.Fatalf("expected POST query to be unchanged, got %q", post.URL.RawQuery)
+	}
+
+	get := httptest.NewRequest(http.MethodGet, BaseURL+"/v1/apps?limit=5&fields%5Bapps%5D=sku", nil)
+	applyRequestFields(get)
+	query := get.URL.Query()
+	if got := query.Get("fields[apps]"); got != "name,bundleId" {
+		t.Fatalf("fields[apps] = %q, want name,bundleId", got)
+	}
+	if query.Has("fields[builds]") {
+		t.Fatalf("expected fields[builds] to be skipped on an apps request, got %q", get.URL.RawQuery)
+	}
+	if got := query.Get("limit"); got != "5" {
+		t.Fatalf("expected other parameters to be kept, got limit=%q", got)
+	}
+	if got := RequestFieldsApplied(); got != 1 {
+		t.Fatalf("RequestFieldsApplied() = %d, want 1", got)
+	}
+}
+
+func TestApplyRequestFieldsMatchesPathResource(t *testing.T) {
+	SetRequestFields(map[string][]string{
+		"apps":   {"name"},
+		"builds": {"version"},
+	})
+	t.Cleanup(func() { SetRequestFields(nil) })
+
+	tests := []struct {
+		url  string
+		want []string
+	}{
+		{"/v1/apps/app-1/builds", []string{"builds"}},
+		{"/v1/builds/build-1", []string{"builds"}},
+		{"/v1/builds/build-1/app", []string{"apps"}},
+		{"/v1/betaGroups", nil},
+		{"/v1/builds?include=app", []string{"apps", "builds"}},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, BaseURL+test.url, nil)
+		applyRequestFields(req)
+		var got []string
+		for _, resourceType := range []string{"apps", "builds"} {
+			if req.URL.Query().Has("fields[" + resourceType + "]") {
+				got = append(got, resourceType)
+			}
+		}
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Fatalf("%s: fieldsets = %v, want %v", test.url, got, test.want)
+		}
+	}
+}
+
+func TestApplyRequestFieldsNoopWithoutFields(t *testing.T) {
+	SetRequestFields(nil)
+	req := httptest.NewRequest(http.MethodGet, BaseURL+"/v1/apps?limit=5", nil)
+	applyRequestFields(req)
+	if req.URL.RawQuery != "limit=5" {
+		t.Fatalf("expected query to be unchanged, got %q", req.URL.RawQuery)
+	}
+}
@@ -34,3 +34,19 @@ func TestResolveRetryLogEnabled_EnvBeatsConfig(t *testing.T) {
 		t.Fatal("expected ASC_RETRY_LOG to enable retry logging")
 	}
 }
+
+func TestResolveRetryOptions_MaxRetriesOverrideBeatsEnv(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "5")
+	t.Cleanup(func() { SetMaxRetriesOverride(nil) })
+
+	zero := 0
+	SetMaxRetriesOverride(&zero)
+	if got := ResolveRetryOptions().MaxRetries; got != 0 {
+		t.Fatalf("expected override 0, got %d", got)
+	}
+
+	SetMaxRetriesOverride(nil)
+	if got := ResolveRetryOptions().MaxRetries; got != 5 {
+		t.Fatalf("expected env value 5, got %d", got)
+	}
+}
@@ -178,7 +178,7 @@ func (c *Client) GetSandboxTesters(ctx context.Context, opts ...SandboxTestersOp
 		opt(query)
 	}
 
-	path := "/v2/sandboxTesters"
+	path := resourcePath("sandboxTesters")
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
 		if err := validateNextURL(query.nextURL); err != nil {
@@ -241,7 +241,7 @@ func (c *Client) UpdateSandboxTester(ctx context.Context, testerID string, attri
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "PATCH", fmt.Sprintf("/v2/sandboxTesters/%s", testerID), body)
+	data, err := c.do(ctx, "PATCH", resourcePath("sandboxTesters", testerID), body)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +273,7 @@ func (c *Client) ClearSandboxTesterPurchaseHistory(ctx context.Context, testerID
 		return nil, err
 	}
 
-	data, err := c.do(ctx, "POST", "/v2/sandboxTestersClearPurchaseHistoryRequest", body)
+	data, err := c.do(ctx, "POST", resourcePath("sandboxTestersClearPurchaseHistoryRequest"), body)
 	if err != nil {
 		return nil, err
 	}
@@ -24,6 +24,13 @@ type CertificateRevokeResult struct {
 	Revoked bool   `json:"revoked"`
 }
 
+// CertificateDownloadResult represents CLI output for certificate downloads.
+type CertificateDownloadResult struct {
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	OutputPath string `json:"outputPath"`
+}
+
 // ProfileDeleteResult represents CLI output for profile deletions.
 type ProfileDeleteResult struct {
 	ID      string `json:"id"`
@@ -37,6 +44,19 @@ type ProfileDownloadResult struct {
 	OutputPath string `json:"outputPath"`
 }
 
+// ProfileRegenerateResult represents CLI output for profile regeneration.
+type ProfileRegenerateResult struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	ProfileType    string   `json:"profileType"`
+	PreviousID     string   `json:"previousId"`
+	BundleID       string   `json:"bundleId"`
+	CertificateIDs []string `json:"certificateIds"`
+	DeviceIDs      []string `json:"deviceIds,omitempty"`
+	ExpirationDate string   `json:"expirationDate,omitempty"`
+	OutputPath     string   `json:"outputPath,omitempty"`
+}
+
 func bundleIDsRows(resp *BundleIDsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Identifier", "Platform", "Seed ID"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -98,6 +118,16 @@ func certificateRevokeResultRows(result *CertificateRevokeResult) ([]string, [][
 	return headers, rows
 }
 
+func certificateDownloadResultRows(result *CertificateDownloadResult) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "Output Path"}
+	rows := [][]string{{
+		result.ID,
+		compactWhitespace(result.Name),
+		result.OutputPath,
+	}}
+	return headers, rows
+}
+
 func profilesRows(resp *ProfilesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Type", "State", "Expiration"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -129,6 +159,21 @@ func profileDownloadResultRows(result *ProfileDownloadResult) ([]string, [][]str
 	return headers, rows
 }
 
+func profileRegenerateResultRows(result *ProfileRegenerateResult) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "Type", "Previous ID", "Certificates", "Devices", "Expiration", "Output Path"}
+	rows := [][]string{{
+		result.ID,
+		compactWhitespace(result.Name),
+		result.ProfileType,
+		result.PreviousID,
+		joinSigningList(result.CertificateIDs),
+		fmt.Sprintf("%d", len(result.DeviceIDs)),
+		result.ExpirationDate,
+		result.OutputPath,
+	}}
+	return headers, rows
+}
+
 func joinSigningList(values []string) string {
 	if len(values) == 0 {
 		return ""
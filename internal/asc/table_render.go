@@ -1,18 +1,56 @@
 package asc
 
 import (
+	"io"
 	"os"
+	"sync/atomic"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/olekukonko/tablewriter/renderer"
 	"github.com/olekukonko/tablewriter/tw"
 )
 
-// RenderTable writes a bordered Unicode table to stdout.
+var (
+	asciiTables atomic.Bool
+	colorTables atomic.Bool
+	tableWidth  atomic.Int64
+)
+
+// SetTableASCII switches RenderTable between Unicode box-drawing borders and
+// plain ASCII borders for consoles without box-drawing glyphs.
+func SetTableASCII(enabled bool) {
+	asciiTables.Store(enabled)
+}
+
+// SetTableColor enables colored state and status values in RenderTable.
+// Callers enable it only for color-capable terminals.
+func SetTableColor(enabled bool) {
+	colorTables.Store(enabled)
+}
+
+// SetTableWidth sets the terminal width RenderTable fits tables into;
+// 0 disables fitting, as when output is piped.
+func SetTableWidth(width int) {
+	tableWidth.Store(int64(max(width, 0)))
+}
+
+// RenderTable writes a bordered Unicode table to stdout, or an ASCII one when
+// SetTableASCII is enabled.
 // Headers preserve their original casing and are center-aligned.
-// Data rows are left-aligned for readability.
+// Data rows are left-aligned for readability. Tables wider than the width set
+// with SetTableWidth are fitted by wrapping or truncating the widest columns,
+// and SetTableColor colors well-known values in state and status columns.
 func RenderTable(headers []string, rows [][]string) {
-	table := tablewriter.NewTable(os.Stdout,
+	renderTableTo(os.Stdout, headers, rows)
+}
+
+func renderTableTo(w io.Writer, headers []string, rows [][]string) {
+	headers, rows = fitTableColumns(headers, rows, int(tableWidth.Load()), asciiTables.Load())
+	if colorTables.Load() {
+		rows = colorizeStatusColumns(headers, rows)
+	}
+
+	opts := []tablewriter.Option{
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
 				Formatting: tw.CellFormatting{
@@ -24,7 +62,11 @@ func RenderTable(headers []string, rows [][]string) {
 				Alignment: tw.CellAlignment{Global: tw.AlignLeft},
 			},
 		}),
-	)
+	}
+	if asciiTables.Load() {
+		opts = append(opts, tablewriter.WithSymbols(tw.NewSymbols(tw.StyleASCII)))
+	}
+	table := tablewriter.NewTable(w, opts...)
 	table.Header(headers)
 	_ = table.Bulk(rows)
 	_ = table.Render()
@@ -34,7 +76,11 @@ func RenderTable(headers []string, rows [][]string) {
 // Headers preserve their original casing. Data rows are left-aligned.
 // Pipe characters in cell values are escaped automatically by the renderer.
 func RenderMarkdown(headers []string, rows [][]string) {
-	table := tablewriter.NewTable(os.Stdout,
+	renderMarkdownTo(os.Stdout, headers, rows)
+}
+
+func renderMarkdownTo(w io.Writer, headers []string, rows [][]string) {
+	table := tablewriter.NewTable(w,
 		tablewriter.WithRenderer(renderer.NewMarkdown()),
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
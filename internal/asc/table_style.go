@@ -0,0 +1,250 @@
+package asc
+
+import (
+	"strings"
+
+	"github.com/olekukonko/tablewriter/pkg/twwidth"
+)
+
+const (
+	// minTableColumnWidth keeps shrunk columns readable; columns that are
+	// naturally narrower keep their width.
+	minTableColumnWidth = 8
+
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+)
+
+// fitTableColumns shrinks the widest columns until a bordered table fits in
+// width terminal columns. Cells with spaces wrap onto extra lines; single
+// tokens such as IDs are truncated with an ellipsis. A width of 0 or less
+// leaves rows unchanged.
+func fitTableColumns(headers []string, rows [][]string, width int, ascii bool) ([]string, [][]string) {
+	if width <= 0 || len(headers) == 0 {
+		return headers, rows
+	}
+
+	natural := make([]int, len(headers))
+	measure := func(col int, value string) {
+		if col >= len(natural) {
+			return
+		}
+		for _, line := range strings.Split(value, "\n") {
+			natural[col] = max(natural[col], twwidth.Width(line))
+		}
+	}
+	for col, header := range headers {
+		measure(col, header)
+	}
+	for _, row := range rows {
+		for col, cell := range row {
+			measure(col, cell)
+		}
+	}
+
+	// Each column has one space of padding on both sides plus a border.
+	budget := width - (3*len(headers) + 1)
+	total := 0
+	for _, w := range natural {
+		total += w
+	}
+	if total <= budget {
+		return headers, rows
+	}
+
+	limits := columnLimits(natural, budget)
+	ellipsis := "…"
+	if ascii {
+		ellipsis = "..."
+	}
+	fit := func(col int, value string) string {
+		if col >= len(limits) || limits[col] >= natural[col] {
+			return value
+		}
+		return fitTableCell(value, limits[col], ellipsis)
+	}
+
+	fittedHeaders := make([]string, len(headers))
+	for col, header := range headers {
+		fittedHeaders[col] = fit(col, header)
+	}
+	fittedRows := make([][]string, len(rows))
+	for i, row := range rows {
+		fitted := make([]string, len(row))
+		for col, cell := range row {
+			fitted[col] = fit(col, cell)
+		}
+		fittedRows[i] = fitted
+	}
+	return fittedHeaders, fittedRows
+}
+
+// columnLimits caps every column at the largest shared width that fits the
+// budget, so narrow columns stay intact and only the widest ones give way.
+func columnLimits(natural []int, budget int) []int {
+	limitFor := func(limit int) []int {
+		limits := make([]int, len(natural))
+		for col, w := range natural {
+			limits[col] = min(w, max(limit, minTableColumnWidth))
+		}
+		return limits
+	}
+	sum := func(values []int) int {
+		total := 0
+		for _, v := range values {
+			total += v
+		}
+		return total
+	}
+
+	widest := 0
+	for _, w := range natural {
+		widest = max(widest, w)
+	}
+	low, high := minTableColumnWidth, widest
+	for low < high {
+		mid := (low + high + 1) / 2
+		if sum(limitFor(mid)) <= budget {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return limitFor(low)
+}
+
+func fitTableCell(value string, limit int, ellipsis string) string {
+	lines := strings.Split(value, "\n")
+	fitted := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if twwidth.Width(line) <= limit {
+			fitted = append(fitted, line)
+			continue
+		}
+		if strings.Contains(strings.TrimSpace(line), " ") {
+			fitted = append(fitted, wrapTableLine(line, limit, ellipsis)...)
+			continue
+		}
+		fitted = append(fitted, truncateTableText(line, limit, ellipsis))
+	}
+	return strings.Join(fitted, "\n")
+}
+
+// wrapTableLine breaks text at spaces; words longer than limit are truncated.
+func wrapTableLine(line string, limit int, ellipsis string) []string {
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(line) {
+		if twwidth.Width(word) > limit {
+			word = truncateTableText(word, limit, ellipsis)
+		}
+		switch {
+		case current == "":
+			current = word
+		case twwidth.Width(current)+1+twwidth.Width(word) <= limit:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+func truncateTableText(text string, limit int, ellipsis string) string {
+	if twwidth.Width(text) <= limit {
+		return text
+	}
+	room := limit - twwidth.Width(ellipsis)
+	if room <= 0 {
+		return ellipsis
+	}
+	var b strings.Builder
+	used := 0
+	for _, r := range text {
+		w := twwidth.Width(string(r))
+		if used+w > room {
+			break
+		}
+		b.WriteRune(r)
+		used += w
+	}
+	return b.String() + ellipsis
+}
+
+// colorizeStatusColumns colors well-known state values in columns whose
+// header names a state, status, or result.
+func colorizeStatusColumns(headers []string, rows [][]string) [][]string {
+	var statusCols []int
+	for col, header := range headers {
+		name := strings.ToLower(header)
+		if strings.Contains(name, "state") || strings.Contains(name, "status") || strings.Contains(name, "result") {
+			statusCols = append(statusCols, col)
+		}
+	}
+	if len(statusCols) == 0 {
+		return rows
+	}
+
+	colored := make([][]string, len(rows))
+	for i, row := range rows {
+		colored[i] = row
+		copied := false
+		for _, col := range statusCols {
+			if col >= len(row) {
+				continue
+			}
+			color := statusColor(row[col])
+			if color == "" {
+				continue
+			}
+			if !copied {
+				colored[i] = append([]string(nil), row...)
+				copied = true
+			}
+			colored[i][col] = color + row[col] + ansiReset
+		}
+	}
+	return colored
+}
+
+var (
+	greenStatuses = map[string]struct{}{
+		"ACCEPTED": {}, "ACTIVE": {}, "APPROVED": {}, "COMPLETE": {}, "COMPLETED": {},
+		"ENABLED": {}, "IN_BETA_TESTING": {}, "OK": {}, "PASS": {}, "PASSED": {},
+		"PROCESSED": {}, "READY_FOR_BETA_TESTING": {}, "READY_FOR_DISTRIBUTION": {},
+		"READY_FOR_SALE": {}, "SUCCEEDED": {}, "SUCCESS": {}, "VALID": {},
+	}
+	redStatuses = map[string]struct{}{
+		"ERROR": {}, "EXPIRED": {}, "FAIL": {}, "FAILED": {}, "FAILURE": {},
+		"INVALID": {}, "INVALID_BINARY": {}, "NOT_APPROVED": {}, "REJECTED": {},
+		"REMOVED_FROM_SALE": {},
+	}
+	yellowStatuses = map[string]struct{}{
+		"DISABLED": {}, "IN_PROGRESS": {}, "IN_REVIEW": {}, "IN_BETA_REVIEW": {},
+		"PREPARE_FOR_SUBMISSION": {}, "PROCESSING": {}, "SKIPPED": {},
+		"WARN": {}, "WARNING": {},
+	}
+)
+
+func statusColor(value string) string {
+	status := strings.ToUpper(strings.TrimSpace(value))
+	if status == "" || strings.Contains(status, "\n") {
+		return ""
+	}
+	if _, ok := greenStatuses[status]; ok {
+		return ansiGreen
+	}
+	if _, ok := redStatuses[status]; ok || strings.HasSuffix(status, "_REJECTED") {
+		return ansiRed
+	}
+	if _, ok := yellowStatuses[status]; ok || strings.HasPrefix(status, "WAITING_FOR_") || strings.HasPrefix(status, "PENDING_") {
+		return ansiYellow
+	}
+	return ""
+}
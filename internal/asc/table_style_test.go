@@ -0,0 +1,102 @@
+package asc
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/olekukonko/tablewriter/pkg/twwidth"
+)
+
+func TestFitTableColumnsLeavesNarrowTablesAlone(t *testing.T) {
+	headers := []string{"ID", "Name"}
+	rows := [][]string{{"1", "Demo"}}
+
+	gotHeaders, gotRows := fitTableColumns(headers, rows, 80, false)
+	if !reflect.DeepEqual(gotHeaders, headers) || !reflect.DeepEqual(gotRows, rows) {
+		t.Fatalf("expected table to be unchanged, got %q %q", gotHeaders, gotRows)
+	}
+	if _, gotRows = fitTableColumns(headers, [][]string{{"1", strings.Repeat("x", 200)}}, 0, false); len(gotRows[0][1]) != 200 {
+		t.Fatal("expected width 0 to disable fitting")
+	}
+}
+
+func TestFitTableColumnsWrapsTextAndTruncatesTokens(t *testing.T) {
+	headers := []string{"ID", "Description", "State"}
+	rows := [][]string{{
+		"abcdef0123456789abcdef0123456789",
+		"Fixes crashes when opening the settings screen on older devices",
+		"READY_FOR_SALE",
+	}}
+
+	var out bytes.Buffer
+	SetTableWidth(60)
+	t.Cleanup(func() { SetTableWidth(0) })
+	renderTableTo(&out, headers, rows)
+
+	for _, line := range strings.Split(strings.TrimRight(out.String(), "\n"), "\n") {
+		if width := twwidth.Width(line); width > 60 {
+			t.Fatalf("line is %d columns wide, want at most 60: %q", width, line)
+		}
+	}
+	if !strings.Contains(out.String(), "…") {
+		t.Fatalf("expected the ID to be truncated with an ellipsis, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "READY_FOR_SALE") {
+		t.Fatalf("expected the narrow State column to stay intact, got:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "crashes when opening the settings screen") {
+		t.Fatalf("expected the description to wrap, got:\n%s", out.String())
+	}
+}
+
+func TestFitTableCellUsesASCIIEllipsis(t *testing.T) {
+	if got := fitTableCell("abcdefghijklmnop", 8, "..."); got != "abcde..." {
+		t.Fatalf("fitTableCell() = %q, want %q", got, "abcde...")
+	}
+	if got := wrapTableLine("one two three", 8, "..."); !reflect.DeepEqual(got, []string{"one two", "three"}) {
+		t.Fatalf("wrapTableLine() = %q", got)
+	}
+}
+
+func TestColorizeStatusColumns(t *testing.T) {
+	headers := []string{"Name", "App Store State", "Status"}
+	rows := [][]string{
+		{"ACTIVE", "READY_FOR_SALE", "failed"},
+		{"Demo", "METADATA_REJECTED", "WAITING_FOR_REVIEW"},
+		{"Other", "CUSTOM", ""},
+	}
+
+	got := colorizeStatusColumns(headers, rows)
+	want := [][]string{
+		{"ACTIVE", ansiGreen + "READY_FOR_SALE" + ansiReset, ansiRed + "failed" + ansiReset},
+		{"Demo", ansiRed + "METADATA_REJECTED" + ansiReset, ansiYellow + "WAITING_FOR_REVIEW" + ansiReset},
+		{"Other", "CUSTOM", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("colorizeStatusColumns() = %q, want %q", got, want)
+	}
+	if rows[0][1] != "READY_FOR_SALE" {
+		t.Fatal("expected input rows to be left unmodified")
+	}
+}
+
+func TestRenderTableColorsOnlyWhenEnabled(t *testing.T) {
+	headers := []string{"ID", "State"}
+	rows := [][]string{{"1", "REJECTED"}}
+
+	var plain bytes.Buffer
+	renderTableTo(&plain, headers, rows)
+	if strings.Contains(plain.String(), "\x1b[") {
+		t.Fatalf("expected no ANSI codes by default, got %q", plain.String())
+	}
+
+	SetTableColor(true)
+	t.Cleanup(func() { SetTableColor(false) })
+	var colored bytes.Buffer
+	renderTableTo(&colored, headers, rows)
+	if !strings.Contains(colored.String(), ansiRed+"REJECTED"+ansiReset) {
+		t.Fatalf("expected red REJECTED, got %q", colored.String())
+	}
+}
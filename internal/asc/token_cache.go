@@ -0,0 +1,169 @@
+package asc
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	tokenCacheEnabledEnv = "ASC_TOKEN_CACHE"
+	tokenCacheDirEnv     = "ASC_TOKEN_CACHE_DIR"
+
+	tokenCacheVersion = 2
+)
+
+type persistedToken struct {
+	Version   int       `json:"version"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// ClockOffset is the server clock offset the token was signed with.
+	ClockOffset time.Duration `json:"clock_offset"`
+}
+
+// EnablePersistentTokenCache lets the client reuse a signed JWT across process
+// invocations. Tokens are stored per key in a user-only cache directory and
+// are re-verified against the client's key before reuse. Setting
+// ASC_TOKEN_CACHE=0 disables it.
+func (c *Client) EnablePersistentTokenCache() {
+	if !tokenCacheEnabled() {
+		return
+	}
+	path, err := tokenCachePath(c.keyID, c.issuerID, c.privateKey)
+	if err != nil {
+		return
+	}
+
+	c.jwtMu.Lock()
+	defer c.jwtMu.Unlock()
+	c.tokenCachePath = path
+}
+
+func tokenCacheEnabled() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(tokenCacheEnabledEnv))) {
+	case "0", "false", "no", "off":
+		return false
+	default:
+		return true
+	}
+}
+
+func tokenCacheDir() (string, error) {
+	if custom := strings.TrimSpace(os.Getenv(tokenCacheDirEnv)); custom != "" {
+		return custom, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".asc", "tokens"), nil
+}
+
+// tokenCachePath keys the cache file by key ID, issuer, and public key so a
+// rotated key never picks up a token signed by its predecessor.
+func tokenCachePath(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string, error) {
+	if privateKey == nil {
+		return "", fmt.Errorf("private key is required")
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode public key: %w", err)
+	}
+	dir, err := tokenCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(strings.TrimSpace(keyID)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(strings.TrimSpace(issuerID)))
+	hash.Write([]byte{0})
+	hash.Write(publicKey)
+	return filepath.Join(dir, "token-"+hex.EncodeToString(hash.Sum(nil))+".json"), nil
+}
+
+// loadPersistedToken returns a cached token that is still valid at now and
+// was signed by privateKey for the given key and issuer. Tokens signed under a
+// different clock offset, or issued after now, are rejected so a correction
+// for local clock skew never resends a token the server already refused.
+func loadPersistedToken(path, keyID, issuerID string, privateKey *ecdsa.PrivateKey, now time.Time, offset time.Duration) (string, time.Time, bool) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	var cached persistedToken
+	if err := json.Unmarshal(raw, &cached); err != nil {
+		return "", time.Time{}, false
+	}
+	if cached.Version != tokenCacheVersion || cached.Token == "" {
+		return "", time.Time{}, false
+	}
+	if !now.Before(cached.ExpiresAt.Add(-jwtRefreshSkew)) {
+		return "", time.Time{}, false
+	}
+	if absDuration(cached.ClockOffset-offset) > clockSkewTolerance {
+		return "", time.Time{}, false
+	}
+
+	parsed, err := jwt.ParseWithClaims(cached.Token, &jwt.RegisteredClaims{}, func(token *jwt.Token) (any, error) {
+		if kid, _ := token.Header["kid"].(string); kid != keyID {
+			return nil, errors.New("token key ID mismatch")
+		}
+		return &privateKey.PublicKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}),
+		jwt.WithIssuer(issuerID),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkewTolerance),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil || !parsed.Valid {
+		return "", time.Time{}, false
+	}
+	return cached.Token, cached.ExpiresAt, true
+}
+
+func storePersistedToken(path, token string, expiresAt time.Time, offset time.Duration) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create token cache dir: %w", err)
+	}
+	raw, err := json.Marshal(persistedToken{
+		Version:     tokenCacheVersion,
+		Token:       token,
+		ExpiresAt:   expiresAt.UTC(),
+		ClockOffset: offset,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal token cache: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".token-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write token cache: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write token cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write token cache: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to finalize token cache: %w", err)
+	}
+	return nil
+}
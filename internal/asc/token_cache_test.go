@@ -0,0 +1,97 @@
+package asc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGenerateJWT_ReusesPersistedTokenAcrossClients(t *testing.T) {
+	t.Setenv(tokenCacheDirEnv, t.TempDir())
+
+	first := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	first.EnablePersistentTokenCache()
+	token, err := first.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+
+	info, err := os.Stat(first.tokenCachePath)
+	if err != nil {
+		t.Fatalf("expected token cache file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		t.Fatalf("expected user-only token cache file, got %o", perm)
+	}
+
+	second := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	second.privateKey = first.privateKey
+	second.EnablePersistentTokenCache()
+	reused, err := second.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+	if reused != token {
+		t.Fatal("expected second client to reuse the persisted token")
+	}
+}
+
+func TestGenerateJWT_IgnoresPersistedTokenForDifferentKey(t *testing.T) {
+	t.Setenv(tokenCacheDirEnv, t.TempDir())
+
+	first := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	first.EnablePersistentTokenCache()
+	token, err := first.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+
+	// A rotated key with the same key ID must not reuse or verify the old token.
+	rotated, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	if _, _, ok := loadPersistedToken(first.tokenCachePath, first.keyID, first.issuerID, rotated, time.Now(), 0); ok {
+		t.Fatal("expected token signed by a different key to be rejected")
+	}
+
+	second := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	second.privateKey = rotated
+	second.EnablePersistentTokenCache()
+	fresh, err := second.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+	if fresh == token {
+		t.Fatal("expected a fresh token for a different key")
+	}
+}
+
+func TestLoadPersistedToken_RejectsNearExpiryToken(t *testing.T) {
+	t.Setenv(tokenCacheDirEnv, t.TempDir())
+
+	client := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	client.EnablePersistentTokenCache()
+	if _, err := client.generateJWT(); err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+
+	later := time.Now().Add(tokenLifetime - jwtRefreshSkew/2)
+	if _, _, ok := loadPersistedToken(client.tokenCachePath, client.keyID, client.issuerID, client.privateKey, later, 0); ok {
+		t.Fatal("expected near-expiry token to be rejected")
+	}
+}
+
+func TestEnablePersistentTokenCache_DisabledByEnv(t *testing.T) {
+	t.Setenv(tokenCacheDirEnv, t.TempDir())
+	t.Setenv(tokenCacheEnabledEnv, "0")
+
+	client := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	client.EnablePersistentTokenCache()
+	if client.tokenCachePath != "" {
+		t.Fatalf("expected token cache to stay disabled, got path %q", client.tokenCachePath)
+	}
+}
@@ -11,15 +11,51 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 )
 
+const (
+	uploadConcurrencyEnv = "ASC_UPLOAD_CONCURRENCY"
+
+	// DefaultUploadConcurrency bounds how many upload parts are sent at once
+	// when neither --upload-concurrency nor ASC_UPLOAD_CONCURRENCY is set.
+	DefaultUploadConcurrency = 4
+)
+
+var uploadConcurrencyOverride struct {
+	mu  sync.RWMutex
+	val *int
+}
+
+var uploadProgressHandler struct {
+	mu sync.RWMutex
+	fn UploadProgressFunc
+}
+
+// UploadProgress reports how much of a file has been uploaded.
+type UploadProgress struct {
+	Name           string
+	UploadedBytes  int64
+	TotalBytes     int64
+	CompletedParts int
+	TotalParts     int
+}
+
+// UploadProgressFunc receives progress updates. It is called once before the
+// first part is sent and again after each part completes, never concurrently.
+type UploadProgressFunc func(UploadProgress)
+
 // UploadOptions configure how upload operations are executed.
 type UploadOptions struct {
-	Concurrency int
-	Client      *http.Client
-	RetryOpts   RetryOptions
+	Concurrency  int
+	Client       *http.Client
+	RetryOpts    RetryOptions
+	Progress     UploadProgressFunc
+	Manifest     *UploadManifest
+	ManifestPath string
 }
 
 // UploadOption configures upload options.
@@ -44,6 +80,69 @@ func WithUploadHTTPClient(client *http.Client) UploadOption {
 	}
 }
 
+// WithUploadProgress sets the progress callback, replacing the process-wide
+// handler for this upload.
+func WithUploadProgress(fn UploadProgressFunc) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.Progress = fn
+	}
+}
+
+// WithUploadManifest records completed parts in manifest, saved at path after
+// each part, and skips parts the manifest already lists.
+func WithUploadManifest(path string, manifest *UploadManifest) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.ManifestPath = path
+		opts.Manifest = manifest
+	}
+}
+
+// SetUploadConcurrencyOverride sets an explicit upload concurrency.
+// When set, it takes precedence over env. When unset (nil), behavior falls back
+// to ASC_UPLOAD_CONCURRENCY.
+func SetUploadConcurrencyOverride(value *int) {
+	uploadConcurrencyOverride.mu.Lock()
+	defer uploadConcurrencyOverride.mu.Unlock()
+	uploadConcurrencyOverride.val = value
+}
+
+// ResolveUploadConcurrency returns how many upload parts to send at once.
+// Precedence: explicit override > ASC_UPLOAD_CONCURRENCY > default.
+func ResolveUploadConcurrency() int {
+	uploadConcurrencyOverride.mu.RLock()
+	override := uploadConcurrencyOverride.val
+	uploadConcurrencyOverride.mu.RUnlock()
+	if override != nil && *override >= 1 {
+		return *override
+	}
+	if raw, ok := envValue(uploadConcurrencyEnv); ok && raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed >= 1 {
+			return parsed
+		}
+	}
+	return DefaultUploadConcurrency
+}
+
+// SetUploadProgressHandler sets the progress callback used by uploads that do
+// not pass WithUploadProgress. Nil disables progress reporting.
+func SetUploadProgressHandler(fn UploadProgressFunc) {
+	uploadProgressHandler.mu.Lock()
+	defer uploadProgressHandler.mu.Unlock()
+	uploadProgressHandler.fn = fn
+}
+
+func defaultUploadOptions() UploadOptions {
+	uploadProgressHandler.mu.RLock()
+	progress := uploadProgressHandler.fn
+	uploadProgressHandler.mu.RUnlock()
+	return UploadOptions{
+		Concurrency: ResolveUploadConcurrency(),
+		Client:      newUploadClient(),
+		RetryOpts:   ResolveRetryOptions(),
+		Progress:    progress,
+	}
+}
+
 // newUploadClient creates a dedicated HTTP client for upload operations
 // with appropriate timeouts and a cloned transport when possible to avoid
 // sharing the connection pool with http.DefaultClient.
@@ -54,12 +153,36 @@ func newUploadClient() *http.Client {
 	}
 	return &http.Client{
 		Timeout:   ResolveUploadTimeout(),
-		Transport: transport,
+		Transport: withMockTransport(transport),
 	}
 }
 
 // ExecuteUploadOperations performs the file uploads for the provided operations.
 func ExecuteUploadOperations(ctx context.Context, filePath string, operations []UploadOperation, opts ...UploadOption) error {
+	if len(operations) == 0 {
+		return errors.New("no upload operations provided")
+	}
+
+	file, err := openUploadSourceFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat file: %w", err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("path %q is a directory", filePath)
+	}
+
+	return uploadFileParts(ctx, file, info.Size(), operations, opts...)
+}
+
+// uploadFileParts sends each operation's byte range of file with a bounded
+// worker pool, retrying parts individually.
+func uploadFileParts(ctx context.Context, file *os.File, size int64, operations []UploadOperation, opts ...UploadOption) error {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -67,11 +190,7 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		return errors.New("no upload operations provided")
 	}
 
-	uploadOpts := UploadOptions{
-		Concurrency: 1,
-		Client:      newUploadClient(),
-		RetryOpts:   ResolveRetryOptions(),
-	}
+	uploadOpts := defaultUploadOptions()
 	for _, opt := range opts {
 		opt(&uploadOpts)
 	}
@@ -85,21 +204,6 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		uploadOpts.Concurrency = len(operations)
 	}
 
-	file, err := openUploadSourceFile(filePath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	info, err := file.Stat()
-	if err != nil {
-		return fmt.Errorf("stat file: %w", err)
-	}
-	if info.IsDir() {
-		return fmt.Errorf("path %q is a directory", filePath)
-	}
-	size := info.Size()
-
 	for i, op := range operations {
 		if strings.TrimSpace(op.URL) == "" {
 			return fmt.Errorf("upload operation %d has empty URL", i)
@@ -115,6 +219,9 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		}
 	}
 
+	tracker := newUploadTracker(filepath.Base(file.Name()), operations, uploadOpts)
+	tracker.report()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -140,6 +247,10 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 				setErr(err)
 				return
 			}
+			if err := tracker.complete(task); err != nil {
+				setErr(err)
+				return
+			}
 		}
 	}
 
@@ -150,6 +261,9 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 
 sendLoop:
 	for i, op := range operations {
+		if tracker.done(i) {
+			continue
+		}
 		select {
 		case <-ctx.Done():
 			break sendLoop
@@ -162,6 +276,63 @@ sendLoop:
 	return firstErr
 }
 
+// uploadTracker counts completed parts for progress reporting and the
+// resume manifest.
+type uploadTracker struct {
+	mu           sync.Mutex
+	progress     UploadProgress
+	report       func()
+	manifest     *UploadManifest
+	manifestPath string
+	completed    map[int]bool
+}
+
+func newUploadTracker(name string, operations []UploadOperation, uploadOpts UploadOptions) *uploadTracker {
+	tracker := &uploadTracker{
+		progress:     UploadProgress{Name: name, TotalParts: len(operations)},
+		manifest:     uploadOpts.Manifest,
+		manifestPath: uploadOpts.ManifestPath,
+		completed:    map[int]bool{},
+	}
+	for index, op := range operations {
+		tracker.progress.TotalBytes += op.Length
+		if tracker.manifest.HasCompleted(op) {
+			tracker.completed[index] = true
+			tracker.progress.CompletedParts++
+			tracker.progress.UploadedBytes += op.Length
+		}
+	}
+	tracker.report = func() {
+		if uploadOpts.Progress != nil {
+			uploadOpts.Progress(tracker.progress)
+		}
+	}
+	return tracker
+}
+
+func (t *uploadTracker) done(index int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.completed[index]
+}
+
+func (t *uploadTracker) complete(task uploadTask) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.completed[task.index] = true
+	t.progress.CompletedParts++
+	t.progress.UploadedBytes += task.op.Length
+	t.report()
+	if t.manifest == nil || t.manifestPath == "" {
+		return nil
+	}
+	t.manifest.Completed = append(t.manifest.Completed, UploadManifestPart{Offset: task.op.Offset, Length: task.op.Length})
+	if err := t.manifest.Save(t.manifestPath); err != nil {
+		return fmt.Errorf("save upload manifest: %w", err)
+	}
+	return nil
+}
+
 func openUploadSourceFile(filePath string) (*os.File, error) {
 	info, err := os.Lstat(filePath)
 	if err != nil {
@@ -0,0 +1,167 @@
+package asc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	uploadStateDirEnv = "ASC_UPLOAD_STATE_DIR"
+
+	uploadManifestVersion = 2
+)
+
+// UploadManifest records an in-progress upload so an interrupted run can
+// reuse the same reservation and skip the parts that were already sent.
+// Key identifies what the caller reserved (for example app, version, and
+// build number); a manifest only applies when the key and the file's size
+// and modification time are unchanged.
+type UploadManifest struct {
+	Version       int                  `json:"version"`
+	Key           string               `json:"key"`
+	FilePath      string               `json:"filePath"`
+	FileSize      int64                `json:"fileSize"`
+	ModTime       time.Time            `json:"modTime"`
+	ParentID      string               `json:"parentId,omitempty"`
+	ReservationID string               `json:"reservationId"`
+	Completed     []UploadManifestPart `json:"completed"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+}
+
+// UploadManifestPart is the byte range of an uploaded part. Parts are matched
+// by range rather than by position, because App Store Connect does not
+// promise to return the upload operations in the same order.
+type UploadManifestPart struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+// UploadManifestPath returns where the manifest for filePath is stored.
+// ASC_UPLOAD_STATE_DIR overrides the default of ~/.asc/uploads.
+func UploadManifestPath(filePath string) (string, error) {
+	dir := strings.TrimSpace(os.Getenv(uploadStateDirEnv))
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to get home directory: %w", err)
+		}
+		dir = filepath.Join(home, ".asc", "uploads")
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(absPath))
+	return filepath.Join(dir, hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// NewUploadManifest starts a manifest for filePath and the given reservation.
+func NewUploadManifest(filePath, key, parentID, reservationID string) (*UploadManifest, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &UploadManifest{
+		Version:       uploadManifestVersion,
+		Key:           key,
+		FilePath:      absPath,
+		FileSize:      info.Size(),
+		ModTime:       info.ModTime().UTC(),
+		ParentID:      parentID,
+		ReservationID: reservationID,
+		Completed:     []UploadManifestPart{},
+	}, nil
+}
+
+// LoadUploadManifest reads the manifest at path. A missing or unreadable
+// manifest yields nil so the caller starts a fresh upload.
+func LoadUploadManifest(path string) *UploadManifest {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var manifest UploadManifest
+	if err := json.Unmarshal(data, &manifest); err != nil || manifest.Version != uploadManifestVersion {
+		return nil
+	}
+	return &manifest
+}
+
+// Matches reports whether the manifest still describes filePath under key.
+func (m *UploadManifest) Matches(filePath, key string) bool {
+	if m == nil || m.Key != key || strings.TrimSpace(m.ReservationID) == "" {
+		return false
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil || absPath != m.FilePath {
+		return false
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return false
+	}
+	return info.Size() == m.FileSize && info.ModTime().UTC().Equal(m.ModTime)
+}
+
+// HasCompleted reports whether the byte range of op was already uploaded.
+func (m *UploadManifest) HasCompleted(op UploadOperation) bool {
+	if m == nil {
+		return false
+	}
+	for _, part := range m.Completed {
+		if part.Offset == op.Offset && part.Length == op.Length {
+			return true
+		}
+	}
+	return false
+}
+
+// Save writes the manifest atomically with user-only permissions.
+func (m *UploadManifest) Save(path string) error {
+	m.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*.json")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// RemoveUploadManifest deletes the manifest at path, ignoring a missing file.
+func RemoveUploadManifest(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -308,3 +308,92 @@ func TestVerifySourceFileChecksums(t *testing.T) {
 		t.Fatalf("expected SHA256 hash %s, got %#v", expected.File.Hash, computed.File)
 	}
 }
+
+func TestExecuteUploadOperations_ResumesFromManifestAndReportsProgress(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.ipa")
+	if err := os.WriteFile(filePath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ops := []UploadOperation{
+		{Method: "PUT", URL: server.URL + "/op0", Length: 4, Offset: 0},
+		{Method: "PUT", URL: server.URL + "/op1", Length: 4, Offset: 4},
+		{Method: "PUT", URL: server.URL + "/op2", Length: 2, Offset: 8},
+	}
+
+	manifest, err := NewUploadManifest(filePath, "key", "upload-1", "file-1")
+	if err != nil {
+		t.Fatalf("NewUploadManifest() error: %v", err)
+	}
+	manifest.Completed = []UploadManifestPart{{Offset: 4, Length: 4}}
+	manifestPath := filepath.Join(dir, "state", "manifest.json")
+
+	var updates []UploadProgress
+	err = ExecuteUploadOperations(context.Background(), filePath, ops,
+		WithUploadConcurrency(1),
+		WithUploadHTTPClient(server.Client()),
+		WithUploadManifest(manifestPath, manifest),
+		WithUploadProgress(func(progress UploadProgress) {
+			updates = append(updates, progress)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+
+	if strings.Join(paths, ",") != "/op0,/op2" {
+		t.Fatalf("expected only the missing parts to upload, got %v", paths)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("expected 3 progress updates, got %+v", updates)
+	}
+	if first := updates[0]; first.Name != "app.ipa" || first.UploadedBytes != 4 || first.TotalBytes != 10 || first.CompletedParts != 1 || first.TotalParts != 3 {
+		t.Fatalf("unexpected initial progress: %+v", first)
+	}
+	if last := updates[2]; last.UploadedBytes != 10 || last.CompletedParts != 3 {
+		t.Fatalf("unexpected final progress: %+v", last)
+	}
+
+	saved := LoadUploadManifest(manifestPath)
+	if saved == nil {
+		t.Fatal("expected manifest to be saved")
+	}
+	if !saved.Matches(filePath, "key") || saved.Matches(filePath, "other") {
+		t.Fatalf("unexpected manifest match result: %+v", saved)
+	}
+	if len(saved.Completed) != 3 {
+		t.Fatalf("expected all parts recorded, got %v", saved.Completed)
+	}
+}
+
+func TestResolveUploadConcurrency(t *testing.T) {
+	t.Cleanup(func() { SetUploadConcurrencyOverride(nil) })
+
+	t.Setenv("ASC_UPLOAD_CONCURRENCY", "")
+	if got := ResolveUploadConcurrency(); got != DefaultUploadConcurrency {
+		t.Fatalf("expected default %d, got %d", DefaultUploadConcurrency, got)
+	}
+
+	t.Setenv("ASC_UPLOAD_CONCURRENCY", "8")
+	if got := ResolveUploadConcurrency(); got != 8 {
+		t.Fatalf("expected env value 8, got %d", got)
+	}
+
+	value := 2
+	SetUploadConcurrencyOverride(&value)
+	if got := ResolveUploadConcurrency(); got != 2 {
+		t.Fatalf("expected override 2, got %d", got)
+	}
+}
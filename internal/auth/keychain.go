@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -200,7 +201,7 @@ func ValidateKeyFile(path string) error {
 	if info.IsDir() {
 		return fmt.Errorf("private key path is a directory")
 	}
-	if info.Mode().Perm()&0o077 != 0 {
+	if permissionsTooOpen(info.Mode()) {
 		return fmt.Errorf("private key file is too permissive; run: chmod 600 %q", path)
 	}
 
@@ -1595,3 +1596,16 @@ func configCleanupPaths() ([]string, error) {
 	}
 	return paths, nil
 }
+
+// runtimeGOOS is the platform permission checks apply to (tests override it).
+var runtimeGOOS = runtime.GOOS
+
+// permissionsTooOpen reports whether a secret file is readable or writable by
+// group or others. Windows has no Unix mode bits (regular files report 0666)
+// and relies on ACLs instead, so the check is skipped there.
+func permissionsTooOpen(mode os.FileMode) bool {
+	if runtimeGOOS == "windows" {
+		return false
+	}
+	return mode.Perm()&0o077 != 0
+}
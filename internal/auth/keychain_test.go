@@ -1609,3 +1609,21 @@ func TestGetCredentialsWithSource_KeychainAccessDeniedReturnsSentinel(t *testing
 		t.Fatalf("expected ErrKeychainAccessDenied, got %v", err)
 	}
 }
+
+func TestPermissionsTooOpen(t *testing.T) {
+	prev := runtimeGOOS
+	t.Cleanup(func() { runtimeGOOS = prev })
+
+	runtimeGOOS = "linux"
+	if !permissionsTooOpen(0o644) {
+		t.Fatal("expected 0644 to be too open on unix")
+	}
+	if permissionsTooOpen(0o600) {
+		t.Fatal("expected 0600 to be accepted on unix")
+	}
+
+	runtimeGOOS = "windows"
+	if permissionsTooOpen(os.FileMode(0o666)) {
+		t.Fatal("expected mode bits to be ignored on windows")
+	}
+}
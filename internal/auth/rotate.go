@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// RotateCredentials replaces the key stored under name with a new key and
+// keeps the previous key under archiveName in the same storage backend.
+//
+// Keychain profiles are archived first and then overwritten in place, so the
+// profile name always resolves to a complete credential. Config profiles are
+// rewritten in a single atomic config save.
+func RotateCredentials(name, keyID, issuerID, keyPath, archiveName string) (Credential, error) {
+	name = strings.TrimSpace(name)
+	archiveName = strings.TrimSpace(archiveName)
+	if name == "" {
+		return Credential{}, fmt.Errorf("profile name is required")
+	}
+	if archiveName == "" || archiveName == name {
+		return Credential{}, fmt.Errorf("archive name must differ from profile name")
+	}
+
+	credentials, err := ListCredentials()
+	if err != nil {
+		if _, ok := errors.AsType[*CredentialsWarning](err); !ok {
+			return Credential{}, err
+		}
+	}
+	var (
+		previous Credential
+		found    bool
+	)
+	for _, cred := range credentials {
+		credName := strings.TrimSpace(cred.Name)
+		if credName == archiveName {
+			return Credential{}, fmt.Errorf("archive profile %q already exists", archiveName)
+		}
+		if credName == name && !found {
+			previous = cred
+			found = true
+		}
+	}
+	if !found {
+		return Credential{}, fmt.Errorf("profile %q not found", name)
+	}
+
+	payload := credentialPayload{
+		KeyID:          keyID,
+		IssuerID:       issuerID,
+		PrivateKeyPath: keyPath,
+	}
+
+	if previous.Source == "keychain" {
+		if privateKeyPEM, err := loadPrivateKeyPEMForStorage(keyPath); err == nil && strings.TrimSpace(privateKeyPEM) != "" {
+			payload.PrivateKeyPEM = privateKeyPEM
+		}
+		archived := credentialPayload{
+			KeyID:          previous.KeyID,
+			IssuerID:       previous.IssuerID,
+			PrivateKeyPath: previous.PrivateKeyPath,
+			PrivateKeyPEM:  previous.PrivateKeyPEM,
+		}
+		if err := storeInKeychain(archiveName, archived); err != nil {
+			return Credential{}, fmt.Errorf("failed to archive previous key: %w", err)
+		}
+		if err := storeInKeychain(name, payload); err != nil {
+			_ = removeFromKeychain(archiveName)
+			return Credential{}, err
+		}
+		return previous, nil
+	}
+
+	configPath := strings.TrimSpace(previous.SourcePath)
+	if configPath == "" {
+		configPath, err = config.Path()
+		if err != nil {
+			return Credential{}, err
+		}
+	}
+	if err := rotateInConfigAt(configPath, name, archiveName, payload); err != nil {
+		return Credential{}, err
+	}
+	return previous, nil
+}
+
+func rotateInConfigAt(configPath, name, archiveName string, payload credentialPayload) error {
+	cfg, err := config.LoadAt(configPath)
+	if err != nil {
+		return err
+	}
+
+	// Promote legacy top-level credentials so both entries live in Keys.
+	keys := configCredentialList(cfg)
+	index := -1
+	for i, cred := range keys {
+		if cred.Name == name {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return fmt.Errorf("profile %q not found in %s", name, configPath)
+	}
+
+	archived := keys[index]
+	archived.Name = archiveName
+	keys[index].KeyID = payload.KeyID
+	keys[index].IssuerID = payload.IssuerID
+	keys[index].PrivateKeyPath = payload.PrivateKeyPath
+	cfg.Keys = append(keys, archived)
+
+	// Top-level fields mirror the active profile; keep them in sync.
+	if strings.TrimSpace(cfg.DefaultKeyName) == name ||
+		(strings.TrimSpace(cfg.KeyID) == strings.TrimSpace(archived.KeyID) &&
+			strings.TrimSpace(cfg.PrivateKeyPath) == strings.TrimSpace(archived.PrivateKeyPath)) {
+		cfg.KeyID = payload.KeyID
+		cfg.IssuerID = payload.IssuerID
+		cfg.PrivateKeyPath = payload.PrivateKeyPath
+	}
+	return config.SaveAt(configPath, cfg)
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRotateCredentials_KeychainArchivesPreviousKey(t *testing.T) {
+	withArrayKeyring(t)
+
+	oldKeyPath := filepath.Join(t.TempDir(), "AuthKey_OLD.p8")
+	writeECDSAPEM(t, oldKeyPath, 0o600, true)
+	newKeyPath := filepath.Join(t.TempDir(), "AuthKey_NEW.p8")
+	writeECDSAPEM(t, newKeyPath, 0o600, true)
+
+	if err := StoreCredentials("ci", "OLD", "ISS", oldKeyPath); err != nil {
+		t.Fatalf("StoreCredentials() error: %v", err)
+	}
+
+	previous, err := RotateCredentials("ci", "NEW", "ISS", newKeyPath, "ci-old")
+	if err != nil {
+		t.Fatalf("RotateCredentials() error: %v", err)
+	}
+	if previous.KeyID != "OLD" {
+		t.Fatalf("expected previous key OLD, got %q", previous.KeyID)
+	}
+
+	creds, err := ListCredentials()
+	if err != nil {
+		t.Fatalf("ListCredentials() error: %v", err)
+	}
+	byName := map[string]Credential{}
+	for _, cred := range creds {
+		byName[cred.Name] = cred
+	}
+	if byName["ci"].KeyID != "NEW" || !byName["ci"].IsDefault {
+		t.Fatalf("expected ci to use NEW and stay default, got %+v", byName["ci"])
+	}
+	archived := byName["ci-old"]
+	if archived.KeyID != "OLD" || strings.TrimSpace(archived.PrivateKeyPEM) == "" {
+		t.Fatalf("expected archived OLD key with PEM, got %+v", archived)
+	}
+}
+
+func TestRotateCredentials_RejectsExistingArchiveName(t *testing.T) {
+	withArrayKeyring(t)
+
+	if err := StoreCredentials("ci", "OLD", "ISS", "/tmp/AuthKey_OLD.p8"); err != nil {
+		t.Fatalf("StoreCredentials() error: %v", err)
+	}
+	if err := StoreCredentials("backup", "BAK", "ISS", "/tmp/AuthKey_BAK.p8"); err != nil {
+		t.Fatalf("StoreCredentials() error: %v", err)
+	}
+
+	_, err := RotateCredentials("ci", "NEW", "ISS", "/tmp/AuthKey_NEW.p8", "backup")
+	if err == nil || !strings.Contains(err.Error(), `archive profile "backup" already exists`) {
+		t.Fatalf("expected archive conflict error, got %v", err)
+	}
+}
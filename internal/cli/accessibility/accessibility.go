@@ -29,7 +29,10 @@ Examples:
   asc accessibility get --id "DECLARATION_ID"
   asc accessibility create --app "APP_ID" --device-family IPHONE --supports-voiceover true
   asc accessibility update --id "DECLARATION_ID" --publish true
-  asc accessibility delete --id "DECLARATION_ID" --confirm`,
+  asc accessibility delete --id "DECLARATION_ID" --confirm
+  asc accessibility pull --app "APP_ID" --out "./accessibility.json"
+  asc accessibility plan --app "APP_ID" --file "./accessibility.json"
+  asc accessibility apply --app "APP_ID" --file "./accessibility.json"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -38,6 +41,9 @@ Examples:
 			AccessibilityCreateCommand(),
 			AccessibilityUpdateCommand(),
 			AccessibilityDeleteCommand(),
+			AccessibilityPullCommand(),
+			AccessibilityPlanCommand(),
+			AccessibilityApplyCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -322,7 +328,7 @@ Examples:
 				return fmt.Errorf("accessibility update: %w", err)
 			}
 
-			if !asc.HasAccessibilityDeclarationUpdates(attrs) {
+			if !asc.HasAccessibilityDeclarationUpdates(attrs) && !shared.UpdatePatchRequested(ctx) {
 				return fmt.Errorf("accessibility update: at least one update flag is required")
 			}
 
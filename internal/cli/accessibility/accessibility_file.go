@@ -0,0 +1,757 @@
+package accessibility
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const accessibilitySchemaVersion = 1
+
+const (
+	accessibilityActionCreate = "create"
+	accessibilityActionUpdate = "update"
+	accessibilityActionNoop   = "noop"
+)
+
+// accessibilityDeclarationFile is the canonical on-disk declaration format.
+// Each entry describes one device family; unset capabilities are left as-is.
+type accessibilityDeclarationFile struct {
+	SchemaVersion int                      `json:"schemaVersion"`
+	Declarations  []accessibilityFileEntry `json:"declarations"`
+}
+
+type accessibilityFileEntry struct {
+	DeviceFamily                           string `json:"deviceFamily"`
+	SupportsAudioDescriptions              *bool  `json:"supportsAudioDescriptions,omitempty"`
+	SupportsCaptions                       *bool  `json:"supportsCaptions,omitempty"`
+	SupportsDarkInterface                  *bool  `json:"supportsDarkInterface,omitempty"`
+	SupportsDifferentiateWithoutColorAlone *bool  `json:"supportsDifferentiateWithoutColorAlone,omitempty"`
+	SupportsLargerText                     *bool  `json:"supportsLargerText,omitempty"`
+	SupportsReducedMotion                  *bool  `json:"supportsReducedMotion,omitempty"`
+	SupportsSufficientContrast             *bool  `json:"supportsSufficientContrast,omitempty"`
+	SupportsVoiceControl                   *bool  `json:"supportsVoiceControl,omitempty"`
+	SupportsVoiceover                      *bool  `json:"supportsVoiceover,omitempty"`
+}
+
+type accessibilityRemoteDeclaration struct {
+	ID    string
+	State string
+	Entry accessibilityFileEntry
+}
+
+type accessibilityPlanChange struct {
+	DeviceFamily  string   `json:"deviceFamily"`
+	Action        string   `json:"action"`
+	DeclarationID string   `json:"declarationId,omitempty"`
+	RemoteState   string   `json:"remoteState,omitempty"`
+	Fields        []string `json:"fields,omitempty"`
+}
+
+type accessibilityPlanOutput struct {
+	AppID     string                    `json:"appId"`
+	File      string                    `json:"file"`
+	Changes   []accessibilityPlanChange `json:"changes"`
+	Unmanaged []string                  `json:"unmanaged,omitempty"`
+}
+
+type accessibilityApplyOutput struct {
+	AppID     string                    `json:"appId"`
+	File      string                    `json:"file"`
+	Changes   []accessibilityPlanChange `json:"changes"`
+	Unmanaged []string                  `json:"unmanaged,omitempty"`
+	Applied   bool                      `json:"applied"`
+	Published []string                  `json:"published,omitempty"`
+}
+
+type accessibilityPullOutput struct {
+	AppID       string                       `json:"appId"`
+	Declaration accessibilityDeclarationFile `json:"declaration"`
+	Out         string                       `json:"out,omitempty"`
+}
+
+type accessibilityMutationClient interface {
+	CreateAccessibilityDeclaration(ctx context.Context, appID string, attrs asc.AccessibilityDeclarationCreateAttributes) (*asc.AccessibilityDeclarationResponse, error)
+	UpdateAccessibilityDeclaration(ctx context.Context, declarationID string, attrs asc.AccessibilityDeclarationUpdateAttributes) (*asc.AccessibilityDeclarationResponse, error)
+}
+
+// accessibilityEntryField pairs a file/API attribute name with its value accessor.
+type accessibilityEntryField struct {
+	name string
+	get  func(*accessibilityFileEntry) **bool
+}
+
+var accessibilityEntryFields = []accessibilityEntryField{
+	{"supportsAudioDescriptions", func(e *accessibilityFileEntry) **bool { return &e.SupportsAudioDescriptions }},
+	{"supportsCaptions", func(e *accessibilityFileEntry) **bool { return &e.SupportsCaptions }},
+	{"supportsDarkInterface", func(e *accessibilityFileEntry) **bool { return &e.SupportsDarkInterface }},
+	{"supportsDifferentiateWithoutColorAlone", func(e *accessibilityFileEntry) **bool { return &e.SupportsDifferentiateWithoutColorAlone }},
+	{"supportsLargerText", func(e *accessibilityFileEntry) **bool { return &e.SupportsLargerText }},
+	{"supportsReducedMotion", func(e *accessibilityFileEntry) **bool { return &e.SupportsReducedMotion }},
+	{"supportsSufficientContrast", func(e *accessibilityFileEntry) **bool { return &e.SupportsSufficientContrast }},
+	{"supportsVoiceControl", func(e *accessibilityFileEntry) **bool { return &e.SupportsVoiceControl }},
+	{"supportsVoiceover", func(e *accessibilityFileEntry) **bool { return &e.SupportsVoiceover }},
+}
+
+func normalizeAccessibilityDeclarationFile(declaration accessibilityDeclarationFile) (accessibilityDeclarationFile, error) {
+	if declaration.SchemaVersion == 0 {
+		declaration.SchemaVersion = accessibilitySchemaVersion
+	}
+	if declaration.SchemaVersion != accessibilitySchemaVersion {
+		return accessibilityDeclarationFile{}, fmt.Errorf("schemaVersion must be %d", accessibilitySchemaVersion)
+	}
+	if len(declaration.Declarations) == 0 {
+		return accessibilityDeclarationFile{}, fmt.Errorf("declarations must contain at least one entry")
+	}
+
+	seen := map[string]struct{}{}
+	entries := make([]accessibilityFileEntry, 0, len(declaration.Declarations))
+	for i, entry := range declaration.Declarations {
+		family := strings.ToUpper(strings.TrimSpace(entry.DeviceFamily))
+		if family == "" {
+			return accessibilityDeclarationFile{}, fmt.Errorf("declarations[%d].deviceFamily is required", i)
+		}
+		if _, ok := accessibilityDeviceFamilies[family]; !ok {
+			return accessibilityDeclarationFile{}, fmt.Errorf("declarations[%d].deviceFamily must be one of: %s", i, strings.Join(accessibilityDeviceFamilyList(), ", "))
+		}
+		if _, exists := seen[family]; exists {
+			return accessibilityDeclarationFile{}, fmt.Errorf("declarations[%d].deviceFamily %s is duplicated", i, family)
+		}
+		seen[family] = struct{}{}
+		entry.DeviceFamily = family
+		entries = append(entries, entry)
+	}
+	sortAccessibilityEntries(entries)
+	declaration.Declarations = entries
+	return declaration, nil
+}
+
+func sortAccessibilityEntries(entries []accessibilityFileEntry) {
+	order := map[string]int{}
+	for i, family := range accessibilityDeviceFamilyList() {
+		order[family] = i
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return order[entries[i].DeviceFamily] < order[entries[j].DeviceFamily]
+	})
+}
+
+func parseAccessibilityDeclarationFile(path string) (accessibilityDeclarationFile, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return accessibilityDeclarationFile{}, fmt.Errorf("file path is required")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return accessibilityDeclarationFile{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var declaration accessibilityDeclarationFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&declaration); err != nil {
+		return accessibilityDeclarationFile{}, fmt.Errorf("invalid accessibility declaration JSON: %w", err)
+	}
+	var trailing json.RawMessage
+	if err := decoder.Decode(&trailing); err != io.EOF {
+		if err == nil {
+			return accessibilityDeclarationFile{}, fmt.Errorf("invalid accessibility declaration JSON: multiple JSON values found")
+		}
+		return accessibilityDeclarationFile{}, fmt.Errorf("invalid accessibility declaration JSON: %w", err)
+	}
+	return normalizeAccessibilityDeclarationFile(declaration)
+}
+
+func writeAccessibilityDeclarationFile(path string, declaration accessibilityDeclarationFile) error {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return fmt.Errorf("output path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	jsonData, err := json.MarshalIndent(declaration, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal accessibility declaration: %w", err)
+	}
+	jsonData = append(jsonData, '\n')
+	if err := os.WriteFile(path, jsonData, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+func accessibilityEntryFromAttributes(attrs asc.AccessibilityDeclarationAttributes) accessibilityFileEntry {
+	return accessibilityFileEntry{
+		DeviceFamily:                           string(attrs.DeviceFamily),
+		SupportsAudioDescriptions:              attrs.SupportsAudioDescriptions,
+		SupportsCaptions:                       attrs.SupportsCaptions,
+		SupportsDarkInterface:                  attrs.SupportsDarkInterface,
+		SupportsDifferentiateWithoutColorAlone: attrs.SupportsDifferentiateWithoutColorAlone,
+		SupportsLargerText:                     attrs.SupportsLargerText,
+		SupportsReducedMotion:                  attrs.SupportsReducedMotion,
+		SupportsSufficientContrast:             attrs.SupportsSufficientContrast,
+		SupportsVoiceControl:                   attrs.SupportsVoiceControl,
+		SupportsVoiceover:                      attrs.SupportsVoiceover,
+	}
+}
+
+// currentAccessibilityDeclarations picks the working declaration per device family.
+// A DRAFT wins over a PUBLISHED declaration; REPLACED declarations are ignored.
+func currentAccessibilityDeclarations(resp *asc.AccessibilityDeclarationsResponse) map[string]accessibilityRemoteDeclaration {
+	current := map[string]accessibilityRemoteDeclaration{}
+	if resp == nil {
+		return current
+	}
+	for _, item := range resp.Data {
+		state := strings.ToUpper(string(item.Attributes.State))
+		if state == string(asc.AccessibilityDeclarationStateReplaced) {
+			continue
+		}
+		family := strings.ToUpper(string(item.Attributes.DeviceFamily))
+		if family == "" {
+			continue
+		}
+		if existing, ok := current[family]; ok && existing.State == string(asc.AccessibilityDeclarationStateDraft) {
+			continue
+		}
+		entry := accessibilityEntryFromAttributes(item.Attributes)
+		entry.DeviceFamily = family
+		current[family] = accessibilityRemoteDeclaration{
+			ID:    strings.TrimSpace(item.ID),
+			State: state,
+			Entry: entry,
+		}
+	}
+	return current
+}
+
+func declarationFromRemoteAccessibility(remote map[string]accessibilityRemoteDeclaration) accessibilityDeclarationFile {
+	entries := make([]accessibilityFileEntry, 0, len(remote))
+	for _, declaration := range remote {
+		entries = append(entries, declaration.Entry)
+	}
+	sortAccessibilityEntries(entries)
+	return accessibilityDeclarationFile{
+		SchemaVersion: accessibilitySchemaVersion,
+		Declarations:  entries,
+	}
+}
+
+// changedAccessibilityFields lists capabilities set in desired that differ from remote.
+func changedAccessibilityFields(desired, remote accessibilityFileEntry) []string {
+	var changed []string
+	for _, field := range accessibilityEntryFields {
+		want := *field.get(&desired)
+		if want == nil {
+			continue
+		}
+		have := *field.get(&remote)
+		if have == nil || *have != *want {
+			changed = append(changed, field.name)
+		}
+	}
+	return changed
+}
+
+// mergeAccessibilityEntry fills capabilities unset in desired from base, so a
+// draft that supersedes a published declaration keeps what the file omits.
+func mergeAccessibilityEntry(desired, base accessibilityFileEntry) accessibilityFileEntry {
+	merged := desired
+	for _, field := range accessibilityEntryFields {
+		if target := field.get(&merged); *target == nil {
+			*target = *field.get(&base)
+		}
+	}
+	return merged
+}
+
+func setAccessibilityFields(entry accessibilityFileEntry) []string {
+	var set []string
+	for _, field := range accessibilityEntryFields {
+		if *field.get(&entry) != nil {
+			set = append(set, field.name)
+		}
+	}
+	return set
+}
+
+func planAccessibilityChanges(appID, file string, desired accessibilityDeclarationFile, remote map[string]accessibilityRemoteDeclaration) accessibilityPlanOutput {
+	plan := accessibilityPlanOutput{
+		AppID:   appID,
+		File:    file,
+		Changes: make([]accessibilityPlanChange, 0, len(desired.Declarations)),
+	}
+
+	managed := map[string]struct{}{}
+	for _, entry := range desired.Declarations {
+		managed[entry.DeviceFamily] = struct{}{}
+		existing, ok := remote[entry.DeviceFamily]
+		switch {
+		case !ok:
+			plan.Changes = append(plan.Changes, accessibilityPlanChange{
+				DeviceFamily: entry.DeviceFamily,
+				Action:       accessibilityActionCreate,
+				Fields:       setAccessibilityFields(entry),
+			})
+		default:
+			fields := changedAccessibilityFields(entry, existing.Entry)
+			change := accessibilityPlanChange{
+				DeviceFamily:  entry.DeviceFamily,
+				DeclarationID: existing.ID,
+				RemoteState:   existing.State,
+				Fields:        fields,
+			}
+			switch {
+			case len(fields) == 0:
+				change.Action = accessibilityActionNoop
+			case existing.State == string(asc.AccessibilityDeclarationStateDraft):
+				change.Action = accessibilityActionUpdate
+			default:
+				// Published declarations are immutable; a new draft replaces them.
+				change.Action = accessibilityActionCreate
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	for family := range remote {
+		if _, ok := managed[family]; !ok {
+			plan.Unmanaged = append(plan.Unmanaged, family)
+		}
+	}
+	sort.Strings(plan.Unmanaged)
+	return plan
+}
+
+func accessibilityCreateAttributesFromEntry(entry accessibilityFileEntry) asc.AccessibilityDeclarationCreateAttributes {
+	return asc.AccessibilityDeclarationCreateAttributes{
+		DeviceFamily:                           asc.DeviceFamily(entry.DeviceFamily),
+		SupportsAudioDescriptions:              entry.SupportsAudioDescriptions,
+		SupportsCaptions:                       entry.SupportsCaptions,
+		SupportsDarkInterface:                  entry.SupportsDarkInterface,
+		SupportsDifferentiateWithoutColorAlone: entry.SupportsDifferentiateWithoutColorAlone,
+		SupportsLargerText:                     entry.SupportsLargerText,
+		SupportsReducedMotion:                  entry.SupportsReducedMotion,
+		SupportsSufficientContrast:             entry.SupportsSufficientContrast,
+		SupportsVoiceControl:                   entry.SupportsVoiceControl,
+		SupportsVoiceover:                      entry.SupportsVoiceover,
+	}
+}
+
+func accessibilityUpdateAttributesFromEntry(entry accessibilityFileEntry) asc.AccessibilityDeclarationUpdateAttributes {
+	return asc.AccessibilityDeclarationUpdateAttributes{
+		SupportsAudioDescriptions:              entry.SupportsAudioDescriptions,
+		SupportsCaptions:                       entry.SupportsCaptions,
+		SupportsDarkInterface:                  entry.SupportsDarkInterface,
+		SupportsDifferentiateWithoutColorAlone: entry.SupportsDifferentiateWithoutColorAlone,
+		SupportsLargerText:                     entry.SupportsLargerText,
+		SupportsReducedMotion:                  entry.SupportsReducedMotion,
+		SupportsSufficientContrast:             entry.SupportsSufficientContrast,
+		SupportsVoiceControl:                   entry.SupportsVoiceControl,
+		SupportsVoiceover:                      entry.SupportsVoiceover,
+	}
+}
+
+// applyAccessibilityPlan executes plan changes and returns the resulting draft IDs in plan order.
+// Drafts that supersede a published declaration start from its capabilities.
+func applyAccessibilityPlan(ctx context.Context, client accessibilityMutationClient, appID string, desired accessibilityDeclarationFile, remote map[string]accessibilityRemoteDeclaration, plan accessibilityPlanOutput) ([]string, error) {
+	entries := map[string]accessibilityFileEntry{}
+	for _, entry := range desired.Declarations {
+		entries[entry.DeviceFamily] = entry
+	}
+
+	draftIDs := make([]string, 0, len(plan.Changes))
+	for i, change := range plan.Changes {
+		entry := entries[change.DeviceFamily]
+		switch change.Action {
+		case accessibilityActionCreate:
+			if existing, ok := remote[change.DeviceFamily]; ok {
+				entry = mergeAccessibilityEntry(entry, existing.Entry)
+			}
+			resp, err := client.CreateAccessibilityDeclaration(ctx, appID, accessibilityCreateAttributesFromEntry(entry))
+			if err != nil {
+				return draftIDs, fmt.Errorf("create %s declaration: %w", change.DeviceFamily, err)
+			}
+			plan.Changes[i].DeclarationID = strings.TrimSpace(resp.Data.ID)
+			draftIDs = append(draftIDs, plan.Changes[i].DeclarationID)
+		case accessibilityActionUpdate:
+			if _, err := client.UpdateAccessibilityDeclaration(ctx, change.DeclarationID, accessibilityUpdateAttributesFromEntry(entry)); err != nil {
+				return draftIDs, fmt.Errorf("update %s declaration %s: %w", change.DeviceFamily, change.DeclarationID, err)
+			}
+			draftIDs = append(draftIDs, change.DeclarationID)
+		}
+	}
+	return draftIDs, nil
+}
+
+func fetchAccessibilityDeclarations(ctx context.Context, client *asc.Client, appID string) (*asc.AccessibilityDeclarationsResponse, error) {
+	firstPage, err := client.GetAccessibilityDeclarations(ctx, appID, asc.WithAccessibilityDeclarationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	pages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAccessibilityDeclarations(ctx, appID, asc.WithAccessibilityDeclarationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := pages.(*asc.AccessibilityDeclarationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected accessibility declarations response type %T", pages)
+	}
+	return resp, nil
+}
+
+func formatAccessibilityBool(value *bool) string {
+	if value == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%t", *value)
+}
+
+func buildAccessibilityFileRows(entries []accessibilityFileEntry) [][]string {
+	if len(entries) == 0 {
+		return [][]string{{"n/a", "n/a", "n/a"}}
+	}
+	rows := make([][]string, 0, len(entries)*len(accessibilityEntryFields))
+	for _, entry := range entries {
+		for _, field := range accessibilityEntryFields {
+			value := *field.get(&entry)
+			if value == nil {
+				continue
+			}
+			rows = append(rows, []string{entry.DeviceFamily, field.name, formatAccessibilityBool(value)})
+		}
+	}
+	if len(rows) == 0 {
+		return [][]string{{"n/a", "n/a", "n/a"}}
+	}
+	return rows
+}
+
+func buildAccessibilityPlanRows(changes []accessibilityPlanChange) [][]string {
+	if len(changes) == 0 {
+		return [][]string{{"n/a", "n/a", "n/a", "n/a", "n/a"}}
+	}
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		fields := "n/a"
+		if len(change.Fields) > 0 {
+			fields = strings.Join(change.Fields, ", ")
+		}
+		rows = append(rows, []string{
+			change.DeviceFamily,
+			change.Action,
+			valueOrNA(change.DeclarationID),
+			valueOrNA(change.RemoteState),
+			fields,
+		})
+	}
+	return rows
+}
+
+func valueOrNA(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "n/a"
+	}
+	return trimmed
+}
+
+func renderAccessibilityPullTable(payload accessibilityPullOutput) error {
+	fmt.Printf("App ID: %s\n", payload.AppID)
+	if strings.TrimSpace(payload.Out) != "" {
+		fmt.Printf("Output File: %s\n", payload.Out)
+	}
+	fmt.Println()
+	asc.RenderTable([]string{"Device Family", "Capability", "Supported"}, buildAccessibilityFileRows(payload.Declaration.Declarations))
+	return nil
+}
+
+func renderAccessibilityPullMarkdown(payload accessibilityPullOutput) error {
+	fmt.Printf("**App ID:** %s\n\n", payload.AppID)
+	if strings.TrimSpace(payload.Out) != "" {
+		fmt.Printf("**Output File:** %s\n\n", payload.Out)
+	}
+	asc.RenderMarkdown([]string{"Device Family", "Capability", "Supported"}, buildAccessibilityFileRows(payload.Declaration.Declarations))
+	return nil
+}
+
+func renderAccessibilityPlanTable(payload accessibilityPlanOutput) error {
+	fmt.Printf("App ID: %s\n", payload.AppID)
+	fmt.Printf("File: %s\n", payload.File)
+	if len(payload.Unmanaged) > 0 {
+		fmt.Printf("Unmanaged: %s\n", strings.Join(payload.Unmanaged, ", "))
+	}
+	fmt.Println()
+	asc.RenderTable([]string{"Device Family", "Action", "Declaration ID", "Remote State", "Fields"}, buildAccessibilityPlanRows(payload.Changes))
+	return nil
+}
+
+func renderAccessibilityPlanMarkdown(payload accessibilityPlanOutput) error {
+	fmt.Printf("**App ID:** %s\n\n", payload.AppID)
+	fmt.Printf("**File:** %s\n\n", payload.File)
+	if len(payload.Unmanaged) > 0 {
+		fmt.Printf("**Unmanaged:** %s\n\n", strings.Join(payload.Unmanaged, ", "))
+	}
+	asc.RenderMarkdown([]string{"Device Family", "Action", "Declaration ID", "Remote State", "Fields"}, buildAccessibilityPlanRows(payload.Changes))
+	return nil
+}
+
+func renderAccessibilityApplyTable(payload accessibilityApplyOutput) error {
+	fmt.Printf("App ID: %s\n", payload.AppID)
+	fmt.Printf("File: %s\n", payload.File)
+	fmt.Printf("Applied: %t\n", payload.Applied)
+	if len(payload.Published) > 0 {
+		fmt.Printf("Published: %s\n", strings.Join(payload.Published, ", "))
+	}
+	fmt.Println()
+	asc.RenderTable([]string{"Device Family", "Action", "Declaration ID", "Remote State", "Fields"}, buildAccessibilityPlanRows(payload.Changes))
+	return nil
+}
+
+func renderAccessibilityApplyMarkdown(payload accessibilityApplyOutput) error {
+	fmt.Printf("**App ID:** %s\n\n", payload.AppID)
+	fmt.Printf("**File:** %s\n\n", payload.File)
+	fmt.Printf("**Applied:** %t\n\n", payload.Applied)
+	if len(payload.Published) > 0 {
+		fmt.Printf("**Published:** %s\n\n", strings.Join(payload.Published, ", "))
+	}
+	asc.RenderMarkdown([]string{"Device Family", "Action", "Declaration ID", "Remote State", "Fields"}, buildAccessibilityPlanRows(payload.Changes))
+	return nil
+}
+
+// AccessibilityPullCommand returns the accessibility pull subcommand.
+func AccessibilityPullCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pull", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	out := fs.String("out", "", "Optional output file path for canonical declaration JSON")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "pull",
+		ShortUsage: "asc accessibility pull --app APP_ID [--out FILE] [flags]",
+		ShortHelp:  "Pull accessibility declarations as a canonical file.",
+		LongHelp: `Pull the current accessibility declarations for an app and emit canonical
+JSON that can be used with plan/apply. Draft declarations take precedence
+over published ones; replaced declarations are ignored.
+
+Examples:
+  asc accessibility pull --app "APP_ID"
+  asc accessibility pull --app "APP_ID" --out "./accessibility.json"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("accessibility pull does not accept positional arguments")
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("accessibility pull: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := fetchAccessibilityDeclarations(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("accessibility pull: failed to fetch: %w", err)
+			}
+
+			declaration := declarationFromRemoteAccessibility(currentAccessibilityDeclarations(resp))
+			outPath := strings.TrimSpace(*out)
+			if outPath != "" {
+				if err := writeAccessibilityDeclarationFile(outPath, declaration); err != nil {
+					return fmt.Errorf("accessibility pull: %w", err)
+				}
+			}
+
+			payload := accessibilityPullOutput{
+				AppID:       resolvedAppID,
+				Declaration: declaration,
+				Out:         outPath,
+			}
+			return shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderAccessibilityPullTable(payload) },
+				func() error { return renderAccessibilityPullMarkdown(payload) },
+			)
+		},
+	}
+}
+
+// AccessibilityPlanCommand returns the accessibility plan subcommand.
+func AccessibilityPlanCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	filePath := fs.String("file", "", "Path to canonical accessibility declaration JSON")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "plan",
+		ShortUsage: "asc accessibility plan --app APP_ID --file FILE [flags]",
+		ShortHelp:  "Plan accessibility declaration changes from a file.",
+		LongHelp: `Compute a deterministic diff between a local declaration file and the
+app's current accessibility declarations. Device families missing from the
+file are reported as unmanaged and never modified.
+
+Examples:
+  asc accessibility plan --app "APP_ID" --file "./accessibility.json"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("accessibility plan does not accept positional arguments")
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			resolvedFilePath := strings.TrimSpace(*filePath)
+			if resolvedFilePath == "" {
+				return shared.UsageError("--file is required")
+			}
+
+			desired, err := parseAccessibilityDeclarationFile(resolvedFilePath)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("accessibility plan: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := fetchAccessibilityDeclarations(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("accessibility plan: failed to fetch: %w", err)
+			}
+
+			plan := planAccessibilityChanges(resolvedAppID, resolvedFilePath, desired, currentAccessibilityDeclarations(resp))
+			return shared.PrintOutputWithRenderers(
+				plan,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderAccessibilityPlanTable(plan) },
+				func() error { return renderAccessibilityPlanMarkdown(plan) },
+			)
+		},
+	}
+}
+
+// AccessibilityApplyCommand returns the accessibility apply subcommand.
+func AccessibilityApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	filePath := fs.String("file", "", "Path to canonical accessibility declaration JSON")
+	publish := fs.Bool("publish", false, "Publish created or updated drafts after applying")
+	confirm := fs.Bool("confirm", false, "Confirm publishing (required with --publish)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc accessibility apply --app APP_ID --file FILE [--publish --confirm] [flags]",
+		ShortHelp:  "Apply accessibility declaration changes from a file.",
+		LongHelp: `Apply a local declaration file to the app's accessibility declarations.
+Drafts are updated in place; published declarations are superseded by a new
+draft that keeps the published values of capabilities the file omits.
+Nothing is published unless --publish --confirm is set.
+
+Examples:
+  asc accessibility apply --app "APP_ID" --file "./accessibility.json"
+  asc accessibility apply --app "APP_ID" --file "./accessibility.json" --publish --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("accessibility apply does not accept positional arguments")
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			resolvedFilePath := strings.TrimSpace(*filePath)
+			if resolvedFilePath == "" {
+				return shared.UsageError("--file is required")
+			}
+			if *publish && !*confirm {
+				return shared.UsageError("--confirm is required when --publish is set")
+			}
+
+			desired, err := parseAccessibilityDeclarationFile(resolvedFilePath)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("accessibility apply: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := fetchAccessibilityDeclarations(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("accessibility apply: failed to fetch: %w", err)
+			}
+
+			remote := currentAccessibilityDeclarations(resp)
+			plan := planAccessibilityChanges(resolvedAppID, resolvedFilePath, desired, remote)
+			draftIDs, err := applyAccessibilityPlan(requestCtx, client, resolvedAppID, desired, remote, plan)
+			if err != nil {
+				return fmt.Errorf("accessibility apply: %w", err)
+			}
+
+			payload := accessibilityApplyOutput{
+				AppID:     resolvedAppID,
+				File:      resolvedFilePath,
+				Changes:   plan.Changes,
+				Unmanaged: plan.Unmanaged,
+				Applied:   true,
+			}
+			if *publish {
+				publishValue := true
+				for _, id := range draftIDs {
+					if _, err := client.UpdateAccessibilityDeclaration(requestCtx, id, asc.AccessibilityDeclarationUpdateAttributes{Publish: &publishValue}); err != nil {
+						return fmt.Errorf("accessibility apply: failed to publish %s: %w", id, err)
+					}
+					payload.Published = append(payload.Published, id)
+				}
+			}
+
+			return shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderAccessibilityApplyTable(payload) },
+				func() error { return renderAccessibilityApplyMarkdown(payload) },
+			)
+		},
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestAccessibilityCommandShape(t *testing.T) {
@@ -15,8 +19,8 @@ func TestAccessibilityCommandShape(t *testing.T) {
 	if cmd.Name != "accessibility" {
 		t.Fatalf("unexpected command name: %q", cmd.Name)
 	}
-	if len(cmd.Subcommands) != 5 {
-		t.Fatalf("expected 5 subcommands, got %d", len(cmd.Subcommands))
+	if len(cmd.Subcommands) != 8 {
+		t.Fatalf("expected 8 subcommands, got %d", len(cmd.Subcommands))
 	}
 	if got := AccessibilityCommand(); got == nil {
 		t.Fatal("expected Command wrapper to return command")
@@ -87,3 +91,160 @@ func TestAccessibilityHelpers(t *testing.T) {
 		t.Fatal("expected bool parsing error")
 	}
 }
+
+type fakeAccessibilityMutationClient struct {
+	created []asc.AccessibilityDeclarationCreateAttributes
+	updated map[string]asc.AccessibilityDeclarationUpdateAttributes
+}
+
+func (f *fakeAccessibilityMutationClient) CreateAccessibilityDeclaration(ctx context.Context, appID string, attrs asc.AccessibilityDeclarationCreateAttributes) (*asc.AccessibilityDeclarationResponse, error) {
+	f.created = append(f.created, attrs)
+	resp := &asc.AccessibilityDeclarationResponse{}
+	resp.Data.ID = "new-" + string(attrs.DeviceFamily)
+	return resp, nil
+}
+
+func (f *fakeAccessibilityMutationClient) UpdateAccessibilityDeclaration(ctx context.Context, declarationID string, attrs asc.AccessibilityDeclarationUpdateAttributes) (*asc.AccessibilityDeclarationResponse, error) {
+	if f.updated == nil {
+		f.updated = map[string]asc.AccessibilityDeclarationUpdateAttributes{}
+	}
+	f.updated[declarationID] = attrs
+	return &asc.AccessibilityDeclarationResponse{}, nil
+}
+
+func TestParseAccessibilityDeclarationFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	if err := os.WriteFile(valid, []byte(`{"schemaVersion":1,"declarations":[{"deviceFamily":"ipad","supportsCaptions":true},{"deviceFamily":"IPHONE"}]}`), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	declaration, err := parseAccessibilityDeclarationFile(valid)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(declaration.Declarations) != 2 || declaration.Declarations[0].DeviceFamily != "IPHONE" || declaration.Declarations[1].DeviceFamily != "IPAD" {
+		t.Fatalf("expected normalized, ordered device families, got %+v", declaration.Declarations)
+	}
+
+	tests := map[string]string{
+		"duplicate": `{"declarations":[{"deviceFamily":"IPHONE"},{"deviceFamily":"iphone"}]}`,
+		"unknown":   `{"declarations":[{"deviceFamily":"IPHONE","supportsTelepathy":true}]}`,
+		"family":    `{"declarations":[{"deviceFamily":"TOASTER"}]}`,
+		"schema":    `{"schemaVersion":2,"declarations":[{"deviceFamily":"IPHONE"}]}`,
+		"empty":     `{"declarations":[]}`,
+	}
+	for name, body := range tests {
+		path := filepath.Join(dir, name+".json")
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		if _, err := parseAccessibilityDeclarationFile(path); err == nil {
+			t.Fatalf("%s: expected parse error", name)
+		}
+	}
+}
+
+func TestPlanAndApplyAccessibilityChanges(t *testing.T) {
+	yes, no := true, false
+	remote := &asc.AccessibilityDeclarationsResponse{
+		Data: []asc.Resource[asc.AccessibilityDeclarationAttributes]{
+			{ID: "iphone-old", Attributes: asc.AccessibilityDeclarationAttributes{DeviceFamily: asc.DeviceFamilyIPhone, State: asc.AccessibilityDeclarationStateReplaced}},
+			{ID: "iphone-pub", Attributes: asc.AccessibilityDeclarationAttributes{DeviceFamily: asc.DeviceFamilyIPhone, State: asc.AccessibilityDeclarationStatePublished, SupportsVoiceover: &no}},
+			{ID: "ipad-draft", Attributes: asc.AccessibilityDeclarationAttributes{DeviceFamily: asc.DeviceFamilyIPad, State: asc.AccessibilityDeclarationStateDraft, SupportsCaptions: &no}},
+			{ID: "ipad-pub", Attributes: asc.AccessibilityDeclarationAttributes{DeviceFamily: asc.DeviceFamilyIPad, State: asc.AccessibilityDeclarationStatePublished}},
+			{ID: "mac-pub", Attributes: asc.AccessibilityDeclarationAttributes{DeviceFamily: asc.DeviceFamilyMac, State: asc.AccessibilityDeclarationStatePublished, SupportsLargerText: &yes}},
+			{ID: "tv-pub", Attributes: asc.AccessibilityDeclarationAttributes{DeviceFamily: asc.DeviceFamilyAppleTV, State: asc.AccessibilityDeclarationStatePublished}},
+		},
+	}
+	desired := accessibilityDeclarationFile{
+		SchemaVersion: 1,
+		Declarations: []accessibilityFileEntry{
+			{DeviceFamily: "IPHONE", SupportsVoiceover: &yes},
+			{DeviceFamily: "IPAD", SupportsCaptions: &yes},
+			{DeviceFamily: "MAC", SupportsLargerText: &yes},
+			{DeviceFamily: "VISION", SupportsVoiceover: &yes},
+		},
+	}
+
+	current := currentAccessibilityDeclarations(remote)
+	plan := planAccessibilityChanges("APP_ID", "file.json", desired, current)
+	got := map[string]accessibilityPlanChange{}
+	for _, change := range plan.Changes {
+		got[change.DeviceFamily] = change
+	}
+	if got["IPHONE"].Action != accessibilityActionCreate || got["IPHONE"].DeclarationID != "iphone-pub" {
+		t.Fatalf("expected new draft superseding published iPhone declaration, got %+v", got["IPHONE"])
+	}
+	if got["IPAD"].Action != accessibilityActionUpdate || got["IPAD"].DeclarationID != "ipad-draft" {
+		t.Fatalf("expected update of iPad draft, got %+v", got["IPAD"])
+	}
+	if got["MAC"].Action != accessibilityActionNoop {
+		t.Fatalf("expected noop for matching Mac declaration, got %+v", got["MAC"])
+	}
+	if got["VISION"].Action != accessibilityActionCreate || got["VISION"].DeclarationID != "" {
+		t.Fatalf("expected create for Vision declaration, got %+v", got["VISION"])
+	}
+	if len(plan.Unmanaged) != 1 || plan.Unmanaged[0] != "APPLE_TV" {
+		t.Fatalf("expected APPLE_TV to be unmanaged, got %v", plan.Unmanaged)
+	}
+
+	client := &fakeAccessibilityMutationClient{}
+	draftIDs, err := applyAccessibilityPlan(context.Background(), client, "APP_ID", desired, current, plan)
+	if err != nil {
+		t.Fatalf("apply error: %v", err)
+	}
+	if len(client.created) != 2 {
+		t.Fatalf("expected 2 creates, got %d", len(client.created))
+	}
+	if attrs, ok := client.updated["ipad-draft"]; !ok || attrs.SupportsCaptions == nil || !*attrs.SupportsCaptions {
+		t.Fatalf("expected iPad draft update with captions, got %+v", client.updated)
+	}
+	if len(draftIDs) != 3 {
+		t.Fatalf("expected 3 draft IDs, got %v", draftIDs)
+	}
+}
+
+func TestApplyAccessibilityPlanKeepsPublishedCapabilitiesOmittedFromFile(t *testing.T) {
+	yes, no := true, false
+	remote := &asc.AccessibilityDeclarationsResponse{
+		Data: []asc.Resource[asc.AccessibilityDeclarationAttributes]{
+			{ID: "iphone-pub", Attributes: asc.AccessibilityDeclarationAttributes{
+				DeviceFamily:       asc.DeviceFamilyIPhone,
+				State:              asc.AccessibilityDeclarationStatePublished,
+				SupportsVoiceover:  &no,
+				SupportsCaptions:   &yes,
+				SupportsLargerText: &no,
+			}},
+		},
+	}
+	desired := accessibilityDeclarationFile{
+		SchemaVersion: 1,
+		Declarations: []accessibilityFileEntry{
+			{DeviceFamily: "IPHONE", SupportsVoiceover: &yes},
+		},
+	}
+
+	current := currentAccessibilityDeclarations(remote)
+	plan := planAccessibilityChanges("APP_ID", "file.json", desired, current)
+	client := &fakeAccessibilityMutationClient{}
+	if _, err := applyAccessibilityPlan(context.Background(), client, "APP_ID", desired, current, plan); err != nil {
+		t.Fatalf("apply error: %v", err)
+	}
+	if len(client.created) != 1 {
+		t.Fatalf("expected 1 create, got %d", len(client.created))
+	}
+	attrs := client.created[0]
+	if attrs.SupportsVoiceover == nil || !*attrs.SupportsVoiceover {
+		t.Fatalf("expected voiceover from file, got %v", attrs.SupportsVoiceover)
+	}
+	if attrs.SupportsCaptions == nil || !*attrs.SupportsCaptions {
+		t.Fatalf("expected captions kept from published declaration, got %v", attrs.SupportsCaptions)
+	}
+	if attrs.SupportsLargerText == nil || *attrs.SupportsLargerText {
+		t.Fatalf("expected larger text kept from published declaration, got %v", attrs.SupportsLargerText)
+	}
+	if attrs.SupportsDarkInterface != nil {
+		t.Fatalf("expected dark interface to stay unset, got %v", *attrs.SupportsDarkInterface)
+	}
+}
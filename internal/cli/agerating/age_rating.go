@@ -84,9 +84,11 @@ Examples:
 func AgeRatingViewCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("age-rating view", flag.ExitOnError)
 
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --app-info-id or --version-id is provided)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env); not needed with --app-info-id or --version-id")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
 	versionID := fs.String("version-id", "", "App Store version ID (optional)")
+	file := fs.String("file", "", "Also write the declaration to a YAML file for `asc age-rating set --file`")
+	overwrite := fs.Bool("overwrite", false, "Overwrite an existing --file")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -95,20 +97,28 @@ func AgeRatingViewCommand() *ffcli.Command {
 		ShortHelp:  "View an age rating declaration.",
 		LongHelp: `Get the current age rating declaration.
 
+Use --file to save the declaration as YAML, edit it, and apply it with
+` + "`asc age-rating set --file`" + `.
+
 Examples:
   asc age-rating view --app APP_ID
   asc age-rating view --app-info-id APP_INFO_ID
-  asc age-rating view --version-id VERSION_ID`,
+  asc age-rating view --version-id VERSION_ID
+  asc age-rating view --version-id VERSION_ID --file declaration.yaml`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			appInfoValue := strings.TrimSpace(*appInfoID)
 			versionValue := strings.TrimSpace(*versionID)
 			appValue := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
+			fileValue := strings.TrimSpace(*file)
 
 			if appInfoValue != "" && versionValue != "" {
 				return fmt.Errorf("age-rating view: only one of --app-info-id or --version-id is allowed")
 			}
+			if *overwrite && fileValue == "" {
+				return shared.UsageError("--overwrite requires --file")
+			}
 			if appInfoValue == "" && versionValue == "" && appValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -127,6 +137,12 @@ Examples:
 				return fmt.Errorf("age-rating view: %w", err)
 			}
 
+			if fileValue != "" {
+				if err := writeAgeRatingDeclarationFile(fileValue, resp.Data.Attributes, *overwrite); err != nil {
+					return fmt.Errorf("age-rating view: write --file: %w", err)
+				}
+			}
+
 			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
 		},
 	}
@@ -142,7 +158,7 @@ func compatAgeRatingGetAliasCommand() *ffcli.Command {
 	clone.UsageFunc = shared.DefaultUsageFunc
 	origExec := cmd.Exec
 	clone.Exec = func(ctx context.Context, args []string) error {
-		fmt.Fprintln(os.Stderr, "Warning: `asc age-rating get` has been renamed to `asc age-rating view`.")
+		fmt.Fprintln(shared.WarningWriter(), "Warning: `asc age-rating get` has been renamed to `asc age-rating view`.")
 		return origExec(ctx, args)
 	}
 	return &clone
@@ -168,10 +184,11 @@ func AgeRatingSetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("age-rating set", flag.ExitOnError)
 
 	id := fs.String("id", "", "Age rating declaration ID (optional)")
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --id, --app-info-id, or --version-id is provided)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env); not needed with --id, --app-info-id, or --version-id")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
 	versionID := fs.String("version-id", "", "App Store version ID (optional)")
 	allNone := fs.Bool("all-none", false, "Set all ratings to NONE/false (safe default for apps with no objectionable content)")
+	file := fs.String("file", "", "Apply a YAML declaration file (see `asc age-rating view --file`)")
 
 	// Boolean content descriptors
 	advertising := fs.String("advertising", "", "Contains advertising (true/false)")
@@ -217,11 +234,20 @@ func AgeRatingSetCommand() *ffcli.Command {
 Use --all-none to set all ratings to their safe defaults (NONE/false) in one
 command, then override individual fields as needed.
 
+Use --file to apply a YAML declaration file instead of individual flags. Keys
+are the API attribute names written by ` + "`asc age-rating view --file`" + `; unknown
+keys and invalid values are rejected before anything is sent. The changes
+against the live declaration are printed to stderr before they are applied,
+and only the fields in the file are updated. Combine with --dry-run to review
+the changes without applying them.
+
 Examples:
   asc age-rating set --app APP_ID --all-none
   asc age-rating set --app APP_ID --all-none --unrestricted-web-access true
   asc age-rating set --id DECLARATION_ID --gambling false --kids-age-band FIVE_AND_UNDER
-  asc age-rating set --app APP_ID --violence-realistic FREQUENT_OR_INTENSE --unrestricted-web-access true`,
+  asc age-rating set --app APP_ID --violence-realistic FREQUENT_OR_INTENSE --unrestricted-web-access true
+  asc age-rating set --version-id VERSION_ID --file declaration.yaml
+  asc age-rating set --version-id VERSION_ID --file declaration.yaml --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -244,6 +270,16 @@ Examples:
 				}
 			}
 
+			if fileValue := strings.TrimSpace(*file); fileValue != "" {
+				if idValue != "" {
+					return shared.UsageError("--file cannot be used with --id; use --app, --app-info-id, or --version-id so the changes can be shown")
+				}
+				if conflict := ageRatingFileConflict(fs); conflict != "" {
+					return shared.UsageErrorf("--file cannot be combined with --%s", conflict)
+				}
+				return applyAgeRatingDeclarationFile(ctx, fileValue, appValue, appInfoValue, versionValue, output)
+			}
+
 			values := map[string]string{
 				// Boolean content descriptors
 				"advertising":               *advertising,
@@ -506,3 +542,58 @@ func parseOptionalEnumFlag(name, raw string, allowed []string) (*string, error)
 	}
 	return nil, fmt.Errorf("%s must be one of: %s", name, strings.Join(allowed, ", "))
 }
+
+// ageRatingFileSelectorFlags are the set flags that may accompany --file.
+var ageRatingFileSelectorFlags = []string{"file", "app", "app-info-id", "version-id", "output", "pretty", "columns"}
+
+// ageRatingFileConflict returns the first explicitly set value flag that
+// --file would otherwise silently override.
+func ageRatingFileConflict(fs *flag.FlagSet) string {
+	conflict := ""
+	fs.Visit(func(f *flag.Flag) {
+		if conflict == "" && !slices.Contains(ageRatingFileSelectorFlags, f.Name) {
+			conflict = f.Name
+		}
+	})
+	return conflict
+}
+
+func applyAgeRatingDeclarationFile(ctx context.Context, path, appID, appInfoID, versionID string, output shared.OutputFlags) error {
+	desired, err := readAgeRatingDeclarationFile(path)
+	if err != nil {
+		return fmt.Errorf("age-rating set: --file %s: %w", path, err)
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("age-rating set: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	current, err := fetchAgeRatingDeclaration(requestCtx, client, appID, appInfoID, versionID)
+	if err != nil {
+		return fmt.Errorf("age-rating set: %w", err)
+	}
+	declarationID := strings.TrimSpace(current.Data.ID)
+	if declarationID == "" {
+		return fmt.Errorf("age-rating set: age rating declaration id is empty")
+	}
+
+	changes, err := diffAgeRatingDeclaration(current.Data.Attributes, desired)
+	if err != nil {
+		return fmt.Errorf("age-rating set: %w", err)
+	}
+	printAgeRatingDiff(shared.WarningWriter(), declarationID, changes)
+	if len(changes) == 0 {
+		return shared.PrintOutput(current, *output.Output, *output.Pretty)
+	}
+
+	resp, err := client.UpdateAgeRatingDeclaration(requestCtx, declarationID, desired)
+	if err != nil {
+		return fmt.Errorf("age-rating set: %w", err)
+	}
+
+	return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+}
@@ -0,0 +1,250 @@
+package agerating
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type ageRatingFieldKind int
+
+const (
+	ageRatingFieldBool ageRatingFieldKind = iota
+	ageRatingFieldEnum
+	ageRatingFieldURL
+)
+
+// ageRatingFileField describes one key of a declaration file. Keys use the
+// API attribute names so a file matches `asc age-rating view --output json`.
+type ageRatingFileField struct {
+	key     string
+	flag    string
+	kind    ageRatingFieldKind
+	allowed []string
+}
+
+var ageRatingFileFields = []ageRatingFileField{
+	{key: "advertising", flag: "advertising", kind: ageRatingFieldBool},
+	{key: "gambling", flag: "gambling", kind: ageRatingFieldBool},
+	{key: "healthOrWellnessTopics", flag: "health-or-wellness-topics", kind: ageRatingFieldBool},
+	{key: "lootBox", flag: "loot-box", kind: ageRatingFieldBool},
+	{key: "messagingAndChat", flag: "messaging-and-chat", kind: ageRatingFieldBool},
+	{key: "parentalControls", flag: "parental-controls", kind: ageRatingFieldBool},
+	{key: "ageAssurance", flag: "age-assurance", kind: ageRatingFieldBool},
+	{key: "unrestrictedWebAccess", flag: "unrestricted-web-access", kind: ageRatingFieldBool},
+	{key: "userGeneratedContent", flag: "user-generated-content", kind: ageRatingFieldBool},
+	{key: "alcoholTobaccoOrDrugUseOrReferences", flag: "alcohol-tobacco-drug-use", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "contests", flag: "contests", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "gamblingSimulated", flag: "gambling-simulated", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "gunsOrOtherWeapons", flag: "guns-or-other-weapons", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "medicalOrTreatmentInformation", flag: "medical-treatment", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "profanityOrCrudeHumor", flag: "profanity-humor", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "sexualContentOrNudity", flag: "sexual-content-nudity", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "sexualContentGraphicAndNudity", flag: "sexual-content-graphic-nudity", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "horrorOrFearThemes", flag: "horror-fear", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "matureOrSuggestiveThemes", flag: "mature-suggestive", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "violenceCartoonOrFantasy", flag: "violence-cartoon", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "violenceRealistic", flag: "violence-realistic", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "violenceRealisticProlongedGraphicOrSadistic", flag: "violence-realistic-graphic", kind: ageRatingFieldEnum, allowed: ageRatingLevelValues},
+	{key: "kidsAgeBand", flag: "kids-age-band", kind: ageRatingFieldEnum, allowed: kidsAgeBandValues},
+	{key: "ageRatingOverride", flag: "age-rating-override", kind: ageRatingFieldEnum, allowed: ageRatingOverrideValues},
+	{key: "ageRatingOverrideV2", flag: "age-rating-override-v2", kind: ageRatingFieldEnum, allowed: ageRatingOverrideV2Values},
+	{key: "koreaAgeRatingOverride", flag: "korea-age-rating-override", kind: ageRatingFieldEnum, allowed: koreaAgeRatingOverrideValues},
+	{key: "developerAgeRatingInfoUrl", flag: "developer-age-rating-info-url", kind: ageRatingFieldURL},
+}
+
+// ageRatingChange is one field that differs between the live declaration and
+// a declaration file.
+type ageRatingChange struct {
+	Key  string
+	From string
+	To   string
+}
+
+// readAgeRatingDeclarationFile loads a YAML (or JSON) declaration file,
+// rejecting unknown keys and values outside each field's schema.
+func readAgeRatingDeclarationFile(path string) (asc.AgeRatingDeclarationAttributes, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return asc.AgeRatingDeclarationAttributes{}, err
+	}
+	return parseAgeRatingDeclaration(data)
+}
+
+func parseAgeRatingDeclaration(data []byte) (asc.AgeRatingDeclarationAttributes, error) {
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return asc.AgeRatingDeclarationAttributes{}, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if len(raw) == 0 {
+		return asc.AgeRatingDeclarationAttributes{}, fmt.Errorf("declaration file has no fields")
+	}
+
+	known := make(map[string]ageRatingFileField, len(ageRatingFileFields))
+	for _, field := range ageRatingFileFields {
+		known[field.key] = field
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := map[string]string{}
+	for _, key := range keys {
+		field, ok := known[key]
+		if !ok {
+			return asc.AgeRatingDeclarationAttributes{}, fmt.Errorf("unknown field %q", key)
+		}
+		value, err := ageRatingFileValue(field, raw[key])
+		if err != nil {
+			return asc.AgeRatingDeclarationAttributes{}, err
+		}
+		values[field.flag] = value
+	}
+	return buildAgeRatingAttributes(values)
+}
+
+// ageRatingFileValue checks a decoded value against its field and returns it
+// in the string form buildAgeRatingAttributes accepts for the matching flag.
+func ageRatingFileValue(field ageRatingFileField, value any) (string, error) {
+	switch field.kind {
+	case ageRatingFieldBool:
+		b, ok := value.(bool)
+		if !ok {
+			return "", fmt.Errorf("%s must be true or false", field.key)
+		}
+		return fmt.Sprint(b), nil
+	case ageRatingFieldEnum:
+		s, ok := value.(string)
+		if !ok || !slices.Contains(field.allowed, strings.ToUpper(strings.TrimSpace(s))) {
+			return "", fmt.Errorf("%s must be one of: %s", field.key, strings.Join(field.allowed, ", "))
+		}
+		return s, nil
+	default:
+		s, ok := value.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return "", fmt.Errorf("%s must be a URL", field.key)
+		}
+		return s, nil
+	}
+}
+
+// ageRatingValuesByKey returns the set attributes keyed by API attribute name.
+func ageRatingValuesByKey(attrs asc.AgeRatingDeclarationAttributes) (map[string]any, error) {
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]any{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// diffAgeRatingDeclaration lists the fields set in desired whose value differs
+// from current, in declaration file order.
+func diffAgeRatingDeclaration(current, desired asc.AgeRatingDeclarationAttributes) ([]ageRatingChange, error) {
+	currentValues, err := ageRatingValuesByKey(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredValues, err := ageRatingValuesByKey(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []ageRatingChange
+	for _, field := range ageRatingFileFields {
+		to, ok := desiredValues[field.key]
+		if !ok {
+			continue
+		}
+		from, had := currentValues[field.key]
+		if had && from == to {
+			continue
+		}
+		change := ageRatingChange{Key: field.key, From: "(unset)", To: fmt.Sprint(to)}
+		if had {
+			change.From = fmt.Sprint(from)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func printAgeRatingDiff(w io.Writer, declarationID string, changes []ageRatingChange) {
+	if len(changes) == 0 {
+		fmt.Fprintf(w, "Age rating declaration %s already matches the file; nothing to update.\n", declarationID)
+		return
+	}
+	fmt.Fprintf(w, "Age rating declaration %s changes:\n", declarationID)
+	for _, change := range changes {
+		fmt.Fprintf(w, "  %s: %s -> %s\n", change.Key, change.From, change.To)
+	}
+}
+
+// marshalAgeRatingDeclaration renders the set attributes as a declaration
+// file, keeping the schema's field order so files diff cleanly.
+func marshalAgeRatingDeclaration(attrs asc.AgeRatingDeclarationAttributes) ([]byte, error) {
+	values, err := ageRatingValuesByKey(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, field := range ageRatingFileFields {
+		value, ok := values[field.key]
+		if !ok {
+			continue
+		}
+		var valueNode yaml.Node
+		if err := valueNode.Encode(value); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: field.key}, &valueNode)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeAgeRatingDeclarationFile(path string, attrs asc.AgeRatingDeclarationAttributes, overwrite bool) error {
+	data, err := marshalAgeRatingDeclaration(attrs)
+	if err != nil {
+		return err
+	}
+	_, err = shared.SafeWriteFileNoSymlink(
+		filepath.Clean(path),
+		0o644,
+		overwrite,
+		".asc-age-rating-*",
+		".asc-age-rating-backup-*",
+		func(f *os.File) (int64, error) {
+			n, err := f.Write(data)
+			return int64(n), err
+		},
+	)
+	return err
+}
@@ -0,0 +1,96 @@
+package agerating
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseAgeRatingDeclarationValidatesSchema(t *testing.T) {
+	attrs, err := parseAgeRatingDeclaration([]byte(`
+gambling: false
+violenceRealistic: infrequent_or_mild
+kidsAgeBand: SIX_TO_EIGHT
+developerAgeRatingInfoUrl: https://example.com/age
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attrs.Gambling == nil || *attrs.Gambling {
+		t.Fatalf("expected gambling=false, got %#v", attrs.Gambling)
+	}
+	if attrs.ViolenceRealistic == nil || *attrs.ViolenceRealistic != "INFREQUENT_OR_MILD" {
+		t.Fatalf("expected normalized violenceRealistic, got %#v", attrs.ViolenceRealistic)
+	}
+	if attrs.KidsAgeBand == nil || *attrs.KidsAgeBand != "SIX_TO_EIGHT" {
+		t.Fatalf("expected kidsAgeBand, got %#v", attrs.KidsAgeBand)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "unknown key", data: "gamblin: true\n", wantErr: `unknown field "gamblin"`},
+		{name: "bool as string", data: "gambling: \"yes\"\n", wantErr: "gambling must be true or false"},
+		{name: "bad enum", data: "contests: SOMETIMES\n", wantErr: "contests must be one of: NONE"},
+		{name: "bad url", data: "developerAgeRatingInfoUrl: not-a-url\n", wantErr: "must be a valid URL"},
+		{name: "empty", data: "", wantErr: "declaration file has no fields"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseAgeRatingDeclaration([]byte(test.data))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDiffAgeRatingDeclarationOnlyReportsChangedFileFields(t *testing.T) {
+	falseValue, trueValue := false, true
+	none, mild := "NONE", "INFREQUENT_OR_MILD"
+	current := asc.AgeRatingDeclarationAttributes{Gambling: &falseValue, Contests: &none, LootBox: &falseValue}
+	desired := asc.AgeRatingDeclarationAttributes{Gambling: &trueValue, Contests: &none, HorrorOrFearThemes: &mild}
+
+	changes, err := diffAgeRatingDeclaration(current, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []ageRatingChange{
+		{Key: "gambling", From: "false", To: "true"},
+		{Key: "horrorOrFearThemes", From: "(unset)", To: "INFREQUENT_OR_MILD"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %v, got %v", want, changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, changes)
+		}
+	}
+}
+
+func TestMarshalAgeRatingDeclarationRoundTrips(t *testing.T) {
+	falseValue := false
+	mild := "INFREQUENT_OR_MILD"
+	attrs := asc.AgeRatingDeclarationAttributes{Gambling: &falseValue, ViolenceCartoonOrFantasy: &mild}
+
+	data, err := marshalAgeRatingDeclaration(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "gambling: false\nviolenceCartoonOrFantasy: INFREQUENT_OR_MILD\n" {
+		t.Fatalf("unexpected YAML: %q", data)
+	}
+
+	parsed, err := parseAgeRatingDeclaration(data)
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	changes, err := diffAgeRatingDeclaration(attrs, parsed)
+	if err != nil || len(changes) != 0 {
+		t.Fatalf("expected round trip without changes, got %v (%v)", changes, err)
+	}
+}
@@ -10,7 +10,12 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
-const analyticsMaxLimit = 200
+const (
+	analyticsMaxLimit = 200
+
+	analyticsWaitDefaultPollInterval = time.Minute
+	analyticsWaitDefaultTimeout      = 30 * time.Minute
+)
 
 var uuidPattern = regexp.MustCompile(`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$`)
 
@@ -281,3 +286,48 @@ func fetchAnalyticsReportSegments(ctx context.Context, client *asc.Client, insta
 	}
 	return all, nil
 }
+
+// waitForAnalyticsReportInstances polls a report request until one of its
+// reports has an instance, returning the request's latest state.
+func waitForAnalyticsReportInstances(ctx context.Context, client *asc.Client, requestID string, interval time.Duration) (asc.AnalyticsReportRequestState, error) {
+	return asc.PollUntil(ctx, interval, func(ctx context.Context) (asc.AnalyticsReportRequestState, bool, error) {
+		request, err := client.GetAnalyticsReportRequest(ctx, requestID)
+		if err != nil {
+			return "", false, err
+		}
+		state := request.Data.Attributes.State
+		if state == asc.AnalyticsReportRequestStateFailed {
+			return state, false, fmt.Errorf("report request %s failed", requestID)
+		}
+
+		reports, _, err := fetchAnalyticsReports(ctx, client, requestID, 0, "", true)
+		if err != nil {
+			return state, false, err
+		}
+		for _, report := range reports {
+			instances, err := client.GetAnalyticsReportInstances(ctx, report.ID, asc.WithAnalyticsReportInstancesLimit(1))
+			if err != nil {
+				return state, false, err
+			}
+			if len(instances.Data) > 0 {
+				return state, true, nil
+			}
+		}
+		return state, false, nil
+	})
+}
+
+// analyticsSegmentPartPath inserts a _partNN suffix before the .csv/.gz
+// extensions of path so each segment of a multi-part instance gets its own file.
+func analyticsSegmentPartPath(path string, part int) string {
+	if path == "" {
+		return ""
+	}
+	suffix := fmt.Sprintf("_part%02d", part)
+	for _, ext := range []string{".csv.gz", ".gz", ".csv"} {
+		if base, ok := strings.CutSuffix(path, ext); ok {
+			return base + suffix + ext
+		}
+	}
+	return path + suffix
+}
@@ -2,6 +2,7 @@ package analytics
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -19,6 +20,9 @@ func AnalyticsRequestCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	accessType := fs.String("access-type", "", "Access type: ONGOING or ONE_TIME_SNAPSHOT")
+	wait := fs.Bool("wait", false, "Wait until the first report instance is available")
+	pollInterval := fs.Duration("poll-interval", analyticsWaitDefaultPollInterval, "Polling interval for --wait")
+	timeout := fs.Duration("timeout", analyticsWaitDefaultTimeout, "Maximum time to wait with --wait")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -27,9 +31,15 @@ func AnalyticsRequestCommand() *ffcli.Command {
 		ShortHelp:  "Create an analytics report request.",
 		LongHelp: `Create an analytics report request.
 
+App Store Connect generates report instances asynchronously, usually within a
+day or two of a new request. With --wait the command polls the request's
+reports until at least one instance exists, then exits; list them with
+"asc analytics get --request-id ID".
+
 Examples:
   asc analytics request --app "123456789" --access-type ONGOING
-  asc analytics request --app "123456789" --access-type ONE_TIME_SNAPSHOT`,
+  asc analytics request --app "123456789" --access-type ONE_TIME_SNAPSHOT
+  asc analytics request --app "123456789" --access-type ONE_TIME_SNAPSHOT --wait --timeout 48h --poll-interval 15m`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -46,6 +56,25 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("analytics request: %w", err)
 			}
+			if !*wait {
+				waitFlagUsed := false
+				fs.Visit(func(f *flag.Flag) {
+					if f.Name == "poll-interval" || f.Name == "timeout" {
+						waitFlagUsed = true
+					}
+				})
+				if waitFlagUsed {
+					return shared.UsageError("--poll-interval and --timeout require --wait")
+				}
+			}
+			if *wait {
+				if *pollInterval <= 0 {
+					return shared.UsageError("--poll-interval must be greater than 0")
+				}
+				if *timeout <= 0 {
+					return shared.UsageError("--timeout must be greater than 0")
+				}
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -60,12 +89,28 @@ Examples:
 				return fmt.Errorf("analytics request: failed to create request: %w", err)
 			}
 
+			if *wait {
+				waitCtx, waitCancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+				defer waitCancel()
+				state, err := waitForAnalyticsReportInstances(waitCtx, client, response.Data.ID, *pollInterval)
+				if err != nil {
+					if errors.Is(err, context.DeadlineExceeded) {
+						return fmt.Errorf("analytics request: timed out after %s waiting for report instances for request %s: %w", *timeout, response.Data.ID, err)
+					}
+					return fmt.Errorf("analytics request: %w", err)
+				}
+				if state != "" {
+					response.Data.Attributes.State = state
+				}
+			}
+
 			result := &asc.AnalyticsReportRequestResult{
-				RequestID:   response.Data.ID,
-				AppID:       resolvedAppID,
-				AccessType:  string(normalizedAccessType),
-				State:       string(response.Data.Attributes.State),
-				CreatedDate: response.Data.Attributes.CreatedDate,
+				RequestID:    response.Data.ID,
+				AppID:        resolvedAppID,
+				AccessType:   string(normalizedAccessType),
+				State:        string(response.Data.Attributes.State),
+				CreatedDate:  response.Data.Attributes.CreatedDate,
+				ReportsReady: *wait,
 			}
 
 			return shared.PrintOutput(result, *output.Output, *output.Pretty)
@@ -401,7 +446,8 @@ func AnalyticsDownloadCommand() *ffcli.Command {
 
 	requestID := fs.String("request-id", "", "Analytics report request ID")
 	instanceID := fs.String("instance-id", "", "Analytics report instance ID")
-	segmentID := fs.String("segment-id", "", "Analytics report segment ID (required if multiple)")
+	segmentID := fs.String("segment-id", "", "Analytics report segment ID (required if multiple, unless --all-segments)")
+	allSegments := fs.Bool("all-segments", false, "Download every segment of a multi-part instance as numbered _partNN files")
 	output := fs.String("output", "", "Output file path (default: analytics_report_{requestId}_{instanceId}.csv.gz)")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .csv")
 	outputFlags := shared.BindMetadataOutputFlags(fs)
@@ -415,7 +461,8 @@ func AnalyticsDownloadCommand() *ffcli.Command {
 Examples:
   asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID"
   asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --decompress
-  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --segment-id "SEGMENT_ID"`,
+  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --segment-id "SEGMENT_ID"
+  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --all-segments --decompress`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -434,6 +481,9 @@ Examples:
 				return fmt.Errorf("analytics download: %w", err)
 			}
 			if strings.TrimSpace(*segmentID) != "" {
+				if *allSegments {
+					return shared.UsageError("--segment-id and --all-segments are mutually exclusive")
+				}
 				if err := validateUUIDFlag("--segment-id", *segmentID); err != nil {
 					return fmt.Errorf("analytics download: %w", err)
 				}
@@ -483,6 +533,29 @@ Examples:
 				return fmt.Errorf("analytics download: no segments available for instance %q", strings.TrimSpace(*instanceID))
 			}
 
+			if *allSegments {
+				result := &asc.AnalyticsReportSegmentsDownloadResult{
+					RequestID:  strings.TrimSpace(*requestID),
+					InstanceID: strings.TrimSpace(*instanceID),
+					Files:      make([]asc.AnalyticsReportDownloadResult, 0, len(segments)),
+				}
+				for i, segment := range segments {
+					partCompressed, partDecompressed := compressedPath, decompressedPath
+					if len(segments) > 1 {
+						partCompressed = analyticsSegmentPartPath(compressedPath, i+1)
+						partDecompressed = analyticsSegmentPartPath(decompressedPath, i+1)
+					}
+					file, err := downloadAnalyticsSegment(requestCtx, client, segment, partCompressed, partDecompressed, *decompress)
+					if err != nil {
+						return fmt.Errorf("analytics download: segment %s: %w", segment.ID, err)
+					}
+					file.RequestID = result.RequestID
+					file.InstanceID = result.InstanceID
+					result.Files = append(result.Files, file)
+				}
+				return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
+			}
+
 			selectedSegment := segments[0]
 			if strings.TrimSpace(*segmentID) != "" {
 				found := false
@@ -497,45 +570,53 @@ Examples:
 					return fmt.Errorf("analytics download: segment %q not found for instance %q", strings.TrimSpace(*segmentID), strings.TrimSpace(*instanceID))
 				}
 			} else if len(segments) > 1 {
-				return fmt.Errorf("analytics download: multiple segments found; specify --segment-id")
-			}
-
-			downloadURL := strings.TrimSpace(selectedSegment.Attributes.URL)
-			if downloadURL == "" {
-				return fmt.Errorf("analytics download: segment download URL is empty")
+				return fmt.Errorf("analytics download: multiple segments found; specify --segment-id or --all-segments")
 			}
 
-			download, err := client.DownloadAnalyticsReport(requestCtx, downloadURL)
+			file, err := downloadAnalyticsSegment(requestCtx, client, selectedSegment, compressedPath, decompressedPath, *decompress)
 			if err != nil {
-				return fmt.Errorf("analytics download: failed to download report: %w", err)
+				return fmt.Errorf("analytics download: %w", err)
 			}
-			defer download.Body.Close()
+			file.RequestID = strings.TrimSpace(*requestID)
+			file.InstanceID = strings.TrimSpace(*instanceID)
+			return shared.PrintOutput(&file, *outputFlags.OutputFormat, *outputFlags.Pretty)
+		},
+	}
+}
 
-			compressedSize, err := shared.WriteStreamToFile(compressedPath, download.Body)
-			if err != nil {
-				return fmt.Errorf("analytics download: failed to write report: %w", err)
-			}
+// downloadAnalyticsSegment saves one segment's gzip file, and its decompressed
+// CSV when decompress is set.
+func downloadAnalyticsSegment(ctx context.Context, client *asc.Client, segment asc.Resource[asc.AnalyticsReportSegmentAttributes], compressedPath, decompressedPath string, decompress bool) (asc.AnalyticsReportDownloadResult, error) {
+	downloadURL := strings.TrimSpace(segment.Attributes.URL)
+	if downloadURL == "" {
+		return asc.AnalyticsReportDownloadResult{}, fmt.Errorf("segment download URL is empty")
+	}
 
-			var decompressedSize int64
-			if *decompress {
-				decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-				if err != nil {
-					return fmt.Errorf("analytics download: %w", err)
-				}
-			}
+	download, err := client.DownloadAnalyticsReport(ctx, downloadURL)
+	if err != nil {
+		return asc.AnalyticsReportDownloadResult{}, fmt.Errorf("failed to download report: %w", err)
+	}
+	defer download.Body.Close()
 
-			result := &asc.AnalyticsReportDownloadResult{
-				RequestID:        strings.TrimSpace(*requestID),
-				InstanceID:       strings.TrimSpace(*instanceID),
-				SegmentID:        selectedSegment.ID,
-				FilePath:         compressedPath,
-				FileSize:         compressedSize,
-				Decompressed:     *decompress,
-				DecompressedPath: decompressedPath,
-				DecompressedSize: decompressedSize,
-			}
+	compressedSize, err := shared.WriteReportDownload(compressedPath, download)
+	if err != nil {
+		return asc.AnalyticsReportDownloadResult{}, fmt.Errorf("failed to write report: %w", err)
+	}
 
-			return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
-		},
+	var decompressedSize int64
+	if decompress {
+		decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
+		if err != nil {
+			return asc.AnalyticsReportDownloadResult{}, err
+		}
 	}
+
+	return asc.AnalyticsReportDownloadResult{
+		SegmentID:        segment.ID,
+		FilePath:         compressedPath,
+		FileSize:         compressedSize,
+		Decompressed:     decompress,
+		DecompressedPath: decompressedPath,
+		DecompressedSize: decompressedSize,
+	}, nil
 }
@@ -109,7 +109,7 @@ Examples:
 			}
 			defer download.Body.Close()
 
-			compressedSize, err := shared.WriteStreamToFile(compressedPath, download.Body)
+			compressedSize, err := shared.WriteReportDownload(compressedPath, download)
 			if err != nil {
 				return fmt.Errorf("analytics sales: failed to write report: %w", err)
 			}
@@ -264,7 +264,7 @@ Examples:
 			fs.Visit(func(f *flag.Flag) {
 				seen[f.Name] = true
 			})
-			if !seen["android-package-name"] && !seen["fingerprints"] && !*clearPackageName && !*clearFingerprints {
+			if !seen["android-package-name"] && !seen["fingerprints"] && !*clearPackageName && !*clearFingerprints && !shared.UpdatePatchRequested(ctx) {
 				return fmt.Errorf("android-ios-mapping update: at least one update flag is required")
 			}
 			if seen["android-package-name"] && *clearPackageName {
@@ -29,7 +29,8 @@ Examples:
   asc app-events create --app "APP_ID" --name "Summer Challenge" --event-type CHALLENGE --start "2026-06-01T00:00:00Z" --end "2026-06-30T23:59:59Z"
   asc app-events update --event-id "EVENT_ID" --priority HIGH
   asc app-events delete --event-id "EVENT_ID" --confirm
-  asc app-events links --event-id "EVENT_ID"`,
+  asc app-events links --event-id "EVENT_ID"
+  asc app-events artwork download --app "APP_ID" --output-dir "./event-artwork"`,
 		FlagSet:   fs,
 		UsageFunc: shared.VisibleUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -49,6 +50,7 @@ Examples:
 			),
 			AppEventScreenshotsCommand(),
 			AppEventVideoClipsCommand(),
+			AppEventArtworkCommand(),
 			AppEventsSubmitCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -437,7 +439,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
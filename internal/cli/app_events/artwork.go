@@ -0,0 +1,436 @@
+package app_events
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/assets"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	artworkKindScreenshot = "screenshot"
+	artworkKindVideoClip  = "videoClip"
+)
+
+type artworkItem struct {
+	EventID        string `json:"eventId"`
+	EventName      string `json:"eventName,omitempty"`
+	EventState     string `json:"eventState,omitempty"`
+	LocalizationID string `json:"localizationId"`
+	Locale         string `json:"locale,omitempty"`
+	Kind           string `json:"kind"`
+	AssetType      string `json:"assetType,omitempty"`
+	ID             string `json:"id"`
+	FileName       string `json:"fileName,omitempty"`
+	Width          int    `json:"width,omitempty"`
+	Height         int    `json:"height,omitempty"`
+	State          string `json:"state,omitempty"`
+	VideoURL       string `json:"videoUrl,omitempty"`
+	OutputPath     string `json:"outputPath,omitempty"`
+	BytesWritten   int64  `json:"bytesWritten,omitempty"`
+
+	image *asc.ImageAsset
+}
+
+type artworkFailure struct {
+	ID         string `json:"id"`
+	OutputPath string `json:"outputPath,omitempty"`
+	Error      string `json:"error"`
+}
+
+type artworkListResult struct {
+	AppID string        `json:"appId,omitempty"`
+	Items []artworkItem `json:"items"`
+}
+
+type artworkDownloadResult struct {
+	AppID      string           `json:"appId,omitempty"`
+	OutputDir  string           `json:"outputDir"`
+	Overwrite  bool             `json:"overwrite"`
+	Total      int              `json:"total"`
+	Downloaded int              `json:"downloaded"`
+	Failed     int              `json:"failed"`
+	Items      []artworkItem    `json:"items"`
+	Failures   []artworkFailure `json:"failures,omitempty"`
+}
+
+// AppEventArtworkCommand returns the app event artwork group.
+func AppEventArtworkCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("artwork", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "artwork",
+		ShortUsage: "asc app-events artwork <subcommand> [flags]",
+		ShortHelp:  "Audit and download in-app event card and details page artwork.",
+		LongHelp: `Audit and download in-app event card and details page artwork.
+
+Walks every event (or one with --event-id), its localizations, and their
+screenshots and video clips, reporting the asset type (EVENT_CARD or
+EVENT_DETAILS_PAGE), dimensions, and delivery state of each.
+
+For product page artwork use "asc screenshots download" and
+"asc video-previews download" on the version or custom product page
+localization.
+
+Examples:
+  asc app-events artwork list --app "APP_ID"
+  asc app-events artwork download --app "APP_ID" --output-dir "./event-artwork"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			AppEventArtworkListCommand(),
+			AppEventArtworkDownloadCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// AppEventArtworkListCommand returns the artwork list subcommand.
+func AppEventArtworkListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("artwork list", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	eventID := fs.String("event-id", "", "Only include this app event")
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json, table, markdown")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc app-events artwork list (--app \"APP_ID\" | --event-id \"EVENT_ID\") [flags]",
+		ShortHelp:  "List artwork for in-app events by event, locale, and asset type.",
+		LongHelp: `List artwork for in-app events by event, locale, and asset type.
+
+Examples:
+  asc app-events artwork list --app "APP_ID"
+  asc app-events artwork list --event-id "EVENT_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID, event, err := resolveArtworkScope(*appID, *eventID)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("app-events artwork list: %w", err)
+			}
+
+			items, err := collectArtwork(ctx, client, resolvedAppID, event)
+			if err != nil {
+				return fmt.Errorf("app-events artwork list: %w", err)
+			}
+
+			result := artworkListResult{AppID: resolvedAppID, Items: items}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderArtworkItems(items, asc.RenderTable) },
+				func() error { return renderArtworkItems(items, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+// AppEventArtworkDownloadCommand returns the artwork download subcommand.
+func AppEventArtworkDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("artwork download", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	eventID := fs.String("event-id", "", "Only include this app event")
+	outputDir := fs.String("output-dir", "", "Output directory (required)")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
+	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc app-events artwork download (--app \"APP_ID\" | --event-id \"EVENT_ID\") --output-dir \"./event-artwork\" [flags]",
+		ShortHelp:  "Download in-app event artwork into a folder per event, locale, and asset type.",
+		LongHelp: `Download in-app event artwork into a folder per event, locale, and asset type.
+
+Files are written as:
+  <output-dir>/<event name>_<event id>/<locale>/<asset type>/<nn>_<id>_<file name>
+
+Video clips are saved as their poster frame image; the summary includes each
+clip's videoUrl.
+
+Examples:
+  asc app-events artwork download --app "APP_ID" --output-dir "./event-artwork"
+  asc app-events artwork download --event-id "EVENT_ID" --output-dir "./event-artwork" --overwrite`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID, event, err := resolveArtworkScope(*appID, *eventID)
+			if err != nil {
+				return err
+			}
+			dir := strings.TrimSpace(*outputDir)
+			if dir == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output-dir is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("app-events artwork download: %w", err)
+			}
+
+			items, err := collectArtwork(ctx, client, resolvedAppID, event)
+			if err != nil {
+				return fmt.Errorf("app-events artwork download: %w", err)
+			}
+
+			result := &artworkDownloadResult{
+				AppID:     resolvedAppID,
+				OutputDir: filepath.Clean(dir),
+				Overwrite: *overwrite,
+				Total:     len(items),
+			}
+			perFolder := map[string]int{}
+			for i := range items {
+				item := &items[i]
+				folder := artworkFolder(dir, *item)
+				perFolder[folder]++
+				item.OutputPath = filepath.Join(folder, fmt.Sprintf("%02d_%s_%s", perFolder[folder], item.ID, artworkImageFileName(*item)))
+
+				downloadCtx, cancel := shared.ContextWithTimeout(ctx)
+				written, err := assets.DownloadImageAsset(downloadCtx, item.image, artworkImageFileName(*item), item.OutputPath, *overwrite)
+				cancel()
+				if err != nil {
+					result.Failures = append(result.Failures, artworkFailure{ID: item.ID, OutputPath: item.OutputPath, Error: err.Error()})
+					continue
+				}
+				item.BytesWritten = written
+				result.Downloaded++
+			}
+			result.Items = items
+			result.Failed = len(result.Failures)
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*format.Output,
+				*format.Pretty,
+				func() error { return renderArtworkDownload(result, asc.RenderTable) },
+				func() error { return renderArtworkDownload(result, asc.RenderMarkdown) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("app-events artwork download: %d file(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+func resolveArtworkScope(appID, eventID string) (string, string, error) {
+	event := strings.TrimSpace(eventID)
+	resolvedAppID := ""
+	if event == "" {
+		resolvedAppID = shared.ResolveAppID(appID)
+		if resolvedAppID == "" {
+			fmt.Fprintln(os.Stderr, "Error: --app or --event-id is required (or set ASC_APP_ID)")
+			return "", "", flag.ErrHelp
+		}
+	} else if strings.TrimSpace(appID) != "" {
+		return "", "", shared.UsageError("--app and --event-id are mutually exclusive")
+	}
+	return resolvedAppID, event, nil
+}
+
+// collectArtwork lists screenshots and video clips for every localization of
+// the selected events, ordered by event, locale, asset type, and file name.
+func collectArtwork(ctx context.Context, client *asc.Client, appID, eventID string) ([]artworkItem, error) {
+	var events []asc.Resource[asc.AppEventAttributes]
+	if eventID != "" {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		resp, err := client.GetAppEvent(requestCtx, eventID)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch event: %w", err)
+		}
+		events = append(events, resp.Data)
+	} else {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		firstPage, err := client.GetAppEvents(requestCtx, appID, asc.WithAppEventsLimit(200))
+		if err == nil {
+			var all asc.PaginatedResponse
+			all, err = asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetAppEvents(ctx, appID, asc.WithAppEventsNextURL(nextURL))
+			})
+			if err == nil {
+				events = all.(*asc.AppEventsResponse).Data
+			}
+		}
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch events: %w", err)
+		}
+	}
+
+	items := []artworkItem{}
+	for _, event := range events {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		locs, err := client.GetAppEventLocalizations(requestCtx, event.ID, asc.WithAppEventLocalizationsLimit(200))
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch localizations for event %s: %w", event.ID, err)
+		}
+
+		for _, loc := range locs.Data {
+			base := artworkItem{
+				EventID:        event.ID,
+				EventName:      strings.TrimSpace(event.Attributes.ReferenceName),
+				EventState:     event.Attributes.EventState,
+				LocalizationID: loc.ID,
+				Locale:         loc.Attributes.Locale,
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			shots, err := client.GetAppEventScreenshots(requestCtx, loc.ID, asc.WithAppEventScreenshotsLimit(200))
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch screenshots for localization %s: %w", loc.ID, err)
+			}
+			for _, shot := range shots.Data {
+				item := base
+				item.Kind = artworkKindScreenshot
+				item.AssetType = shot.Attributes.AppEventAssetType
+				item.ID = shot.ID
+				item.FileName = shot.Attributes.FileName
+				item.State, _ = resolveAppEventAssetState(shot.Attributes.AssetDeliveryState)
+				item.image = shot.Attributes.ImageAsset
+				items = append(items, withImageSize(item))
+			}
+
+			requestCtx, cancel = shared.ContextWithTimeout(ctx)
+			clips, err := client.GetAppEventVideoClips(requestCtx, loc.ID, asc.WithAppEventVideoClipsLimit(200))
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch video clips for localization %s: %w", loc.ID, err)
+			}
+			for _, clip := range clips.Data {
+				item := base
+				item.Kind = artworkKindVideoClip
+				item.AssetType = clip.Attributes.AppEventAssetType
+				item.ID = clip.ID
+				item.FileName = clip.Attributes.FileName
+				item.State, _ = resolveAppEventVideoState(clip.Attributes)
+				item.VideoURL = clip.Attributes.VideoURL
+				if frame := clip.Attributes.PreviewFrameImage; frame != nil && frame.Image != nil {
+					item.image = frame.Image
+				} else {
+					item.image = clip.Attributes.PreviewImage
+				}
+				items = append(items, withImageSize(item))
+			}
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		switch {
+		case a.EventID != b.EventID:
+			return a.EventName+a.EventID < b.EventName+b.EventID
+		case a.Locale != b.Locale:
+			return a.Locale < b.Locale
+		case a.AssetType != b.AssetType:
+			return a.AssetType < b.AssetType
+		case a.Kind != b.Kind:
+			return a.Kind < b.Kind
+		default:
+			return strings.ToLower(a.FileName)+a.ID < strings.ToLower(b.FileName)+b.ID
+		}
+	})
+	return items, nil
+}
+
+func withImageSize(item artworkItem) artworkItem {
+	if item.image != nil {
+		item.Width = item.image.Width
+		item.Height = item.image.Height
+	}
+	return item
+}
+
+// artworkFolder is the directory for an item's event, locale, and asset type.
+func artworkFolder(dir string, item artworkItem) string {
+	eventDir := item.EventID
+	if name := assets.SanitizeBaseFileName(item.EventName); name != "" {
+		eventDir = name + "_" + item.EventID
+	}
+	locale := assets.SanitizeBaseFileName(item.Locale)
+	if locale == "" {
+		locale = item.LocalizationID
+	}
+	assetType := assets.SanitizeBaseFileName(strings.ToLower(item.AssetType))
+	if assetType == "" {
+		assetType = "unknown"
+	}
+	return filepath.Join(dir, eventDir, locale, assetType)
+}
+
+// artworkImageFileName is the downloaded file's base name. Video clips are
+// saved as their poster frame, so they get an image name.
+func artworkImageFileName(item artworkItem) string {
+	if item.Kind == artworkKindVideoClip {
+		return "poster.jpg"
+	}
+	if name := assets.SanitizeBaseFileName(item.FileName); name != "" {
+		return name
+	}
+	return "artwork.png"
+}
+
+func artworkRows(items []artworkItem) [][]string {
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		size := ""
+		if item.Width > 0 && item.Height > 0 {
+			size = fmt.Sprintf("%dx%d", item.Width, item.Height)
+		}
+		rows = append(rows, []string{
+			shared.OrNA(item.EventName),
+			shared.OrNA(item.Locale),
+			item.Kind,
+			shared.OrNA(item.AssetType),
+			shared.OrNA(item.FileName),
+			shared.OrNA(size),
+			shared.OrNA(item.State),
+		})
+	}
+	return rows
+}
+
+func renderArtworkItems(items []artworkItem, render func([]string, [][]string)) error {
+	render([]string{"Event", "Locale", "Kind", "Asset Type", "File", "Size", "State"}, artworkRows(items))
+	return nil
+}
+
+func renderArtworkDownload(result *artworkDownloadResult, render func([]string, [][]string)) error {
+	render([]string{"Output Dir", "Total", "Downloaded", "Failed"}, [][]string{{
+		result.OutputDir,
+		fmt.Sprintf("%d", result.Total),
+		fmt.Sprintf("%d", result.Downloaded),
+		fmt.Sprintf("%d", result.Failed),
+	}})
+	if len(result.Failures) > 0 {
+		rows := make([][]string, 0, len(result.Failures))
+		for _, failure := range result.Failures {
+			rows = append(rows, []string{failure.ID, failure.OutputPath, failure.Error})
+		}
+		render([]string{"ID", "Output Path", "Error"}, rows)
+	}
+	return nil
+}
@@ -285,7 +285,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -19,7 +19,7 @@ func AppEventsSubmitCommand() *ffcli.Command {
 
 	eventID := fs.String("event-id", "", "App event ID")
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	confirm := fs.Bool("confirm", false, "Confirm submission (required)")
 	output := shared.BindOutputFlags(fs)
 
@@ -52,7 +52,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return fmt.Errorf("app-events submit: %w", err)
 			}
@@ -331,7 +331,7 @@ Examples:
 			})
 
 			hasUpdate := visited["action"] || visited["category"] || visited["default-language"] || visited["is-powered-by"] || visited["removed"] || visited["header-image-id"] || visited["localization-id"] || visited["app-clip-id"]
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -28,7 +28,8 @@ Examples:
   asc app-clips get --id "CLIP_ID"
   asc app-clips default-experiences list --app-clip-id "CLIP_ID"
   asc app-clips advanced-experiences create --app "APP_ID" --bundle-id "com.example.clip" --link "https://example.com" --default-language EN --is-powered-by
-  asc app-clips invocations list --build-bundle-id "BUILD_BUNDLE_ID"`,
+  asc app-clips invocations list --build-bundle-id "BUILD_BUNDLE_ID"
+  asc app-clips publish-experience --app "APP_ID" --action OPEN --url "https://example.com/clip" --subtitle-file subs.yaml --header-image header.png`,
 		FlagSet:   fs,
 		UsageFunc: shared.VisibleUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -56,6 +57,7 @@ Examples:
 			AppClipInvocationsCommand(),
 			AppClipDomainStatusCommand(),
 			AppClipReviewDetailsCommand(),
+			AppClipsPublishExperienceCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -69,6 +71,7 @@ func AppClipsListCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	bundleID := fs.String("bundle-id", "", "Filter by bundle ID(s), comma-separated")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(appClipIncludeList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -83,6 +86,7 @@ func AppClipsListCommand() *ffcli.Command {
 Examples:
   asc app-clips list --app "APP_ID"
   asc app-clips list --app "APP_ID" --bundle-id "com.example.clip"
+  asc app-clips list --app "APP_ID" --include "appClipDefaultExperiences" --output table
   asc app-clips list --app "APP_ID" --limit 50
   asc app-clips list --app "APP_ID" --paginate`,
 		FlagSet:   fs,
@@ -94,6 +98,10 @@ Examples:
 			if err := shared.ValidateNextURL(*next); err != nil {
 				return fmt.Errorf("app-clips list: %w", err)
 			}
+			includeValues, err := normalizeAppClipInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			appValue := strings.TrimSpace(shared.ResolveAppID(*appID))
 			if appValue == "" {
@@ -113,6 +121,7 @@ Examples:
 				asc.WithAppClipsLimit(*limit),
 				asc.WithAppClipsNextURL(*next),
 				asc.WithAppClipsBundleIDs(shared.SplitCSV(*bundleID)),
+				asc.WithAppClipsInclude(includeValues),
 			}
 
 			if *paginate {
@@ -155,6 +164,7 @@ func AppClipsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	appClipID := fs.String("id", "", "App Clip ID")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(appClipIncludeList(), ", "))
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -164,7 +174,8 @@ func AppClipsGetCommand() *ffcli.Command {
 		LongHelp: `Get App Clip details by ID.
 
 Examples:
-  asc app-clips get --id "CLIP_ID"`,
+  asc app-clips get --id "CLIP_ID"
+  asc app-clips get --id "CLIP_ID" --include "app" --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -173,6 +184,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --id is required")
 				return flag.ErrHelp
 			}
+			includeValues, err := normalizeAppClipInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -182,7 +197,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.GetAppClip(requestCtx, idValue)
+			resp, err := client.GetAppClip(requestCtx, idValue, asc.WithAppClipInclude(includeValues))
 			if err != nil {
 				return fmt.Errorf("app-clips get: failed to fetch: %w", err)
 			}
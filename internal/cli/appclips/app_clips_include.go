@@ -0,0 +1,14 @@
+package appclips
+
+import "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+
+func normalizeAppClipInclude(value string) ([]string, error) {
+	return shared.NormalizeSelection(value, appClipIncludeList(), "--include")
+}
+
+func appClipIncludeList() []string {
+	return []string{
+		"app",
+		"appClipDefaultExperiences",
+	}
+}
@@ -260,7 +260,7 @@ Examples:
 				visited[f.Name] = true
 			})
 
-			if !visited["subtitle"] {
+			if !visited["subtitle"] && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
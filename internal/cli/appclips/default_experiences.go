@@ -268,7 +268,7 @@ Examples:
 				visited[f.Name] = true
 			})
 
-			if !visited["action"] && !visited["release-version-id"] {
+			if !visited["action"] && !visited["release-version-id"] && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
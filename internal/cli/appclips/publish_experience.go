@@ -0,0 +1,474 @@
+package appclips
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	publishActionCreate    = "create"
+	publishActionUpdate    = "update"
+	publishActionUnchanged = "unchanged"
+	publishActionUpload    = "upload"
+	publishActionReplace   = "replace"
+	publishActionSkipped   = "skipped"
+)
+
+type publishExperienceStep struct {
+	Step   string `json:"step"`
+	Locale string `json:"locale,omitempty"`
+	Action string `json:"action"`
+	ID     string `json:"id,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type publishExperienceResult struct {
+	AppClipID    string                  `json:"appClipId"`
+	ExperienceID string                  `json:"experienceId,omitempty"`
+	DryRun       bool                    `json:"dryRun"`
+	Steps        []publishExperienceStep `json:"steps"`
+}
+
+type publishExperienceOptions struct {
+	appID              string
+	appClipID          string
+	bundleID           string
+	action             string
+	releaseVersionID   string
+	urls               []string
+	locales            []string
+	subtitles          map[string]string
+	headerImage        string
+	headerImageMD5     string
+	replaceHeaderImage bool
+	dryRun             bool
+}
+
+// AppClipsPublishExperienceCommand returns the publish-experience subcommand.
+func AppClipsPublishExperienceCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("publish-experience", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appClipID := fs.String("app-clip-id", "", "App Clip ID (defaults to the app's only App Clip)")
+	bundleID := fs.String("bundle-id", "", "App Clip bundle ID (alternative to --app-clip-id)")
+	action := fs.String("action", "", "Action (OPEN, VIEW, PLAY); required when the default experience is created")
+	releaseVersionID := fs.String("release-version-id", "", "Release with App Store version ID")
+	urls := fs.String("url", "", "Invocation URL(s) for App Review, comma-separated")
+	locales := fs.String("locales", "", "Localization locales, comma-separated (default: the locales in --subtitle-file)")
+	subtitle := fs.String("subtitle", "", "Subtitle for every locale without one in --subtitle-file")
+	subtitleFile := fs.String("subtitle-file", "", "YAML or JSON map of locale to subtitle")
+	headerImage := fs.String("header-image", "", "Header image (PNG) for every localization")
+	replaceHeaderImage := fs.Bool("replace-header-image", false, "Replace existing header images that differ from --header-image")
+	dryRun := fs.Bool("dry-run", false, "Show what would change without changing anything")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "publish-experience",
+		ShortUsage: "asc app-clips publish-experience --app \"APP_ID\" [flags]",
+		ShortHelp:  "Create or update an App Clip default experience in one step.",
+		LongHelp: `Create or update an App Clip default experience in one step.
+
+Chains the default experience, its localizations (subtitles), header images,
+and App Store review details (invocation URLs). Each step checks what already
+exists and only creates or updates what differs, so re-running the command
+with the same flags changes nothing.
+
+Header images are compared by checksum. An existing header image that differs
+is left alone unless --replace-header-image is set. Localizations for other
+locales are never deleted.
+
+Subtitles come from --subtitle-file (for example "en-US: Order ahead") and
+fall back to --subtitle.
+
+Examples:
+  asc app-clips publish-experience --app "APP_ID" --action OPEN --url "https://example.com/clip" --locales "en-US,de-DE" --subtitle-file subs.yaml --header-image header.png
+  asc app-clips publish-experience --app "APP_ID" --bundle-id "com.example.app.clip" --subtitle-file subs.yaml --dry-run
+  asc app-clips publish-experience --app-clip-id "CLIP_ID" --locales "en-US" --subtitle "Order ahead" --header-image header.png --replace-header-image`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			opts := publishExperienceOptions{
+				appID:              strings.TrimSpace(shared.ResolveAppID(*appID)),
+				appClipID:          strings.TrimSpace(*appClipID),
+				bundleID:           strings.TrimSpace(*bundleID),
+				releaseVersionID:   strings.TrimSpace(*releaseVersionID),
+				urls:               shared.SplitCSV(*urls),
+				locales:            shared.SplitCSV(*locales),
+				headerImage:        strings.TrimSpace(*headerImage),
+				replaceHeaderImage: *replaceHeaderImage,
+				dryRun:             *dryRun,
+			}
+			if opts.appClipID == "" && opts.appID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app or --app-clip-id is required")
+				return flag.ErrHelp
+			}
+			if opts.appClipID != "" && opts.bundleID != "" {
+				return shared.UsageError("--app-clip-id and --bundle-id are mutually exclusive")
+			}
+			if strings.TrimSpace(*action) != "" {
+				normalized, err := normalizeAppClipAction(*action)
+				if err != nil {
+					return shared.UsageError("--action must be one of: OPEN, VIEW, PLAY")
+				}
+				opts.action = string(normalized)
+			}
+			if opts.replaceHeaderImage && opts.headerImage == "" {
+				return shared.UsageError("--replace-header-image requires --header-image")
+			}
+
+			subtitles, err := loadPublishSubtitles(strings.TrimSpace(*subtitleFile))
+			if err != nil {
+				return shared.UsageErrorf("--subtitle-file: %v", err)
+			}
+			if len(opts.locales) == 0 {
+				for locale := range subtitles {
+					opts.locales = append(opts.locales, locale)
+				}
+				slices.Sort(opts.locales)
+			}
+			opts.subtitles = map[string]string{}
+			for _, locale := range opts.locales {
+				value, ok := subtitles[locale]
+				if !ok {
+					value = strings.TrimSpace(*subtitle)
+				}
+				if value == "" {
+					return shared.UsageErrorf("no subtitle for locale %q; add it to --subtitle-file or pass --subtitle", locale)
+				}
+				opts.subtitles[locale] = value
+			}
+			if opts.headerImage != "" && len(opts.locales) == 0 {
+				return shared.UsageError("--header-image requires --locales or --subtitle-file")
+			}
+			if opts.headerImage != "" {
+				if err := asc.ValidateImageFile(opts.headerImage); err != nil {
+					return shared.UsageErrorf("--header-image: %v", err)
+				}
+				checksum, err := asc.ComputeFileChecksum(opts.headerImage, asc.ChecksumAlgorithmMD5)
+				if err != nil {
+					return fmt.Errorf("app-clips publish-experience: %w", err)
+				}
+				opts.headerImageMD5 = checksum.Hash
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("app-clips publish-experience: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithUploadTimeout(ctx)
+			defer cancel()
+
+			result := &publishExperienceResult{DryRun: opts.dryRun, Steps: []publishExperienceStep{}}
+			runErr := publishExperience(requestCtx, client, opts, result)
+			if err := printPublishExperienceResult(result, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if runErr != nil {
+				return fmt.Errorf("app-clips publish-experience: %w", runErr)
+			}
+			return nil
+		},
+	}
+}
+
+// loadPublishSubtitles reads a locale-to-subtitle map. An empty path yields
+// an empty map.
+func loadPublishSubtitles(path string) (map[string]string, error) {
+	subtitles := map[string]string{}
+	if path == "" {
+		return subtitles, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]string
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for locale, subtitle := range raw {
+		locale = strings.TrimSpace(locale)
+		subtitle = strings.TrimSpace(subtitle)
+		if locale == "" || subtitle == "" {
+			return nil, fmt.Errorf("%s: locales and subtitles must not be empty", path)
+		}
+		subtitles[locale] = subtitle
+	}
+	return subtitles, nil
+}
+
+func publishExperience(ctx context.Context, client *asc.Client, opts publishExperienceOptions, result *publishExperienceResult) error {
+	appClipID, err := resolvePublishAppClipID(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+	result.AppClipID = appClipID
+
+	experienceID, created, err := publishDefaultExperience(ctx, client, opts, appClipID, result)
+	if err != nil {
+		return err
+	}
+	result.ExperienceID = experienceID
+
+	localizationIDs, err := publishExperienceLocalizations(ctx, client, opts, experienceID, created, result)
+	if err != nil {
+		return err
+	}
+	if opts.headerImage != "" {
+		for _, locale := range opts.locales {
+			if err := publishHeaderImage(ctx, client, opts, locale, localizationIDs[locale], result); err != nil {
+				return err
+			}
+		}
+	}
+	if len(opts.urls) > 0 {
+		if err := publishReviewDetail(ctx, client, opts, experienceID, created, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvePublishAppClipID uses --app-clip-id, --bundle-id, or the app's only
+// App Clip.
+func resolvePublishAppClipID(ctx context.Context, client *asc.Client, opts publishExperienceOptions) (string, error) {
+	if opts.appClipID != "" || opts.bundleID != "" {
+		return resolveAppClipID(ctx, client, opts.appID, opts.appClipID, opts.bundleID)
+	}
+	resp, err := client.GetAppClips(ctx, opts.appID, asc.WithAppClipsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve app clip ID: %w", err)
+	}
+	switch len(resp.Data) {
+	case 0:
+		return "", fmt.Errorf("no App Clip found for app %q", opts.appID)
+	case 1:
+		return resp.Data[0].ID, nil
+	}
+	return "", fmt.Errorf("app %q has %d App Clips; pass --app-clip-id or --bundle-id", opts.appID, len(resp.Data))
+}
+
+func (r *publishExperienceResult) add(step publishExperienceStep) {
+	r.Steps = append(r.Steps, step)
+}
+
+// publishDefaultExperience finds or creates the App Clip's default
+// experience. It reports whether the experience was (or would be) created.
+func publishDefaultExperience(ctx context.Context, client *asc.Client, opts publishExperienceOptions, appClipID string, result *publishExperienceResult) (string, bool, error) {
+	resp, err := client.GetAppClipDefaultExperiences(ctx, appClipID, asc.WithAppClipDefaultExperiencesLimit(200))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to fetch default experiences: %w", err)
+	}
+
+	if len(resp.Data) == 0 {
+		if opts.action == "" {
+			return "", false, shared.UsageError("--action is required to create the default experience")
+		}
+		step := publishExperienceStep{Step: "default-experience", Action: publishActionCreate, Detail: "action " + opts.action}
+		if opts.dryRun {
+			result.add(step)
+			return "", true, nil
+		}
+		action := asc.AppClipAction(opts.action)
+		created, err := client.CreateAppClipDefaultExperience(ctx, appClipID, &asc.AppClipDefaultExperienceCreateAttributes{Action: &action}, opts.releaseVersionID, "")
+		if err != nil {
+			return "", false, fmt.Errorf("failed to create default experience: %w", err)
+		}
+		step.ID = created.Data.ID
+		result.add(step)
+		return created.Data.ID, true, nil
+	}
+
+	experience := resp.Data[0]
+	step := publishExperienceStep{Step: "default-experience", ID: experience.ID, Action: publishActionUnchanged}
+	var changes []string
+	var attrs *asc.AppClipDefaultExperienceUpdateAttributes
+	if opts.action != "" && string(experience.Attributes.Action) != opts.action {
+		action := asc.AppClipAction(opts.action)
+		attrs = &asc.AppClipDefaultExperienceUpdateAttributes{Action: &action}
+		changes = append(changes, "action "+opts.action)
+	}
+	releaseVersionID := ""
+	if opts.releaseVersionID != "" {
+		linkage, err := client.GetAppClipDefaultExperienceReleaseWithAppStoreVersionRelationship(ctx, experience.ID)
+		if err != nil && !asc.IsNotFound(err) {
+			return "", false, fmt.Errorf("failed to fetch release version: %w", err)
+		}
+		if linkage == nil || linkage.Data.ID != opts.releaseVersionID {
+			releaseVersionID = opts.releaseVersionID
+			changes = append(changes, "release version "+opts.releaseVersionID)
+		}
+	}
+	if len(changes) > 0 {
+		step.Action = publishActionUpdate
+		step.Detail = strings.Join(changes, ", ")
+		if !opts.dryRun {
+			if _, err := client.UpdateAppClipDefaultExperience(ctx, experience.ID, attrs, releaseVersionID); err != nil {
+				return "", false, fmt.Errorf("failed to update default experience: %w", err)
+			}
+		}
+	}
+	result.add(step)
+	return experience.ID, false, nil
+}
+
+// publishExperienceLocalizations creates or updates a localization per locale
+// and returns their IDs by locale ("" for ones a dry run would create).
+func publishExperienceLocalizations(ctx context.Context, client *asc.Client, opts publishExperienceOptions, experienceID string, created bool, result *publishExperienceResult) (map[string]string, error) {
+	existing := map[string]asc.Resource[asc.AppClipDefaultExperienceLocalizationAttributes]{}
+	if !created {
+		resp, err := client.GetAppClipDefaultExperienceLocalizations(ctx, experienceID, asc.WithAppClipDefaultExperienceLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+		}
+		for _, item := range resp.Data {
+			existing[strings.ToLower(item.Attributes.Locale)] = item
+		}
+	}
+
+	ids := map[string]string{}
+	for _, locale := range opts.locales {
+		subtitle := opts.subtitles[locale]
+		current, ok := existing[strings.ToLower(locale)]
+		step := publishExperienceStep{Step: "localization", Locale: locale, ID: current.ID}
+		switch {
+		case !ok:
+			step.Action = publishActionCreate
+			if !opts.dryRun {
+				resp, err := client.CreateAppClipDefaultExperienceLocalization(ctx, experienceID, asc.AppClipDefaultExperienceLocalizationCreateAttributes{
+					Locale:   locale,
+					Subtitle: &subtitle,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to create %s localization: %w", locale, err)
+				}
+				step.ID = resp.Data.ID
+			}
+		case current.Attributes.Subtitle != subtitle:
+			step.Action = publishActionUpdate
+			step.Detail = "subtitle"
+			if !opts.dryRun {
+				if _, err := client.UpdateAppClipDefaultExperienceLocalization(ctx, current.ID, &asc.AppClipDefaultExperienceLocalizationUpdateAttributes{Subtitle: &subtitle}); err != nil {
+					return nil, fmt.Errorf("failed to update %s localization: %w", locale, err)
+				}
+			}
+		default:
+			step.Action = publishActionUnchanged
+		}
+		result.add(step)
+		ids[locale] = step.ID
+	}
+	return ids, nil
+}
+
+func publishHeaderImage(ctx context.Context, client *asc.Client, opts publishExperienceOptions, locale, localizationID string, result *publishExperienceResult) error {
+	step := publishExperienceStep{Step: "header-image", Locale: locale, Action: publishActionUpload}
+
+	var current *asc.AppClipHeaderImageResponse
+	if localizationID != "" {
+		resp, err := client.GetAppClipDefaultExperienceLocalizationHeaderImage(ctx, localizationID)
+		if err != nil && !asc.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch %s header image: %w", locale, err)
+		}
+		if err == nil && resp.Data.ID != "" {
+			current = resp
+		}
+	}
+
+	if current != nil {
+		step.ID = current.Data.ID
+		if strings.EqualFold(current.Data.Attributes.SourceFileChecksum, opts.headerImageMD5) {
+			step.Action = publishActionUnchanged
+			result.add(step)
+			return nil
+		}
+		if !opts.replaceHeaderImage {
+			step.Action = publishActionSkipped
+			step.Detail = "existing header image differs; pass --replace-header-image to replace it"
+			result.add(step)
+			return nil
+		}
+		step.Action = publishActionReplace
+		if !opts.dryRun {
+			if err := client.DeleteAppClipHeaderImage(ctx, current.Data.ID); err != nil {
+				return fmt.Errorf("failed to delete %s header image: %w", locale, err)
+			}
+		}
+	}
+
+	if !opts.dryRun {
+		uploaded, err := client.UploadAppClipHeaderImage(ctx, localizationID, opts.headerImage)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s header image: %w", locale, err)
+		}
+		step.ID = uploaded.ID
+	}
+	result.add(step)
+	return nil
+}
+
+func publishReviewDetail(ctx context.Context, client *asc.Client, opts publishExperienceOptions, experienceID string, created bool, result *publishExperienceResult) error {
+	step := publishExperienceStep{Step: "review-detail", Detail: strings.Join(opts.urls, ", ")}
+
+	var current *asc.AppClipAppStoreReviewDetailResponse
+	if !created {
+		resp, err := client.GetAppClipDefaultExperienceReviewDetail(ctx, experienceID)
+		if err != nil && !asc.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch review detail: %w", err)
+		}
+		if err == nil && resp.Data.ID != "" {
+			current = resp
+		}
+	}
+
+	switch {
+	case current == nil:
+		step.Action = publishActionCreate
+		if !opts.dryRun {
+			resp, err := client.CreateAppClipAppStoreReviewDetail(ctx, experienceID, &asc.AppClipAppStoreReviewDetailCreateAttributes{InvocationURLs: opts.urls})
+			if err != nil {
+				return fmt.Errorf("failed to create review detail: %w", err)
+			}
+			step.ID = resp.Data.ID
+		}
+	case !slices.Equal(current.Data.Attributes.InvocationURLs, opts.urls):
+		step.ID = current.Data.ID
+		step.Action = publishActionUpdate
+		if !opts.dryRun {
+			if _, err := client.UpdateAppClipAppStoreReviewDetail(ctx, current.Data.ID, &asc.AppClipAppStoreReviewDetailUpdateAttributes{InvocationURLs: opts.urls}); err != nil {
+				return fmt.Errorf("failed to update review detail: %w", err)
+			}
+		}
+	default:
+		step.ID = current.Data.ID
+		step.Action = publishActionUnchanged
+	}
+	result.add(step)
+	return nil
+}
+
+func printPublishExperienceResult(result *publishExperienceResult, format string, pretty bool) error {
+	headers := []string{"Step", "Locale", "Action", "ID", "Detail"}
+	rows := make([][]string, 0, len(result.Steps))
+	for _, step := range result.Steps {
+		rows = append(rows, []string{step.Step, step.Locale, step.Action, step.ID, step.Detail})
+	}
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { asc.RenderTable(headers, rows); return nil },
+		func() error { asc.RenderMarkdown(headers, rows); return nil },
+	)
+}
@@ -58,7 +58,7 @@ func AppsInfoViewCommand() *ffcli.Command {
 	legacyAppInfoID := fs.String("app-info", "", "Deprecated alias for --info-id")
 	versionID := fs.String("version-id", "", "App Store version ID (optional override)")
 	version := fs.String("version", "", "App Store version string (optional)")
-	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (required with --version)")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (required with --version, or ASC_DEFAULT_PLATFORM env)")
 	state := fs.String("state", "", "Filter by app store state(s), comma-separated")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -115,7 +115,11 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			platforms, err := shared.NormalizeAppStoreVersionPlatforms(shared.SplitCSVUpper(*platform))
+			platformValue := *platform
+			if strings.TrimSpace(*version) != "" {
+				platformValue = shared.ResolvePlatform(platformValue, "")
+			}
+			platforms, err := shared.NormalizeAppStoreVersionPlatforms(shared.SplitCSVUpper(platformValue))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
@@ -219,7 +223,7 @@ func AppsInfoEditCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	versionID := fs.String("version-id", "", "App Store version ID (optional override)")
 	version := fs.String("version", "", "App Store version string (optional)")
-	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (required with --version)")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (required with --version, or ASC_DEFAULT_PLATFORM env)")
 	state := fs.String("state", "", "Filter by app store state(s), comma-separated")
 	locale := fs.String("locale", "", "Locale (e.g., en-US)")
 	copyFromLocale := fs.String("copy-from-locale", "", "Copy submit-required fields (description, keywords, support-url) from this locale when missing")
@@ -260,7 +264,11 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			platforms, err := shared.NormalizeAppStoreVersionPlatforms(shared.SplitCSVUpper(*platform))
+			platformValue := *platform
+			if strings.TrimSpace(*version) != "" {
+				platformValue = shared.ResolvePlatform(platformValue, "")
+			}
+			platforms, err := shared.NormalizeAppStoreVersionPlatforms(shared.SplitCSVUpper(platformValue))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
@@ -386,7 +394,7 @@ Examples:
 				resolvedAppID,
 				versionResource.ID,
 				valuesByLocale,
-				*dryRun,
+				*dryRun || asc.DryRunEnabled(),
 			)
 			if err != nil {
 				return fmt.Errorf("apps info edit: %w", err)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -36,7 +35,7 @@ This alias still works during the transition, but new usage should move to ` + "
 			deprecatedAppInfoTerritoryAgeRatingsAliasCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			fmt.Fprintf(os.Stderr, "Warning: `asc app-info` is deprecated. Use `%s`.\n", removedAppInfoSuggestion(args))
+			fmt.Fprintf(shared.WarningWriter(), "Warning: `asc app-info` is deprecated. Use `%s`.\n", removedAppInfoSuggestion(args))
 			return flag.ErrHelp
 		},
 	}
@@ -59,7 +58,7 @@ Use ` + "`asc apps info list --app \"APP_ID\"`" + ` to inspect app info records
 			deprecatedAppInfosListAliasCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			fmt.Fprintln(os.Stderr, `Warning: `+"`asc app-infos`"+` is deprecated. Use `+"`asc apps info list --app \"APP_ID\"`"+`.`)
+			fmt.Fprintln(shared.WarningWriter(), `Warning: `+"`asc app-infos`"+` is deprecated. Use `+"`asc apps info list --app \"APP_ID\"`"+`.`)
 			return flag.ErrHelp
 		},
 	}
@@ -148,7 +147,7 @@ func deprecatedAliasLeafCommand(cmd *ffcli.Command, name, shortUsage, newCommand
 	clone.UsageFunc = shared.DefaultUsageFunc
 	origExec := cmd.Exec
 	clone.Exec = func(ctx context.Context, args []string) error {
-		fmt.Fprintln(os.Stderr, warning)
+		fmt.Fprintln(shared.WarningWriter(), warning)
 		return origExec(ctx, args)
 	}
 	return &clone
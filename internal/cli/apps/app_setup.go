@@ -71,7 +71,7 @@ Examples:
 func AppSetupInfoSetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-setup info set", flag.ExitOnError)
 
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App Store Connect app ID (required)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	bundleID := fs.String("bundle-id", "", "Bundle ID to set")
 	primaryLocale := fs.String("primary-locale", "", "Primary locale (e.g., en-US)")
 	locale := fs.String("locale", "", "Locale for app info localization (defaults to --primary-locale)")
@@ -98,9 +98,9 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			appIDValue := strings.TrimSpace(*appID)
+			appIDValue := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
 			if appIDValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required")
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
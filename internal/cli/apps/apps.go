@@ -16,12 +16,13 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/iris"
 )
 
-func appsListFlags(fs *flag.FlagSet) (output shared.OutputFlags, bundleID *string, name *string, sku *string, sort *string, limit *int, next *string, paginate *bool) {
+func appsListFlags(fs *flag.FlagSet) (output shared.OutputFlags, bundleID *string, name *string, sku *string, sort *string, include *string, limit *int, next *string, paginate *bool) {
 	output = shared.BindOutputFlags(fs)
 	bundleID = fs.String("bundle-id", "", "Filter by bundle ID(s), comma-separated")
 	name = fs.String("name", "", "Filter by app name(s), comma-separated")
 	sku = fs.String("sku", "", "Filter by SKU(s), comma-separated")
 	sort = fs.String("sort", "", "Sort by name, -name, bundleId, or -bundleId")
+	include = fs.String("include", "", "Include related resources: "+strings.Join(appIncludeList(), ", "))
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -32,7 +33,7 @@ func appsListFlags(fs *flag.FlagSet) (output shared.OutputFlags, bundleID *strin
 func AppsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps", flag.ExitOnError)
 
-	output, bundleID, name, sku, sort, limit, next, paginate := appsListFlags(fs)
+	output, bundleID, name, sku, sort, include, limit, next, paginate := appsListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "apps",
@@ -80,7 +81,7 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Error: unknown subcommand %q\n", strings.TrimSpace(args[0]))
 				return flag.ErrHelp
 			}
-			return appsList(ctx, *output.Output, *output.Pretty, *bundleID, *name, *sku, *sort, *limit, *next, *paginate)
+			return appsList(ctx, *output.Output, *output.Pretty, *bundleID, *name, *sku, *sort, *include, *limit, *next, *paginate)
 		},
 	}
 }
@@ -89,7 +90,7 @@ Examples:
 func AppsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps list", flag.ExitOnError)
 
-	output, bundleID, name, sku, sort, limit, next, paginate := appsListFlags(fs)
+	output, bundleID, name, sku, sort, include, limit, next, paginate := appsListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -103,13 +104,14 @@ Examples:
   asc apps list --name "My App"
   asc apps list --limit 10
   asc apps list --sort name
+  asc apps list --include "appStoreVersions" --output table
   asc apps list --output table
   asc apps list --next "<links.next>"
   asc apps list --paginate`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return appsList(ctx, *output.Output, *output.Pretty, *bundleID, *name, *sku, *sort, *limit, *next, *paginate)
+			return appsList(ctx, *output.Output, *output.Pretty, *bundleID, *name, *sku, *sort, *include, *limit, *next, *paginate)
 		},
 	}
 }
@@ -119,6 +121,7 @@ func AppsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps get", flag.ExitOnError)
 
 	id := fs.String("id", "", "App Store Connect app ID")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(appIncludeList(), ", "))
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -129,7 +132,8 @@ func AppsGetCommand() *ffcli.Command {
 
 Examples:
   asc apps get --id "APP_ID"
-  asc apps get --id "APP_ID" --output table`,
+  asc apps get --id "APP_ID" --output table
+  asc apps get --id "APP_ID" --include "appInfos,ciProduct" --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -138,6 +142,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --id is required")
 				return flag.ErrHelp
 			}
+			includeValues, err := normalizeAppInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -147,7 +155,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			app, err := client.GetApp(requestCtx, idValue)
+			app, err := client.GetApp(requestCtx, idValue, asc.WithAppInclude(includeValues))
 			if err != nil {
 				return fmt.Errorf("apps get: failed to fetch: %w", err)
 			}
@@ -215,6 +223,9 @@ Examples:
 
 			// If no flags provided, run interactive mode
 			if *name == "" && *bundleID == "" && *sku == "" {
+				if err := shared.RequireInputAllowed("pass --name, --bundle-id, and --sku"); err != nil {
+					return err
+				}
 				fmt.Fprintln(os.Stderr)
 				fmt.Fprintln(os.Stderr, "Create a new app in App Store Connect")
 				fmt.Fprintln(os.Stderr)
@@ -562,7 +573,7 @@ Examples:
 	}
 }
 
-func appsList(ctx context.Context, output string, pretty bool, bundleID string, name string, sku string, sort string, limit int, next string, paginate bool) error {
+func appsList(ctx context.Context, output string, pretty bool, bundleID string, name string, sku string, sort string, include string, limit int, next string, paginate bool) error {
 	if limit != 0 && (limit < 1 || limit > 200) {
 		return fmt.Errorf("apps: --limit must be between 1 and 200")
 	}
@@ -572,6 +583,10 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 	if err := shared.ValidateSort(sort, "name", "-name", "bundleId", "-bundleId"); err != nil {
 		return fmt.Errorf("apps: %w", err)
 	}
+	includeValues, err := normalizeAppInclude(include)
+	if err != nil {
+		return shared.UsageError(err.Error())
+	}
 
 	client, err := shared.GetASCClient()
 	if err != nil {
@@ -581,12 +596,17 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 	requestCtx, cancel := shared.ContextWithTimeout(ctx)
 	defer cancel()
 
+	// Only a complete unfiltered listing can tell the ID cache that an app
+	// name is unique.
+	unfiltered := strings.TrimSpace(bundleID) == "" && strings.TrimSpace(name) == "" && strings.TrimSpace(sku) == ""
+
 	opts := []asc.AppsOption{
 		asc.WithAppsBundleIDs(shared.SplitCSV(bundleID)),
 		asc.WithAppsNames(shared.SplitCSV(name)),
 		asc.WithAppsSKUs(shared.SplitCSV(sku)),
 		asc.WithAppsLimit(limit),
 		asc.WithAppsNextURL(next),
+		asc.WithAppsInclude(includeValues),
 	}
 	if strings.TrimSpace(sort) != "" {
 		opts = append(opts, asc.WithAppsSort(sort))
@@ -605,6 +625,9 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 		if err != nil {
 			return fmt.Errorf("apps: %w", err)
 		}
+		if resp, ok := apps.(*asc.AppsResponse); ok {
+			shared.RememberApps(client, resp.Data, unfiltered && strings.TrimSpace(next) == "")
+		}
 
 		return shared.PrintOutput(apps, output, pretty)
 	}
@@ -613,6 +636,7 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 	if err != nil {
 		return fmt.Errorf("apps: failed to fetch: %w", err)
 	}
+	shared.RememberApps(client, apps.Data, unfiltered && strings.TrimSpace(next) == "" && strings.TrimSpace(apps.Links.Next) == "")
 
 	return shared.PrintOutput(apps, output, pretty)
 }
@@ -0,0 +1,26 @@
+package apps
+
+import "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+
+func normalizeAppInclude(value string) ([]string, error) {
+	return shared.NormalizeSelection(value, appIncludeList(), "--include")
+}
+
+func appIncludeList() []string {
+	return []string{
+		"appClips",
+		"appInfos",
+		"appStoreVersions",
+		"betaAppLocalizations",
+		"betaAppReviewDetail",
+		"betaGroups",
+		"betaLicenseAgreement",
+		"builds",
+		"ciProduct",
+		"endUserLicenseAgreement",
+		"gameCenterDetail",
+		"preReleaseVersions",
+		"reviewSubmissions",
+		"subscriptionGroups",
+	}
+}
@@ -220,7 +220,7 @@ Examples:
 			}
 
 			for _, warning := range result.Warnings {
-				fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+				fmt.Fprintf(shared.WarningWriter(), "Warning: %s\n", warning)
 			}
 			if result.PullRequestURL != "" {
 				fmt.Fprintf(os.Stderr, "Pull request created: #%d %s\n", result.PullRequestNumber, result.PullRequestURL)
@@ -287,7 +287,7 @@ func resolveCommunityWallGitHubIdentity(ctx context.Context) (string, string, er
 }
 
 func collectCommunityWallSubmitInput(appIDValue, nameValue, linkValue string) (communityWallSubmitInput, error) {
-	canPrompt := communityWallPromptEnabled()
+	canPrompt := communityWallPromptEnabled() && !shared.NoInputEnabled()
 
 	appIDValue = normalizeCommunityWallAppID(appIDValue)
 	nameValue = strings.TrimSpace(nameValue)
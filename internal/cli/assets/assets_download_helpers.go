@@ -233,3 +233,26 @@ func writeDownloadedFile(path string, reader io.Reader, overwrite bool) (int64,
 		},
 	)
 }
+
+// SanitizeBaseFileName reduces an API-provided file name to a safe base name,
+// or "" when nothing usable remains.
+func SanitizeBaseFileName(value string) string {
+	return sanitizeBaseFileName(value)
+}
+
+// DownloadImageAsset resolves an image asset's template URL at its full size
+// and downloads it to outputPath, retrying transient CDN failures.
+func DownloadImageAsset(ctx context.Context, asset *asc.ImageAsset, fileName, outputPath string, overwrite bool) (int64, error) {
+	downloadURL, err := resolveImageAssetDownloadURL(asset, fileName)
+	if err != nil {
+		return 0, err
+	}
+	written, _, err := downloadURLToFile(ctx, downloadURL, outputPath, overwrite)
+	return written, err
+}
+
+// DownloadURL downloads rawURL to outputPath, retrying transient CDN failures.
+func DownloadURL(ctx context.Context, rawURL, outputPath string, overwrite bool) (int64, error) {
+	written, _, err := downloadURLToFile(ctx, rawURL, outputPath, overwrite)
+	return written, err
+}
@@ -102,6 +102,19 @@ func waitForAssetDeliveryState(ctx context.Context, assetID string, fetch func(c
 	return lastState, nil
 }
 
+// verifyUploadedChecksum confirms the checksum App Store Connect recorded for
+// an uploaded asset matches the one computed from the local file.
+func verifyUploadedChecksum(fileName, local, remote string) error {
+	remote = strings.TrimSpace(remote)
+	if remote == "" {
+		return fmt.Errorf("verify checksum for %q: server did not report a source file checksum", fileName)
+	}
+	if !strings.EqualFold(local, remote) {
+		return fmt.Errorf("verify checksum for %q: server checksum %s does not match local checksum %s", fileName, remote, local)
+	}
+	return nil
+}
+
 func formatAssetErrors(errors []asc.ErrorDetail) string {
 	if len(errors) == 0 {
 		return "unknown error"
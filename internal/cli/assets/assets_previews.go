@@ -84,6 +84,7 @@ func AssetsPreviewsUploadCommand() *ffcli.Command {
 	skipExisting := fs.Bool("skip-existing", false, "Skip files whose MD5 checksum already exists in the target preview set")
 	replace := fs.Bool("replace", false, "Delete all existing previews from the target set before uploading")
 	dryRun := fs.Bool("dry-run", false, "Show what would be uploaded, skipped, or deleted without making changes")
+	verifyChecksum := fs.Bool("verify-checksum", false, "Re-fetch each uploaded preview and fail unless its server-side MD5 checksum matches the local file")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -97,7 +98,8 @@ Examples:
   asc video-previews upload --version-localization "LOC_ID" --path "./previews/preview.mov" --device-type "IPHONE_65"
   asc video-previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --skip-existing
   asc video-previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --replace
-  asc video-previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --skip-existing --dry-run`,
+  asc video-previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --skip-existing --dry-run
+  asc video-previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --verify-checksum`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -120,6 +122,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --skip-existing and --replace are mutually exclusive")
 				return flag.ErrHelp
 			}
+			if *verifyChecksum && *dryRun {
+				fmt.Fprintln(os.Stderr, "Error: --verify-checksum and --dry-run are mutually exclusive")
+				return flag.ErrHelp
+			}
 
 			previewType, err := normalizePreviewType(deviceValue)
 			if err != nil {
@@ -136,7 +142,7 @@ Examples:
 				return fmt.Errorf("video-previews upload: %w", err)
 			}
 
-			result, err := uploadPreviews(ctx, client, locID, previewType, files, *skipExisting, *replace, *dryRun)
+			result, err := uploadPreviews(ctx, client, locID, previewType, files, *skipExisting, *replace, *dryRun, *verifyChecksum)
 			if err != nil {
 				return fmt.Errorf("video-previews upload: %w", err)
 			}
@@ -579,7 +585,7 @@ func ensurePreviewSet(ctx context.Context, client *asc.Client, localizationID, p
 	return created.Data, nil
 }
 
-func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
+func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string, verifyChecksum bool) (asc.AssetUploadResultItem, error) {
 	if err := asc.ValidateImageFile(filePath); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
@@ -626,17 +632,29 @@ func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	return asc.AssetUploadResultItem{
+	item := asc.AssetUploadResultItem{
 		FileName: info.Name(),
 		FilePath: filePath,
 		AssetID:  created.Data.ID,
 		State:    state,
-	}, nil
+	}
+	if verifyChecksum {
+		resp, err := client.GetAppPreview(ctx, created.Data.ID)
+		if err != nil {
+			return asc.AssetUploadResultItem{}, fmt.Errorf("verify checksum for %q: %w", info.Name(), err)
+		}
+		if err := verifyUploadedChecksum(info.Name(), checksum.Hash, resp.Data.Attributes.SourceFileChecksum); err != nil {
+			return asc.AssetUploadResultItem{}, err
+		}
+		item.Checksum = checksum.Hash
+		item.ChecksumVerified = true
+	}
+	return item, nil
 }
 
 // UploadPreviewAsset uploads a preview file to a set.
 func UploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
-	return uploadPreviewAsset(ctx, client, setID, filePath)
+	return uploadPreviewAsset(ctx, client, setID, filePath, false)
 }
 
 func detectPreviewMimeType(path string) (string, error) {
@@ -654,7 +672,7 @@ func detectPreviewMimeType(path string) (string, error) {
 	return mimeType, nil
 }
 
-func uploadPreviews(ctx context.Context, client *asc.Client, localizationID, previewType string, files []string, skipExisting, replace, dryRun bool) (asc.AppPreviewUploadResult, error) {
+func uploadPreviews(ctx context.Context, client *asc.Client, localizationID, previewType string, files []string, skipExisting, replace, dryRun, verifyChecksum bool) (asc.AppPreviewUploadResult, error) {
 	if client == nil {
 		return asc.AppPreviewUploadResult{}, fmt.Errorf("client is required")
 	}
@@ -732,7 +750,7 @@ func uploadPreviews(ctx context.Context, client *asc.Client, localizationID, pre
 	results := make([]asc.AssetUploadResultItem, 0, len(skippedResults)+len(files))
 	if len(files) > 0 {
 		for _, filePath := range files {
-			item, err := uploadPreviewAsset(uploadCtx, client, set.ID, filePath)
+			item, err := uploadPreviewAsset(uploadCtx, client, set.ID, filePath, verifyChecksum)
 			if err != nil {
 				return asc.AppPreviewUploadResult{}, err
 			}
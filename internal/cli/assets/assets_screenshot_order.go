@@ -11,6 +11,10 @@ import (
 // UploadScreenshotsToSet uploads screenshots in the provided file order and then
 // applies that order to the remote screenshot set.
 func UploadScreenshotsToSet(ctx context.Context, client *asc.Client, setID string, files []string, preserveExistingOrder bool) ([]asc.AssetUploadResultItem, error) {
+	return uploadScreenshotsToSet(ctx, client, setID, files, preserveExistingOrder, false)
+}
+
+func uploadScreenshotsToSet(ctx context.Context, client *asc.Client, setID string, files []string, preserveExistingOrder, verifyChecksum bool) ([]asc.AssetUploadResultItem, error) {
 	orderedIDs := make([]string, 0, len(files))
 	if preserveExistingOrder {
 		existingIDs, err := GetOrderedAppScreenshotIDs(ctx, client, setID)
@@ -22,7 +26,7 @@ func UploadScreenshotsToSet(ctx context.Context, client *asc.Client, setID strin
 
 	results := make([]asc.AssetUploadResultItem, 0, len(files))
 	for _, filePath := range files {
-		item, err := uploadScreenshotAsset(ctx, client, setID, filePath)
+		item, err := uploadScreenshotAsset(ctx, client, setID, filePath, verifyChecksum)
 		if err != nil {
 			return nil, err
 		}
@@ -158,6 +158,7 @@ func AssetsScreenshotsUploadCommand() *ffcli.Command {
 	skipExisting := fs.Bool("skip-existing", false, "Skip files whose MD5 checksum already exists in the target screenshot set")
 	replace := fs.Bool("replace", false, "Delete all existing screenshots from the target set before uploading")
 	dryRun := fs.Bool("dry-run", false, "Show what would be uploaded, skipped, or deleted without making changes")
+	verifyChecksum := fs.Bool("verify-checksum", false, "Re-fetch each uploaded screenshot and fail unless its server-side MD5 checksum matches the local file")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -171,6 +172,7 @@ Examples:
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65" --skip-existing
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65" --replace
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65" --skip-existing --dry-run
+  asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65" --verify-checksum
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPAD_PRO_3GEN_129"
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"`,
 		FlagSet:   fs,
@@ -195,6 +197,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --skip-existing and --replace are mutually exclusive")
 				return flag.ErrHelp
 			}
+			if *verifyChecksum && *dryRun {
+				fmt.Fprintln(os.Stderr, "Error: --verify-checksum and --dry-run are mutually exclusive")
+				return flag.ErrHelp
+			}
 
 			displayType, err := normalizeScreenshotDisplayType(deviceValue)
 			if err != nil {
@@ -216,7 +222,7 @@ Examples:
 				return fmt.Errorf("screenshots upload: %w", err)
 			}
 
-			result, err := uploadScreenshots(ctx, client, locID, apiDisplayType, files, *skipExisting, *replace, *dryRun)
+			result, err := uploadScreenshots(ctx, client, locID, apiDisplayType, files, *skipExisting, *replace, *dryRun, *verifyChecksum)
 			if err != nil {
 				return fmt.Errorf("screenshots upload: %w", err)
 			}
@@ -680,7 +686,7 @@ func ensureScreenshotSet(ctx context.Context, client *asc.Client, localizationID
 	return created.Data, nil
 }
 
-func uploadScreenshots(ctx context.Context, client *asc.Client, localizationID, displayType string, files []string, skipExisting, replace, dryRun bool) (asc.AppScreenshotUploadResult, error) {
+func uploadScreenshots(ctx context.Context, client *asc.Client, localizationID, displayType string, files []string, skipExisting, replace, dryRun, verifyChecksum bool) (asc.AppScreenshotUploadResult, error) {
 	if client == nil {
 		return asc.AppScreenshotUploadResult{}, fmt.Errorf("client is required")
 	}
@@ -757,7 +763,7 @@ func uploadScreenshots(ctx context.Context, client *asc.Client, localizationID,
 
 	results := make([]asc.AssetUploadResultItem, 0, len(skippedResults)+len(files))
 	if len(files) > 0 {
-		uploadedResults, err := UploadScreenshotsToSet(uploadCtx, client, set.ID, files, !replace)
+		uploadedResults, err := uploadScreenshotsToSet(uploadCtx, client, set.ID, files, !replace, verifyChecksum)
 		if err != nil {
 			return asc.AppScreenshotUploadResult{}, err
 		}
@@ -828,7 +834,13 @@ func computeFileChecksum(filePath string) (string, error) {
 	return checksum.Hash, nil
 }
 
-func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
+// ComputeFileChecksum returns the MD5 checksum App Store Connect reports as
+// sourceFileChecksum for an uploaded asset.
+func ComputeFileChecksum(filePath string) (string, error) {
+	return computeFileChecksum(filePath)
+}
+
+func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string, verifyChecksum bool) (asc.AssetUploadResultItem, error) {
 	if err := asc.ValidateImageFile(filePath); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
@@ -870,17 +882,29 @@ func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, fileP
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	return asc.AssetUploadResultItem{
+	item := asc.AssetUploadResultItem{
 		FileName: info.Name(),
 		FilePath: filePath,
 		AssetID:  created.Data.ID,
 		State:    state,
-	}, nil
+	}
+	if verifyChecksum {
+		resp, err := client.GetAppScreenshot(ctx, created.Data.ID)
+		if err != nil {
+			return asc.AssetUploadResultItem{}, fmt.Errorf("verify checksum for %q: %w", info.Name(), err)
+		}
+		if err := verifyUploadedChecksum(info.Name(), checksum.Hash, resp.Data.Attributes.SourceFileChecksum); err != nil {
+			return asc.AssetUploadResultItem{}, err
+		}
+		item.Checksum = checksum.Hash
+		item.ChecksumVerified = true
+	}
+	return item, nil
 }
 
 // UploadScreenshotAsset uploads a screenshot file to a set.
 func UploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
-	return uploadScreenshotAsset(ctx, client, setID, filePath)
+	return uploadScreenshotAsset(ctx, client, setID, filePath, false)
 }
 
 func waitForScreenshotDelivery(ctx context.Context, client *asc.Client, screenshotID string) (string, error) {
@@ -0,0 +1,549 @@
+package assets
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const defaultScreenshotSyncConcurrency = 4
+
+// screenshotSyncLocalSet is one <locale>/<display type> directory to sync.
+type screenshotSyncLocalSet struct {
+	Locale      string
+	DisplayType string
+	Files       []string
+}
+
+// screenshotSyncItem is one planned keep, upload, or delete in a set.
+type screenshotSyncItem struct {
+	Action   string
+	FileName string
+	FilePath string
+	AssetID  string
+	Err      error
+}
+
+type screenshotSyncSetResult struct {
+	Locale         string
+	DisplayType    string
+	LocalizationID string
+	SetID          string
+	Uploaded       int
+	Kept           int
+	Deleted        int
+	Items          []screenshotSyncItem
+	// ReorderErr is set when the set could not be reordered after syncing.
+	ReorderErr error
+}
+
+type screenshotSyncResult struct {
+	VersionID string `json:"versionId"`
+	Dir       string `json:"dir"`
+	DryRun    bool   `json:"dryRun"`
+	Uploaded  int    `json:"uploaded"`
+	Kept      int    `json:"kept"`
+	Deleted   int    `json:"deleted"`
+	asc.BulkResult
+
+	Sets []screenshotSyncSetResult `json:"-"`
+}
+
+// screenshotSyncUpload is a single pending upload, indexed back into its set.
+type screenshotSyncUpload struct {
+	setIndex  int
+	itemIndex int
+	setID     string
+	filePath  string
+}
+
+// AssetsScreenshotsSyncCommand returns the screenshots sync subcommand.
+func AssetsScreenshotsSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID")
+	dir := fs.String("dir", "", "Directory laid out as <locale>/<display type>/<images>")
+	concurrency := fs.Int("concurrency", defaultScreenshotSyncConcurrency, "Number of screenshots to upload in parallel")
+	dryRun := fs.Bool("dry-run", false, "Show what would be uploaded, kept, or deleted without making changes")
+	confirm := fs.Bool("confirm", false, "Confirm uploading and deleting screenshots")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc screenshots sync --version-id \"VERSION_ID\" --dir \"./screenshots\" --confirm",
+		ShortHelp:  "Sync a directory of screenshots to a version's screenshot sets.",
+		LongHelp: `Sync a directory of screenshots to a version's screenshot sets.
+
+The directory is laid out by locale and display type:
+
+  screenshots/
+    en-US/
+      IPHONE_65/01-home.png
+      IPAD_PRO_3GEN_129/01-home.png
+    de-DE/
+      IPHONE_65/01-home.png
+
+For each <locale>/<display type> directory, screenshots whose MD5 checksum
+already exists in the remote set are kept, new files are uploaded in
+parallel, remote screenshots with no matching local file are deleted, and the
+set is reordered to match local file name order. Locales and display types
+without a local directory are left untouched.
+
+Examples:
+  asc screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --dry-run
+  asc screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --confirm
+  asc screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --confirm --concurrency 8`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				return shared.UsageError("--version-id is required")
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			if *concurrency < 1 {
+				return shared.UsageError("--concurrency must be at least 1")
+			}
+			planOnly := *dryRun || asc.DryRunEnabled()
+			if !planOnly && !*confirm {
+				return shared.UsageError("--confirm is required unless --dry-run is set")
+			}
+
+			localSets, err := collectScreenshotSyncSets(dirValue)
+			if err != nil {
+				return fmt.Errorf("screenshots sync: %w", err)
+			}
+			for _, localSet := range localSets {
+				if err := validateScreenshotDimensions(localSet.Files, localSet.DisplayType); err != nil {
+					return fmt.Errorf("screenshots sync: %s/%s: %w", localSet.Locale, localSet.DisplayType, err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("screenshots sync: %w", err)
+			}
+
+			result, err := syncScreenshots(ctx, client, versionValue, localSets, planOnly, *concurrency)
+			if err != nil {
+				return fmt.Errorf("screenshots sync: %w", err)
+			}
+			result.Dir = dirValue
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderScreenshotSyncResult(result, false) },
+				func() error { return renderScreenshotSyncResult(result, true) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("screenshots sync: %d item(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+// collectScreenshotSyncSets reads <dir>/<locale>/<display type>/ directories.
+// Display type directory names accept the same forms as --device-type.
+func collectScreenshotSyncSets(dir string) ([]screenshotSyncLocalSet, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%q is not a directory", dir)
+	}
+
+	localeEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]screenshotSyncLocalSet, 0)
+	for _, localeEntry := range localeEntries {
+		if !localeEntry.IsDir() || strings.HasPrefix(localeEntry.Name(), ".") {
+			continue
+		}
+		locale := localeEntry.Name()
+		localeDir := filepath.Join(dir, locale)
+
+		typeEntries, err := os.ReadDir(localeDir)
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[string]string)
+		for _, typeEntry := range typeEntries {
+			if !typeEntry.IsDir() || strings.HasPrefix(typeEntry.Name(), ".") {
+				continue
+			}
+			displayType, err := normalizeScreenshotDisplayType(typeEntry.Name())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filepath.Join(locale, typeEntry.Name()), err)
+			}
+			apiDisplayType := asc.CanonicalScreenshotDisplayTypeForAPI(displayType)
+			if previous, ok := seen[apiDisplayType]; ok {
+				return nil, fmt.Errorf("%s: directories %q and %q both map to %s", locale, previous, typeEntry.Name(), apiDisplayType)
+			}
+			seen[apiDisplayType] = typeEntry.Name()
+
+			files, err := collectAssetFiles(filepath.Join(localeDir, typeEntry.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filepath.Join(locale, typeEntry.Name()), err)
+			}
+			sets = append(sets, screenshotSyncLocalSet{
+				Locale:      locale,
+				DisplayType: apiDisplayType,
+				Files:       files,
+			})
+		}
+	}
+
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("no <locale>/<display type> directories found in %q", dir)
+	}
+	sort.Slice(sets, func(i, j int) bool {
+		if sets[i].Locale == sets[j].Locale {
+			return sets[i].DisplayType < sets[j].DisplayType
+		}
+		return sets[i].Locale < sets[j].Locale
+	})
+	return sets, nil
+}
+
+// syncScreenshots plans every set before mutating anything, then deletes stale
+// screenshots, uploads new ones in parallel, and reorders each changed set.
+// Failed deletes, uploads, and reorders are recorded as failed items; a set
+// with a failed item is not reordered.
+func syncScreenshots(ctx context.Context, client *asc.Client, versionID string, localSets []screenshotSyncLocalSet, dryRun bool, concurrency int) (*screenshotSyncResult, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	localizationIDs, err := screenshotSyncLocalizationIDs(requestCtx, client, versionID)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	for _, localSet := range localSets {
+		if _, ok := localizationIDs[localSet.Locale]; !ok {
+			return nil, fmt.Errorf("locale %q has no localization on version %s", localSet.Locale, versionID)
+		}
+	}
+
+	result := &screenshotSyncResult{
+		VersionID: versionID,
+		DryRun:    dryRun,
+		Sets:      make([]screenshotSyncSetResult, 0, len(localSets)),
+	}
+	reorder := make([]bool, len(localSets))
+
+	for i, localSet := range localSets {
+		localizationID := localizationIDs[localSet.Locale]
+
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		var set asc.Resource[asc.AppScreenshotSetAttributes]
+		if dryRun {
+			set, err = findScreenshotSet(requestCtx, client, localizationID, localSet.DisplayType)
+		} else {
+			set, err = ensureScreenshotSet(requestCtx, client, localizationID, localSet.DisplayType)
+		}
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", localSet.Locale, localSet.DisplayType, err)
+		}
+
+		existing := make([]asc.Resource[asc.AppScreenshotAttributes], 0)
+		if set.ID != "" {
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			resp, err := client.GetAppScreenshots(requestCtx, set.ID)
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", localSet.Locale, localSet.DisplayType, err)
+			}
+			existing = resp.Data
+		}
+
+		setResult, changed, err := planScreenshotSync(localSet, existing, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", localSet.Locale, localSet.DisplayType, err)
+		}
+		setResult.LocalizationID = localizationID
+		setResult.SetID = set.ID
+
+		result.Sets = append(result.Sets, setResult)
+		reorder[i] = changed
+	}
+
+	if !dryRun {
+		uploadCtx, cancel := contextWithAssetUploadTimeout(ctx)
+		defer cancel()
+
+		for i := range result.Sets {
+			for j, item := range result.Sets[i].Items {
+				if item.Action != "delete" {
+					continue
+				}
+				if err := client.DeleteAppScreenshot(uploadCtx, item.AssetID); err != nil {
+					result.Sets[i].Items[j].Err = err
+				}
+			}
+		}
+
+		uploadScreenshotSyncItems(uploadCtx, client, result.Sets, concurrency)
+
+		for i := range result.Sets {
+			if !reorder[i] || screenshotSyncSetFailed(result.Sets[i]) {
+				continue
+			}
+			if err := SetOrderedAppScreenshots(uploadCtx, client, result.Sets[i].SetID, screenshotSyncOrderedIDs(result.Sets[i])); err != nil {
+				result.Sets[i].ReorderErr = err
+			}
+		}
+	}
+
+	recordScreenshotSyncItems(result)
+	return result, nil
+}
+
+func screenshotSyncSetFailed(setResult screenshotSyncSetResult) bool {
+	for _, item := range setResult.Items {
+		if item.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// recordScreenshotSyncItems reports every planned item in the bulk result and
+// totals what was actually uploaded, kept, and deleted.
+func recordScreenshotSyncItems(result *screenshotSyncResult) {
+	for _, setResult := range result.Sets {
+		setName := setResult.Locale + "/" + setResult.DisplayType
+		for _, item := range setResult.Items {
+			bulkItem := asc.BulkResultItem{Item: setName + "/" + item.FileName, ID: item.AssetID}
+			switch {
+			case item.Err != nil:
+				bulkItem.Detail = item.Action
+				result.Fail(bulkItem, item.Err)
+			case item.Action == "keep":
+				result.Kept++
+				result.Skip(bulkItem, "already uploaded")
+			case item.Action == "upload" || item.Action == "would-upload":
+				result.Uploaded++
+				bulkItem.Detail = strings.ReplaceAll(item.Action, "-", " ")
+				result.Succeed(bulkItem)
+			default:
+				result.Deleted++
+				bulkItem.Detail = strings.ReplaceAll(item.Action, "-", " ")
+				result.Succeed(bulkItem)
+			}
+		}
+		if setResult.ReorderErr != nil {
+			result.Fail(asc.BulkResultItem{Item: setName, ID: setResult.SetID, Detail: "reorder"}, setResult.ReorderErr)
+		}
+	}
+	result.Total = len(result.Items)
+}
+
+// planScreenshotSync matches local files to remote screenshots by checksum.
+// It returns the set result, with remote screenshots to delete as delete
+// items, and whether the set needs reordering after the sync.
+func planScreenshotSync(localSet screenshotSyncLocalSet, existing []asc.Resource[asc.AppScreenshotAttributes], dryRun bool) (screenshotSyncSetResult, bool, error) {
+	uploadAction, deleteAction := "upload", "delete"
+	if dryRun {
+		uploadAction, deleteAction = "would-upload", "would-delete"
+	}
+
+	remaining := make(map[string][]asc.Resource[asc.AppScreenshotAttributes])
+	for _, screenshot := range existing {
+		checksum := strings.TrimSpace(screenshot.Attributes.SourceFileChecksum)
+		if checksum == "" {
+			continue
+		}
+		remaining[checksum] = append(remaining[checksum], screenshot)
+	}
+
+	setResult := screenshotSyncSetResult{
+		Locale:      localSet.Locale,
+		DisplayType: localSet.DisplayType,
+		Items:       make([]screenshotSyncItem, 0, len(localSet.Files)),
+	}
+	keptIDs := make(map[string]struct{})
+	keptOrder := make([]string, 0, len(localSet.Files))
+	for _, filePath := range localSet.Files {
+		checksum, err := screenshotFileChecksumFunc(filePath)
+		if err != nil {
+			return screenshotSyncSetResult{}, false, err
+		}
+		item := screenshotSyncItem{FileName: filepath.Base(filePath), FilePath: filePath}
+		if matches := remaining[checksum]; len(matches) > 0 {
+			remaining[checksum] = matches[1:]
+			item.Action = "keep"
+			item.AssetID = matches[0].ID
+			keptIDs[matches[0].ID] = struct{}{}
+			keptOrder = append(keptOrder, matches[0].ID)
+			setResult.Kept++
+		} else {
+			item.Action = uploadAction
+			setResult.Uploaded++
+		}
+		setResult.Items = append(setResult.Items, item)
+	}
+
+	remoteKeptOrder := make([]string, 0, len(keptOrder))
+	for _, screenshot := range existing {
+		if _, kept := keptIDs[screenshot.ID]; kept {
+			remoteKeptOrder = append(remoteKeptOrder, screenshot.ID)
+			continue
+		}
+		setResult.Items = append(setResult.Items, screenshotSyncItem{
+			Action:   deleteAction,
+			FileName: strings.TrimSpace(screenshot.Attributes.FileName),
+			AssetID:  screenshot.ID,
+		})
+		setResult.Deleted++
+	}
+
+	changed := setResult.Uploaded > 0 || setResult.Deleted > 0 || strings.Join(keptOrder, ",") != strings.Join(remoteKeptOrder, ",")
+	return setResult, changed, nil
+}
+
+// uploadScreenshotSyncItems uploads every pending file across all sets with a
+// bounded worker pool and records the new asset ID, or the upload error, on
+// the matching item.
+func uploadScreenshotSyncItems(ctx context.Context, client *asc.Client, sets []screenshotSyncSetResult, concurrency int) {
+	uploads := make([]screenshotSyncUpload, 0)
+	for setIndex, setResult := range sets {
+		for itemIndex, item := range setResult.Items {
+			if item.Action != "upload" {
+				continue
+			}
+			uploads = append(uploads, screenshotSyncUpload{
+				setIndex:  setIndex,
+				itemIndex: itemIndex,
+				setID:     setResult.SetID,
+				filePath:  item.FilePath,
+			})
+		}
+	}
+	if len(uploads) == 0 {
+		return
+	}
+
+	workers := max(min(len(uploads), concurrency), 1)
+	sem := make(chan struct{}, workers)
+	assetIDs := make([]string, len(uploads))
+	errs := make([]error, len(uploads))
+	var wg sync.WaitGroup
+
+	for idx := range uploads {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[idx] = fmt.Errorf("context cancelled: %w", ctx.Err())
+				return
+			}
+			defer func() { <-sem }()
+
+			upload := uploads[idx]
+			item, err := uploadScreenshotAsset(ctx, client, upload.setID, upload.filePath, false)
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+			assetIDs[idx] = item.AssetID
+		})
+	}
+	wg.Wait()
+
+	for idx, upload := range uploads {
+		item := &sets[upload.setIndex].Items[upload.itemIndex]
+		item.AssetID = assetIDs[idx]
+		item.Err = errs[idx]
+	}
+}
+
+// screenshotSyncOrderedIDs returns the set's screenshot IDs in local file order.
+func screenshotSyncOrderedIDs(setResult screenshotSyncSetResult) []string {
+	ids := make([]string, 0, len(setResult.Items))
+	for _, item := range setResult.Items {
+		if item.Action == "keep" || item.Action == "upload" {
+			ids = appendUniqueScreenshotID(ids, item.AssetID)
+		}
+	}
+	return ids
+}
+
+func screenshotSyncLocalizationIDs(ctx context.Context, client *asc.Client, versionID string) (map[string]string, error) {
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	localizations, ok := allPages.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected localizations response type: %T", allPages)
+	}
+
+	ids := make(map[string]string, len(localizations.Data))
+	for _, localization := range localizations.Data {
+		locale := strings.TrimSpace(localization.Attributes.Locale)
+		if locale != "" {
+			ids[locale] = localization.ID
+		}
+	}
+	return ids, nil
+}
+
+func renderScreenshotSyncResult(result *screenshotSyncResult, markdown bool) error {
+	if result == nil {
+		return fmt.Errorf("result is nil")
+	}
+
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Version", "Dir", "Dry Run", "Uploaded", "Kept", "Deleted", "Failed"},
+		[][]string{{
+			result.VersionID,
+			result.Dir,
+			fmt.Sprintf("%t", result.DryRun),
+			fmt.Sprintf("%d", result.Uploaded),
+			fmt.Sprintf("%d", result.Kept),
+			fmt.Sprintf("%d", result.Deleted),
+			fmt.Sprintf("%d", result.Failed),
+		}},
+	)
+
+	if len(result.Items) > 0 {
+		render(asc.BulkResultRows(&result.BulkResult))
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func stubScreenshotSyncChecksums(t *testing.T) {
+	t.Helper()
+
+	origChecksumFunc := screenshotFileChecksumFunc
+	screenshotFileChecksumFunc = func(path string) (string, error) {
+		return "sum-" + strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), nil
+	}
+	t.Cleanup(func() {
+		screenshotFileChecksumFunc = origChecksumFunc
+	})
+}
+
+func TestCollectScreenshotSyncSetsMapsLocaleAndDisplayTypeDirs(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"en-US/IPHONE_65", "en-US/APP_IPAD_PRO_3GEN_129", "de-DE/iphone_65"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		writeAssetsTestPNG(t, filepath.Join(root, dir), "01-home.png")
+	}
+
+	sets, err := collectScreenshotSyncSets(root)
+	if err != nil {
+		t.Fatalf("collectScreenshotSyncSets() error: %v", err)
+	}
+
+	got := make([]string, 0, len(sets))
+	for _, set := range sets {
+		got = append(got, fmt.Sprintf("%s/%s:%d", set.Locale, set.DisplayType, len(set.Files)))
+	}
+	want := "de-DE/APP_IPHONE_65:1,en-US/APP_IPAD_PRO_3GEN_129:1,en-US/APP_IPHONE_65:1"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected %s, got %s", want, strings.Join(got, ","))
+	}
+}
+
+func TestCollectScreenshotSyncSetsRejectsUnknownDisplayType(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "en-US", "WATCH_TOASTER"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	_, err := collectScreenshotSyncSets(root)
+	if err == nil || !strings.Contains(err.Error(), "unsupported screenshot display type") {
+		t.Fatalf("expected unsupported display type error, got %v", err)
+	}
+}
+
+func TestSyncScreenshotsKeepsUploadsDeletesAndReorders(t *testing.T) {
+	stubScreenshotSyncChecksums(t)
+
+	dir := t.TempDir()
+	homePath := writeAssetsTestPNG(t, dir, "01-home.png")
+	newPath := writeAssetsTestPNG(t, dir, "02-new.png")
+	newSize := fileSize(t, newPath)
+
+	var mu sync.Mutex
+	var deleted []string
+	var orderBody string
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = assetsUploadRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_1/appStoreVersionLocalizations":
+			return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_EN/appScreenshotSets":
+			return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/set-1/appScreenshots":
+			return assetsJSONResponse(http.StatusOK, `{"data":[
+				{"type":"appScreenshots","id":"old-stale","attributes":{"fileName":"stale.png","sourceFileChecksum":"sum-stale"}},
+				{"type":"appScreenshots","id":"old-home","attributes":{"fileName":"01-home.png","sourceFileChecksum":"sum-01-home"}}
+			],"links":{}}`)
+		case req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, "/v1/appScreenshots/"):
+			mu.Lock()
+			deleted = append(deleted, strings.TrimPrefix(req.URL.Path, "/v1/appScreenshots/"))
+			mu.Unlock()
+			return assetsJSONResponse(http.StatusNoContent, "")
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			body := fmt.Sprintf(`{"data":{"type":"appScreenshots","id":"new-1","attributes":{"uploadOperations":[{"method":"PUT","url":"https://upload.example/new-1","length":%d,"offset":0}]}}}`, newSize)
+			return assetsJSONResponse(http.StatusCreated, body)
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example":
+			return assetsJSONResponse(http.StatusOK, `{}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/new-1":
+			return assetsJSONResponse(http.StatusOK, `{"data":{"type":"appScreenshots","id":"new-1","attributes":{"uploaded":true}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshots/new-1":
+			return assetsJSONResponse(http.StatusOK, `{"data":{"type":"appScreenshots","id":"new-1","attributes":{"assetDeliveryState":{"state":"COMPLETE"}}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshotSets/set-1/relationships/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			orderBody = string(payload)
+			return assetsJSONResponse(http.StatusNoContent, "")
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+	t.Cleanup(func() {
+		http.DefaultTransport = origTransport
+	})
+
+	client := newAssetsUploadTestClient(t)
+	localSets := []screenshotSyncLocalSet{{Locale: "en-US", DisplayType: "APP_IPHONE_65", Files: []string{homePath, newPath}}}
+	result, err := syncScreenshots(context.Background(), client, "VERSION_1", localSets, false, 2)
+	if err != nil {
+		t.Fatalf("syncScreenshots() error: %v", err)
+	}
+
+	if result.Uploaded != 1 || result.Kept != 1 || result.Deleted != 1 {
+		t.Fatalf("expected 1 uploaded, 1 kept, 1 deleted, got %+v", result)
+	}
+	if len(deleted) != 1 || deleted[0] != "old-stale" {
+		t.Fatalf("expected old-stale to be deleted, got %v", deleted)
+	}
+	homeIdx := strings.Index(orderBody, `"old-home"`)
+	newIdx := strings.Index(orderBody, `"new-1"`)
+	if homeIdx < 0 || newIdx < 0 || homeIdx > newIdx || strings.Contains(orderBody, "old-stale") {
+		t.Fatalf("expected set ordered old-home, new-1, got %s", orderBody)
+	}
+}
+
+func TestSyncScreenshotsDryRunDoesNotMutate(t *testing.T) {
+	stubScreenshotSyncChecksums(t)
+
+	dir := t.TempDir()
+	homePath := writeAssetsTestPNG(t, dir, "01-home.png")
+
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = assetsUploadRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_1/appStoreVersionLocalizations":
+			return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_EN/appScreenshotSets":
+			return assetsJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		default:
+			t.Fatalf("dry-run must not issue mutating requests: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+	t.Cleanup(func() {
+		http.DefaultTransport = origTransport
+	})
+
+	client := newAssetsUploadTestClient(t)
+	localSets := []screenshotSyncLocalSet{{Locale: "en-US", DisplayType: "APP_IPHONE_65", Files: []string{homePath}}}
+	result, err := syncScreenshots(context.Background(), client, "VERSION_1", localSets, true, 4)
+	if err != nil {
+		t.Fatalf("syncScreenshots() error: %v", err)
+	}
+
+	if !result.DryRun || result.Uploaded != 1 {
+		t.Fatalf("expected dry-run with 1 planned upload, got %+v", result)
+	}
+	if got := result.Sets[0].Items[0].Action; got != "would-upload" {
+		t.Fatalf("expected would-upload, got %q", got)
+	}
+	if result.Total != 1 || result.Succeeded != 1 || result.Items[0].Detail != "would upload" {
+		t.Fatalf("expected one planned upload item, got %+v", result.BulkResult)
+	}
+}
+
+func TestSyncScreenshotsRecordsFailedDeleteAndSkipsReorder(t *testing.T) {
+	stubScreenshotSyncChecksums(t)
+
+	dir := t.TempDir()
+	homePath := writeAssetsTestPNG(t, dir, "01-home.png")
+	newPath := writeAssetsTestPNG(t, dir, "02-new.png")
+	newSize := fileSize(t, newPath)
+
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = assetsUploadRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_1/appStoreVersionLocalizations":
+			return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_EN/appScreenshotSets":
+			return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/set-1/appScreenshots":
+			return assetsJSONResponse(http.StatusOK, `{"data":[
+				{"type":"appScreenshots","id":"old-stale","attributes":{"fileName":"stale.png","sourceFileChecksum":"sum-stale"}},
+				{"type":"appScreenshots","id":"old-home","attributes":{"fileName":"01-home.png","sourceFileChecksum":"sum-01-home"}}
+			],"links":{}}`)
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/appScreenshots/old-stale":
+			return assetsJSONResponse(http.StatusConflict, `{"errors":[{"id":"req-1","status":"409","code":"STATE_ERROR","title":"Cannot delete","detail":"Screenshot is locked"}]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			body := fmt.Sprintf(`{"data":{"type":"appScreenshots","id":"new-1","attributes":{"uploadOperations":[{"method":"PUT","url":"https://upload.example/new-1","length":%d,"offset":0}]}}}`, newSize)
+			return assetsJSONResponse(http.StatusCreated, body)
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example":
+			return assetsJSONResponse(http.StatusOK, `{}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/new-1":
+			return assetsJSONResponse(http.StatusOK, `{"data":{"type":"appScreenshots","id":"new-1","attributes":{"uploaded":true}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshots/new-1":
+			return assetsJSONResponse(http.StatusOK, `{"data":{"type":"appScreenshots","id":"new-1","attributes":{"assetDeliveryState":{"state":"COMPLETE"}}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+	t.Cleanup(func() {
+		http.DefaultTransport = origTransport
+	})
+
+	client := newAssetsUploadTestClient(t)
+	localSets := []screenshotSyncLocalSet{{Locale: "en-US", DisplayType: "APP_IPHONE_65", Files: []string{homePath, newPath}}}
+	result, err := syncScreenshots(context.Background(), client, "VERSION_1", localSets, false, 2)
+	if err != nil {
+		t.Fatalf("syncScreenshots() error: %v", err)
+	}
+
+	if result.Total != 3 || result.Succeeded != 1 || result.Skipped != 1 || result.Failed != 1 {
+		t.Fatalf("expected 1 succeeded, 1 skipped, 1 failed, got %+v", result.BulkResult)
+	}
+	failed := result.FailedItems()
+	if failed[0].ID != "old-stale" || failed[0].Detail != "delete" || failed[0].RequestID != "req-1" {
+		t.Fatalf("expected failed delete of old-stale, got %+v", failed[0])
+	}
+	if result.Uploaded != 1 || result.Deleted != 0 {
+		t.Fatalf("expected 1 uploaded and 0 deleted, got %+v", result)
+	}
+}
+
+func TestSyncScreenshotsRejectsUnknownLocale(t *testing.T) {
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = assetsUploadRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_1/appStoreVersionLocalizations" {
+			return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}],"links":{}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+	t.Cleanup(func() {
+		http.DefaultTransport = origTransport
+	})
+
+	client := newAssetsUploadTestClient(t)
+	localSets := []screenshotSyncLocalSet{{Locale: "fr-FR", DisplayType: "APP_IPHONE_65"}}
+	_, err := syncScreenshots(context.Background(), client, "VERSION_1", localSets, false, 1)
+	if err == nil || !strings.Contains(err.Error(), `locale "fr-FR"`) {
+		t.Fatalf("expected unknown locale error, got %v", err)
+	}
+}
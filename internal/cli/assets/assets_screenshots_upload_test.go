@@ -2,8 +2,10 @@ package assets
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -58,7 +60,7 @@ func TestUploadScreenshotsSkipExistingStartsUploadTimeoutAfterChecksumFiltering(
 	})
 
 	client := newAssetsUploadTestClient(t)
-	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, true, false, false)
+	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, true, false, false, false)
 	if err != nil {
 		t.Fatalf("uploadScreenshots() error: %v", err)
 	}
@@ -89,7 +91,7 @@ func TestUploadScreenshotsDryRunReportsWouldUpload(t *testing.T) {
 	})
 
 	client := newAssetsUploadTestClient(t)
-	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, false, true)
+	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, false, true, false)
 	if err != nil {
 		t.Fatalf("uploadScreenshots() error: %v", err)
 	}
@@ -126,7 +128,7 @@ func TestUploadScreenshotsDryRunDoesNotCreateSet(t *testing.T) {
 	})
 
 	client := newAssetsUploadTestClient(t)
-	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, false, true)
+	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, false, true, false)
 	if err != nil {
 		t.Fatalf("uploadScreenshots() error: %v", err)
 	}
@@ -165,7 +167,7 @@ func TestUploadScreenshotsDryRunWithReplaceReportsWouldDelete(t *testing.T) {
 	})
 
 	client := newAssetsUploadTestClient(t)
-	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, true, true)
+	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, true, true, false)
 	if err != nil {
 		t.Fatalf("uploadScreenshots() error: %v", err)
 	}
@@ -211,7 +213,7 @@ func TestUploadScreenshotsDryRunWithSkipExistingReportsSkipped(t *testing.T) {
 	})
 
 	client := newAssetsUploadTestClient(t)
-	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, true, false, true)
+	result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, true, false, true, false)
 	if err != nil {
 		t.Fatalf("uploadScreenshots() error: %v", err)
 	}
@@ -229,3 +231,88 @@ func TestUploadScreenshotsDryRunWithSkipExistingReportsSkipped(t *testing.T) {
 		t.Fatal("expected Skipped=true")
 	}
 }
+
+func TestUploadScreenshotsVerifyChecksum(t *testing.T) {
+	filePath := writeAssetsTestPNG(t, t.TempDir(), "01-home.png")
+	fileSizeBytes := fileSize(t, filePath)
+	localChecksum, err := computeFileChecksum(filePath)
+	if err != nil {
+		t.Fatalf("computeFileChecksum() error: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		serverChecksum string
+		wantErr        string
+	}{
+		{name: "match", serverChecksum: localChecksum},
+		{name: "mismatch", serverChecksum: "0123456789abcdef0123456789abcdef", wantErr: "does not match local checksum"},
+		{name: "missing", serverChecksum: "", wantErr: "server did not report a source file checksum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var committedChecksum string
+			origTransport := http.DefaultTransport
+			http.DefaultTransport = assetsUploadRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_123/appScreenshotSets":
+					return assetsJSONResponse(http.StatusOK, `{"data":[{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}],"links":{}}`)
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/set-1/relationships/appScreenshots":
+					return assetsJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+				case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+					body := fmt.Sprintf(`{"data":{"type":"appScreenshots","id":"new-1","attributes":{"uploadOperations":[{"method":"PUT","url":"https://upload.example/new-1","length":%d,"offset":0}]}}}`, fileSizeBytes)
+					return assetsJSONResponse(http.StatusCreated, body)
+				case req.Method == http.MethodPut && req.URL.Host == "upload.example":
+					return assetsJSONResponse(http.StatusOK, `{}`)
+				case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/new-1":
+					var payload struct {
+						Data struct {
+							Attributes struct {
+								SourceFileChecksum string `json:"sourceFileChecksum"`
+							} `json:"attributes"`
+						} `json:"data"`
+					}
+					if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+						t.Fatalf("decode commit body: %v", err)
+					}
+					committedChecksum = payload.Data.Attributes.SourceFileChecksum
+					return assetsJSONResponse(http.StatusOK, `{"data":{"type":"appScreenshots","id":"new-1","attributes":{"uploaded":true}}}`)
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshots/new-1":
+					body := fmt.Sprintf(`{"data":{"type":"appScreenshots","id":"new-1","attributes":{"sourceFileChecksum":%q,"assetDeliveryState":{"state":"COMPLETE"}}}}`, tt.serverChecksum)
+					return assetsJSONResponse(http.StatusOK, body)
+				case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshotSets/set-1/relationships/appScreenshots":
+					return assetsJSONResponse(http.StatusNoContent, "")
+				default:
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+					return nil, nil
+				}
+			})
+			t.Cleanup(func() {
+				http.DefaultTransport = origTransport
+			})
+
+			client := newAssetsUploadTestClient(t)
+			result, err := uploadScreenshots(context.Background(), client, "LOC_123", "APP_IPHONE_65", []string{filePath}, false, false, false, true)
+			if committedChecksum != localChecksum {
+				t.Fatalf("expected commit checksum %q, got %q", localChecksum, committedChecksum)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("uploadScreenshots() error: %v", err)
+			}
+			if len(result.Results) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(result.Results))
+			}
+			item := result.Results[0]
+			if !item.ChecksumVerified || item.Checksum != localChecksum {
+				t.Fatalf("expected verified checksum %q, got %#v", localChecksum, item)
+			}
+		})
+	}
+}
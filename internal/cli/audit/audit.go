@@ -0,0 +1,218 @@
+package audit
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const defaultShowLimit = 50
+
+type auditShowResult struct {
+	Enabled bool             `json:"enabled"`
+	LogPath string           `json:"logPath"`
+	Total   int              `json:"total"`
+	Entries []asc.AuditEntry `json:"entries"`
+}
+
+type auditFilter struct {
+	since        time.Time
+	command      string
+	resourceType string
+	resourceID   string
+	keyID        string
+	errorsOnly   bool
+}
+
+// AuditCommand returns the audit command group.
+func AuditCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "audit",
+		ShortUsage: "asc audit <subcommand> [flags]",
+		ShortHelp:  "Review the local log of create, update, and delete requests.",
+		LongHelp: `Review the local log of create, update, and delete requests.
+
+Every request other than GET/HEAD that asc sends to App Store Connect is
+appended to a local audit log with the time, command, resource type and ID,
+API key ID, and the local user and host. Request bodies are never recorded.
+Dry runs send nothing and are not logged.
+
+The log defaults to ~/.asc/audit.jsonl; set ASC_AUDIT_LOG_PATH to use a
+different file (for example, a shared path on a CI runner). Set
+ASC_AUDIT_LOG=0 to stop recording.
+
+Examples:
+  asc audit show
+  asc audit show --key-id "ABC123" --since 2026-01-01
+  asc audit show --resource-type apps --resource-id "123456789"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			AuditShowCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// AuditShowCommand returns the audit show subcommand.
+func AuditShowCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+
+	since := fs.String("since", "", "Only include requests on or after this date (YYYY-MM-DD or RFC3339)")
+	command := fs.String("command", "", "Only include commands starting with this path (e.g., \"builds\" or \"asc apps update\")")
+	resourceType := fs.String("resource-type", "", "Only include this resource type (e.g., apps, betaGroups)")
+	resourceID := fs.String("resource-id", "", "Only include this resource ID")
+	keyID := fs.String("key-id", "", "Only include requests signed with this API key ID")
+	errorsOnly := fs.Bool("errors", false, "Only include requests that failed")
+	limit := fs.Int("limit", defaultShowLimit, "Maximum entries to show, newest first (0 for all)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "show",
+		ShortUsage: "asc audit show [flags]",
+		ShortHelp:  "Show recorded create, update, and delete requests.",
+		LongHelp: `Show recorded create, update, and delete requests, newest first.
+
+Examples:
+  asc audit show
+  asc audit show --since 2026-01-01 --limit 0
+  asc audit show --command "builds" --errors
+  asc audit show --key-id "ABC123" --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit < 0 {
+				return shared.UsageError("--limit must be 0 or greater")
+			}
+			filter := auditFilter{
+				command:      normalizeCommandPrefix(*command),
+				resourceType: strings.TrimSpace(*resourceType),
+				resourceID:   strings.TrimSpace(*resourceID),
+				keyID:        strings.TrimSpace(*keyID),
+				errorsOnly:   *errorsOnly,
+			}
+			if value := strings.TrimSpace(*since); value != "" {
+				parsed, err := parseSince(value)
+				if err != nil {
+					return shared.UsageError("--since must be YYYY-MM-DD or RFC3339")
+				}
+				filter.since = parsed
+			}
+
+			path, err := asc.AuditLogPath()
+			if err != nil {
+				return fmt.Errorf("audit show: %w", err)
+			}
+			entries, err := asc.ReadAuditEntries(path)
+			if err != nil {
+				return fmt.Errorf("audit show: %w", err)
+			}
+
+			enabled := asc.AuditLogEnabled()
+			if !enabled {
+				fmt.Fprintln(shared.WarningWriter(), "Warning: audit logging is off; unset ASC_AUDIT_LOG to resume recording")
+			}
+
+			result := &auditShowResult{
+				Enabled: enabled,
+				LogPath: path,
+				Entries: filterEntries(entries, filter),
+			}
+			result.Total = len(result.Entries)
+			if *limit > 0 && len(result.Entries) > *limit {
+				result.Entries = result.Entries[:*limit]
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderAuditEntries(result, false) },
+				func() error { return renderAuditEntries(result, true) },
+			)
+		},
+	}
+}
+
+// filterEntries returns the matching entries, newest first.
+func filterEntries(entries []asc.AuditEntry, filter auditFilter) []asc.AuditEntry {
+	matched := make([]asc.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !filter.since.IsZero() && entry.Time.Before(filter.since) {
+			continue
+		}
+		if filter.command != "" && entry.Command != filter.command && !strings.HasPrefix(entry.Command, filter.command+" ") {
+			continue
+		}
+		if filter.resourceType != "" && !strings.EqualFold(entry.ResourceType, filter.resourceType) {
+			continue
+		}
+		if filter.resourceID != "" && entry.ResourceID != filter.resourceID {
+			continue
+		}
+		if filter.keyID != "" && entry.KeyID != filter.keyID {
+			continue
+		}
+		if filter.errorsOnly && entry.Error == "" {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+	slices.Reverse(matched)
+	return matched
+}
+
+func parseSince(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// normalizeCommandPrefix accepts "apps update" or "asc apps update".
+func normalizeCommandPrefix(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" || value == "asc" || strings.HasPrefix(value, "asc ") {
+		return value
+	}
+	return "asc " + value
+}
+
+func renderAuditEntries(result *auditShowResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	rows := make([][]string, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		outcome := "ok"
+		if entry.Error != "" {
+			outcome = "failed: " + entry.Error
+		}
+		rows = append(rows, []string{
+			entry.Time.UTC().Format(time.RFC3339),
+			entry.Command,
+			entry.Method,
+			entry.ResourceType,
+			entry.ResourceID,
+			entry.KeyID,
+			entry.User,
+			outcome,
+		})
+	}
+	render([]string{"Time", "Command", "Method", "Resource Type", "Resource ID", "Key ID", "User", "Result"}, rows)
+	return nil
+}
@@ -55,6 +55,7 @@ Set ASC_BYPASS_KEYCHAIN to 1/true/yes/on to bypass keychain.`,
 			AuthInitCommand(),
 			AuthLoginCommand(),
 			AuthSwitchCommand(),
+			AuthRotateCommand(),
 			AuthLogoutCommand(),
 			AuthDoctorCommand(),
 			AuthStatusCommand(),
@@ -547,7 +548,7 @@ Examples:
 			credentials, err := listCredentialSummaries()
 			if err != nil {
 				if warning, ok := errors.AsType[*authsvc.CredentialsWarning](err); ok {
-					fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+					fmt.Fprintf(shared.WarningWriter(), "Warning: %s\n", warning)
 				} else {
 					return fmt.Errorf("auth switch: failed to list credentials: %w", err)
 				}
@@ -496,6 +496,102 @@ func TestAuthSwitchCommand(t *testing.T) {
 	})
 }
 
+func TestAuthRotateCommand(t *testing.T) {
+	t.Run("missing new key", func(t *testing.T) {
+		cmd := AuthRotateCommand()
+		if err := cmd.FlagSet.Parse([]string{"--new-key-id", "NEW"}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		_, stderr := captureAuthOutput(t, func() {
+			err := cmd.Exec(context.Background(), []string{})
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+		})
+		if !strings.Contains(stderr, "--new-key is required") {
+			t.Fatalf("expected missing --new-key error, got %q", stderr)
+		}
+	})
+
+	t.Run("validation failure leaves profile unchanged", func(t *testing.T) {
+		cfgPath := filepath.Join(t.TempDir(), "config.json")
+		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+		t.Setenv("ASC_CONFIG_PATH", cfgPath)
+		if err := authsvc.StoreCredentialsConfigAt("ci", "OLD", "ISS", "/tmp/AuthKey_OLD.p8", cfgPath); err != nil {
+			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
+		}
+		prevNetwork := loginNetworkValidate
+		loginNetworkValidate = func(context.Context, string, string, string) error {
+			return errors.New("401 unauthorized")
+		}
+		t.Cleanup(func() {
+			loginNetworkValidate = prevNetwork
+		})
+
+		cmd := AuthRotateCommand()
+		if err := cmd.FlagSet.Parse([]string{"--new-key", writeTempECDSAKeyFile(t), "--new-key-id", "NEW"}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		err := cmd.Exec(context.Background(), []string{})
+		if err == nil || !strings.Contains(err.Error(), "profile unchanged") {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+
+		cfg, err := config.LoadAt(cfgPath)
+		if err != nil {
+			t.Fatalf("LoadAt() error: %v", err)
+		}
+		if len(cfg.Keys) != 1 || cfg.Keys[0].KeyID != "OLD" {
+			t.Fatalf("expected config to be unchanged, got %+v", cfg.Keys)
+		}
+	})
+
+	t.Run("success archives previous key", func(t *testing.T) {
+		cfgPath := filepath.Join(t.TempDir(), "config.json")
+		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+		t.Setenv("ASC_CONFIG_PATH", cfgPath)
+		if err := authsvc.StoreCredentialsConfigAt("ci", "OLD", "ISS", "/tmp/AuthKey_OLD.p8", cfgPath); err != nil {
+			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
+		}
+		prevNetwork := loginNetworkValidate
+		loginNetworkValidate = func(context.Context, string, string, string) error {
+			return nil
+		}
+		t.Cleanup(func() {
+			loginNetworkValidate = prevNetwork
+		})
+
+		keyPath := writeTempECDSAKeyFile(t)
+		cmd := AuthRotateCommand()
+		if err := cmd.FlagSet.Parse([]string{"--new-key", keyPath, "--new-key-id", "NEW", "--archive-name", "ci-old"}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		stdout, _ := captureAuthOutput(t, func() {
+			if err := cmd.Exec(context.Background(), []string{}); err != nil {
+				t.Fatalf("Exec() error: %v", err)
+			}
+		})
+		if !strings.Contains(stdout, "Rotated profile 'ci' from key OLD to NEW") {
+			t.Fatalf("unexpected stdout %q", stdout)
+		}
+
+		cfg, err := config.LoadAt(cfgPath)
+		if err != nil {
+			t.Fatalf("LoadAt() error: %v", err)
+		}
+		if cfg.DefaultKeyName != "ci" || cfg.KeyID != "NEW" || cfg.PrivateKeyPath != keyPath {
+			t.Fatalf("expected active profile to use new key, got %+v", cfg)
+		}
+		keys := map[string]string{}
+		for _, key := range cfg.Keys {
+			keys[key.Name] = key.KeyID
+		}
+		if keys["ci"] != "NEW" || keys["ci-old"] != "OLD" {
+			t.Fatalf("unexpected keys after rotation: %+v", cfg.Keys)
+		}
+	})
+}
+
 func TestAuthLogoutCommand(t *testing.T) {
 	t.Run("blank name rejected", func(t *testing.T) {
 		cmd := AuthLogoutCommand()
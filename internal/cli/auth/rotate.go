@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AuthRotateCommand swaps a new API key into an existing profile.
+func AuthRotateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth rotate", flag.ExitOnError)
+
+	name := fs.String("name", "", "Profile to rotate (default: current default profile)")
+	newKeyPath := fs.String("new-key", "", "Path to the new private key (.p8) file")
+	newKeyID := fs.String("new-key-id", "", "Key ID of the new API key")
+	issuerID := fs.String("issuer-id", "", "Issuer ID for the new key (default: keep current)")
+	archiveName := fs.String("archive-name", "", "Profile name for the previous key (default: <name>-archived-<date>)")
+
+	return &ffcli.Command{
+		Name:       "rotate",
+		ShortUsage: "asc auth rotate --new-key <path> --new-key-id <id> [flags]",
+		ShortHelp:  "Rotate the API key for a stored profile.",
+		LongHelp: `Rotate the API key for a stored profile.
+
+The new key is validated with a lightweight read request before anything is
+changed. The profile is then updated in place and the previous key is kept as
+an archived profile in the same storage (keychain or config file), so you can
+switch back with "asc auth switch" until the old key is revoked. Remove the
+archive with "asc auth logout --name" once rotation is complete.
+
+Examples:
+  asc auth rotate --new-key ./AuthKey_XYZ.p8 --new-key-id "XYZ"
+  asc auth rotate --name "CI" --new-key ./AuthKey_XYZ.p8 --new-key-id "XYZ"
+  asc auth rotate --name "CI" --new-key ./AuthKey_XYZ.p8 --new-key-id "XYZ" --archive-name "CI-old"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			keyPath := strings.TrimSpace(*newKeyPath)
+			if keyPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --new-key is required")
+				return flag.ErrHelp
+			}
+			keyID := strings.TrimSpace(*newKeyID)
+			if keyID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --new-key-id is required")
+				return flag.ErrHelp
+			}
+			if *name != "" && strings.TrimSpace(*name) == "" {
+				return shared.UsageError("--name cannot be blank")
+			}
+
+			credentials, err := listCredentialSummaries()
+			if err != nil {
+				if warning, ok := errors.AsType[*authsvc.CredentialsWarning](err); ok {
+					fmt.Fprintf(shared.WarningWriter(), "Warning: %s\n", warning)
+				} else {
+					return fmt.Errorf("auth rotate: failed to list credentials: %w", err)
+				}
+			}
+			if len(credentials) == 0 {
+				return fmt.Errorf("auth rotate: no credentials stored; use asc auth login first")
+			}
+
+			profileName := strings.TrimSpace(*name)
+			var current *authsvc.Credential
+			for i := range credentials {
+				credName := strings.TrimSpace(credentials[i].Name)
+				if (profileName != "" && credName == profileName) || (profileName == "" && credentials[i].IsDefault) {
+					current = &credentials[i]
+					break
+				}
+			}
+			if current == nil {
+				if profileName == "" {
+					fmt.Fprintln(os.Stderr, "Error: --name is required when no default profile is set")
+					return flag.ErrHelp
+				}
+				return fmt.Errorf("auth rotate: profile %q not found", profileName)
+			}
+			profileName = strings.TrimSpace(current.Name)
+			if keyID == strings.TrimSpace(current.KeyID) {
+				return shared.UsageErrorf("--new-key-id matches the current key for profile %q", profileName)
+			}
+
+			issuerValue := strings.TrimSpace(*issuerID)
+			if issuerValue == "" {
+				issuerValue = strings.TrimSpace(current.IssuerID)
+			}
+			archiveValue := strings.TrimSpace(*archiveName)
+			if archiveValue == "" {
+				archiveValue = fmt.Sprintf("%s-archived-%s", profileName, time.Now().UTC().Format("20060102"))
+			}
+			if archiveValue == profileName {
+				return shared.UsageError("--archive-name must differ from the profile name")
+			}
+
+			if err := authsvc.ValidateKeyFile(keyPath); err != nil {
+				return fmt.Errorf("auth rotate: invalid private key: %w", err)
+			}
+			if err := validateLoginCredentials(ctx, keyID, issuerValue, keyPath, true); err != nil {
+				return fmt.Errorf("auth rotate: new key rejected, profile unchanged: %w", err)
+			}
+
+			previous, err := authsvc.RotateCredentials(profileName, keyID, issuerValue, keyPath, archiveValue)
+			if err != nil {
+				return fmt.Errorf("auth rotate: %w", err)
+			}
+
+			fmt.Printf("Rotated profile '%s' from key %s to %s\n", profileName, previous.KeyID, keyID)
+			fmt.Printf("Previous key archived as '%s'\n", archiveValue)
+			return nil
+		},
+	}
+}
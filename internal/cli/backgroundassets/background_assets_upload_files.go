@@ -244,7 +244,7 @@ Examples:
 			sourceChecksums := resp.Data.Attributes.SourceFileChecksums
 			if *checksum {
 				if sourceChecksums == nil || (sourceChecksums.File == nil && sourceChecksums.Composite == nil) {
-					fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+					fmt.Fprintln(shared.WarningWriter(), "Warning: --checksum requested but API provided no checksums to verify; skipping")
 				} else {
 					computed, err := asc.VerifySourceFileChecksums(pathValue, sourceChecksums)
 					if err != nil {
@@ -352,7 +352,7 @@ Examples:
 				sourceChecksums := resp.Data.Attributes.SourceFileChecksums
 				if *checksum {
 					if sourceChecksums == nil || (sourceChecksums.File == nil && sourceChecksums.Composite == nil) {
-						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+						fmt.Fprintln(shared.WarningWriter(), "Warning: --checksum requested but API provided no checksums to verify; skipping")
 					} else {
 						computed, err := asc.VerifySourceFileChecksums(pathValue, sourceChecksums)
 						if err != nil {
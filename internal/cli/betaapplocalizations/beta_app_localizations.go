@@ -289,7 +289,7 @@ Examples:
 				visited["marketing-url"] ||
 				visited["privacy-policy-url"] ||
 				visited["tv-os-privacy-policy"]
-			if !hasUpdates {
+			if !hasUpdates && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -64,7 +63,7 @@ Examples:
 			),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			fmt.Fprintln(os.Stderr, "Warning: `asc beta-app-localizations` is deprecated. Use `asc testflight app-localizations ...`.")
+			fmt.Fprintln(shared.WarningWriter(), "Warning: `asc beta-app-localizations` is deprecated. Use `asc testflight app-localizations ...`.")
 			return flag.ErrHelp
 		},
 	}
@@ -107,7 +106,7 @@ func deprecatedBetaAppLocalizationsLeafCommand(cmd *ffcli.Command, shortUsage, n
 
 	origExec := cmd.Exec
 	clone.Exec = func(ctx context.Context, args []string) error {
-		fmt.Fprintln(os.Stderr, warning)
+		fmt.Fprintln(shared.WarningWriter(), warning)
 		return origExec(ctx, args)
 	}
 
@@ -66,7 +66,7 @@ Examples:
 			),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			fmt.Fprintln(os.Stderr, "Warning: `asc beta-build-localizations` is deprecated. Use `asc builds test-notes ...` for canonical build-scoped workflows.")
+			fmt.Fprintln(shared.WarningWriter(), "Warning: `asc beta-build-localizations` is deprecated. Use `asc builds test-notes ...` for canonical build-scoped workflows.")
 			return flag.ErrHelp
 		},
 	}
@@ -235,8 +235,8 @@ Examples:
 					fmt.Fprintln(os.Stderr, "Error: --id is required")
 					return flag.ErrHelp
 				}
-				if *latest && appValue == "" {
-					fmt.Fprintln(os.Stderr, "Error: --app is required with --latest")
+				if *latest && shared.ResolveAppID(appValue) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --app is required with --latest (or set ASC_APP_ID)")
 					return flag.ErrHelp
 				}
 				if !*latest && appValue != "" {
@@ -356,8 +356,8 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --build is mutually exclusive with --app, --latest, and --state")
 				return flag.ErrHelp
 			}
-			if *latest && appValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required with --latest")
+			if *latest && shared.ResolveAppID(appValue) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required with --latest (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 			if !*latest && appValue != "" {
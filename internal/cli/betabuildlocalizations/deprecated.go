@@ -3,9 +3,10 @@ package betabuildlocalizations
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 func deprecatedBetaBuildLocalizationsLeafCommand(cmd *ffcli.Command, replacement, warning string) *ffcli.Command {
@@ -24,7 +25,7 @@ func deprecatedBetaBuildLocalizationsLeafCommand(cmd *ffcli.Command, replacement
 
 	origExec := cmd.Exec
 	clone.Exec = func(ctx context.Context, args []string) error {
-		fmt.Fprintln(os.Stderr, warning)
+		fmt.Fprintln(shared.WarningWriter(), warning)
 		return origExec(ctx, args)
 	}
 
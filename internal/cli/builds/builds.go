@@ -20,6 +20,7 @@ func BuildsAddGroupsCommand() *ffcli.Command {
 	buildID := fs.String("build", "", "Build ID")
 	groups := fs.String("group", "", "Comma-separated beta group IDs or names")
 	skipInternal := fs.Bool("skip-internal", false, "Skip internal beta groups instead of adding them")
+	compliance := shared.BindComplianceFlag(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -28,11 +29,15 @@ func BuildsAddGroupsCommand() *ffcli.Command {
 		ShortHelp:  "Add beta groups to a build for TestFlight distribution.",
 		LongHelp: `Add beta groups to a build for TestFlight distribution.
 
+Use --compliance to answer export compliance before the groups are added;
+external testing is blocked until the build has an answer.
+
 Examples:
   asc builds add-groups --build "BUILD_ID" --group "GROUP_ID"
   asc builds add-groups --build "BUILD_ID" --group "External Testers"
   asc builds add-groups --build "BUILD_ID" --group "GROUP1,GROUP2"
-  asc builds add-groups --build "BUILD_ID" --group "INTERNAL_ID,EXTERNAL_ID" --skip-internal`,
+  asc builds add-groups --build "BUILD_ID" --group "INTERNAL_ID,EXTERNAL_ID" --skip-internal
+  asc builds add-groups --build "BUILD_ID" --group "External Testers" --compliance uses-non-exempt-encryption=false`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -61,6 +66,11 @@ Examples:
 				return fmt.Errorf("builds add-groups: %w", err)
 			}
 
+			usesNonExemptEncryption, err := shared.ApplyBuildComplianceByID(requestCtx, client, trimmedBuildID, compliance)
+			if err != nil {
+				return fmt.Errorf("builds add-groups: %w", err)
+			}
+
 			addResult, err := shared.AddBuildBetaGroups(requestCtx, client, trimmedBuildID, resolvedGroups, shared.AddBuildBetaGroupsOptions{
 				SkipInternal: *skipInternal,
 			})
@@ -80,18 +90,20 @@ Examples:
 			if len(addResult.AddedGroupIDs) == 0 {
 				fmt.Fprintf(os.Stderr, "No groups to add for build %s after applying filters\n", trimmedBuildID)
 				result := &asc.BuildBetaGroupsUpdateResult{
-					BuildID:  trimmedBuildID,
-					GroupIDs: []string{},
-					Action:   "added",
+					BuildID:                 trimmedBuildID,
+					GroupIDs:                []string{},
+					Action:                  "added",
+					UsesNonExemptEncryption: usesNonExemptEncryption,
 				}
 				return shared.PrintOutput(result, *output.Output, *output.Pretty)
 			}
 
 			fmt.Fprintf(os.Stderr, "Successfully added %d group(s) to build %s\n", len(addResult.AddedGroupIDs), trimmedBuildID)
 			result := &asc.BuildBetaGroupsUpdateResult{
-				BuildID:  trimmedBuildID,
-				GroupIDs: addResult.AddedGroupIDs,
-				Action:   "added",
+				BuildID:                 trimmedBuildID,
+				GroupIDs:                addResult.AddedGroupIDs,
+				Action:                  "added",
+				UsesNonExemptEncryption: usesNonExemptEncryption,
 			}
 
 			return shared.PrintOutput(result, *output.Output, *output.Pretty)
@@ -3,9 +3,11 @@ package builds
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -22,18 +24,21 @@ func BuildsUploadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("upload", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	filePathFlag := fs.String("file", "", "Path to .ipa or .pkg file (type detected from the extension)")
 	ipaPath := fs.String("ipa", "", "Path to .ipa file (for iOS, tvOS, visionOS apps)")
 	pkgPath := fs.String("pkg", "", "Path to .pkg file (for macOS apps)")
 	version := fs.String("version", "", "CFBundleShortVersionString (e.g., 1.0.0, auto-extracted from IPA if not provided)")
 	buildNumber := fs.String("build-number", "", "CFBundleVersion (e.g., 123, auto-extracted from IPA if not provided)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (auto-detected for --pkg)")
 	dryRun := fs.Bool("dry-run", false, "Reserve upload operations without uploading the file")
-	concurrency := fs.Int("concurrency", 1, "Upload concurrency (default 1)")
+	concurrency := fs.Int("concurrency", 0, "Upload parts to send in parallel (default: --upload-concurrency, ASC_UPLOAD_CONCURRENCY, or 4)")
+	noResume := fs.Bool("no-resume", false, "Start a new upload instead of resuming an interrupted upload of the same file")
 	verifyChecksum := fs.Bool("checksum", false, "Verify upload checksums if provided by API")
 	testNotes := fs.String("test-notes", "", "What to Test notes (requires build processing)")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
+	compliance := shared.BindComplianceFlag(fs)
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
-	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and --test-notes")
+	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait, --test-notes, and --compliance")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -46,13 +51,26 @@ By default, this command uploads the IPA/PKG to the presigned URLs and commits
 the file. Use --dry-run to only reserve the upload operations.
 
 Use --ipa for iOS, tvOS, and visionOS apps. Use --pkg for macOS apps.
-When using --pkg, the platform is automatically set to MAC_OS.
+When using --pkg, the platform is automatically set to MAC_OS. --file accepts
+either and picks the type from the file extension.
+
+Upload parts are sent in parallel and retried individually, and --checksum
+verifies the file against the checksums App Store Connect returns before the
+upload is committed. Completed parts are recorded under ~/.asc/uploads (or
+ASC_UPLOAD_STATE_DIR), so re-running the same command after an interruption
+reuses the reservation and only sends the remaining parts. Use --no-resume to
+start over.
+
+--compliance answers export compliance once the build finishes processing, so
+it implies --wait.
 
 Examples:
+  asc builds upload --app "123456789" --file "path/to/app.ipa"
   asc builds upload --app "123456789" --ipa "path/to/app.ipa"
   asc builds upload --ipa "app.ipa" --version "1.0.0" --build-number "123"
   asc builds upload --app "123456789" --ipa "app.ipa" --dry-run
   asc builds upload --app "123456789" --ipa "app.ipa" --test-notes "Test flow" --locale "en-US" --wait
+  asc builds upload --app "123456789" --ipa "app.ipa" --compliance uses-non-exempt-encryption=false
   asc builds upload --app "123456789" --pkg "path/to/app.pkg" --version "1.0.0" --build-number "123"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -64,11 +82,29 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			// --file is shorthand for --ipa or --pkg based on its extension
+			ipaValue := *ipaPath
+			pkgValue := *pkgPath
+			if fileValue := strings.TrimSpace(*filePathFlag); fileValue != "" {
+				if ipaValue != "" || pkgValue != "" {
+					fmt.Fprintf(os.Stderr, "Error: --file cannot be combined with --ipa or --pkg\n\n")
+					return flag.ErrHelp
+				}
+				switch strings.ToLower(filepath.Ext(fileValue)) {
+				case ".ipa":
+					ipaValue = fileValue
+				case ".pkg":
+					pkgValue = fileValue
+				default:
+					return shared.UsageError("--file must be an .ipa or .pkg file")
+				}
+			}
+
 			// Validate that exactly one of --ipa or --pkg is provided
-			hasIPA := *ipaPath != ""
-			hasPKG := *pkgPath != ""
+			hasIPA := ipaValue != ""
+			hasPKG := pkgValue != ""
 			if !hasIPA && !hasPKG {
-				fmt.Fprintf(os.Stderr, "Error: --ipa or --pkg is required\n\n")
+				fmt.Fprintf(os.Stderr, "Error: --file, --ipa, or --pkg is required\n\n")
 				return flag.ErrHelp
 			}
 			if hasIPA && hasPKG {
@@ -80,10 +116,10 @@ Examples:
 			var filePath string
 			var fileUTI asc.UTI
 			if hasIPA {
-				filePath = *ipaPath
+				filePath = ipaValue
 				fileUTI = asc.UTIIPA
 			} else {
-				filePath = *pkgPath
+				filePath = pkgValue
 				fileUTI = asc.UTIPKG
 			}
 
@@ -126,7 +162,7 @@ Examples:
 				return fmt.Errorf("builds upload: --platform must be IOS, MAC_OS, TV_OS, or VISION_OS")
 			}
 			if *dryRun {
-				if *concurrency != 1 {
+				if *concurrency != 0 {
 					return fmt.Errorf("builds upload: --concurrency is not supported with --dry-run")
 				}
 				if *verifyChecksum {
@@ -135,7 +171,10 @@ Examples:
 				if *wait {
 					return fmt.Errorf("builds upload: --wait is not supported with --dry-run")
 				}
-			} else if *concurrency < 1 {
+				if compliance.IsSet() {
+					return fmt.Errorf("builds upload: --compliance is not supported with --dry-run")
+				}
+			} else if *concurrency < 0 {
 				return fmt.Errorf("builds upload: --concurrency must be at least 1")
 			}
 
@@ -157,7 +196,8 @@ Examples:
 					return fmt.Errorf("builds upload: %w", err)
 				}
 			}
-			if (*wait || testNotesValue != "") && *pollInterval <= 0 {
+			waitForProcessing := *wait || testNotesValue != "" || compliance.IsSet()
+			if waitForProcessing && *pollInterval <= 0 {
 				return fmt.Errorf("builds upload: --poll-interval must be greater than 0")
 			}
 
@@ -215,60 +255,99 @@ Examples:
 			}
 
 			timeoutValue := asc.ResolveTimeout()
-			if *wait || testNotesValue != "" {
+			if waitForProcessing {
 				timeoutValue = asc.ResolveTimeoutWithDefault(buildWaitDefaultTimeout)
 			}
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			// Step 1: Create build upload record
-			uploadReq := asc.BuildUploadCreateRequest{
-				Data: asc.BuildUploadCreateData{
-					Type: asc.ResourceTypeBuildUploads,
-					Attributes: asc.BuildUploadAttributes{
-						CFBundleShortVersionString: versionValue,
-						CFBundleVersion:            buildNumberValue,
-						Platform:                   platformValue,
-					},
-					Relationships: &asc.BuildUploadRelationships{
-						App: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
-						},
-					},
-				},
+			// Resume an interrupted upload of the same file when possible
+			uploadKey := strings.Join([]string{resolvedAppID, versionValue, buildNumberValue, string(platformValue)}, "|")
+			manifestPath := ""
+			var manifest *asc.UploadManifest
+			var fileResp *asc.BuildUploadFileResponse
+			if !*dryRun {
+				if path, err := asc.UploadManifestPath(filePath); err == nil {
+					manifestPath = path
+					if !*noResume {
+						manifest, fileResp = resumeBuildUploadFile(requestCtx, client, manifestPath, filePath, uploadKey)
+					}
+				}
 			}
 
-			uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create upload record: %w", err)
-			}
-
-			// Step 2: Create build upload file reservation
-			fileReq := asc.BuildUploadFileCreateRequest{
-				Data: asc.BuildUploadFileCreateData{
-					Type: asc.ResourceTypeBuildUploadFiles,
-					Attributes: asc.BuildUploadFileAttributes{
-						FileName:  fileInfo.Name(),
-						FileSize:  fileInfo.Size(),
-						UTI:       fileUTI,
-						AssetType: asc.AssetTypeAsset,
+			var uploadID string
+			if fileResp != nil {
+				uploadID = manifest.ParentID
+				operations := fileResp.Data.Attributes.UploadOperations
+				completed := 0
+				for _, op := range operations {
+					if manifest.HasCompleted(op) {
+						completed++
+					}
+				}
+				fmt.Fprintf(shared.WarningWriter(), "Resuming upload of %s (%d of %d parts already uploaded)...\n", fileInfo.Name(), completed, len(operations))
+			} else {
+				// Step 1: Create build upload record
+				uploadReq := asc.BuildUploadCreateRequest{
+					Data: asc.BuildUploadCreateData{
+						Type: asc.ResourceTypeBuildUploads,
+						Attributes: asc.BuildUploadAttributes{
+							CFBundleShortVersionString: versionValue,
+							CFBundleVersion:            buildNumberValue,
+							Platform:                   platformValue,
+						},
+						Relationships: &asc.BuildUploadRelationships{
+							App: &asc.Relationship{
+								Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
+							},
+						},
 					},
-					Relationships: &asc.BuildUploadFileRelationships{
-						BuildUpload: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+				}
+
+				uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to create upload record: %w", err)
+				}
+
+				// Step 2: Create build upload file reservation
+				fileReq := asc.BuildUploadFileCreateRequest{
+					Data: asc.BuildUploadFileCreateData{
+						Type: asc.ResourceTypeBuildUploadFiles,
+						Attributes: asc.BuildUploadFileAttributes{
+							FileName:  fileInfo.Name(),
+							FileSize:  fileInfo.Size(),
+							UTI:       fileUTI,
+							AssetType: asc.AssetTypeAsset,
+						},
+						Relationships: &asc.BuildUploadFileRelationships{
+							BuildUpload: &asc.Relationship{
+								Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+							},
 						},
 					},
-				},
-			}
+				}
 
-			fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
+				fileResp, err = client.CreateBuildUploadFile(requestCtx, fileReq)
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
+				}
+				uploadID = uploadResp.Data.ID
+
+				if manifestPath != "" {
+					manifest, err = asc.NewUploadManifest(filePath, uploadKey, uploadID, fileResp.Data.ID)
+					if err == nil {
+						err = manifest.Save(manifestPath)
+					}
+					if err != nil {
+						fmt.Fprintf(shared.WarningWriter(), "Warning: upload cannot be resumed if interrupted: %v\n", err)
+						manifest = nil
+					}
+				}
 			}
 
 			// Return upload info including presigned URL operations
 			result := &asc.BuildUploadResult{
-				UploadID:   uploadResp.Data.ID,
+				UploadID:   uploadID,
 				FileID:     fileResp.Data.ID,
 				FileName:   fileResp.Data.Attributes.FileName,
 				FileSize:   fileResp.Data.Attributes.FileSize,
@@ -280,10 +359,14 @@ Examples:
 					return fmt.Errorf("builds upload: no upload operations returned")
 				}
 
-				uploadOpts := []asc.UploadOption{
-					asc.WithUploadConcurrency(*concurrency),
+				var uploadOpts []asc.UploadOption
+				if *concurrency > 0 {
+					uploadOpts = append(uploadOpts, asc.WithUploadConcurrency(*concurrency))
+				}
+				if manifest != nil {
+					uploadOpts = append(uploadOpts, asc.WithUploadManifest(manifestPath, manifest))
 				}
-				fmt.Fprintf(os.Stderr, "Uploading %s (%d bytes) to App Store Connect...\n", fileInfo.Name(), fileInfo.Size())
+				fmt.Fprintf(shared.WarningWriter(), "Uploading %s (%d bytes) to App Store Connect...\n", fileInfo.Name(), fileInfo.Size())
 				uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
 				err = asc.ExecuteUploadOperations(uploadCtx, filePath, fileResp.Data.Attributes.UploadOperations, uploadOpts...)
 				uploadCancel()
@@ -296,7 +379,7 @@ Examples:
 				if *verifyChecksum {
 					src := fileResp.Data.Attributes.SourceFileChecksums
 					if src == nil || (src.File == nil && src.Composite == nil) {
-						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
+						fmt.Fprintln(shared.WarningWriter(), "Warning: --checksum requested but API provided no checksums to verify; skipping")
 					} else {
 						checksums, err := asc.VerifySourceFileChecksums(filePath, src)
 						if err != nil {
@@ -332,14 +415,19 @@ Examples:
 				} else {
 					result.Uploaded = &uploaded
 				}
-				fmt.Fprintln(os.Stderr, "Upload committed in App Store Connect.")
+				fmt.Fprintln(shared.WarningWriter(), "Upload committed in App Store Connect.")
+				if manifestPath != "" {
+					if err := asc.RemoveUploadManifest(manifestPath); err != nil {
+						fmt.Fprintf(shared.WarningWriter(), "Warning: failed to remove upload manifest: %v\n", err)
+					}
+				}
 				result.ChecksumVerified = checksumVerified
 				result.SourceFileChecksums = verifiedChecksums
 				result.Operations = nil
 
-				if *wait || testNotesValue != "" {
-					fmt.Fprintf(os.Stderr, "Waiting for build %s (%s) to appear in App Store Connect...\n", buildNumberValue, versionValue)
-					buildResp, err := shared.WaitForBuildByNumberOrUploadFailure(requestCtx, client, resolvedAppID, uploadResp.Data.ID, versionValue, buildNumberValue, string(platformValue), *pollInterval)
+				if waitForProcessing {
+					fmt.Fprintf(shared.WarningWriter(), "Waiting for build %s (%s) to appear in App Store Connect...\n", buildNumberValue, versionValue)
+					buildResp, err := shared.WaitForBuildByNumberOrUploadFailure(requestCtx, client, resolvedAppID, uploadID, versionValue, buildNumberValue, string(platformValue), *pollInterval)
 					if err != nil {
 						return fmt.Errorf("builds upload: %w", err)
 					}
@@ -347,12 +435,17 @@ Examples:
 						return fmt.Errorf("builds upload: failed to resolve build for version %q build %q", versionValue, buildNumberValue)
 					}
 
-					fmt.Fprintf(os.Stderr, "Build %s discovered; waiting for processing...\n", buildResp.Data.ID)
+					fmt.Fprintf(shared.WarningWriter(), "Build %s discovered; waiting for processing...\n", buildResp.Data.ID)
 					buildResp, err = client.WaitForBuildProcessing(requestCtx, buildResp.Data.ID, *pollInterval)
 					if err != nil {
 						return fmt.Errorf("builds upload: %w", err)
 					}
 
+					result.UsesNonExemptEncryption, err = shared.ApplyBuildCompliance(requestCtx, client, buildResp, compliance)
+					if err != nil {
+						return fmt.Errorf("builds upload: %w", err)
+					}
+
 					if testNotesValue != "" {
 						if _, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildResp.Data.ID, localeValue, testNotesValue); err != nil {
 							return fmt.Errorf("builds upload: %w", err)
@@ -387,6 +480,7 @@ Examples:
   asc builds find --app "123456789" --build-number "42"
   asc builds wait --build "BUILD_ID"
   asc builds wait --app "123456789" --newest
+  asc builds watch --id "BUILD_ID"
   asc builds info --build "BUILD_ID"
   asc builds expire --build "BUILD_ID"
   asc builds expire-all --app "123456789" --older-than 90d --dry-run
@@ -412,6 +506,7 @@ Examples:
 			BuildsLatestCommand(),
 			BuildsFindCommand(),
 			BuildsWaitCommand(),
+			BuildsWatchCommand(),
 			BuildsInfoCommand(),
 			BuildsExpireCommand(),
 			BuildsExpireAllCommand(),
@@ -449,9 +544,13 @@ func BuildsListCommand() *ffcli.Command {
 	buildNumber := fs.String("build-number", "", "Filter by build number (CFBundleVersion)")
 	platform := fs.String("platform", "", "Filter by platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	processingState := fs.String("processing-state", "", "Filter by processing state: VALID, PROCESSING, FAILED, INVALID, or all")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(buildIncludeList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	wait := fs.Bool("wait", false, "Wait for listed builds in PROCESSING to finish before printing")
+	waitInterval := fs.Duration("interval", buildsWatchDefaultInterval, "Polling interval for build status checks (with --wait)")
+	waitTimeout := fs.Duration("timeout", buildsWatchDefaultTimeout, "Maximum time to wait for processing builds (with --wait)")
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -470,9 +569,11 @@ Examples:
   asc builds list --app "123456789" --platform IOS --version "1.2.3"
   asc builds list --app "123456789" --processing-state "PROCESSING"
   asc builds list --app "123456789" --processing-state "all"
+  asc builds list --app "123456789" --include "betaGroups,appStoreVersion" --output table
   asc builds list --app "123456789" --version "1.2.3" --build-number "123"
   asc builds list --app "123456789" --limit 10
-  asc builds list --app "123456789" --paginate`,
+  asc builds list --app "123456789" --paginate
+  asc builds list --app "123456789" --limit 5 --wait --interval 15s --timeout 30m`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -486,6 +587,23 @@ Examples:
 			if err := shared.ValidateSort(*sort, "uploadedDate", "-uploadedDate"); err != nil {
 				return fmt.Errorf("builds: %w", err)
 			}
+			if !*wait {
+				waitFlagUsed := false
+				fs.Visit(func(f *flag.Flag) {
+					if f.Name == "interval" || f.Name == "timeout" {
+						waitFlagUsed = true
+					}
+				})
+				if waitFlagUsed {
+					return shared.UsageError("--interval and --timeout require --wait")
+				}
+			}
+			if *wait && *waitInterval <= 0 {
+				return shared.UsageError("--interval must be greater than 0")
+			}
+			if *wait && *waitTimeout <= 0 {
+				return shared.UsageError("--timeout must be greater than 0")
+			}
 
 			platformValue := ""
 			if strings.TrimSpace(*platform) != "" {
@@ -502,6 +620,10 @@ Examples:
 			if err != nil {
 				return err
 			}
+			includeValues, err := normalizeBuildInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			if resolvedAppID == "" && nextValue == "" {
@@ -538,7 +660,7 @@ Examples:
 			opts := []asc.BuildsOption{
 				asc.WithBuildsLimit(*limit),
 				asc.WithBuildsNextURL(nextValue),
-				asc.WithBuildsInclude([]string{"preReleaseVersion"}),
+				asc.WithBuildsInclude(withPreReleaseVersionInclude(includeValues)),
 			}
 			if strings.TrimSpace(*sort) != "" {
 				opts = append(opts, asc.WithBuildsSort(*sort))
@@ -569,6 +691,13 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("builds: %w", err)
 				}
+				if *wait {
+					if buildsResp, ok := builds.(*asc.BuildsResponse); ok {
+						if err := waitForListedBuilds(ctx, client, buildsResp, *waitInterval, *waitTimeout); err != nil {
+							return err
+						}
+					}
+				}
 
 				format := *output.Output
 				return shared.PrintOutput(builds, format, *output.Pretty)
@@ -578,6 +707,11 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("builds: failed to fetch: %w", err)
 			}
+			if *wait {
+				if err := waitForListedBuilds(ctx, client, builds, *waitInterval, *waitTimeout); err != nil {
+					return err
+				}
+			}
 
 			format := *output.Output
 
@@ -586,6 +720,19 @@ Examples:
 	}
 }
 
+func waitForListedBuilds(ctx context.Context, client *asc.Client, builds *asc.BuildsResponse, interval, timeout time.Duration) error {
+	waitCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeout)
+	defer cancel()
+
+	if err := waitForBuildsList(waitCtx, client, builds, interval); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("builds: timed out waiting for processing builds after %s", timeout.Round(time.Second))
+		}
+		return fmt.Errorf("builds: %w", err)
+	}
+	return nil
+}
+
 func normalizeBuildProcessingStateFilter(raw string) ([]string, error) {
 	return shared.NormalizeBuildProcessingStateFilter(raw, shared.BuildProcessingStateFilterOptions{
 		FlagName:          "--processing-state",
@@ -710,6 +857,7 @@ func BuildsInfoCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds info", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(buildIncludeList(), ", "))
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -719,7 +867,8 @@ func BuildsInfoCommand() *ffcli.Command {
 		LongHelp: `Show details for a specific build.
 
 Examples:
-  asc builds info --build "BUILD_ID"`,
+  asc builds info --build "BUILD_ID"
+  asc builds info --build "BUILD_ID" --include "app,betaGroups" --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -727,6 +876,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --build is required")
 				return flag.ErrHelp
 			}
+			includeValues, err := normalizeBuildInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -736,6 +889,16 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			// With --include the pre-release version comes back in Included;
+			// otherwise it is fetched separately.
+			if len(includeValues) > 0 {
+				build, err := client.GetBuild(requestCtx, strings.TrimSpace(*buildID), asc.WithBuildInclude(withPreReleaseVersionInclude(includeValues)))
+				if err != nil {
+					return fmt.Errorf("builds info: failed to fetch: %w", err)
+				}
+				return shared.PrintOutput(build, *output.Output, *output.Pretty)
+			}
+
 			build, err := client.GetBuild(requestCtx, strings.TrimSpace(*buildID))
 			if err != nil {
 				return fmt.Errorf("builds info: failed to fetch: %w", err)
@@ -756,6 +919,7 @@ func BuildsExpireCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds expire", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
+	aliasID := fs.String("id", "", "Build ID (alias of --build)")
 	confirm := fs.Bool("confirm", false, "Confirm expiration")
 	output := shared.BindOutputFlags(fs)
 
@@ -765,14 +929,23 @@ func BuildsExpireCommand() *ffcli.Command {
 		ShortHelp:  "Expire a build for TestFlight.",
 		LongHelp: `Expire a build for TestFlight.
 
-This action is irreversible for the specified build.
+This action is irreversible for the specified build. To expire many builds
+at once, preview them with "asc builds expire-all --dry-run" first.
 
 Examples:
-  asc builds expire --build "BUILD_ID" --confirm`,
+  asc builds expire --build "BUILD_ID" --confirm
+  asc builds expire --id "BUILD_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if strings.TrimSpace(*buildID) == "" {
+			buildValue := strings.TrimSpace(*buildID)
+			aliasValue := strings.TrimSpace(*aliasID)
+			if buildValue == "" {
+				buildValue = aliasValue
+			} else if aliasValue != "" && aliasValue != buildValue {
+				return shared.UsageError("--build and --id must match")
+			}
+			if buildValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --build is required")
 				return flag.ErrHelp
 			}
@@ -789,7 +962,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			build, err := client.ExpireBuild(requestCtx, strings.TrimSpace(*buildID))
+			build, err := client.ExpireBuild(requestCtx, buildValue)
 			if err != nil {
 				return fmt.Errorf("builds expire: failed to expire: %w", err)
 			}
@@ -120,11 +120,11 @@ Examples:
 			// relationship. Use the user-supplied --version when available.
 			appVersion := strings.TrimSpace(*version)
 
-			fmt.Fprintf(os.Stderr, "Resolved build %s", resolvedBuildID)
+			fmt.Fprintf(shared.WarningWriter(), "Resolved build %s", resolvedBuildID)
 			if buildVersion != "" {
-				fmt.Fprintf(os.Stderr, " (build %s)", buildVersion)
+				fmt.Fprintf(shared.WarningWriter(), " (build %s)", buildVersion)
 			}
-			fmt.Fprintln(os.Stderr)
+			fmt.Fprintln(shared.WarningWriter())
 
 			bundlesResp, err := client.GetBuildBundlesForBuild(requestCtx, resolvedBuildID)
 			if err != nil {
@@ -145,7 +145,7 @@ Examples:
 
 			downloadable := filterBundlesWithDSYM(bundles)
 			if len(downloadable) == 0 {
-				fmt.Fprintln(os.Stderr, "No dSYM files available for this build")
+				fmt.Fprintln(shared.WarningWriter(), "No dSYM files available for this build")
 				result := DSYMDownloadResult{
 					BuildID:     resolvedBuildID,
 					Version:     appVersion,
@@ -171,14 +171,14 @@ Examples:
 				fileName := dsymFileName(bundle.BundleID, appVersion, buildVersion, resolvedBuildID, i)
 				filePath := filepath.Join(dirValue, fileName)
 
-				fmt.Fprintf(os.Stderr, "Downloading dSYM for %s...\n", displayBundleID(bundle.BundleID, i))
+				fmt.Fprintf(shared.WarningWriter(), "Downloading dSYM for %s...\n", displayBundleID(bundle.BundleID, i))
 
 				size, err := downloadDSYM(requestCtx, *bundle.DSYMURL, filePath)
 				if err != nil {
 					return fmt.Errorf("builds dsyms: failed to download %s: %w", fileName, err)
 				}
 
-				fmt.Fprintf(os.Stderr, "  Saved %s (%d bytes)\n", filePath, size)
+				fmt.Fprintf(shared.WarningWriter(), "  Saved %s (%d bytes)\n", filePath, size)
 
 				files = append(files, DSYMDownloadFile{
 					BundleID: bundle.BundleID,
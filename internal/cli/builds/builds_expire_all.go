@@ -65,7 +65,8 @@ Examples:
 			if *keepLatest < 0 {
 				return fmt.Errorf("builds expire-all: --keep-latest must be greater than or equal to 0")
 			}
-			if !*dryRun && !*confirm {
+			planOnly := *dryRun || asc.DryRunEnabled()
+			if !planOnly && !*confirm {
 				fmt.Fprintln(os.Stderr, "Error: --confirm is required to expire builds")
 				return flag.ErrHelp
 			}
@@ -116,7 +117,7 @@ Examples:
 				uploadedAt, err := parseBuildTimestamp(item.Attributes.UploadedDate)
 				if err != nil {
 					skippedInvalid++
-					fmt.Fprintf(os.Stderr, "Warning: build %s has invalid uploadedDate %q: %v\n", item.ID, item.Attributes.UploadedDate, err)
+					fmt.Fprintf(shared.WarningWriter(), "Warning: build %s has invalid uploadedDate %q: %v\n", item.ID, item.Attributes.UploadedDate, err)
 					continue
 				}
 				ageDays := max(int(now.Sub(uploadedAt).Hours()/24), 0)
@@ -155,7 +156,7 @@ Examples:
 
 			for _, candidate := range candidates {
 				item := buildExpireAllItem(candidate)
-				if *dryRun {
+				if planOnly {
 					items = append(items, item)
 					continue
 				}
@@ -198,7 +199,7 @@ Examples:
 			}
 
 			result := &asc.BuildExpireAllResult{
-				DryRun:              *dryRun,
+				DryRun:              planOnly,
 				AppID:               resolvedAppID,
 				OlderThan:           olderThanPtr,
 				KeepLatest:          keepLatestPtr,
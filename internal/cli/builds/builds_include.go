@@ -0,0 +1,36 @@
+package builds
+
+import (
+	"slices"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func normalizeBuildInclude(value string) ([]string, error) {
+	return shared.NormalizeSelection(value, buildIncludeList(), "--include")
+}
+
+func buildIncludeList() []string {
+	return []string{
+		"app",
+		"appEncryptionDeclaration",
+		"appStoreVersion",
+		"betaAppReviewSubmission",
+		"betaBuildLocalizations",
+		"betaGroups",
+		"buildBetaDetail",
+		"buildBundles",
+		"icons",
+		"individualTesters",
+		"preReleaseVersion",
+	}
+}
+
+// withPreReleaseVersionInclude adds preReleaseVersion, which build tables use
+// for the version and platform columns, to the requested includes.
+func withPreReleaseVersionInclude(include []string) []string {
+	if slices.Contains(include, "preReleaseVersion") {
+		return include
+	}
+	return append([]string{"preReleaseVersion"}, include...)
+}
@@ -0,0 +1,27 @@
+package builds
+
+import (
+	"context"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// resumeBuildUploadFile returns the manifest and reservation left by an
+// interrupted upload of filePath under key. It returns nils when there is
+// nothing to resume: no matching manifest, the reservation is gone or already
+// uploaded, or App Store Connect no longer returns its upload operations.
+func resumeBuildUploadFile(ctx context.Context, client *asc.Client, manifestPath, filePath, key string) (*asc.UploadManifest, *asc.BuildUploadFileResponse) {
+	manifest := asc.LoadUploadManifest(manifestPath)
+	if !manifest.Matches(filePath, key) || manifest.ParentID == "" {
+		return nil, nil
+	}
+	resp, err := client.GetBuildUploadFile(ctx, manifest.ReservationID)
+	if err != nil || resp == nil {
+		return nil, nil
+	}
+	attrs := resp.Data.Attributes
+	if (attrs.Uploaded != nil && *attrs.Uploaded) || len(attrs.UploadOperations) == 0 {
+		return nil, nil
+	}
+	return manifest, resp
+}
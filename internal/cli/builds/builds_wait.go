@@ -5,7 +5,6 @@ import (
 	"errors"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -34,6 +33,7 @@ func BuildsWaitCommand() *ffcli.Command {
 	timeout := fs.Duration("timeout", buildsWaitDefaultTimeout, "Maximum time to wait for build processing")
 	pollInterval := fs.Duration("poll-interval", buildsWaitDefaultPollInterval, "Polling interval for build status checks")
 	failOnInvalid := fs.Bool("fail-on-invalid", false, "Exit non-zero if build reaches INVALID")
+	notifyURL := shared.BindNotifyURLFlag(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -53,13 +53,17 @@ Build selector modes (mutually exclusive):
       --newest
       [--version VERSION] [--build-number NUMBER] [--since RFC3339] [--platform IOS]
 
+With --notify-url a message with the build details is posted to a Slack,
+Microsoft Teams, or generic JSON webhook when processing finishes.
+
 Examples:
   asc builds wait --build "BUILD_ID"
   asc builds wait --build "BUILD_ID" --timeout 20m --poll-interval 15s
   asc builds wait --app "1500196580" --newest
   asc builds wait --app "1500196580" --version "2.4.0" --build-number "2"
   asc builds wait --app "1500196580" --since "2026-03-02T18:00:00Z"
-  asc builds wait --app "123456789" --build-number "42" --platform MAC_OS --fail-on-invalid`,
+  asc builds wait --app "123456789" --build-number "42" --platform MAC_OS --fail-on-invalid
+  asc builds wait --build "BUILD_ID" --notify-url "$TEAMS_WEBHOOK_URL"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -79,6 +83,10 @@ Examples:
 			if *timeout <= 0 {
 				return shared.UsageError("--timeout must be greater than 0")
 			}
+			notifyTarget, err := shared.ResolveNotifyURL(*notifyURL)
+			if err != nil {
+				return err
+			}
 
 			if buildValue != "" {
 				if appScopedFlagsUsed {
@@ -95,7 +103,6 @@ Examples:
 
 			var normalizedPlatform string
 			if platformValue != "" {
-				var err error
 				normalizedPlatform, err = shared.NormalizeAppStoreVersionPlatform(platformValue)
 				if err != nil {
 					return shared.UsageError(err.Error())
@@ -148,7 +155,10 @@ Examples:
 			}
 
 			waitBuildID := buildResp.Data.ID
-			buildResp, err = waitForBuildProcessingState(requestCtx, client, buildResp.Data.ID, *pollInterval, *failOnInvalid)
+			buildResp, err = waitForBuildProcessingState(requestCtx, client, buildResp.Data.ID, *pollInterval, *failOnInvalid, printBuildWaitProgress)
+			if buildResp != nil {
+				shared.SendWaitNotification(ctx, notifyTarget, buildProcessingNotification(buildResp, buildProcessingState(buildResp)))
+			}
 			if err != nil {
 				if errors.Is(err, context.DeadlineExceeded) {
 					return fmt.Errorf("builds wait: timed out waiting for build %s after %s", waitBuildID, (*timeout).Round(time.Second))
@@ -220,7 +230,7 @@ func waitForBuildDiscovery(
 		}
 
 		fmt.Fprintf(
-			os.Stderr,
+			shared.WarningWriter(),
 			"Waiting for build discovery... (%s elapsed)\n",
 			time.Since(started).Round(time.Second),
 		)
@@ -339,14 +349,20 @@ func resolveBuildForWait(
 	return &asc.BuildResponse{Data: buildsResp.Data[0], Links: buildsResp.Links}, nil
 }
 
+// waitForBuildProcessingState polls until the build reaches a terminal
+// processing state. When that state is a failure the build is returned along
+// with the error. onState, if set, is called after every poll with the
+// previously observed state ("" on the first poll) and the current one.
 func waitForBuildProcessingState(
 	ctx context.Context,
-	client *asc.Client,
+	client buildGetter,
 	buildID string,
 	pollInterval time.Duration,
 	failOnInvalid bool,
+	onState func(buildID, previous, current string, elapsed time.Duration),
 ) (*asc.BuildResponse, error) {
 	started := time.Now()
+	previous := ""
 
 	for {
 		buildResp, err := client.GetBuild(ctx, buildID)
@@ -354,26 +370,20 @@ func waitForBuildProcessingState(
 			return nil, err
 		}
 
-		state := strings.ToUpper(strings.TrimSpace(buildResp.Data.Attributes.ProcessingState))
-		if state == "" {
-			state = "UNKNOWN"
+		state := buildProcessingState(buildResp)
+		if onState != nil {
+			onState(buildID, previous, state, time.Since(started))
 		}
-		fmt.Fprintf(
-			os.Stderr,
-			"Waiting for build %s... (%s, %s elapsed)\n",
-			buildID,
-			state,
-			time.Since(started).Round(time.Second),
-		)
+		previous = state
 
 		switch state {
 		case asc.BuildProcessingStateValid:
 			return buildResp, nil
 		case asc.BuildProcessingStateFailed:
-			return nil, fmt.Errorf("build processing failed with state %s", state)
+			return buildResp, fmt.Errorf("build processing failed with state %s", state)
 		case asc.BuildProcessingStateInvalid:
 			if failOnInvalid {
-				return nil, fmt.Errorf("build processing failed with state %s", state)
+				return buildResp, fmt.Errorf("build processing failed with state %s", state)
 			}
 			return buildResp, nil
 		}
@@ -385,3 +395,7 @@ func waitForBuildProcessingState(
 		}
 	}
 }
+
+func printBuildWaitProgress(buildID, _, current string, elapsed time.Duration) {
+	fmt.Fprintf(shared.WarningWriter(), "Waiting for build %s... (%s, %s elapsed)\n", buildID, current, elapsed.Round(time.Second))
+}
@@ -0,0 +1,201 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	buildsWatchDefaultTimeout  = 15 * time.Minute
+	buildsWatchDefaultInterval = 30 * time.Second
+)
+
+type buildGetter interface {
+	GetBuild(ctx context.Context, buildID string, opts ...asc.BuildOption) (*asc.BuildResponse, error)
+}
+
+// BuildsWatchCommand polls a build and reports processing state transitions.
+func BuildsWatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	buildID := fs.String("id", "", "Build ID to watch (required)")
+	interval := fs.Duration("interval", buildsWatchDefaultInterval, "Polling interval for build status checks")
+	timeout := fs.Duration("timeout", buildsWatchDefaultTimeout, "Maximum time to watch before giving up")
+	failOnInvalid := fs.Bool("fail-on-invalid", false, "Exit non-zero if build reaches INVALID")
+	notifyURL := shared.BindNotifyURLFlag(fs)
+	output := shared.BindGHAOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc builds watch --id BUILD_ID [flags]",
+		ShortHelp:  "Watch a build until it leaves PROCESSING.",
+		LongHelp: `Watch a build until it leaves PROCESSING.
+
+Each processingState transition is printed to stderr as it is observed.
+The final build is written to stdout once the state is no longer PROCESSING,
+including when it failed:
+  - VALID   -> exits 0
+  - FAILED  -> exits non-zero
+  - INVALID -> exits non-zero only with --fail-on-invalid
+
+With --output gha the final state is reported as a GitHub Actions annotation
+(::notice for VALID, ::error for failures) and the build table is appended to
+the job summary in GITHUB_STEP_SUMMARY.
+
+With --notify-url a message with the build details is posted to a Slack,
+Microsoft Teams, or generic JSON webhook once the build leaves PROCESSING.
+
+Examples:
+  asc builds watch --id "BUILD_ID"
+  asc builds watch --id "BUILD_ID" --interval 15s --timeout 30m
+  asc builds watch --id "BUILD_ID" --fail-on-invalid
+  asc builds watch --id "BUILD_ID" --output gha
+  asc builds watch --id "BUILD_ID" --notify-url "$SLACK_WEBHOOK_URL"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*buildID)
+			if idValue == "" {
+				return shared.UsageError("--id is required")
+			}
+			if *interval <= 0 {
+				return shared.UsageError("--interval must be greater than 0")
+			}
+			if *timeout <= 0 {
+				return shared.UsageError("--timeout must be greater than 0")
+			}
+			notifyTarget, err := shared.ResolveNotifyURL(*notifyURL)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("builds watch: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+			defer cancel()
+
+			buildResp, err := waitForBuildProcessingState(requestCtx, client, idValue, *interval, *failOnInvalid, printBuildStateTransition)
+			if buildResp == nil {
+				if errors.Is(err, context.DeadlineExceeded) {
+					return fmt.Errorf("builds watch: timed out watching build %s after %s", idValue, (*timeout).Round(time.Second))
+				}
+				return fmt.Errorf("builds watch: %w", err)
+			}
+
+			// A failed build is still printed before the non-zero exit.
+			failed := err != nil
+			state := buildProcessingState(buildResp)
+			shared.SendWaitNotification(ctx, notifyTarget, buildProcessingNotification(buildResp, state))
+			if shared.IsGHAOutput(*output.Output) {
+				// Annotate failures too, so the job summary explains the non-zero exit.
+				annotation := buildWatchAnnotation(buildResp, state, failed)
+				if err := shared.PrintOutputWithGHA(buildResp, *output.Output, *output.Pretty, "Build processing", annotation); err != nil {
+					return err
+				}
+			} else if err := shared.PrintOutput(buildResp, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if failed {
+				return fmt.Errorf("builds watch: build %s finished with state %s", idValue, state)
+			}
+			return nil
+		},
+	}
+}
+
+// waitForBuildsList refreshes builds in a list response that are still
+// PROCESSING until each one settles, updating the response in place.
+func waitForBuildsList(ctx context.Context, client buildGetter, builds *asc.BuildsResponse, interval time.Duration) error {
+	if builds == nil {
+		return nil
+	}
+	for i := range builds.Data {
+		if !strings.EqualFold(strings.TrimSpace(builds.Data[i].Attributes.ProcessingState), asc.BuildProcessingStateProcessing) {
+			continue
+		}
+		// Failed builds are kept in the list with their final state.
+		buildResp, err := waitForBuildProcessingState(ctx, client, builds.Data[i].ID, interval, false, printBuildStateTransition)
+		if buildResp == nil {
+			return err
+		}
+		builds.Data[i].Attributes = buildResp.Data.Attributes
+	}
+	return nil
+}
+
+func buildProcessingState(buildResp *asc.BuildResponse) string {
+	if buildResp == nil {
+		return "UNKNOWN"
+	}
+	state := strings.ToUpper(strings.TrimSpace(buildResp.Data.Attributes.ProcessingState))
+	if state == "" {
+		return "UNKNOWN"
+	}
+	return state
+}
+
+// buildWatchAnnotation describes a watched build's final state for
+// --output gha.
+func buildWatchAnnotation(buildResp *asc.BuildResponse, state string, failed bool) shared.GHAAnnotation {
+	label := buildResp.Data.ID
+	if version := strings.TrimSpace(buildResp.Data.Attributes.Version); version != "" {
+		label = fmt.Sprintf("%s (%s)", version, buildResp.Data.ID)
+	}
+	message := fmt.Sprintf("Build %s finished processing with state %s", label, state)
+	switch {
+	case failed:
+		return shared.GHAError("Build processing failed", message)
+	case state == asc.BuildProcessingStateValid:
+		return shared.GHANotice("Build processed", message)
+	default:
+		return shared.GHAWarning("Build not valid", message)
+	}
+}
+
+// buildProcessingNotification describes a build that left PROCESSING for
+// --notify-url.
+func buildProcessingNotification(buildResp *asc.BuildResponse, state string) shared.WaitNotification {
+	label := buildResp.Data.ID
+	if version := strings.TrimSpace(buildResp.Data.Attributes.Version); version != "" {
+		label = version
+	}
+	fields := []shared.NotificationField{
+		{Name: "Build ID", Value: buildResp.Data.ID},
+		{Name: "Build Number", Value: buildResp.Data.Attributes.Version},
+		{Name: "Processing State", Value: state},
+	}
+	if uploaded := strings.TrimSpace(buildResp.Data.Attributes.UploadedDate); uploaded != "" {
+		fields = append(fields, shared.NotificationField{Name: "Uploaded", Value: uploaded})
+	}
+	return shared.WaitNotification{
+		Event:   "build.processing.finished",
+		Title:   fmt.Sprintf("Build %s is %s", label, state),
+		Summary: fmt.Sprintf("Build %s (%s) finished processing with state %s.", label, buildResp.Data.ID, state),
+		Success: state == asc.BuildProcessingStateValid,
+		Fields:  fields,
+	}
+}
+
+// printBuildStateTransition reports processingState changes; repeated polls
+// of the same state print nothing.
+func printBuildStateTransition(buildID, previous, current string, _ time.Duration) {
+	switch previous {
+	case current:
+	case "":
+		fmt.Fprintf(shared.WarningWriter(), "Build %s: %s\n", buildID, current)
+	default:
+		fmt.Fprintf(shared.WarningWriter(), "Build %s: %s -> %s\n", buildID, previous, current)
+	}
+}
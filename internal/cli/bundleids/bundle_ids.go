@@ -31,7 +31,8 @@ Examples:
   asc bundle-ids create --identifier "com.example.app" --name "Example" --platform IOS
   asc bundle-ids update --id "BUNDLE_ID" --name "New Name"
   asc bundle-ids delete --id "BUNDLE_ID" --confirm
-  asc bundle-ids capabilities list --bundle "BUNDLE_ID"`,
+  asc bundle-ids capabilities list --bundle "BUNDLE_ID"
+  asc bundle-ids sync --file "./capabilities.yaml" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -43,6 +44,7 @@ Examples:
 			BundleIDsUpdateCommand(),
 			BundleIDsDeleteCommand(),
 			BundleIDsCapabilitiesCommand(),
+			BundleIDsSyncCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
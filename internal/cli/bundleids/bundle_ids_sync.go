@@ -0,0 +1,412 @@
+package bundleids
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// bundleIDCapabilitiesFile is the declared capability state of one bundle ID.
+type bundleIDCapabilitiesFile struct {
+	Identifier   string                          `yaml:"identifier"`
+	Capabilities []bundleIDCapabilityDeclaration `yaml:"capabilities"`
+}
+
+// bundleIDCapabilityDeclaration is one declared capability. Settings are only
+// compared when present, so omitting them leaves the remote settings alone.
+type bundleIDCapabilityDeclaration struct {
+	Type     string                  `yaml:"type"`
+	Settings []asc.CapabilitySetting `yaml:"settings"`
+}
+
+type bundleIDSyncChange struct {
+	Action         string
+	CapabilityType string
+	CapabilityID   string
+}
+
+type bundleIDSyncResult struct {
+	BundleID   string               `json:"bundleId"`
+	Identifier string               `json:"identifier,omitempty"`
+	File       string               `json:"file"`
+	DryRun     bool                 `json:"dryRun,omitempty"`
+	Applied    bool                 `json:"applied"`
+	Added      int                  `json:"added"`
+	Updated    int                  `json:"updated"`
+	Removed    int                  `json:"removed"`
+	Unchanged  int                  `json:"unchanged"`
+	Changes    []bundleIDSyncChange `json:"-"`
+	asc.BulkResult
+}
+
+// bundleIDSyncPlanItem pairs a planned change with the payload needed to apply it.
+type bundleIDSyncPlanItem struct {
+	change   bundleIDSyncChange
+	settings []asc.CapabilitySetting
+}
+
+// BundleIDsSyncCommand returns the bundle IDs sync subcommand.
+func BundleIDsSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to a capabilities YAML file")
+	bundleID := fs.String("bundle", "", "Bundle ID (overrides the file's identifier)")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without making changes")
+	confirm := fs.Bool("confirm", false, "Confirm adding, updating, and removing capabilities")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc bundle-ids sync --file \"./capabilities.yaml\" --confirm",
+		ShortHelp:  "Sync bundle ID capabilities to a declaration file.",
+		LongHelp: `Sync bundle ID capabilities to a declaration file.
+
+The file declares every capability the bundle ID should have:
+
+  identifier: com.example.app
+  capabilities:
+    - type: PUSH_NOTIFICATIONS
+    - type: ASSOCIATED_DOMAINS
+    - type: APP_GROUPS
+    - type: ICLOUD
+      settings:
+        - key: ICLOUD_VERSION
+          options:
+            - key: XCODE_6
+              enabled: true
+
+The current capabilities are compared with the file and a plan is printed to
+stderr before anything changes. Declared capabilities that are missing are
+added, declared settings that differ are updated, and capabilities that are
+not declared are removed. Settings are only compared for capabilities that
+declare them.
+
+Examples:
+  asc bundle-ids sync --file "./capabilities.yaml" --dry-run
+  asc bundle-ids sync --file "./capabilities.yaml" --confirm
+  asc bundle-ids sync --bundle "BUNDLE_ID" --file "./capabilities.yaml" --confirm --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				return shared.UsageError("--file is required")
+			}
+			planOnly := *dryRun || asc.DryRunEnabled()
+			if !planOnly && !*confirm {
+				return shared.UsageError("--confirm is required unless --dry-run is set")
+			}
+
+			declaration, err := readBundleIDCapabilitiesFile(fileValue)
+			if err != nil {
+				return fmt.Errorf("bundle-ids sync: %w", err)
+			}
+			bundleValue := strings.TrimSpace(*bundleID)
+			if bundleValue == "" && declaration.Identifier == "" {
+				return shared.UsageError("--bundle is required when the file has no identifier")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("bundle-ids sync: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := syncBundleIDCapabilities(requestCtx, client, bundleValue, declaration, planOnly, os.Stderr)
+			if err != nil {
+				return fmt.Errorf("bundle-ids sync: %w", err)
+			}
+			result.File = fileValue
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderBundleIDSyncResult(result, false) },
+				func() error { return renderBundleIDSyncResult(result, true) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("bundle-ids sync: %d capability change(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+// readBundleIDCapabilitiesFile loads a capabilities file, rejecting unknown
+// keys, missing types, and duplicate capabilities.
+func readBundleIDCapabilitiesFile(path string) (*bundleIDCapabilitiesFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseBundleIDCapabilitiesFile(data)
+}
+
+func parseBundleIDCapabilitiesFile(data []byte) (*bundleIDCapabilitiesFile, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var declaration bundleIDCapabilitiesFile
+	if err := decoder.Decode(&declaration); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("capabilities file is empty")
+		}
+		return nil, fmt.Errorf("invalid capabilities YAML: %w", err)
+	}
+
+	declaration.Identifier = strings.TrimSpace(declaration.Identifier)
+	seen := make(map[string]struct{}, len(declaration.Capabilities))
+	for i := range declaration.Capabilities {
+		capabilityType := strings.ToUpper(strings.TrimSpace(declaration.Capabilities[i].Type))
+		if capabilityType == "" {
+			return nil, fmt.Errorf("capabilities[%d].type is required", i)
+		}
+		if _, ok := seen[capabilityType]; ok {
+			return nil, fmt.Errorf("capability %s is declared more than once", capabilityType)
+		}
+		seen[capabilityType] = struct{}{}
+		declaration.Capabilities[i].Type = capabilityType
+
+		for j, setting := range declaration.Capabilities[i].Settings {
+			if strings.TrimSpace(setting.Key) == "" {
+				return nil, fmt.Errorf("capabilities[%d].settings[%d].key is required", i, j)
+			}
+		}
+	}
+	return &declaration, nil
+}
+
+// syncBundleIDCapabilities resolves the bundle ID, prints the plan to w, and
+// applies it unless planOnly is set.
+func syncBundleIDCapabilities(ctx context.Context, client *asc.Client, bundleID string, declaration *bundleIDCapabilitiesFile, planOnly bool, w io.Writer) (*bundleIDSyncResult, error) {
+	result := &bundleIDSyncResult{
+		BundleID:   bundleID,
+		Identifier: declaration.Identifier,
+		DryRun:     planOnly,
+	}
+	if result.BundleID == "" {
+		resp, err := client.GetBundleIDs(ctx, asc.WithBundleIDsFilterIdentifier(declaration.Identifier))
+		if err != nil {
+			return nil, fmt.Errorf("find bundle ID: %w", err)
+		}
+		if len(resp.Data) == 0 {
+			return nil, fmt.Errorf("bundle ID not found: %s", declaration.Identifier)
+		}
+		result.BundleID = resp.Data[0].ID
+	}
+
+	firstPage, err := client.GetBundleIDCapabilities(ctx, result.BundleID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch capabilities: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDCapabilities(ctx, result.BundleID, asc.WithBundleIDCapabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch capabilities: %w", err)
+	}
+	current, ok := paginated.(*asc.BundleIDCapabilitiesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected capabilities response type %T", paginated)
+	}
+
+	plan, unchanged := planBundleIDCapabilities(current.Data, declaration.Capabilities)
+	result.Unchanged = unchanged
+	result.Changes = make([]bundleIDSyncChange, 0, len(plan))
+	for _, item := range plan {
+		result.Changes = append(result.Changes, item.change)
+		switch item.change.Action {
+		case "add":
+			result.Added++
+		case "update":
+			result.Updated++
+		case "remove":
+			result.Removed++
+		}
+	}
+	printBundleIDSyncPlan(w, result)
+
+	result.Total = len(plan)
+	if planOnly || len(plan) == 0 {
+		for _, item := range plan {
+			result.Succeed(asc.BulkResultItem{
+				Item:   item.change.CapabilityType,
+				ID:     item.change.CapabilityID,
+				Detail: "would " + item.change.Action,
+			})
+		}
+		return result, nil
+	}
+	// Each change is independent, so a failed one does not stop the rest.
+	for i, item := range plan {
+		var err error
+		switch item.change.Action {
+		case "add":
+			var resp *asc.BundleIDCapabilityResponse
+			resp, err = client.CreateBundleIDCapability(ctx, result.BundleID, asc.BundleIDCapabilityCreateAttributes{
+				CapabilityType: item.change.CapabilityType,
+				Settings:       item.settings,
+			})
+			if err == nil {
+				result.Changes[i].CapabilityID = resp.Data.ID
+			}
+		case "update":
+			_, err = client.UpdateBundleIDCapability(ctx, item.change.CapabilityID, asc.BundleIDCapabilityUpdateAttributes{
+				CapabilityType: item.change.CapabilityType,
+				Settings:       item.settings,
+			})
+		case "remove":
+			err = client.DeleteBundleIDCapability(ctx, item.change.CapabilityID)
+		}
+		bulkItem := asc.BulkResultItem{
+			Item:   item.change.CapabilityType,
+			ID:     result.Changes[i].CapabilityID,
+			Detail: item.change.Action,
+		}
+		if err != nil {
+			result.Fail(bulkItem, err)
+			switch item.change.Action {
+			case "add":
+				result.Added--
+			case "update":
+				result.Updated--
+			case "remove":
+				result.Removed--
+			}
+			continue
+		}
+		result.Succeed(bulkItem)
+	}
+	result.Applied = result.Failed == 0
+	return result, nil
+}
+
+// planBundleIDCapabilities diffs the remote capabilities against the declared
+// ones. Adds and updates follow file order; removals follow capability type.
+func planBundleIDCapabilities(current []asc.Resource[asc.BundleIDCapabilityAttributes], declared []bundleIDCapabilityDeclaration) ([]bundleIDSyncPlanItem, int) {
+	remote := make(map[string]asc.Resource[asc.BundleIDCapabilityAttributes], len(current))
+	for _, capability := range current {
+		remote[strings.ToUpper(strings.TrimSpace(capability.Attributes.CapabilityType))] = capability
+	}
+
+	plan := make([]bundleIDSyncPlanItem, 0)
+	unchanged := 0
+	declaredTypes := make(map[string]struct{}, len(declared))
+	for _, capability := range declared {
+		declaredTypes[capability.Type] = struct{}{}
+		existing, ok := remote[capability.Type]
+		switch {
+		case !ok:
+			plan = append(plan, bundleIDSyncPlanItem{
+				change:   bundleIDSyncChange{Action: "add", CapabilityType: capability.Type},
+				settings: capability.Settings,
+			})
+		case capability.Settings != nil && capabilitySettingsKey(capability.Settings) != capabilitySettingsKey(existing.Attributes.Settings):
+			plan = append(plan, bundleIDSyncPlanItem{
+				change:   bundleIDSyncChange{Action: "update", CapabilityType: capability.Type, CapabilityID: existing.ID},
+				settings: capability.Settings,
+			})
+		default:
+			unchanged++
+		}
+	}
+
+	removals := make([]bundleIDSyncPlanItem, 0)
+	for capabilityType, existing := range remote {
+		if _, ok := declaredTypes[capabilityType]; ok {
+			continue
+		}
+		removals = append(removals, bundleIDSyncPlanItem{
+			change: bundleIDSyncChange{Action: "remove", CapabilityType: capabilityType, CapabilityID: existing.ID},
+		})
+	}
+	sort.Slice(removals, func(i, j int) bool {
+		return removals[i].change.CapabilityType < removals[j].change.CapabilityType
+	})
+	return append(plan, removals...), unchanged
+}
+
+// capabilitySettingsKey renders settings as a comparable string of setting and
+// option keys with their enabled state, ignoring display names and order.
+func capabilitySettingsKey(settings []asc.CapabilitySetting) string {
+	parts := make([]string, 0, len(settings))
+	for _, setting := range settings {
+		options := make([]string, 0, len(setting.Options))
+		for _, option := range setting.Options {
+			enabled := "-"
+			if option.Enabled != nil {
+				enabled = fmt.Sprintf("%t", *option.Enabled)
+			}
+			options = append(options, strings.ToUpper(strings.TrimSpace(option.Key))+"="+enabled)
+		}
+		sort.Strings(options)
+		parts = append(parts, strings.ToUpper(strings.TrimSpace(setting.Key))+"["+strings.Join(options, ",")+"]")
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+func printBundleIDSyncPlan(w io.Writer, result *bundleIDSyncResult) {
+	name := result.BundleID
+	if result.Identifier != "" {
+		name = fmt.Sprintf("%s (%s)", result.Identifier, result.BundleID)
+	}
+	if len(result.Changes) == 0 {
+		fmt.Fprintf(w, "Bundle ID %s already matches the file; nothing to change.\n", name)
+		return
+	}
+	fmt.Fprintf(w, "Bundle ID %s plan:\n", name)
+	symbols := map[string]string{"add": "+", "update": "~", "remove": "-"}
+	for _, change := range result.Changes {
+		fmt.Fprintf(w, "  %s %s\n", symbols[change.Action], change.CapabilityType)
+	}
+	fmt.Fprintf(w, "Plan: %d to add, %d to update, %d to remove.\n", result.Added, result.Updated, result.Removed)
+}
+
+func renderBundleIDSyncResult(result *bundleIDSyncResult, markdown bool) error {
+	if result == nil {
+		return fmt.Errorf("result is nil")
+	}
+
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Bundle ID", "Identifier", "Dry Run", "Applied", "Added", "Updated", "Removed", "Unchanged", "Failed"},
+		[][]string{{
+			result.BundleID,
+			result.Identifier,
+			fmt.Sprintf("%t", result.DryRun),
+			fmt.Sprintf("%t", result.Applied),
+			fmt.Sprintf("%d", result.Added),
+			fmt.Sprintf("%d", result.Updated),
+			fmt.Sprintf("%d", result.Removed),
+			fmt.Sprintf("%d", result.Unchanged),
+			fmt.Sprintf("%d", result.Failed),
+		}},
+	)
+
+	if len(result.Items) > 0 {
+		render(asc.BulkResultRows(&result.BulkResult))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package bundleids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseBundleIDCapabilitiesFile(t *testing.T) {
+	declaration, err := parseBundleIDCapabilitiesFile([]byte("identifier: com.example.app\ncapabilities:\n  - type: push_notifications\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if declaration.Identifier != "com.example.app" || declaration.Capabilities[0].Type != "PUSH_NOTIFICATIONS" {
+		t.Fatalf("unexpected declaration: %+v", declaration)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "empty", data: "", wantErr: "capabilities file is empty"},
+		{name: "unknown key", data: "capabilities:\n  - capability: ICLOUD\n", wantErr: "field capability not found"},
+		{name: "missing type", data: "capabilities:\n  - settings: []\n", wantErr: "capabilities[0].type is required"},
+		{name: "duplicate", data: "capabilities:\n  - type: ICLOUD\n  - type: icloud\n", wantErr: "ICLOUD is declared more than once"},
+		{name: "setting without key", data: "capabilities:\n  - type: ICLOUD\n    settings:\n      - name: iCloud\n", wantErr: "capabilities[0].settings[0].key is required"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseBundleIDCapabilitiesFile([]byte(test.data))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPlanBundleIDCapabilitiesIgnoresSettingNamesAndOrder(t *testing.T) {
+	enabled := true
+	current := []asc.Resource[asc.BundleIDCapabilityAttributes]{
+		{ID: "cap-1", Attributes: asc.BundleIDCapabilityAttributes{
+			CapabilityType: "ICLOUD",
+			Settings: []asc.CapabilitySetting{{
+				Key:  "ICLOUD_VERSION",
+				Name: "iCloud",
+				Options: []asc.CapabilityOption{
+					{Key: "XCODE_6", Name: "Xcode 6", Enabled: &enabled},
+					{Key: "XCODE_5"},
+				},
+			}},
+		}},
+	}
+	declared := []bundleIDCapabilityDeclaration{{
+		Type: "ICLOUD",
+		Settings: []asc.CapabilitySetting{{
+			Key:     "ICLOUD_VERSION",
+			Options: []asc.CapabilityOption{{Key: "XCODE_5"}, {Key: "XCODE_6", Enabled: &enabled}},
+		}},
+	}}
+
+	plan, unchanged := planBundleIDCapabilities(current, declared)
+	if len(plan) != 0 || unchanged != 1 {
+		t.Fatalf("expected no changes, got %+v (unchanged %d)", plan, unchanged)
+	}
+}
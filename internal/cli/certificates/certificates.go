@@ -34,6 +34,7 @@ Examples:
   asc certificates update --id "CERT_ID" --activated true
   asc certificates update --id "CERT_ID" --activated false
   asc certificates revoke --id "CERT_ID" --confirm
+  asc certificates download --id "CERT_ID" --output "./cert.cer"
   asc certificates links pass-type-id --id "CERT_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.VisibleUsageFunc,
@@ -44,6 +45,7 @@ Examples:
 			CertificatesCreateCommand(),
 			CertificatesUpdateCommand(),
 			CertificatesRevokeCommand(),
+			CertificatesDownloadCommand(),
 			CertificatesRelationshipsCommand(),
 			DeprecatedCertificatesRelationshipsAliasCommand(),
 		},
@@ -341,6 +343,85 @@ Examples:
 	}
 }
 
+// CertificatesDownloadCommand returns the certificates download subcommand.
+func CertificatesDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	id := fs.String("id", "", "Certificate ID")
+	outputPath := fs.String("output", "", "Output certificate file path")
+	format := fs.String("format", "der", "Certificate encoding: der (default) or pem")
+	output := shared.BindMetadataOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc certificates download --id \"CERT_ID\" --output ./cert.cer [flags]",
+		ShortHelp:  "Download a signing certificate.",
+		LongHelp: `Download a signing certificate.
+
+Writes the DER-encoded certificate by default (the .cer format Keychain Access
+imports). Use --format pem for a PEM-encoded file.
+
+Examples:
+  asc certificates download --id "CERT_ID" --output "./cert.cer"
+  asc certificates download --id "CERT_ID" --output "./cert.pem" --format pem`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*outputPath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output is required")
+				return flag.ErrHelp
+			}
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != "der" && formatValue != "pem" {
+				return shared.UsageError("--format must be der or pem")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetCertificate(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("certificates download: failed to fetch: %w", err)
+			}
+
+			content := strings.Join(strings.Fields(resp.Data.Attributes.CertificateContent), "")
+			if content == "" {
+				return fmt.Errorf("certificates download: certificate content is empty")
+			}
+			decoded, err := base64.StdEncoding.DecodeString(content)
+			if err != nil {
+				return fmt.Errorf("certificates download: decode certificate: %w", err)
+			}
+			if formatValue == "pem" {
+				decoded = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: decoded})
+			}
+
+			if err := shared.WriteProfileFile(pathValue, decoded); err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			result := &asc.CertificateDownloadResult{
+				ID:         idValue,
+				Name:       resp.Data.Attributes.Name,
+				OutputPath: pathValue,
+			}
+
+			return shared.PrintOutput(result, *output.OutputFormat, *output.Pretty)
+		},
+	}
+}
+
 func readCSRContent(path string) (string, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
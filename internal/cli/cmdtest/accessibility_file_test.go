@@ -0,0 +1,276 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+const accessibilityPublishedIPhoneResponse = `{"data":[{"type":"accessibilityDeclarations","id":"iphone-pub","attributes":{"deviceFamily":"IPHONE","state":"PUBLISHED","supportsVoiceover":false,"supportsCaptions":true}}]}`
+
+func mockAccessibilityDeclarations(t *testing.T, handle func(req *http.Request, body []byte) (*http.Response, error)) {
+	t.Helper()
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/apps/123456789/accessibilityDeclarations" {
+			return promoteJSONResponse(http.StatusOK, accessibilityPublishedIPhoneResponse), nil
+		}
+		var body []byte
+		if req.Body != nil {
+			body, _ = io.ReadAll(req.Body)
+		}
+		return handle(req, body)
+	})
+}
+
+func writeAccessibilityFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "accessibility.json")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	return path
+}
+
+func TestAccessibilityPullWritesOutFile(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	mockAccessibilityDeclarations(t, func(req *http.Request, body []byte) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	outPath := filepath.Join(t.TempDir(), "nested", "accessibility.json")
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"accessibility", "pull", "--app", "123456789", "--out", outPath}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	var payload struct {
+		Out string `json:"out"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Out != outPath {
+		t.Fatalf("expected out %q, got %q", outPath, payload.Out)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read out file: %v", err)
+	}
+	var file struct {
+		SchemaVersion int `json:"schemaVersion"`
+		Declarations  []struct {
+			DeviceFamily     string `json:"deviceFamily"`
+			SupportsCaptions *bool  `json:"supportsCaptions"`
+		} `json:"declarations"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("parse out file: %v", err)
+	}
+	if file.SchemaVersion != 1 || len(file.Declarations) != 1 || file.Declarations[0].DeviceFamily != "IPHONE" {
+		t.Fatalf("unexpected declaration file: %s", data)
+	}
+	if file.Declarations[0].SupportsCaptions == nil || !*file.Declarations[0].SupportsCaptions {
+		t.Fatalf("expected captions in pulled file: %s", data)
+	}
+}
+
+func TestAccessibilityPullOutWriteFailure(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	mockAccessibilityDeclarations(t, func(req *http.Request, body []byte) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	blocker := writeAccessibilityFile(t, "not a directory")
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"accessibility", "pull", "--app", "123456789", "--out", filepath.Join(blocker, "accessibility.json")}, "1.2.3")
+	})
+	if code != cmd.ExitError {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitError, stderr)
+	}
+	if !strings.Contains(stderr, "failed to create output directory") {
+		t.Fatalf("expected write failure in stderr, got %q", stderr)
+	}
+}
+
+func TestAccessibilityPlanFromFile(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	mockAccessibilityDeclarations(t, func(req *http.Request, body []byte) (*http.Response, error) {
+		t.Fatalf("plan must not mutate: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	path := writeAccessibilityFile(t, `{"schemaVersion":1,"declarations":[{"deviceFamily":"IPHONE","supportsVoiceover":true},{"deviceFamily":"IPAD","supportsCaptions":true}]}`)
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"accessibility", "plan", "--app", "123456789", "--file", path}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	var plan struct {
+		Changes []struct {
+			DeviceFamily  string   `json:"deviceFamily"`
+			Action        string   `json:"action"`
+			DeclarationID string   `json:"declarationId"`
+			Fields        []string `json:"fields"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &plan); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(plan.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", plan.Changes)
+	}
+	iphone := plan.Changes[0]
+	if iphone.DeviceFamily != "IPHONE" || iphone.Action != "create" || iphone.DeclarationID != "iphone-pub" {
+		t.Fatalf("expected new draft superseding iphone-pub, got %+v", iphone)
+	}
+	if len(iphone.Fields) != 1 || iphone.Fields[0] != "supportsVoiceover" {
+		t.Fatalf("expected only supportsVoiceover to change, got %v", iphone.Fields)
+	}
+	if ipad := plan.Changes[1]; ipad.DeviceFamily != "IPAD" || ipad.Action != "create" || ipad.DeclarationID != "" {
+		t.Fatalf("expected new iPad declaration, got %+v", ipad)
+	}
+}
+
+func TestAccessibilityApplyPublishKeepsOmittedCapabilities(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	var created map[string]any
+	published := false
+	mockAccessibilityDeclarations(t, func(req *http.Request, body []byte) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/accessibilityDeclarations":
+			var payload struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(body, &payload); err != nil {
+				t.Fatalf("parse create body: %v", err)
+			}
+			created = payload.Data.Attributes
+			return promoteJSONResponse(http.StatusCreated, `{"data":{"type":"accessibilityDeclarations","id":"iphone-draft","attributes":{"deviceFamily":"IPHONE","state":"DRAFT"}}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/accessibilityDeclarations/iphone-draft":
+			var payload struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(body, &payload); err != nil {
+				t.Fatalf("parse publish body: %v", err)
+			}
+			published = payload.Data.Attributes["publish"] == true
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"accessibilityDeclarations","id":"iphone-draft","attributes":{"deviceFamily":"IPHONE","state":"PUBLISHED"}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	path := writeAccessibilityFile(t, `{"schemaVersion":1,"declarations":[{"deviceFamily":"IPHONE","supportsVoiceover":true}]}`)
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"accessibility", "apply", "--app", "123456789", "--file", path, "--publish", "--confirm"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	if created["supportsVoiceover"] != true {
+		t.Fatalf("expected voiceover from file, got %v", created)
+	}
+	if created["supportsCaptions"] != true {
+		t.Fatalf("expected captions kept from published declaration, got %v", created)
+	}
+	if !published {
+		t.Fatal("expected the new draft to be published")
+	}
+
+	var payload struct {
+		Applied   bool     `json:"applied"`
+		Published []string `json:"published"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if !payload.Applied || len(payload.Published) != 1 || payload.Published[0] != "iphone-draft" {
+		t.Fatalf("unexpected apply output: %+v", payload)
+	}
+}
+
+func TestAccessibilityFileCommandsInvalidValues(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	validFile := writeAccessibilityFile(t, `{"schemaVersion":1,"declarations":[{"deviceFamily":"IPHONE"}]}`)
+	invalidFile := writeAccessibilityFile(t, `{"schemaVersion":1,"declarations":[{"deviceFamily":"TOASTER"}]}`)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "pull missing app",
+			args:    []string{"accessibility", "pull", "--out", "accessibility.json"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "plan missing file",
+			args:    []string{"accessibility", "plan", "--app", "123456789"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "plan invalid file",
+			args:    []string{"accessibility", "plan", "--app", "123456789", "--file", invalidFile},
+			wantErr: "deviceFamily must be one of",
+		},
+		{
+			name:    "apply invalid file",
+			args:    []string{"accessibility", "apply", "--app", "123456789", "--file", invalidFile},
+			wantErr: "deviceFamily must be one of",
+		},
+		{
+			name:    "apply publish without confirm",
+			args:    []string{"accessibility", "apply", "--app", "123456789", "--file", validFile, "--publish"},
+			wantErr: "--confirm is required when --publish is set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var code int
+			_, stderr := captureOutput(t, func() {
+				code = cmd.Run(test.args, "1.2.3")
+			})
+			if code != cmd.ExitUsage {
+				t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
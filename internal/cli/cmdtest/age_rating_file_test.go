@@ -0,0 +1,156 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func ageRatingFileTransport(t *testing.T, patched *map[string]any) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/info-1/ageRatingDeclaration":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"ageRatingDeclarations","id":"age-1","attributes":{"gambling":false,"contests":"NONE","violenceRealistic":"NONE"}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/ageRatingDeclarations/age-1":
+			var payload struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			body, _ := io.ReadAll(req.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				t.Fatalf("unmarshal patch body: %v", err)
+			}
+			*patched = payload.Data.Attributes
+			return jsonResponse(http.StatusOK, `{"data":{"type":"ageRatingDeclarations","id":"age-1","attributes":{"gambling":false,"contests":"NONE","violenceRealistic":"INFREQUENT_OR_MILD"}}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
+
+func TestAgeRatingViewFileThenSetFileShowsDiffAndApplies(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var patched map[string]any
+	http.DefaultTransport = ageRatingFileTransport(t, &patched)
+
+	path := filepath.Join(t.TempDir(), "declaration.yaml")
+	run := func(args []string) (string, string) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		return captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+	}
+
+	run([]string{"age-rating", "view", "--app-info-id", "info-1", "--file", path, "--output", "json"})
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read declaration file: %v", err)
+	}
+	if string(data) != "gambling: false\ncontests: NONE\nviolenceRealistic: NONE\n" {
+		t.Fatalf("unexpected declaration file: %q", data)
+	}
+
+	_, stderr := run([]string{"age-rating", "set", "--app-info-id", "info-1", "--file", path, "--output", "json"})
+	if !strings.Contains(stderr, "nothing to update") || patched != nil {
+		t.Fatalf("expected unchanged file to skip the update, got stderr %q and patch %v", stderr, patched)
+	}
+
+	edited := strings.Replace(string(data), "violenceRealistic: NONE", "violenceRealistic: INFREQUENT_OR_MILD", 1)
+	if err := os.WriteFile(path, []byte(edited), 0o600); err != nil {
+		t.Fatalf("write declaration file: %v", err)
+	}
+
+	stdout, stderr := run([]string{"age-rating", "set", "--app-info-id", "info-1", "--file", path, "--output", "json"})
+	if !strings.Contains(stderr, "violenceRealistic: NONE -> INFREQUENT_OR_MILD") {
+		t.Fatalf("expected diff on stderr, got %q", stderr)
+	}
+	if strings.Contains(stderr, "gambling:") {
+		t.Fatalf("expected unchanged fields to be left out of the diff, got %q", stderr)
+	}
+	if patched["violenceRealistic"] != "INFREQUENT_OR_MILD" {
+		t.Fatalf("unexpected patch attributes: %v", patched)
+	}
+
+	var resp struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &resp); err != nil || resp.Data.ID != "age-1" {
+		t.Fatalf("expected updated declaration JSON, got %q (%v)", stdout, err)
+	}
+	_, stderr = run([]string{"--quiet", "age-rating", "set", "--app-info-id", "info-1", "--file", path, "--output", "json"})
+	if strings.Contains(stderr, "violenceRealistic") {
+		t.Fatalf("expected --quiet to suppress the diff, got %q", stderr)
+	}
+}
+
+func TestAgeRatingSetFileValidation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "declaration.yaml")
+	if err := os.WriteFile(path, []byte("gambling: false\n"), 0o600); err != nil {
+		t.Fatalf("write declaration file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "file with id",
+			args:    []string{"age-rating", "set", "--id", "age-1", "--file", path},
+			wantErr: "--file cannot be used with --id",
+		},
+		{
+			name:    "file with field flag",
+			args:    []string{"age-rating", "set", "--version-id", "version-1", "--file", path, "--gambling", "true"},
+			wantErr: "--file cannot be combined with --gambling",
+		},
+		{
+			name:    "overwrite without file",
+			args:    []string{"age-rating", "view", "--version-id", "version-1", "--overwrite"},
+			wantErr: "--overwrite requires --file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
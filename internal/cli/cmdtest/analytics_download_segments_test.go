@@ -0,0 +1,195 @@
+package cmdtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	analyticsTestRequestID  = "11111111-1111-1111-1111-111111111111"
+	analyticsTestInstanceID = "22222222-2222-2222-2222-222222222222"
+)
+
+func gzipBytes(t *testing.T, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestAnalyticsDownloadAllSegmentsWritesNumberedParts(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	parts := map[string][]byte{
+		"/segment-1.csv.gz": gzipBytes(t, "date,units\n2026-10-01,3\n"),
+		"/segment-2.csv.gz": gzipBytes(t, "date,units\n2026-10-02,5\n"),
+	}
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Path == "/v1/analyticsReportRequests/"+analyticsTestRequestID+"/reports":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReports","id":"report-1","attributes":{"name":"App Downloads"}}],"links":{}}`)
+		case req.URL.Path == "/v1/analyticsReports/report-1/instances":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReportInstances","id":"`+analyticsTestInstanceID+`","attributes":{"granularity":"DAILY"}}],"links":{}}`)
+		case req.URL.Path == "/v1/analyticsReportInstances/"+analyticsTestInstanceID+"/segments":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"analyticsReportSegments","id":"seg-1","attributes":{"url":"https://reports.apple.com/segment-1.csv.gz"}},
+				{"type":"analyticsReportSegments","id":"seg-2","attributes":{"url":"https://reports.apple.com/segment-2.csv.gz"}}
+			],"links":{}}`)
+		case req.URL.Host == "reports.apple.com":
+			data, ok := parts[req.URL.Path]
+			if !ok {
+				t.Fatalf("unexpected segment download: %s", req.URL.String())
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+				Body:       io.NopCloser(bytes.NewReader(data)),
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "report.csv.gz")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"analytics", "download",
+			"--request-id", analyticsTestRequestID,
+			"--instance-id", analyticsTestInstanceID,
+			"--all-segments",
+			"--decompress",
+			"--output", outputPath,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		RequestID  string `json:"requestId"`
+		InstanceID string `json:"instanceId"`
+		Files      []struct {
+			SegmentID        string `json:"segmentId"`
+			FilePath         string `json:"filePath"`
+			DecompressedPath string `json:"decompressedPath"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.RequestID != analyticsTestRequestID || result.InstanceID != analyticsTestInstanceID {
+		t.Fatalf("unexpected result IDs: %+v", result)
+	}
+	if len(result.Files) != 2 {
+		t.Fatalf("expected 2 files, got %+v", result.Files)
+	}
+
+	dir := filepath.Dir(outputPath)
+	for i, want := range []struct {
+		segmentID, compressed, decompressed, content string
+	}{
+		{"seg-1", "report_part01.csv.gz", "report_part01.csv", "2026-10-01,3"},
+		{"seg-2", "report_part02.csv.gz", "report_part02.csv", "2026-10-02,5"},
+	} {
+		file := result.Files[i]
+		if file.SegmentID != want.segmentID {
+			t.Fatalf("file %d: expected segment %q, got %q", i, want.segmentID, file.SegmentID)
+		}
+		if file.FilePath != filepath.Join(dir, want.compressed) || file.DecompressedPath != filepath.Join(dir, want.decompressed) {
+			t.Fatalf("file %d: unexpected paths %+v", i, file)
+		}
+		data, err := os.ReadFile(file.DecompressedPath)
+		if err != nil {
+			t.Fatalf("read decompressed part: %v", err)
+		}
+		if !strings.Contains(string(data), want.content) {
+			t.Fatalf("file %d: expected %q in %q", i, want.content, data)
+		}
+	}
+}
+
+func TestAnalyticsRequestWaitPollsUntilInstanceExists(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	instancePolls := 0
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/analyticsReportRequests":
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"analyticsReportRequests","id":"`+analyticsTestRequestID+`","attributes":{"accessType":"ONE_TIME_SNAPSHOT"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReportRequests/"+analyticsTestRequestID:
+			return jsonResponse(http.StatusOK, `{"data":{"type":"analyticsReportRequests","id":"`+analyticsTestRequestID+`","attributes":{"accessType":"ONE_TIME_SNAPSHOT"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReportRequests/"+analyticsTestRequestID+"/reports":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReports","id":"report-1"}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReports/report-1/instances":
+			instancePolls++
+			if instancePolls < 2 {
+				return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReportInstances","id":"`+analyticsTestInstanceID+`"}],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"analytics", "request",
+			"--app", "123456789",
+			"--access-type", "ONE_TIME_SNAPSHOT",
+			"--wait",
+			"--poll-interval", "1ms",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		RequestID    string `json:"requestId"`
+		ReportsReady bool   `json:"reportsReady"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.RequestID != analyticsTestRequestID || !result.ReportsReady {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if instancePolls != 2 {
+		t.Fatalf("expected 2 instance polls, got %d", instancePolls)
+	}
+}
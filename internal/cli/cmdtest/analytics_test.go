@@ -50,6 +50,16 @@ func TestAnalyticsValidationErrors(t *testing.T) {
 			args:    []string{"analytics", "requests", "delete", "--request-id", "11111111-1111-1111-1111-111111111111"},
 			wantErr: "--confirm is required",
 		},
+		{
+			name:    "download segment id with all segments",
+			args:    []string{"analytics", "download", "--request-id", "11111111-1111-1111-1111-111111111111", "--instance-id", "22222222-2222-2222-2222-222222222222", "--segment-id", "seg-1", "--all-segments"},
+			wantErr: "--segment-id and --all-segments are mutually exclusive",
+		},
+		{
+			name:    "request poll interval without wait",
+			args:    []string{"analytics", "request", "--app", "123456789", "--access-type", "ONE_TIME_SNAPSHOT", "--poll-interval", "5s"},
+			wantErr: "--poll-interval and --timeout require --wait",
+		},
 	}
 
 	for _, test := range tests {
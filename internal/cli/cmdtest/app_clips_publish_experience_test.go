@@ -0,0 +1,285 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+type appClipsPublishStep struct {
+	Step   string `json:"step"`
+	Locale string `json:"locale"`
+	Action string `json:"action"`
+	ID     string `json:"id"`
+}
+
+type appClipsPublishResult struct {
+	AppClipID    string                `json:"appClipId"`
+	ExperienceID string                `json:"experienceId"`
+	DryRun       bool                  `json:"dryRun"`
+	Steps        []appClipsPublishStep `json:"steps"`
+}
+
+func TestAppClipsPublishExperienceMissingApp(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"app-clips", "publish-experience", "--locales", "en-US", "--subtitle", "Order ahead"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--app or --app-clip-id is required") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
+
+func TestAppClipsPublishExperienceUsageErrors(t *testing.T) {
+	dir := t.TempDir()
+	subtitles := filepath.Join(dir, "subs.yaml")
+	if err := os.WriteFile(subtitles, []byte("en-US: Order ahead\n"), 0o600); err != nil {
+		t.Fatalf("write subtitles: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "invalid action",
+			args:    []string{"--app", "APP_ID", "--action", "RUN"},
+			wantErr: "--action must be one of",
+		},
+		{
+			name:    "clip id and bundle id",
+			args:    []string{"--app-clip-id", "CLIP_ID", "--bundle-id", "com.example.clip"},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name:    "replace without header image",
+			args:    []string{"--app", "APP_ID", "--replace-header-image"},
+			wantErr: "--replace-header-image requires --header-image",
+		},
+		{
+			name:    "locale without subtitle",
+			args:    []string{"--app", "APP_ID", "--locales", "en-US,de-DE", "--subtitle-file", subtitles},
+			wantErr: `no subtitle for locale "de-DE"`,
+		},
+		{
+			name:    "header image without locales",
+			args:    []string{"--app", "APP_ID", "--header-image", filepath.Join(dir, "header.png")},
+			wantErr: "--header-image requires --locales",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			args := append([]string{"app-clips", "publish-experience"}, test.args...)
+			var code int
+			_, stderr := captureOutput(t, func() {
+				code = cmd.Run(args, "1.2.3")
+			})
+			if code != cmd.ExitUsage {
+				t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestAppClipsPublishExperienceDryRunPlansCreation(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	subtitles := filepath.Join(dir, "subs.yaml")
+	if err := os.WriteFile(subtitles, []byte("en-US: Order ahead\nde-DE: Vorbestellen\n"), 0o600); err != nil {
+		t.Fatalf("write subtitles: %v", err)
+	}
+	headerImage := filepath.Join(dir, "header.png")
+	if err := os.WriteFile(headerImage, []byte("png"), 0o600); err != nil {
+		t.Fatalf("write header image: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected %s %s during dry run", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID/appClips":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"appClips","id":"clip-1","attributes":{"bundleId":"com.example.app.clip"}}],"links":{}}`)
+		case "/v1/appClips/clip-1/appClipDefaultExperiences":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		if code := cmd.Run([]string{
+			"app-clips", "publish-experience",
+			"--app", "APP_ID",
+			"--action", "open",
+			"--url", "https://example.com/clip",
+			"--subtitle-file", subtitles,
+			"--header-image", headerImage,
+			"--dry-run",
+		}, "1.2.3"); code != cmd.ExitSuccess {
+			t.Fatalf("exit code = %d, want %d", code, cmd.ExitSuccess)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result appClipsPublishResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if !result.DryRun || result.AppClipID != "clip-1" || result.ExperienceID != "" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	want := []appClipsPublishStep{
+		{Step: "default-experience", Action: "create"},
+		{Step: "localization", Locale: "de-DE", Action: "create"},
+		{Step: "localization", Locale: "en-US", Action: "create"},
+		{Step: "header-image", Locale: "de-DE", Action: "upload"},
+		{Step: "header-image", Locale: "en-US", Action: "upload"},
+		{Step: "review-detail", Action: "create"},
+	}
+	if len(result.Steps) != len(want) {
+		t.Fatalf("steps = %+v, want %+v", result.Steps, want)
+	}
+	for i := range want {
+		if result.Steps[i] != want[i] {
+			t.Fatalf("step %d = %+v, want %+v", i, result.Steps[i], want[i])
+		}
+	}
+}
+
+func TestAppClipsPublishExperienceUpdatesOnlyWhatDiffers(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	headerImage := filepath.Join(dir, "header.png")
+	content := []byte("png")
+	if err := os.WriteFile(headerImage, content, 0o600); err != nil {
+		t.Fatalf("write header image: %v", err)
+	}
+	sum := md5.Sum(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	var mutations []string
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appClips/clip-1/appClipDefaultExperiences":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"appClipDefaultExperiences","id":"exp-1","attributes":{"action":"OPEN"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appClipDefaultExperiences/exp-1/appClipDefaultExperienceLocalizations":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[
+				{"type":"appClipDefaultExperienceLocalizations","id":"loc-en","attributes":{"locale":"en-US","subtitle":"Order ahead"}},
+				{"type":"appClipDefaultExperienceLocalizations","id":"loc-de","attributes":{"locale":"de-DE","subtitle":"Alt"}}
+			],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appClipDefaultExperienceLocalizations/loc-en/appClipHeaderImage":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"appClipHeaderImages","id":"img-en","attributes":{"fileName":"header.png","sourceFileChecksum":"`+checksum+`"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appClipDefaultExperienceLocalizations/loc-de/appClipHeaderImage":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"appClipHeaderImages","id":"img-de","attributes":{"fileName":"old.png","sourceFileChecksum":"different"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appClipDefaultExperiences/exp-1/appClipAppStoreReviewDetail":
+			return gameCenterJSONResponse(http.StatusNotFound, gameCenterNotFoundBody)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appClipDefaultExperienceLocalizations/loc-de":
+			mutations = append(mutations, "PATCH loc-de")
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"appClipDefaultExperienceLocalizations","id":"loc-de","attributes":{"locale":"de-DE","subtitle":"Vorbestellen"}}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appClipAppStoreReviewDetails":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), "https://example.com/clip") {
+				t.Fatalf("unexpected review detail body: %s", body)
+			}
+			mutations = append(mutations, "POST review detail")
+			return gameCenterJSONResponse(http.StatusCreated, `{"data":{"type":"appClipAppStoreReviewDetails","id":"review-1","attributes":{"invocationUrls":["https://example.com/clip"]}}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		if code := cmd.Run([]string{
+			"app-clips", "publish-experience",
+			"--app-clip-id", "clip-1",
+			"--action", "OPEN",
+			"--url", "https://example.com/clip",
+			"--locales", "en-US,de-DE",
+			"--subtitle", "Vorbestellen",
+			"--subtitle-file", writeAppClipsSubtitles(t, dir, "en-US: Order ahead\n"),
+			"--header-image", headerImage,
+		}, "1.2.3"); code != cmd.ExitSuccess {
+			t.Fatalf("exit code = %d, want %d", code, cmd.ExitSuccess)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result appClipsPublishResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	want := []appClipsPublishStep{
+		{Step: "default-experience", Action: "unchanged", ID: "exp-1"},
+		{Step: "localization", Locale: "en-US", Action: "unchanged", ID: "loc-en"},
+		{Step: "localization", Locale: "de-DE", Action: "update", ID: "loc-de"},
+		{Step: "header-image", Locale: "en-US", Action: "unchanged", ID: "img-en"},
+		{Step: "header-image", Locale: "de-DE", Action: "skipped", ID: "img-de"},
+		{Step: "review-detail", Action: "create", ID: "review-1"},
+	}
+	if len(result.Steps) != len(want) {
+		t.Fatalf("steps = %+v, want %+v", result.Steps, want)
+	}
+	for i := range want {
+		if result.Steps[i] != want[i] {
+			t.Fatalf("step %d = %+v, want %+v", i, result.Steps[i], want[i])
+		}
+	}
+	if strings.Join(mutations, "; ") != "PATCH loc-de; POST review detail" {
+		t.Fatalf("unexpected mutations: %v", mutations)
+	}
+}
+
+func writeAppClipsSubtitles(t *testing.T, dir, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, "subs.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write subtitles: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,171 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func appEventsArtworkTransport(t *testing.T) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s %s", req.Method, req.URL.String())
+		}
+		body := ""
+		switch req.URL.Host + req.URL.Path {
+		case "api.appstoreconnect.apple.com/v1/apps/app-1/appEvents":
+			body = `{"data":[{"type":"appEvents","id":"event-1","attributes":{"referenceName":"Summer Challenge","eventState":"PUBLISHED"}}],"links":{}}`
+		case "api.appstoreconnect.apple.com/v1/appEvents/event-1/localizations":
+			body = `{"data":[{"type":"appEventLocalizations","id":"loc-1","attributes":{"locale":"en-US"}}],"links":{}}`
+		case "api.appstoreconnect.apple.com/v1/appEventLocalizations/loc-1/appEventScreenshots":
+			body = `{"data":[{"type":"appEventScreenshots","id":"shot-1","attributes":{"fileName":"card.png","appEventAssetType":"EVENT_CARD","assetDeliveryState":{"state":"COMPLETE"},"imageAsset":{"templateUrl":"https://example.com/card/{w}x{h}.{f}","width":1920,"height":1080}}}],"links":{}}`
+		case "api.appstoreconnect.apple.com/v1/appEventLocalizations/loc-1/appEventVideoClips":
+			body = `{"data":[{"type":"appEventVideoClips","id":"clip-1","attributes":{"fileName":"details.mov","appEventAssetType":"EVENT_DETAILS_PAGE","videoUrl":"https://example.com/details.m3u8","previewFrameImage":{"image":{"templateUrl":"https://example.com/poster/{w}x{h}.{f}","width":1080,"height":1920}}}}],"links":{}}`
+		case "example.com/card/1920x1080.png":
+			body = "CARD"
+		case "example.com/poster/1080x1920.jpg":
+			body = "POSTER"
+		default:
+			t.Fatalf("unexpected request: %s", req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestAppEventsArtworkList(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = appEventsArtworkTransport(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"app-events", "artwork", "list", "--app", "app-1", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var got struct {
+		Items []struct {
+			EventName string `json:"eventName"`
+			Locale    string `json:"locale"`
+			Kind      string `json:"kind"`
+			AssetType string `json:"assetType"`
+			ID        string `json:"id"`
+			Width     int    `json:"width"`
+			State     string `json:"state"`
+			VideoURL  string `json:"videoUrl"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("decode stdout JSON: %v (stdout=%q)", err, stdout)
+	}
+	if len(got.Items) != 2 {
+		t.Fatalf("expected 2 items, got %+v", got.Items)
+	}
+	card, clip := got.Items[0], got.Items[1]
+	if card.ID != "shot-1" || card.AssetType != "EVENT_CARD" || card.Kind != "screenshot" || card.Width != 1920 || card.State != "COMPLETE" || card.Locale != "en-US" {
+		t.Fatalf("unexpected card item %+v", card)
+	}
+	if clip.ID != "clip-1" || clip.AssetType != "EVENT_DETAILS_PAGE" || clip.Kind != "videoClip" || clip.VideoURL != "https://example.com/details.m3u8" {
+		t.Fatalf("unexpected clip item %+v", clip)
+	}
+}
+
+func TestAppEventsArtworkDownloadWritesFolders(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = appEventsArtworkTransport(t)
+
+	dir := t.TempDir()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"app-events", "artwork", "download", "--app", "app-1", "--output-dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var got struct {
+		Total      int `json:"total"`
+		Downloaded int `json:"downloaded"`
+		Failed     int `json:"failed"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("decode stdout JSON: %v (stdout=%q)", err, stdout)
+	}
+	if got.Total != 2 || got.Downloaded != 2 || got.Failed != 0 {
+		t.Fatalf("unexpected result %+v", got)
+	}
+
+	for path, want := range map[string]string{
+		filepath.Join(dir, "Summer Challenge_event-1", "en-US", "event_card", "01_shot-1_card.png"):           "CARD",
+		filepath.Join(dir, "Summer Challenge_event-1", "en-US", "event_details_page", "01_clip-1_poster.jpg"): "POSTER",
+	} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile(%s) error: %v", path, err)
+		}
+		if string(data) != want {
+			t.Fatalf("unexpected contents for %s: %q", path, data)
+		}
+	}
+}
+
+func TestAppEventsArtworkValidation(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing scope", args: []string{"app-events", "artwork", "list"}},
+		{name: "app and event", args: []string{"app-events", "artwork", "list", "--app", "app-1", "--event-id", "event-1"}},
+		{name: "missing output dir", args: []string{"app-events", "artwork", "download", "--app", "app-1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected usage error, got %v", err)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppLookupFlagsResolveBundleIDBeforeRunning(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_ID_CACHE", "off")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.URL.Path)
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps":
+			if got := req.URL.Query().Get("filter[bundleId]"); got != "com.example.app" {
+				t.Fatalf("expected bundle ID filter, got %q", got)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"123","attributes":{"name":"Example","bundleId":"com.example.app"}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/123/appStoreVersions":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "list", "--bundle-id", "com.example.app", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.Join(requests, ",") != "/v1/apps,/v1/apps/123/appStoreVersions" {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+}
+
+func TestAppLookupFlagsRejectMultipleSelectors(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "list", "--app", "123", "--app-name", "My App"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--app, --bundle-id, and --app-name are mutually exclusive") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
@@ -0,0 +1,106 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestAuditLogRecordsSentMutationsOnly(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	logPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	t.Setenv("ASC_AUDIT_LOG", "")
+	t.Setenv("ASC_AUDIT_LOG_PATH", logPath)
+	t.Cleanup(func() {
+		asc.SetDryRunOverride(nil)
+		asc.SetActiveCommand("")
+	})
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"bundleId":"com.example.new"}}}`)
+	})
+
+	for _, args := range [][]string{
+		{"apps", "update", "--id", "app-1", "--bundle-id", "com.example.new", "--output", "json"},
+		{"apps", "update", "--id", "app-1", "--bundle-id", "com.example.other", "--dry-run"},
+	} {
+		var code int
+		_, stderr := captureOutput(t, func() {
+			code = cmd.Run(args, "1.2.3")
+		})
+		if code != cmd.ExitSuccess {
+			t.Fatalf("%v: exit code = %d, want %d; stderr=%q", args, code, cmd.ExitSuccess, stderr)
+		}
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"audit", "show", "--key-id", "TEST_KEY", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Enabled bool   `json:"enabled"`
+		LogPath string `json:"logPath"`
+		Total   int    `json:"total"`
+		Entries []struct {
+			Command      string `json:"command"`
+			Method       string `json:"method"`
+			Path         string `json:"path"`
+			ResourceType string `json:"resourceType"`
+			ResourceID   string `json:"resourceId"`
+			KeyID        string `json:"keyId"`
+			Error        string `json:"error"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if !result.Enabled || result.LogPath != logPath || result.Total != 1 || len(result.Entries) != 1 {
+		t.Fatalf("expected one recorded mutation, got %+v", result)
+	}
+	entry := result.Entries[0]
+	if entry.Command != "asc apps update" || entry.Method != http.MethodPatch || entry.Path != "/v1/apps/app-1" {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+	if entry.ResourceType != "apps" || entry.ResourceID != "app-1" || entry.KeyID != "TEST_KEY" || entry.Error != "" {
+		t.Fatalf("unexpected entry resource or actor: %+v", entry)
+	}
+}
+
+func TestAuditShowRejectsInvalidFlags(t *testing.T) {
+	t.Setenv("ASC_AUDIT_LOG_PATH", filepath.Join(t.TempDir(), "audit.jsonl"))
+
+	for _, args := range [][]string{
+		{"audit", "show", "--since", "yesterday"},
+		{"audit", "show", "--limit", "-1"},
+	} {
+		var code int
+		_, stderr := captureOutput(t, func() {
+			code = cmd.Run(args, "1.2.3")
+		})
+		if code != cmd.ExitUsage {
+			t.Fatalf("%v: exit code = %d, want %d; stderr=%q", args, code, cmd.ExitUsage, stderr)
+		}
+	}
+}
@@ -1742,6 +1742,7 @@ func TestBuildsLatestDoesNotTreatIDOnlyTieAsOrderingAnomaly(t *testing.T) {
 
 func TestBuildsLatestReturnsFirstPageBestWhenProbePageFails(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
 
 	const secondBuildsURL = "https://api.appstoreconnect.apple.com/v1/builds?page=2"
@@ -1950,6 +1951,7 @@ func TestBuildsLatestReturnsErrorWhenProbePageIsCanceled(t *testing.T) {
 
 func TestBuildsLatestReturnsBestFetchedCandidateWhenLaterProbeFails(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
 
 	const secondBuildsURL = "https://api.appstoreconnect.apple.com/v1/builds?page=2"
@@ -0,0 +1,164 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestBuildsUploadFileDetectsPKG(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	pkgPath := filepath.Join(t.TempDir(), "app.pkg")
+	if err := os.WriteFile(pkgPath, []byte("test"), 0o600); err != nil {
+		t.Fatalf("write pkg fixture: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/buildUploads":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"platform":"MAC_OS"`) {
+				t.Fatalf("expected MAC_OS platform, got %s", body)
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"buildUploads","id":"upload-1","attributes":{"cfBundleShortVersionString":"1.0.0","cfBundleVersion":"42","platform":"MAC_OS"}}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/buildUploadFiles":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"uti":"com.apple.pkg"`) {
+				t.Fatalf("expected pkg UTI, got %s", body)
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.pkg","fileSize":4,"uti":"com.apple.pkg","assetType":"ASSET","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/part-1","length":4,"offset":0}]}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"builds", "upload", "--app", "APP_123", "--file", pkgPath, "--version", "1.0.0", "--build-number", "42", "--dry-run"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	var result struct {
+		UploadID string `json:"uploadId"`
+		FileName string `json:"fileName"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if result.UploadID != "upload-1" || result.FileName != "app.pkg" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestBuildsUploadFileRejectsUnknownExtension(t *testing.T) {
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"builds", "upload", "--app", "APP_123", "--file", "app.zip"}, "1.2.3")
+	})
+	if code != cmd.ExitUsage {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+	}
+	if !strings.Contains(stderr, "--file must be an .ipa or .pkg file") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
+
+func TestBuildsUploadResumesInterruptedUpload(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_UPLOAD_STATE_DIR", t.TempDir())
+
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("abcdefgh"), 0o600); err != nil {
+		t.Fatalf("write ipa fixture: %v", err)
+	}
+
+	manifestPath, err := asc.UploadManifestPath(ipaPath)
+	if err != nil {
+		t.Fatalf("UploadManifestPath() error: %v", err)
+	}
+	manifest, err := asc.NewUploadManifest(ipaPath, "APP_123|1.0.0|42|IOS", "upload-1", "file-1")
+	if err != nil {
+		t.Fatalf("NewUploadManifest() error: %v", err)
+	}
+	manifest.Completed = []asc.UploadManifestPart{{Offset: 0, Length: 4}}
+	if err := manifest.Save(manifestPath); err != nil {
+		t.Fatalf("save manifest: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var uploadedParts []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/buildUploadFiles/file-1":
+			// The operations come back in a different order than when the
+			// manifest was written; parts are matched by byte range.
+			return jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"fileName":"app.ipa","fileSize":8,"uti":"com.apple.itunes.ipa","assetType":"ASSET","uploaded":false,"uploadOperations":[
+				{"method":"PUT","url":"https://upload.example.com/part-2","length":4,"offset":4},
+				{"method":"PUT","url":"https://upload.example.com/part-1","length":4,"offset":0}
+			]}}}`)
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			uploadedParts = append(uploadedParts, req.URL.Path)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("")),
+				Header:     http.Header{},
+			}, nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/buildUploadFiles/file-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"buildUploadFiles","id":"file-1","attributes":{"uploaded":true}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"builds", "upload", "--app", "APP_123", "--ipa", ipaPath, "--version", "1.0.0", "--build-number", "42"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+	if !strings.Contains(stderr, "Resuming upload of app.ipa (1 of 2 parts already uploaded)") {
+		t.Fatalf("expected resume notice, got %q", stderr)
+	}
+	if strings.Join(uploadedParts, ",") != "/part-2" {
+		t.Fatalf("expected only the remaining part to upload, got %v", uploadedParts)
+	}
+
+	var result struct {
+		UploadID string `json:"uploadId"`
+		FileID   string `json:"fileId"`
+		Uploaded *bool  `json:"uploaded"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if result.UploadID != "upload-1" || result.FileID != "file-1" || result.Uploaded == nil || !*result.Uploaded {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if _, err := os.Stat(manifestPath); !os.IsNotExist(err) {
+		t.Fatalf("expected manifest to be removed after commit, got %v", err)
+	}
+}
@@ -79,6 +79,44 @@ func TestBuildsWaitByBuildIDPollsUntilValid(t *testing.T) {
 	}
 }
 
+func TestBuildsWaitQuietSuppressesProgress(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"VALID","version":"42"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--quiet", "builds", "wait", "--build", "build-1", "--poll-interval", "1ms", "--timeout", "200ms"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"build-1"`) {
+		t.Fatalf("expected build output, got %q", stdout)
+	}
+	if stderr != "" {
+		t.Fatalf("expected no progress output with --quiet, got %q", stderr)
+	}
+}
+
 func TestBuildsWaitByAppAndBuildNumberResolvesThenWaits(t *testing.T) {
 	setupAuth(t)
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
@@ -0,0 +1,240 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildsWatchPrintsTransitionsUntilNotProcessing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requestCount := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requestCount++
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/builds/build-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+
+		state := "PROCESSING"
+		if requestCount >= 3 {
+			state = "VALID"
+		}
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"` + state + `","version":"42"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "watch", "--id", "build-1", "--interval", "1ms", "--timeout", "1s", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				ProcessingState string `json:"processingState"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Data.ID != "build-1" || payload.Data.Attributes.ProcessingState != "VALID" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if requestCount != 3 {
+		t.Fatalf("expected 3 polls, got %d", requestCount)
+	}
+	if strings.Count(stderr, "Build build-1: PROCESSING\n") != 1 {
+		t.Fatalf("expected single initial state line, got %q", stderr)
+	}
+	if !strings.Contains(stderr, "Build build-1: PROCESSING -> VALID") {
+		t.Fatalf("expected transition line, got %q", stderr)
+	}
+}
+
+func TestBuildsWatchFailedStateReturnsError(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"FAILED"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--quiet", "builds", "watch", "--id", "build-1", "--interval", "1ms"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "finished with state FAILED") {
+		t.Fatalf("expected failed state error, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"id":"build-1"`) {
+		t.Fatalf("expected the failed build on stdout, got %q", stdout)
+	}
+	if strings.Contains(stderr, "Build build-1:") {
+		t.Fatalf("expected --quiet to suppress state transitions, got %q", stderr)
+	}
+}
+
+func TestBuildsWatchValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing id",
+			args:    []string{"builds", "watch"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "invalid interval",
+			args:    []string{"builds", "watch", "--id", "build-1", "--interval", "0s"},
+			wantErr: "--interval must be greater than 0",
+		},
+		{
+			name:    "list interval without wait",
+			args:    []string{"builds", "list", "--app", "123", "--interval", "5s"},
+			wantErr: "--interval and --timeout require --wait",
+		},
+		{
+			name:    "list invalid timeout",
+			args:    []string{"builds", "list", "--app", "123", "--wait", "--timeout", "0s"},
+			wantErr: "--timeout must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestBuildsListWaitRefreshesProcessingBuilds(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	buildPolls := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/builds":
+			body = `{"data":[
+				{"type":"builds","id":"build-2","attributes":{"processingState":"PROCESSING","version":"2"}},
+				{"type":"builds","id":"build-1","attributes":{"processingState":"VALID","version":"1"}}
+			],"links":{}}`
+		case "/v1/builds/build-2":
+			buildPolls++
+			state := "PROCESSING"
+			if buildPolls >= 2 {
+				state = "VALID"
+			}
+			body = `{"data":{"type":"builds","id":"build-2","attributes":{"processingState":"` + state + `","version":"2"}}}`
+		default:
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "list", "--app", "123", "--wait", "--interval", "1ms", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data []struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				ProcessingState string `json:"processingState"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Data) != 2 {
+		t.Fatalf("expected 2 builds, got %d", len(payload.Data))
+	}
+	for _, build := range payload.Data {
+		if build.Attributes.ProcessingState != "VALID" {
+			t.Fatalf("expected all builds VALID after wait, got %+v", payload.Data)
+		}
+	}
+	if !strings.Contains(stderr, "Build build-2: PROCESSING -> VALID") {
+		t.Fatalf("expected transition line, got %q", stderr)
+	}
+}
@@ -0,0 +1,259 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+const bundleIDSyncTestFile = `identifier: com.example.app
+capabilities:
+  - type: push_notifications
+  - type: ICLOUD
+    settings:
+      - key: ICLOUD_VERSION
+        options:
+          - key: XCODE_6
+            enabled: true
+  - type: APP_GROUPS
+`
+
+func bundleIDSyncTransport(t *testing.T, requests *[]string) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		*requests = append(*requests, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds":
+			if got := req.URL.Query().Get("filter[identifier]"); got != "com.example.app" {
+				t.Fatalf("expected identifier filter, got %q", got)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app"}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bundle-1/bundleIdCapabilities":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD","settings":[{"key":"ICLOUD_VERSION","name":"iCloud","options":[{"key":"XCODE_5","enabled":true}]}]}},
+				{"type":"bundleIdCapabilities","id":"cap-groups","attributes":{"capabilityType":"APP_GROUPS"}},
+				{"type":"bundleIdCapabilities","id":"cap-gc","attributes":{"capabilityType":"GAME_CENTER"}}
+			]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/bundleIdCapabilities":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"capabilityType":"PUSH_NOTIFICATIONS"`) {
+				t.Fatalf("unexpected create body: %s", body)
+			}
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"bundleIdCapabilities","id":"cap-push","attributes":{"capabilityType":"PUSH_NOTIFICATIONS"}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/bundleIdCapabilities/cap-icloud":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"key":"XCODE_6"`) {
+				t.Fatalf("unexpected update body: %s", body)
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD"}}}`)
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/bundleIdCapabilities/cap-gc":
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
+
+func runBundleIDSync(t *testing.T, args []string) (string, string) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	return captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+}
+
+func TestBundleIDsSyncPlansAndApplies(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = bundleIDSyncTransport(t, &requests)
+
+	path := filepath.Join(t.TempDir(), "capabilities.yaml")
+	if err := os.WriteFile(path, []byte(bundleIDSyncTestFile), 0o600); err != nil {
+		t.Fatalf("write capabilities file: %v", err)
+	}
+
+	stdout, stderr := runBundleIDSync(t, []string{"bundle-ids", "sync", "--file", path, "--dry-run", "--output", "json"})
+	for _, want := range []string{"+ PUSH_NOTIFICATIONS", "~ ICLOUD", "- GAME_CENTER", "Plan: 1 to add, 1 to update, 1 to remove."} {
+		if !strings.Contains(stderr, want) {
+			t.Fatalf("expected %q in plan, got %q", want, stderr)
+		}
+	}
+	if strings.Contains(stderr, "APP_GROUPS") {
+		t.Fatalf("expected unchanged capability to be left out of the plan, got %q", stderr)
+	}
+	var planned struct {
+		BundleID  string `json:"bundleId"`
+		DryRun    bool   `json:"dryRun"`
+		Applied   bool   `json:"applied"`
+		Unchanged int    `json:"unchanged"`
+		Items     []struct {
+			Item   string `json:"item"`
+			Detail string `json:"detail"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &planned); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if planned.BundleID != "bundle-1" || !planned.DryRun || planned.Applied || planned.Unchanged != 1 || len(planned.Items) != 3 || planned.Items[0].Detail != "would add" {
+		t.Fatalf("unexpected dry-run result: %+v", planned)
+	}
+	for _, request := range requests {
+		if !strings.HasPrefix(request, http.MethodGet) {
+			t.Fatalf("expected dry run to only read, got %v", requests)
+		}
+	}
+
+	requests = nil
+	stdout, _ = runBundleIDSync(t, []string{"bundle-ids", "sync", "--file", path, "--confirm", "--output", "json"})
+	var applied struct {
+		Applied   bool `json:"applied"`
+		Succeeded int  `json:"succeeded"`
+		Items     []struct {
+			Item   string `json:"item"`
+			ID     string `json:"id"`
+			Detail string `json:"detail"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &applied); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if !applied.Applied || applied.Succeeded != 3 || len(applied.Items) != 3 || applied.Items[0].ID != "cap-push" || applied.Items[0].Detail != "add" {
+		t.Fatalf("unexpected apply result: %+v", applied)
+	}
+	want := []string{
+		"GET /v1/bundleIds",
+		"GET /v1/bundleIds/bundle-1/bundleIdCapabilities",
+		"POST /v1/bundleIdCapabilities",
+		"PATCH /v1/bundleIdCapabilities/cap-icloud",
+		"DELETE /v1/bundleIdCapabilities/cap-gc",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+}
+
+func TestBundleIDsSyncReportsFailedChanges(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	next := bundleIDSyncTransport(t, &requests)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPatch && req.URL.Path == "/v1/bundleIdCapabilities/cap-icloud" {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			return jsonResponse(http.StatusConflict, `{"errors":[{"id":"req-icloud","status":"409","code":"ENTITY_ERROR","title":"Invalid iCloud setting"}]}`)
+		}
+		return next(req)
+	})
+
+	path := filepath.Join(t.TempDir(), "capabilities.yaml")
+	if err := os.WriteFile(path, []byte(bundleIDSyncTestFile), 0o600); err != nil {
+		t.Fatalf("write capabilities file: %v", err)
+	}
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"bundle-ids", "sync", "--file", path, "--confirm", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitError {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitError, stderr)
+	}
+	if requests[len(requests)-1] != "DELETE /v1/bundleIdCapabilities/cap-gc" {
+		t.Fatalf("expected the removal to run after the failed update, got %v", requests)
+	}
+
+	var result struct {
+		Applied   bool `json:"applied"`
+		Updated   int  `json:"updated"`
+		Succeeded int  `json:"succeeded"`
+		Failed    int  `json:"failed"`
+		Items     []struct {
+			Item      string `json:"item"`
+			Status    string `json:"status"`
+			RequestID string `json:"requestId"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if result.Applied || result.Updated != 0 || result.Succeeded != 2 || result.Failed != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if failed := result.Items[1]; failed.Item != "ICLOUD" || failed.Status != "failed" || failed.RequestID != "req-icloud" {
+		t.Fatalf("unexpected failed item: %+v", failed)
+	}
+}
+
+func TestBundleIDsSyncValidation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capabilities.yaml")
+	if err := os.WriteFile(path, []byte("capabilities:\n  - type: ICLOUD\n"), 0o600); err != nil {
+		t.Fatalf("write capabilities file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			args:    []string{"bundle-ids", "sync", "--confirm"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"bundle-ids", "sync", "--file", path},
+			wantErr: "--confirm is required unless --dry-run is set",
+		},
+		{
+			name:    "missing bundle",
+			args:    []string{"bundle-ids", "sync", "--file", path, "--dry-run"},
+			wantErr: "--bundle is required when the file has no identifier",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCertificatesDownloadWritesPEM(t *testing.T) {
+	setupAuth(t)
+	outputPath := filepath.Join(t.TempDir(), "certs", "cert.pem")
+	content := base64.StdEncoding.EncodeToString([]byte("der-bytes"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/certificates/cert-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":{"type":"certificates","id":"cert-1","attributes":{"name":"Apple Distribution","certificateType":"DISTRIBUTION","certificateContent":"` + content + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "download", "--id", "cert-1", "--output", outputPath, "--format", "pem"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ID         string `json:"id"`
+		Name       string `json:"name"`
+		OutputPath string `json:"outputPath"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if result.ID != "cert-1" || result.Name != "Apple Distribution" || result.OutputPath != outputPath {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read certificate: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "-----BEGIN CERTIFICATE-----") {
+		t.Fatalf("expected PEM output, got %q", data)
+	}
+}
+
+func TestCertificatesDownloadValidation(t *testing.T) {
+	setupAuth(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing id",
+			args:    []string{"--output", "cert.cer"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "missing output",
+			args:    []string{"--id", "cert-1"},
+			wantErr: "--output is required",
+		},
+		{
+			name:    "invalid format",
+			args:    []string{"--id", "cert-1", "--output", "cert.cer", "--format", "p12"},
+			wantErr: "--format must be der or pem",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(append([]string{"certificates", "download"}, test.args...)); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
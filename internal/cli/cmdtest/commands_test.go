@@ -346,6 +346,11 @@ func TestCanonicalOfferCodesGenerateValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "offers", "offer-codes", "generate", "--offer-code-id", "OFFER_CODE_ID", "--expiration-date", "2026-02-01"},
 			wantErr: "Error: --quantity is required",
 		},
+		{
+			name:    "csv without output",
+			args:    []string{"subscriptions", "offers", "offer-codes", "generate", "--offer-code-id", "OFFER_CODE_ID", "--quantity", "1", "--expiration-date", "2026-02-01", "--csv"},
+			wantErr: "Error: --csv requires --output",
+		},
 	}
 
 	for _, test := range tests {
@@ -611,6 +616,11 @@ func TestBuildsExpireValidationErrors(t *testing.T) {
 			args:    []string{"builds", "expire", "--build", "BUILD_ID"},
 			wantErr: "Error: --confirm is required to expire build",
 		},
+		{
+			name:    "builds expire id alias missing confirm",
+			args:    []string{"builds", "expire", "--id", "BUILD_ID"},
+			wantErr: "Error: --confirm is required to expire build",
+		},
 	}
 
 	for _, test := range tests {
@@ -1587,14 +1597,14 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			wantErr: "--product-type is fixed to SUBSCRIPTION",
 		},
 		{
-			name:    "subscriptions grace-periods get missing id",
+			name:    "subscriptions grace-periods get missing id and app",
 			args:    []string{"subscriptions", "grace-periods", "get"},
-			wantErr: "--id is required",
+			wantErr: "--id or --app is required",
 		},
 		{
-			name:    "subscriptions grace-periods update missing id",
+			name:    "subscriptions grace-periods update missing id and app",
 			args:    []string{"subscriptions", "grace-periods", "update"},
-			wantErr: "--id is required",
+			wantErr: "--id or --app is required",
 		},
 		{
 			name:    "subscriptions grace-periods update missing update flags",
@@ -2869,6 +2879,18 @@ func TestPerformanceValidationErrors(t *testing.T) {
 			wantErr:  "--build is required",
 			wantHelp: true,
 		},
+		{
+			name:     "performance metrics regressions missing app",
+			args:     []string{"performance", "metrics", "regressions"},
+			wantErr:  "--app or --build is required",
+			wantHelp: true,
+		},
+		{
+			name:     "performance metrics regressions app and build",
+			args:     []string{"performance", "metrics", "regressions", "--app", "APP_ID", "--build", "BUILD_ID"},
+			wantErr:  "--app and --build are mutually exclusive",
+			wantHelp: true,
+		},
 		{
 			name:     "performance diagnostics list missing build",
 			args:     []string{"performance", "diagnostics", "list"},
@@ -3515,7 +3537,12 @@ func TestBuildsUploadValidationErrors(t *testing.T) {
 		{
 			name:    "missing ipa or pkg",
 			args:    []string{"builds", "upload", "--app", "APP_123", "--version", "1.0.0", "--build-number", "123"},
-			wantErr: "Error: --ipa or --pkg is required",
+			wantErr: "Error: --file, --ipa, or --pkg is required",
+		},
+		{
+			name:    "file and ipa mutually exclusive",
+			args:    []string{"builds", "upload", "--app", "APP_123", "--file", "app.ipa", "--ipa", "app.ipa"},
+			wantErr: "Error: --file cannot be combined with --ipa or --pkg",
 		},
 		{
 			name:    "ipa and pkg mutually exclusive",
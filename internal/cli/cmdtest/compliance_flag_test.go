@@ -0,0 +1,196 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildsAddGroupsComplianceSetsEncryptionBeforeAddingGroups(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var calls []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/app":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1"}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return promoteJSONResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-external","attributes":{"name":"External QA","isInternalGroup":false}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"VALID"}}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/builds/build-1":
+			payload, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body error: %v", err)
+			}
+			if !strings.Contains(string(payload), `"usesNonExemptEncryption":false`) {
+				t.Fatalf("expected usesNonExemptEncryption false in body, got %s", payload)
+			}
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"usesNonExemptEncryption":false}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/builds/build-1/relationships/betaGroups":
+			return promoteJSONResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "add-groups", "--build", "build-1", "--group", "External QA", "--compliance", "uses-non-exempt-encryption=false"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := []string{
+		"GET /v1/builds/build-1/app",
+		"GET /v1/apps/app-1/betaGroups",
+		"GET /v1/builds/build-1",
+		"PATCH /v1/builds/build-1",
+		"POST /v1/builds/build-1/relationships/betaGroups",
+	}
+	if strings.Join(calls, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected calls:\n%s", strings.Join(calls, "\n"))
+	}
+
+	var result struct {
+		BuildID                 string `json:"buildId"`
+		UsesNonExemptEncryption *bool  `json:"usesNonExemptEncryption"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if result.UsesNonExemptEncryption == nil || *result.UsesNonExemptEncryption {
+		t.Fatalf("expected usesNonExemptEncryption false in output, got %q", stdout)
+	}
+}
+
+func TestVersionsAttachBuildComplianceSkipsMatchingAnswer(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"usesNonExemptEncryption":false}}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersions/version-1/relationships/build":
+			return promoteJSONResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "attach-build", "--version-id", "version-1", "--build", "build-1", "--compliance", "uses-non-exempt-encryption=false"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Attached                bool  `json:"attached"`
+		UsesNonExemptEncryption *bool `json:"usesNonExemptEncryption"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if !result.Attached || result.UsesNonExemptEncryption == nil || *result.UsesNonExemptEncryption {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+}
+
+func TestTestFlightDistributeRejectsComplianceWithUsesNonExemptEncryption(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "distribute", "--app", "123", "--build", "build-1", "--group", "G", "--uses-non-exempt-encryption", "false", "--compliance", "uses-non-exempt-encryption=false"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--compliance and --uses-non-exempt-encryption are mutually exclusive") {
+		t.Fatalf("expected conflict error, got %q", stderr)
+	}
+}
+
+func TestTestFlightDistributeComplianceSkipsMatchingAnswer(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return promoteJSONResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Internal","isInternalGroup":true}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID","usesNonExemptEncryption":false}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/builds/build-1/relationships/betaGroups":
+			return promoteJSONResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "distribute", "--app", "app-1", "--build", "build-1", "--group", "Internal", "--compliance", "uses-non-exempt-encryption=false", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		UsesNonExemptEncryption *bool `json:"usesNonExemptEncryption"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if result.UsesNonExemptEncryption == nil || *result.UsesNonExemptEncryption {
+		t.Fatalf("expected usesNonExemptEncryption false in output, got %q", stdout)
+	}
+}
@@ -0,0 +1,155 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func runConfigCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestConfigSetGetAndList(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+
+	if _, _, err := runConfigCommand(t, "config", "set", "--key", "timeout", "--value", "90s", "--output", "json"); err != nil {
+		t.Fatalf("set timeout: %v", err)
+	}
+	if _, _, err := runConfigCommand(t, "config", "set", "--profile", "client", "--key", "app_id", "--value", "987654321", "--output", "json"); err != nil {
+		t.Fatalf("set profile app_id: %v", err)
+	}
+
+	cfg, err := config.LoadAt(configPath)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if cfg.Timeout.String() != "90s" {
+		t.Fatalf("expected timeout 90s, got %q", cfg.Timeout.String())
+	}
+	if cfg.ProfileAppID("client") != "987654321" {
+		t.Fatalf("expected profile app ID, got %+v", cfg.Profiles)
+	}
+
+	stdout, _, err := runConfigCommand(t, "config", "get", "--profile", "client", "--key", "app_id", "--output", "json")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	var entry struct {
+		Profile string `json:"profile"`
+		Key     string `json:"key"`
+		Value   string `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &entry); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if entry.Profile != "client" || entry.Key != "app_id" || entry.Value != "987654321" {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+
+	stdout, _, err = runConfigCommand(t, "config", "list", "--output", "json")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	var list struct {
+		Path    string `json:"path"`
+		Entries []struct {
+			Profile string `json:"profile"`
+			Key     string `json:"key"`
+			Value   string `json:"value"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &list); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if list.Path != configPath || len(list.Entries) != 2 {
+		t.Fatalf("unexpected list: %+v", list)
+	}
+
+	if _, _, err := runConfigCommand(t, "config", "set", "--profile", "client", "--key", "app_id", "--value", ""); err != nil {
+		t.Fatalf("clear profile app_id: %v", err)
+	}
+	cfg, err = config.LoadAt(configPath)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if len(cfg.Profiles) != 0 {
+		t.Fatalf("expected empty profile settings to be removed, got %+v", cfg.Profiles)
+	}
+}
+
+func TestConfigValidationErrors(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing key",
+			args:    []string{"config", "get"},
+			wantErr: "--key is required",
+		},
+		{
+			name:    "unknown key",
+			args:    []string{"config", "get", "--key", "nope"},
+			wantErr: "--key must be one of",
+		},
+		{
+			name:    "profile key not supported",
+			args:    []string{"config", "set", "--profile", "client", "--key", "timeout", "--value", "5s"},
+			wantErr: "when --profile is set",
+		},
+		{
+			name:    "missing value",
+			args:    []string{"config", "set", "--key", "app_id"},
+			wantErr: "--value is required",
+		},
+		{
+			name:    "invalid retries",
+			args:    []string{"config", "set", "--key", "max_retries", "--value", "-1"},
+			wantErr: "invalid value for max_retries",
+		},
+		{
+			name:    "invalid paginate workers",
+			args:    []string{"config", "set", "--key", "paginate_workers", "--value", "many"},
+			wantErr: "invalid value for paginate_workers",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stdout, stderr, err := runConfigCommand(t, test.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", err)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppsListCSVOutputWithColumns(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":[
+			{"type":"apps","id":"app-1","attributes":{"name":"Alpha","bundleId":"com.example.alpha","sku":"A"}},
+			{"type":"apps","id":"app-2","attributes":{"name":"Beta, Inc","bundleId":"com.example.beta","sku":"B"}}
+		],"links":{}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list", "--output", "csv", "--columns", "id,name,bundleId"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v (%q)", err, stdout)
+	}
+	want := [][]string{
+		{"id", "name", "bundleId"},
+		{"app-1", "Alpha", "com.example.alpha"},
+		{"app-2", "Beta, Inc", "com.example.beta"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("expected %d records, got %v", len(want), records)
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Fatalf("record %d = %v, want %v", i, records[i], want[i])
+		}
+	}
+}
+
+func TestColumnsRequiresCSVOutput(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list", "--output", "json", "--columns", "id"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--columns requires --output csv") {
+		t.Fatalf("expected columns validation error, got %q", stderr)
+	}
+}
@@ -0,0 +1,107 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestVersionsCreateUsesDefaultAppAndPlatformEnv(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "app-env")
+	t.Setenv("ASC_DEFAULT_PLATFORM", "mac_os")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/appStoreVersions" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data struct {
+				Attributes struct {
+					Platform string `json:"platform"`
+				} `json:"attributes"`
+				Relationships struct {
+					App struct {
+						Data struct {
+							ID string `json:"id"`
+						} `json:"data"`
+					} `json:"app"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.Attributes.Platform != "MAC_OS" {
+			t.Fatalf("expected platform MAC_OS from env, got %q", payload.Data.Attributes.Platform)
+		}
+		if payload.Data.Relationships.App.Data.ID != "app-env" {
+			t.Fatalf("expected app app-env from env, got %q", payload.Data.Relationships.App.Data.ID)
+		}
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"appStoreVersions","id":"ver-new","attributes":{"platform":"MAC_OS","versionString":"2.4.0"}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "create", "--version", "2.4.0", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ID       string `json:"id"`
+		Platform string `json:"platform"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.ID != "ver-new" || result.Platform != "MAC_OS" {
+		t.Fatalf("expected created version, got %s", stdout)
+	}
+}
+
+func TestCategoriesSetUsesAppIDEnv(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "app-env")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps/app-env/appInfos" {
+			t.Fatalf("expected app infos lookup for env app, got %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":[]}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"categories", "set", "--primary", "GAMES"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil {
+		t.Fatal("expected error for app without app infos")
+	}
+}
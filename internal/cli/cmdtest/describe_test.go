@@ -0,0 +1,131 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func describeJSONResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func TestDescribeRejectsUnsupportedType(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"describe", "widgets", "1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, `unsupported type "widgets"`) || !strings.Contains(stderr, "builds") {
+		t.Fatalf("expected unsupported type error listing types, got %q", stderr)
+	}
+}
+
+func TestDescribeBuildFetchesRelationships(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var paths []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+		paths = append(paths, req.URL.Path)
+		switch req.URL.Path {
+		case "/v1/builds/build-1":
+			return describeJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID","expired":false}}}`), nil
+		case "/v1/builds/build-1/app":
+			return describeJSONResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"name":"My App","bundleId":"com.example.app"}}}`), nil
+		case "/v1/builds/build-1/individualTesters":
+			return describeJSONResponse(http.StatusOK, `{"data":[{"type":"betaTesters","id":"t-1","attributes":{"email":"a@example.com"}},{"type":"betaTesters","id":"t-2","attributes":{"email":"b@example.com"}}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/builds/build-1/individualTesters?cursor=2"}}`), nil
+		case "/v1/builds/build-1/appStoreVersion":
+			return describeJSONResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`), nil
+		default:
+			t.Fatalf("unexpected request %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"describe",
+			"--relationships", "app,individualTesters,appStoreVersion",
+			"builds", "build-1",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "could not fetch appStoreVersion") {
+		t.Fatalf("expected relationship warning, got %q", stderr)
+	}
+	if len(paths) != 4 {
+		t.Fatalf("expected 4 requests, got %v", paths)
+	}
+
+	var description struct {
+		Type          string         `json:"type"`
+		ID            string         `json:"id"`
+		Attributes    map[string]any `json:"attributes"`
+		Relationships []struct {
+			Name    string `json:"name"`
+			Count   int    `json:"count"`
+			HasMore bool   `json:"hasMore"`
+			Items   []struct {
+				ID    string `json:"id"`
+				Label string `json:"label"`
+			} `json:"items"`
+			Error string `json:"error"`
+		} `json:"relationships"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &description); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if description.Type != "builds" || description.ID != "build-1" || description.Attributes["version"] != "42" {
+		t.Fatalf("unexpected resource: %+v", description)
+	}
+	if len(description.Relationships) != 3 {
+		t.Fatalf("expected 3 relationships, got %+v", description.Relationships)
+	}
+	app := description.Relationships[0]
+	if app.Name != "app" || app.Count != 1 || app.Items[0].Label != "My App" {
+		t.Fatalf("unexpected app relationship: %+v", app)
+	}
+	testers := description.Relationships[1]
+	if testers.Count != 2 || !testers.HasMore || testers.Items[1].Label != "b@example.com" {
+		t.Fatalf("unexpected testers relationship: %+v", testers)
+	}
+	if description.Relationships[2].Error == "" {
+		t.Fatalf("expected appStoreVersion error, got %+v", description.Relationships[2])
+	}
+}
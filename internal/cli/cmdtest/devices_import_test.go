@@ -0,0 +1,208 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDevicesImportReportsRowsAndRegeneratesProfiles(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	path := filepath.Join(t.TempDir(), "devices.txt")
+	content := "Device ID\tDevice Name\tDevice Platform\n" +
+		"00008030-001a2c3e0e68802e\tQA iPhone\tios\n" +
+		"00008030-001A2C3E0E68802E\tQA iPhone again\tios\n" +
+		"AAAAAAAA-BBBBBBBBBBBBBBBB\tOld iPad\tios\n" +
+		"CCCCCCCC-DDDDDDDDDDDDDDDD\tRetired iPad\tios\n" +
+		"not-a-udid\tBroken\tios\n" +
+		"EEEEEEEE-FFFFFFFFFFFFFFFF\tWatch\twatchos\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write devices file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var created []string
+	var profileDevices []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"devices","id":"dev-old","attributes":{"udid":"AAAAAAAA-BBBBBBBBBBBBBBBB","platform":"IOS","status":"ENABLED"}},
+				{"type":"devices","id":"dev-retired","attributes":{"udid":"cccccccc-dddddddddddddddd","platform":"IOS","status":"DISABLED"}}
+			]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/devices":
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						UDID     string `json:"udid"`
+						Platform string `json:"platform"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode device payload: %v", err)
+			}
+			created = append(created, payload.Data.Attributes.UDID+" "+payload.Data.Attributes.Platform)
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"devices","id":"dev-new","attributes":{"udid":"00008030-001A2C3E0E68802E"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles":
+			if got := req.URL.Query().Get("filter[profileType]"); got != "IOS_APP_ADHOC,IOS_APP_DEVELOPMENT" {
+				t.Fatalf("unexpected profile type filter %q", got)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"profiles","id":"prof-1","attributes":{"name":"Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE"}},
+				{"type":"profiles","id":"prof-invalid","attributes":{"name":"Stale","profileType":"IOS_APP_DEVELOPMENT","profileState":"INVALID"}},
+				{"type":"profiles","id":"prof-expired","attributes":{"name":"Old","profileType":"IOS_APP_ADHOC","profileState":"EXPIRED"}}
+			]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"profiles","id":"prof-1","attributes":{"name":"Dev","profileType":"IOS_APP_DEVELOPMENT"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/relationships/bundleId":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"bundleIds","id":"bundle-1"},"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"cert-1"}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"devices","id":"dev-old"}],"links":{}}`)
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/profiles/prof-1":
+			return jsonResponse(http.StatusNoContent, "")
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			var payload struct {
+				Data struct {
+					Relationships struct {
+						Devices struct {
+							Data []struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"devices"`
+					} `json:"relationships"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode profile payload: %v", err)
+			}
+			for _, device := range payload.Data.Relationships.Devices.Data {
+				profileDevices = append(profileDevices, device.ID)
+			}
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"profiles","id":"prof-2","attributes":{"name":"Dev","profileType":"IOS_APP_DEVELOPMENT"}}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "import", "--file", path, "--regenerate-profiles", "--confirm", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "2 row(s) and 0 profile(s) failed") {
+			t.Fatalf("expected row failures to be reported, got %v", err)
+		}
+	})
+
+	var summary struct {
+		Registered int `json:"registered"`
+		Existed    int `json:"existed"`
+		Disabled   int `json:"disabled"`
+		Duplicates int `json:"duplicates"`
+		Failed     int `json:"failed"`
+		Items      []struct {
+			Row    int    `json:"row"`
+			Status string `json:"status"`
+			Detail string `json:"detail"`
+			Error  string `json:"error"`
+		} `json:"items"`
+		Profiles []struct {
+			PreviousID string `json:"previousId"`
+			ID         string `json:"id"`
+		} `json:"profiles"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if summary.Registered != 1 || summary.Existed != 1 || summary.Disabled != 1 || summary.Duplicates != 1 || summary.Failed != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if len(summary.Items) != 6 || summary.Items[1].Detail != "duplicate of row 1" || !strings.Contains(summary.Items[3].Detail, "disabled") {
+		t.Fatalf("unexpected items: %+v", summary.Items)
+	}
+	if !strings.Contains(summary.Items[4].Error, "invalid UDID") || !strings.Contains(summary.Items[5].Error, "platform must be one of") {
+		t.Fatalf("unexpected failures: %+v", summary.Items)
+	}
+	if len(created) != 1 || created[0] != "00008030-001A2C3E0E68802E IOS" {
+		t.Fatalf("expected one normalized registration, got %v", created)
+	}
+	if len(summary.Profiles) != 1 || summary.Profiles[0].ID != "prof-2" {
+		t.Fatalf("unexpected profiles: %+v", summary.Profiles)
+	}
+	if strings.Join(profileDevices, ",") != "dev-old,dev-new" {
+		t.Fatalf("expected regenerated profile to keep existing devices and add new ones, got %v", profileDevices)
+	}
+}
+
+func TestDevicesImportValidation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.csv")
+	if err := os.WriteFile(path, []byte("udid,name\n"), 0o600); err != nil {
+		t.Fatalf("write devices file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			args:    []string{"devices", "import"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "regenerate without confirm",
+			args:    []string{"devices", "import", "--file", path, "--regenerate-profiles"},
+			wantErr: "--confirm is required with --regenerate-profiles",
+		},
+		{
+			name:    "confirm without regenerate",
+			args:    []string{"devices", "import", "--file", path, "--confirm"},
+			wantErr: "--confirm requires --regenerate-profiles",
+		},
+		{
+			name:    "invalid platform",
+			args:    []string{"devices", "import", "--file", path, "--platform", "WATCH_OS"},
+			wantErr: "--platform must be one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestDryRunPrintsUpdateWithoutSending(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Cleanup(func() { asc.SetDryRunOverride(nil) })
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	})
+
+	for _, args := range [][]string{
+		{"--dry-run", "apps", "update", "--id", "app-1", "--bundle-id", "com.example.new"},
+		{"apps", "update", "--id", "app-1", "--bundle-id", "com.example.new", "--dry-run"},
+	} {
+		var code int
+		stdout, stderr := captureOutput(t, func() {
+			code = cmd.Run(args, "1.2.3")
+		})
+		if code != cmd.ExitSuccess {
+			t.Fatalf("%v: exit code = %d, want %d; stderr=%q", args, code, cmd.ExitSuccess, stderr)
+		}
+
+		var plan struct {
+			DryRun bool   `json:"dryRun"`
+			Method string `json:"method"`
+			URL    string `json:"url"`
+			Body   struct {
+				Data struct {
+					ID         string            `json:"id"`
+					Attributes map[string]string `json:"attributes"`
+				} `json:"data"`
+			} `json:"body"`
+		}
+		if err := json.Unmarshal([]byte(stdout), &plan); err != nil {
+			t.Fatalf("%v: failed to parse stdout %q: %v", args, stdout, err)
+		}
+		if !plan.DryRun || plan.Method != http.MethodPatch || plan.URL != asc.BaseURL+"/v1/apps/app-1" {
+			t.Fatalf("%v: unexpected plan %+v", args, plan)
+		}
+		if plan.Body.Data.ID != "app-1" || plan.Body.Data.Attributes["bundleId"] != "com.example.new" {
+			t.Fatalf("%v: unexpected body %+v", args, plan.Body)
+		}
+	}
+}
+
+func TestDryRunPreviewsBulkCommandWithoutFailing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Cleanup(func() { asc.SetDryRunOverride(nil) })
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/builds/build-1/betaBuildLocalizations" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"betaBuildLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}]}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"--dry-run", "testflight", "whats-new", "set", "--build", "build-1", "--all-locales", "Bug fixes", "--locale", "fr-FR"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	var result struct {
+		DryRun bool `json:"dryRun"`
+		asc.BulkResult
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if !result.DryRun || result.Failed != 0 || len(result.Items) != 2 {
+		t.Fatalf("expected a previewed bulk result with no failures, got %+v", result)
+	}
+}
+
+func TestDryRunContinuesStdinIDBatch(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Cleanup(func() { asc.SetDryRunOverride(nil) })
+	setStdin(t, "app-1\napp-2\n")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"apps", "update", "--id", "-", "--bundle-id", "com.example.new", "--dry-run"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+	for _, id := range []string{"app-1", "app-2"} {
+		if !strings.Contains(stdout, asc.BaseURL+"/v1/apps/"+id) {
+			t.Fatalf("expected a dry-run plan for %s, got %q", id, stdout)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestEnumsListByType(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"enums", "list", "--type", "DeviceClass", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data []struct {
+			Name   string   `json:"name"`
+			Values []string `json:"values"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Data) != 1 || payload.Data[0].Name != "deviceClass" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if !strings.Contains(strings.Join(payload.Data[0].Values, ","), "IPHONE") {
+		t.Fatalf("expected IPHONE in values, got %v", payload.Data[0].Values)
+	}
+}
+
+func TestEnumsListAllSortedByName(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"enums", "list", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data []struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Data) < 2 {
+		t.Fatalf("expected multiple enums, got %d", len(payload.Data))
+	}
+	for i := 1; i < len(payload.Data); i++ {
+		if payload.Data[i-1].Name > payload.Data[i].Name {
+			t.Fatalf("expected enums sorted by name, got %q before %q", payload.Data[i-1].Name, payload.Data[i].Name)
+		}
+	}
+}
+
+func TestEnumsListUnknownType(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"enums", "list", "--type", "nope"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if !strings.Contains(stderr, `unknown --type "nope"`) {
+		t.Fatalf("expected unknown type error, got %q", stderr)
+	}
+}
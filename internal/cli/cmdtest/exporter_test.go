@@ -0,0 +1,79 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestExporterValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"exporter"},
+			wantErr: "--app is required for the reviews and builds collectors",
+		},
+		{
+			name:    "unknown collector",
+			args:    []string{"exporter", "--app", "app-1", "--collect", "reviews,sales"},
+			wantErr: `unknown collector "sales"`,
+		},
+		{
+			name:    "metrics without queue",
+			args:    []string{"exporter", "--collect", "metrics"},
+			wantErr: "--queue-id is required for the metrics collector",
+		},
+		{
+			name:    "invalid granularity",
+			args:    []string{"exporter", "--collect", "metrics", "--queue-id", "queue-1", "--granularity", "P1W"},
+			wantErr: "--granularity must be one of: P1D, PT1H, PT15M",
+		},
+		{
+			name:    "interval too short",
+			args:    []string{"exporter", "--app", "app-1", "--interval", "5s"},
+			wantErr: "--interval must be at least 30s",
+		},
+		{
+			name:    "remote listen without allow-remote",
+			args:    []string{"exporter", "--app", "app-1", "--listen", ":9108"},
+			wantErr: "requires --allow-remote",
+		},
+		{
+			name:    "positional args",
+			args:    []string{"exporter", "extra"},
+			wantErr: "exporter does not accept positional arguments",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
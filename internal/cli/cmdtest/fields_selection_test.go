@@ -0,0 +1,83 @@
+package cmdtest
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestFieldsSelectionAddsSparseFieldsets(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		query := req.URL.Query()
+		if got := query.Get("fields[apps]"); got != "name,bundleId" {
+			t.Fatalf("fields[apps] = %q, want name,bundleId", got)
+		}
+		if query.Has("fields[builds]") {
+			t.Fatalf("expected fields[builds] to be skipped on an apps request, got %q", req.URL.RawQuery)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"One","bundleId":"com.example.one"}}],"links":{}}`)
+	})
+
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"apps", "list", "--fields", "apps=name,bundleId", "--fields", "builds=version", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+}
+
+func TestFieldsSelectionTypedValueOnCommandWithOwnFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/actors" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("fields[actors]"); got != "userEmail" {
+			t.Fatalf("fields[actors] = %q, want userEmail", got)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+	})
+
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"actors", "list", "--id", "actor-1", "--fields", "actors=userEmail", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+}
+
+func TestFieldsSelectionRejectsInvalidValue(t *testing.T) {
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"apps", "list", "--fields", "apps="}, "1.2.3")
+	})
+	if code != cmd.ExitUsage {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+	}
+	if !strings.Contains(stderr, "--fields must be TYPE=FIELD") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
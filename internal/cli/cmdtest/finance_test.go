@@ -6,6 +6,9 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -151,3 +154,117 @@ func TestFinanceRegionsCommandOutputsJSON(t *testing.T) {
 		t.Fatal("expected region Z1 in output")
 	}
 }
+
+func TestFinanceDownloadWritesDecompressedReport(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	report := "Start Date\tEnd Date\tUnits\n04/28/2025\t05/31/2025\t3\n"
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/financeReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		query := req.URL.Query()
+		if query.Get("filter[reportType]") != "FINANCIAL" || query.Get("filter[regionCode]") != "ZZ" || query.Get("filter[reportDate]") != "2025-05" {
+			t.Fatalf("unexpected query: %s", req.URL.RawQuery)
+		}
+		return insightsGzipResponse(report), nil
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "finance.tsv")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"finance", "download",
+			"--vendor", "12345678",
+			"--region", "zz",
+			"--date", "2025-05",
+			"--output", outputPath,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ReportType       string `json:"reportType"`
+		RegionCode       string `json:"regionCode"`
+		FilePath         string `json:"filePath"`
+		Decompressed     bool   `json:"decompressed"`
+		DecompressedPath string `json:"decompressedPath"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if result.ReportType != "FINANCIAL" || result.RegionCode != "ZZ" || !result.Decompressed {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.FilePath != outputPath+".gz" || result.DecompressedPath != outputPath {
+		t.Fatalf("unexpected paths: %+v", result)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read decompressed report: %v", err)
+	}
+	if string(data) != report {
+		t.Fatalf("expected decompressed report %q, got %q", report, string(data))
+	}
+}
+
+func TestFinanceDownloadValidationErrors(t *testing.T) {
+	t.Setenv("ASC_VENDOR_NUMBER", "")
+	t.Setenv("ASC_ANALYTICS_VENDOR_NUMBER", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing vendor",
+			args:    []string{"finance", "download", "--region", "ZZ", "--date", "2025-12"},
+			wantErr: "--vendor is required",
+		},
+		{
+			name:    "missing region",
+			args:    []string{"finance", "download", "--vendor", "12345678", "--date", "2025-12"},
+			wantErr: "--region is required",
+		},
+		{
+			name:    "missing date",
+			args:    []string{"finance", "download", "--vendor", "12345678", "--region", "ZZ"},
+			wantErr: "--date is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
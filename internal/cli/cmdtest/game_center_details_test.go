@@ -275,7 +275,7 @@ func TestGameCenterDetailsMetricsMissingGranularityValidationErrors(t *testing.T
 			if stdout != "" {
 				t.Fatalf("expected empty stdout, got %q", stdout)
 			}
-			if !strings.Contains(stderr, "Error: --granularity is required") {
+			if !strings.Contains(stderr, "Error: --granularity or --since is required") {
 				t.Fatalf("expected missing granularity error, got %q", stderr)
 			}
 		})
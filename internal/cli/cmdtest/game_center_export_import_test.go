@@ -0,0 +1,508 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestGameCenterExportImportValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "export missing app",
+			args:    []string{"game-center", "export", "--file", "gc.yaml"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "export missing file",
+			args:    []string{"game-center", "export", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "import missing app",
+			args:    []string{"game-center", "import", "--file", "gc.yaml"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "import missing file",
+			args:    []string{"game-center", "import", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestGameCenterImportRejectsInvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "unsupported version",
+			config:  "version: 2\n",
+			wantErr: "unsupported version 2",
+		},
+		{
+			name:    "unknown field",
+			config:  "version: 1\nachievments: []\n",
+			wantErr: "achievments",
+		},
+		{
+			name: "duplicate vendor id",
+			config: `version: 1
+achievements:
+  - vendorId: com.example.win
+    referenceName: Win
+  - vendorId: com.example.win
+    referenceName: Win Again
+`,
+			wantErr: `duplicate vendorId "com.example.win"`,
+		},
+		{
+			name: "unknown set member",
+			config: `version: 1
+leaderboardSets:
+  - vendorId: com.example.season
+    referenceName: Season
+    leaderboards: [com.example.missing]
+`,
+			wantErr: "com.example.missing",
+		},
+		{
+			name: "missing image file",
+			config: `version: 1
+achievements:
+  - vendorId: com.example.win
+    referenceName: Win
+    points: 10
+    localizations:
+      - locale: en-US
+        name: Win
+        beforeEarnedDescription: Win a game
+        afterEarnedDescription: You won
+        image:
+          path: missing.png
+`,
+			wantErr: "missing.png",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(test.name, " ", "-")+".yaml")
+			if err := os.WriteFile(path, []byte(test.config), 0o600); err != nil {
+				t.Fatalf("write config: %v", err)
+			}
+
+			var code int
+			_, stderr := captureOutput(t, func() {
+				code = cmd.Run([]string{"game-center", "import", "--app", "APP_ID", "--file", path, "--dry-run"}, "1.2.3")
+			})
+			if code != cmd.ExitUsage {
+				t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func gameCenterJSONResponse(status int, body string) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}, nil
+}
+
+const gameCenterNotFoundBody = `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`
+
+func TestGameCenterExportWritesConfiguration(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID/gameCenterDetail":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"gameCenterDetails","id":"detail-1"}}`)
+		case "/v1/gameCenterDetails/detail-1/gameCenterAchievements":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[
+				{"type":"gameCenterAchievements","id":"ach-1","attributes":{"referenceName":"First Win","vendorIdentifier":"com.example.firstwin","points":10,"showBeforeEarned":true}},
+				{"type":"gameCenterAchievements","id":"ach-2","attributes":{"referenceName":"Old","vendorIdentifier":"com.example.old","archived":true}}
+			],"links":{}}`)
+		case "/v1/gameCenterAchievements/ach-1/localizations":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"gameCenterAchievementLocalizations","id":"ach-loc-1","attributes":{"locale":"en-US","name":"First Win","beforeEarnedDescription":"Win a game","afterEarnedDescription":"You won"}}],"links":{}}`)
+		case "/v1/gameCenterAchievementLocalizations/ach-loc-1/gameCenterAchievementImage":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"gameCenterAchievementImages","id":"img-1","attributes":{"fileName":"win.png","imageAsset":{"templateUrl":"https://example.com/{w}x{h}.{f}","width":512,"height":512}}}}`)
+		case "/v1/gameCenterDetails/detail-1/gameCenterLeaderboards":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"gameCenterLeaderboards","id":"lb-1","attributes":{"referenceName":"High Score","vendorIdentifier":"com.example.high","defaultFormatter":"INTEGER","scoreSortType":"DESC","submissionType":"BEST_SCORE"}}],"links":{}}`)
+		case "/v1/gameCenterLeaderboards/lb-1/localizations":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		case "/v1/gameCenterDetails/detail-1/gameCenterLeaderboardSets":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"gameCenterLeaderboardSets","id":"set-1","attributes":{"referenceName":"Season","vendorIdentifier":"com.example.season"}}],"links":{}}`)
+		case "/v1/gameCenterLeaderboardSets/set-1/gameCenterLeaderboards":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"gameCenterLeaderboards","id":"lb-1"}],"links":{}}`)
+		case "/v1/gameCenterLeaderboardSets/set-1/localizations":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		case "/v1/gameCenterDetails/detail-1/gameCenterActivities",
+			"/v1/gameCenterDetails/detail-1/gameCenterChallenges":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "gc.yaml")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "export", "--app", "APP_ID", "--file", path, "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		DetailID        string `json:"detailId"`
+		File            string `json:"file"`
+		Achievements    int    `json:"achievements"`
+		Leaderboards    int    `json:"leaderboards"`
+		LeaderboardSets int    `json:"leaderboardSets"`
+		Localizations   int    `json:"localizations"`
+		Images          int    `json:"images"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if result.DetailID != "detail-1" || result.File != path {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Achievements != 1 || result.Leaderboards != 1 || result.LeaderboardSets != 1 || result.Localizations != 1 || result.Images != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	var config struct {
+		Version      int    `yaml:"version"`
+		SourceAppID  string `yaml:"sourceAppId"`
+		Achievements []struct {
+			VendorID      string `yaml:"vendorId"`
+			Points        int    `yaml:"points"`
+			Localizations []struct {
+				Locale string `yaml:"locale"`
+				Image  struct {
+					FileName string `yaml:"fileName"`
+				} `yaml:"image"`
+			} `yaml:"localizations"`
+		} `yaml:"achievements"`
+		LeaderboardSets []struct {
+			Leaderboards []string `yaml:"leaderboards"`
+		} `yaml:"leaderboardSets"`
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		t.Fatalf("parse export: %v\n%s", err, data)
+	}
+	if config.Version != 1 || config.SourceAppID != "APP_ID" {
+		t.Fatalf("unexpected header: %+v", config)
+	}
+	if len(config.Achievements) != 1 || config.Achievements[0].VendorID != "com.example.firstwin" || config.Achievements[0].Points != 10 {
+		t.Fatalf("expected only the active achievement, got %+v", config.Achievements)
+	}
+	if locs := config.Achievements[0].Localizations; len(locs) != 1 || locs[0].Locale != "en-US" || locs[0].Image.FileName != "win.png" {
+		t.Fatalf("unexpected achievement localizations: %+v", locs)
+	}
+	if len(config.LeaderboardSets) != 1 || len(config.LeaderboardSets[0].Leaderboards) != 1 || config.LeaderboardSets[0].Leaderboards[0] != "com.example.high" {
+		t.Fatalf("expected set members by vendor ID, got %+v", config.LeaderboardSets)
+	}
+
+	// A second export without --overwrite must not replace the file.
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"game-center", "export", "--app", "APP_ID", "--file", path}, "1.2.3")
+	})
+	if code != cmd.ExitUsage || !strings.Contains(stderr, "--overwrite") {
+		t.Fatalf("expected usage error mentioning --overwrite, got code %d stderr %q", code, stderr)
+	}
+}
+
+const gameCenterImportConfig = `version: 1
+achievements:
+  - vendorId: com.example.firstwin
+    referenceName: First Win
+    points: 20
+    showBeforeEarned: true
+    localizations:
+      - locale: en-US
+        name: First Win
+        beforeEarnedDescription: Win a game
+        afterEarnedDescription: You won
+leaderboards:
+  - vendorId: com.example.high
+    referenceName: High Score
+    formatter: INTEGER
+    sort: DESC
+    submissionType: BEST_SCORE
+    localizations:
+      - locale: en-US
+        name: High Score
+`
+
+type gameCenterImportOutput struct {
+	DryRun    bool `json:"dryRun"`
+	Created   int  `json:"created"`
+	Updated   int  `json:"updated"`
+	Unchanged int  `json:"unchanged"`
+	Total     int  `json:"total"`
+	Failed    int  `json:"failed"`
+	Items     []struct {
+		Item      string `json:"item"`
+		ID        string `json:"id"`
+		Status    string `json:"status"`
+		Detail    string `json:"detail"`
+		Error     string `json:"error"`
+		RequestID string `json:"requestId"`
+	} `json:"items"`
+}
+
+func gameCenterImportTransport(t *testing.T, mutations *[]string) roundTripFunc {
+	t.Helper()
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			*mutations = append(*mutations, req.Method+" "+req.URL.Path)
+		}
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_2/gameCenterDetail":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"gameCenterDetails","id":"detail-2"}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterDetails/detail-2/gameCenterAchievements":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"gameCenterAchievements","id":"ach-9","attributes":{"referenceName":"First Win","vendorIdentifier":"com.example.firstwin","points":10,"showBeforeEarned":true}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterAchievements/ach-9/localizations":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[{"type":"gameCenterAchievementLocalizations","id":"ach-loc-9","attributes":{"locale":"en-US","name":"First Win","beforeEarnedDescription":"Win a game","afterEarnedDescription":"You won"}}],"links":{}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterAchievementLocalizations/ach-loc-9/gameCenterAchievementImage":
+			return gameCenterJSONResponse(http.StatusNotFound, gameCenterNotFoundBody)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterDetails/detail-2/gameCenterLeaderboards":
+			return gameCenterJSONResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/gameCenterAchievements/ach-9":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"points":20`) || strings.Contains(string(payload), "referenceName") {
+				t.Fatalf("expected only points in update, got %s", payload)
+			}
+			return gameCenterJSONResponse(http.StatusOK, `{"data":{"type":"gameCenterAchievements","id":"ach-9"}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/gameCenterLeaderboards":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"vendorIdentifier":"com.example.high"`) {
+				t.Fatalf("unexpected leaderboard create body %s", payload)
+			}
+			return gameCenterJSONResponse(http.StatusCreated, `{"data":{"type":"gameCenterLeaderboards","id":"lb-9"}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/gameCenterLeaderboardLocalizations":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"lb-9"`) {
+				t.Fatalf("expected localization for the new leaderboard, got %s", payload)
+			}
+			return gameCenterJSONResponse(http.StatusCreated, `{"data":{"type":"gameCenterLeaderboardLocalizations","id":"lb-loc-9"}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	}
+}
+
+func runGameCenterImport(t *testing.T, args []string) gameCenterImportOutput {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload gameCenterImportOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	return payload
+}
+
+func TestGameCenterImportDryRunPlansChanges(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	path := filepath.Join(t.TempDir(), "gc.yaml")
+	if err := os.WriteFile(path, []byte(gameCenterImportConfig), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var mutations []string
+	http.DefaultTransport = gameCenterImportTransport(t, &mutations)
+
+	payload := runGameCenterImport(t, []string{"game-center", "import", "--app", "APP_2", "--file", path, "--dry-run", "--output", "json"})
+
+	if len(mutations) != 0 {
+		t.Fatalf("expected no changes in dry run, got %v", mutations)
+	}
+	if !payload.DryRun || payload.Created != 2 || payload.Updated != 1 || payload.Unchanged != 1 || payload.Total != 4 || len(payload.Items) != 4 {
+		t.Fatalf("unexpected plan: %+v", payload)
+	}
+	update := payload.Items[0]
+	if update.Item != "achievement/com.example.firstwin" || update.Detail != "would update: points" || update.ID != "ach-9" || update.Status != "succeeded" {
+		t.Fatalf("unexpected achievement item: %+v", update)
+	}
+	if unchanged := payload.Items[1]; unchanged.Item != "achievement-localization/com.example.firstwin/en-US" || unchanged.Status != "skipped" || unchanged.Detail != "unchanged" {
+		t.Fatalf("unexpected localization item: %+v", unchanged)
+	}
+	if created := payload.Items[2]; created.Item != "leaderboard/com.example.high" || created.Detail != "would create" || created.ID != "" {
+		t.Fatalf("unexpected leaderboard item: %+v", created)
+	}
+	if created := payload.Items[3]; created.Item != "leaderboard-localization/com.example.high/en-US" || created.Detail != "would create" {
+		t.Fatalf("unexpected leaderboard localization item: %+v", created)
+	}
+}
+
+func TestGameCenterImportAppliesChanges(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	path := filepath.Join(t.TempDir(), "gc.yaml")
+	if err := os.WriteFile(path, []byte(gameCenterImportConfig), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var mutations []string
+	http.DefaultTransport = gameCenterImportTransport(t, &mutations)
+
+	payload := runGameCenterImport(t, []string{"game-center", "import", "--app", "APP_2", "--file", path, "--output", "json"})
+
+	want := []string{
+		"PATCH /v1/gameCenterAchievements/ach-9",
+		"POST /v1/gameCenterLeaderboards",
+		"POST /v1/gameCenterLeaderboardLocalizations",
+	}
+	if strings.Join(mutations, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("mutations = %v, want %v", mutations, want)
+	}
+	if payload.DryRun || payload.Created != 2 || payload.Updated != 1 {
+		t.Fatalf("unexpected result: %+v", payload)
+	}
+	if created := payload.Items[2]; created.Detail != "create" || created.ID != "lb-9" {
+		t.Fatalf("expected new leaderboard ID in result, got %+v", created)
+	}
+}
+
+func TestGameCenterImportReportsFailedItem(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	path := filepath.Join(t.TempDir(), "gc.yaml")
+	if err := os.WriteFile(path, []byte(gameCenterImportConfig), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var mutations []string
+	next := gameCenterImportTransport(t, &mutations)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPatch && req.URL.Path == "/v1/gameCenterAchievements/ach-9" {
+			mutations = append(mutations, req.Method+" "+req.URL.Path)
+			return gameCenterJSONResponse(http.StatusConflict, `{"errors":[{"id":"req-9","status":"409","code":"STATE_ERROR","title":"Achievement is live"}]}`)
+		}
+		return next(req)
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"game-center", "import", "--app", "APP_2", "--file", path, "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitError {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitError, stderr)
+	}
+	if len(mutations) != 1 {
+		t.Fatalf("expected the import to stop after the failed update, got %v", mutations)
+	}
+
+	var payload gameCenterImportOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Failed != 1 || payload.Total != 1 || len(payload.Items) != 1 {
+		t.Fatalf("unexpected result: %+v", payload)
+	}
+	failed := payload.Items[0]
+	if failed.Item != "achievement/com.example.firstwin" || failed.Status != "failed" || failed.RequestID != "req-9" || !strings.Contains(failed.Error, "Achievement is live") {
+		t.Fatalf("unexpected failed item: %+v", failed)
+	}
+}
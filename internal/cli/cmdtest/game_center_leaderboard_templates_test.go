@@ -0,0 +1,190 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func leaderboardTemplateJSONResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func writeLeaderboardTemplate(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "recurring-weekly.yaml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	return path
+}
+
+const recurringWeeklyTemplate = `referenceName: Weekly High Score
+vendorId: com.example.weekly
+formatter: integer
+sort: DESC
+submissionType: BEST_SCORE
+scoreRangeStart: 0
+scoreRangeEnd: "1000000"
+recurrence:
+  startDate: "2026-01-05T00:00:00Z"
+  duration: P7D
+  rule: FREQ=WEEKLY;INTERVAL=1
+localizations:
+  - locale: en-US
+    name: Weekly High Score
+    formatterSuffix: " points"
+  - locale: de-DE
+    name: Wöchentlicher Highscore
+`
+
+func TestGameCenterLeaderboardsCreateFromTemplate(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	templatePath := writeLeaderboardTemplate(t, recurringWeeklyTemplate)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var localeBodies []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/gameCenterDetail":
+			return leaderboardTemplateJSONResponse(http.StatusOK, `{"data":{"type":"gameCenterDetails","id":"gc-1"}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/gameCenterLeaderboards":
+			var payload struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			attrs := payload.Data.Attributes
+			if attrs["referenceName"] != "Week 2" || attrs["vendorIdentifier"] != "com.example.weekly" {
+				t.Fatalf("expected flag override and template vendor, got %v", attrs)
+			}
+			if attrs["defaultFormatter"] != "INTEGER" || attrs["scoreRangeStart"] != "0" || attrs["recurrenceDuration"] != "P7D" || attrs["recurrenceRule"] != "FREQ=WEEKLY;INTERVAL=1" {
+				t.Fatalf("unexpected template attributes %v", attrs)
+			}
+			return leaderboardTemplateJSONResponse(http.StatusCreated, `{"data":{"type":"gameCenterLeaderboards","id":"lb-1","attributes":{"referenceName":"Week 2"}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/gameCenterLeaderboardLocalizations":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"lb-1"`) {
+				t.Fatalf("expected leaderboard relationship, got %s", payload)
+			}
+			localeBodies = append(localeBodies, string(payload))
+			id := fmt.Sprintf("loc-%d", len(localeBodies))
+			return leaderboardTemplateJSONResponse(http.StatusCreated, `{"data":{"type":"gameCenterLeaderboardLocalizations","id":"`+id+`"}}`), nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "leaderboards", "create",
+			"--app", "app-1",
+			"--template", templatePath,
+			"--reference-name", "Week 2",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(localeBodies) != 2 || !strings.Contains(localeBodies[0], `"formatterSuffix":" points"`) || !strings.Contains(localeBodies[1], `"locale":"de-DE"`) {
+		t.Fatalf("unexpected localization requests %v", localeBodies)
+	}
+
+	var result struct {
+		Leaderboard struct {
+			ID string `json:"id"`
+		} `json:"leaderboard"`
+		Localizations []struct {
+			ID string `json:"id"`
+		} `json:"localizations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Leaderboard.ID != "lb-1" || len(result.Localizations) != 2 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestGameCenterLeaderboardsCreateTemplateValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		args     []string
+		wantErr  string
+	}{
+		{
+			name:     "unknown key",
+			template: "referenceName: Weekly\nsortOrder: DESC\n",
+			wantErr:  "field sortOrder not found",
+		},
+		{
+			name:     "incomplete recurrence",
+			template: "recurrence:\n  duration: P7D\n",
+			wantErr:  "recurrence requires startDate, duration, and rule",
+		},
+		{
+			name:     "localization without name",
+			template: "localizations:\n  - locale: en-US\n",
+			wantErr:  "localizations[0]: name is required",
+		},
+		{
+			name:     "localizations with v2",
+			template: recurringWeeklyTemplate,
+			args:     []string{"--v2"},
+			wantErr:  "only supported for v1 leaderboards",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			templatePath := writeLeaderboardTemplate(t, test.template)
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			args := append([]string{"game-center", "leaderboards", "create", "--app", "app-1", "--template", templatePath}, test.args...)
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
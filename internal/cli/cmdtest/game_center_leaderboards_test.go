@@ -44,6 +44,10 @@ func TestGameCenterLeaderboardsCreateValidationErrors(t *testing.T) {
 			name: "app and group",
 			args: []string{"game-center", "leaderboards", "create", "--app", "APP_ID", "--group-id", "GROUP_ID", "--reference-name", "Test", "--vendor-id", "com.test", "--formatter", "INTEGER", "--sort", "DESC", "--submission-type", "BEST_SCORE"},
 		},
+		{
+			name: "gc-detail-id and group",
+			args: []string{"game-center", "leaderboards", "create", "--gc-detail-id", "DETAIL_ID", "--group-id", "GROUP_ID", "--reference-name", "Test", "--vendor-id", "grp.com.test", "--formatter", "INTEGER", "--sort", "DESC", "--submission-type", "BEST_SCORE"},
+		},
 		{
 			name: "group vendor prefix",
 			args: []string{"game-center", "leaderboards", "create", "--group-id", "GROUP_ID", "--reference-name", "Test", "--vendor-id", "com.test", "--formatter", "INTEGER", "--sort", "DESC", "--submission-type", "BEST_SCORE"},
@@ -0,0 +1,241 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGameCenterLeaderboardGetAndLinkValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "leaderboard image get missing id",
+			args:    []string{"game-center", "leaderboards", "images", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "leaderboard release get missing id",
+			args:    []string{"game-center", "leaderboards", "releases", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "group leaderboard set missing id",
+			args:    []string{"game-center", "leaderboards", "group-leaderboard", "set", "--group-leaderboard-id", "GROUP_LB"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "group leaderboard set missing group id",
+			args:    []string{"game-center", "leaderboards", "group-leaderboard", "set", "--id", "LB_ID"},
+			wantErr: "--group-leaderboard-id is required",
+		},
+		{
+			name:    "leaderboard set image get missing id",
+			args:    []string{"game-center", "leaderboard-sets", "images", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "leaderboard set release get missing id",
+			args:    []string{"game-center", "leaderboard-sets", "releases", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "achievement release get missing id",
+			args:    []string{"game-center", "achievements", "releases", "get"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "group achievement set missing group id",
+			args:    []string{"game-center", "achievements", "group-achievement", "set", "--id", "ACH_ID"},
+			wantErr: "--group-achievement-id is required",
+		},
+		{
+			name:    "group leaderboard set set missing group id",
+			args:    []string{"game-center", "leaderboard-sets", "group-leaderboard-set", "set", "--id", "SET_ID"},
+			wantErr: "--group-leaderboard-set-id is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestGameCenterLeaderboardGroupLeaderboardSetPatchesRelationship(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/gameCenterLeaderboards/LB_ID/relationships/groupLeaderboard" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		var payload struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.Type != "gameCenterLeaderboards" || payload.Data.ID != "GROUP_LB" {
+			t.Fatalf("unexpected payload: %s", body)
+		}
+		return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "leaderboards", "group-leaderboard", "set",
+			"--id", "LB_ID",
+			"--group-leaderboard-id", "GROUP_LB",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		LeaderboardID      string `json:"leaderboardId"`
+		GroupLeaderboardID string `json:"groupLeaderboardId"`
+		Updated            bool   `json:"updated"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.LeaderboardID != "LB_ID" || result.GroupLeaderboardID != "GROUP_LB" || !result.Updated {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestGameCenterLeaderboardSetReleasesGetFetchesRelease(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/gameCenterLeaderboardSetReleases/REL_ID" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"gameCenterLeaderboardSetReleases","id":"REL_ID","attributes":{"live":true}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "leaderboard-sets", "releases", "get", "--id", "REL_ID", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Data struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				Live bool `json:"live"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.Data.ID != "REL_ID" || !result.Data.Attributes.Live {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+}
+
+func TestGameCenterAchievementGroupAchievementSetPatchesRelationship(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/gameCenterAchievements/ACH_ID/relationships/groupAchievement" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if !strings.Contains(string(body), `"id":"GROUP_ACH"`) || !strings.Contains(string(body), `"type":"gameCenterAchievements"`) {
+			t.Fatalf("unexpected payload: %s", body)
+		}
+		return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "achievements", "group-achievement", "set",
+			"--id", "ACH_ID",
+			"--group-achievement-id", "GROUP_ACH",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		AchievementID      string `json:"achievementId"`
+		GroupAchievementID string `json:"groupAchievementId"`
+		Updated            bool   `json:"updated"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.AchievementID != "ACH_ID" || result.GroupAchievementID != "GROUP_ACH" || !result.Updated {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
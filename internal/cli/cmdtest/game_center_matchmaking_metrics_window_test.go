@@ -0,0 +1,106 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGameCenterMatchmakingMetricsSincePicksGranularityAndFilters(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/gameCenterMatchmakingQueues/queue-1/metrics/matchmakingRequests" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		if got := req.URL.Query().Get("granularity"); got != "PT15M" {
+			t.Fatalf("expected PT15M granularity, got %q", got)
+		}
+		return matchmakingWatchJSONResponse(matchmakingWatchMetricsBody), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "matchmaking", "metrics", "queue-requests",
+			"--queue-id", "queue-1",
+			"--group-by", "result",
+			"--since", "2026-10-15T10:15:00Z",
+			"--until", "2026-10-15T11:00:00Z",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var resp struct {
+		Data []struct {
+			DataPoints []struct {
+				Start string `json:"start"`
+			} `json:"dataPoints"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &resp); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(resp.Data) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(resp.Data))
+	}
+	for _, row := range resp.Data {
+		if len(row.DataPoints) != 1 || row.DataPoints[0].Start != "2026-10-15T10:15:00Z" {
+			t.Fatalf("expected only the 10:15 bucket, got %+v", row.DataPoints)
+		}
+	}
+}
+
+func TestGameCenterMatchmakingMetricsRejectsInvalidWindow(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "details", "metrics", "classic-matchmaking",
+			"--id", "DETAIL_ID",
+			"--since", "last week",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected usage error, got %v", err)
+		}
+	})
+}
+
+func TestGameCenterMatchmakingMetricsRequiresGranularityOrSince(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "matchmaking", "metrics", "rule-errors", "--rule-id", "RULE_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--granularity or --since is required") {
+		t.Fatalf("expected granularity error, got %q", stderr)
+	}
+}
@@ -0,0 +1,302 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestGameCenterMatchmakingRuleSetTestsValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "validate missing target",
+			args:    []string{"game-center", "matchmaking", "rule-set-tests", "validate"},
+			wantErr: "--rule-set-id or --expression is required",
+		},
+		{
+			name:    "simulate missing rule set",
+			args:    []string{"game-center", "matchmaking", "rule-set-tests", "simulate", "--file", "requests.yaml"},
+			wantErr: "--rule-set-id is required",
+		},
+		{
+			name:    "simulate missing file",
+			args:    []string{"game-center", "matchmaking", "rule-set-tests", "simulate", "--rule-set-id", "RULE_SET_ID"},
+			wantErr: "--file is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestGameCenterMatchmakingRuleSetTestsValidateUsageErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"game-center", "matchmaking", "rule-set-tests", "validate", "--rule-set-id", "RS", "--expression", "a > 1"},
+		{"game-center", "matchmaking", "rule-set-tests", "validate", "--expression", "a > 1", "--type", "RANKED"},
+		{"game-center", "matchmaking", "rule-set-tests", "validate", "--rule-set-id", "RS", "--type", "MATCH"},
+	} {
+		var code int
+		_, stderr := captureOutput(t, func() {
+			code = cmd.Run(args, "1.2.3")
+		})
+		if code != cmd.ExitUsage {
+			t.Fatalf("%v: exit code = %d, want %d; stderr=%q", args, code, cmd.ExitUsage, stderr)
+		}
+	}
+}
+
+type matchmakingLintOutput struct {
+	Errors   int `json:"errors"`
+	Warnings int `json:"warnings"`
+	Rules    []struct {
+		ID         string   `json:"id"`
+		Expression string   `json:"expression"`
+		Properties []string `json:"properties"`
+		Issues     []struct {
+			Severity string `json:"severity"`
+			Column   int    `json:"column"`
+			Message  string `json:"message"`
+		} `json:"issues"`
+	} `json:"rules"`
+}
+
+func TestGameCenterMatchmakingRuleSetTestsValidateExpression(t *testing.T) {
+	var code int
+	stdout, _ := captureOutput(t, func() {
+		code = cmd.Run([]string{"game-center", "matchmaking", "rule-set-tests", "validate", "--expression", "avg(requests[].properties.skill) > 10", "--type", "match", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d", code, cmd.ExitSuccess)
+	}
+
+	var payload matchmakingLintOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Errors != 0 || payload.Warnings != 0 || len(payload.Rules) != 1 {
+		t.Fatalf("unexpected report: %+v", payload)
+	}
+	if props := payload.Rules[0].Properties; len(props) != 1 || props[0] != "skill" {
+		t.Fatalf("expected skill property, got %v", props)
+	}
+}
+
+const matchmakingSampleRequests = `bundleId: com.example.game
+appVersion: "1.0"
+platform: ios
+requests:
+  - name: alice
+    secondsInQueue: 5
+    locale: en_us
+    properties:
+      skill: 10
+  - name: party
+    secondsInQueue: 20
+    location: {latitude: 37.33, longitude: -122.01}
+    minPlayers: 2
+    maxPlayers: 4
+    players:
+      - id: bob
+        properties: {skill: 12, mode: ranked}
+      - id: carol
+        properties: {skill: 9}
+`
+
+func TestGameCenterMatchmakingRuleSetTestsValidateRuleSet(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	requestsPath := filepath.Join(t.TempDir(), "requests.yaml")
+	if err := os.WriteFile(requestsPath, []byte(matchmakingSampleRequests), 0o600); err != nil {
+		t.Fatalf("write requests: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/gameCenterMatchmakingRuleSets/RS/rules" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return gameCenterJSONResponse(http.StatusOK, `{"data":[
+			{"type":"gameCenterMatchmakingRules","id":"rule-1","attributes":{"referenceName":"skill","type":"COMPATIBLE","expression":"abs(requests[0].properties.skill - requests[1].properties.skill) < 5"}},
+			{"type":"gameCenterMatchmakingRules","id":"rule-2","attributes":{"referenceName":"region","type":"MATCH","expression":"requests[0].properties.region == requests[1].properties.region"}},
+			{"type":"gameCenterMatchmakingRules","id":"rule-3","attributes":{"referenceName":"broken","type":"MATCH","expression":"requests[0].locale = 'EN-US'"}}
+		],"links":{}}`)
+	})
+
+	var code int
+	stdout, _ := captureOutput(t, func() {
+		code = cmd.Run([]string{"game-center", "matchmaking", "rule-set-tests", "validate", "--rule-set-id", "RS", "--requests", requestsPath, "--output", "json"}, "1.2.3")
+	})
+	if code == cmd.ExitSuccess {
+		t.Fatal("expected a non-zero exit code for a syntax error")
+	}
+
+	var payload matchmakingLintOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Errors != 1 || payload.Warnings != 1 || len(payload.Rules) != 3 {
+		t.Fatalf("unexpected report: %+v", payload)
+	}
+	if issues := payload.Rules[0].Issues; len(issues) != 0 {
+		t.Fatalf("expected skill rule to pass, got %+v", issues)
+	}
+	if issues := payload.Rules[1].Issues; len(issues) != 1 || issues[0].Severity != "warning" || !strings.Contains(issues[0].Message, `"region"`) {
+		t.Fatalf("expected missing sample property warning, got %+v", issues)
+	}
+	if issues := payload.Rules[2].Issues; len(issues) != 1 || issues[0].Severity != "error" || issues[0].Column != 20 {
+		t.Fatalf("expected syntax error at column 20, got %+v", issues)
+	}
+}
+
+func TestGameCenterMatchmakingRuleSetTestsSimulateBuildsPayload(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	requestsPath := filepath.Join(t.TempDir(), "requests.yaml")
+	if err := os.WriteFile(requestsPath, []byte(matchmakingSampleRequests), 0o600); err != nil {
+		t.Fatalf("write requests: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var sent map[string]any
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/gameCenterMatchmakingRuleSetTests" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return gameCenterJSONResponse(http.StatusCreated, `{"data":{"type":"gameCenterMatchmakingRuleSetTests","id":"test-1","attributes":{"matchmakingResults":[]}}}`)
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"game-center", "matchmaking", "rule-set-tests", "simulate", "--rule-set-id", "RS", "--file", requestsPath, "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+	if !strings.Contains(stdout, `"test-1"`) {
+		t.Fatalf("expected test response in stdout, got %q", stdout)
+	}
+
+	var body struct {
+		Data struct {
+			Relationships struct {
+				MatchmakingRuleSet struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"matchmakingRuleSet"`
+				MatchmakingRequests struct {
+					Data []struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"matchmakingRequests"`
+			} `json:"relationships"`
+		} `json:"data"`
+		Included []struct {
+			Type       string         `json:"type"`
+			ID         string         `json:"id"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"included"`
+	}
+	raw, _ := json.Marshal(sent)
+	if err := json.Unmarshal(raw, &body); err != nil {
+		t.Fatalf("parse body: %v", err)
+	}
+	if body.Data.Relationships.MatchmakingRuleSet.Data.ID != "RS" || len(body.Data.Relationships.MatchmakingRequests.Data) != 2 {
+		t.Fatalf("unexpected relationships: %s", raw)
+	}
+	if len(body.Included) != 5 {
+		t.Fatalf("expected 3 players and 2 requests included, got %s", raw)
+	}
+
+	alicePlayer := body.Included[0]
+	if alicePlayer.Type != "gameCenterMatchmakingTestPlayerProperties" || alicePlayer.Attributes["playerId"] != "alice" {
+		t.Fatalf("expected properties shorthand to become a player, got %+v", alicePlayer)
+	}
+	properties, _ := alicePlayer.Attributes["properties"].([]any)
+	if len(properties) != 1 || properties[0].(map[string]any)["value"] != "10" {
+		t.Fatalf("expected skill=10 as a string property, got %v", alicePlayer.Attributes["properties"])
+	}
+	aliceRequest := body.Included[1]
+	if aliceRequest.Type != "gameCenterMatchmakingTestRequests" || aliceRequest.Attributes["platform"] != "IOS" || aliceRequest.Attributes["locale"] != "EN-US" || aliceRequest.Attributes["bundleId"] != "com.example.game" {
+		t.Fatalf("unexpected request attributes: %+v", aliceRequest)
+	}
+	if partyRequest := body.Included[4]; partyRequest.Attributes["maxPlayers"] != float64(4) || partyRequest.Attributes["location"] == nil {
+		t.Fatalf("unexpected party request: %+v", partyRequest)
+	}
+}
+
+func TestGameCenterMatchmakingRuleSetTestsSimulateRejectsInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "no requests", content: "bundleId: com.example.game\n", wantErr: "requests is required"},
+		{name: "missing app version", content: "bundleId: b\nplatform: IOS\nrequests:\n  - name: a\n", wantErr: "appVersion is required"},
+		{name: "bad locale", content: "bundleId: b\nappVersion: '1'\nplatform: IOS\nrequests:\n  - name: a\n    locale: xx-YY\n", wantErr: "unsupported locale"},
+		{name: "duplicate name", content: "bundleId: b\nappVersion: '1'\nplatform: IOS\nrequests:\n  - name: a\n  - name: a\n", wantErr: `duplicate name "a"`},
+		{name: "unknown field", content: "bundleId: b\nappVersion: '1'\nplatform: IOS\nrequests:\n  - name: a\n    queueTime: 3\n", wantErr: "queueTime"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(test.name, " ", "-")+".yaml")
+			if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+			var code int
+			_, stderr := captureOutput(t, func() {
+				code = cmd.Run([]string{"game-center", "matchmaking", "rule-set-tests", "simulate", "--rule-set-id", "RS", "--file", path}, "1.2.3")
+			})
+			if code != cmd.ExitUsage || !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("code = %d, stderr = %q; want usage error containing %q", code, stderr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func matchmakingWatchJSONResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+const matchmakingWatchMetricsBody = `{
+	"data":[
+		{"dataPoints":[
+			{"start":"2026-10-15T10:00:00Z","end":"2026-10-15T10:15:00Z","values":{"count":90}},
+			{"start":"2026-10-15T10:15:00Z","end":"2026-10-15T10:30:00Z","values":{"count":60}}
+		],"dimensions":{"result":{"data":{"id":"MATCHED"}}}},
+		{"dataPoints":[
+			{"start":"2026-10-15T10:00:00Z","end":"2026-10-15T10:15:00Z","values":{"count":5}},
+			{"start":"2026-10-15T10:15:00Z","end":"2026-10-15T10:30:00Z","values":{"count":30}}
+		],"dimensions":{"result":{"data":{"id":"EXPIRED"}}}},
+		{"dataPoints":[
+			{"start":"2026-10-15T10:15:00Z","end":"2026-10-15T10:30:00Z","values":{"count":10}}
+		],"dimensions":{"result":{"data":{"id":"CANCELED"}}}}
+	],
+	"links":{}
+}`
+
+func TestGameCenterMatchmakingWatchValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing queue-id",
+			args:    []string{"game-center", "matchmaking", "watch", "--alert-expired-rate", "0.2"},
+			wantErr: "--queue-id is required",
+		},
+		{
+			name:    "missing thresholds",
+			args:    []string{"game-center", "matchmaking", "watch", "--queue-id", "queue-1"},
+			wantErr: "at least one of --alert-expired-rate or --alert-canceled-rate is required",
+		},
+		{
+			name:    "rate out of range",
+			args:    []string{"game-center", "matchmaking", "watch", "--queue-id", "queue-1", "--alert-expired-rate", "1.5"},
+			wantErr: "--alert-expired-rate must be between 0 and 1",
+		},
+		{
+			name:    "invalid poll",
+			args:    []string{"game-center", "matchmaking", "watch", "--queue-id", "queue-1", "--alert-expired-rate", "0.2", "--poll", "0s"},
+			wantErr: "--poll must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestGameCenterMatchmakingWatchAlertsOnLatestBucket(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/gameCenterMatchmakingQueues/queue-1/metrics/matchmakingRequests" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("granularity") != "PT15M" || query.Get("groupBy") != "result" {
+			t.Fatalf("unexpected query %q", req.URL.RawQuery)
+		}
+		return matchmakingWatchJSONResponse(matchmakingWatchMetricsBody), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "matchmaking", "watch",
+			"--queue-id", "queue-1",
+			"--alert-expired-rate", "0.2",
+			"--alert-canceled-rate", "0.5",
+			"--max-polls", "1",
+			"--exit-on-alert",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "expired rate 0.30 exceeds 0.20") {
+		t.Fatalf("expected expired rate alert error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "Alert: queue queue-1: expired rate 0.30 exceeds 0.20 (30 of 100 requests)") {
+		t.Fatalf("expected alert on stderr, got %q", stderr)
+	}
+	if strings.Contains(stderr, "canceled rate") {
+		t.Fatalf("expected no canceled alert, got %q", stderr)
+	}
+
+	var snapshot struct {
+		QueueID     string   `json:"queueId"`
+		BucketStart string   `json:"bucketStart"`
+		Total       int      `json:"total"`
+		Matched     int      `json:"matched"`
+		Canceled    int      `json:"canceled"`
+		Expired     int      `json:"expired"`
+		ExpiredRate float64  `json:"expiredRate"`
+		Alerts      []string `json:"alerts"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &snapshot); err != nil {
+		t.Fatalf("parse snapshot: %v (%q)", err, stdout)
+	}
+	if snapshot.QueueID != "queue-1" || snapshot.BucketStart != "2026-10-15T10:15:00Z" {
+		t.Fatalf("unexpected snapshot: %+v", snapshot)
+	}
+	if snapshot.Total != 100 || snapshot.Matched != 60 || snapshot.Expired != 30 || snapshot.Canceled != 10 {
+		t.Fatalf("unexpected counts: %+v", snapshot)
+	}
+	if snapshot.ExpiredRate != 0.3 || len(snapshot.Alerts) != 1 {
+		t.Fatalf("unexpected rates/alerts: %+v", snapshot)
+	}
+}
+
+func TestGameCenterMatchmakingWatchQuietKeepsAlertsInOutputOnly(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return matchmakingWatchJSONResponse(matchmakingWatchMetricsBody), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"--quiet",
+			"game-center", "matchmaking", "watch",
+			"--queue-id", "queue-1",
+			"--alert-expired-rate", "0.2",
+			"--max-polls", "1",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.Contains(stderr, "Alert:") {
+		t.Fatalf("expected --quiet to suppress the alert line, got %q", stderr)
+	}
+	if !strings.Contains(stdout, "expired rate 0.30 exceeds 0.20") {
+		t.Fatalf("expected the alert in the snapshot output, got %q", stdout)
+	}
+}
+
+func TestGameCenterMatchmakingWatchSkipsSmallBuckets(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return matchmakingWatchJSONResponse(matchmakingWatchMetricsBody), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "matchmaking", "watch",
+			"--queue-id", "queue-1",
+			"--alert-expired-rate", "0.2",
+			"--min-requests", "500",
+			"--max-polls", "1",
+			"--exit-on-alert",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected no alerts, got %q", stderr)
+	}
+}
@@ -0,0 +1,237 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestGameCenterMoveValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"game-center", "move", "--to-group", "GROUP_ID", "--dry-run"},
+			wantErr: "--from-app is required",
+		},
+		{
+			name:    "missing group",
+			args:    []string{"game-center", "move", "--from-app", "APP_ID", "--dry-run"},
+			wantErr: "--to-group is required",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"game-center", "move", "--from-app", "APP_ID", "--to-group", "GROUP_ID"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "dry-run with confirm",
+			args:    []string{"game-center", "move", "--from-app", "APP_ID", "--to-group", "GROUP_ID", "--dry-run", "--confirm"},
+			wantErr: "mutually exclusive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func gameCenterMoveTransport(t *testing.T, patched *bool, groupLeaderboards string) roundTripFunc {
+	t.Helper()
+	return func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/gameCenterDetail":
+			body = `{"data":{"type":"gameCenterDetails","id":"detail-1"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterDetails/detail-1/gameCenterGroup":
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterDetails/detail-1/gameCenterAchievements":
+			body = `{"data":[{"type":"gameCenterAchievements","id":"ach-1","attributes":{"referenceName":"First Win","vendorIdentifier":"com.example.firstwin"}}],"links":{}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterDetails/detail-1/gameCenterLeaderboards":
+			body = `{"data":[{"type":"gameCenterLeaderboards","id":"lb-1","attributes":{"referenceName":"High Score","vendorIdentifier":"grp.com.example.high"}}],"links":{}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/gameCenterDetails/detail-1":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"gameCenterGroup":{"data":{"type":"gameCenterGroups","id":"GROUP_ID"}}`) {
+				t.Fatalf("expected group relationship in body, got %s", payload)
+			}
+			*patched = true
+			body = `{"data":{"type":"gameCenterDetails","id":"detail-1"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterGroups/GROUP_ID/gameCenterAchievements":
+			body = `{"data":[{"type":"gameCenterAchievements","id":"ach-1","attributes":{"vendorIdentifier":"grp.com.example.firstwin"}}],"links":{}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/gameCenterGroups/GROUP_ID/gameCenterLeaderboards":
+			body = `{"data":[` + groupLeaderboards + `],"links":{}}`
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	}
+}
+
+type gameCenterMoveOutput struct {
+	DetailID string `json:"detailId"`
+	DryRun   bool   `json:"dryRun"`
+	Items    []struct {
+		Type                  string `json:"type"`
+		GroupVendorIdentifier string `json:"groupVendorIdentifier"`
+		Migrated              *bool  `json:"migrated"`
+	} `json:"items"`
+}
+
+func TestGameCenterMoveDryRunPlansGroupVendorIDs(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patched := false
+	http.DefaultTransport = gameCenterMoveTransport(t, &patched, "")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "move", "--from-app", "APP_ID", "--to-group", "GROUP_ID", "--dry-run", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if patched {
+		t.Fatal("expected dry run not to patch the detail")
+	}
+	var payload gameCenterMoveOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if !payload.DryRun || len(payload.Items) != 2 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if payload.Items[0].GroupVendorIdentifier != "grp.com.example.firstwin" {
+		t.Fatalf("expected grp. prefix to be added, got %q", payload.Items[0].GroupVendorIdentifier)
+	}
+	if payload.Items[1].GroupVendorIdentifier != "grp.com.example.high" {
+		t.Fatalf("expected existing grp. prefix to be kept, got %q", payload.Items[1].GroupVendorIdentifier)
+	}
+	if payload.Items[0].Migrated != nil {
+		t.Fatal("expected migrated to be omitted in dry run")
+	}
+}
+
+func TestGameCenterMoveConfirmAssociatesGroupAndVerifies(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patched := false
+	http.DefaultTransport = gameCenterMoveTransport(t, &patched, `{"type":"gameCenterLeaderboards","id":"lb-1","attributes":{"vendorIdentifier":"grp.com.example.high"}}`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"game-center", "move", "--from-app", "APP_ID", "--to-group", "GROUP_ID", "--confirm", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !patched {
+		t.Fatal("expected detail to be patched with the target group")
+	}
+	var payload gameCenterMoveOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Items) != 2 || payload.Items[0].Migrated == nil || payload.Items[1].Migrated == nil {
+		t.Fatalf("expected migrated flags, got %+v", payload.Items)
+	}
+	if !*payload.Items[0].Migrated || !*payload.Items[1].Migrated {
+		t.Fatalf("expected both items to be verified in group, got %+v", payload.Items)
+	}
+}
+
+func TestGameCenterMoveConfirmFailsWhenItemsAreNotMigrated(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patched := false
+	http.DefaultTransport = gameCenterMoveTransport(t, &patched, "")
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"game-center", "move", "--from-app", "APP_ID", "--to-group", "GROUP_ID", "--confirm", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitError {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitError, stderr)
+	}
+	if !strings.Contains(stderr, "1 item(s) not found in group GROUP_ID") || !strings.Contains(stderr, "lb-1") {
+		t.Fatalf("expected unmigrated IDs in stderr, got %q", stderr)
+	}
+	if strings.Contains(stderr, "ach-1") {
+		t.Fatalf("expected only unmigrated IDs in stderr, got %q", stderr)
+	}
+
+	var payload gameCenterMoveOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Items) != 2 || payload.Items[1].Migrated == nil || *payload.Items[1].Migrated {
+		t.Fatalf("expected leaderboard reported as not migrated, got %+v", payload.Items)
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
 )
 
 func TestIAPPricesValidationErrors(t *testing.T) {
@@ -56,6 +60,43 @@ func TestIAPPricesValidationErrors(t *testing.T) {
 	}
 }
 
+func TestIAPPricesByIDIgnoresProjectApp(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	os.Unsetenv("ASC_APP_ID")
+
+	project := t.TempDir()
+	if err := os.WriteFile(filepath.Join(project, ".asc.yaml"), []byte("app: \"999\"\n"), 0o600); err != nil {
+		t.Fatalf("write project config: %v", err)
+	}
+	t.Chdir(project)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var paths []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	_, stderr := captureOutput(t, func() {
+		_ = cmd.Run([]string{"iap", "pricing", "summary", "--iap-id", "iap-1"}, "1.2.3")
+	})
+	if strings.Contains(stderr, "mutually exclusive") {
+		t.Fatalf("expected project app not to conflict with --iap-id, got %q", stderr)
+	}
+	if len(paths) == 0 || paths[0] != "/v2/inAppPurchases/iap-1" {
+		t.Fatalf("expected lookup by IAP ID, got %v", paths)
+	}
+}
+
 func TestIAPPricesByIDSuccess(t *testing.T) {
 	setupAuth(t)
 
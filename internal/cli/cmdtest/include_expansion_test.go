@@ -0,0 +1,75 @@
+package cmdtest
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestAppsListIncludeJoinsTableColumns(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("include"); got != "ciProduct" {
+			t.Fatalf("include = %q, want ciProduct", got)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[
+			{"type":"apps","id":"app-1","attributes":{"name":"One","bundleId":"com.example.one","sku":"one"},
+			 "relationships":{"ciProduct":{"data":{"type":"ciProducts","id":"ci-1"}}}}
+		],"included":[
+			{"type":"ciProducts","id":"ci-1","attributes":{"name":"One Workflows"}}
+		],"links":{}}`)
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"apps", "list", "--include", "ciProduct", "--output", "table"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+	if !strings.Contains(stdout, "Ci Product") || !strings.Contains(stdout, "One Workflows") {
+		t.Fatalf("expected joined ciProduct column, got %q", stdout)
+	}
+}
+
+func TestIncludeRejectsUnknownRelationship(t *testing.T) {
+	tests := [][]string{
+		{"apps", "list", "--include", "nope"},
+		{"apps", "get", "--id", "app-1", "--include", "nope"},
+		{"builds", "list", "--app", "app-1", "--include", "nope"},
+		{"builds", "info", "--build", "build-1", "--include", "nope"},
+		{"versions", "list", "--app", "app-1", "--include", "nope"},
+		{"app-clips", "list", "--app", "app-1", "--include", "nope"},
+		{"app-clips", "get", "--id", "clip-1", "--include", "nope"},
+		{"game-center", "achievements", "list", "--app", "app-1", "--include", "nope"},
+		{"game-center", "leaderboards", "list", "--app", "app-1", "--include", "nope"},
+	}
+
+	for _, args := range tests {
+		t.Run(strings.Join(args[:len(args)-2], " "), func(t *testing.T) {
+			var code int
+			_, stderr := captureOutput(t, func() {
+				code = cmd.Run(args, "1.2.3")
+			})
+			if code != cmd.ExitUsage {
+				t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+			}
+			if !strings.Contains(stderr, "--include must be one of") {
+				t.Fatalf("expected --include error, got %q", stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestLocalizationsTranslateWritesDraftsForReview(t *testing.T) {
+	setupAuth(t)
+	outputDir := filepath.Join(t.TempDir(), "drafts")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/version-1/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if got := req.URL.Query().Get("filter[locale]"); got != "en-US" {
+			t.Fatalf("expected filter[locale]=en-US, got %q", got)
+		}
+		body := `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US","description":"A great app","whatsNew":"Bug fixes","supportUrl":"https://example.com"}}],"links":{}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"localizations", "translate",
+			"--version", "version-1",
+			"--source", "en-US",
+			"--targets", "de-DE,fr-FR",
+			"--exec", `printf '%s:%s:' "$ASC_TARGET_LOCALE" "$ASC_LOCALIZATION_FIELD"; cat`,
+			"--path", outputDir,
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		SourceLocale   string   `json:"sourceLocale"`
+		ReviewRequired bool     `json:"reviewRequired"`
+		Fields         []string `json:"fields"`
+		Succeeded      int      `json:"succeeded"`
+		Items          []struct {
+			Item   string `json:"item"`
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if !result.ReviewRequired || result.Succeeded != 2 || len(result.Items) != 2 || strings.Join(result.Fields, ",") != "description,whatsNew" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Items[0].Item != "de-DE" || result.Items[0].ID != filepath.Join(outputDir, "de-DE.strings") {
+		t.Fatalf("unexpected first item: %+v", result.Items[0])
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "de-DE.strings"))
+	if err != nil {
+		t.Fatalf("read draft: %v", err)
+	}
+	content := string(data)
+	if !strings.HasPrefix(content, "/* DRAFT: machine-translated from en-US") {
+		t.Fatalf("expected draft header, got: %s", content)
+	}
+	if !strings.Contains(content, `"whatsNew" = "de-DE:whatsNew:Bug fixes";`) {
+		t.Fatalf("expected translated whatsNew, got: %s", content)
+	}
+	if strings.Contains(content, "supportUrl") {
+		t.Fatalf("expected URL fields to be skipped, got: %s", content)
+	}
+}
+
+func TestLocalizationsTranslateReportsFailedTargets(t *testing.T) {
+	setupAuth(t)
+	outputDir := filepath.Join(t.TempDir(), "drafts")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US","whatsNew":"Bug fixes"}}],"links":{}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{
+			"localizations", "translate",
+			"--version", "version-1",
+			"--source", "en-US",
+			"--targets", "de-DE,fr-FR",
+			"--exec", `if [ "$ASC_TARGET_LOCALE" = "fr-FR" ]; then echo "quota exceeded" >&2; exit 1; fi; cat`,
+			"--path", outputDir,
+			"--output", "json",
+		}, "1.2.3")
+	})
+	if code != cmd.ExitError {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitError, stderr)
+	}
+
+	var result struct {
+		Total     int `json:"total"`
+		Succeeded int `json:"succeeded"`
+		Failed    int `json:"failed"`
+		Items     []struct {
+			Item   string `json:"item"`
+			Status string `json:"status"`
+			Detail string `json:"detail"`
+			Error  string `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if result.Total != 2 || result.Succeeded != 1 || result.Failed != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	failed := result.Items[0]
+	if failed.Item != "fr-FR" || failed.Status != "failed" || failed.Detail != "whatsNew" || !strings.Contains(failed.Error, "quota exceeded") {
+		t.Fatalf("unexpected failed item: %+v", failed)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "de-DE.strings")); err != nil {
+		t.Fatalf("expected de-DE draft to be written: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "fr-FR.strings")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected no fr-FR draft, got err=%v", err)
+	}
+}
+
+func TestLocalizationsTranslateValidation(t *testing.T) {
+	setupAuth(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing source",
+			args:    []string{"--version", "v1", "--targets", "de-DE", "--exec", "cat"},
+			wantErr: "--source is required",
+		},
+		{
+			name:    "missing targets",
+			args:    []string{"--version", "v1", "--source", "en-US", "--exec", "cat"},
+			wantErr: "--targets is required",
+		},
+		{
+			name:    "missing exec",
+			args:    []string{"--version", "v1", "--source", "en-US", "--targets", "de-DE"},
+			wantErr: "--exec is required",
+		},
+		{
+			name:    "target equals source",
+			args:    []string{"--version", "v1", "--source", "en-US", "--targets", "en-US", "--exec", "cat"},
+			wantErr: "--targets must not include the source locale",
+		},
+		{
+			name:    "untranslatable field",
+			args:    []string{"--version", "v1", "--source", "en-US", "--targets", "de-DE", "--exec", "cat", "--fields", "supportUrl"},
+			wantErr: `--fields: "supportUrl" is not translatable`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(append([]string{"localizations", "translate"}, test.args...)); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
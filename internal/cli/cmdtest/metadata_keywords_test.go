@@ -133,6 +133,13 @@ func TestMetadataKeywordsImportJSONDryRun(t *testing.T) {
 			DuplicateCount    int      `json:"duplicateCount"`
 			SkippedDuplicates []string `json:"skippedDuplicates"`
 		} `json:"results"`
+		Total     int `json:"total"`
+		Succeeded int `json:"succeeded"`
+		Items     []struct {
+			Item   string `json:"item"`
+			Status string `json:"status"`
+			Detail string `json:"detail"`
+		} `json:"items"`
 	}
 	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
 		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
@@ -152,6 +159,12 @@ func TestMetadataKeywordsImportJSONDryRun(t *testing.T) {
 	if payload.Results[1].Locale != "fr-FR" || payload.Results[1].KeywordField != "journal d'humeur,habitudes" {
 		t.Fatalf("unexpected fr-FR result: %+v", payload.Results[1])
 	}
+	if payload.Total != 2 || payload.Succeeded != 2 || len(payload.Items) != 2 {
+		t.Fatalf("expected 2 planned bulk items, got %+v", payload)
+	}
+	if item := payload.Items[0]; item.Item != "en-US" || item.Status != "succeeded" || item.Detail != "would create" {
+		t.Fatalf("unexpected en-US bulk item: %+v", item)
+	}
 
 	path, err := filepath.Abs(filepath.Join(dir, "version", "1.2.3", "en-US.json"))
 	if err != nil {
@@ -209,6 +222,12 @@ func TestMetadataKeywordsImportDryRunReportsOverLimitIssue(t *testing.T) {
 			Action string `json:"action"`
 			Reason string `json:"reason"`
 		} `json:"results"`
+		Failed int `json:"failed"`
+		Items  []struct {
+			Item   string `json:"item"`
+			Status string `json:"status"`
+			Error  string `json:"error"`
+		} `json:"items"`
 	}
 	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
 		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
@@ -222,6 +241,9 @@ func TestMetadataKeywordsImportDryRunReportsOverLimitIssue(t *testing.T) {
 	if len(payload.Results) != 1 || payload.Results[0].Action != "invalid" || payload.Results[0].Reason != "keywords exceed 100 characters" {
 		t.Fatalf("unexpected result payload: %+v", payload.Results)
 	}
+	if payload.Failed != 1 || len(payload.Items) != 1 || payload.Items[0].Item != "en-US" || payload.Items[0].Status != "failed" || payload.Items[0].Error != "keywords exceed 100 characters" {
+		t.Fatalf("unexpected bulk items: %+v", payload)
+	}
 }
 
 func TestMetadataKeywordsImportTextCanonicalizesLocaleAlias(t *testing.T) {
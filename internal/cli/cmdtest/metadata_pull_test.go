@@ -40,7 +40,7 @@ func TestMetadataPullValidationErrors(t *testing.T) {
 		{
 			name:    "invalid include",
 			args:    []string{"metadata", "pull", "--app", "app-1", "--version", "1.2.3", "--dir", "./metadata", "--include", "screenshots"},
-			wantErr: "Error: --include supports only \"localizations\"",
+			wantErr: "Error: --include must be one of: localizations, categories, version-attributes, all",
 		},
 	}
 
@@ -623,3 +623,292 @@ func TestMetadataPullSupportsTableAndMarkdownOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataPullIncludeAllWritesCategoriesAndVersionAttributes(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	outputDir := filepath.Join(t.TempDir(), "metadata")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/apps/app-1/appStoreVersions":
+			body = `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}],"links":{"next":""}}`
+		case "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"appinfo-loc-1","attributes":{"locale":"en-US","name":"App Name"}}],"links":{"next":""}}`
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"version-loc-1","attributes":{"locale":"en-US","description":"English description"}}],"links":{"next":""}}`
+		case "/v1/appInfos/appinfo-1":
+			if got := req.URL.Query().Get("include"); !strings.Contains(got, "primaryCategory") || !strings.Contains(got, "secondarySubcategoryTwo") {
+				t.Fatalf("expected category relationships in include, got %q", got)
+			}
+			body = `{"data":{"type":"appInfos","id":"appinfo-1","attributes":{},"relationships":{
+				"primaryCategory":{"data":{"type":"appCategories","id":"GAMES"}},
+				"primarySubcategoryOne":{"data":{"type":"appCategories","id":"GAMES_PUZZLE"}},
+				"secondaryCategory":{"data":null}
+			}}}`
+		case "/v1/appStoreVersions/version-1":
+			body = `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","copyright":"2026 Example Inc.","releaseType":"MANUAL"}}}`
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "pull",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", outputDir,
+			"--include", "all",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Includes []string `json:"includes"`
+		Files    []string `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if !slices.Equal(payload.Includes, []string{"localizations", "categories", "version-attributes"}) {
+		t.Fatalf("unexpected includes: %v", payload.Includes)
+	}
+	if len(payload.Files) != 4 {
+		t.Fatalf("expected 4 files, got %v", payload.Files)
+	}
+
+	var categories map[string]string
+	data, err := os.ReadFile(filepath.Join(outputDir, "categories.json"))
+	if err != nil {
+		t.Fatalf("read categories.json: %v", err)
+	}
+	if err := json.Unmarshal(data, &categories); err != nil {
+		t.Fatalf("parse categories.json: %v", err)
+	}
+	want := map[string]string{"primaryCategory": "GAMES", "primarySubcategoryOne": "GAMES_PUZZLE"}
+	if len(categories) != len(want) || categories["primaryCategory"] != "GAMES" || categories["primarySubcategoryOne"] != "GAMES_PUZZLE" {
+		t.Fatalf("expected %v, got %v", want, categories)
+	}
+
+	var attrs map[string]string
+	data, err = os.ReadFile(filepath.Join(outputDir, "version-attributes", "1.2.3.json"))
+	if err != nil {
+		t.Fatalf("read version attributes: %v", err)
+	}
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		t.Fatalf("parse version attributes: %v", err)
+	}
+	if attrs["copyright"] != "2026 Example Inc." || attrs["releaseType"] != "MANUAL" {
+		t.Fatalf("unexpected version attributes: %v", attrs)
+	}
+}
+
+func TestMetadataPullCategoriesOnlySkipsLocalizations(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	outputDir := filepath.Join(t.TempDir(), "metadata")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/apps/app-1/appStoreVersions":
+			body = `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}],"links":{"next":""}}`
+		case "/v1/appInfos/appinfo-1":
+			body = `{"data":{"type":"appInfos","id":"appinfo-1","attributes":{},"relationships":{"primaryCategory":{"data":{"type":"appCategories","id":"UTILITIES"}}}}}`
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "pull",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", outputDir,
+			"--include", "categories",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Files []string `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(payload.Files) != 1 || payload.Files[0] != filepath.Join(outputDir, "categories.json") {
+		t.Fatalf("expected only categories.json, got %v", payload.Files)
+	}
+}
+
+func TestMetadataPullPushRoundTripsCategoriesAndVersionAttributes(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	outputDir := filepath.Join(t.TempDir(), "metadata")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patches := map[string]string{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appStoreVersions":
+			body = `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}],"links":{"next":""}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"appinfo-loc-1","attributes":{"locale":"en-US","name":"App Name"}}],"links":{"next":""}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"version-loc-1","attributes":{"locale":"en-US","description":"English description"}}],"links":{"next":""}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/appinfo-1":
+			body = `{"data":{"type":"appInfos","id":"appinfo-1","attributes":{},"relationships":{
+				"primaryCategory":{"data":{"type":"appCategories","id":"GAMES"}},
+				"primarySubcategoryOne":{"data":{"type":"appCategories","id":"GAMES_PUZZLE"}}
+			}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1":
+			body = `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","copyright":"2026 Example Inc.","releaseType":"MANUAL"}}}`
+		case req.Method == http.MethodPatch && (req.URL.Path == "/v1/appInfos/appinfo-1" || req.URL.Path == "/v1/appStoreVersions/version-1"):
+			payload, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			patches[req.URL.Path] = string(payload)
+			body = `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	run := func(args ...string) string {
+		t.Helper()
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		return stdout
+	}
+	type pushPlan struct {
+		Adds    []map[string]any `json:"adds"`
+		Updates []map[string]any `json:"updates"`
+		Actions []map[string]any `json:"actions"`
+	}
+	pushArgs := []string{"metadata", "push", "--app", "app-1", "--version", "1.2.3", "--dir", outputDir, "--include", "all", "--output", "json"}
+
+	run("metadata", "pull", "--app", "app-1", "--version", "1.2.3", "--dir", outputDir, "--include", "all", "--output", "json")
+
+	var unchanged pushPlan
+	stdout := run(append(pushArgs, "--dry-run")...)
+	if err := json.Unmarshal([]byte(stdout), &unchanged); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(unchanged.Adds) != 0 || len(unchanged.Updates) != 0 {
+		t.Fatalf("expected pulled files to push as a no-op, got %s", stdout)
+	}
+
+	if err := os.WriteFile(filepath.Join(outputDir, "categories.json"), []byte(`{"primaryCategory":"GAMES","primarySubcategoryOne":"GAMES_BOARD"}`), 0o644); err != nil {
+		t.Fatalf("write categories.json: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "version-attributes", "1.2.3.json"), []byte(`{"copyright":"2027 Example Inc.","releaseType":"MANUAL"}`), 0o644); err != nil {
+		t.Fatalf("write version attributes: %v", err)
+	}
+
+	var applied pushPlan
+	stdout = run(pushArgs...)
+	if err := json.Unmarshal([]byte(stdout), &applied); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(applied.Updates) != 2 || len(applied.Actions) != 2 {
+		t.Fatalf("expected two updates and two actions, got %s", stdout)
+	}
+
+	var categories struct {
+		Data struct {
+			Relationships map[string]struct {
+				Data struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(patches["/v1/appInfos/appinfo-1"]), &categories); err != nil {
+		t.Fatalf("parse categories patch %q: %v", patches["/v1/appInfos/appinfo-1"], err)
+	}
+	if categories.Data.Relationships["primaryCategory"].Data.ID != "GAMES" || categories.Data.Relationships["primarySubcategoryOne"].Data.ID != "GAMES_BOARD" {
+		t.Fatalf("unexpected categories patch: %s", patches["/v1/appInfos/appinfo-1"])
+	}
+
+	var version struct {
+		Data struct {
+			Attributes map[string]string `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(patches["/v1/appStoreVersions/version-1"]), &version); err != nil {
+		t.Fatalf("parse version patch %q: %v", patches["/v1/appStoreVersions/version-1"], err)
+	}
+	if len(version.Data.Attributes) != 1 || version.Data.Attributes["copyright"] != "2027 Example Inc." {
+		t.Fatalf("expected only the changed copyright in the version patch, got %s", patches["/v1/appStoreVersions/version-1"])
+	}
+}
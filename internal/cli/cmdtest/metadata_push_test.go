@@ -2,9 +2,11 @@ package cmdtest
 
 import (
 	"context"
+	"crypto/md5"
 	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -1632,3 +1634,249 @@ func TestMetadataPushSupportsTableAndMarkdownOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataPushDryRunReadsFastlaneLayout(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en-US"), 0o755); err != nil {
+		t.Fatalf("mkdir en-US: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "en-US", "subtitle.txt"), []byte("Local subtitle\n"), 0o644); err != nil {
+		t.Fatalf("write subtitle.txt: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "en-US", "release_notes.txt"), []byte("Bug fixes\n"), 0o644); err != nil {
+		t.Fatalf("write release_notes.txt: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected dry-run to use GET only, got %s %s", req.Method, req.URL.Path)
+		}
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/apps/app-1/appStoreVersions":
+			body = `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}],"links":{"next":""}}`
+		case "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"loc-app-1","attributes":{"locale":"en-US","name":"App Name","subtitle":"Remote subtitle"}}],"links":{"next":""}}`
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-ver-1","attributes":{"locale":"en-US","description":"Remote description"}}],"links":{"next":""}}`
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "push",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", dir,
+			"--dry-run",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Adds []struct {
+			Field string `json:"field"`
+			To    string `json:"to"`
+		} `json:"adds"`
+		Updates []struct {
+			Field string `json:"field"`
+			To    string `json:"to"`
+		} `json:"updates"`
+		Deletes []struct {
+			Key string `json:"key"`
+		} `json:"deletes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+
+	if len(payload.Updates) != 1 || payload.Updates[0].Field != "subtitle" || payload.Updates[0].To != "Local subtitle" {
+		t.Fatalf("expected subtitle update, got %+v", payload.Updates)
+	}
+	if len(payload.Adds) != 1 || payload.Adds[0].Field != "whatsNew" || payload.Adds[0].To != "Bug fixes" {
+		t.Fatalf("expected whatsNew add, got %+v", payload.Adds)
+	}
+	if len(payload.Deletes) != 0 {
+		t.Fatalf("expected no deletes, got %+v", payload.Deletes)
+	}
+}
+
+func TestMetadataPushDryRunDiffsAssetChecksums(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	writeAsset := func(path, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write asset: %v", err)
+		}
+	}
+	writeAsset(filepath.Join(dir, "screenshots", "en-US", "IPHONE_65", "01-home.png"), "home")
+	writeAsset(filepath.Join(dir, "screenshots", "en-US", "IPHONE_65", "02-settings.png"), "settings v2")
+	writeAsset(filepath.Join(dir, "previews", "de-DE", "IPHONE_65", "intro.mp4"), "intro video")
+
+	homeChecksum := fmt.Sprintf("%x", md5.Sum([]byte("home")))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected dry-run to avoid mutations, got %s %s", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appStoreVersions":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}],"links":{"next":""}}`)
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}],"links":{"next":""}}`)
+		case "/v1/appStoreVersionLocalizations/loc-en/appScreenshotSets":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"appScreenshotSets","id":"set-65","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}},
+				{"type":"appScreenshotSets","id":"set-67","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}
+			]}`)
+		case "/v1/appScreenshotSets/set-65/appScreenshots":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"appScreenshots","id":"shot-home","attributes":{"fileName":"home.png","fileSize":4,"sourceFileChecksum":"`+homeChecksum+`"}},
+				{"type":"appScreenshots","id":"shot-old","attributes":{"fileName":"settings.png","fileSize":8,"sourceFileChecksum":"stale"}}
+			]}`)
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "push",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", dir,
+			"--include", "screenshots,previews",
+			"--dry-run",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Includes []string `json:"includes"`
+		Adds     []any    `json:"adds"`
+		Assets   struct {
+			Uploads     int   `json:"uploads"`
+			Unchanged   int   `json:"unchanged"`
+			RemoteOnly  int   `json:"remoteOnly"`
+			UploadBytes int64 `json:"uploadBytes"`
+			SavedBytes  int64 `json:"savedBytes"`
+			Items       []struct {
+				Kind     string `json:"kind"`
+				Locale   string `json:"locale"`
+				Type     string `json:"type"`
+				Action   string `json:"action"`
+				FileName string `json:"fileName"`
+				Checksum string `json:"checksum"`
+				AssetID  string `json:"assetId"`
+			} `json:"items"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if strings.Join(result.Includes, ",") != "screenshots,previews" || len(result.Adds) != 0 {
+		t.Fatalf("expected asset-only plan, got %s", stdout)
+	}
+	assets := result.Assets
+	if assets.Uploads != 2 || assets.Unchanged != 1 || assets.RemoteOnly != 1 {
+		t.Fatalf("unexpected asset counts: %s", stdout)
+	}
+	if assets.UploadBytes != int64(len("intro video")+len("settings v2")) || assets.SavedBytes != int64(len("home")) {
+		t.Fatalf("unexpected asset byte totals: %s", stdout)
+	}
+
+	got := make([]string, 0, len(assets.Items))
+	for _, item := range assets.Items {
+		got = append(got, strings.Join([]string{item.Action, item.Kind, item.Locale, item.Type, item.FileName, item.AssetID}, "|"))
+	}
+	want := []string{
+		"unchanged|screenshot|en-US|APP_IPHONE_65|01-home.png|shot-home",
+		"upload|screenshot|en-US|APP_IPHONE_65|02-settings.png|",
+		"remote-only|screenshot|en-US|APP_IPHONE_65|settings.png|shot-old",
+		"upload|preview|de-DE|IPHONE_65|intro.mp4|",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected asset items:\n%s", strings.Join(got, "\n"))
+	}
+	if assets.Items[0].Checksum != homeChecksum {
+		t.Fatalf("expected local checksum %q, got %q", homeChecksum, assets.Items[0].Checksum)
+	}
+}
+
+func TestMetadataPushAssetScopesRequireDryRun(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "push",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", t.TempDir(),
+			"--include", "localizations,screenshots",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "requires --dry-run") {
+		t.Fatalf("expected dry-run error, got %q", stderr)
+	}
+}
@@ -0,0 +1,62 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMockReplayServesRecordingsWithoutCredentials(t *testing.T) {
+	mockDir := t.TempDir()
+	t.Setenv("ASC_MOCK_DIR", mockDir)
+	t.Setenv("ASC_MOCK_MODE", "replay")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+
+	recording := `{"method":"GET","url":"/v1/apps/123","responses":[{"status":200,"contentType":"application/json","body":{"data":{"type":"apps","id":"123","attributes":{"name":"Demo","bundleId":"com.example.demo"}}}}]}`
+	if err := os.WriteFile(filepath.Join(mockDir, "GET_v1_apps_123.json"), []byte(recording), 0o600); err != nil {
+		t.Fatalf("write recording: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("replay must not reach the network: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "get", "--id", "123", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				BundleID string `json:"bundleId"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if payload.Data.ID != "123" || payload.Data.Attributes.BundleID != "com.example.demo" {
+		t.Fatalf("unexpected replayed app: %+v", payload)
+	}
+}
@@ -51,8 +51,8 @@ func TestNominationsValidationErrors(t *testing.T) {
 		},
 		{
 			name:    "nominations create invalid publish date",
-			args:    []string{"nominations", "create", "--app", "APP_ID", "--name", "Launch", "--type", "APP_LAUNCH", "--description", "desc", "--submitted=false", "--publish-start-date", "2026-02-01"},
-			wantErr: "--publish-start-date must be in RFC3339 format",
+			args:    []string{"nominations", "create", "--app", "APP_ID", "--name", "Launch", "--type", "APP_LAUNCH", "--description", "desc", "--submitted=false", "--publish-start-date", "02/01/2026"},
+			wantErr: "--publish-start-date must be YYYY-MM-DD or RFC3339",
 		},
 		{
 			name:    "nominations update missing id",
@@ -71,8 +71,8 @@ func TestNominationsValidationErrors(t *testing.T) {
 		},
 		{
 			name:    "nominations update invalid publish date",
-			args:    []string{"nominations", "update", "--id", "NOM_ID", "--publish-start-date", "2026-02-01", "--submitted=false"},
-			wantErr: "--publish-start-date must be in RFC3339 format",
+			args:    []string{"nominations", "update", "--id", "NOM_ID", "--publish-start-date", "02/01/2026", "--submitted=false"},
+			wantErr: "--publish-start-date must be YYYY-MM-DD or RFC3339",
 		},
 		{
 			name:    "nominations update missing submitted or archived",
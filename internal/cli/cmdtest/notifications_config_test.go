@@ -0,0 +1,209 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNotificationsConfigValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "get missing app",
+			args:    []string{"notifications-config", "get"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "get invalid environment",
+			args:    []string{"notifications-config", "get", "--app", "123", "--environment", "staging"},
+			wantErr: "--environment must be production or sandbox",
+		},
+		{
+			name:    "set missing environment",
+			args:    []string{"notifications-config", "set", "--app", "123", "--url", "https://example.com/asn"},
+			wantErr: "--environment is required",
+		},
+		{
+			name:    "set missing url and version",
+			args:    []string{"notifications-config", "set", "--app", "123", "--environment", "sandbox"},
+			wantErr: "--url or --version is required",
+		},
+		{
+			name:    "set http url",
+			args:    []string{"notifications-config", "set", "--app", "123", "--environment", "sandbox", "--url", "http://example.com/asn"},
+			wantErr: "--url must be an absolute https URL",
+		},
+		{
+			name:    "set invalid version",
+			args:    []string{"notifications-config", "set", "--app", "123", "--environment", "production", "--version", "V3"},
+			wantErr: "--version must be V1 or V2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestNotificationsConfigGetShowsBothEnvironments(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/123" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"123","attributes":{
+			"name":"Demo",
+			"subscriptionStatusUrl":"https://example.com/asn",
+			"subscriptionStatusUrlVersion":"V2",
+			"subscriptionStatusUrlForSandbox":"https://example.com/asn/sandbox",
+			"subscriptionStatusUrlVersionForSandbox":"V1"
+		}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"notifications-config", "get", "--app", "123", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		AppID        string `json:"appId"`
+		Environments []struct {
+			Environment string `json:"environment"`
+			URL         string `json:"url"`
+			Version     string `json:"version"`
+		} `json:"environments"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.AppID != "123" || len(result.Environments) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if got := result.Environments[0]; got.Environment != "PRODUCTION" || got.URL != "https://example.com/asn" || got.Version != "V2" {
+		t.Fatalf("unexpected production entry: %+v", got)
+	}
+	if got := result.Environments[1]; got.Environment != "SANDBOX" || got.URL != "https://example.com/asn/sandbox" || got.Version != "V1" {
+		t.Fatalf("unexpected sandbox entry: %+v", got)
+	}
+}
+
+func TestNotificationsConfigSetPatchesOnlySelectedEnvironment(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/123" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		var payload struct {
+			Data struct {
+				Type       string            `json:"type"`
+				ID         string            `json:"id"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		want := map[string]string{
+			"subscriptionStatusUrlForSandbox":        "https://example.com/asn/sandbox",
+			"subscriptionStatusUrlVersionForSandbox": "V2",
+		}
+		if payload.Data.Type != "apps" || payload.Data.ID != "123" || len(payload.Data.Attributes) != len(want) {
+			t.Fatalf("unexpected payload: %s", body)
+		}
+		for key, value := range want {
+			if payload.Data.Attributes[key] != value {
+				t.Fatalf("expected %s=%q, got payload %s", key, value, body)
+			}
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"123","attributes":{
+			"subscriptionStatusUrl":"https://example.com/asn",
+			"subscriptionStatusUrlForSandbox":"https://example.com/asn/sandbox",
+			"subscriptionStatusUrlVersionForSandbox":"V2"
+		}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"notifications-config", "set",
+			"--app", "123",
+			"--environment", "sandbox",
+			"--url", "https://example.com/asn/sandbox",
+			"--version", "v2",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Environments []struct {
+			Environment string `json:"environment"`
+			URL         string `json:"url"`
+			Version     string `json:"version"`
+		} `json:"environments"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if len(result.Environments) != 1 || result.Environments[0].Environment != "SANDBOX" || result.Environments[0].Version != "V2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
@@ -0,0 +1,306 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOfferCodesGenerateWritesCSVExport(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	outputPath := filepath.Join(t.TempDir(), "codes", "offer-codes.csv")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/subscriptionOfferCodeOneTimeUseCodes":
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionOfferCodeOneTimeUseCodes","id":"batch-1","attributes":{"numberOfCodes":2,"expirationDate":"2026-02-01"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/subscriptionOfferCodeOneTimeUseCodes/batch-1/values":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("code\nAAAA1111\nBBBB2222\n")),
+				Header:     http.Header{"Content-Type": []string{"text/csv"}},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"subscriptions", "offers", "offer-codes", "generate",
+			"--offer-code-id", "OFFER_CODE_ID",
+			"--quantity", "2",
+			"--expiration-date", "2026-02-01",
+			"--output", outputPath,
+			"--csv",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	file, err := os.Open(outputPath)
+	if err != nil {
+		t.Fatalf("open export: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("parse export: %v", err)
+	}
+	want := [][]string{
+		{"batch_id", "code"},
+		{"batch-1", "AAAA1111"},
+		{"batch-1", "BBBB2222"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("expected %v, got %v", want, records)
+	}
+}
+
+func TestOfferCodesValuesPrintsCSV(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/subscriptionOfferCodeOneTimeUseCodes/batch-1/values" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("code\nAAAA1111\n")),
+			Header:     http.Header{"Content-Type": []string{"text/csv"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "offers", "offer-codes", "values", "--batch-id", "batch-1", "--csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse stdout: %v\n%s", err, stdout)
+	}
+	want := [][]string{{"batch_id", "code"}, {"batch-1", "AAAA1111"}}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("expected %v, got %v", want, records)
+	}
+}
+
+func TestOfferCodesOneTimeCodesDownloadValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	base := []string{"subscriptions", "offers", "offer-codes", "one-time-codes"}
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "download missing batch id",
+			args:    append(append([]string{}, base...), "download", "--output-file", "codes.csv"),
+			wantErr: "--batch-id is required",
+		},
+		{
+			name:    "download missing output file",
+			args:    append(append([]string{}, base...), "download", "--batch-id", "batch-1"),
+			wantErr: "--output-file is required",
+		},
+		{
+			name:    "redeem urls without app",
+			args:    append(append([]string{}, base...), "download", "--batch-id", "batch-1", "--output-file", "codes.csv", "--redeem-urls"),
+			wantErr: "--redeem-urls requires --app",
+		},
+		{
+			name:    "app without redeem urls",
+			args:    append(append([]string{}, base...), "download", "--batch-id", "batch-1", "--output-file", "codes.csv", "--app", "123"),
+			wantErr: "--app is only used with --redeem-urls",
+		},
+		{
+			name:    "update missing active",
+			args:    append(append([]string{}, base...), "update", "--batch-id", "batch-1"),
+			wantErr: "--active is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestOfferCodesOneTimeCodesDownloadWritesRedeemURLs(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	outputPath := filepath.Join(t.TempDir(), "codes.csv")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/subscriptionOfferCodeOneTimeUseCodes/batch-1/values" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("code\nAAAA1111\nBBBB2222\n")),
+			Header:     http.Header{"Content-Type": []string{"text/csv"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"subscriptions", "offers", "offer-codes", "one-time-codes", "download",
+			"--batch-id", "batch-1",
+			"--output-file", outputPath,
+			"--redeem-urls",
+			"--app", "123456789",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		BatchID     string `json:"batchId"`
+		Codes       int    `json:"codes"`
+		RedeemAppID string `json:"redeemAppId"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.BatchID != "batch-1" || result.Codes != 2 || result.RedeemAppID != "123456789" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	file, err := os.Open(outputPath)
+	if err != nil {
+		t.Fatalf("open export: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("parse export: %v", err)
+	}
+	want := [][]string{
+		{"batch_id", "code", "redeem_url"},
+		{"batch-1", "AAAA1111", "https://apps.apple.com/redeem?ctx=offercodes&id=123456789&code=AAAA1111"},
+		{"batch-1", "BBBB2222", "https://apps.apple.com/redeem?ctx=offercodes&id=123456789&code=BBBB2222"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("expected %v, got %v", want, records)
+	}
+}
+
+func TestOfferCodesOneTimeCodesUpdateDeactivatesBatch(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/subscriptionOfferCodeOneTimeUseCodes/batch-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data struct {
+				Attributes struct {
+					Active *bool `json:"active"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.Attributes.Active == nil || *payload.Data.Attributes.Active {
+			t.Fatalf("expected active=false, got %+v", payload.Data.Attributes)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionOfferCodeOneTimeUseCodes","id":"batch-1","attributes":{"active":false}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "offers", "offer-codes", "one-time-codes", "update", "--batch-id", "batch-1", "--active", "false", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil || result.Data.ID != "batch-1" {
+		t.Fatalf("unexpected output %q (%v)", stdout, err)
+	}
+}
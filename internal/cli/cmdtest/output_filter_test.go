@@ -0,0 +1,60 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestOutputFilterAfterSubcommand(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":[
+			{"type":"apps","id":"app-1","attributes":{"name":"One","bundleId":"com.example.one","sku":"one"}},
+			{"type":"apps","id":"app-2","attributes":{"name":"Two","bundleId":"com.example.two","sku":"two"}}
+		],"links":{}}`)
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"apps", "list", "--filter", "data[?attributes.sku == 'two'].{id: id, bundleId: attributes.bundleId}", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	var result []map[string]string
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if len(result) != 1 || result[0]["id"] != "app-2" || result[0]["bundleId"] != "com.example.two" {
+		t.Fatalf("unexpected filtered output: %v", result)
+	}
+}
+
+func TestOutputFilterInvalidExpression(t *testing.T) {
+	var code int
+	_, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"apps", "list", "--filter", "data[?"}, "1.2.3")
+	})
+	if code != cmd.ExitUsage {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitUsage, stderr)
+	}
+	if !strings.Contains(stderr, "--filter") {
+		t.Fatalf("expected --filter in error, got %q", stderr)
+	}
+}
@@ -0,0 +1,190 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildsWatchGHAOutputAnnotatesAndWritesStepSummary(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	summaryPath := filepath.Join(t.TempDir(), "summary.md")
+	t.Setenv("GITHUB_STEP_SUMMARY", summaryPath)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"VALID","version":"42"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "watch", "--id", "build-1", "--interval", "1ms", "--output", "gha"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.HasPrefix(stdout, "::notice title=Build processed::Build 42 (build-1) finished processing with state VALID\n") {
+		t.Fatalf("expected notice annotation, got %q", stdout)
+	}
+	if !strings.Contains(stdout, "| VALID") {
+		t.Fatalf("expected markdown build table, got %q", stdout)
+	}
+
+	summary, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("read step summary: %v", err)
+	}
+	if !strings.HasPrefix(string(summary), "### Build processing\n\n") || !strings.Contains(string(summary), "| VALID") {
+		t.Fatalf("unexpected step summary: %q", summary)
+	}
+}
+
+func TestBuildsWatchGHAOutputAnnotatesFailure(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"FAILED"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "watch", "--id", "build-1", "--interval", "1ms", "--output", "gha"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "finished with state FAILED") {
+		t.Fatalf("expected failed state error, got %v", runErr)
+	}
+	if !strings.HasPrefix(stdout, "::error title=Build processing failed::Build build-1 finished processing with state FAILED\n") {
+		t.Fatalf("expected error annotation, got %q", stdout)
+	}
+}
+
+func TestSubmitStatusGHAOutputAnnotatesRejection(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/reviewSubmissions/sub-1":
+			body := `{"data":{"type":"reviewSubmissions","id":"sub-1","attributes":{"state":"UNRESOLVED_ISSUES","submittedDate":"2026-01-01T00:00:00Z"}}}`
+			return jsonResponse(http.StatusOK, body)
+		case "/v1/reviewSubmissions/sub-1/items":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"submit", "status", "--id", "sub-1", "--output", "gha"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.HasPrefix(stdout, "::error title=Submission needs attention::Submission sub-1 is UNRESOLVED_ISSUES\n") {
+		t.Fatalf("expected error annotation, got %q", stdout)
+	}
+}
+
+func TestReviewsRespondGHAOutputAnnotates(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/customerReviewResponses" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":{"type":"customerReviewResponses","id":"resp-1","attributes":{"responseBody":"Thanks!","state":"PENDING_PUBLISH"}}}`
+		return jsonResponse(http.StatusCreated, body)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "respond", "--review-id", "review-1", "--response", "Thanks!", "--output", "gha"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.HasPrefix(stdout, "::notice title=Review response::Response resp-1 to review review-1 is PENDING_PUBLISH\n") {
+		t.Fatalf("expected notice annotation, got %q", stdout)
+	}
+}
+
+func TestGHAOutputRejectedByCommandsWithoutSupport(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list", "--output", "gha"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	if !strings.Contains(stderr, "unsupported format: gha") {
+		t.Fatalf("expected unsupported format error, got %q", stderr)
+	}
+}
@@ -0,0 +1,122 @@
+package cmdtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func paginateWorkersAppsPage(offset, total int) string {
+	var data []string
+	for i := offset; i < offset+2 && i < total; i++ {
+		data = append(data, fmt.Sprintf(`{"type":"apps","id":"app-%d","attributes":{"name":"App %d"}}`, i, i))
+	}
+	next := ""
+	if offset+2 < total {
+		cursor := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"offset":"%d"}`, offset+2))
+		next = "https://api.appstoreconnect.apple.com/v1/apps?cursor=" + cursor + "&limit=2"
+	}
+	return fmt.Sprintf(`{"data":[%s],"links":{"next":%q},"meta":{"paging":{"total":%d,"limit":2}}}`, strings.Join(data, ","), next, total)
+}
+
+func TestPaginateWorkersFlagFetchesAllPages(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Cleanup(func() { asc.SetPaginateWorkersOverride(nil) })
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const total = 7
+	var mu sync.Mutex
+	offsets := map[int]int{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.String())
+			return promoteJSONResponse(http.StatusNotFound, `{"errors":[]}`), nil
+		}
+		offset := 0
+		if cursor := req.URL.Query().Get("cursor"); cursor != "" {
+			decoded, _ := base64.RawURLEncoding.DecodeString(cursor)
+			var payload struct {
+				Offset string `json:"offset"`
+			}
+			_ = json.Unmarshal(decoded, &payload)
+			_, _ = fmt.Sscanf(payload.Offset, "%d", &offset)
+		}
+		mu.Lock()
+		offsets[offset]++
+		mu.Unlock()
+		return promoteJSONResponse(http.StatusOK, paginateWorkersAppsPage(offset, total)), nil
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"--paginate-workers", "3", "apps", "list", "--paginate", "--output", "json"}, "1.2.3")
+	})
+	if code != cmd.ExitSuccess {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitSuccess, stderr)
+	}
+
+	var payload struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if len(payload.Data) != total {
+		t.Fatalf("expected %d apps, got %d", total, len(payload.Data))
+	}
+	for i, item := range payload.Data {
+		if want := fmt.Sprintf("app-%d", i); item.ID != want {
+			t.Fatalf("index %d: expected %s, got %s", i, want, item.ID)
+		}
+	}
+	for offset, count := range offsets {
+		if count != 1 {
+			t.Fatalf("expected offset %d fetched once, got %d", offset, count)
+		}
+	}
+}
+
+func TestPaginateWorkersFlagRejectsInvalidValues(t *testing.T) {
+	// The root flag set exits on parse errors, so run the CLI in a child
+	// process to observe the exit code.
+	if value := os.Getenv("ASC_TEST_PAGINATE_WORKERS_VALUE"); value != "" {
+		os.Exit(cmd.Run([]string{"--paginate-workers", value, "apps", "list"}, "1.2.3"))
+	}
+
+	for _, value := range []string{"-1", "many"} {
+		t.Run(value, func(t *testing.T) {
+			child := exec.Command(os.Args[0], "-test.run=^TestPaginateWorkersFlagRejectsInvalidValues$")
+			child.Env = append(os.Environ(), "ASC_TEST_PAGINATE_WORKERS_VALUE="+value)
+			var stderr bytes.Buffer
+			child.Stderr = &stderr
+			err := child.Run()
+
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) || exitErr.ExitCode() != cmd.ExitUsage {
+				t.Fatalf("expected exit code %d, got %v; stderr=%q", cmd.ExitUsage, err, stderr.String())
+			}
+			if !strings.Contains(stderr.String(), "must be a non-negative integer") {
+				t.Fatalf("expected validation error in stderr, got %q", stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func pricingExportJSONResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func TestPricingExportRequiresOutput(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"pricing", "export", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--output is required") {
+		t.Fatalf("expected --output error, got %q", stderr)
+	}
+}
+
+func TestPricingExportWritesCurrentPricePerTerritory(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appPriceSchedule":
+			return pricingExportJSONResponse(`{"data":{"type":"appPriceSchedules","id":"schedule-1"}}`), nil
+		case "/v1/appPriceSchedules/schedule-1/manualPrices":
+			if got := req.URL.Query().Get("include"); got != "appPricePoint,territory" {
+				t.Fatalf("expected include=appPricePoint,territory, got %q", got)
+			}
+			return pricingExportJSONResponse(`{
+				"data":[
+					{"type":"appPrices","id":"m-old","attributes":{"manual":true,"startDate":"2026-01-01","endDate":"2026-06-01"},
+					 "relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-usa-099"}},"territory":{"data":{"type":"territories","id":"USA"}}}},
+					{"type":"appPrices","id":"m-new","attributes":{"manual":true,"startDate":"2026-06-01"},
+					 "relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-usa-199"}},"territory":{"data":{"type":"territories","id":"USA"}}}}
+				],
+				"included":[
+					{"type":"appPricePoints","id":"pp-usa-099","attributes":{"customerPrice":"0.99","proceeds":"0.70"}},
+					{"type":"appPricePoints","id":"pp-usa-199","attributes":{"customerPrice":"1.99","proceeds":"1.40"}},
+					{"type":"territories","id":"USA","attributes":{"currency":"USD"}}
+				],
+				"links":{}
+			}`), nil
+		case "/v1/appPriceSchedules/schedule-1/automaticPrices":
+			return pricingExportJSONResponse(`{
+				"data":[
+					{"type":"appPrices","id":"a-usa","attributes":{"manual":false,"startDate":"2026-01-01"},
+					 "relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-usa-auto"}},"territory":{"data":{"type":"territories","id":"USA"}}}},
+					{"type":"appPrices","id":"a-gbr","attributes":{"manual":false,"startDate":"2026-01-01"},
+					 "relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-gbr"}},"territory":{"data":{"type":"territories","id":"GBR"}}}}
+				],
+				"included":[
+					{"type":"appPricePoints","id":"pp-usa-auto","attributes":{"customerPrice":"2.99","proceeds":"2.10"}},
+					{"type":"appPricePoints","id":"pp-gbr","attributes":{"customerPrice":"1.79","proceeds":"1.05"}},
+					{"type":"territories","id":"GBR","attributes":{"currency":"GBP"}}
+				],
+				"links":{}
+			}`), nil
+		default:
+			t.Fatalf("unexpected request %s", req.URL.String())
+			return nil, nil
+		}
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "prices.csv")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"pricing", "export",
+			"--app", "app-1",
+			"--output", outputPath,
+			"--date", "2026-07-01",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var summary struct {
+		AppID       string `json:"appId"`
+		AsOf        string `json:"asOf"`
+		Territories int    `json:"territories"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse summary: %v (%q)", err, stdout)
+	}
+	if summary.AppID != "app-1" || summary.AsOf != "2026-07-01" || summary.Territories != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	file, err := os.Open(outputPath)
+	if err != nil {
+		t.Fatalf("open csv: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	want := [][]string{
+		{"territory", "currency", "customer_price", "proceeds", "price_point_id", "source", "start_date"},
+		{"GBR", "GBP", "1.79", "1.05", "pp-gbr", "automatic", "2026-01-01"},
+		{"USA", "USD", "1.99", "1.40", "pp-usa-199", "manual", "2026-06-01"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("unexpected csv:\n got %v\nwant %v", records, want)
+	}
+}
@@ -0,0 +1,190 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProfilesRegenerateRecreatesWithExistingRelationships(t *testing.T) {
+	setupAuth(t)
+	outputPath := filepath.Join(t.TempDir(), "profile.mobileprovision")
+	profileContent := base64.StdEncoding.EncodeToString([]byte("new-profile"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var calls []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+		var body string
+		status := http.StatusOK
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1":
+			body = `{"data":{"type":"profiles","id":"prof-1","attributes":{"name":"Dev Profile","profileType":"IOS_APP_DEVELOPMENT"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/relationships/bundleId":
+			body = `{"data":{"type":"bundleIds","id":"bundle-1"},"links":{}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/relationships/certificates":
+			body = `{"data":[{"type":"certificates","id":"cert-1"}],"links":{}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/relationships/devices":
+			body = `{"data":[{"type":"devices","id":"dev-1"},{"type":"devices","id":"dev-2"}],"links":{}}`
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/profiles/prof-1":
+			status = http.StatusNoContent
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						Name        string `json:"name"`
+						ProfileType string `json:"profileType"`
+					} `json:"attributes"`
+					Relationships struct {
+						BundleID struct {
+							Data struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"bundleId"`
+						Certificates struct {
+							Data []struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"certificates"`
+						Devices struct {
+							Data []struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"devices"`
+					} `json:"relationships"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode create payload: %v", err)
+			}
+			if payload.Data.Attributes.Name != "Dev Profile" || payload.Data.Attributes.ProfileType != "IOS_APP_DEVELOPMENT" {
+				t.Fatalf("unexpected attributes: %+v", payload.Data.Attributes)
+			}
+			if payload.Data.Relationships.BundleID.Data.ID != "bundle-1" {
+				t.Fatalf("unexpected bundle ID: %+v", payload.Data.Relationships.BundleID)
+			}
+			if len(payload.Data.Relationships.Certificates.Data) != 1 || len(payload.Data.Relationships.Devices.Data) != 2 {
+				t.Fatalf("unexpected relationships: %+v", payload.Data.Relationships)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"profiles","id":"prof-2","attributes":{"name":"Dev Profile","profileType":"IOS_APP_DEVELOPMENT","profileContent":"` + profileContent + `"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "regenerate", "--id", "prof-1", "--output", outputPath, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ID         string   `json:"id"`
+		PreviousID string   `json:"previousId"`
+		DeviceIDs  []string `json:"deviceIds"`
+		OutputPath string   `json:"outputPath"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if result.ID != "prof-2" || result.PreviousID != "prof-1" || len(result.DeviceIDs) != 2 || result.OutputPath != outputPath {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	deleteIndex, createIndex := -1, -1
+	for i, call := range calls {
+		switch call {
+		case "DELETE /v1/profiles/prof-1":
+			deleteIndex = i
+		case "POST /v1/profiles":
+			createIndex = i
+		}
+	}
+	if deleteIndex == -1 || createIndex < deleteIndex {
+		t.Fatalf("expected delete before create, got calls %v", calls)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read profile: %v", err)
+	}
+	if string(data) != "new-profile" {
+		t.Fatalf("unexpected profile content %q", data)
+	}
+}
+
+func TestProfilesRegenerateValidation(t *testing.T) {
+	setupAuth(t)
+	existingPath := filepath.Join(t.TempDir(), "existing.mobileprovision")
+	if err := os.WriteFile(existingPath, []byte("old"), 0o600); err != nil {
+		t.Fatalf("write existing file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing id",
+			args:    []string{"--confirm"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"--id", "prof-1"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "existing output",
+			args:    []string{"--id", "prof-1", "--output", existingPath, "--confirm"},
+			wantErr: "already exists",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(append([]string{"profiles", "regenerate"}, test.args...)); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type reviewDetailsSetOutput struct {
+	VersionID      string `json:"versionId"`
+	ReviewDetailID string `json:"reviewDetailId"`
+	Action         string `json:"action"`
+	Attachments    []struct {
+		File         string `json:"file"`
+		AttachmentID string `json:"attachmentId"`
+		Action       string `json:"action"`
+	} `json:"attachments"`
+}
+
+func TestReviewDetailsSetUpdatesDetailsAndSkipsUnchangedAttachments(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_REVIEW_DEMO_PASSWORD", "s3cret")
+
+	dir := t.TempDir()
+	notesPath := filepath.Join(dir, "notes.txt")
+	existingPath := filepath.Join(dir, "flows.zip")
+	newPath := filepath.Join(dir, "demo.mp4")
+	for path, content := range map[string]string{notesPath: "Use the demo account.\n", existingPath: "flows", newPath: "demo"} {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	existingSum := md5.Sum([]byte("flows"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var updatePayload struct {
+		Data struct {
+			Attributes map[string]any `json:"attributes"`
+		} `json:"data"`
+	}
+	uploads, commits := 0, 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreReviewDetail":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreReviewDetails","id":"detail-1","attributes":{}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreReviewDetails/detail-1":
+			body, _ := io.ReadAll(req.Body)
+			if err := json.Unmarshal(body, &updatePayload); err != nil {
+				t.Fatalf("unmarshal update body: %v", err)
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreReviewDetails","id":"detail-1","attributes":{}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreReviewDetails/detail-1/appStoreReviewAttachments":
+			return jsonResponse(http.StatusOK, fmt.Sprintf(`{"data":[{"type":"appStoreReviewAttachments","id":"att-existing","attributes":{"fileName":"flows.zip","sourceFileChecksum":%q}}],"links":{}}`, hex.EncodeToString(existingSum[:])))
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreReviewAttachments":
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"appStoreReviewAttachments","id":"att-new","attributes":{"fileName":"demo.mp4","fileSize":4,"uploadOperations":[{"method":"PUT","url":"https://upload.example.com/att-new","length":4,"offset":0}]}}}`)
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			uploads++
+			return jsonResponse(http.StatusOK, "")
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreReviewAttachments/att-new":
+			commits++
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreReviewAttachments","id":"att-new","attributes":{"fileName":"demo.mp4"}}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"review-details", "set",
+			"--version-id", "version-1",
+			"--contact", "Jane Q Doe <jane@example.com>",
+			"--demo-user", "reviewer@example.com",
+			"--notes-file", notesPath,
+			"--attachment", existingPath,
+			"--attachment", newPath,
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reviewDetailsSetOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if result.Action != "updated" || result.ReviewDetailID != "detail-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if strings.Contains(stdout, "s3cret") {
+		t.Fatalf("expected password to stay out of output, got %q", stdout)
+	}
+
+	attrs := updatePayload.Data.Attributes
+	want := map[string]any{
+		"contactFirstName":    "Jane",
+		"contactLastName":     "Q Doe",
+		"contactEmail":        "jane@example.com",
+		"demoAccountName":     "reviewer@example.com",
+		"demoAccountPassword": "s3cret",
+		"demoAccountRequired": true,
+		"notes":               "Use the demo account.",
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Fatalf("expected %s=%v in update, got %v", key, value, attrs)
+		}
+	}
+
+	if len(result.Attachments) != 2 {
+		t.Fatalf("expected two attachment entries, got %+v", result.Attachments)
+	}
+	if result.Attachments[0].Action != "skipped" || result.Attachments[0].AttachmentID != "att-existing" {
+		t.Fatalf("expected existing attachment to be skipped, got %+v", result.Attachments[0])
+	}
+	if result.Attachments[1].Action != "uploaded" || result.Attachments[1].AttachmentID != "att-new" {
+		t.Fatalf("expected new attachment to be uploaded, got %+v", result.Attachments[1])
+	}
+	if uploads != 1 || commits != 1 {
+		t.Fatalf("expected one upload and commit, got %d and %d", uploads, commits)
+	}
+}
+
+func TestReviewDetailsSetCreatesMissingDetails(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_REVIEW_DEMO_PASSWORD", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	created := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreReviewDetail":
+			return jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"not found"}]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreReviewDetails":
+			created = true
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"appStoreReviewDetails","id":"detail-new","attributes":{}}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"review-details", "set", "--version-id", "version-1", "--contact-phone", "+1 555 0100", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reviewDetailsSetOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if !created || result.Action != "created" || result.ReviewDetailID != "detail-new" {
+		t.Fatalf("unexpected result: %+v (created=%v)", result, created)
+	}
+}
+
+func TestReviewDetailsSetValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version id",
+			args:    []string{"review-details", "set", "--notes", "hi"},
+			wantErr: "--version-id is required",
+		},
+		{
+			name:    "nothing to set",
+			args:    []string{"review-details", "set", "--version-id", "version-1"},
+			wantErr: "at least one review detail flag or --attachment is required",
+		},
+		{
+			name:    "conflicting notes",
+			args:    []string{"review-details", "set", "--version-id", "version-1", "--notes", "hi", "--notes-file", "notes.txt"},
+			wantErr: "--notes and --notes-file are mutually exclusive",
+		},
+		{
+			name:    "contact without last name",
+			args:    []string{"review-details", "set", "--version-id", "version-1", "--contact", "Jane <jane@example.com>"},
+			wantErr: "--contact must include a first and last name",
+		},
+		{
+			name:    "demo user without demo account",
+			args:    []string{"review-details", "set", "--version-id", "version-1", "--demo-user", "reviewer", "--demo-required=false"},
+			wantErr: "--demo-user cannot be combined with --demo-required=false",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_REVIEW_DEMO_PASSWORD", "")
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const reviewsEscalateFixture = `{"data":[
+	{"type":"customerReviews","id":"rev-3","attributes":{"rating":5,"title":"Love it","createdDate":"2026-02-03T10:00:00Z","territory":"USA"}},
+	{"type":"customerReviews","id":"rev-2","attributes":{"rating":1,"title":"Crashes on launch","body":"Every time.","createdDate":"2026-02-02T10:00:00Z","territory":"USA"}},
+	{"type":"customerReviews","id":"rev-1","attributes":{"rating":2,"title":"Slow","createdDate":"2026-02-01T10:00:00Z","territory":"GBR"}}
+],"links":{}}`
+
+type reviewsEscalateOutput struct {
+	Scanned          int `json:"scanned"`
+	Matched          int `json:"matched"`
+	AlreadyEscalated int `json:"alreadyEscalated"`
+	Escalated        []struct {
+		ReviewID    string `json:"reviewId"`
+		IssueURL    string `json:"issueUrl"`
+		IssueNumber int    `json:"issueNumber"`
+		Executed    bool   `json:"executed"`
+	} `json:"escalated"`
+}
+
+func runReviewsEscalate(t *testing.T, args ...string) (reviewsEscalateOutput, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"reviews", "escalate"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var out reviewsEscalateOutput
+	if runErr == nil {
+		if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+			t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+		}
+	}
+	return out, runErr
+}
+
+func TestReviewsEscalateCreatesIssuesOnceForLowRatings(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("GITHUB_TOKEN", "gh-token")
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var issueTitles []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		status := http.StatusOK
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/customerReviews":
+			if got := req.URL.Query().Get("sort"); got != "-createdDate" {
+				t.Fatalf("expected sort=-createdDate, got %q", got)
+			}
+			body = reviewsEscalateFixture
+		case req.Method == http.MethodPost && req.URL.Host == "api.github.com" && req.URL.Path == "/repos/org/app/issues":
+			if got := req.Header.Get("Authorization"); got != "Bearer gh-token" {
+				t.Fatalf("unexpected authorization header %q", got)
+			}
+			var payload struct {
+				Title  string   `json:"title"`
+				Body   string   `json:"body"`
+				Labels []string `json:"labels"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode issue payload: %v", err)
+			}
+			if strings.Join(payload.Labels, ",") != "feedback,app-store" {
+				t.Fatalf("unexpected labels %v", payload.Labels)
+			}
+			issueTitles = append(issueTitles, payload.Title)
+			status = http.StatusCreated
+			number := strconv.Itoa(len(issueTitles))
+			body = `{"number":` + number + `,"html_url":"https://github.com/org/app/issues/` + number + `"}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	args := []string{
+		"--app", "app-1",
+		"--create-github-issue",
+		"--repo", "org/app",
+		"--labels", "feedback,app-store",
+		"--state-file", statePath,
+		"--output", "json",
+	}
+
+	first, err := runReviewsEscalate(t, args...)
+	if err != nil {
+		t.Fatalf("first run error: %v", err)
+	}
+	if first.Scanned != 3 || first.Matched != 2 || len(first.Escalated) != 2 {
+		t.Fatalf("unexpected first run result: %+v", first)
+	}
+	if first.Escalated[0].ReviewID != "rev-1" || first.Escalated[1].ReviewID != "rev-2" {
+		t.Fatalf("expected oldest review first, got %+v", first.Escalated)
+	}
+	if first.Escalated[1].IssueURL != "https://github.com/org/app/issues/2" {
+		t.Fatalf("unexpected issue URL %q", first.Escalated[1].IssueURL)
+	}
+	if len(issueTitles) != 2 || issueTitles[1] != "[1★ review] Crashes on launch" {
+		t.Fatalf("unexpected issue titles %v", issueTitles)
+	}
+
+	stateData, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatalf("read state file: %v", err)
+	}
+	if !strings.Contains(string(stateData), `"rev-2"`) {
+		t.Fatalf("expected rev-2 in state file, got %s", stateData)
+	}
+
+	second, err := runReviewsEscalate(t, args...)
+	if err != nil {
+		t.Fatalf("second run error: %v", err)
+	}
+	if second.AlreadyEscalated != 2 || len(second.Escalated) != 0 {
+		t.Fatalf("expected deduplicated second run, got %+v", second)
+	}
+	if len(issueTitles) != 2 {
+		t.Fatalf("expected no new issues, got %v", issueTitles)
+	}
+}
+
+func TestReviewsEscalateExecReceivesReviewJSON(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.json")
+	capturePath := filepath.Join(dir, "captured.txt")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps/app-1/customerReviews" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(reviewsEscalateFixture)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	out, err := runReviewsEscalate(t,
+		"--app", "app-1",
+		"--max-rating", "1",
+		"--exec", `cat >> "`+capturePath+`"; echo " $ASC_REVIEW_ID $ASC_REVIEW_RATING" >> "`+capturePath+`"`,
+		"--state-file", statePath,
+		"--output", "json",
+	)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if len(out.Escalated) != 1 || out.Escalated[0].ReviewID != "rev-2" || !out.Escalated[0].Executed {
+		t.Fatalf("unexpected result: %+v", out)
+	}
+
+	captured, err := os.ReadFile(capturePath)
+	if err != nil {
+		t.Fatalf("read captured output: %v", err)
+	}
+	if !strings.Contains(string(captured), `"id":"rev-2"`) || !strings.Contains(string(captured), " rev-2 1") {
+		t.Fatalf("unexpected exec input: %s", captured)
+	}
+}
+
+func TestReviewsEscalateValidation(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"--exec", "true"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing target",
+			args:    []string{"--app", "app-1"},
+			wantErr: "at least one of --create-github-issue or --exec is required",
+		},
+		{
+			name:    "issue without repo",
+			args:    []string{"--app", "app-1", "--create-github-issue"},
+			wantErr: "--repo is required with --create-github-issue",
+		},
+		{
+			name:    "repo without issue",
+			args:    []string{"--app", "app-1", "--exec", "true", "--repo", "org/app"},
+			wantErr: "--repo and --labels require --create-github-issue",
+		},
+		{
+			name:    "invalid repo",
+			args:    []string{"--app", "app-1", "--create-github-issue", "--repo", "org"},
+			wantErr: "--repo must be in owner/name format",
+		},
+		{
+			name:    "invalid max rating",
+			args:    []string{"--app", "app-1", "--exec", "true", "--max-rating", "6"},
+			wantErr: "--max-rating must be between 1 and 5",
+		},
+		{
+			name:    "invalid since",
+			args:    []string{"--app", "app-1", "--exec", "true", "--since", "yesterday"},
+			wantErr: "--since must be YYYY-MM-DD or RFC3339",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(append([]string{"reviews", "escalate"}, test.args...)); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -2,9 +2,12 @@ package cmdtest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"io"
+	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -133,6 +136,113 @@ func TestReviewsResponseDeleteValidationErrors(t *testing.T) {
 	}
 }
 
+func TestReviewsDeleteResponseValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "reviews delete-response missing ids",
+			args:    []string{"reviews", "delete-response", "--confirm"},
+			wantErr: "--review-id or --id is required",
+		},
+		{
+			name:    "reviews delete-response both ids",
+			args:    []string{"reviews", "delete-response", "--review-id", "REVIEW_123", "--id", "RESPONSE_123", "--confirm"},
+			wantErr: "--review-id and --id are mutually exclusive",
+		},
+		{
+			name:    "reviews delete-response missing confirm",
+			args:    []string{"reviews", "delete-response", "--review-id", "REVIEW_123"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestReviewsDeleteResponseByReviewID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var deleted string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/customerReviews/review-1/relationships/response":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"customerReviewResponses","id":"response-1"}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/customerReviewResponses/response-1":
+			deleted = "response-1"
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       io.NopCloser(strings.NewReader("")),
+				Header:     http.Header{},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "delete-response", "--review-id", "review-1", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if deleted != "response-1" {
+		t.Fatalf("expected response-1 to be deleted, got %q", deleted)
+	}
+
+	var result struct {
+		ID      string `json:"id"`
+		Deleted bool   `json:"deleted"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if result.ID != "response-1" || !result.Deleted {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
 func TestReviewsResponseForReviewValidationErrors(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,192 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeWorkflowYAML(t *testing.T, dir, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, "release.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write release.yaml: %v", err)
+	}
+	return path
+}
+
+func TestRunWorkflowFile_MissingPath(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "workflow file path is required") {
+		t.Fatalf("expected missing path error, got %q", stderr)
+	}
+}
+
+func TestRunWorkflowFile_YAMLConditionsAndOutputs(t *testing.T) {
+	path := writeWorkflowYAML(t, t.TempDir(), `
+workflows:
+  release:
+    steps:
+      - name: check
+        run: printf '{"state":"VALID"}'
+        outputs:
+          STATE: $.state
+      - name: submit
+        if: ${steps.check.STATE} == VALID
+        run: echo submitting_$VERSION
+      - name: wait
+        if: ${steps.check.STATE} != VALID
+        run: echo waiting
+`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"run", path, "VERSION:2.1.0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Workflow string `json:"workflow"`
+		Status   string `json:"status"`
+		Steps    []struct {
+			Name   string `json:"name"`
+			Status string `json:"status"`
+		} `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if result.Workflow != "release" || result.Status != "ok" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(result.Steps) != 3 || result.Steps[1].Status != "ok" || result.Steps[2].Status != "skipped" {
+		t.Fatalf("unexpected steps: %+v", result.Steps)
+	}
+	if !strings.Contains(stderr, "submitting_2.1.0") || strings.Contains(stderr, "waiting") {
+		t.Fatalf("unexpected step output: %q", stderr)
+	}
+}
+
+func TestRunWorkflowFile_NamedWorkflowWithFlagsAfterPath(t *testing.T) {
+	path := writeWorkflowYAML(t, t.TempDir(), `
+workflows:
+  beta:
+    steps: [echo beta]
+  release:
+    steps: [echo release]
+`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"run", path, "beta", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Workflow string `json:"workflow"`
+		Steps    []struct {
+			Status string `json:"status"`
+		} `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if result.Workflow != "beta" || len(result.Steps) != 1 || result.Steps[0].Status != "dry-run" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestRunWorkflowFile_RequiresNameForMultipleWorkflows(t *testing.T) {
+	path := writeWorkflowYAML(t, t.TempDir(), `
+workflows:
+  beta:
+    steps: [echo beta]
+  release:
+    steps: [echo release]
+`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"run", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "available: beta, release") {
+		t.Fatalf("expected available workflows in error, got %q", stderr)
+	}
+}
+
+func TestRunWorkflowFile_RejectsFileFlag(t *testing.T) {
+	path := writeWorkflowYAML(t, t.TempDir(), "workflows:\n  beta:\n    steps: [echo beta]\n")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"run", path, "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, `unknown flag "--file"`) {
+		t.Fatalf("expected unknown flag error, got %q", stderr)
+	}
+}
+
+func TestRunWorkflowFile_InvalidYAML(t *testing.T) {
+	path := writeWorkflowYAML(t, t.TempDir(), "workflows:\n  beta: [unterminated\n")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"run", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "yaml") {
+			t.Fatalf("expected YAML parse error, got %v", err)
+		}
+	})
+}
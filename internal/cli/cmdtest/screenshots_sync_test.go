@@ -0,0 +1,63 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestScreenshotsSyncValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version id",
+			args:    []string{"screenshots", "sync", "--dir", "./screenshots", "--confirm"},
+			wantErr: "--version-id is required",
+		},
+		{
+			name:    "missing dir",
+			args:    []string{"screenshots", "sync", "--version-id", "VERSION_ID", "--confirm"},
+			wantErr: "--dir is required",
+		},
+		{
+			name:    "invalid concurrency",
+			args:    []string{"screenshots", "sync", "--version-id", "VERSION_ID", "--dir", "./screenshots", "--confirm", "--concurrency", "0"},
+			wantErr: "--concurrency must be at least 1",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"screenshots", "sync", "--version-id", "VERSION_ID", "--dir", "./screenshots"},
+			wantErr: "--confirm is required unless --dry-run is set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
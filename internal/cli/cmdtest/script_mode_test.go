@@ -0,0 +1,92 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestQuietSuppressesDeprecationWarnings(t *testing.T) {
+	t.Setenv("ASC_QUIET", "")
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantWarning bool
+	}{
+		{
+			name:        "default",
+			args:        []string{"feedback", "--app", "APP_ID", "--limit", "500"},
+			wantWarning: true,
+		},
+		{
+			name: "quiet",
+			args: []string{"--quiet", "feedback", "--app", "APP_ID", "--limit", "500"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if runErr == nil || !strings.Contains(runErr.Error(), "--limit must be between 1 and 200") {
+				t.Fatalf("expected limit error, got %v", runErr)
+			}
+			if got := strings.Contains(stderr, "Warning:"); got != test.wantWarning {
+				t.Fatalf("expected warning=%v, got stderr %q", test.wantWarning, stderr)
+			}
+		})
+	}
+}
+
+func TestNoInputRejectsInteractiveAppsCreate(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	tests := []struct {
+		name string
+		args []string
+		env  string
+	}{
+		{name: "flag", args: []string{"--no-input", "apps", "create"}},
+		{name: "env", args: []string{"apps", "create"}, env: "1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_NO_INPUT", test.env)
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, "input required but --no-input is set: pass --name, --bundle-id, and --sku") {
+				t.Fatalf("expected no-input error, got %q", stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestServeValidationErrors(t *testing.T) {
+	t.Setenv("ASC_SERVE_TOKEN", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "invalid listen",
+			args:    []string{"serve", "--listen", "8080"},
+			wantErr: "--listen must be host:port",
+		},
+		{
+			name:    "remote listen without allow-remote",
+			args:    []string{"serve", "--listen", ":8080"},
+			wantErr: "requires --allow-remote",
+		},
+		{
+			name:    "remote listen without token",
+			args:    []string{"serve", "--listen", ":8080", "--allow-remote"},
+			wantErr: "requires --token",
+		},
+		{
+			name:    "invalid rate limit",
+			args:    []string{"serve", "--rate-limit", "fast"},
+			wantErr: "--rate-limit must be a non-negative number",
+		},
+		{
+			name:    "positional args",
+			args:    []string{"serve", "extra"},
+			wantErr: "serve does not accept positional arguments",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
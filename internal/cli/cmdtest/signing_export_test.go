@@ -0,0 +1,172 @@
+package cmdtest
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSigningExportWritesArchiveWithManifest(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	profileContent := base64.StdEncoding.EncodeToString([]byte("profile-bytes"))
+	certContent := base64.StdEncoding.EncodeToString([]byte("cert-bytes"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app"}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bundle-1/profiles":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"profiles","id":"prof-store","attributes":{"name":"App Store","profileType":"IOS_APP_STORE","profileState":"ACTIVE","uuid":"UUID-1","profileContent":"`+profileContent+`"}},
+				{"type":"profiles","id":"prof-adhoc","attributes":{"name":"Ad Hoc","profileType":"IOS_APP_ADHOC","profileState":"ACTIVE","profileContent":"`+profileContent+`"}},
+				{"type":"profiles","id":"prof-old","attributes":{"name":"Old","profileType":"IOS_APP_STORE","profileState":"INVALID","profileContent":"`+profileContent+`"}}
+			]}`)
+		case req.Method == http.MethodGet && (req.URL.Path == "/v1/profiles/prof-store/certificates" || req.URL.Path == "/v1/profiles/prof-adhoc/certificates"):
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"cert-1","attributes":{"name":"Dist","certificateType":"IOS_DISTRIBUTION","serialNumber":"ABC123","certificateContent":"`+certContent+`"}}]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	archivePath := filepath.Join(t.TempDir(), "signing.zip")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"signing", "export", "--bundle-id", "com.example.app", "--output", archivePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		OutputPath   string `json:"outputPath"`
+		Profiles     []struct{ ID, File string }
+		Certificates []struct{ ID, File string }
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if result.OutputPath != archivePath || len(result.Profiles) != 2 || len(result.Certificates) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		t.Fatalf("open archive: %v", err)
+	}
+	defer reader.Close()
+
+	contents := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		contents[file.Name] = string(data)
+	}
+	if contents["profiles/App Store.mobileprovision"] != "profile-bytes" {
+		t.Fatalf("expected App Store profile in archive, got entries %v", signingExportEntryNames(contents))
+	}
+	if contents["certificates/ABC123.cer"] != "cert-bytes" {
+		t.Fatalf("expected certificate in archive, got entries %v", signingExportEntryNames(contents))
+	}
+	if len(contents) != 4 {
+		t.Fatalf("expected manifest, two profiles, and one certificate, got %v", signingExportEntryNames(contents))
+	}
+
+	var manifest struct {
+		BundleID string `json:"bundleId"`
+		Profiles []struct {
+			ID             string   `json:"id"`
+			UUID           string   `json:"uuid"`
+			CertificateIDs []string `json:"certificateIds"`
+			File           string   `json:"file"`
+		} `json:"profiles"`
+	}
+	if err := json.Unmarshal([]byte(contents["manifest.json"]), &manifest); err != nil {
+		t.Fatalf("parse manifest: %v", err)
+	}
+	if manifest.BundleID != "com.example.app" || manifest.Profiles[0].UUID != "UUID-1" || manifest.Profiles[0].CertificateIDs[0] != "cert-1" {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+	if _, ok := contents[manifest.Profiles[1].File]; !ok {
+		t.Fatalf("manifest file %q missing from archive", manifest.Profiles[1].File)
+	}
+}
+
+func signingExportEntryNames(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestSigningExportValidation(t *testing.T) {
+	existing := filepath.Join(t.TempDir(), "signing.zip")
+	if err := os.WriteFile(existing, []byte("old"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing bundle id",
+			args:    []string{"signing", "export", "--output", "./signing.zip"},
+			wantErr: "--bundle-id is required",
+		},
+		{
+			name:    "not a zip",
+			args:    []string{"signing", "export", "--bundle-id", "com.example.app", "--output", "./signing"},
+			wantErr: "--output must end in .zip",
+		},
+		{
+			name:    "existing archive",
+			args:    []string{"signing", "export", "--bundle-id", "com.example.app", "--output", existing},
+			wantErr: "use --overwrite to replace it",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStatsSummaryReadsLocalUsageLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+	t.Setenv("ASC_USAGE_LOG", "1")
+	t.Setenv("ASC_USAGE_LOG_PATH", path)
+	log := strings.Join([]string{
+		`{"time":"2026-03-01T10:00:00Z","command":"asc builds upload","durationMs":4000,"exitCode":0}`,
+		`{"time":"2026-03-02T10:00:00Z","command":"asc builds upload","durationMs":6000,"exitCode":1}`,
+		`not json`,
+		`{"time":"2026-03-03T10:00:00Z","command":"asc apps list","durationMs":100,"exitCode":0}`,
+	}, "\n") + "\n"
+	if err := os.WriteFile(path, []byte(log), 0o600); err != nil {
+		t.Fatalf("write usage log: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"stats", "summary", "--command", "builds", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Enabled  bool   `json:"enabled"`
+		LogPath  string `json:"logPath"`
+		Runs     int    `json:"runs"`
+		Failures int    `json:"failures"`
+		Commands []struct {
+			Command       string  `json:"command"`
+			Runs          int     `json:"runs"`
+			FailureRate   float64 `json:"failureRate"`
+			AvgDurationMS int64   `json:"avgDurationMs"`
+			LastRun       string  `json:"lastRun"`
+		} `json:"commands"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if !result.Enabled || result.LogPath != path || result.Runs != 2 || result.Failures != 1 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	if len(result.Commands) != 1 {
+		t.Fatalf("expected one command, got %+v", result.Commands)
+	}
+	upload := result.Commands[0]
+	if upload.Command != "asc builds upload" || upload.FailureRate != 0.5 || upload.AvgDurationMS != 5000 || upload.LastRun != "2026-03-02T10:00:00Z" {
+		t.Fatalf("unexpected command summary: %+v", upload)
+	}
+}
+
+func TestStatsClearRequiresConfirm(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+	t.Setenv("ASC_USAGE_LOG_PATH", path)
+	if err := os.WriteFile(path, []byte("{}\n"), 0o600); err != nil {
+		t.Fatalf("write usage log: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"stats", "clear"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if !errors.Is(runErr, flag.ErrHelp) || !strings.Contains(stderr, "--confirm is required") {
+		t.Fatalf("expected confirm usage error, got err=%v stderr=%q", runErr, stderr)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected usage log to remain, got %v", err)
+	}
+
+	root = RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"stats", "clear", "--confirm", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	var cleared struct {
+		Cleared bool `json:"cleared"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &cleared); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if !cleared.Cleared {
+		t.Fatalf("expected cleared=true, got %s", stdout)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected usage log to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setStdin(t *testing.T, contents string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stdin.txt")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("write stdin: %v", err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open stdin: %v", err)
+	}
+	original := os.Stdin
+	os.Stdin = file
+	t.Cleanup(func() {
+		os.Stdin = original
+		_ = file.Close()
+	})
+}
+
+func TestGetCommandReadsIDsFromStdin(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setStdin(t, "dev-1\n\n# skipped\n  dev-2  \n")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requested []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || !strings.HasPrefix(req.URL.Path, "/v1/devices/") {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		id := strings.TrimPrefix(req.URL.Path, "/v1/devices/")
+		requested = append(requested, id)
+		body := `{"data":{"type":"devices","id":"` + id + `","attributes":{"name":"Device ` + id + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "get", "--id", "-", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.Join(requested, ",") != "dev-1,dev-2" {
+		t.Fatalf("expected requests for dev-1 and dev-2, got %v", requested)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(stdout))
+	var ids []string
+	for decoder.More() {
+		var payload struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		if err := decoder.Decode(&payload); err != nil {
+			t.Fatalf("decode output: %v (%q)", err, stdout)
+		}
+		ids = append(ids, payload.Data.ID)
+	}
+	if strings.Join(ids, ",") != "dev-1,dev-2" {
+		t.Fatalf("expected one JSON result per ID, got %v", ids)
+	}
+}
+
+func TestGetCommandRejectsEmptyStdinIDs(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setStdin(t, "\n# nothing here\n")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "get", "--id", "-"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--id - requires at least one ID on stdin") {
+		t.Fatalf("expected stdin usage error, got %q", stderr)
+	}
+}
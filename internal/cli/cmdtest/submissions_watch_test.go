@@ -0,0 +1,313 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/submit"
+)
+
+// submissionsWatchTransport serves a review submission and version whose
+// states advance one step per poll.
+func submissionsWatchTransport(t *testing.T, submissionStates, versionStates []string) roundTripFunc {
+	t.Helper()
+	submissionPolls, versionPolls := 0, 0
+	next := func(states []string, polls *int) string {
+		state := states[min(*polls, len(states)-1)]
+		*polls++
+		return state
+	}
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/apps/app-1/reviewSubmissions":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"reviewSubmissions","id":"sub-1","attributes":{"state":"WAITING_FOR_REVIEW","platform":"IOS"},"relationships":{"appStoreVersionForReview":{"data":{"type":"appStoreVersions","id":"version-1"}}}}],"links":{}}`)
+		case "/v1/reviewSubmissions/sub-1":
+			return jsonResponse(http.StatusOK, fmt.Sprintf(`{"data":{"type":"reviewSubmissions","id":"sub-1","attributes":{"state":%q},"relationships":{"appStoreVersionForReview":{"data":{"type":"appStoreVersions","id":"version-1"}}}}}`, next(submissionStates, &submissionPolls)))
+		case "/v1/appStoreVersions/version-1":
+			return jsonResponse(http.StatusOK, fmt.Sprintf(`{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.0","platform":"IOS","appVersionState":%q}}}`, next(versionStates, &versionPolls)))
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
+
+type submissionsWatchOutput struct {
+	SubmissionID    string `json:"submissionId"`
+	SubmissionState string `json:"submissionState"`
+	VersionID       string `json:"versionId"`
+	VersionState    string `json:"versionState"`
+	Outcome         string `json:"outcome"`
+	Transitions     []struct {
+		Subject string `json:"subject"`
+		From    string `json:"from"`
+		To      string `json:"to"`
+	} `json:"transitions"`
+	Messages []struct {
+		ID   string `json:"id"`
+		Body string `json:"body"`
+	} `json:"messages"`
+}
+
+func TestSubmissionsWatchReportsTransitionsUntilAccepted(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = submissionsWatchTransport(t,
+		[]string{"WAITING_FOR_REVIEW", "IN_REVIEW", "COMPLETE"},
+		[]string{"WAITING_FOR_REVIEW", "IN_REVIEW", "PENDING_DEVELOPER_RELEASE"},
+	)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"submissions", "watch", "--app", "app-1", "--interval", "1ms", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result submissionsWatchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if result.Outcome != "accepted" || result.VersionState != "PENDING_DEVELOPER_RELEASE" || result.SubmissionID != "sub-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	var versionPath []string
+	for _, transition := range result.Transitions {
+		if transition.Subject == "version" {
+			versionPath = append(versionPath, transition.To)
+		}
+	}
+	if strings.Join(versionPath, ",") != "WAITING_FOR_REVIEW,IN_REVIEW,PENDING_DEVELOPER_RELEASE" {
+		t.Fatalf("unexpected version transitions: %+v", result.Transitions)
+	}
+	if !strings.Contains(stderr, "Version 1.2.0 (IOS): IN_REVIEW -> PENDING_DEVELOPER_RELEASE") {
+		t.Fatalf("expected transition on stderr, got %q", stderr)
+	}
+}
+
+func TestSubmissionsWatchUntilReleasedKeepsWatchingAcceptedVersion(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = submissionsWatchTransport(t,
+		[]string{"COMPLETE"},
+		[]string{"PENDING_DEVELOPER_RELEASE", "PROCESSING_FOR_DISTRIBUTION", "READY_FOR_SALE"},
+	)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"submissions", "watch", "--app", "app-1", "--id", "sub-1", "--until-released", "--interval", "1ms", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result submissionsWatchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if result.Outcome != "released" || result.VersionState != "READY_FOR_SALE" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestSubmissionsWatchRejectionExitCodeAndMessages(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = submissionsWatchTransport(t,
+		[]string{"UNRESOLVED_ISSUES"},
+		[]string{"IN_REVIEW", "REJECTED"},
+	)
+
+	var fetchedFor string
+	t.Cleanup(submit.SetFetchReviewMessages(func(ctx context.Context, submissionID string) ([]asc.ReviewSubmissionWatchMessage, error) {
+		fetchedFor = submissionID
+		return []asc.ReviewSubmissionWatchMessage{{ID: "msg-1", Body: "Guideline 2.1 - Performance"}}, nil
+	}))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"submissions", "watch", "--app", "app-1", "--version-id", "version-1", "--messages", "--interval", "1ms", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	exitErr, ok := errors.AsType[shared.ExitCoder](runErr)
+	if !ok || exitErr.ExitCode() != shared.ExitCodeReviewRejected {
+		t.Fatalf("expected rejection exit code error, got %v", runErr)
+	}
+	if !strings.Contains(runErr.Error(), "version 1.2.0 (IOS) was rejected") {
+		t.Fatalf("unexpected error: %v", runErr)
+	}
+	if fetchedFor != "sub-1" {
+		t.Fatalf("expected messages fetched for sub-1, got %q", fetchedFor)
+	}
+
+	var result submissionsWatchOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected JSON stdout, got %q: %v", stdout, err)
+	}
+	if result.Outcome != "rejected" || result.SubmissionState != "UNRESOLVED_ISSUES" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(result.Messages) != 1 || result.Messages[0].Body != "Guideline 2.1 - Performance" {
+		t.Fatalf("unexpected messages: %+v", result.Messages)
+	}
+}
+
+func TestSubmissionsWatchMessagesFailureIsWarning(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = submissionsWatchTransport(t,
+		[]string{"UNRESOLVED_ISSUES"},
+		[]string{"METADATA_REJECTED"},
+	)
+	t.Cleanup(submit.SetFetchReviewMessages(func(ctx context.Context, submissionID string) ([]asc.ReviewSubmissionWatchMessage, error) {
+		return nil, errors.New("no cached web session")
+	}))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"submissions", "watch", "--app", "app-1", "--id", "sub-1", "--messages", "--interval", "1ms"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if exitErr, ok := errors.AsType[shared.ExitCoder](runErr); !ok || exitErr.ExitCode() != shared.ExitCodeReviewRejected {
+		t.Fatalf("expected rejection exit code error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "Warning: --messages: no cached web session") {
+		t.Fatalf("expected messages warning, got %q", stderr)
+	}
+}
+
+func TestSubmissionsWatchMessagesWarningHonorsQuiet(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_QUIET", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = submissionsWatchTransport(t,
+		[]string{"UNRESOLVED_ISSUES"},
+		[]string{"METADATA_REJECTED"},
+	)
+	t.Cleanup(submit.SetFetchReviewMessages(func(ctx context.Context, submissionID string) ([]asc.ReviewSubmissionWatchMessage, error) {
+		return nil, errors.New("no cached web session")
+	}))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--quiet", "submissions", "watch", "--app", "app-1", "--id", "sub-1", "--messages", "--interval", "1ms"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		_ = root.Run(context.Background())
+	})
+
+	if strings.Contains(stderr, "Warning:") {
+		t.Fatalf("expected no warning with --quiet, got %q", stderr)
+	}
+	if strings.Contains(stderr, "UNRESOLVED_ISSUES") {
+		t.Fatalf("expected no state transitions with --quiet, got %q", stderr)
+	}
+}
+
+func TestSubmissionsWatchValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"submissions", "watch"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "conflicting selectors",
+			args:    []string{"submissions", "watch", "--app", "app-1", "--id", "sub-1", "--version", "1.2.0"},
+			wantErr: "--id, --version, and --version-id are mutually exclusive",
+		},
+		{
+			name:    "platform with version id",
+			args:    []string{"submissions", "watch", "--app", "app-1", "--version-id", "version-1", "--platform", "IOS"},
+			wantErr: "--platform can only be used",
+		},
+		{
+			name:    "invalid interval",
+			args:    []string{"submissions", "watch", "--app", "app-1", "--interval", "0s"},
+			wantErr: "--interval must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_APP_ID", "")
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionsGracePeriodsGetByApp(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/app-1/subscriptionGracePeriod" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":{"type":"subscriptionGracePeriods","id":"grace-1","attributes":{"optIn":true,"duration":"DAY_16","renewalType":"ALL_RENEWALS"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "grace-periods", "get", "--app", "app-1", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if payload.Data.ID != "grace-1" {
+		t.Fatalf("expected grace-1, got %q", payload.Data.ID)
+	}
+}
+
+func TestSubscriptionsGracePeriodsUpdateResolvesIDFromApp(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var patched bool
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/relationships/subscriptionGracePeriod":
+			body = `{"data":{"type":"subscriptionGracePeriods","id":"grace-1"}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/subscriptionGracePeriods/grace-1":
+			patched = true
+			payload, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if !strings.Contains(string(payload), `"renewalType":"ALL_RENEWALS"`) {
+				t.Fatalf("expected renewalType in body, got %s", payload)
+			}
+			body = `{"data":{"type":"subscriptionGracePeriods","id":"grace-1","attributes":{"renewalType":"ALL_RENEWALS"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "grace-periods", "update", "--app", "app-1", "--renewal-type", "ALL_RENEWALS", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !patched {
+		t.Fatal("expected grace period PATCH request")
+	}
+}
+
+func TestSubscriptionsGracePeriodsRejectsIDAndApp(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "grace-periods", "get", "--id", "grace-1", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "--id and --app are mutually exclusive") {
+		t.Fatalf("expected mutually exclusive error, got %q", stderr)
+	}
+}
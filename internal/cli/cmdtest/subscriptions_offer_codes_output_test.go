@@ -191,6 +191,7 @@ func TestSubscriptionsOfferCodesCreateReturnsCreateFailure(t *testing.T) {
 
 func TestSubscriptionsOfferCodesListPaginateReturnsSecondPageFailure(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
 
 	const nextURL = "https://api.appstoreconnect.apple.com/v1/subscriptions/sub-1/offerCodes?cursor=AQ&limit=200"
@@ -264,6 +264,7 @@ func TestSubscriptionsPricePointsListStreamRejectsRepeatedNextURL(t *testing.T)
 
 func TestSubscriptionsPricePointsListStreamReturnsSecondPageFailure(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 
 	const nextURL = "https://api.appstoreconnect.apple.com/v1/subscriptions/sub-1/pricePoints?cursor=AQ&limit=200"
 
@@ -195,14 +195,22 @@ func TestSubscriptionsPricesImport_PartialFailureReturnsReportedErrorAndSummary(
 	root := RootCommand("1.2.3")
 	root.FlagSet.SetOutput(io.Discard)
 
+	type importItem struct {
+		Row    int    `json:"row"`
+		Item   string `json:"item"`
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	}
 	type importFailure struct {
 		Row int `json:"row"`
 	}
 	type importSummary struct {
-		Total    int             `json:"total"`
-		Created  int             `json:"created"`
-		Failed   int             `json:"failed"`
-		Failures []importFailure `json:"failures"`
+		Total     int             `json:"total"`
+		Created   int             `json:"created"`
+		Succeeded int             `json:"succeeded"`
+		Failed    int             `json:"failed"`
+		Failures  []importFailure `json:"failures"`
+		Items     []importItem    `json:"items"`
 	}
 
 	var runErr error
@@ -233,6 +241,12 @@ func TestSubscriptionsPricesImport_PartialFailureReturnsReportedErrorAndSummary(
 	if len(summary.Failures) != 1 || summary.Failures[0].Row != 2 {
 		t.Fatalf("expected one failure at row 2, got %+v", summary.Failures)
 	}
+	if summary.Succeeded != 1 || len(summary.Items) != 2 {
+		t.Fatalf("expected one item per row, got %+v", summary)
+	}
+	if got := summary.Items[1]; got.Row != 2 || got.Status != "failed" || got.Error == "" {
+		t.Fatalf("expected failed item at row 2, got %+v", got)
+	}
 	if createCount != 1 {
 		t.Fatalf("expected one successful create, got %d", createCount)
 	}
@@ -17,6 +17,9 @@ func TestMain(m *testing.M) {
 
 	_ = os.Setenv("ASC_CONFIG_PATH", testConfigPath)
 	_ = os.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	_ = os.Setenv("ASC_ID_CACHE", "0")
+	// Stub transports answer only the requests under test, not the clock probe.
+	_ = os.Setenv("ASC_CLOCK_SKEW_CHECK", "0")
 	_ = os.Setenv("HOME", tempDir)
 
 	code := m.Run()
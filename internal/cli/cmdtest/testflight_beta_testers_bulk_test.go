@@ -0,0 +1,289 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func betaTestersBulkJSONResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func writeBetaTestersBulkCSV(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "testers.csv")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	return path
+}
+
+type betaTestersBulkSummary struct {
+	Action    string `json:"action"`
+	Group     string `json:"group"`
+	Total     int    `json:"total"`
+	Succeeded int    `json:"succeeded"`
+	NotFound  int    `json:"notFound"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+	Items     []struct {
+		Row    int    `json:"row"`
+		Item   string `json:"item"`
+		ID     string `json:"id"`
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	} `json:"items"`
+}
+
+func TestTestFlightTestersAddFromFile(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var created []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return betaTestersBulkJSONResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Beta"}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaTesters":
+			return betaTestersBulkJSONResponse(http.StatusOK, `{"data":[]}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaTesters":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"group-1"`) {
+				t.Fatalf("expected group-1 in body, got %s", payload)
+			}
+			id := fmt.Sprintf("tester-%d", len(created)+1)
+			created = append(created, id)
+			return betaTestersBulkJSONResponse(http.StatusCreated, `{"data":{"type":"betaTesters","id":"`+id+`"}}`), nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	csvPath := writeBetaTestersBulkCSV(t, "email,first_name,last_name\none@example.com,One,Tester\ntwo@example.com,Two,Tester\n")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "testers", "add", "--app", "app-1", "--file", csvPath, "--group", "Beta"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var summary struct {
+		Total   int `json:"total"`
+		Created int `json:"created"`
+		Failed  int `json:"failed"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse summary: %v (%q)", err, stdout)
+	}
+	if summary.Total != 2 || summary.Created != 2 || summary.Failed != 0 || len(created) != 2 {
+		t.Fatalf("unexpected summary %+v (created %v)", summary, created)
+	}
+}
+
+func TestTestFlightTestersAddFileRejectsEmail(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "testers", "add", "--app", "app-1", "--file", "testers.csv", "--email", "a@example.com"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--file cannot be combined with --email") {
+		t.Fatalf("expected conflict error, got %q", stderr)
+	}
+}
+
+func TestTestFlightTestersRemoveFileRequiresConfirm(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "testers", "remove", "--app", "app-1", "--file", "testers.csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--confirm is required") {
+		t.Fatalf("expected --confirm error, got %q", stderr)
+	}
+}
+
+func TestTestFlightTestersRemoveFromGroupBatchesAcrossPages(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	testerPage := func(from, to int, next string) string {
+		items := make([]string, 0, to-from)
+		for i := from; i < to; i++ {
+			items = append(items, fmt.Sprintf(`{"type":"betaTesters","id":"tester-%d","attributes":{"email":"user%d@example.com"}}`, i, i))
+		}
+		return `{"data":[` + strings.Join(items, ",") + `],"links":{"next":"` + next + `"}}`
+	}
+
+	var batchSizes []int
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return betaTestersBulkJSONResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Beta"}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaTesters":
+			if req.URL.Query().Get("cursor") == "2" {
+				return betaTestersBulkJSONResponse(http.StatusOK, testerPage(200, 250, "")), nil
+			}
+			return betaTestersBulkJSONResponse(http.StatusOK, testerPage(0, 200, "https://api.appstoreconnect.apple.com/v1/betaTesters?cursor=2")), nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/betaGroups/group-1/relationships/betaTesters":
+			var payload struct {
+				Data []map[string]string `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			batchSizes = append(batchSizes, len(payload.Data))
+			return betaTestersBulkJSONResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	var rows strings.Builder
+	rows.WriteString("email\n")
+	for i := 100; i < 250; i++ {
+		fmt.Fprintf(&rows, "user%d@example.com\n", i)
+	}
+	rows.WriteString("missing@example.com\n")
+	csvPath := writeBetaTestersBulkCSV(t, rows.String())
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "testers", "remove", "--app", "app-1", "--file", csvPath, "--group", "Beta", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var summary betaTestersBulkSummary
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse summary: %v (%q)", err, stdout)
+	}
+	if summary.Action != "remove-from-group" || summary.Total != 151 || summary.Succeeded != 150 || summary.NotFound != 1 || summary.Skipped != 1 || summary.Failed != 0 {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+	if len(batchSizes) != 2 || batchSizes[0] != 100 || batchSizes[1] != 50 {
+		t.Fatalf("expected batches of 100 and 50, got %v", batchSizes)
+	}
+}
+
+func TestTestFlightTestersInviteFromFileReportsMissingTesters(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	invited := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaTesters":
+			return betaTestersBulkJSONResponse(http.StatusOK, `{"data":[{"type":"betaTesters","id":"tester-1","attributes":{"email":"known@example.com"}}]}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaTesterInvitations":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"tester-1"`) {
+				t.Fatalf("expected tester-1 in body, got %s", payload)
+			}
+			invited++
+			return betaTestersBulkJSONResponse(http.StatusCreated, `{"data":{"type":"betaTesterInvitations","id":"invite-1"}}`), nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	csvPath := writeBetaTestersBulkCSV(t, "email\nknown@example.com\nunknown@example.com\n")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "testers", "invite", "--app", "app-1", "--file", csvPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "1 row(s) failed") {
+		t.Fatalf("expected row failure error, got %v", runErr)
+	}
+	var summary betaTestersBulkSummary
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse summary: %v (%q)", err, stdout)
+	}
+	if invited != 1 || summary.Succeeded != 1 || summary.NotFound != 1 || summary.Failed != 1 {
+		t.Fatalf("unexpected summary %+v (invited %d)", summary, invited)
+	}
+	if len(summary.Items) != 2 {
+		t.Fatalf("expected one item per row, got %+v", summary.Items)
+	}
+	for _, item := range summary.Items {
+		switch item.Row {
+		case 1:
+			if item.Status != "succeeded" || item.ID != "tester-1" {
+				t.Fatalf("unexpected item for known tester %+v", item)
+			}
+		case 2:
+			if item.Item != "unknown@example.com" || item.Status != "failed" || item.Error == "" {
+				t.Fatalf("unexpected item for missing tester %+v", item)
+			}
+		default:
+			t.Fatalf("unexpected item %+v", item)
+		}
+	}
+}
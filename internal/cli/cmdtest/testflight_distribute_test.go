@@ -0,0 +1,166 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTestFlightDistributeExistingBuildRunsFullPipeline(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	jsonResponse := func(status int, body string) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	}
+
+	var steps []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			steps = append(steps, "groups")
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Internal","isInternalGroup":true}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			steps = append(steps, "build")
+			return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID"}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/builds/build-1":
+			steps = append(steps, "encryption")
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"usesNonExemptEncryption":false`) {
+				t.Fatalf("expected encryption compliance payload, got %s", string(payload))
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID","usesNonExemptEncryption":false}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/betaBuildLocalizations":
+			steps = append(steps, "localizations")
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaBuildLocalizations":
+			steps = append(steps, "whats-new")
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"whatsNew":"Bug fixes"`) || !strings.Contains(string(payload), `"locale":"en-US"`) {
+				t.Fatalf("expected whats new payload, got %s", string(payload))
+			}
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"betaBuildLocalizations","id":"loc-1","attributes":{"locale":"en-US","whatsNew":"Bug fixes"}}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/builds/build-1/relationships/betaGroups":
+			steps = append(steps, "assign")
+			return jsonResponse(http.StatusNoContent, "")
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "distribute",
+			"--app", "app-1",
+			"--build", "build-1",
+			"--group", "Internal",
+			"--whats-new", "Bug fixes",
+			"--uses-non-exempt-encryption", "false",
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	want := "groups,build,build,encryption,localizations,whats-new,assign"
+	if got := strings.Join(steps, ","); got != want {
+		t.Fatalf("expected pipeline %s, got %s", want, got)
+	}
+
+	var result struct {
+		BuildID                 string   `json:"buildId"`
+		GroupIDs                []string `json:"groupIds"`
+		UsesNonExemptEncryption *bool    `json:"usesNonExemptEncryption"`
+		WhatsNewLocale          string   `json:"whatsNewLocale"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if result.BuildID != "build-1" || len(result.GroupIDs) != 1 || result.GroupIDs[0] != "group-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.UsesNonExemptEncryption == nil || *result.UsesNonExemptEncryption {
+		t.Fatalf("expected usesNonExemptEncryption=false, got %v", result.UsesNonExemptEncryption)
+	}
+	if result.WhatsNewLocale != "en-US" {
+		t.Fatalf("expected whatsNewLocale en-US, got %q", result.WhatsNewLocale)
+	}
+}
+
+func TestTestFlightDistributeValidationErrors(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"testflight", "distribute", "--ipa", "app.ipa", "--group", "Internal"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing build source",
+			args:    []string{"testflight", "distribute", "--app", "app-1", "--group", "Internal"},
+			wantErr: "--ipa is required unless --build or --build-number is provided",
+		},
+		{
+			name:    "missing group",
+			args:    []string{"testflight", "distribute", "--app", "app-1", "--build", "build-1"},
+			wantErr: "--group is required",
+		},
+		{
+			name:    "locale without whats new",
+			args:    []string{"testflight", "distribute", "--app", "app-1", "--build", "build-1", "--group", "Internal", "--locale", "fr-FR"},
+			wantErr: "--locale requires --whats-new",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type feedbackDownloadOutput struct {
+	AppID       string `json:"appId"`
+	Submissions int    `json:"submissions"`
+	Files       []struct {
+		SubmissionID string `json:"submissionId"`
+		Kind         string `json:"kind"`
+		DeviceModel  string `json:"deviceModel"`
+		FilePath     string `json:"filePath"`
+		FileSize     int64  `json:"fileSize"`
+		Skipped      bool   `json:"skipped"`
+	} `json:"files"`
+}
+
+func TestTestFlightFeedbackDownloadValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "feedback missing app",
+			args:    []string{"testflight", "feedback", "download"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "crashes missing app",
+			args:    []string{"testflight", "crashes", "download"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "empty output dir",
+			args:    []string{"testflight", "crashes", "download", "--app", "123", "--output-dir", " "},
+			wantErr: "--output-dir must not be empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestTestFlightFeedbackDownloadSavesScreenshots(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := filepath.Join(t.TempDir(), "feedback")
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Path == "/v1/apps/123/betaFeedbackScreenshotSubmissions":
+			query := req.URL.Query()
+			if query.Get("filter[build]") != "build-1" || query.Get("filter[deviceModel]") != "iPhone15,3" {
+				t.Fatalf("expected build and device filters, got %s", req.URL.RawQuery)
+			}
+			if !strings.Contains(query.Get("fields[betaFeedbackScreenshotSubmissions]"), "screenshots") {
+				t.Fatalf("expected screenshots field, got %s", req.URL.RawQuery)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"betaFeedbackScreenshotSubmissions","id":"fb-1","attributes":{
+					"deviceModel":"iPhone15,3",
+					"screenshots":[
+						{"url":"https://cdn.example.com/shots/a.jpg?sig=1"},
+						{"url":"https://cdn.example.com/shots/b"}
+					]
+				}},
+				{"type":"betaFeedbackScreenshotSubmissions","id":"fb-2","attributes":{"comment":"no image"}}
+			],"links":{"next":""}}`)
+		case req.URL.Host == "cdn.example.com":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("image:" + req.URL.Path)),
+				Header:     http.Header{"Content-Type": []string{"image/png"}},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "feedback", "download",
+			"--app", "123",
+			"--build", "build-1",
+			"--device-model", "iPhone15,3",
+			"--output-dir", dir,
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result feedbackDownloadOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if result.AppID != "123" || result.Submissions != 2 || len(result.Files) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	wantPaths := []string{filepath.Join(dir, "fb-1-1.jpg"), filepath.Join(dir, "fb-1-2.png")}
+	for i, file := range result.Files {
+		if file.SubmissionID != "fb-1" || file.Kind != "screenshot" || file.DeviceModel != "iPhone15,3" || file.FilePath != wantPaths[i] {
+			t.Fatalf("unexpected file %d: %+v", i, file)
+		}
+		data, err := os.ReadFile(file.FilePath)
+		if err != nil {
+			t.Fatalf("read %s: %v", file.FilePath, err)
+		}
+		if int64(len(data)) != file.FileSize || !strings.HasPrefix(string(data), "image:/shots/") {
+			t.Fatalf("unexpected content in %s: %q", file.FilePath, data)
+		}
+	}
+}
+
+func TestTestFlightCrashesDownloadSavesLogsAndSkipsExisting(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "crash-2.crash"), []byte("old"), 0o600); err != nil {
+		t.Fatalf("write existing log: %v", err)
+	}
+
+	origTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/apps/123/betaFeedbackCrashSubmissions":
+			if req.URL.Query().Get("filter[build]") != "build-1" {
+				t.Fatalf("expected build filter, got %s", req.URL.RawQuery)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"betaFeedbackCrashSubmissions","id":"crash-1","attributes":{"deviceModel":"iPhone16,1","osVersion":"18.0"}},
+				{"type":"betaFeedbackCrashSubmissions","id":"crash-2","attributes":{}}
+			],"links":{"next":""}}`)
+		case "/v1/betaFeedbackCrashSubmissions/crash-1/crashLog":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"betaCrashLogs","id":"log-1","attributes":{"logText":"Thread 0 Crashed"}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "crashes", "download",
+			"--app", "123",
+			"--build", "build-1",
+			"--output-dir", dir,
+			"--output", "json",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result feedbackDownloadOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout)
+	}
+	if len(result.Files) != 2 {
+		t.Fatalf("expected 2 files, got %+v", result.Files)
+	}
+	if got := result.Files[0]; got.Kind != "crashLog" || got.Skipped || got.FilePath != filepath.Join(dir, "crash-1.crash") {
+		t.Fatalf("unexpected first file: %+v", got)
+	}
+	if got := result.Files[1]; !got.Skipped || got.FileSize != 3 {
+		t.Fatalf("expected existing crash log to be skipped, got %+v", got)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "crash-1.crash"))
+	if err != nil {
+		t.Fatalf("read crash log: %v", err)
+	}
+	if string(data) != "Thread 0 Crashed" {
+		t.Fatalf("unexpected crash log: %q", data)
+	}
+}
@@ -0,0 +1,295 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func promoteJSONResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+const promoteBetaGroupsBody = `{"data":[` +
+	`{"type":"betaGroups","id":"group-qa","attributes":{"name":"QA","isInternalGroup":true}},` +
+	`{"type":"betaGroups","id":"group-public","attributes":{"name":"Public Beta","isInternalGroup":false}}]}`
+
+func TestTestFlightPromoteValidationErrors(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"testflight", "promote", "--build", "build-1", "--group", "Public Beta", "--confirm"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing build",
+			args:    []string{"testflight", "promote", "--app", "app-1", "--group", "Public Beta", "--confirm"},
+			wantErr: "--build is required",
+		},
+		{
+			name:    "unsupported ladder",
+			args:    []string{"testflight", "promote", "--app", "app-1", "--build", "build-1", "--from", "external", "--to", "internal", "--group", "Public Beta", "--confirm"},
+			wantErr: "unsupported promotion",
+		},
+		{
+			name:    "missing group",
+			args:    []string{"testflight", "promote", "--app", "app-1", "--build", "build-1", "--confirm"},
+			wantErr: "--group is required",
+		},
+		{
+			name:    "locale without notes",
+			args:    []string{"testflight", "promote", "--app", "app-1", "--build", "build-1", "--group", "Public Beta", "--locale", "fr-FR", "--confirm"},
+			wantErr: "--locale requires --whats-new",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"testflight", "promote", "--app", "app-1", "--build", "build-1", "--group", "Public Beta"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestTestFlightPromoteRunsFullLadder(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var calls []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return promoteJSONResponse(http.StatusOK, promoteBetaGroupsBody), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID"}}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/builds/build-1":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"usesNonExemptEncryption":false`) {
+				t.Fatalf("expected compliance payload, got %s", string(payload))
+			}
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"usesNonExemptEncryption":false}}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/buildBetaDetail":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"buildBetaDetails","id":"detail-1","attributes":{"externalBuildState":"READY_FOR_BETA_SUBMISSION"}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/builds/build-1/relationships/betaGroups":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"group-public"`) {
+				t.Fatalf("expected external group in payload, got %s", string(payload))
+			}
+			return promoteJSONResponse(http.StatusNoContent, ""), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaAppReviewSubmissions":
+			return promoteJSONResponse(http.StatusCreated, `{"data":{"type":"betaAppReviewSubmissions","id":"submission-1","attributes":{"betaReviewState":"WAITING_FOR_REVIEW"}}}`), nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/builds/build-1/relationships/betaGroups":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"group-qa"`) {
+				t.Fatalf("expected internal group in remove payload, got %s", string(payload))
+			}
+			return promoteJSONResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "promote",
+			"--app", "app-1",
+			"--build", "build-1",
+			"--group", "Public Beta",
+			"--remove-group", "QA",
+			"--uses-non-exempt-encryption", "false",
+			"--confirm",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		BuildID            string   `json:"buildId"`
+		ComplianceUpdated  bool     `json:"complianceUpdated"`
+		AddedGroupIDs      []string `json:"addedGroupIds"`
+		RemovedGroupIDs    []string `json:"removedGroupIds"`
+		ReviewSubmitted    bool     `json:"reviewSubmitted"`
+		ReviewSubmissionID string   `json:"reviewSubmissionId"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if result.BuildID != "build-1" || !result.ComplianceUpdated || !result.ReviewSubmitted || result.ReviewSubmissionID != "submission-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(result.AddedGroupIDs) != 1 || result.AddedGroupIDs[0] != "group-public" {
+		t.Fatalf("expected group-public added, got %v", result.AddedGroupIDs)
+	}
+	if len(result.RemovedGroupIDs) != 1 || result.RemovedGroupIDs[0] != "group-qa" {
+		t.Fatalf("expected group-qa removed, got %v", result.RemovedGroupIDs)
+	}
+
+	// Review is submitted only after the build joins an external group.
+	addIndex, submitIndex := -1, -1
+	for i, call := range calls {
+		switch call {
+		case "POST /v1/builds/build-1/relationships/betaGroups":
+			addIndex = i
+		case "POST /v1/betaAppReviewSubmissions":
+			submitIndex = i
+		}
+	}
+	if addIndex == -1 || submitIndex == -1 || addIndex > submitIndex {
+		t.Fatalf("expected group assignment before review submission, got %v", calls)
+	}
+}
+
+func TestTestFlightPromoteSkipsSubmissionWhenAlreadyApproved(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return promoteJSONResponse(http.StatusOK, promoteBetaGroupsBody), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"VALID","usesNonExemptEncryption":false}}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/buildBetaDetail":
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"buildBetaDetails","id":"detail-1","attributes":{"externalBuildState":"BETA_APPROVED"}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/builds/build-1/relationships/betaGroups":
+			return promoteJSONResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "promote",
+			"--app", "app-1",
+			"--build", "build-1",
+			"--group", "group-public",
+			"--confirm",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ComplianceUpdated  bool   `json:"complianceUpdated"`
+		ReviewSubmitted    bool   `json:"reviewSubmitted"`
+		ExternalBuildState string `json:"externalBuildState"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if result.ComplianceUpdated || result.ReviewSubmitted || result.ExternalBuildState != "BETA_APPROVED" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestTestFlightPromoteRejectsInternalTargetGroup(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups" {
+			return promoteJSONResponse(http.StatusOK, promoteBetaGroupsBody), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "promote",
+			"--app", "app-1",
+			"--build", "build-1",
+			"--group", "QA",
+			"--confirm",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "internal group") {
+		t.Fatalf("expected internal group error, got %q", stderr)
+	}
+}
@@ -122,6 +122,16 @@ func TestTestFlightReviewSubmitOutput(t *testing.T) {
 	})
 
 	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/betaAppReviewSubmissions" {
+			if req.URL.Query().Get("filter[build]") != "build-1" {
+				t.Fatalf("expected existing-submission lookup for build-1, got %s", req.URL.RawQuery)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
 		if req.Method != http.MethodPost {
 			t.Fatalf("expected POST, got %s", req.Method)
 		}
@@ -0,0 +1,289 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTestFlightSubmitValidationErrors(t *testing.T) {
+	setupAuth(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing build",
+			args:    []string{"testflight", "submit", "--confirm"},
+			wantErr: "--build is required",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"testflight", "submit", "--build", "build-1"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "timeout without wait",
+			args:    []string{"testflight", "submit", "--build", "build-1", "--confirm", "--timeout", "1h"},
+			wantErr: "--timeout requires --wait",
+		},
+		{
+			name:    "non-positive poll interval",
+			args:    []string{"testflight", "submit", "--build", "build-1", "--confirm", "--wait", "--poll-interval", "0s"},
+			wantErr: "--poll-interval must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestTestFlightSubmitWaitsForApproval(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	polled := 0
+	var calls []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaAppReviewSubmissions":
+			if got := req.URL.Query().Get("filter[build]"); got != "build-1" {
+				t.Fatalf("expected filter[build]=build-1, got %q", got)
+			}
+			return promoteJSONResponse(http.StatusOK, `{"data":[]}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaAppReviewSubmissions":
+			return promoteJSONResponse(http.StatusCreated, `{"data":{"type":"betaAppReviewSubmissions","id":"submission-1","attributes":{"betaReviewState":"WAITING_FOR_REVIEW"}}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaAppReviewSubmissions/submission-1":
+			polled++
+			state := "IN_REVIEW"
+			if polled > 1 {
+				state = "APPROVED"
+			}
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"betaAppReviewSubmissions","id":"submission-1","attributes":{"betaReviewState":"`+state+`"}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "submit", "--build", "build-1", "--confirm", "--wait", "--poll-interval", "1ms"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				BetaReviewState string `json:"betaReviewState"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Data.ID != "submission-1" || payload.Data.Attributes.BetaReviewState != "APPROVED" {
+		t.Fatalf("expected approved submission-1, got %+v", payload.Data)
+	}
+	for _, state := range []string{"WAITING_FOR_REVIEW", "IN_REVIEW", "APPROVED"} {
+		if !strings.Contains(stderr, state) {
+			t.Fatalf("expected stderr to report %s, got %q", state, stderr)
+		}
+	}
+	if polled != 2 {
+		t.Fatalf("expected 2 polls, got %d (calls %v)", polled, calls)
+	}
+}
+
+func TestTestFlightSubmitReusesExistingSubmissionAndFailsOnRejection(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/betaAppReviewSubmissions" {
+			return promoteJSONResponse(http.StatusOK, `{"data":[{"type":"betaAppReviewSubmissions","id":"submission-1","attributes":{"betaReviewState":"REJECTED"}}]}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "submit", "--build", "build-1", "--confirm", "--wait"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "rejected build build-1") {
+		t.Fatalf("expected rejection error, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"id":"submission-1"`) || !strings.Contains(stdout, `"REJECTED"`) {
+		t.Fatalf("expected the rejected submission on stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "already submitted for beta app review") {
+		t.Fatalf("expected reuse notice in stderr, got %q", stderr)
+	}
+}
+
+func TestTestFlightReviewDetailSetByApp(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaAppReviewDetails":
+			if got := req.URL.Query().Get("filter[app]"); got != "123456789" {
+				t.Fatalf("expected filter[app]=123456789, got %q", got)
+			}
+			return promoteJSONResponse(http.StatusOK, `{"data":[{"type":"betaAppReviewDetails","id":"detail-1","attributes":{}}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/betaAppReviewDetails/detail-1":
+			var body struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			attrs := body.Data.Attributes
+			if attrs["contactEmail"] != "dev@example.com" || attrs["demoAccountRequired"] != true {
+				t.Fatalf("unexpected attributes: %v", attrs)
+			}
+			if _, ok := attrs["notes"]; ok {
+				t.Fatalf("expected notes to be left unchanged, got %v", attrs)
+			}
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"betaAppReviewDetails","id":"detail-1","attributes":{"contactEmail":"dev@example.com","demoAccountRequired":true}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "review-detail", "set", "--app", "123456789", "--contact-email", "dev@example.com", "--demo-account-required"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if payload.Data.ID != "detail-1" {
+		t.Fatalf("expected detail-1, got %q", payload.Data.ID)
+	}
+}
+
+func TestTestFlightReviewDetailSetValidationErrors(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing selector",
+			args:    []string{"testflight", "review-detail", "set", "--notes", "hi"},
+			wantErr: "--app or --id is required",
+		},
+		{
+			name:    "app and id",
+			args:    []string{"testflight", "review-detail", "set", "--app", "123", "--id", "detail-1", "--notes", "hi"},
+			wantErr: "--app and --id are mutually exclusive",
+		},
+		{
+			name:    "no updates",
+			args:    []string{"testflight", "review-detail", "set", "--id", "detail-1"},
+			wantErr: "at least one detail flag is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func whatsNewJSONResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+type whatsNewSetOutput struct {
+	BuildID string `json:"buildId"`
+	DryRun  bool   `json:"dryRun"`
+	Total   int    `json:"total"`
+	Failed  int    `json:"failed"`
+	Items   []struct {
+		Item      string `json:"item"`
+		ID        string `json:"id"`
+		Status    string `json:"status"`
+		Detail    string `json:"detail"`
+		Error     string `json:"error"`
+		RequestID string `json:"requestId"`
+	} `json:"items"`
+}
+
+func TestTestFlightWhatsNewSetRequiresSource(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "whats-new", "set", "--build", "build-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--dir or --all-locales is required") {
+		t.Fatalf("expected source error, got %q", stderr)
+	}
+}
+
+func TestTestFlightWhatsNewSetFromDir(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"en-US.txt":   "Try the new onboarding\n",
+		"de-DE.txt":   "Teste das neue Onboarding",
+		"README.md":   "ignored",
+		".hidden.txt": "ignored",
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o600); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var updated, created []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/betaBuildLocalizations":
+			return whatsNewJSONResponse(http.StatusOK, `{"data":[{"type":"betaBuildLocalizations","id":"loc-en","attributes":{"locale":"en-US","whatsNew":"Old"}}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/betaBuildLocalizations/loc-en":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), "Try the new onboarding") {
+				t.Fatalf("unexpected update body %s", payload)
+			}
+			updated = append(updated, "loc-en")
+			return whatsNewJSONResponse(http.StatusOK, `{"data":{"type":"betaBuildLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaBuildLocalizations":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"locale":"de-DE"`) || !strings.Contains(string(payload), `"id":"build-1"`) {
+				t.Fatalf("unexpected create body %s", payload)
+			}
+			created = append(created, "de-DE")
+			return whatsNewJSONResponse(http.StatusCreated, `{"data":{"type":"betaBuildLocalizations","id":"loc-de","attributes":{"locale":"de-DE"}}}`), nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "whats-new", "set", "--build", "build-1", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result whatsNewSetOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(updated) != 1 || len(created) != 1 {
+		t.Fatalf("expected one update and one create, got updated=%v created=%v", updated, created)
+	}
+	if result.Total != 2 || len(result.Items) != 2 {
+		t.Fatalf("expected 2 items, got %+v", result)
+	}
+	if item := result.Items[0]; item.Item != "de-DE" || item.Detail != "create" || item.ID != "loc-de" || item.Status != "succeeded" {
+		t.Fatalf("unexpected de-DE item %+v", item)
+	}
+	if item := result.Items[1]; item.Item != "en-US" || item.Detail != "update" || item.ID != "loc-en" {
+		t.Fatalf("unexpected en-US item %+v", item)
+	}
+}
+
+func TestTestFlightWhatsNewSetAllLocalesDryRun(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/builds/build-1/betaBuildLocalizations" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return whatsNewJSONResponse(http.StatusOK, `{"data":[{"type":"betaBuildLocalizations","id":"loc-en","attributes":{"locale":"en-US"}},{"type":"betaBuildLocalizations","id":"loc-ja","attributes":{"locale":"ja"}}]}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"testflight", "whats-new", "set",
+			"--build", "build-1",
+			"--all-locales", "Bug fixes",
+			"--locale", "en-us,fr-FR",
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result whatsNewSetOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if !result.DryRun || len(result.Items) != 3 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	actions := map[string]string{}
+	for _, item := range result.Items {
+		actions[item.Item] = item.Detail
+	}
+	if actions["en-US"] != "would update" || actions["ja"] != "would update" || actions["fr-FR"] != "would create" {
+		t.Fatalf("unexpected actions %v", actions)
+	}
+}
+
+func TestTestFlightWhatsNewSetReportsFailedLocales(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/betaBuildLocalizations":
+			return whatsNewJSONResponse(http.StatusOK, `{"data":[{"type":"betaBuildLocalizations","id":"loc-en","attributes":{"locale":"en-US"}},{"type":"betaBuildLocalizations","id":"loc-ja","attributes":{"locale":"ja"}}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/betaBuildLocalizations/loc-en":
+			return whatsNewJSONResponse(http.StatusConflict, `{"errors":[{"id":"req-en","status":"409","code":"STATE_ERROR","title":"Build is expired"}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/betaBuildLocalizations/loc-ja":
+			return whatsNewJSONResponse(http.StatusOK, `{"data":{"type":"betaBuildLocalizations","id":"loc-ja","attributes":{"locale":"ja"}}}`), nil
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	var code int
+	stdout, stderr := captureOutput(t, func() {
+		code = cmd.Run([]string{"testflight", "whats-new", "set", "--build", "build-1", "--all-locales", "Bug fixes"}, "1.2.3")
+	})
+	if code != cmd.ExitError {
+		t.Fatalf("exit code = %d, want %d; stderr=%q", code, cmd.ExitError, stderr)
+	}
+
+	var result whatsNewSetOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Total != 2 || result.Failed != 1 || len(result.Items) != 2 {
+		t.Fatalf("expected one failed and one updated locale, got %+v", result)
+	}
+	failed := result.Items[0]
+	if failed.Item != "en-US" || failed.Status != "failed" || failed.RequestID != "req-en" || !strings.Contains(failed.Error, "Build is expired") {
+		t.Fatalf("unexpected failed item %+v", failed)
+	}
+	if result.Items[1].Item != "ja" || result.Items[1].Status != "succeeded" {
+		t.Fatalf("expected ja to still be updated, got %+v", result.Items[1])
+	}
+}
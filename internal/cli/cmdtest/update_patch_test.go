@@ -0,0 +1,213 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpdatePatchMergesIntoRequestBody(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var sent map[string]any
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/subscriptions/sub-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		payload, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if err := json.Unmarshal(payload, &sent); err != nil {
+			t.Fatalf("parse body %q: %v", payload, err)
+		}
+		body := `{"data":{"type":"subscriptions","id":"sub-1","attributes":{"name":"New Name","reviewNote":"Notes"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"subscriptions", "update",
+			"--id", "sub-1",
+			"--reference-name", "New Name",
+			"--patch", `{"attributes":{"reviewNote":"Notes"}}`,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	data, _ := sent["data"].(map[string]any)
+	if data["id"] != "sub-1" || data["type"] != "subscriptions" {
+		t.Fatalf("expected resource identity to be kept, got %v", data)
+	}
+	attrs, _ := data["attributes"].(map[string]any)
+	if attrs["name"] != "New Name" || attrs["reviewNote"] != "Notes" {
+		t.Fatalf("expected flag and patch attributes, got %v", attrs)
+	}
+}
+
+func TestUpdatePatchAloneSatisfiesUpdateFlags(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var sent string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/devices/dev-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		payload, _ := io.ReadAll(req.Body)
+		sent = string(payload)
+		body := `{"data":{"type":"devices","id":"dev-1","attributes":{"name":"Lab iPhone"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"devices", "update",
+			"--id", "dev-1",
+			"--patch", `{"attributes":{"name":"Lab iPhone"}}`,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			Attributes map[string]any `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(sent), &payload); err != nil {
+		t.Fatalf("parse body %q: %v", sent, err)
+	}
+	if payload.Data.Attributes["name"] != "Lab iPhone" {
+		t.Fatalf("expected patched name, got %s", sent)
+	}
+}
+
+func TestUpdatePatchRejectsNonObject(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"devices", "update",
+			"--id", "dev-1",
+			"--patch", `["not","an","object"]`,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--patch must be a JSON object") {
+		t.Fatalf("expected --patch error, got %q", stderr)
+	}
+}
+
+func TestUpdatePatchTargetsEachStdinID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setStdin(t, "dev-1\ndev-2\n")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	bodies := map[string]string{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || !strings.HasPrefix(req.URL.Path, "/v1/devices/") {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		payload, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		id := strings.TrimPrefix(req.URL.Path, "/v1/devices/")
+		bodies[id] = string(payload)
+		body := `{"data":{"type":"devices","id":"` + id + `","attributes":{"name":"Lab iPhone"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"devices", "update",
+			"--id", "-",
+			"--patch", `{"attributes":{"name":"Lab iPhone"}}`,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.Contains(stderr, "--patch was not applied") {
+		t.Fatalf("expected the patch to apply to every ID, got %q", stderr)
+	}
+	for _, id := range []string{"dev-1", "dev-2"} {
+		var payload struct {
+			Data struct {
+				ID         string         `json:"id"`
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal([]byte(bodies[id]), &payload); err != nil {
+			t.Fatalf("parse %s body %q: %v", id, bodies[id], err)
+		}
+		if payload.Data.ID != id || payload.Data.Attributes["name"] != "Lab iPhone" {
+			t.Fatalf("expected patched body for %s, got %s", id, bodies[id])
+		}
+	}
+}
@@ -2,6 +2,7 @@ package cmdtest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"io"
@@ -341,3 +342,99 @@ func TestVersionsCreateCopyMetadataRejectsInvalidExcludeField(t *testing.T) {
 		t.Fatalf("expected exclude-fields usage error, got %q", stderr)
 	}
 }
+
+func TestVersionsCreateAttachesBuildAndCopiesFromPreviousVersion(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	jsonResponse := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	}
+
+	var calls []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersions":
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"appStoreVersions","id":"ver-new","attributes":{"platform":"IOS","versionString":"2.3.0","appVersionState":"PREPARE_FOR_SUBMISSION"}}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersions/ver-new/relationships/build":
+			payload, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("failed to read payload: %v", err)
+			}
+			if !strings.Contains(string(payload), `"id":"build-1"`) {
+				t.Fatalf("expected build-1 in payload, got %s", payload)
+			}
+			return jsonResponse(http.StatusNoContent, ""), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appStoreVersions":
+			if got := req.URL.Query().Get("filter[versionString]"); got != "" {
+				t.Fatalf("expected no versionString filter for previous, got %q", got)
+			}
+			body := `{"data":[` +
+				`{"type":"appStoreVersions","id":"ver-new","attributes":{"platform":"IOS","versionString":"2.3.0","createdDate":"2026-03-01T10:00:00Z"}},` +
+				`{"type":"appStoreVersions","id":"ver-old","attributes":{"platform":"IOS","versionString":"2.1.0","createdDate":"2025-06-01T10:00:00Z"}},` +
+				`{"type":"appStoreVersions","id":"ver-prev","attributes":{"platform":"IOS","versionString":"2.2.0","createdDate":"2025-12-01T10:00:00Z"}}]}`
+			return jsonResponse(http.StatusOK, body), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/ver-prev/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"src-en","attributes":{"locale":"en-US","description":"Previous description"}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/ver-new/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"dst-en","attributes":{"locale":"en-US"}}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/dst-en":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionLocalizations","id":"dst-en","attributes":{"locale":"en-US","description":"Previous description"}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"versions", "create",
+			"--app", "app-1",
+			"--version", "2.3.0",
+			"--platform", "IOS",
+			"--build", "build-1",
+			"--copy-metadata-from", "previous",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ID           string `json:"id"`
+		BuildID      string `json:"buildId"`
+		MetadataCopy struct {
+			SourceVersion   string `json:"sourceVersion"`
+			SourceVersionID string `json:"sourceVersionId"`
+			CopiedLocales   int    `json:"copiedLocales"`
+		} `json:"metadataCopy"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if result.ID != "ver-new" || result.BuildID != "build-1" {
+		t.Fatalf("expected ver-new with build-1, got %+v", result)
+	}
+	if result.MetadataCopy.SourceVersion != "2.2.0" || result.MetadataCopy.SourceVersionID != "ver-prev" || result.MetadataCopy.CopiedLocales != 1 {
+		t.Fatalf("expected copy from 2.2.0, got %+v", result.MetadataCopy)
+	}
+	if calls[1] != "PATCH /v1/appStoreVersions/ver-new/relationships/build" {
+		t.Fatalf("expected build attach right after create, got %v", calls)
+	}
+}
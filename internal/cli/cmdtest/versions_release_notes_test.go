@@ -0,0 +1,214 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVersionsReleaseNotesSetRendersPerLocale(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	templatePath := filepath.Join(dir, "notes.tmpl")
+	if err := os.WriteFile(templatePath, []byte("Version {{.version}} ({{.locale}}) released {{.date}}.\n"), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	localeDir := filepath.Join(dir, "locales")
+	if err := os.Mkdir(localeDir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(localeDir, "de-DE.tmpl"), []byte("Version {{.version}} ist da."), 0o600); err != nil {
+		t.Fatalf("write override: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	updates := map[string]string{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/ver-1/appStoreVersionLocalizations":
+			return promoteJSONResponse(http.StatusOK, `{"data":[`+
+				`{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US","whatsNew":"old"}},`+
+				`{"type":"appStoreVersionLocalizations","id":"loc-de","attributes":{"locale":"de-DE"}},`+
+				`{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR","whatsNew":"Version 2.3.0 (fr-FR) released 2026-10-15."}}]}`), nil
+		case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, "/v1/appStoreVersionLocalizations/"):
+			var body struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if len(body.Data.Attributes) != 1 {
+				t.Fatalf("expected only whatsNew to be sent, got %v", body.Data.Attributes)
+			}
+			id := strings.TrimPrefix(req.URL.Path, "/v1/appStoreVersionLocalizations/")
+			updates[id], _ = body.Data.Attributes["whatsNew"].(string)
+			return promoteJSONResponse(http.StatusOK, `{"data":{"type":"appStoreVersionLocalizations","id":"`+id+`","attributes":{}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"versions", "release-notes", "set",
+			"--version-id", "ver-1",
+			"--template", templatePath,
+			"--locale-dir", localeDir,
+			"--vars", "version=2.3.0,date=2026-10-15",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	wantUpdates := map[string]string{
+		"loc-en": "Version 2.3.0 (en-US) released 2026-10-15.",
+		"loc-de": "Version 2.3.0 ist da.",
+	}
+	if len(updates) != len(wantUpdates) {
+		t.Fatalf("expected updates %v, got %v", wantUpdates, updates)
+	}
+	for id, want := range wantUpdates {
+		if updates[id] != want {
+			t.Fatalf("expected %s whatsNew %q, got %q", id, want, updates[id])
+		}
+	}
+
+	var result struct {
+		VersionID string `json:"versionId"`
+		DryRun    bool   `json:"dryRun"`
+		Results   []struct {
+			Locale   string `json:"locale"`
+			Action   string `json:"action"`
+			Template string `json:"template"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if result.VersionID != "ver-1" || result.DryRun || len(result.Results) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	wantActions := map[string]string{"de-DE": "update", "en-US": "update", "fr-FR": "unchanged"}
+	for i, item := range result.Results {
+		if i > 0 && result.Results[i-1].Locale > item.Locale {
+			t.Fatalf("expected results sorted by locale, got %+v", result.Results)
+		}
+		if item.Action != wantActions[item.Locale] {
+			t.Fatalf("expected %s action %q, got %q", item.Locale, wantActions[item.Locale], item.Action)
+		}
+	}
+	if got := result.Results[0].Template; got != filepath.Join(localeDir, "de-DE.tmpl") {
+		t.Fatalf("expected de-DE override template, got %q", got)
+	}
+}
+
+func TestVersionsReleaseNotesSetMissingVariableUpdatesNothing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	templatePath := filepath.Join(t.TempDir(), "notes.tmpl")
+	if err := os.WriteFile(templatePath, []byte("Version {{.version}} on {{.date}}"), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/ver-1/appStoreVersionLocalizations" {
+			return promoteJSONResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}]}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "release-notes", "set", "--version-id", "ver-1", "--template", templatePath, "--vars", "version=2.3.0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "en-US") || !strings.Contains(runErr.Error(), "date") {
+		t.Fatalf("expected missing variable error for en-US, got %v", runErr)
+	}
+}
+
+func TestVersionsReleaseNotesSetValidationErrors(t *testing.T) {
+	setupAuth(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version id",
+			args:    []string{"versions", "release-notes", "set", "--template", "notes.tmpl"},
+			wantErr: "--version-id is required",
+		},
+		{
+			name:    "missing template",
+			args:    []string{"versions", "release-notes", "set", "--version-id", "ver-1"},
+			wantErr: "--template is required",
+		},
+		{
+			name:    "malformed vars",
+			args:    []string{"versions", "release-notes", "set", "--version-id", "ver-1", "--template", "notes.tmpl", "--vars", "version"},
+			wantErr: "--vars expects key=value pairs",
+		},
+		{
+			name:    "reserved locale var",
+			args:    []string{"versions", "release-notes", "set", "--version-id", "ver-1", "--template", "notes.tmpl", "--vars", "locale=en-US"},
+			wantErr: `--vars cannot set "locale"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -91,6 +91,16 @@ func TestWebhooksValidationErrors(t *testing.T) {
 			args:    []string{"webhooks", "deliveries", "--webhook-id", "wh-1", "--created-after", "2026-01-01", "--created-before", "2026-01-02"},
 			wantErr: "only one of --created-after or --created-before can be used",
 		},
+		{
+			name:    "deliveries list missing webhook id",
+			args:    []string{"webhooks", "deliveries", "list"},
+			wantErr: "--webhook-id is required",
+		},
+		{
+			name:    "deliveries list missing filter",
+			args:    []string{"webhooks", "deliveries", "list", "--webhook-id", "wh-1"},
+			wantErr: "--created-after or --created-before is required",
+		},
 		{
 			name:    "deliveries redeliver missing delivery id",
 			args:    []string{"webhooks", "deliveries", "redeliver"},
@@ -111,6 +121,16 @@ func TestWebhooksValidationErrors(t *testing.T) {
 			args:    []string{"webhooks", "ping"},
 			wantErr: "--webhook-id is required",
 		},
+		{
+			name:    "verify missing secret",
+			args:    []string{"webhooks", "verify", "--payload", "event.json"},
+			wantErr: "--secret is required",
+		},
+		{
+			name:    "verify missing payload",
+			args:    []string{"webhooks", "verify", "--secret", "secret"},
+			wantErr: "--payload is required",
+		},
 		{
 			name:    "serve invalid port high",
 			args:    []string{"webhooks", "serve", "--port", "70000"},
@@ -0,0 +1,122 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type webhookVerifyOutput struct {
+	Valid             bool   `json:"valid"`
+	Checked           bool   `json:"checked"`
+	ComputedSignature string `json:"computedSignature"`
+}
+
+func writeWebhookPayload(t *testing.T, body string) (string, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "event.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte("secret123"))
+	mac.Write([]byte(body))
+	return path, hex.EncodeToString(mac.Sum(nil))
+}
+
+func runWebhooksVerify(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"webhooks", "verify"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestWebhooksVerifyAcceptsValidSignature(t *testing.T) {
+	path, signature := writeWebhookPayload(t, `{"data":{"type":"webhookPingCreated"}}`)
+
+	for _, provided := range []string{"hmacsha256=" + signature, signature} {
+		stdout, err := runWebhooksVerify(t,
+			"--secret", "secret123",
+			"--payload", path,
+			"--signature", provided,
+			"--output", "json",
+		)
+		if err != nil {
+			t.Fatalf("run error for %q: %v", provided, err)
+		}
+
+		var result webhookVerifyOutput
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+		}
+		if !result.Checked || !result.Valid {
+			t.Fatalf("expected valid signature for %q, got %+v", provided, result)
+		}
+		if result.ComputedSignature != signature {
+			t.Fatalf("expected computed signature %q, got %q", signature, result.ComputedSignature)
+		}
+	}
+}
+
+func TestWebhooksVerifyRejectsTamperedPayload(t *testing.T) {
+	_, signature := writeWebhookPayload(t, `{"data":{"type":"webhookPingCreated"}}`)
+	tampered, _ := writeWebhookPayload(t, `{"data":{"type":"webhookPingCreated","extra":true}}`)
+
+	stdout, err := runWebhooksVerify(t,
+		"--secret", "secret123",
+		"--payload", tampered,
+		"--signature", "hmacsha256="+signature,
+		"--output", "json",
+	)
+	if err == nil {
+		t.Fatal("expected error for mismatched signature")
+	}
+	if _, ok := errors.AsType[ReportedError](err); !ok {
+		t.Fatalf("expected ReportedError, got %v", err)
+	}
+
+	var result webhookVerifyOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if !result.Checked || result.Valid {
+		t.Fatalf("expected invalid signature result, got %+v", result)
+	}
+}
+
+func TestWebhooksVerifyWithoutSignaturePrintsComputed(t *testing.T) {
+	path, signature := writeWebhookPayload(t, `{"data":{"type":"webhookPingCreated"}}`)
+
+	stdout, err := runWebhooksVerify(t,
+		"--secret", "secret123",
+		"--payload", path,
+		"--output", "json",
+	)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var result webhookVerifyOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v (%q)", err, stdout)
+	}
+	if result.Checked || result.ComputedSignature != signature {
+		t.Fatalf("expected unchecked result with computed signature, got %+v", result)
+	}
+}
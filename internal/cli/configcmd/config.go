@@ -0,0 +1,380 @@
+package configcmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// configSetting describes a config.json key that can be read and written.
+type configSetting struct {
+	key string
+	get func(cfg *config.Config) string
+	set func(cfg *config.Config, value string) error
+}
+
+// configEntry is a single resolved key/value pair.
+type configEntry struct {
+	Profile string `json:"profile,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+type configListResult struct {
+	Path    string        `json:"path"`
+	Entries []configEntry `json:"entries"`
+}
+
+var globalSettings = []configSetting{
+	stringSetting("app_id", func(cfg *config.Config) *string { return &cfg.AppID }),
+	stringSetting("default_key_name", func(cfg *config.Config) *string { return &cfg.DefaultKeyName }),
+	stringSetting("vendor_number", func(cfg *config.Config) *string { return &cfg.VendorNumber }),
+	stringSetting("analytics_vendor_number", func(cfg *config.Config) *string { return &cfg.AnalyticsVendorNumber }),
+	durationSetting("timeout", func(cfg *config.Config) *config.DurationValue { return &cfg.Timeout }),
+	durationSetting("upload_timeout", func(cfg *config.Config) *config.DurationValue { return &cfg.UploadTimeout }),
+	stringSetting("max_retries", func(cfg *config.Config) *string { return &cfg.MaxRetries }),
+	stringSetting("base_delay", func(cfg *config.Config) *string { return &cfg.BaseDelay }),
+	stringSetting("max_delay", func(cfg *config.Config) *string { return &cfg.MaxDelay }),
+	stringSetting("paginate_workers", func(cfg *config.Config) *string { return &cfg.PaginateWorkers }),
+	stringSetting("retry_log", func(cfg *config.Config) *string { return &cfg.RetryLog }),
+	stringSetting("debug", func(cfg *config.Config) *string { return &cfg.Debug }),
+}
+
+// profileKeys lists the keys that can be scoped to a named profile with --profile.
+var profileKeys = []string{"app_id"}
+
+func stringSetting(key string, field func(cfg *config.Config) *string) configSetting {
+	return configSetting{
+		key: key,
+		get: func(cfg *config.Config) string { return strings.TrimSpace(*field(cfg)) },
+		set: func(cfg *config.Config, value string) error {
+			*field(cfg) = value
+			return nil
+		},
+	}
+}
+
+func durationSetting(key string, field func(cfg *config.Config) *config.DurationValue) configSetting {
+	return configSetting{
+		key: key,
+		get: func(cfg *config.Config) string { return field(cfg).String() },
+		set: func(cfg *config.Config, value string) error {
+			parsed, err := config.ParseDurationValue(value)
+			if err != nil {
+				return err
+			}
+			*field(cfg) = parsed
+			return nil
+		},
+	}
+}
+
+func findGlobalSetting(key string) (configSetting, bool) {
+	for _, setting := range globalSettings {
+		if setting.key == key {
+			return setting, true
+		}
+	}
+	return configSetting{}, false
+}
+
+func globalKeyNames() string {
+	names := make([]string, 0, len(globalSettings))
+	for _, setting := range globalSettings {
+		names = append(names, setting.key)
+	}
+	return strings.Join(names, ", ")
+}
+
+func isProfileKey(key string) bool {
+	for _, name := range profileKeys {
+		if name == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigCommand returns the config command group.
+func ConfigCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "config",
+		ShortUsage: "asc config <subcommand> [flags]",
+		ShortHelp:  "Read and write CLI configuration values.",
+		LongHelp: `Read and write CLI configuration values.
+
+Values are stored in the active config file (./.asc/config.json when present,
+otherwise ~/.asc/config.json, or ASC_CONFIG_PATH). Credentials are managed with
+"asc auth login"; use --profile to set defaults for a named profile.
+
+A profile app_id is used when that profile is selected with --profile,
+ASC_PROFILE, or as the default profile, and takes precedence over the
+top-level app_id.
+
+Examples:
+  asc config list
+  asc config get --key timeout
+  asc config set --key timeout --value 90s
+  asc config set --profile "Client" --key app_id --value "123456789"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ConfigListCommand(),
+			ConfigGetCommand(),
+			ConfigSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ConfigListCommand returns the config list subcommand.
+func ConfigListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config list", flag.ExitOnError)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc config list [flags]",
+		ShortHelp:  "List configured values and profile defaults.",
+		LongHelp: `List configured values and profile defaults.
+
+Only keys with a value are listed. Credential secrets are never printed.
+
+Examples:
+  asc config list
+  asc config list --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path, cfg, err := loadConfigForEdit()
+			if err != nil {
+				return fmt.Errorf("config list: %w", err)
+			}
+
+			result := configListResult{Path: path, Entries: listConfigEntries(cfg)}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printConfigEntries(result.Entries, false) },
+				func() error { return printConfigEntries(result.Entries, true) },
+			)
+		},
+	}
+}
+
+// ConfigGetCommand returns the config get subcommand.
+func ConfigGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config get", flag.ExitOnError)
+	key := fs.String("key", "", "Config key to read (required)")
+	profile := fs.String("profile", "", "Read the key from a named profile")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc config get --key KEY [flags]",
+		ShortHelp:  "Read a configuration value.",
+		LongHelp: `Read a configuration value.
+
+Examples:
+  asc config get --key app_id
+  asc config get --profile "Client" --key app_id`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			keyValue, profileValue, err := validateKeyFlags(*key, *profile)
+			if err != nil {
+				return err
+			}
+
+			_, cfg, err := loadConfigForEdit()
+			if err != nil {
+				return fmt.Errorf("config get: %w", err)
+			}
+
+			entry := configEntry{Profile: profileValue, Key: keyValue}
+			if profileValue != "" {
+				entry.Value = cfg.ProfileAppID(profileValue)
+			} else {
+				setting, _ := findGlobalSetting(keyValue)
+				entry.Value = setting.get(cfg)
+			}
+			return printConfigEntry(entry, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+// ConfigSetCommand returns the config set subcommand.
+func ConfigSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config set", flag.ExitOnError)
+	key := fs.String("key", "", "Config key to write (required)")
+	value := fs.String("value", "", "Value to store; an empty value clears the key (required)")
+	profile := fs.String("profile", "", "Write the key to a named profile")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc config set --key KEY --value VALUE [flags]",
+		ShortHelp:  "Write a configuration value.",
+		LongHelp: `Write a configuration value.
+
+Values are validated before the config file is written.
+
+Examples:
+  asc config set --key app_id --value "123456789"
+  asc config set --key max_retries --value 5
+  asc config set --key timeout --value ""
+  asc config set --profile "Client" --key app_id --value "987654321"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			keyValue, profileValue, err := validateKeyFlags(*key, *profile)
+			if err != nil {
+				return err
+			}
+			valueSet := false
+			fs.Visit(func(f *flag.Flag) {
+				if f.Name == "value" {
+					valueSet = true
+				}
+			})
+			if !valueSet {
+				return shared.UsageError("--value is required")
+			}
+			newValue := strings.TrimSpace(*value)
+
+			path, cfg, err := loadConfigForEdit()
+			if err != nil {
+				return fmt.Errorf("config set: %w", err)
+			}
+
+			if profileValue != "" {
+				setProfileAppID(cfg, profileValue, newValue)
+			} else {
+				setting, _ := findGlobalSetting(keyValue)
+				if err := setting.set(cfg, newValue); err != nil {
+					return shared.UsageErrorf("invalid value for %s: %v", keyValue, err)
+				}
+			}
+			if err := cfg.Validate(); err != nil {
+				return shared.UsageErrorf("invalid value for %s: %v", keyValue, err)
+			}
+
+			if err := config.SaveAt(path, cfg); err != nil {
+				return fmt.Errorf("config set: %w", err)
+			}
+
+			return printConfigEntry(configEntry{Profile: profileValue, Key: keyValue, Value: newValue}, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func validateKeyFlags(key, profile string) (string, string, error) {
+	keyValue := strings.ToLower(strings.TrimSpace(key))
+	if keyValue == "" {
+		return "", "", shared.UsageError("--key is required")
+	}
+	profileValue := strings.TrimSpace(profile)
+	if profile != "" && profileValue == "" {
+		return "", "", shared.UsageError("--profile cannot be blank")
+	}
+	if profileValue != "" {
+		if !isProfileKey(keyValue) {
+			return "", "", shared.UsageErrorf("--key must be one of: %s when --profile is set", strings.Join(profileKeys, ", "))
+		}
+		return keyValue, profileValue, nil
+	}
+	if _, ok := findGlobalSetting(keyValue); !ok {
+		return "", "", shared.UsageErrorf("--key must be one of: %s", globalKeyNames())
+	}
+	return keyValue, "", nil
+}
+
+func loadConfigForEdit() (string, *config.Config, error) {
+	path, err := config.Path()
+	if err != nil {
+		return "", nil, err
+	}
+	cfg, err := config.LoadAt(path)
+	if err != nil {
+		if errors.Is(err, config.ErrNotFound) {
+			return path, &config.Config{}, nil
+		}
+		return "", nil, err
+	}
+	return path, cfg, nil
+}
+
+func setProfileAppID(cfg *config.Config, profile, appID string) {
+	settings := cfg.Profiles[profile]
+	settings.AppID = appID
+	if settings.AppID == "" && len(settings.CommandDefaults) == 0 {
+		delete(cfg.Profiles, profile)
+		if len(cfg.Profiles) == 0 {
+			cfg.Profiles = nil
+		}
+		return
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = make(map[string]config.ProfileSettings)
+	}
+	cfg.Profiles[profile] = settings
+}
+
+func listConfigEntries(cfg *config.Config) []configEntry {
+	entries := make([]configEntry, 0, len(globalSettings))
+	for _, setting := range globalSettings {
+		if value := setting.get(cfg); value != "" {
+			entries = append(entries, configEntry{Key: setting.key, Value: value})
+		}
+	}
+
+	profiles := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	for _, name := range profiles {
+		if appID := cfg.ProfileAppID(name); appID != "" {
+			entries = append(entries, configEntry{Profile: name, Key: "app_id", Value: appID})
+		}
+	}
+	return entries
+}
+
+func printConfigEntry(entry configEntry, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		entry,
+		format,
+		pretty,
+		func() error { return printConfigEntries([]configEntry{entry}, false) },
+		func() error { return printConfigEntries([]configEntry{entry}, true) },
+	)
+}
+
+func printConfigEntries(entries []configEntry, markdown bool) error {
+	headers := []string{"Profile", "Key", "Value"}
+	rows := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, []string{entry.Profile, entry.Key, entry.Value})
+	}
+	if markdown {
+		asc.RenderMarkdown(headers, rows)
+		return nil
+	}
+	asc.RenderTable(headers, rows)
+	return nil
+}
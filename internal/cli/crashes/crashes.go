@@ -80,7 +80,7 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 		prefix = "crashes"
 	}
 	if strings.TrimSpace(config.DeprecatedWarning) != "" {
-		fmt.Fprintln(os.Stderr, config.DeprecatedWarning)
+		fmt.Fprintln(shared.WarningWriter(), config.DeprecatedWarning)
 	}
 
 	if *flags.limit != 0 && (*flags.limit < 1 || *flags.limit > 200) {
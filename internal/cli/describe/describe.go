@@ -0,0 +1,179 @@
+package describe
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// describeTableItemLimit caps the related items listed per relationship in
+// table and markdown output. JSON output always includes the full first page.
+const describeTableItemLimit = 5
+
+// DescribeCommand returns the describe command.
+func DescribeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("describe", flag.ExitOnError)
+
+	relationships := fs.String("relationships", "", "Relationships to fetch, comma-separated (default: the type's primary relationships; \"none\" to skip)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "describe",
+		ShortUsage: "asc describe [flags] <type> <id>",
+		ShortHelp:  "Show a resource with a summary of its related resources.",
+		LongHelp: `Show a resource with a summary of its related resources.
+
+Fetches the resource and the first page of each of its primary relationships,
+then prints an overview: the resource's attributes followed by each
+relationship with its count and the first related items. A relationship that
+cannot be fetched is reported inline instead of failing the command.
+
+<type> is an App Store Connect resource type, for example apps, builds,
+appStoreVersions, betaGroups, betaTesters, bundleIds, profiles, or
+subscriptions. Run "asc describe" without arguments to list every
+supported type.
+
+Examples:
+  asc describe apps "123456789"
+  asc describe builds "BUILD_ID" --output json --pretty
+  asc describe appStoreVersions "VERSION_ID" --relationships "build,appStoreReviewDetail"
+  asc describe betaGroups "GROUP_ID" --relationships none`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) != 2 {
+				return shared.UsageErrorf("expected <type> <id>; supported types: %s", supportedTypesList())
+			}
+			resourceType, ok := asc.LookupDescribableResourceType(args[0])
+			if !ok {
+				return shared.UsageErrorf("unsupported type %q; supported types: %s", args[0], supportedTypesList())
+			}
+			id := strings.TrimSpace(args[1])
+			if id == "" {
+				return shared.UsageError("<id> must not be empty")
+			}
+
+			relationshipNames := asc.DescribeRelationshipsFor(resourceType)
+			if value := strings.TrimSpace(*relationships); value != "" {
+				relationshipNames = shared.SplitCSV(value)
+				if strings.EqualFold(value, "none") {
+					relationshipNames = nil
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("describe: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			description, err := client.DescribeResource(requestCtx, resourceType, id, relationshipNames)
+			if err != nil {
+				return fmt.Errorf("describe: failed to fetch %s %q: %w", resourceType, id, err)
+			}
+			for _, relationship := range description.Relationships {
+				if relationship.Error != "" {
+					fmt.Fprintf(shared.WarningWriter(), "Warning: could not fetch %s: %s\n", relationship.Name, relationship.Error)
+				}
+			}
+
+			return shared.PrintOutputWithRenderers(
+				description,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderDescription(description, asc.RenderTable) },
+				func() error { return renderDescription(description, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+func supportedTypesList() string {
+	types := asc.DescribableResourceTypes()
+	names := make([]string, 0, len(types))
+	for _, resourceType := range types {
+		names = append(names, string(resourceType))
+	}
+	return strings.Join(names, ", ")
+}
+
+func renderDescription(description *asc.ResourceDescription, render func([]string, [][]string)) error {
+	rows := [][]string{
+		{"type", string(description.Type)},
+		{"id", description.ID},
+	}
+	keys := make([]string, 0, len(description.Attributes))
+	for key := range description.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rows = append(rows, []string{key, formatAttributeValue(description.Attributes[key])})
+	}
+	render([]string{"Field", "Value"}, rows)
+
+	if len(description.Relationships) == 0 {
+		return nil
+	}
+	relationshipRows := make([][]string, 0, len(description.Relationships))
+	for _, relationship := range description.Relationships {
+		count := strconv.Itoa(relationship.Count)
+		if relationship.HasMore {
+			count += "+"
+		}
+		items := formatRelatedItems(relationship.Items)
+		if relationship.Error != "" {
+			count = "-"
+			items = "error: " + relationship.Error
+		}
+		relationshipRows = append(relationshipRows, []string{relationship.Name, count, items})
+	}
+	render([]string{"Relationship", "Count", "Items"}, relationshipRows)
+	return nil
+}
+
+func formatRelatedItems(items []asc.DescribeRelatedItem) string {
+	parts := make([]string, 0, describeTableItemLimit+1)
+	for i, item := range items {
+		if i == describeTableItemLimit {
+			parts = append(parts, fmt.Sprintf("... %d more", len(items)-describeTableItemLimit))
+			break
+		}
+		if item.Label != "" {
+			parts = append(parts, fmt.Sprintf("%s (%s)", item.Label, item.ID))
+			continue
+		}
+		parts = append(parts, item.ID)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatAttributeValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(encoded)
+	}
+}
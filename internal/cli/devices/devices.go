@@ -42,7 +42,8 @@ Examples:
   asc devices get --id "DEVICE_ID"
   asc devices local-udid
   asc devices register --name "iPhone 15" --udid "UDID" --platform IOS
-  asc devices update --id "DEVICE_ID" --status DISABLED`,
+  asc devices update --id "DEVICE_ID" --status DISABLED
+  asc devices import --file "./devices.csv" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -51,6 +52,7 @@ Examples:
 			DevicesLocalUDIDCommand(),
 			DevicesRegisterCommand(),
 			DevicesUpdateCommand(),
+			DevicesImportCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -369,7 +371,7 @@ Examples:
 
 			nameValue := strings.TrimSpace(*name)
 			statusRaw := strings.TrimSpace(*status)
-			if nameValue == "" && statusRaw == "" {
+			if nameValue == "" && statusRaw == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -0,0 +1,534 @@
+package devices
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/profiles"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var (
+	// Legacy iOS UDIDs are 40 hex characters; newer iOS devices use an
+	// 8-16 form and Macs use a hardware UUID.
+	legacyUDIDPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	modernUDIDPattern = regexp.MustCompile(`^[0-9A-F]{8}-[0-9A-F]{16}$`)
+	macUDIDPattern    = regexp.MustCompile(`^[0-9A-F]{8}-[0-9A-F]{4}-[0-9A-F]{4}-[0-9A-F]{4}-[0-9A-F]{12}$`)
+)
+
+// deviceProfileTypes lists the profile types that carry devices, by device platform.
+var deviceProfileTypes = map[string][]string{
+	"IOS":       {"IOS_APP_DEVELOPMENT", "IOS_APP_ADHOC"},
+	"VISION_OS": {"IOS_APP_DEVELOPMENT", "IOS_APP_ADHOC"},
+	"TV_OS":     {"TVOS_APP_DEVELOPMENT", "TVOS_APP_ADHOC"},
+	"MAC_OS":    {"MAC_APP_DEVELOPMENT", "MAC_CATALYST_APP_DEVELOPMENT"},
+}
+
+type devicesImportRow struct {
+	row      int
+	udid     string
+	name     string
+	platform string
+}
+
+type devicesImportProfile struct {
+	PreviousID   string `json:"previousId"`
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name"`
+	ProfileType  string `json:"profileType"`
+	AddedDevices int    `json:"addedDevices"`
+	Error        string `json:"error,omitempty"`
+}
+
+type devicesImportSummary struct {
+	InputFile          string                 `json:"inputFile"`
+	DryRun             bool                   `json:"dryRun"`
+	Registered         int                    `json:"registered"`
+	Existed            int                    `json:"existed"`
+	Disabled           int                    `json:"disabled"`
+	Duplicates         int                    `json:"duplicates"`
+	RegenerateProfiles bool                   `json:"regenerateProfiles"`
+	Profiles           []devicesImportProfile `json:"profiles,omitempty"`
+	ProfilesFailed     int                    `json:"profilesFailed"`
+	asc.BulkResult
+}
+
+// DevicesImportCommand returns the devices import subcommand.
+func DevicesImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	file := fs.String("file", "", "Device list file (CSV, or Apple's tab-separated device upload file)")
+	platform := fs.String("platform", "", "Platform for rows without one: "+strings.Join(devicePlatformList(), ", "))
+	dryRun := fs.Bool("dry-run", false, "Validate rows and print the plan without registering devices")
+	regenerate := fs.Bool("regenerate-profiles", false, "Regenerate development and ad-hoc profiles to include the new devices")
+	confirm := fs.Bool("confirm", false, "Confirm deleting and recreating profiles (required with --regenerate-profiles)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc devices import --file \"./devices.csv\" [flags]",
+		ShortHelp:  "Register devices in bulk from a file.",
+		LongHelp: `Register devices in bulk from a file.
+
+The file has udid, name, and platform columns. Both a CSV header and the
+header of Apple's device upload file are accepted:
+
+  udid,name,platform
+  00008030-001A2C3E0E68802E,QA iPhone 15,IOS
+
+  Device ID<TAB>Device Name<TAB>Device Platform
+
+The platform column is optional when --platform is set. UDIDs are trimmed
+and normalized to the case App Store Connect uses before matching.
+
+Each row is reported separately:
+  - registered devices succeed
+  - rows whose UDID is already registered are skipped, and disabled devices
+    are called out so they can be re-enabled with "asc devices update"
+  - repeated UDIDs within the file are skipped as duplicates
+  - rows with a missing name, malformed UDID, or unknown platform fail
+
+With --regenerate-profiles, every active development and ad-hoc profile for
+the platforms of the newly registered devices is regenerated to include them;
+invalid and expired profiles are left alone. Regenerating deletes and
+recreates each profile, so it requires --confirm.
+
+Examples:
+  asc devices import --file "./devices.csv" --dry-run
+  asc devices import --file "./devices.txt" --platform IOS
+  asc devices import --file "./devices.csv" --regenerate-profiles --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				return shared.UsageError("--file is required")
+			}
+			platformValue, err := normalizeDevicePlatform(*platform)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			planOnly := *dryRun || asc.DryRunEnabled()
+			if *regenerate && !planOnly && !*confirm {
+				return shared.UsageError("--confirm is required with --regenerate-profiles")
+			}
+			if *confirm && !*regenerate {
+				return shared.UsageError("--confirm requires --regenerate-profiles")
+			}
+
+			rows, err := readDevicesImportFile(fileValue)
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			summary, err := runDevicesImport(requestCtx, client, rows, platformValue, planOnly, *regenerate)
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+			summary.InputFile = filepath.Clean(fileValue)
+
+			if err := shared.PrintOutputWithRenderers(
+				summary,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderDevicesImportSummary(summary, false) },
+				func() error { return renderDevicesImportSummary(summary, true) },
+			); err != nil {
+				return err
+			}
+
+			if summary.Failed > 0 || summary.ProfilesFailed > 0 {
+				return shared.NewReportedError(fmt.Errorf("devices import: %d row(s) and %d profile(s) failed", summary.Failed, summary.ProfilesFailed))
+			}
+			return nil
+		},
+	}
+}
+
+// readDevicesImportFile reads device rows from a comma- or tab-separated file
+// with a header row. Tab-separated files follow Apple's device upload format.
+func readDevicesImportFile(path string) ([]devicesImportRow, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	buffered := bufio.NewReader(file)
+	firstLine, err := buffered.Peek(buffered.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, err
+	}
+	if i := strings.IndexByte(string(firstLine), '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	if strings.Contains(string(firstLine), "\t") {
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, shared.UsageError("device file is empty")
+		}
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	columns, err := parseDevicesImportHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]devicesImportRow, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read device file: %w", err)
+		}
+		empty := true
+		for _, value := range record {
+			if strings.TrimSpace(value) != "" {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			continue
+		}
+
+		get := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		rows = append(rows, devicesImportRow{
+			row:      len(rows) + 1,
+			udid:     get("udid"),
+			name:     get("name"),
+			platform: get("platform"),
+		})
+	}
+	if len(rows) == 0 {
+		return nil, shared.UsageError("device file has no rows")
+	}
+	return rows, nil
+}
+
+func parseDevicesImportHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, raw := range header {
+		var column string
+		switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(raw, "\ufeff"))) {
+		case "udid", "device id", "device_id":
+			column = "udid"
+		case "name", "device name", "device_name":
+			column = "name"
+		case "platform", "device platform", "device_platform":
+			column = "platform"
+		case "":
+			continue
+		default:
+			return nil, shared.UsageErrorf("unknown column %q (allowed: udid, name, platform)", strings.TrimSpace(raw))
+		}
+		if _, exists := columns[column]; exists {
+			return nil, shared.UsageErrorf("duplicate column %q", column)
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["udid"]; !ok {
+		return nil, shared.UsageError("device file header must include a udid column")
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, shared.UsageError("device file header must include a name column")
+	}
+	return columns, nil
+}
+
+// normalizeDeviceUDID trims a UDID and puts it in the case App Store Connect
+// stores: lowercase for legacy 40-character UDIDs, uppercase otherwise.
+func normalizeDeviceUDID(value string) (string, error) {
+	trimmed := strings.Join(strings.Fields(value), "")
+	if trimmed == "" {
+		return "", fmt.Errorf("udid is required")
+	}
+	if lower := strings.ToLower(trimmed); legacyUDIDPattern.MatchString(lower) {
+		return lower, nil
+	}
+	upper := strings.ToUpper(trimmed)
+	if modernUDIDPattern.MatchString(upper) || macUDIDPattern.MatchString(upper) {
+		return upper, nil
+	}
+	return "", fmt.Errorf("invalid UDID %q", value)
+}
+
+// normalizeImportPlatform accepts the API platform values plus the short names
+// used in Apple's device upload file.
+func normalizeImportPlatform(value, fallback string) (string, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(value))
+	switch trimmed {
+	case "":
+		if fallback == "" {
+			return "", fmt.Errorf("platform is required (set a platform column or --platform)")
+		}
+		return fallback, nil
+	case "MAC", "MACOS":
+		return "MAC_OS", nil
+	case "TVOS":
+		return "TV_OS", nil
+	case "VISIONOS", "XROS":
+		return "VISION_OS", nil
+	}
+	if slices.Contains(devicePlatformList(), trimmed) {
+		return trimmed, nil
+	}
+	return "", fmt.Errorf("platform must be one of: %s", strings.Join(devicePlatformList(), ", "))
+}
+
+// runDevicesImport registers each valid, unregistered row and, when asked,
+// regenerates the profiles that should include the new devices. Row failures
+// are recorded in the summary rather than returned as errors.
+func runDevicesImport(ctx context.Context, client *asc.Client, rows []devicesImportRow, defaultPlatform string, dryRun, regenerate bool) (*devicesImportSummary, error) {
+	existing, err := fetchDevicesByUDID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &devicesImportSummary{
+		DryRun:             dryRun,
+		RegenerateProfiles: regenerate,
+	}
+	summary.Total = len(rows)
+
+	seen := make(map[string]int)
+	// New device IDs by platform; a dry run records UDIDs since nothing is registered.
+	newDevicesByPlatform := make(map[string][]string)
+	for _, row := range rows {
+		item := asc.BulkResultItem{Row: row.row, Item: row.udid}
+
+		udid, err := normalizeDeviceUDID(row.udid)
+		if err != nil {
+			summary.Fail(item, err)
+			continue
+		}
+		item.Item = udid
+		if row.name == "" {
+			summary.Fail(item, errors.New("name is required"))
+			continue
+		}
+		platform, err := normalizeImportPlatform(row.platform, defaultPlatform)
+		if err != nil {
+			summary.Fail(item, err)
+			continue
+		}
+
+		key := strings.ToUpper(udid)
+		if firstRow, ok := seen[key]; ok {
+			summary.Duplicates++
+			summary.Skip(item, fmt.Sprintf("duplicate of row %d", firstRow))
+			continue
+		}
+		seen[key] = row.row
+
+		if device, ok := existing[key]; ok {
+			item.ID = device.ID
+			if device.Attributes.Status == asc.DeviceStatusDisabled {
+				summary.Disabled++
+				summary.Skip(item, fmt.Sprintf("registered but disabled; enable with: asc devices update --id %q --status ENABLED", device.ID))
+				continue
+			}
+			summary.Existed++
+			summary.Skip(item, "already registered")
+			continue
+		}
+
+		if dryRun {
+			summary.Registered++
+			item.Detail = "would register " + platform
+			summary.Succeed(item)
+			newDevicesByPlatform[platform] = append(newDevicesByPlatform[platform], udid)
+			continue
+		}
+
+		created, err := client.CreateDevice(ctx, asc.DeviceCreateAttributes{
+			Name:     row.name,
+			UDID:     udid,
+			Platform: asc.DevicePlatform(platform),
+		})
+		if err != nil {
+			summary.Fail(item, err)
+			continue
+		}
+		summary.Registered++
+		item.ID = created.Data.ID
+		item.Detail = "registered " + platform
+		summary.Succeed(item)
+		newDevicesByPlatform[platform] = append(newDevicesByPlatform[platform], created.Data.ID)
+	}
+
+	if regenerate && len(newDevicesByPlatform) > 0 {
+		if err := regenerateDeviceProfiles(ctx, client, summary, newDevicesByPlatform, dryRun); err != nil {
+			return nil, err
+		}
+	}
+	return summary, nil
+}
+
+func fetchDevicesByUDID(ctx context.Context, client *asc.Client) (map[string]asc.Resource[asc.DeviceAttributes], error) {
+	firstPage, err := client.GetDevices(ctx, asc.WithDevicesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch devices: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch devices: %w", err)
+	}
+	devices, ok := all.(*asc.DevicesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", all)
+	}
+
+	byUDID := make(map[string]asc.Resource[asc.DeviceAttributes], len(devices.Data))
+	for _, device := range devices.Data {
+		byUDID[strings.ToUpper(strings.TrimSpace(device.Attributes.UDID))] = device
+	}
+	return byUDID, nil
+}
+
+// regenerateDeviceProfiles regenerates every active profile whose type
+// carries devices of a platform that gained devices. Invalid and expired
+// profiles are left alone. Each profile failure is recorded and the remaining
+// profiles are still regenerated.
+func regenerateDeviceProfiles(ctx context.Context, client *asc.Client, summary *devicesImportSummary, newDevicesByPlatform map[string][]string, dryRun bool) error {
+	addByType := make(map[string][]string)
+	for platform, deviceIDs := range newDevicesByPlatform {
+		for _, profileType := range deviceProfileTypes[platform] {
+			addByType[profileType] = append(addByType[profileType], deviceIDs...)
+		}
+	}
+	profileTypes := make([]string, 0, len(addByType))
+	for profileType := range addByType {
+		profileTypes = append(profileTypes, profileType)
+	}
+	sort.Strings(profileTypes)
+
+	firstPage, err := client.GetProfiles(ctx, asc.WithProfilesTypes(profileTypes), asc.WithProfilesLimit(200))
+	if err != nil {
+		return fmt.Errorf("fetch profiles: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("fetch profiles: %w", err)
+	}
+	profilesResp, ok := all.(*asc.ProfilesResponse)
+	if !ok {
+		return fmt.Errorf("unexpected profiles response type %T", all)
+	}
+
+	summary.Profiles = make([]devicesImportProfile, 0, len(profilesResp.Data))
+	for _, profile := range profilesResp.Data {
+		if profile.Attributes.ProfileState != asc.ProfileStateActive {
+			continue
+		}
+		addDeviceIDs := addByType[profile.Attributes.ProfileType]
+		if len(addDeviceIDs) == 0 {
+			continue
+		}
+		result := devicesImportProfile{
+			PreviousID:   profile.ID,
+			Name:         profile.Attributes.Name,
+			ProfileType:  profile.Attributes.ProfileType,
+			AddedDevices: len(addDeviceIDs),
+		}
+		if !dryRun {
+			_, regenerated, err := profiles.RegenerateProfile(ctx, client, profile.ID, profiles.RegenerateOptions{AddDeviceIDs: addDeviceIDs})
+			if err != nil {
+				result.Error = err.Error()
+				summary.ProfilesFailed++
+			} else {
+				result.ID = regenerated.ID
+			}
+		}
+		summary.Profiles = append(summary.Profiles, result)
+	}
+	return nil
+}
+
+func renderDevicesImportSummary(summary *devicesImportSummary, markdown bool) error {
+	if summary == nil {
+		return fmt.Errorf("summary is nil")
+	}
+
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Input File", "Dry Run", "Total", "Registered", "Existed", "Disabled", "Duplicates", "Failed", "Profiles"},
+		[][]string{{
+			summary.InputFile,
+			fmt.Sprintf("%t", summary.DryRun),
+			fmt.Sprintf("%d", summary.Total),
+			fmt.Sprintf("%d", summary.Registered),
+			fmt.Sprintf("%d", summary.Existed),
+			fmt.Sprintf("%d", summary.Disabled),
+			fmt.Sprintf("%d", summary.Duplicates),
+			fmt.Sprintf("%d", summary.Failed),
+			fmt.Sprintf("%d", len(summary.Profiles)),
+		}},
+	)
+
+	if len(summary.Items) > 0 {
+		render(asc.BulkResultRows(&summary.BulkResult))
+	}
+	if len(summary.Profiles) > 0 {
+		rows := make([][]string, 0, len(summary.Profiles))
+		for _, profile := range summary.Profiles {
+			rows = append(rows, []string{
+				profile.PreviousID,
+				profile.ID,
+				profile.Name,
+				profile.ProfileType,
+				fmt.Sprintf("%d", profile.AddedDevices),
+				profile.Error,
+			})
+		}
+		render([]string{"Previous ID", "New ID", "Name", "Type", "Added Devices", "Error"}, rows)
+	}
+	return nil
+}
@@ -152,3 +152,38 @@ func TestLocalMacUDID_UsesContextTimeout(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestNormalizeDeviceUDID(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: " 00008030-001a2c3e0e68802e ", want: "00008030-001A2C3E0E68802E"},
+		{input: "A1B2C3D4E5F60718293A4B5C6D7E8F9012345678", want: "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"},
+		{input: "12345678-90ab-cdef-1234-567890abcdef", want: "12345678-90AB-CDEF-1234-567890ABCDEF"},
+	}
+	for _, test := range tests {
+		got, err := normalizeDeviceUDID(test.input)
+		if err != nil || got != test.want {
+			t.Fatalf("normalizeDeviceUDID(%q) = %q, %v; want %q", test.input, got, err, test.want)
+		}
+	}
+
+	for _, input := range []string{"", "not-a-udid", "00008030001A2C3E0E68802E"} {
+		if _, err := normalizeDeviceUDID(input); err == nil {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+}
+
+func TestNormalizeImportPlatform(t *testing.T) {
+	for input, want := range map[string]string{"ios": "IOS", "mac": "MAC_OS", "tvOS": "TV_OS", "": "IOS"} {
+		got, err := normalizeImportPlatform(input, "IOS")
+		if err != nil || got != want {
+			t.Fatalf("normalizeImportPlatform(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := normalizeImportPlatform("", ""); err == nil || !strings.Contains(err.Error(), "platform is required") {
+		t.Fatalf("expected missing platform error, got %v", err)
+	}
+}
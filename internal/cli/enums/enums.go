@@ -0,0 +1,96 @@
+package enums
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type enumsListResult struct {
+	Data []asc.EnumDefinition `json:"data"`
+}
+
+// EnumsCommand returns the enums command group.
+func EnumsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("enums", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "enums",
+		ShortUsage: "asc enums <subcommand> [flags]",
+		ShortHelp:  "Discover allowed enum values offline.",
+		LongHelp: `Discover allowed enum values offline.
+
+Lists the string enums the client defines (device classes, app clip business
+categories, review submission states, and more) without calling the API.
+
+Examples:
+  asc enums list
+  asc enums list --type deviceClass
+  asc enums list --type appClipAdvancedExperienceBusinessCategory --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			EnumsListCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// EnumsListCommand returns the enums list subcommand.
+func EnumsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("enums list", flag.ExitOnError)
+	enumType := fs.String("type", "", "Enum name to show (e.g. deviceClass); omit to list all")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc enums list [flags]",
+		ShortHelp:  "List enum names and their allowed values.",
+		LongHelp: `List enum names and their allowed values.
+
+Enum names match the client type names in lowerCamelCase and are matched
+case-insensitively.
+
+Examples:
+  asc enums list
+  asc enums list --type deviceClass
+  asc enums list --type webhookEventType --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			result := enumsListResult{Data: asc.Enums()}
+
+			if name := strings.TrimSpace(*enumType); name != "" {
+				enum, ok := asc.LookupEnum(name)
+				if !ok {
+					return shared.UsageErrorf("unknown --type %q (run \"asc enums list\" to see available enums)", name)
+				}
+				result.Data = []asc.EnumDefinition{enum}
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printEnums(result.Data, asc.RenderTable) },
+				func() error { return printEnums(result.Data, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+func printEnums(enums []asc.EnumDefinition, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(enums))
+	for _, enum := range enums {
+		rows = append(rows, []string{enum.Name, strings.Join(enum.Values, ", ")})
+	}
+	render([]string{"Enum", "Values"}, rows)
+	return nil
+}
@@ -0,0 +1,327 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	exporterDefaultListen      = "127.0.0.1:9108"
+	exporterDefaultInterval    = 5 * time.Minute
+	exporterMinInterval        = 30 * time.Second
+	exporterDefaultGranularity = "PT1H"
+)
+
+// collectorState holds the last successful result and counters for a collector.
+type collectorState struct {
+	families    []metricFamily
+	scrapes     int
+	errors      int
+	success     bool
+	duration    time.Duration
+	lastSuccess time.Time
+}
+
+type exporterRuntime struct {
+	client     exporterClient
+	target     exporterTarget
+	collectors []string
+
+	mu     sync.RWMutex
+	states map[string]*collectorState
+}
+
+func newExporterRuntime(client exporterClient, target exporterTarget, collectors []string) *exporterRuntime {
+	states := make(map[string]*collectorState, len(collectors))
+	for _, name := range collectors {
+		states[name] = &collectorState{}
+	}
+	return &exporterRuntime{
+		client:     client,
+		target:     target,
+		collectors: collectors,
+		states:     states,
+	}
+}
+
+// scrape runs every selected collector once. A failing collector keeps its
+// previous samples so dashboards show stale values rather than gaps, and the
+// failure is visible through asc_exporter_collector_up.
+func (r *exporterRuntime) scrape(ctx context.Context) {
+	for _, name := range r.collectors {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		started := time.Now()
+		families, err := exporterCollectors[name](requestCtx, r.client, r.target)
+		elapsed := time.Since(started)
+		cancel()
+
+		r.mu.Lock()
+		state := r.states[name]
+		state.scrapes++
+		state.duration = elapsed
+		if err != nil {
+			state.errors++
+			state.success = false
+			fmt.Fprintf(shared.WarningWriter(), "Warning: exporter: %v\n", err)
+		} else {
+			state.success = true
+			state.families = families
+			state.lastSuccess = time.Now()
+		}
+		r.mu.Unlock()
+	}
+}
+
+func (r *exporterRuntime) families() []metricFamily {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	up := metricFamily{Name: "asc_exporter_collector_up", Help: "Whether the last scrape of the collector succeeded.", Kind: metricKindGauge}
+	scrapes := metricFamily{Name: "asc_exporter_scrapes_total", Help: "Total App Store Connect scrapes by collector.", Kind: metricKindCounter}
+	scrapeErrors := metricFamily{Name: "asc_exporter_scrape_errors_total", Help: "Total failed App Store Connect scrapes by collector.", Kind: metricKindCounter}
+	duration := metricFamily{Name: "asc_exporter_scrape_duration_seconds", Help: "Duration of the last scrape by collector.", Kind: metricKindGauge}
+	lastSuccess := metricFamily{Name: "asc_exporter_last_success_timestamp_seconds", Help: "Unix time of the last successful scrape by collector.", Kind: metricKindGauge}
+
+	var collected []metricFamily
+	for _, name := range r.collectors {
+		state := r.states[name]
+		if state.scrapes == 0 {
+			continue
+		}
+		collector := label("collector", name)
+		upValue := 0.0
+		if state.success {
+			upValue = 1
+		}
+		up.add(upValue, collector)
+		scrapes.add(float64(state.scrapes), collector)
+		scrapeErrors.add(float64(state.errors), collector)
+		duration.add(state.duration.Seconds(), collector)
+		if !state.lastSuccess.IsZero() {
+			lastSuccess.add(float64(state.lastSuccess.Unix()), collector)
+		}
+		collected = append(collected, state.families...)
+	}
+
+	return append([]metricFamily{up, scrapes, scrapeErrors, duration, lastSuccess}, collected...)
+}
+
+func (r *exporterRuntime) newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var body bytes.Buffer
+		if err := writeMetricFamilies(&body, r.families()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = w.Write(body.Bytes())
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = fmt.Fprintln(w, "asc exporter: metrics are served at /metrics")
+	})
+	return mux
+}
+
+// ExporterCommand returns the Prometheus exporter command.
+func ExporterCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("exporter", flag.ExitOnError)
+
+	listen := fs.String("listen", exporterDefaultListen, "Address to serve /metrics on (host:port)")
+	allowRemote := fs.Bool("allow-remote", false, "Allow listening on non-loopback addresses")
+	collect := fs.String("collect", "reviews,builds", "Collectors to run, comma-separated: "+strings.Join(exporterCollectorNames, ", "))
+	appID := fs.String("app", "", "App Store Connect app ID for reviews/builds (or ASC_APP_ID env)")
+	queueIDs := fs.String("queue-id", "", "Matchmaking queue ID(s) for the metrics collector, comma-separated")
+	granularity := fs.String("granularity", exporterDefaultGranularity, "Matchmaking metrics granularity: P1D, PT1H, PT15M")
+	interval := fs.Duration("interval", exporterDefaultInterval, "Time between App Store Connect scrapes (minimum 30s)")
+
+	return &ffcli.Command{
+		Name:       "exporter",
+		ShortUsage: "asc exporter [flags]",
+		ShortHelp:  "Serve App Store Connect data as Prometheus metrics.",
+		LongHelp: `Serve App Store Connect data as Prometheus metrics.
+
+The exporter scrapes the selected collectors every --interval and serves the
+latest values at /metrics in the Prometheus text format. App Store Connect is
+never called from the /metrics handler, so scrape frequency on the Prometheus
+side does not affect API rate limits.
+
+Collectors:
+  reviews   Rating counts, average rating, and newest review time (latest 200 reviews)
+  builds    Build counts by processing state and the latest build (latest 200 builds)
+  metrics   Matchmaking queue sizes and requests by result (requires --queue-id)
+
+Security note:
+  The default address is loopback-only.
+  Listening on non-loopback addresses requires --allow-remote.
+
+Examples:
+  asc exporter --app "APP_ID"
+  asc exporter --app "APP_ID" --listen 127.0.0.1:9108 --collect reviews,builds --interval 10m
+  asc exporter --collect metrics --queue-id "QUEUE_ID" --granularity PT15M
+  asc exporter --app "APP_ID" --listen :9108 --allow-remote --collect reviews,builds,metrics --queue-id "QUEUE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: exporter does not accept positional arguments")
+				return flag.ErrHelp
+			}
+
+			collectors, err := normalizeExporterCollectors(*collect)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			target := exporterTarget{
+				AppID:       shared.ResolveAppID(*appID),
+				QueueIDs:    shared.SplitCSV(*queueIDs),
+				Granularity: strings.ToUpper(strings.TrimSpace(*granularity)),
+			}
+			if (slices.Contains(collectors, collectorReviews) || slices.Contains(collectors, collectorBuilds)) && target.AppID == "" {
+				return shared.UsageError("--app is required for the reviews and builds collectors (or set ASC_APP_ID)")
+			}
+			if slices.Contains(collectors, collectorMetrics) {
+				if len(target.QueueIDs) == 0 {
+					return shared.UsageError("--queue-id is required for the metrics collector")
+				}
+				switch target.Granularity {
+				case "P1D", "PT1H", "PT15M":
+				default:
+					return shared.UsageError("--granularity must be one of: P1D, PT1H, PT15M")
+				}
+			}
+			if *interval < exporterMinInterval {
+				return shared.UsageErrorf("--interval must be at least %s", exporterMinInterval)
+			}
+
+			host, _, err := net.SplitHostPort(strings.TrimSpace(*listen))
+			if err != nil {
+				return shared.UsageErrorf("--listen must be host:port: %v", err)
+			}
+			if !*allowRemote && !shared.IsLoopbackBindHost(host) {
+				return shared.UsageErrorf("listening on non-loopback address %q requires --allow-remote", strings.TrimSpace(*listen))
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("exporter: %w", err)
+			}
+
+			listener, err := net.Listen("tcp", strings.TrimSpace(*listen))
+			if err != nil {
+				return fmt.Errorf("exporter: failed to listen on %s: %w", strings.TrimSpace(*listen), err)
+			}
+			defer listener.Close()
+
+			runtime := newExporterRuntime(client, target, collectors)
+			return runExporter(ctx, runtime, listener, *interval)
+		},
+	}
+}
+
+// runExporter serves /metrics on listener and scrapes on every interval until
+// ctx is cancelled.
+func runExporter(ctx context.Context, runtime *exporterRuntime, listener net.Listener, interval time.Duration) error {
+	server := &http.Server{
+		Handler:           runtime.newHandler(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       15 * time.Second,
+		WriteTimeout:      15 * time.Second,
+		IdleTimeout:       60 * time.Second,
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErrCh <- err
+			return
+		}
+		serveErrCh <- nil
+	}()
+
+	fmt.Fprintf(shared.WarningWriter(), "Serving Prometheus metrics on http://%s/metrics\n", listener.Addr().String())
+
+	// The scrape loop gets its own context so a Serve failure can stop it
+	// while ctx is still live.
+	scrapeCtx, stopScrape := context.WithCancel(ctx)
+	defer stopScrape()
+	scrapeDone := make(chan struct{})
+	go func() {
+		defer close(scrapeDone)
+		runtime.scrape(scrapeCtx)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-scrapeCtx.Done():
+				return
+			case <-ticker.C:
+				runtime.scrape(scrapeCtx)
+			}
+		}
+	}()
+
+	select {
+	case err := <-serveErrCh:
+		stopScrape()
+		<-scrapeDone
+		if err != nil {
+			return fmt.Errorf("exporter: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+		<-scrapeDone
+		if err := <-serveErrCh; err != nil {
+			return fmt.Errorf("exporter: %w", err)
+		}
+		return nil
+	}
+}
+
+func normalizeExporterCollectors(value string) ([]string, error) {
+	values := shared.SplitCSV(value)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("--collect must include at least one of: %s", strings.Join(exporterCollectorNames, ", "))
+	}
+
+	var collectors []string
+	for _, item := range values {
+		name := strings.ToLower(strings.TrimSpace(item))
+		if _, ok := exporterCollectors[name]; !ok {
+			return nil, fmt.Errorf("--collect: unknown collector %q (valid: %s)", item, strings.Join(exporterCollectorNames, ", "))
+		}
+		if !slices.Contains(collectors, name) {
+			collectors = append(collectors, name)
+		}
+	}
+	return collectors, nil
+}
@@ -0,0 +1,241 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	collectorReviews = "reviews"
+	collectorBuilds  = "builds"
+	collectorMetrics = "metrics"
+
+	// exporterPageLimit bounds each collector to one API page per scrape.
+	exporterPageLimit = 200
+)
+
+var exporterCollectorNames = []string{collectorReviews, collectorBuilds, collectorMetrics}
+
+// exporterClient is the subset of the API client the collectors need.
+type exporterClient interface {
+	GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error)
+	GetBuilds(ctx context.Context, appID string, opts ...asc.BuildsOption) (*asc.BuildsResponse, error)
+	GetGameCenterMatchmakingQueueSizes(ctx context.Context, queueID string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSizesResponse, error)
+	GetGameCenterMatchmakingQueueRequests(ctx context.Context, queueID string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueRequestsResponse, error)
+}
+
+type exporterTarget struct {
+	AppID       string
+	QueueIDs    []string
+	Granularity string
+}
+
+type collectFunc func(ctx context.Context, client exporterClient, target exporterTarget) ([]metricFamily, error)
+
+var exporterCollectors = map[string]collectFunc{
+	collectorReviews: collectReviews,
+	collectorBuilds:  collectBuilds,
+	collectorMetrics: collectMatchmakingMetrics,
+}
+
+// collectReviews summarizes the most recent page of customer reviews.
+func collectReviews(ctx context.Context, client exporterClient, target exporterTarget) ([]metricFamily, error) {
+	resp, err := client.GetReviews(ctx, target.AppID, asc.WithLimit(exporterPageLimit), asc.WithReviewSort("-createdDate"))
+	if err != nil {
+		return nil, fmt.Errorf("reviews: %w", err)
+	}
+
+	counts := map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0}
+	total := 0
+	sum := 0
+	var latest time.Time
+	for _, review := range resp.Data {
+		rating := review.Attributes.Rating
+		if rating < 1 || rating > 5 {
+			continue
+		}
+		counts[rating]++
+		total++
+		sum += rating
+		if created, ok := parseExporterTime(review.Attributes.CreatedDate); ok && created.After(latest) {
+			latest = created
+		}
+	}
+
+	byRating := metricFamily{
+		Name: "asc_customer_reviews_recent",
+		Help: fmt.Sprintf("Customer reviews by star rating among the %d most recent reviews.", exporterPageLimit),
+		Kind: metricKindGauge,
+	}
+	for rating := 1; rating <= 5; rating++ {
+		byRating.add(float64(counts[rating]), label("app", target.AppID), label("rating", strconv.Itoa(rating)))
+	}
+
+	average := metricFamily{
+		Name: "asc_customer_review_recent_average_rating",
+		Help: "Average star rating among the most recent customer reviews.",
+		Kind: metricKindGauge,
+	}
+	if total > 0 {
+		average.add(float64(sum)/float64(total), label("app", target.AppID))
+	}
+
+	newest := metricFamily{
+		Name: "asc_customer_review_latest_timestamp_seconds",
+		Help: "Unix time of the newest customer review.",
+		Kind: metricKindGauge,
+	}
+	if !latest.IsZero() {
+		newest.add(float64(latest.Unix()), label("app", target.AppID))
+	}
+
+	return []metricFamily{byRating, average, newest}, nil
+}
+
+// collectBuilds reports processing states for the most recently uploaded builds.
+func collectBuilds(ctx context.Context, client exporterClient, target exporterTarget) ([]metricFamily, error) {
+	resp, err := client.GetBuilds(ctx, target.AppID, asc.WithBuildsLimit(exporterPageLimit), asc.WithBuildsSort("-uploadedDate"))
+	if err != nil {
+		return nil, fmt.Errorf("builds: %w", err)
+	}
+
+	states := map[string]int{}
+	for _, build := range resp.Data {
+		state := strings.TrimSpace(build.Attributes.ProcessingState)
+		if state == "" {
+			state = "UNKNOWN"
+		}
+		states[state]++
+	}
+
+	byState := metricFamily{
+		Name: "asc_builds_recent",
+		Help: fmt.Sprintf("Builds by processing state among the %d most recently uploaded builds.", exporterPageLimit),
+		Kind: metricKindGauge,
+	}
+	for _, state := range sortedKeys(states) {
+		byState.add(float64(states[state]), label("app", target.AppID), label("processing_state", state))
+	}
+
+	latestInfo := metricFamily{
+		Name: "asc_build_latest_info",
+		Help: "The most recently uploaded build; always 1.",
+		Kind: metricKindGauge,
+	}
+	latestUpload := metricFamily{
+		Name: "asc_build_latest_upload_timestamp_seconds",
+		Help: "Unix time the most recent build was uploaded.",
+		Kind: metricKindGauge,
+	}
+	if len(resp.Data) > 0 {
+		latest := resp.Data[0]
+		latestInfo.add(1,
+			label("app", target.AppID),
+			label("build_id", latest.ID),
+			label("version", latest.Attributes.Version),
+			label("processing_state", latest.Attributes.ProcessingState),
+		)
+		if uploaded, ok := parseExporterTime(latest.Attributes.UploadedDate); ok {
+			latestUpload.add(float64(uploaded.Unix()), label("app", target.AppID))
+		}
+	}
+
+	return []metricFamily{byState, latestInfo, latestUpload}, nil
+}
+
+// collectMatchmakingMetrics exports the latest data point of queue size and
+// request metrics for each matchmaking queue.
+func collectMatchmakingMetrics(ctx context.Context, client exporterClient, target exporterTarget) ([]metricFamily, error) {
+	families := map[string]*metricFamily{}
+	var order []string
+	record := func(prefix, help string, queueID string, resp *asc.GameCenterMetricsResponse) {
+		for _, series := range resp.Data {
+			point, ok := latestMetricsDataPoint(series.DataPoints)
+			if !ok {
+				continue
+			}
+			labels := []metricLabel{label("queue", queueID)}
+			for _, name := range sortedKeys(series.Dimensions) {
+				labels = append(labels, label(metricNameSegment(name), metricsDimensionValue(series.Dimensions[name].Data)))
+			}
+			for _, valueName := range sortedKeys(point.Values) {
+				value, ok := point.Values[valueName].(float64)
+				if !ok {
+					continue
+				}
+				name := prefix + "_" + metricNameSegment(valueName)
+				family, exists := families[name]
+				if !exists {
+					family = &metricFamily{Name: name, Help: help + " (" + valueName + ", latest bucket).", Kind: metricKindGauge}
+					families[name] = family
+					order = append(order, name)
+				}
+				family.add(value, labels...)
+			}
+		}
+	}
+
+	for _, queueID := range target.QueueIDs {
+		sizes, err := client.GetGameCenterMatchmakingQueueSizes(ctx, queueID, asc.WithGCMatchmakingMetricsGranularity(target.Granularity))
+		if err != nil {
+			return nil, fmt.Errorf("metrics: queue %s sizes: %w", queueID, err)
+		}
+		record("asc_matchmaking_queue_size", "Game Center matchmaking queue size", queueID, sizes)
+
+		requests, err := client.GetGameCenterMatchmakingQueueRequests(ctx, queueID,
+			asc.WithGCMatchmakingMetricsGranularity(target.Granularity),
+			asc.WithGCMatchmakingMetricsGroupBy([]string{"result"}),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("metrics: queue %s requests: %w", queueID, err)
+		}
+		record("asc_matchmaking_queue_requests", "Game Center matchmaking queue requests", queueID, requests)
+	}
+
+	result := make([]metricFamily, 0, len(order))
+	for _, name := range order {
+		result = append(result, *families[name])
+	}
+	return result, nil
+}
+
+func latestMetricsDataPoint(points []asc.GameCenterMetricsDataPoint) (asc.GameCenterMetricsDataPoint, bool) {
+	if len(points) == 0 {
+		return asc.GameCenterMetricsDataPoint{}, false
+	}
+	latest := points[0]
+	for _, point := range points[1:] {
+		if point.Start > latest.Start {
+			latest = point
+		}
+	}
+	return latest, true
+}
+
+func metricsDimensionValue(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case map[string]any:
+		if id, ok := typed["id"].(string); ok {
+			return id
+		}
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func parseExporterTime(value string) (time.Time, bool) {
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return parsed, true
+}
@@ -0,0 +1,125 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type metricKind string
+
+const (
+	metricKindGauge   metricKind = "gauge"
+	metricKindCounter metricKind = "counter"
+)
+
+// metricLabel is a single name="value" pair on a sample.
+type metricLabel struct {
+	Name  string
+	Value string
+}
+
+type metricSample struct {
+	Labels []metricLabel
+	Value  float64
+}
+
+// metricFamily is one metric name with its HELP/TYPE header and samples,
+// rendered in the Prometheus text exposition format.
+type metricFamily struct {
+	Name    string
+	Help    string
+	Kind    metricKind
+	Samples []metricSample
+}
+
+func (f *metricFamily) add(value float64, labels ...metricLabel) {
+	f.Samples = append(f.Samples, metricSample{Labels: labels, Value: value})
+}
+
+func label(name, value string) metricLabel {
+	return metricLabel{Name: name, Value: value}
+}
+
+// writeMetricFamilies renders families in order. Families without samples
+// are skipped so scrapers never see a TYPE line with no data.
+func writeMetricFamilies(w io.Writer, families []metricFamily) error {
+	for _, family := range families {
+		if len(family.Samples) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", family.Name, escapeMetricHelp(family.Help), family.Name, family.Kind); err != nil {
+			return err
+		}
+		for _, sample := range family.Samples {
+			if _, err := fmt.Fprintf(w, "%s%s %s\n", family.Name, formatMetricLabels(sample.Labels), formatMetricValue(sample.Value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func formatMetricLabels(labels []metricLabel) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(labels))
+	for _, l := range labels {
+		parts = append(parts, fmt.Sprintf("%s=\"%s\"", l.Name, escapeMetricLabelValue(l.Value)))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatMetricValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func escapeMetricLabelValue(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return replacer.Replace(value)
+}
+
+func escapeMetricHelp(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	return replacer.Replace(value)
+}
+
+// metricNameSegment converts an API value name such as "averageSecondsInQueue"
+// into a Prometheus-safe snake_case segment ("average_seconds_in_queue").
+func metricNameSegment(value string) string {
+	var b strings.Builder
+	prevLower := false
+	for _, r := range value {
+		switch {
+		case unicode.IsUpper(r):
+			if prevLower {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			prevLower = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			prevLower = true
+		default:
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+				b.WriteByte('_')
+			}
+			prevLower = false
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
+
+// sortedKeys returns map keys in a stable order for deterministic output.
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,242 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type fakeExporterClient struct {
+	reviews   *asc.ReviewsResponse
+	builds    *asc.BuildsResponse
+	sizes     *asc.GameCenterMetricsResponse
+	requests  *asc.GameCenterMetricsResponse
+	buildsErr error
+}
+
+func (f *fakeExporterClient) GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error) {
+	return f.reviews, nil
+}
+
+func (f *fakeExporterClient) GetBuilds(ctx context.Context, appID string, opts ...asc.BuildsOption) (*asc.BuildsResponse, error) {
+	if f.buildsErr != nil {
+		return nil, f.buildsErr
+	}
+	return f.builds, nil
+}
+
+func (f *fakeExporterClient) GetGameCenterMatchmakingQueueSizes(ctx context.Context, queueID string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSizesResponse, error) {
+	return f.sizes, nil
+}
+
+func (f *fakeExporterClient) GetGameCenterMatchmakingQueueRequests(ctx context.Context, queueID string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueRequestsResponse, error) {
+	return f.requests, nil
+}
+
+func decodeExporterMetrics(t *testing.T, body string) *asc.GameCenterMetricsResponse {
+	t.Helper()
+	var resp asc.GameCenterMetricsResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode metrics: %v", err)
+	}
+	return &resp
+}
+
+func scrapeMetricsText(t *testing.T, runtime *exporterRuntime) string {
+	t.Helper()
+	server := httptest.NewServer(runtime.newHandler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/plain; version=0.0.4") {
+		t.Fatalf("unexpected content type %q", got)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	return string(body)
+}
+
+func assertMetricLine(t *testing.T, output, line string) {
+	t.Helper()
+	for _, got := range strings.Split(output, "\n") {
+		if got == line {
+			return
+		}
+	}
+	t.Fatalf("expected line %q in output:\n%s", line, output)
+}
+
+func TestExporterServesReviewAndBuildMetrics(t *testing.T) {
+	client := &fakeExporterClient{
+		reviews: &asc.ReviewsResponse{Data: []asc.Resource[asc.ReviewAttributes]{
+			{ID: "r1", Attributes: asc.ReviewAttributes{Rating: 5, CreatedDate: "2026-02-02T10:00:00Z"}},
+			{ID: "r2", Attributes: asc.ReviewAttributes{Rating: 5, CreatedDate: "2026-02-01T10:00:00Z"}},
+			{ID: "r3", Attributes: asc.ReviewAttributes{Rating: 2, CreatedDate: "2026-01-31T10:00:00Z"}},
+		}},
+		builds: &asc.BuildsResponse{Data: []asc.Resource[asc.BuildAttributes]{
+			{ID: "b2", Attributes: asc.BuildAttributes{Version: "43", ProcessingState: "PROCESSING", UploadedDate: "2026-02-03T00:00:00Z"}},
+			{ID: "b1", Attributes: asc.BuildAttributes{Version: "42", ProcessingState: "VALID", UploadedDate: "2026-02-01T00:00:00Z"}},
+		}},
+	}
+
+	runtime := newExporterRuntime(client, exporterTarget{AppID: "app-1"}, []string{collectorReviews, collectorBuilds})
+	runtime.scrape(context.Background())
+	output := scrapeMetricsText(t, runtime)
+
+	assertMetricLine(t, output, "# TYPE asc_customer_reviews_recent gauge")
+	assertMetricLine(t, output, `asc_customer_reviews_recent{app="app-1",rating="5"} 2`)
+	assertMetricLine(t, output, `asc_customer_reviews_recent{app="app-1",rating="1"} 0`)
+	assertMetricLine(t, output, `asc_customer_review_recent_average_rating{app="app-1"} 4`)
+	assertMetricLine(t, output, `asc_customer_review_latest_timestamp_seconds{app="app-1"} 1770026400`)
+	assertMetricLine(t, output, `asc_builds_recent{app="app-1",processing_state="PROCESSING"} 1`)
+	assertMetricLine(t, output, `asc_build_latest_info{app="app-1",build_id="b2",version="43",processing_state="PROCESSING"} 1`)
+	assertMetricLine(t, output, `asc_exporter_collector_up{collector="reviews"} 1`)
+	assertMetricLine(t, output, "# TYPE asc_exporter_scrapes_total counter")
+}
+
+func TestExporterKeepsStaleSamplesWhenCollectorFails(t *testing.T) {
+	client := &fakeExporterClient{
+		builds: &asc.BuildsResponse{Data: []asc.Resource[asc.BuildAttributes]{
+			{ID: "b1", Attributes: asc.BuildAttributes{Version: "42", ProcessingState: "VALID"}},
+		}},
+	}
+	runtime := newExporterRuntime(client, exporterTarget{AppID: "app-1"}, []string{collectorBuilds})
+	runtime.scrape(context.Background())
+
+	client.buildsErr = errors.New("boom")
+	runtime.scrape(context.Background())
+	output := scrapeMetricsText(t, runtime)
+
+	assertMetricLine(t, output, `asc_exporter_collector_up{collector="builds"} 0`)
+	assertMetricLine(t, output, `asc_exporter_scrapes_total{collector="builds"} 2`)
+	assertMetricLine(t, output, `asc_exporter_scrape_errors_total{collector="builds"} 1`)
+	assertMetricLine(t, output, `asc_builds_recent{app="app-1",processing_state="VALID"} 1`)
+}
+
+func TestExporterCollectorWarningHonorsQuiet(t *testing.T) {
+	t.Setenv("ASC_QUIET", "")
+	shared.SetQuiet(true)
+	t.Cleanup(func() { shared.SetQuiet(false) })
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	originalStderr := os.Stderr
+	os.Stderr = writer
+	t.Cleanup(func() { os.Stderr = originalStderr })
+
+	runtime := newExporterRuntime(&fakeExporterClient{buildsErr: errors.New("boom")}, exporterTarget{AppID: "app-1"}, []string{collectorBuilds})
+	runtime.scrape(context.Background())
+
+	os.Stderr = originalStderr
+	_ = writer.Close()
+	stderr, _ := io.ReadAll(reader)
+	if len(stderr) != 0 {
+		t.Fatalf("expected no warning with --quiet, got %q", stderr)
+	}
+}
+
+// failingListener makes http.Server.Serve fail on the first Accept.
+type failingListener struct{}
+
+func (failingListener) Accept() (net.Conn, error) { return nil, errors.New("accept failed") }
+func (failingListener) Close() error              { return nil }
+func (failingListener) Addr() net.Addr            { return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9108} }
+
+func TestRunExporterReturnsServeErrorWhileContextIsLive(t *testing.T) {
+	shared.SetQuiet(true)
+	t.Cleanup(func() { shared.SetQuiet(false) })
+
+	runtime := newExporterRuntime(&fakeExporterClient{builds: &asc.BuildsResponse{}}, exporterTarget{AppID: "app-1"}, []string{collectorBuilds})
+	done := make(chan error, 1)
+	go func() {
+		done <- runExporter(context.Background(), runtime, failingListener{}, time.Hour)
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "accept failed") {
+			t.Fatalf("expected serve error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("runExporter did not return after Serve failed")
+	}
+}
+
+func TestExporterMatchmakingMetricsUseLatestBucket(t *testing.T) {
+	client := &fakeExporterClient{
+		sizes: decodeExporterMetrics(t, `{"data":[{"granularity":"PT1H","dataPoints":[
+			{"start":"2026-01-01T00:00:00Z","end":"2026-01-01T01:00:00Z","values":{"count":3,"averageSecondsInQueue":1.5}},
+			{"start":"2026-01-01T01:00:00Z","end":"2026-01-01T02:00:00Z","values":{"count":9,"averageSecondsInQueue":4.25}}
+		]}]}`),
+		requests: decodeExporterMetrics(t, `{"data":[
+			{"dimensions":{"result":{"data":"MATCHED"}},"dataPoints":[{"start":"2026-01-01T01:00:00Z","values":{"count":7}}]},
+			{"dimensions":{"result":{"data":{"type":"results","id":"TIMED_OUT"}}},"dataPoints":[{"start":"2026-01-01T01:00:00Z","values":{"count":2}}]}
+		]}`),
+	}
+
+	runtime := newExporterRuntime(client, exporterTarget{QueueIDs: []string{"queue-1"}, Granularity: "PT1H"}, []string{collectorMetrics})
+	runtime.scrape(context.Background())
+
+	var out bytes.Buffer
+	if err := writeMetricFamilies(&out, runtime.families()); err != nil {
+		t.Fatalf("writeMetricFamilies() error: %v", err)
+	}
+	output := out.String()
+
+	assertMetricLine(t, output, `asc_matchmaking_queue_size_count{queue="queue-1"} 9`)
+	assertMetricLine(t, output, `asc_matchmaking_queue_size_average_seconds_in_queue{queue="queue-1"} 4.25`)
+	assertMetricLine(t, output, `asc_matchmaking_queue_requests_count{queue="queue-1",result="MATCHED"} 7`)
+	assertMetricLine(t, output, `asc_matchmaking_queue_requests_count{queue="queue-1",result="TIMED_OUT"} 2`)
+}
+
+func TestWriteMetricFamiliesEscapesLabelValues(t *testing.T) {
+	family := metricFamily{Name: "asc_test", Help: "Test\nhelp", Kind: metricKindGauge}
+	family.add(1, label("name", "a\"b\\c\nd"))
+
+	var out bytes.Buffer
+	if err := writeMetricFamilies(&out, []metricFamily{family, {Name: "asc_empty", Kind: metricKindGauge}}); err != nil {
+		t.Fatalf("writeMetricFamilies() error: %v", err)
+	}
+
+	want := "# HELP asc_test Test\\nhelp\n# TYPE asc_test gauge\nasc_test{name=\"a\\\"b\\\\c\\nd\"} 1\n"
+	if out.String() != want {
+		t.Fatalf("expected %q, got %q", want, out.String())
+	}
+}
+
+func TestNormalizeExporterCollectors(t *testing.T) {
+	got, err := normalizeExporterCollectors("Reviews, builds,reviews")
+	if err != nil {
+		t.Fatalf("normalizeExporterCollectors() error: %v", err)
+	}
+	if strings.Join(got, ",") != "reviews,builds" {
+		t.Fatalf("expected deduplicated collectors, got %v", got)
+	}
+
+	if _, err := normalizeExporterCollectors("reviews,sales"); err == nil || !strings.Contains(err.Error(), `unknown collector "sales"`) {
+		t.Fatalf("expected unknown collector error, got %v", err)
+	}
+}
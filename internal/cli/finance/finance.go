@@ -28,11 +28,13 @@ Requires Account Holder, Admin, or Finance role.
 
 Examples:
   asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12"
+  asc finance download --vendor "12345678" --region "ZZ" --date "2025-12"
   asc finance regions --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			FinanceReportsCommand(),
+			FinanceDownloadCommand(),
 			FinanceRegionsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -45,13 +47,8 @@ Examples:
 func FinanceReportsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("reports", flag.ExitOnError)
 
-	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER env)")
-	reportType := fs.String("report-type", "", "Report type: FINANCIAL or FINANCE_DETAIL (see help for UI mapping)")
-	region := fs.String("region", "", "Region code (e.g., US, ZZ, Z1; see 'asc finance regions')")
-	date := fs.String("date", "", "Report date (YYYY-MM, Apple fiscal month)")
-	output := fs.String("output", "", "Output file path (default: finance_report_{date}_{type}_{region}.tsv.gz)")
+	flags := bindFinanceReportFlags(fs, "", "Report type: FINANCIAL or FINANCE_DETAIL (see help for UI mapping)", "finance_report_{date}_{type}_{region}.tsv.gz")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .tsv")
-	outputFlags := shared.BindMetadataOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "reports",
@@ -103,88 +100,116 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			vendorNumber := shared.ResolveVendorNumber(*vendor)
-			if vendorNumber == "" {
-				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER)")
-				return flag.ErrHelp
-			}
-			if strings.TrimSpace(*reportType) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --report-type is required")
-				return flag.ErrHelp
-			}
-			if strings.TrimSpace(*region) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --region is required")
-				return flag.ErrHelp
-			}
-			if strings.TrimSpace(*date) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --date is required")
-				return flag.ErrHelp
-			}
-
-			normalizedReportType, err := normalizeFinanceReportType(*reportType)
-			if err != nil {
-				return fmt.Errorf("finance reports: %w", err)
-			}
-			reportDate, err := normalizeFinanceReportDate(*date)
-			if err != nil {
-				return fmt.Errorf("finance reports: %w", err)
-			}
-			regionCode, err := normalizeFinanceReportRegion(normalizedReportType, *region)
-			if err != nil {
-				return fmt.Errorf("finance reports: %w", err)
-			}
-			defaultOutput := fmt.Sprintf("finance_report_%s_%s_%s.tsv.gz", reportDate, string(normalizedReportType), regionCode)
-			compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".tsv", *decompress)
-
-			client, err := shared.GetASCClient()
-			if err != nil {
-				return fmt.Errorf("finance reports: %w", err)
-			}
-
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			download, err := client.DownloadFinanceReport(requestCtx, asc.FinanceReportParams{
-				VendorNumber: vendorNumber,
-				ReportType:   normalizedReportType,
-				RegionCode:   regionCode,
-				ReportDate:   reportDate,
-			})
-			if err != nil {
-				return fmt.Errorf("finance reports: failed to download report: %w", err)
-			}
-			defer download.Body.Close()
-
-			compressedSize, err := shared.WriteStreamToFile(compressedPath, download.Body)
-			if err != nil {
-				return fmt.Errorf("finance reports: failed to write report: %w", err)
-			}
-
-			var decompressedSize int64
-			if *decompress {
-				decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-				if err != nil {
-					return fmt.Errorf("finance reports: %w", err)
-				}
-			}
-
-			result := &asc.FinanceReportResult{
-				VendorNumber:      vendorNumber,
-				ReportType:        string(normalizedReportType),
-				RegionCode:        regionCode,
-				ReportDate:        reportDate,
-				FilePath:          compressedPath,
-				Bytes:             compressedSize,
-				Decompressed:      *decompress,
-				DecompressedPath:  decompressedPath,
-				DecompressedBytes: decompressedSize,
-			}
-
-			return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
+			return flags.run(ctx, "finance reports", *decompress)
 		},
 	}
 }
 
+// financeReportFlags are the flags shared by 'finance reports' and
+// 'finance download'.
+type financeReportFlags struct {
+	vendor      *string
+	reportType  *string
+	region      *string
+	date        *string
+	output      *string
+	outputFlags shared.MetadataOutputFlags
+}
+
+func bindFinanceReportFlags(fs *flag.FlagSet, defaultReportType, reportTypeUsage, defaultOutput string) *financeReportFlags {
+	return &financeReportFlags{
+		vendor:      fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER env)"),
+		reportType:  fs.String("report-type", defaultReportType, reportTypeUsage),
+		region:      fs.String("region", "", "Region code (e.g., US, ZZ, Z1; see 'asc finance regions')"),
+		date:        fs.String("date", "", "Report date (YYYY-MM, Apple fiscal month)"),
+		output:      fs.String("output", "", fmt.Sprintf("Output file path (default: %s)", defaultOutput)),
+		outputFlags: shared.BindMetadataOutputFlags(fs),
+	}
+}
+
+// run validates the flags, streams the gzip report to disk, optionally
+// inflates it next to the compressed file, and prints the result.
+func (f *financeReportFlags) run(ctx context.Context, commandName string, decompress bool) error {
+	vendorNumber := shared.ResolveVendorNumber(*f.vendor)
+	if vendorNumber == "" {
+		fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER)")
+		return flag.ErrHelp
+	}
+	if strings.TrimSpace(*f.reportType) == "" {
+		fmt.Fprintln(os.Stderr, "Error: --report-type is required")
+		return flag.ErrHelp
+	}
+	if strings.TrimSpace(*f.region) == "" {
+		fmt.Fprintln(os.Stderr, "Error: --region is required")
+		return flag.ErrHelp
+	}
+	if strings.TrimSpace(*f.date) == "" {
+		fmt.Fprintln(os.Stderr, "Error: --date is required")
+		return flag.ErrHelp
+	}
+
+	normalizedReportType, err := normalizeFinanceReportType(*f.reportType)
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+	reportDate, err := normalizeFinanceReportDate(*f.date)
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+	regionCode, err := normalizeFinanceReportRegion(normalizedReportType, *f.region)
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+	defaultOutput := fmt.Sprintf("finance_report_%s_%s_%s.tsv.gz", reportDate, string(normalizedReportType), regionCode)
+	compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*f.output, defaultOutput, ".tsv", decompress)
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := client.DownloadFinanceReport(requestCtx, asc.FinanceReportParams{
+		VendorNumber: vendorNumber,
+		ReportType:   normalizedReportType,
+		RegionCode:   regionCode,
+		ReportDate:   reportDate,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to download report: %w", commandName, err)
+	}
+	defer download.Body.Close()
+
+	compressedSize, err := shared.WriteReportDownload(compressedPath, download)
+	if err != nil {
+		return fmt.Errorf("%s: failed to write report: %w", commandName, err)
+	}
+
+	var decompressedSize int64
+	if decompress {
+		decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
+		if err != nil {
+			return fmt.Errorf("%s: %w", commandName, err)
+		}
+	}
+
+	result := &asc.FinanceReportResult{
+		VendorNumber:      vendorNumber,
+		ReportType:        string(normalizedReportType),
+		RegionCode:        regionCode,
+		ReportDate:        reportDate,
+		FilePath:          compressedPath,
+		Bytes:             compressedSize,
+		Decompressed:      decompress,
+		DecompressedPath:  decompressedPath,
+		DecompressedBytes: decompressedSize,
+	}
+
+	return shared.PrintOutput(result, *f.outputFlags.OutputFormat, *f.outputFlags.Pretty)
+}
+
 // FinanceRegionsCommand lists finance report regions and currencies.
 func FinanceRegionsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("regions", flag.ExitOnError)
@@ -0,0 +1,40 @@
+package finance
+
+import (
+	"context"
+	"flag"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// FinanceDownloadCommand downloads a finance report and decompresses it to TSV.
+func FinanceDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	flags := bindFinanceReportFlags(fs, string(asc.FinanceReportTypeFinancial), "Report type: FINANCIAL or FINANCE_DETAIL", "finance_report_{date}_{type}_{region}.tsv")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc finance download --vendor VENDOR --region REGION --date YYYY-MM [flags]",
+		ShortHelp:  "Download a finance report and decompress it to TSV.",
+		LongHelp: `Download a finance report and decompress it to TSV.
+
+Same as 'asc finance reports --decompress', with --report-type defaulting to
+FINANCIAL. The gzip file from App Store Connect is kept next to the .tsv.
+
+Requires Account Holder, Admin, or Finance role.
+
+Examples:
+  asc finance download --vendor "12345678" --region "ZZ" --date "2025-12"
+  asc finance download --vendor "12345678" --region "Z1" --date "2025-12" --report-type FINANCE_DETAIL
+  asc finance download --vendor "12345678" --region "US" --date "2025-12" --output "reports/finance-us.tsv"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return flags.run(ctx, "finance download", true)
+		},
+	}
+}
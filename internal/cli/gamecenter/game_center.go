@@ -21,8 +21,13 @@ func GameCenterCommand() *ffcli.Command {
 		ShortHelp:  "Manage Game Center resources in App Store Connect.",
 		LongHelp: `Manage Game Center resources in App Store Connect.
 
+Commands scoped to an app look up its Game Center detail first. The mapping is
+cached in ~/.asc/cache (disable with ASC_ID_CACHE=0); pass --gc-detail-id to
+skip the lookup entirely in scripts that run many commands.
+
 Examples:
   asc game-center achievements list --app "APP_ID"
+  asc game-center achievements list --gc-detail-id "DETAIL_ID"
   asc game-center achievements create --app "APP_ID" --reference-name "First Win" --vendor-id "com.example.firstwin" --points 10
   asc game-center leaderboards list --app "APP_ID"
   asc game-center leaderboards create --app "APP_ID" --reference-name "High Score" --vendor-id "com.example.highscore" --formatter INTEGER --sort DESC --submission-type BEST_SCORE
@@ -36,7 +41,10 @@ Examples:
   asc game-center enabled-versions compatible-versions --id "ENABLED_VERSION_ID"
   asc game-center details list --app "APP_ID"
   asc game-center details achievements-v2 list --id "DETAILS_ID"
-  asc game-center matchmaking queues list`,
+  asc game-center matchmaking queues list
+  asc game-center move --from-app "APP_ID" --to-group "GROUP_ID" --dry-run
+  asc game-center export --app "APP_ID" --file gc.yaml
+  asc game-center import --app "OTHER_APP_ID" --file gc.yaml --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -50,6 +58,9 @@ Examples:
 			GameCenterEnabledVersionsCommand(),
 			GameCenterDetailsCommand(),
 			GameCenterMatchmakingCommand(),
+			GameCenterMoveCommand(),
+			GameCenterExportCommand(),
+			GameCenterImportCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
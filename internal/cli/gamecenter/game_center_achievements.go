@@ -65,6 +65,8 @@ func GameCenterAchievementsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(gcAchievementsIncludeList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -78,6 +80,7 @@ func GameCenterAchievementsListCommand() *ffcli.Command {
 
 Examples:
   asc game-center achievements list --app "APP_ID"
+  asc game-center achievements list --app "APP_ID" --include "localizations,gameCenterGroup" --output table
   asc game-center achievements list --app "APP_ID" --limit 50
   asc game-center achievements list --app "APP_ID" --paginate`,
 		FlagSet:   fs,
@@ -89,10 +92,14 @@ Examples:
 			if err := shared.ValidateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center achievements list: %w", err)
 			}
+			includeValues, err := normalizeGCAchievementsInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -109,7 +116,7 @@ Examples:
 			if nextURL == "" {
 				// Get Game Center detail ID first
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center achievements list: failed to get Game Center detail: %w", err)
 				}
@@ -118,6 +125,7 @@ Examples:
 			opts := []asc.GCAchievementsOption{
 				asc.WithGCAchievementsLimit(*limit),
 				asc.WithGCAchievementsNextURL(*next),
+				asc.WithGCAchievementsInclude(includeValues),
 			}
 
 			if *paginate {
@@ -201,6 +209,7 @@ func GameCenterAchievementsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	referenceName := fs.String("reference-name", "", "Reference name for the achievement")
 	vendorID := fs.String("vendor-id", "", "Vendor identifier (e.g., com.example.achievement)")
 	points := fs.Int("points", 0, "Points value (1-100)")
@@ -229,8 +238,13 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			if group != "" && strings.TrimSpace(*gcDetailIDFlag) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --gc-detail-id cannot be used with --group-id")
+				return flag.ErrHelp
+			}
+
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if group == "" && resolvedAppID == "" {
+			if group == "" && resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -268,7 +282,7 @@ Examples:
 			if group == "" {
 				// Get Game Center detail ID first
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center achievements create: failed to get Game Center detail: %w", err)
 				}
@@ -379,7 +393,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -833,7 +847,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -919,12 +933,14 @@ func GameCenterAchievementReleasesCommand() *ffcli.Command {
 
 Examples:
   asc game-center achievements releases list --achievement-id "ACHIEVEMENT_ID"
+  asc game-center achievements releases get --id "RELEASE_ID"
   asc game-center achievements releases create --app "APP_ID" --achievement-id "ACHIEVEMENT_ID"
   asc game-center achievements releases delete --id "RELEASE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterAchievementReleasesListCommand(),
+			GameCenterAchievementReleasesGetCommand(),
 			GameCenterAchievementReleasesCreateCommand(),
 			GameCenterAchievementReleasesDeleteCommand(),
 		},
@@ -1010,11 +1026,54 @@ Examples:
 	}
 }
 
+// GameCenterAchievementReleasesGetCommand returns the achievement releases get subcommand.
+func GameCenterAchievementReleasesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	releaseID := fs.String("id", "", "Game Center achievement release ID")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc game-center achievements releases get --id \"RELEASE_ID\"",
+		ShortHelp:  "Get a Game Center achievement release.",
+		LongHelp: `Get a Game Center achievement release.
+
+Examples:
+  asc game-center achievements releases get --id "RELEASE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*releaseID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center achievements releases get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetGameCenterAchievementRelease(requestCtx, id)
+			if err != nil {
+				return fmt.Errorf("game-center achievements releases get: failed to fetch: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterAchievementReleasesCreateCommand returns the achievement releases create subcommand.
 func GameCenterAchievementReleasesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	achievementID := fs.String("achievement-id", "", "Game Center achievement ID")
 	output := shared.BindOutputFlags(fs)
 
@@ -1030,7 +1089,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if resolvedAppID == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -1050,7 +1109,7 @@ Examples:
 			defer cancel()
 
 			// Get Game Center detail ID first
-			gcDetailID, err := client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+			gcDetailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 			if err != nil {
 				return fmt.Errorf("game-center achievements releases create: failed to get Game Center detail: %w", err)
 			}
@@ -1293,16 +1352,18 @@ func GameCenterAchievementGroupAchievementCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "group-achievement",
-		ShortUsage: "asc game-center achievements group-achievement get --id \"ACHIEVEMENT_ID\"",
-		ShortHelp:  "Get the group achievement for an achievement.",
-		LongHelp: `Get the group achievement for a Game Center achievement.
+		ShortUsage: "asc game-center achievements group-achievement <subcommand> [flags]",
+		ShortHelp:  "Get or link the group achievement for an achievement.",
+		LongHelp: `Get or link the group achievement for a Game Center achievement.
 
 Examples:
-  asc game-center achievements group-achievement get --id "ACHIEVEMENT_ID"`,
+  asc game-center achievements group-achievement get --id "ACHIEVEMENT_ID"
+  asc game-center achievements group-achievement set --id "ACHIEVEMENT_ID" --group-achievement-id "GROUP_ACHIEVEMENT_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterAchievementGroupAchievementGetCommand(),
+			GameCenterAchievementGroupAchievementSetCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -1352,6 +1413,59 @@ Examples:
 	}
 }
 
+// GameCenterAchievementGroupAchievementSetCommand returns the group achievement set subcommand.
+func GameCenterAchievementGroupAchievementSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+
+	achievementID := fs.String("id", "", "Game Center achievement ID")
+	groupAchievementID := fs.String("group-achievement-id", "", "Group achievement ID to link")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc game-center achievements group-achievement set --id \"ACHIEVEMENT_ID\" --group-achievement-id \"GROUP_ACHIEVEMENT_ID\"",
+		ShortHelp:  "Link an achievement to a group achievement.",
+		LongHelp: `Link an achievement to a group achievement.
+
+Examples:
+  asc game-center achievements group-achievement set --id "ACHIEVEMENT_ID" --group-achievement-id "GROUP_ACHIEVEMENT_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*achievementID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			groupID := strings.TrimSpace(*groupAchievementID)
+			if groupID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group-achievement-id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center achievements group-achievement set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.UpdateGameCenterAchievementGroupAchievementRelationship(requestCtx, id, groupID); err != nil {
+				return fmt.Errorf("game-center achievements group-achievement set: failed to update: %w", err)
+			}
+
+			result := &asc.GameCenterAchievementGroupAchievementUpdateResult{
+				AchievementID:      id,
+				GroupAchievementID: groupID,
+				Updated:            true,
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterAchievementLocalizationImageCommand returns the localization image command group.
 func GameCenterAchievementLocalizationImageCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("image", flag.ExitOnError)
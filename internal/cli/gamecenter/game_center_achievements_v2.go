@@ -47,6 +47,7 @@ func GameCenterAchievementsV2ListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	groupID := fs.String("group-id", "", "Game Center group ID")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -79,9 +80,14 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			if group != "" && strings.TrimSpace(*gcDetailIDFlag) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --gc-detail-id cannot be used with --group-id")
+				return flag.ErrHelp
+			}
+
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if group == "" && resolvedAppID == "" && nextURL == "" {
+			if group == "" && resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -97,7 +103,7 @@ Examples:
 			gcDetailID := ""
 			if group == "" && nextURL == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center achievements v2 list: failed to get Game Center detail: %w", err)
 				}
@@ -589,7 +595,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -63,6 +63,7 @@ func GameCenterActivitiesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -90,7 +91,7 @@ Examples:
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -106,7 +107,7 @@ Examples:
 			gcDetailID := ""
 			if nextURL == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center activities list: failed to get Game Center detail: %w", err)
 				}
@@ -191,6 +192,7 @@ func GameCenterActivitiesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	referenceName := fs.String("reference-name", "", "Reference name for the activity")
 	vendorID := fs.String("vendor-id", "", "Vendor identifier for the activity")
 	playStyle := fs.String("play-style", "", "Play style (ASYNCHRONOUS, SYNCHRONOUS)")
@@ -222,8 +224,13 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			if group != "" && strings.TrimSpace(*gcDetailIDFlag) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --gc-detail-id cannot be used with --group-id")
+				return flag.ErrHelp
+			}
+
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if group == "" && resolvedAppID == "" {
+			if group == "" && resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -303,7 +310,7 @@ Examples:
 			gcDetailID := ""
 			if group == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center activities create: failed to get Game Center detail: %w", err)
 				}
@@ -392,7 +399,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -1129,7 +1136,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -1406,6 +1413,7 @@ func GameCenterActivityReleasesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -1433,7 +1441,7 @@ Examples:
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -1449,7 +1457,7 @@ Examples:
 			gcDetailID := ""
 			if nextURL == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center activities releases list: failed to get Game Center detail: %w", err)
 				}
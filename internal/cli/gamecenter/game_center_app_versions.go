@@ -51,6 +51,7 @@ func GameCenterAppVersionsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -78,7 +79,7 @@ Examples:
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -94,7 +95,7 @@ Examples:
 			detailID := ""
 			if nextURL == "" {
 				var err error
-				detailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				detailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center app-versions list: failed to get Game Center detail: %w", err)
 				}
@@ -59,6 +59,7 @@ func GameCenterChallengesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -86,7 +87,7 @@ Examples:
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -102,7 +103,7 @@ Examples:
 			gcDetailID := ""
 			if nextURL == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center challenges list: failed to get Game Center detail: %w", err)
 				}
@@ -187,6 +188,7 @@ func GameCenterChallengesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	referenceName := fs.String("reference-name", "", "Reference name for the challenge")
 	vendorID := fs.String("vendor-id", "", "Vendor identifier for the challenge")
 	repeatable := fs.String("repeatable", "", "Challenge can be earned multiple times (true/false)")
@@ -214,8 +216,13 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			if group != "" && strings.TrimSpace(*gcDetailIDFlag) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --gc-detail-id cannot be used with --group-id")
+				return flag.ErrHelp
+			}
+
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if group == "" && resolvedAppID == "" {
+			if group == "" && resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -272,7 +279,7 @@ Examples:
 			gcDetailID := ""
 			if group == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center challenges create: failed to get Game Center detail: %w", err)
 				}
@@ -346,7 +353,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate && strings.TrimSpace(*leaderboardID) == "" {
+			if !hasUpdate && strings.TrimSpace(*leaderboardID) == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -865,7 +872,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -1142,6 +1149,7 @@ func GameCenterChallengeReleasesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -1169,7 +1177,7 @@ Examples:
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -1185,7 +1193,7 @@ Examples:
 			gcDetailID := ""
 			if nextURL == "" {
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center challenges releases list: failed to get Game Center detail: %w", err)
 				}
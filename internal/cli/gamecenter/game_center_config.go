@@ -0,0 +1,643 @@
+package gamecenter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// gameCenterConfigVersion is the schema version written by game-center export.
+const gameCenterConfigVersion = 1
+
+// gameCenterConfig is the single-file Game Center configuration written by
+// game-center export and read by game-center import. Resources are keyed by
+// vendor identifier so the same file can be applied to any app.
+type gameCenterConfig struct {
+	Version         int                              `yaml:"version"`
+	SourceAppID     string                           `yaml:"sourceAppId,omitempty"`
+	Achievements    []gameCenterConfigAchievement    `yaml:"achievements,omitempty"`
+	Leaderboards    []gameCenterConfigLeaderboard    `yaml:"leaderboards,omitempty"`
+	LeaderboardSets []gameCenterConfigLeaderboardSet `yaml:"leaderboardSets,omitempty"`
+	Activities      []gameCenterConfigActivity       `yaml:"activities,omitempty"`
+	Challenges      []gameCenterConfigChallenge      `yaml:"challenges,omitempty"`
+}
+
+type gameCenterConfigAchievement struct {
+	VendorID           string                         `yaml:"vendorId"`
+	ReferenceName      string                         `yaml:"referenceName"`
+	Points             int                            `yaml:"points"`
+	ShowBeforeEarned   bool                           `yaml:"showBeforeEarned"`
+	Repeatable         bool                           `yaml:"repeatable"`
+	ActivityProperties map[string]string              `yaml:"activityProperties,omitempty"`
+	Localizations      []gameCenterConfigLocalization `yaml:"localizations,omitempty"`
+}
+
+type gameCenterConfigLeaderboard struct {
+	VendorID           string                         `yaml:"vendorId"`
+	ReferenceName      string                         `yaml:"referenceName"`
+	Formatter          string                         `yaml:"formatter"`
+	Sort               string                         `yaml:"sort"`
+	SubmissionType     string                         `yaml:"submissionType"`
+	ScoreRangeStart    string                         `yaml:"scoreRangeStart,omitempty"`
+	ScoreRangeEnd      string                         `yaml:"scoreRangeEnd,omitempty"`
+	Recurrence         *leaderboardTemplateRecurrence `yaml:"recurrence,omitempty"`
+	Visibility         string                         `yaml:"visibility,omitempty"`
+	ActivityProperties map[string]string              `yaml:"activityProperties,omitempty"`
+	Localizations      []gameCenterConfigLocalization `yaml:"localizations,omitempty"`
+}
+
+type gameCenterConfigLeaderboardSet struct {
+	VendorID      string                         `yaml:"vendorId"`
+	ReferenceName string                         `yaml:"referenceName"`
+	Leaderboards  []string                       `yaml:"leaderboards,omitempty"`
+	Localizations []gameCenterConfigLocalization `yaml:"localizations,omitempty"`
+}
+
+type gameCenterConfigActivity struct {
+	VendorID            string                         `yaml:"vendorId"`
+	ReferenceName       string                         `yaml:"referenceName"`
+	PlayStyle           string                         `yaml:"playStyle,omitempty"`
+	MinimumPlayersCount int                            `yaml:"minimumPlayersCount,omitempty"`
+	MaximumPlayersCount int                            `yaml:"maximumPlayersCount,omitempty"`
+	SupportsPartyCode   bool                           `yaml:"supportsPartyCode,omitempty"`
+	Properties          map[string]string              `yaml:"properties,omitempty"`
+	FallbackURL         string                         `yaml:"fallbackUrl,omitempty"`
+	Localizations       []gameCenterConfigLocalization `yaml:"localizations,omitempty"`
+}
+
+type gameCenterConfigChallenge struct {
+	VendorID      string                         `yaml:"vendorId"`
+	ReferenceName string                         `yaml:"referenceName"`
+	ChallengeType string                         `yaml:"challengeType"`
+	Repeatable    bool                           `yaml:"repeatable,omitempty"`
+	Leaderboard   string                         `yaml:"leaderboard,omitempty"`
+	Localizations []gameCenterConfigLocalization `yaml:"localizations,omitempty"`
+}
+
+// gameCenterConfigLocalization holds the localized fields of every Game
+// Center resource type; each type only uses the fields it supports.
+type gameCenterConfigLocalization struct {
+	Locale                  string                 `yaml:"locale"`
+	Name                    string                 `yaml:"name"`
+	Description             string                 `yaml:"description,omitempty"`
+	BeforeEarnedDescription string                 `yaml:"beforeEarnedDescription,omitempty"`
+	AfterEarnedDescription  string                 `yaml:"afterEarnedDescription,omitempty"`
+	FormatterOverride       string                 `yaml:"formatterOverride,omitempty"`
+	FormatterSuffix         string                 `yaml:"formatterSuffix,omitempty"`
+	FormatterSuffixSingular string                 `yaml:"formatterSuffixSingular,omitempty"`
+	Image                   *gameCenterConfigImage `yaml:"image,omitempty"`
+}
+
+// gameCenterConfigImage references a localization image. Export records the
+// file name and asset URL; import uploads Path (relative to the config file)
+// when the target localization has no image yet.
+type gameCenterConfigImage struct {
+	FileName string `yaml:"fileName,omitempty"`
+	URL      string `yaml:"url,omitempty"`
+	Path     string `yaml:"path,omitempty"`
+}
+
+// loadGameCenterConfig reads a YAML (or JSON) Game Center configuration.
+// Unknown keys are rejected so typos do not silently drop settings.
+func loadGameCenterConfig(path string) (*gameCenterConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var config gameCenterConfig
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s: configuration is empty", path)
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &config, nil
+}
+
+func (c *gameCenterConfig) validate() error {
+	if c.Version != gameCenterConfigVersion {
+		return fmt.Errorf("unsupported version %d (expected %d)", c.Version, gameCenterConfigVersion)
+	}
+
+	leaderboards := map[string]bool{}
+	seen := map[string]bool{}
+	checkVendorID := func(kind string, index int, vendorID, referenceName string) error {
+		vendorID = strings.TrimSpace(vendorID)
+		if vendorID == "" {
+			return fmt.Errorf("%s[%d]: vendorId is required", kind, index)
+		}
+		if strings.TrimSpace(referenceName) == "" {
+			return fmt.Errorf("%s[%d]: referenceName is required", kind, index)
+		}
+		if seen[kind+"|"+vendorID] {
+			return fmt.Errorf("%s[%d]: duplicate vendorId %q", kind, index, vendorID)
+		}
+		seen[kind+"|"+vendorID] = true
+		return nil
+	}
+
+	for i, item := range c.Achievements {
+		if err := checkVendorID("achievements", i, item.VendorID, item.ReferenceName); err != nil {
+			return err
+		}
+		if err := validateGameCenterConfigLocalizations(fmt.Sprintf("achievements[%d]", i), item.Localizations); err != nil {
+			return err
+		}
+	}
+	for i, item := range c.Leaderboards {
+		if err := checkVendorID("leaderboards", i, item.VendorID, item.ReferenceName); err != nil {
+			return err
+		}
+		if !isValidLeaderboardFormatter(item.Formatter) {
+			return fmt.Errorf("leaderboards[%d]: formatter must be one of: %s", i, strings.Join(asc.ValidLeaderboardFormatters, ", "))
+		}
+		if !isValidScoreSortType(item.Sort) {
+			return fmt.Errorf("leaderboards[%d]: sort must be one of: %s", i, strings.Join(asc.ValidScoreSortTypes, ", "))
+		}
+		if !isValidSubmissionType(item.SubmissionType) {
+			return fmt.Errorf("leaderboards[%d]: submissionType must be one of: %s", i, strings.Join(asc.ValidSubmissionTypes, ", "))
+		}
+		if err := validateGameCenterConfigLocalizations(fmt.Sprintf("leaderboards[%d]", i), item.Localizations); err != nil {
+			return err
+		}
+		leaderboards[strings.TrimSpace(item.VendorID)] = true
+	}
+	for i, item := range c.LeaderboardSets {
+		if err := checkVendorID("leaderboardSets", i, item.VendorID, item.ReferenceName); err != nil {
+			return err
+		}
+		for _, member := range item.Leaderboards {
+			if !leaderboards[strings.TrimSpace(member)] {
+				return fmt.Errorf("leaderboardSets[%d]: leaderboard %q is not defined in leaderboards", i, member)
+			}
+		}
+		if err := validateGameCenterConfigLocalizations(fmt.Sprintf("leaderboardSets[%d]", i), item.Localizations); err != nil {
+			return err
+		}
+	}
+	for i, item := range c.Activities {
+		if err := checkVendorID("activities", i, item.VendorID, item.ReferenceName); err != nil {
+			return err
+		}
+		if err := validateGameCenterConfigLocalizations(fmt.Sprintf("activities[%d]", i), item.Localizations); err != nil {
+			return err
+		}
+	}
+	for i, item := range c.Challenges {
+		if err := checkVendorID("challenges", i, item.VendorID, item.ReferenceName); err != nil {
+			return err
+		}
+		if strings.TrimSpace(item.ChallengeType) == "" {
+			return fmt.Errorf("challenges[%d]: challengeType is required", i)
+		}
+		if member := strings.TrimSpace(item.Leaderboard); member != "" && !leaderboards[member] {
+			return fmt.Errorf("challenges[%d]: leaderboard %q is not defined in leaderboards", i, member)
+		}
+		if err := validateGameCenterConfigLocalizations(fmt.Sprintf("challenges[%d]", i), item.Localizations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateGameCenterConfigLocalizations(prefix string, localizations []gameCenterConfigLocalization) error {
+	seen := make(map[string]bool, len(localizations))
+	for i, localization := range localizations {
+		locale := strings.TrimSpace(localization.Locale)
+		if locale == "" {
+			return fmt.Errorf("%s.localizations[%d]: locale is required", prefix, i)
+		}
+		if err := shared.ValidateBuildLocalizationLocale(locale); err != nil {
+			return fmt.Errorf("%s.localizations[%d]: %w", prefix, i, err)
+		}
+		if seen[strings.ToLower(locale)] {
+			return fmt.Errorf("%s.localizations[%d]: duplicate locale %q", prefix, i, locale)
+		}
+		seen[strings.ToLower(locale)] = true
+		if strings.TrimSpace(localization.Name) == "" {
+			return fmt.Errorf("%s.localizations[%d]: name is required", prefix, i)
+		}
+	}
+	return nil
+}
+
+// fetchAllGameCenterPages fetches the first page (nextURL is empty) and
+// follows links.next, returning every resource.
+func fetchAllGameCenterPages[T any](ctx context.Context, fetch func(context.Context, string) (*asc.Response[T], error)) ([]asc.Resource[T], error) {
+	first, err := fetch(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, first, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return fetch(ctx, nextURL)
+	})
+	if err != nil {
+		return nil, err
+	}
+	typed, ok := all.(*asc.Response[T])
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T", all)
+	}
+	return typed.Data, nil
+}
+
+// collectGameCenterConfig reads the Game Center configuration of a detail.
+// Archived achievements, leaderboards, activities, and challenges are left
+// out because they cannot be recreated on another app.
+func collectGameCenterConfig(ctx context.Context, client *asc.Client, detailID string) (*gameCenterConfig, error) {
+	config := &gameCenterConfig{Version: gameCenterConfigVersion}
+
+	achievements, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterAchievementsResponse, error) {
+		return client.GetGameCenterAchievements(ctx, detailID, asc.WithGCAchievementsLimit(200), asc.WithGCAchievementsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch achievements: %w", err)
+	}
+	for _, item := range achievements {
+		if item.Attributes.Archived {
+			continue
+		}
+		localizations, err := collectGameCenterAchievementLocalizations(ctx, client, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("achievement %q: %w", item.Attributes.VendorIdentifier, err)
+		}
+		config.Achievements = append(config.Achievements, gameCenterConfigAchievement{
+			VendorID:           item.Attributes.VendorIdentifier,
+			ReferenceName:      item.Attributes.ReferenceName,
+			Points:             item.Attributes.Points,
+			ShowBeforeEarned:   item.Attributes.ShowBeforeEarned,
+			Repeatable:         item.Attributes.Repeatable,
+			ActivityProperties: item.Attributes.ActivityProperties,
+			Localizations:      gameCenterConfigLocalizations(localizations),
+		})
+	}
+
+	leaderboards, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardsResponse, error) {
+		return client.GetGameCenterLeaderboards(ctx, detailID, asc.WithGCLeaderboardsLimit(200), asc.WithGCLeaderboardsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leaderboards: %w", err)
+	}
+	leaderboardVendorIDs := make(map[string]string, len(leaderboards))
+	for _, item := range leaderboards {
+		if item.Attributes.Archived {
+			continue
+		}
+		leaderboardVendorIDs[item.ID] = item.Attributes.VendorIdentifier
+		localizations, err := collectGameCenterLeaderboardLocalizations(ctx, client, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("leaderboard %q: %w", item.Attributes.VendorIdentifier, err)
+		}
+		entry := gameCenterConfigLeaderboard{
+			VendorID:           item.Attributes.VendorIdentifier,
+			ReferenceName:      item.Attributes.ReferenceName,
+			Formatter:          item.Attributes.DefaultFormatter,
+			Sort:               item.Attributes.ScoreSortType,
+			SubmissionType:     item.Attributes.SubmissionType,
+			ScoreRangeStart:    item.Attributes.ScoreRangeStart,
+			ScoreRangeEnd:      item.Attributes.ScoreRangeEnd,
+			Visibility:         item.Attributes.Visibility,
+			ActivityProperties: item.Attributes.ActivityProperties,
+			Localizations:      gameCenterConfigLocalizations(localizations),
+		}
+		if item.Attributes.RecurrenceRule != "" {
+			entry.Recurrence = &leaderboardTemplateRecurrence{
+				StartDate: item.Attributes.RecurrenceStartDate,
+				Duration:  item.Attributes.RecurrenceDuration,
+				Rule:      item.Attributes.RecurrenceRule,
+			}
+		}
+		config.Leaderboards = append(config.Leaderboards, entry)
+	}
+
+	sets, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardSetsResponse, error) {
+		return client.GetGameCenterLeaderboardSets(ctx, detailID, asc.WithGCLeaderboardSetsLimit(200), asc.WithGCLeaderboardSetsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leaderboard sets: %w", err)
+	}
+	for _, item := range sets {
+		members, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardsResponse, error) {
+			return client.GetGameCenterLeaderboardSetMembers(ctx, item.ID, asc.WithGCLeaderboardSetMembersLimit(200), asc.WithGCLeaderboardSetMembersNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("leaderboard set %q: failed to fetch members: %w", item.Attributes.VendorIdentifier, err)
+		}
+		localizations, err := collectGameCenterLeaderboardSetLocalizations(ctx, client, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("leaderboard set %q: %w", item.Attributes.VendorIdentifier, err)
+		}
+		entry := gameCenterConfigLeaderboardSet{
+			VendorID:      item.Attributes.VendorIdentifier,
+			ReferenceName: item.Attributes.ReferenceName,
+			Localizations: gameCenterConfigLocalizations(localizations),
+		}
+		for _, member := range members {
+			// Members of archived leaderboards are left out with them.
+			if vendorID, ok := leaderboardVendorIDs[member.ID]; ok {
+				entry.Leaderboards = append(entry.Leaderboards, vendorID)
+			}
+		}
+		config.LeaderboardSets = append(config.LeaderboardSets, entry)
+	}
+
+	activities, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterActivitiesResponse, error) {
+		return client.GetGameCenterActivities(ctx, detailID, asc.WithGCActivitiesLimit(200), asc.WithGCActivitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch activities: %w", err)
+	}
+	for _, item := range activities {
+		if item.Attributes.Archived {
+			continue
+		}
+		entry := gameCenterConfigActivity{
+			VendorID:            item.Attributes.VendorIdentifier,
+			ReferenceName:       item.Attributes.ReferenceName,
+			PlayStyle:           item.Attributes.PlayStyle,
+			MinimumPlayersCount: item.Attributes.MinimumPlayersCount,
+			MaximumPlayersCount: item.Attributes.MaximumPlayersCount,
+			SupportsPartyCode:   item.Attributes.SupportsPartyCode,
+			Properties:          item.Attributes.Properties,
+		}
+		version, err := latestGameCenterActivityVersion(ctx, client, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("activity %q: %w", item.Attributes.VendorIdentifier, err)
+		}
+		if version != nil {
+			entry.FallbackURL = version.Attributes.FallbackURL
+			localizations, err := collectGameCenterActivityLocalizations(ctx, client, version.ID)
+			if err != nil {
+				return nil, fmt.Errorf("activity %q: %w", item.Attributes.VendorIdentifier, err)
+			}
+			entry.Localizations = gameCenterConfigLocalizations(localizations)
+		}
+		config.Activities = append(config.Activities, entry)
+	}
+
+	challenges, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterChallengesResponse, error) {
+		return client.GetGameCenterChallenges(ctx, detailID, asc.WithGCChallengesLimit(200), asc.WithGCChallengesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch challenges: %w", err)
+	}
+	for _, item := range challenges {
+		if item.Attributes.Archived {
+			continue
+		}
+		entry := gameCenterConfigChallenge{
+			VendorID:      item.Attributes.VendorIdentifier,
+			ReferenceName: item.Attributes.ReferenceName,
+			ChallengeType: item.Attributes.ChallengeType,
+			Repeatable:    item.Attributes.Repeatable,
+		}
+		linkage, err := client.GetGameCenterChallengeLeaderboardRelationship(ctx, item.ID)
+		if err != nil && !errors.Is(err, asc.ErrNotFound) {
+			return nil, fmt.Errorf("challenge %q: failed to fetch leaderboard: %w", item.Attributes.VendorIdentifier, err)
+		}
+		if linkage != nil {
+			entry.Leaderboard = leaderboardVendorIDs[linkage.Data.ID]
+		}
+		version, err := latestGameCenterChallengeVersion(ctx, client, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("challenge %q: %w", item.Attributes.VendorIdentifier, err)
+		}
+		if version != nil {
+			localizations, err := collectGameCenterChallengeLocalizations(ctx, client, version.ID)
+			if err != nil {
+				return nil, fmt.Errorf("challenge %q: %w", item.Attributes.VendorIdentifier, err)
+			}
+			entry.Localizations = gameCenterConfigLocalizations(localizations)
+		}
+		config.Challenges = append(config.Challenges, entry)
+	}
+
+	return config, nil
+}
+
+// gameCenterRemoteLocalization is a localization read from App Store Connect
+// together with its resource ID.
+type gameCenterRemoteLocalization struct {
+	ID           string
+	Localization gameCenterConfigLocalization
+}
+
+func gameCenterConfigLocalizations(remote []gameCenterRemoteLocalization) []gameCenterConfigLocalization {
+	result := make([]gameCenterConfigLocalization, 0, len(remote))
+	for _, item := range remote {
+		result = append(result, item.Localization)
+	}
+	return result
+}
+
+// gameCenterConfigImageRef converts an image resource into a reference. It
+// returns nil when the localization has no image.
+func gameCenterConfigImageRef(fileName string, asset *asc.ImageAsset) *gameCenterConfigImage {
+	if strings.TrimSpace(fileName) == "" {
+		return nil
+	}
+	image := &gameCenterConfigImage{FileName: fileName}
+	if asset != nil {
+		image.URL = asset.TemplateURL
+	}
+	return image
+}
+
+// ignoreGameCenterImageNotFound treats a missing image as no image.
+func ignoreGameCenterImageNotFound(err error) error {
+	if errors.Is(err, asc.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func collectGameCenterAchievementLocalizations(ctx context.Context, client *asc.Client, achievementID string) ([]gameCenterRemoteLocalization, error) {
+	localizations, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterAchievementLocalizationsResponse, error) {
+		return client.GetGameCenterAchievementLocalizations(ctx, achievementID, asc.WithGCAchievementLocalizationsLimit(200), asc.WithGCAchievementLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	result := make([]gameCenterRemoteLocalization, 0, len(localizations))
+	for _, item := range localizations {
+		entry := gameCenterConfigLocalization{
+			Locale:                  item.Attributes.Locale,
+			Name:                    item.Attributes.Name,
+			BeforeEarnedDescription: item.Attributes.BeforeEarnedDescription,
+			AfterEarnedDescription:  item.Attributes.AfterEarnedDescription,
+		}
+		image, err := client.GetGameCenterAchievementLocalizationImage(ctx, item.ID)
+		if err := ignoreGameCenterImageNotFound(err); err != nil {
+			return nil, fmt.Errorf("%s: failed to fetch image: %w", item.Attributes.Locale, err)
+		}
+		if image != nil {
+			entry.Image = gameCenterConfigImageRef(image.Data.Attributes.FileName, image.Data.Attributes.ImageAsset)
+		}
+		result = append(result, gameCenterRemoteLocalization{ID: item.ID, Localization: entry})
+	}
+	return result, nil
+}
+
+func collectGameCenterLeaderboardLocalizations(ctx context.Context, client *asc.Client, leaderboardID string) ([]gameCenterRemoteLocalization, error) {
+	localizations, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardLocalizationsResponse, error) {
+		return client.GetGameCenterLeaderboardLocalizations(ctx, leaderboardID, asc.WithGCLeaderboardLocalizationsLimit(200), asc.WithGCLeaderboardLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	result := make([]gameCenterRemoteLocalization, 0, len(localizations))
+	for _, item := range localizations {
+		entry := gameCenterConfigLocalization{
+			Locale:                  item.Attributes.Locale,
+			Name:                    item.Attributes.Name,
+			Description:             derefString(item.Attributes.Description),
+			FormatterOverride:       derefString(item.Attributes.FormatterOverride),
+			FormatterSuffix:         derefString(item.Attributes.FormatterSuffix),
+			FormatterSuffixSingular: derefString(item.Attributes.FormatterSuffixSingular),
+		}
+		image, err := client.GetGameCenterLeaderboardLocalizationImage(ctx, item.ID)
+		if err := ignoreGameCenterImageNotFound(err); err != nil {
+			return nil, fmt.Errorf("%s: failed to fetch image: %w", item.Attributes.Locale, err)
+		}
+		if image != nil {
+			entry.Image = gameCenterConfigImageRef(image.Data.Attributes.FileName, image.Data.Attributes.ImageAsset)
+		}
+		result = append(result, gameCenterRemoteLocalization{ID: item.ID, Localization: entry})
+	}
+	return result, nil
+}
+
+func collectGameCenterLeaderboardSetLocalizations(ctx context.Context, client *asc.Client, setID string) ([]gameCenterRemoteLocalization, error) {
+	localizations, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardSetLocalizationsResponse, error) {
+		return client.GetGameCenterLeaderboardSetLocalizations(ctx, setID, asc.WithGCLeaderboardSetLocalizationsLimit(200), asc.WithGCLeaderboardSetLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	result := make([]gameCenterRemoteLocalization, 0, len(localizations))
+	for _, item := range localizations {
+		entry := gameCenterConfigLocalization{
+			Locale: item.Attributes.Locale,
+			Name:   item.Attributes.Name,
+		}
+		image, err := client.GetGameCenterLeaderboardSetLocalizationImage(ctx, item.ID)
+		if err := ignoreGameCenterImageNotFound(err); err != nil {
+			return nil, fmt.Errorf("%s: failed to fetch image: %w", item.Attributes.Locale, err)
+		}
+		if image != nil {
+			entry.Image = gameCenterConfigImageRef(image.Data.Attributes.FileName, image.Data.Attributes.ImageAsset)
+		}
+		result = append(result, gameCenterRemoteLocalization{ID: item.ID, Localization: entry})
+	}
+	return result, nil
+}
+
+func collectGameCenterActivityLocalizations(ctx context.Context, client *asc.Client, versionID string) ([]gameCenterRemoteLocalization, error) {
+	localizations, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterActivityLocalizationsResponse, error) {
+		return client.GetGameCenterActivityLocalizations(ctx, versionID, asc.WithGCActivityLocalizationsLimit(200), asc.WithGCActivityLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	result := make([]gameCenterRemoteLocalization, 0, len(localizations))
+	for _, item := range localizations {
+		entry := gameCenterConfigLocalization{
+			Locale:      item.Attributes.Locale,
+			Name:        item.Attributes.Name,
+			Description: item.Attributes.Description,
+		}
+		image, err := client.GetGameCenterActivityLocalizationImage(ctx, item.ID)
+		if err := ignoreGameCenterImageNotFound(err); err != nil {
+			return nil, fmt.Errorf("%s: failed to fetch image: %w", item.Attributes.Locale, err)
+		}
+		if image != nil {
+			entry.Image = gameCenterConfigImageRef(image.Data.Attributes.FileName, image.Data.Attributes.ImageAsset)
+		}
+		result = append(result, gameCenterRemoteLocalization{ID: item.ID, Localization: entry})
+	}
+	return result, nil
+}
+
+func collectGameCenterChallengeLocalizations(ctx context.Context, client *asc.Client, versionID string) ([]gameCenterRemoteLocalization, error) {
+	localizations, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterChallengeLocalizationsResponse, error) {
+		return client.GetGameCenterChallengeLocalizations(ctx, versionID, asc.WithGCChallengeLocalizationsLimit(200), asc.WithGCChallengeLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	result := make([]gameCenterRemoteLocalization, 0, len(localizations))
+	for _, item := range localizations {
+		entry := gameCenterConfigLocalization{
+			Locale:      item.Attributes.Locale,
+			Name:        item.Attributes.Name,
+			Description: item.Attributes.Description,
+		}
+		image, err := client.GetGameCenterChallengeLocalizationImage(ctx, item.ID)
+		if err := ignoreGameCenterImageNotFound(err); err != nil {
+			return nil, fmt.Errorf("%s: failed to fetch image: %w", item.Attributes.Locale, err)
+		}
+		if image != nil {
+			entry.Image = gameCenterConfigImageRef(image.Data.Attributes.FileName, image.Data.Attributes.ImageAsset)
+		}
+		result = append(result, gameCenterRemoteLocalization{ID: item.ID, Localization: entry})
+	}
+	return result, nil
+}
+
+// latestGameCenterActivityVersion returns the highest-numbered version of an
+// activity, or nil when it has none.
+func latestGameCenterActivityVersion(ctx context.Context, client *asc.Client, activityID string) (*asc.Resource[asc.GameCenterActivityVersionAttributes], error) {
+	versions, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterActivityVersionsResponse, error) {
+		return client.GetGameCenterActivityVersions(ctx, activityID, asc.WithGCActivityVersionsLimit(200), asc.WithGCActivityVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	var latest *asc.Resource[asc.GameCenterActivityVersionAttributes]
+	for i := range versions {
+		if latest == nil || versions[i].Attributes.Version > latest.Attributes.Version {
+			latest = &versions[i]
+		}
+	}
+	return latest, nil
+}
+
+// latestGameCenterChallengeVersion returns the highest-numbered version of a
+// challenge, or nil when it has none.
+func latestGameCenterChallengeVersion(ctx context.Context, client *asc.Client, challengeID string) (*asc.Resource[asc.GameCenterChallengeVersionAttributes], error) {
+	versions, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterChallengeVersionsResponse, error) {
+		return client.GetGameCenterChallengeVersions(ctx, challengeID, asc.WithGCChallengeVersionsLimit(200), asc.WithGCChallengeVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	var latest *asc.Resource[asc.GameCenterChallengeVersionAttributes]
+	for i := range versions {
+		if latest == nil || versions[i].Attributes.Version > latest.Attributes.Version {
+			latest = &versions[i]
+		}
+	}
+	return latest, nil
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -61,6 +62,7 @@ func GameCenterDetailsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -90,7 +92,7 @@ Examples:
 			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -103,7 +105,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			detailID, err := client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+			detailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 			if err != nil {
 				return fmt.Errorf("game-center details list: failed to get Game Center detail: %w", err)
 			}
@@ -111,7 +113,7 @@ Examples:
 			if detailID == "" {
 				// App Store Connect returns 200 with an empty id when no detail exists yet.
 				// Treat this as an empty list rather than attempting /v1/gameCenterDetails/.
-				fmt.Fprintln(os.Stderr, `Warning: no Game Center detail exists for this app. Run "asc game-center details create --app <APP_ID>" to create one.`)
+				fmt.Fprintln(shared.WarningWriter(), `Warning: no Game Center detail exists for this app. Run "asc game-center details create --app <APP_ID>" to create one.`)
 				resp := &asc.GameCenterDetailsResponse{
 					Data:  []asc.Resource[asc.GameCenterDetailAttributes]{},
 					Links: asc.Links{},
@@ -1141,6 +1143,8 @@ func GameCenterDetailsRuleBasedMatchmakingCommand() *ffcli.Command {
 }
 
 func detailsMetricsCommand(name string, fs *flag.FlagSet, detailID *string, granularity *string, groupBy *string, filterResult *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMetricsResponse, error)) *ffcli.Command {
+	window := bindMetricsWindowFlags(fs)
+
 	return &ffcli.Command{
 		Name:       name,
 		ShortUsage: "asc game-center details metrics " + name + " --id \"DETAIL_ID\" --granularity P1D",
@@ -1148,16 +1152,17 @@ func detailsMetricsCommand(name string, fs *flag.FlagSet, detailID *string, gran
 		LongHelp: `Fetch Game Center details metrics.
 
 Examples:
-  asc game-center details metrics ` + name + ` --id "DETAIL_ID" --granularity P1D`,
+  asc game-center details metrics ` + name + ` --id "DETAIL_ID" --granularity P1D
+  asc game-center details metrics ` + name + ` --id "DETAIL_ID" --since 7d`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return runDetailsMetrics(ctx, name, detailID, granularity, groupBy, filterResult, sort, limit, next, paginate, output, pretty, fetch)
+			return runDetailsMetrics(ctx, name, detailID, granularity, groupBy, filterResult, sort, limit, next, paginate, output, pretty, fetch, window)
 		},
 	}
 }
 
-func runDetailsMetrics(ctx context.Context, name string, detailID *string, granularity *string, groupBy *string, filterResult *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMetricsResponse, error)) error {
+func runDetailsMetrics(ctx context.Context, name string, detailID *string, granularity *string, groupBy *string, filterResult *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMetricsResponse, error), windowFlags metricsWindowFlags) error {
 	if *limit != 0 && (*limit < 1 || *limit > 200) {
 		return fmt.Errorf("game-center details metrics %s: --limit must be between 1 and 200", name)
 	}
@@ -1170,9 +1175,14 @@ func runDetailsMetrics(ctx context.Context, name string, detailID *string, granu
 		fmt.Fprintln(os.Stderr, "Error: --id is required")
 		return flag.ErrHelp
 	}
-	gran := strings.TrimSpace(*granularity)
+	now := time.Now()
+	window, err := windowFlags.parse(now)
+	if err != nil {
+		return err
+	}
+	gran := resolveMetricsGranularity(*granularity, window, now)
 	if gran == "" && strings.TrimSpace(*next) == "" {
-		fmt.Fprintln(os.Stderr, "Error: --granularity is required")
+		fmt.Fprintln(os.Stderr, "Error: --granularity or --since is required")
 		return flag.ErrHelp
 	}
 
@@ -1202,6 +1212,7 @@ func runDetailsMetrics(ctx context.Context, name string, detailID *string, granu
 			return fmt.Errorf("game-center details metrics %s: %w", name, err)
 		}
 
+		window.filter(resp)
 		return shared.PrintOutput(resp, *output, *pretty)
 	}
 
@@ -1210,5 +1221,6 @@ func runDetailsMetrics(ctx context.Context, name string, detailID *string, granu
 		return fmt.Errorf("game-center details metrics %s: failed to fetch: %w", name, err)
 	}
 
+	window.filter(resp)
 	return shared.PrintOutput(resp, *output, *pretty)
 }
@@ -0,0 +1,177 @@
+package gamecenter
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type gameCenterExportResult struct {
+	AppID           string `json:"appId,omitempty"`
+	DetailID        string `json:"detailId"`
+	File            string `json:"file"`
+	Achievements    int    `json:"achievements"`
+	Leaderboards    int    `json:"leaderboards"`
+	LeaderboardSets int    `json:"leaderboardSets"`
+	Activities      int    `json:"activities"`
+	Challenges      int    `json:"challenges"`
+	Localizations   int    `json:"localizations"`
+	Images          int    `json:"images"`
+}
+
+// GameCenterExportCommand returns the game-center export subcommand.
+func GameCenterExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
+	file := fs.String("file", "", "Path to write the configuration YAML")
+	overwrite := fs.Bool("overwrite", false, "Overwrite an existing --file")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc game-center export --app APP_ID --file gc.yaml [flags]",
+		ShortHelp:  "Export an app's Game Center configuration to a single file.",
+		LongHelp: `Export an app's Game Center configuration to a single YAML file.
+
+The file holds achievements, leaderboards, leaderboard sets (with their member
+leaderboards), activities, and challenges, each with its localizations and
+image references. Resources are keyed by vendor identifier, so the file can be
+applied to another app with "asc game-center import".
+
+Archived resources are not exported. Images are recorded by file name and
+asset URL; add a "path" to an image entry to have import upload it.
+
+Examples:
+  asc game-center export --app "APP_ID" --file gc.yaml
+  asc game-center export --gc-detail-id "DETAIL_ID" --file gc.yaml --overwrite`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			filePath := strings.TrimSpace(*file)
+			if filePath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+			if !*overwrite {
+				if _, err := os.Lstat(filePath); err == nil {
+					return shared.UsageErrorf("--file %q already exists; pass --overwrite to replace it", filePath)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			detailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
+			if err != nil {
+				return fmt.Errorf("game-center export: failed to get Game Center detail: %w", err)
+			}
+
+			config, err := collectGameCenterConfig(requestCtx, client, detailID)
+			if err != nil {
+				return fmt.Errorf("game-center export: %w", err)
+			}
+			config.SourceAppID = resolvedAppID
+
+			data, err := yaml.Marshal(config)
+			if err != nil {
+				return fmt.Errorf("game-center export: failed to encode configuration: %w", err)
+			}
+			if _, err := shared.SafeWriteFileNoSymlink(
+				filePath,
+				0o644,
+				*overwrite,
+				".asc-game-center-*",
+				".asc-game-center-backup-*",
+				func(f *os.File) (int64, error) {
+					n, err := f.Write(data)
+					return int64(n), err
+				},
+			); err != nil {
+				return fmt.Errorf("game-center export: failed to write %s: %w", filePath, err)
+			}
+
+			result := summarizeGameCenterExport(config)
+			result.AppID = resolvedAppID
+			result.DetailID = detailID
+			if absolute, err := filepath.Abs(filePath); err == nil {
+				result.File = absolute
+			} else {
+				result.File = filePath
+			}
+
+			headers := []string{"File", "Achievements", "Leaderboards", "Leaderboard Sets", "Activities", "Challenges", "Localizations", "Images"}
+			rows := [][]string{{
+				result.File,
+				fmt.Sprintf("%d", result.Achievements),
+				fmt.Sprintf("%d", result.Leaderboards),
+				fmt.Sprintf("%d", result.LeaderboardSets),
+				fmt.Sprintf("%d", result.Activities),
+				fmt.Sprintf("%d", result.Challenges),
+				fmt.Sprintf("%d", result.Localizations),
+				fmt.Sprintf("%d", result.Images),
+			}}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { asc.RenderTable(headers, rows); return nil },
+				func() error { asc.RenderMarkdown(headers, rows); return nil },
+			)
+		},
+	}
+}
+
+func summarizeGameCenterExport(config *gameCenterConfig) *gameCenterExportResult {
+	result := &gameCenterExportResult{
+		Achievements:    len(config.Achievements),
+		Leaderboards:    len(config.Leaderboards),
+		LeaderboardSets: len(config.LeaderboardSets),
+		Activities:      len(config.Activities),
+		Challenges:      len(config.Challenges),
+	}
+	count := func(localizations []gameCenterConfigLocalization) {
+		result.Localizations += len(localizations)
+		for _, localization := range localizations {
+			if localization.Image != nil {
+				result.Images++
+			}
+		}
+	}
+	for _, item := range config.Achievements {
+		count(item.Localizations)
+	}
+	for _, item := range config.Leaderboards {
+		count(item.Localizations)
+	}
+	for _, item := range config.LeaderboardSets {
+		count(item.Localizations)
+	}
+	for _, item := range config.Activities {
+		count(item.Localizations)
+	}
+	for _, item := range config.Challenges {
+		count(item.Localizations)
+	}
+	return result
+}
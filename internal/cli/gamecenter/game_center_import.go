@@ -0,0 +1,1024 @@
+package gamecenter
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	gameCenterImportCreate    = "create"
+	gameCenterImportUpdate    = "update"
+	gameCenterImportUnchanged = "unchanged"
+	gameCenterImportUpload    = "upload"
+)
+
+type gameCenterImportAction struct {
+	Type     string
+	VendorID string
+	Locale   string
+	Action   string
+	ID       string
+	Changes  []string
+}
+
+// item returns the bulk result item for the action. Items are named
+// type/vendorId[/locale] and the detail is the action and changed fields.
+func (a gameCenterImportAction) item(dryRun bool) asc.BulkResultItem {
+	name := a.Type + "/" + a.VendorID
+	if a.Locale != "" {
+		name += "/" + a.Locale
+	}
+	detail := a.Action
+	if dryRun {
+		detail = "would " + detail
+	}
+	if len(a.Changes) > 0 {
+		detail += ": " + strings.Join(a.Changes, ", ")
+	}
+	return asc.BulkResultItem{Item: name, ID: a.ID, Detail: detail}
+}
+
+type gameCenterImportResult struct {
+	AppID     string `json:"appId,omitempty"`
+	DetailID  string `json:"detailId"`
+	File      string `json:"file"`
+	DryRun    bool   `json:"dryRun"`
+	Created   int    `json:"created"`
+	Updated   int    `json:"updated"`
+	Unchanged int    `json:"unchanged"`
+	Uploaded  int    `json:"uploaded"`
+	asc.BulkResult
+}
+
+// GameCenterImportCommand returns the game-center import subcommand.
+func GameCenterImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
+	file := fs.String("file", "", "Path to a configuration written by game-center export (YAML or JSON)")
+	dryRun := fs.Bool("dry-run", false, "Show what would be created or updated without changing anything")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc game-center import --app APP_ID --file gc.yaml [--dry-run] [flags]",
+		ShortHelp:  "Create or update an app's Game Center configuration from a file.",
+		LongHelp: `Create or update an app's Game Center configuration from a file.
+
+Resources are matched by vendor identifier and localizations by locale.
+Missing ones are created and existing ones are updated only where they differ
+from the file, so running the same import again changes nothing. Nothing is
+deleted: resources and localizations missing from the file are left alone.
+
+Leaderboard set members are replaced with the set's "leaderboards" list.
+Activity and challenge localizations are applied to their latest version.
+Images with a "path" (relative to the file) are uploaded to localizations
+that have no image yet.
+
+Examples:
+  asc game-center import --app "APP_ID" --file gc.yaml --dry-run
+  asc game-center import --app "APP_ID" --file gc.yaml
+  asc game-center import --gc-detail-id "DETAIL_ID" --file gc.yaml --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			filePath := strings.TrimSpace(*file)
+			if filePath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			config, err := loadGameCenterConfig(filePath)
+			if err != nil {
+				return shared.UsageErrorf("--file: %v", err)
+			}
+			if err := resolveGameCenterConfigImagePaths(config, filepath.Dir(filePath)); err != nil {
+				return shared.UsageErrorf("--file: %s: %v", filePath, err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithUploadTimeout(ctx)
+			defer cancel()
+
+			detailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
+			if err != nil {
+				return fmt.Errorf("game-center import: failed to get Game Center detail: %w", err)
+			}
+
+			planOnly := *dryRun || asc.DryRunEnabled()
+			importer := &gameCenterImporter{
+				client:         client,
+				detailID:       detailID,
+				dryRun:         planOnly,
+				leaderboardIDs: map[string]string{},
+				result: &gameCenterImportResult{
+					AppID:    resolvedAppID,
+					DetailID: detailID,
+					File:     filePath,
+					DryRun:   planOnly,
+				},
+			}
+			runErr := importer.run(requestCtx, config)
+			importer.result.Total = len(importer.result.Items)
+			if runErr != nil && importer.result.Failed == 0 {
+				// Report what was applied before the failure.
+				_ = printGameCenterImportResult(importer.result, *output.Output, *output.Pretty)
+				return fmt.Errorf("game-center import: %w", runErr)
+			}
+
+			if err := printGameCenterImportResult(importer.result, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if importer.result.Failed > 0 {
+				// Later resources can depend on the failed one, so the
+				// import stops at the first failed item.
+				return shared.NewReportedError(fmt.Errorf("game-center import: %d item(s) failed", importer.result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+// resolveGameCenterConfigImagePaths makes image paths relative to baseDir and
+// checks that each file exists before anything is changed.
+func resolveGameCenterConfigImagePaths(config *gameCenterConfig, baseDir string) error {
+	resolve := func(localizations []gameCenterConfigLocalization) error {
+		for i := range localizations {
+			image := localizations[i].Image
+			if image == nil || strings.TrimSpace(image.Path) == "" {
+				continue
+			}
+			path := strings.TrimSpace(image.Path)
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(baseDir, path)
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				return fmt.Errorf("image %q: %w", image.Path, err)
+			}
+			if info.IsDir() {
+				return fmt.Errorf("image %q is a directory", image.Path)
+			}
+			image.Path = path
+		}
+		return nil
+	}
+	for _, item := range config.Achievements {
+		if err := resolve(item.Localizations); err != nil {
+			return err
+		}
+	}
+	for _, item := range config.Leaderboards {
+		if err := resolve(item.Localizations); err != nil {
+			return err
+		}
+	}
+	for _, item := range config.LeaderboardSets {
+		if err := resolve(item.Localizations); err != nil {
+			return err
+		}
+	}
+	for _, item := range config.Activities {
+		if err := resolve(item.Localizations); err != nil {
+			return err
+		}
+	}
+	for _, item := range config.Challenges {
+		if err := resolve(item.Localizations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gameCenterImporter applies a configuration to one Game Center detail. In
+// dry-run mode it records the same actions without sending changes.
+type gameCenterImporter struct {
+	client   *asc.Client
+	detailID string
+	dryRun   bool
+	result   *gameCenterImportResult
+	// leaderboardIDs maps vendor identifiers to leaderboard IDs in the target
+	// app. Leaderboards that a dry run would create map to "".
+	leaderboardIDs map[string]string
+}
+
+// gameCenterLocalizationSpec describes how to read and write the
+// localizations of one resource type.
+type gameCenterLocalizationSpec struct {
+	kind   string
+	list   func(ctx context.Context, parentID string) ([]gameCenterRemoteLocalization, error)
+	create func(ctx context.Context, parentID string, localization gameCenterConfigLocalization) (string, error)
+	update func(ctx context.Context, id string, localization gameCenterConfigLocalization) error
+	upload func(ctx context.Context, id, path string) error
+}
+
+func (im *gameCenterImporter) run(ctx context.Context, config *gameCenterConfig) error {
+	if err := im.importAchievements(ctx, config.Achievements); err != nil {
+		return err
+	}
+	if err := im.importLeaderboards(ctx, config.Leaderboards); err != nil {
+		return err
+	}
+	if err := im.importLeaderboardSets(ctx, config.LeaderboardSets); err != nil {
+		return err
+	}
+	if err := im.importActivities(ctx, config.Activities); err != nil {
+		return err
+	}
+	return im.importChallenges(ctx, config.Challenges)
+}
+
+func (im *gameCenterImporter) record(action gameCenterImportAction) {
+	switch action.Action {
+	case gameCenterImportCreate:
+		im.result.Created++
+	case gameCenterImportUpdate:
+		im.result.Updated++
+	case gameCenterImportUnchanged:
+		im.result.Unchanged++
+		im.result.Skip(action.item(false), gameCenterImportUnchanged)
+		return
+	case gameCenterImportUpload:
+		im.result.Uploaded++
+	}
+	im.result.Succeed(action.item(im.dryRun))
+}
+
+// fail records an action whose request failed and returns err.
+func (im *gameCenterImporter) fail(action gameCenterImportAction, err error) error {
+	im.result.Fail(action.item(false), err)
+	return err
+}
+
+// create records a create action and, outside dry-run mode, runs it. It
+// returns the new resource ID, or "" in dry-run mode.
+func (im *gameCenterImporter) create(action gameCenterImportAction, run func() (string, error)) (string, error) {
+	action.Action = gameCenterImportCreate
+	if im.dryRun {
+		im.record(action)
+		return "", nil
+	}
+	id, err := run()
+	if err != nil {
+		return "", im.fail(action, err)
+	}
+	action.ID = id
+	im.record(action)
+	return id, nil
+}
+
+// update records an update (or unchanged) action and, outside dry-run mode,
+// runs it when there are changes.
+func (im *gameCenterImporter) update(action gameCenterImportAction, changes []string, run func() error) error {
+	if len(changes) == 0 {
+		action.Action = gameCenterImportUnchanged
+		im.record(action)
+		return nil
+	}
+	action.Action = gameCenterImportUpdate
+	action.Changes = changes
+	if !im.dryRun {
+		if err := run(); err != nil {
+			return im.fail(action, err)
+		}
+	}
+	im.record(action)
+	return nil
+}
+
+// syncLocalizations creates or updates localizations by locale and uploads
+// images to localizations without one. When the parent was just created (or
+// would be, in a dry run) every localization is a create.
+func (im *gameCenterImporter) syncLocalizations(ctx context.Context, spec gameCenterLocalizationSpec, vendorID, parentID string, parentExisted bool, desired []gameCenterConfigLocalization) error {
+	remote := map[string]gameCenterRemoteLocalization{}
+	if parentExisted && parentID != "" {
+		existing, err := spec.list(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		for _, item := range existing {
+			remote[strings.ToLower(item.Localization.Locale)] = item
+		}
+	}
+
+	for _, localization := range desired {
+		locale := strings.TrimSpace(localization.Locale)
+		action := gameCenterImportAction{Type: spec.kind + "-localization", VendorID: vendorID, Locale: locale}
+		existing, ok := remote[strings.ToLower(locale)]
+		localizationID := existing.ID
+		if !ok {
+			id, err := im.create(action, func() (string, error) {
+				return spec.create(ctx, parentID, localization)
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", locale, err)
+			}
+			localizationID = id
+		} else {
+			action.ID = existing.ID
+			changes := gameCenterLocalizationChanges(existing.Localization, localization)
+			if err := im.update(action, changes, func() error {
+				return spec.update(ctx, existing.ID, localization)
+			}); err != nil {
+				return fmt.Errorf("%s: %w", locale, err)
+			}
+		}
+
+		if localization.Image == nil || strings.TrimSpace(localization.Image.Path) == "" {
+			continue
+		}
+		if ok && existing.Localization.Image != nil {
+			continue
+		}
+		upload := gameCenterImportAction{
+			Type:     spec.kind + "-image",
+			VendorID: vendorID,
+			Locale:   locale,
+			Action:   gameCenterImportUpload,
+			ID:       localizationID,
+		}
+		if !im.dryRun {
+			if err := spec.upload(ctx, localizationID, localization.Image.Path); err != nil {
+				return fmt.Errorf("%s: failed to upload image: %w", locale, im.fail(upload, err))
+			}
+		}
+		im.record(upload)
+	}
+	return nil
+}
+
+// gameCenterLocalizationChanges lists the localized fields that differ.
+// Fields a resource type does not use are empty on both sides.
+func gameCenterLocalizationChanges(remote, desired gameCenterConfigLocalization) []string {
+	var changes []string
+	compare := func(name, have, want string) {
+		if have != want {
+			changes = append(changes, name)
+		}
+	}
+	compare("name", remote.Name, desired.Name)
+	compare("description", remote.Description, desired.Description)
+	compare("beforeEarnedDescription", remote.BeforeEarnedDescription, desired.BeforeEarnedDescription)
+	compare("afterEarnedDescription", remote.AfterEarnedDescription, desired.AfterEarnedDescription)
+	compare("formatterOverride", remote.FormatterOverride, desired.FormatterOverride)
+	compare("formatterSuffix", remote.FormatterSuffix, desired.FormatterSuffix)
+	compare("formatterSuffixSingular", remote.FormatterSuffixSingular, desired.FormatterSuffixSingular)
+	return changes
+}
+
+func (im *gameCenterImporter) importAchievements(ctx context.Context, items []gameCenterConfigAchievement) error {
+	if len(items) == 0 {
+		return nil
+	}
+	existing, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterAchievementsResponse, error) {
+		return im.client.GetGameCenterAchievements(ctx, im.detailID, asc.WithGCAchievementsLimit(200), asc.WithGCAchievementsNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch achievements: %w", err)
+	}
+	byVendorID := make(map[string]asc.Resource[asc.GameCenterAchievementAttributes], len(existing))
+	for _, item := range existing {
+		byVendorID[item.Attributes.VendorIdentifier] = item
+	}
+
+	spec := gameCenterLocalizationSpec{
+		kind: "achievement",
+		list: func(ctx context.Context, parentID string) ([]gameCenterRemoteLocalization, error) {
+			return collectGameCenterAchievementLocalizations(ctx, im.client, parentID)
+		},
+		create: func(ctx context.Context, parentID string, localization gameCenterConfigLocalization) (string, error) {
+			resp, err := im.client.CreateGameCenterAchievementLocalization(ctx, parentID, asc.GameCenterAchievementLocalizationCreateAttributes{
+				Locale:                  strings.TrimSpace(localization.Locale),
+				Name:                    localization.Name,
+				BeforeEarnedDescription: localization.BeforeEarnedDescription,
+				AfterEarnedDescription:  localization.AfterEarnedDescription,
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Data.ID, nil
+		},
+		update: func(ctx context.Context, id string, localization gameCenterConfigLocalization) error {
+			_, err := im.client.UpdateGameCenterAchievementLocalization(ctx, id, asc.GameCenterAchievementLocalizationUpdateAttributes{
+				Name:                    &localization.Name,
+				BeforeEarnedDescription: &localization.BeforeEarnedDescription,
+				AfterEarnedDescription:  &localization.AfterEarnedDescription,
+			})
+			return err
+		},
+		upload: func(ctx context.Context, id, path string) error {
+			_, err := im.client.UploadGameCenterAchievementImage(ctx, id, path)
+			return err
+		},
+	}
+
+	for _, item := range items {
+		vendorID := strings.TrimSpace(item.VendorID)
+		action := gameCenterImportAction{Type: "achievement", VendorID: vendorID}
+		remote, ok := byVendorID[vendorID]
+		id := remote.ID
+		if !ok {
+			id, err = im.create(action, func() (string, error) {
+				resp, err := im.client.CreateGameCenterAchievement(ctx, im.detailID, asc.GameCenterAchievementCreateAttributes{
+					ReferenceName:      item.ReferenceName,
+					VendorIdentifier:   vendorID,
+					Points:             item.Points,
+					ShowBeforeEarned:   item.ShowBeforeEarned,
+					Repeatable:         item.Repeatable,
+					ActivityProperties: item.ActivityProperties,
+				})
+				if err != nil {
+					return "", err
+				}
+				return resp.Data.ID, nil
+			})
+		} else {
+			action.ID = id
+			attrs, changes := gameCenterAchievementUpdate(remote.Attributes, item)
+			err = im.update(action, changes, func() error {
+				_, err := im.client.UpdateGameCenterAchievement(ctx, id, attrs)
+				return err
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("achievement %q: %w", vendorID, err)
+		}
+		if err := im.syncLocalizations(ctx, spec, vendorID, id, ok, item.Localizations); err != nil {
+			return fmt.Errorf("achievement %q: %w", vendorID, err)
+		}
+	}
+	return nil
+}
+
+func gameCenterAchievementUpdate(remote asc.GameCenterAchievementAttributes, desired gameCenterConfigAchievement) (asc.GameCenterAchievementUpdateAttributes, []string) {
+	var attrs asc.GameCenterAchievementUpdateAttributes
+	var changes []string
+	if remote.ReferenceName != desired.ReferenceName {
+		attrs.ReferenceName = &desired.ReferenceName
+		changes = append(changes, "referenceName")
+	}
+	if remote.Points != desired.Points {
+		attrs.Points = &desired.Points
+		changes = append(changes, "points")
+	}
+	if remote.ShowBeforeEarned != desired.ShowBeforeEarned {
+		attrs.ShowBeforeEarned = &desired.ShowBeforeEarned
+		changes = append(changes, "showBeforeEarned")
+	}
+	if remote.Repeatable != desired.Repeatable {
+		attrs.Repeatable = &desired.Repeatable
+		changes = append(changes, "repeatable")
+	}
+	if len(desired.ActivityProperties) > 0 && !maps.Equal(remote.ActivityProperties, desired.ActivityProperties) {
+		attrs.ActivityProperties = desired.ActivityProperties
+		changes = append(changes, "activityProperties")
+	}
+	return attrs, changes
+}
+
+func (im *gameCenterImporter) importLeaderboards(ctx context.Context, items []gameCenterConfigLeaderboard) error {
+	existing, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardsResponse, error) {
+		return im.client.GetGameCenterLeaderboards(ctx, im.detailID, asc.WithGCLeaderboardsLimit(200), asc.WithGCLeaderboardsNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch leaderboards: %w", err)
+	}
+	byVendorID := make(map[string]asc.Resource[asc.GameCenterLeaderboardAttributes], len(existing))
+	for _, item := range existing {
+		byVendorID[item.Attributes.VendorIdentifier] = item
+		im.leaderboardIDs[item.Attributes.VendorIdentifier] = item.ID
+	}
+
+	spec := gameCenterLocalizationSpec{
+		kind: "leaderboard",
+		list: func(ctx context.Context, parentID string) ([]gameCenterRemoteLocalization, error) {
+			return collectGameCenterLeaderboardLocalizations(ctx, im.client, parentID)
+		},
+		create: func(ctx context.Context, parentID string, localization gameCenterConfigLocalization) (string, error) {
+			resp, err := im.client.CreateGameCenterLeaderboardLocalization(ctx, parentID, asc.GameCenterLeaderboardLocalizationCreateAttributes{
+				Locale:                  strings.TrimSpace(localization.Locale),
+				Name:                    localization.Name,
+				FormatterOverride:       optionalTemplateString(localization.FormatterOverride),
+				FormatterSuffix:         optionalTemplateString(localization.FormatterSuffix),
+				FormatterSuffixSingular: optionalTemplateString(localization.FormatterSuffixSingular),
+				Description:             optionalTemplateString(localization.Description),
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Data.ID, nil
+		},
+		update: func(ctx context.Context, id string, localization gameCenterConfigLocalization) error {
+			_, err := im.client.UpdateGameCenterLeaderboardLocalization(ctx, id, asc.GameCenterLeaderboardLocalizationUpdateAttributes{
+				Name:                    &localization.Name,
+				FormatterOverride:       &localization.FormatterOverride,
+				FormatterSuffix:         &localization.FormatterSuffix,
+				FormatterSuffixSingular: &localization.FormatterSuffixSingular,
+				Description:             &localization.Description,
+			})
+			return err
+		},
+		upload: func(ctx context.Context, id, path string) error {
+			_, err := im.client.UploadGameCenterLeaderboardImage(ctx, id, path)
+			return err
+		},
+	}
+
+	for _, item := range items {
+		vendorID := strings.TrimSpace(item.VendorID)
+		action := gameCenterImportAction{Type: "leaderboard", VendorID: vendorID}
+		remote, ok := byVendorID[vendorID]
+		id := remote.ID
+		if !ok {
+			attrs := asc.GameCenterLeaderboardCreateAttributes{
+				ReferenceName:      item.ReferenceName,
+				VendorIdentifier:   vendorID,
+				DefaultFormatter:   item.Formatter,
+				ScoreSortType:      item.Sort,
+				SubmissionType:     item.SubmissionType,
+				ScoreRangeStart:    item.ScoreRangeStart,
+				ScoreRangeEnd:      item.ScoreRangeEnd,
+				Visibility:         item.Visibility,
+				ActivityProperties: item.ActivityProperties,
+			}
+			if item.Recurrence != nil {
+				(&leaderboardTemplate{Recurrence: item.Recurrence}).applyRecurrence(&attrs)
+			}
+			id, err = im.create(action, func() (string, error) {
+				resp, err := im.client.CreateGameCenterLeaderboard(ctx, im.detailID, attrs)
+				if err != nil {
+					return "", err
+				}
+				return resp.Data.ID, nil
+			})
+			im.leaderboardIDs[vendorID] = id
+		} else {
+			action.ID = id
+			attrs, changes := gameCenterLeaderboardUpdate(remote.Attributes, item)
+			err = im.update(action, changes, func() error {
+				_, err := im.client.UpdateGameCenterLeaderboard(ctx, id, attrs)
+				return err
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("leaderboard %q: %w", vendorID, err)
+		}
+		if err := im.syncLocalizations(ctx, spec, vendorID, id, ok, item.Localizations); err != nil {
+			return fmt.Errorf("leaderboard %q: %w", vendorID, err)
+		}
+	}
+	return nil
+}
+
+func gameCenterLeaderboardUpdate(remote asc.GameCenterLeaderboardAttributes, desired gameCenterConfigLeaderboard) (asc.GameCenterLeaderboardUpdateAttributes, []string) {
+	var attrs asc.GameCenterLeaderboardUpdateAttributes
+	var changes []string
+	compare := func(name, have string, want *string, field **string) {
+		if have != *want {
+			*field = want
+			changes = append(changes, name)
+		}
+	}
+	compare("referenceName", remote.ReferenceName, &desired.ReferenceName, &attrs.ReferenceName)
+	compare("formatter", remote.DefaultFormatter, &desired.Formatter, &attrs.DefaultFormatter)
+	compare("sort", remote.ScoreSortType, &desired.Sort, &attrs.ScoreSortType)
+	compare("submissionType", remote.SubmissionType, &desired.SubmissionType, &attrs.SubmissionType)
+	compare("scoreRangeStart", remote.ScoreRangeStart, &desired.ScoreRangeStart, &attrs.ScoreRangeStart)
+	compare("scoreRangeEnd", remote.ScoreRangeEnd, &desired.ScoreRangeEnd, &attrs.ScoreRangeEnd)
+	if desired.Visibility != "" {
+		compare("visibility", remote.Visibility, &desired.Visibility, &attrs.Visibility)
+	}
+	if desired.Recurrence != nil {
+		compare("recurrence.startDate", remote.RecurrenceStartDate, &desired.Recurrence.StartDate, &attrs.RecurrenceStartDate)
+		compare("recurrence.duration", remote.RecurrenceDuration, &desired.Recurrence.Duration, &attrs.RecurrenceDuration)
+		compare("recurrence.rule", remote.RecurrenceRule, &desired.Recurrence.Rule, &attrs.RecurrenceRule)
+	}
+	if len(desired.ActivityProperties) > 0 && !maps.Equal(remote.ActivityProperties, desired.ActivityProperties) {
+		attrs.ActivityProperties = desired.ActivityProperties
+		changes = append(changes, "activityProperties")
+	}
+	return attrs, changes
+}
+
+func (im *gameCenterImporter) importLeaderboardSets(ctx context.Context, items []gameCenterConfigLeaderboardSet) error {
+	if len(items) == 0 {
+		return nil
+	}
+	existing, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardSetsResponse, error) {
+		return im.client.GetGameCenterLeaderboardSets(ctx, im.detailID, asc.WithGCLeaderboardSetsLimit(200), asc.WithGCLeaderboardSetsNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch leaderboard sets: %w", err)
+	}
+	byVendorID := make(map[string]asc.Resource[asc.GameCenterLeaderboardSetAttributes], len(existing))
+	for _, item := range existing {
+		byVendorID[item.Attributes.VendorIdentifier] = item
+	}
+
+	spec := gameCenterLocalizationSpec{
+		kind: "leaderboard-set",
+		list: func(ctx context.Context, parentID string) ([]gameCenterRemoteLocalization, error) {
+			return collectGameCenterLeaderboardSetLocalizations(ctx, im.client, parentID)
+		},
+		create: func(ctx context.Context, parentID string, localization gameCenterConfigLocalization) (string, error) {
+			resp, err := im.client.CreateGameCenterLeaderboardSetLocalization(ctx, parentID, asc.GameCenterLeaderboardSetLocalizationCreateAttributes{
+				Locale: strings.TrimSpace(localization.Locale),
+				Name:   localization.Name,
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Data.ID, nil
+		},
+		update: func(ctx context.Context, id string, localization gameCenterConfigLocalization) error {
+			_, err := im.client.UpdateGameCenterLeaderboardSetLocalization(ctx, id, asc.GameCenterLeaderboardSetLocalizationUpdateAttributes{
+				Name: &localization.Name,
+			})
+			return err
+		},
+		upload: func(ctx context.Context, id, path string) error {
+			_, err := im.client.UploadGameCenterLeaderboardSetImage(ctx, id, path)
+			return err
+		},
+	}
+
+	for _, item := range items {
+		vendorID := strings.TrimSpace(item.VendorID)
+		action := gameCenterImportAction{Type: "leaderboard-set", VendorID: vendorID}
+		remote, ok := byVendorID[vendorID]
+		id := remote.ID
+		var currentMembers []string
+		if !ok {
+			id, err = im.create(action, func() (string, error) {
+				resp, err := im.client.CreateGameCenterLeaderboardSet(ctx, im.detailID, asc.GameCenterLeaderboardSetCreateAttributes{
+					ReferenceName:    item.ReferenceName,
+					VendorIdentifier: vendorID,
+				})
+				if err != nil {
+					return "", err
+				}
+				return resp.Data.ID, nil
+			})
+		} else {
+			action.ID = id
+			var changes []string
+			var attrs asc.GameCenterLeaderboardSetUpdateAttributes
+			if remote.Attributes.ReferenceName != item.ReferenceName {
+				attrs.ReferenceName = &item.ReferenceName
+				changes = append(changes, "referenceName")
+			}
+			err = im.update(action, changes, func() error {
+				_, err := im.client.UpdateGameCenterLeaderboardSet(ctx, id, attrs)
+				return err
+			})
+			if err == nil {
+				members, fetchErr := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterLeaderboardsResponse, error) {
+					return im.client.GetGameCenterLeaderboardSetMembers(ctx, id, asc.WithGCLeaderboardSetMembersLimit(200), asc.WithGCLeaderboardSetMembersNextURL(nextURL))
+				})
+				if fetchErr != nil {
+					err = fmt.Errorf("failed to fetch members: %w", fetchErr)
+				}
+				for _, member := range members {
+					currentMembers = append(currentMembers, member.ID)
+				}
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("leaderboard set %q: %w", vendorID, err)
+		}
+
+		desiredMembers := make([]string, 0, len(item.Leaderboards))
+		for _, member := range item.Leaderboards {
+			desiredMembers = append(desiredMembers, im.leaderboardIDs[strings.TrimSpace(member)])
+		}
+		if ok || len(desiredMembers) > 0 {
+			membersAction := gameCenterImportAction{Type: "leaderboard-set-members", VendorID: vendorID, ID: id}
+			var changes []string
+			if !slices.Equal(currentMembers, desiredMembers) {
+				changes = []string{"leaderboards"}
+			}
+			if err := im.update(membersAction, changes, func() error {
+				return im.client.SetGameCenterLeaderboardSetMembers(ctx, id, desiredMembers)
+			}); err != nil {
+				return fmt.Errorf("leaderboard set %q: failed to set members: %w", vendorID, err)
+			}
+		}
+		if err := im.syncLocalizations(ctx, spec, vendorID, id, ok, item.Localizations); err != nil {
+			return fmt.Errorf("leaderboard set %q: %w", vendorID, err)
+		}
+	}
+	return nil
+}
+
+func (im *gameCenterImporter) importActivities(ctx context.Context, items []gameCenterConfigActivity) error {
+	if len(items) == 0 {
+		return nil
+	}
+	existing, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterActivitiesResponse, error) {
+		return im.client.GetGameCenterActivities(ctx, im.detailID, asc.WithGCActivitiesLimit(200), asc.WithGCActivitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch activities: %w", err)
+	}
+	byVendorID := make(map[string]asc.Resource[asc.GameCenterActivityAttributes], len(existing))
+	for _, item := range existing {
+		byVendorID[item.Attributes.VendorIdentifier] = item
+	}
+
+	spec := gameCenterLocalizationSpec{
+		kind: "activity",
+		list: func(ctx context.Context, parentID string) ([]gameCenterRemoteLocalization, error) {
+			return collectGameCenterActivityLocalizations(ctx, im.client, parentID)
+		},
+		create: func(ctx context.Context, parentID string, localization gameCenterConfigLocalization) (string, error) {
+			resp, err := im.client.CreateGameCenterActivityLocalization(ctx, parentID, asc.GameCenterActivityLocalizationCreateAttributes{
+				Locale:      strings.TrimSpace(localization.Locale),
+				Name:        localization.Name,
+				Description: localization.Description,
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Data.ID, nil
+		},
+		update: func(ctx context.Context, id string, localization gameCenterConfigLocalization) error {
+			_, err := im.client.UpdateGameCenterActivityLocalization(ctx, id, asc.GameCenterActivityLocalizationUpdateAttributes{
+				Name:        &localization.Name,
+				Description: &localization.Description,
+			})
+			return err
+		},
+		upload: func(ctx context.Context, id, path string) error {
+			_, err := im.client.UploadGameCenterActivityImage(ctx, id, path)
+			return err
+		},
+	}
+
+	for _, item := range items {
+		vendorID := strings.TrimSpace(item.VendorID)
+		action := gameCenterImportAction{Type: "activity", VendorID: vendorID}
+		remote, ok := byVendorID[vendorID]
+		id := remote.ID
+		if !ok {
+			attrs := asc.GameCenterActivityCreateAttributes{
+				ReferenceName:    item.ReferenceName,
+				VendorIdentifier: vendorID,
+				PlayStyle:        optionalTemplateString(item.PlayStyle),
+				Properties:       item.Properties,
+			}
+			if item.MinimumPlayersCount > 0 {
+				attrs.MinimumPlayersCount = &item.MinimumPlayersCount
+			}
+			if item.MaximumPlayersCount > 0 {
+				attrs.MaximumPlayersCount = &item.MaximumPlayersCount
+			}
+			if item.SupportsPartyCode {
+				attrs.SupportsPartyCode = &item.SupportsPartyCode
+			}
+			initialVersion := &asc.GameCenterActivityVersionCreateAttributes{FallbackURL: optionalTemplateString(item.FallbackURL)}
+			id, err = im.create(action, func() (string, error) {
+				resp, err := im.client.CreateGameCenterActivity(ctx, im.detailID, attrs, "", initialVersion)
+				if err != nil {
+					return "", err
+				}
+				return resp.Data.ID, nil
+			})
+		} else {
+			action.ID = id
+			attrs, changes := gameCenterActivityUpdate(remote.Attributes, item)
+			err = im.update(action, changes, func() error {
+				_, err := im.client.UpdateGameCenterActivity(ctx, id, attrs)
+				return err
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("activity %q: %w", vendorID, err)
+		}
+
+		versionID := ""
+		if id != "" {
+			version, err := latestGameCenterActivityVersion(ctx, im.client, id)
+			if err != nil {
+				return fmt.Errorf("activity %q: %w", vendorID, err)
+			}
+			if version != nil {
+				versionID = version.ID
+				if ok {
+					versionAction := gameCenterImportAction{Type: "activity-version", VendorID: vendorID, ID: versionID}
+					var changes []string
+					if version.Attributes.FallbackURL != item.FallbackURL {
+						changes = []string{"fallbackUrl"}
+					}
+					if err := im.update(versionAction, changes, func() error {
+						_, err := im.client.UpdateGameCenterActivityVersion(ctx, versionID, &item.FallbackURL)
+						return err
+					}); err != nil {
+						return fmt.Errorf("activity %q: %w", vendorID, err)
+					}
+				}
+			} else if len(item.Localizations) > 0 {
+				return fmt.Errorf("activity %q: has no version to localize", vendorID)
+			}
+		}
+		if err := im.syncLocalizations(ctx, spec, vendorID, versionID, ok, item.Localizations); err != nil {
+			return fmt.Errorf("activity %q: %w", vendorID, err)
+		}
+	}
+	return nil
+}
+
+func gameCenterActivityUpdate(remote asc.GameCenterActivityAttributes, desired gameCenterConfigActivity) (asc.GameCenterActivityUpdateAttributes, []string) {
+	var attrs asc.GameCenterActivityUpdateAttributes
+	var changes []string
+	if remote.ReferenceName != desired.ReferenceName {
+		attrs.ReferenceName = &desired.ReferenceName
+		changes = append(changes, "referenceName")
+	}
+	if remote.PlayStyle != desired.PlayStyle {
+		attrs.PlayStyle = &desired.PlayStyle
+		changes = append(changes, "playStyle")
+	}
+	if remote.MinimumPlayersCount != desired.MinimumPlayersCount {
+		attrs.MinimumPlayersCount = &desired.MinimumPlayersCount
+		changes = append(changes, "minimumPlayersCount")
+	}
+	if remote.MaximumPlayersCount != desired.MaximumPlayersCount {
+		attrs.MaximumPlayersCount = &desired.MaximumPlayersCount
+		changes = append(changes, "maximumPlayersCount")
+	}
+	if remote.SupportsPartyCode != desired.SupportsPartyCode {
+		attrs.SupportsPartyCode = &desired.SupportsPartyCode
+		changes = append(changes, "supportsPartyCode")
+	}
+	if len(desired.Properties) > 0 && !maps.Equal(remote.Properties, desired.Properties) {
+		attrs.Properties = desired.Properties
+		changes = append(changes, "properties")
+	}
+	return attrs, changes
+}
+
+func (im *gameCenterImporter) importChallenges(ctx context.Context, items []gameCenterConfigChallenge) error {
+	if len(items) == 0 {
+		return nil
+	}
+	existing, err := fetchAllGameCenterPages(ctx, func(ctx context.Context, nextURL string) (*asc.GameCenterChallengesResponse, error) {
+		return im.client.GetGameCenterChallenges(ctx, im.detailID, asc.WithGCChallengesLimit(200), asc.WithGCChallengesNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch challenges: %w", err)
+	}
+	byVendorID := make(map[string]asc.Resource[asc.GameCenterChallengeAttributes], len(existing))
+	for _, item := range existing {
+		byVendorID[item.Attributes.VendorIdentifier] = item
+	}
+
+	spec := gameCenterLocalizationSpec{
+		kind: "challenge",
+		list: func(ctx context.Context, parentID string) ([]gameCenterRemoteLocalization, error) {
+			return collectGameCenterChallengeLocalizations(ctx, im.client, parentID)
+		},
+		create: func(ctx context.Context, parentID string, localization gameCenterConfigLocalization) (string, error) {
+			resp, err := im.client.CreateGameCenterChallengeLocalization(ctx, parentID, asc.GameCenterChallengeLocalizationCreateAttributes{
+				Locale:      strings.TrimSpace(localization.Locale),
+				Name:        localization.Name,
+				Description: localization.Description,
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Data.ID, nil
+		},
+		update: func(ctx context.Context, id string, localization gameCenterConfigLocalization) error {
+			_, err := im.client.UpdateGameCenterChallengeLocalization(ctx, id, asc.GameCenterChallengeLocalizationUpdateAttributes{
+				Name:        &localization.Name,
+				Description: &localization.Description,
+			})
+			return err
+		},
+		upload: func(ctx context.Context, id, path string) error {
+			_, err := im.client.UploadGameCenterChallengeImage(ctx, id, path)
+			return err
+		},
+	}
+
+	for _, item := range items {
+		vendorID := strings.TrimSpace(item.VendorID)
+		action := gameCenterImportAction{Type: "challenge", VendorID: vendorID}
+		leaderboardID := im.leaderboardIDs[strings.TrimSpace(item.Leaderboard)]
+		remote, ok := byVendorID[vendorID]
+		id := remote.ID
+		if !ok {
+			attrs := asc.GameCenterChallengeCreateAttributes{
+				ReferenceName:    item.ReferenceName,
+				VendorIdentifier: vendorID,
+				ChallengeType:    item.ChallengeType,
+			}
+			if item.Repeatable {
+				attrs.Repeatable = &item.Repeatable
+			}
+			id, err = im.create(action, func() (string, error) {
+				resp, err := im.client.CreateGameCenterChallenge(ctx, im.detailID, attrs, leaderboardID, "", true)
+				if err != nil {
+					return "", err
+				}
+				return resp.Data.ID, nil
+			})
+		} else {
+			action.ID = id
+			var attrs asc.GameCenterChallengeUpdateAttributes
+			var changes []string
+			if remote.Attributes.ReferenceName != item.ReferenceName {
+				attrs.ReferenceName = &item.ReferenceName
+				changes = append(changes, "referenceName")
+			}
+			if remote.Attributes.Repeatable != item.Repeatable {
+				attrs.Repeatable = &item.Repeatable
+				changes = append(changes, "repeatable")
+			}
+			updateLeaderboardID := ""
+			if strings.TrimSpace(item.Leaderboard) != "" {
+				linkage, linkErr := im.client.GetGameCenterChallengeLeaderboardRelationship(ctx, id)
+				if linkErr != nil && !errors.Is(linkErr, asc.ErrNotFound) {
+					return fmt.Errorf("challenge %q: failed to fetch leaderboard: %w", vendorID, linkErr)
+				}
+				if linkage == nil || linkage.Data.ID != leaderboardID || leaderboardID == "" {
+					updateLeaderboardID = leaderboardID
+					changes = append(changes, "leaderboard")
+				}
+			}
+			err = im.update(action, changes, func() error {
+				_, err := im.client.UpdateGameCenterChallenge(ctx, id, attrs, updateLeaderboardID)
+				return err
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("challenge %q: %w", vendorID, err)
+		}
+
+		versionID := ""
+		if id != "" {
+			version, err := latestGameCenterChallengeVersion(ctx, im.client, id)
+			if err != nil {
+				return fmt.Errorf("challenge %q: %w", vendorID, err)
+			}
+			if version == nil && len(item.Localizations) > 0 {
+				return fmt.Errorf("challenge %q: has no version to localize", vendorID)
+			}
+			if version != nil {
+				versionID = version.ID
+			}
+		}
+		if err := im.syncLocalizations(ctx, spec, vendorID, versionID, ok, item.Localizations); err != nil {
+			return fmt.Errorf("challenge %q: %w", vendorID, err)
+		}
+	}
+	return nil
+}
+
+func printGameCenterImportResult(result *gameCenterImportResult, format string, pretty bool) error {
+	summary := func() {
+		fmt.Printf("Detail ID: %s\n", result.DetailID)
+		fmt.Printf("File: %s\n", result.File)
+		fmt.Printf("Dry Run: %t\n", result.DryRun)
+		fmt.Printf("Created: %d, Updated: %d, Unchanged: %d, Images Uploaded: %d, Failed: %d\n\n", result.Created, result.Updated, result.Unchanged, result.Uploaded, result.Failed)
+	}
+
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error {
+			summary()
+			asc.RenderTable(asc.BulkResultRows(&result.BulkResult))
+			return nil
+		},
+		func() error {
+			summary()
+			asc.RenderMarkdown(asc.BulkResultRows(&result.BulkResult))
+			return nil
+		},
+	)
+}
@@ -0,0 +1,32 @@
+package gamecenter
+
+import "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+
+func normalizeGCAchievementsInclude(value string) ([]string, error) {
+	return shared.NormalizeSelection(value, gcAchievementsIncludeList(), "--include")
+}
+
+func gcAchievementsIncludeList() []string {
+	return []string{
+		"gameCenterDetail",
+		"gameCenterGroup",
+		"groupAchievement",
+		"localizations",
+		"releases",
+	}
+}
+
+func normalizeGCLeaderboardsInclude(value string) ([]string, error) {
+	return shared.NormalizeSelection(value, gcLeaderboardsIncludeList(), "--include")
+}
+
+func gcLeaderboardsIncludeList() []string {
+	return []string{
+		"gameCenterDetail",
+		"gameCenterGroup",
+		"gameCenterLeaderboardSets",
+		"groupLeaderboard",
+		"localizations",
+		"releases",
+	}
+}
@@ -25,11 +25,13 @@ func GameCenterLeaderboardSetImagesCommand() *ffcli.Command {
 
 Examples:
   asc game-center leaderboard-sets images upload --localization-id "LOC_ID" --file path/to/image.png
+  asc game-center leaderboard-sets images get --id "IMAGE_ID"
   asc game-center leaderboard-sets images delete --id "IMAGE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardSetImagesUploadCommand(),
+			GameCenterLeaderboardSetImagesGetCommand(),
 			GameCenterLeaderboardSetImagesDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -89,6 +91,48 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardSetImagesGetCommand returns the images get subcommand.
+func GameCenterLeaderboardSetImagesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	id := fs.String("id", "", "Game Center leaderboard set image ID")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc game-center leaderboard-sets images get --id \"IMAGE_ID\"",
+		ShortHelp:  "Get a Game Center leaderboard set image, including its upload state.",
+		LongHelp: `Get a Game Center leaderboard set image, including its upload state.
+
+Examples:
+  asc game-center leaderboard-sets images get --id "IMAGE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboard-sets images get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetGameCenterLeaderboardSetImage(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboard-sets images get: failed to fetch: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterLeaderboardSetImagesDeleteCommand returns the images delete subcommand.
 func GameCenterLeaderboardSetImagesDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
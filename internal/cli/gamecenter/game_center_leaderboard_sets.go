@@ -310,7 +310,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required (--name)")
 				return flag.ErrHelp
 			}
@@ -389,6 +389,7 @@ func GameCenterLeaderboardSetsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -416,7 +417,7 @@ Examples:
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -433,7 +434,7 @@ Examples:
 			if nextURL == "" {
 				// Get Game Center detail ID first
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center leaderboard-sets list: failed to get Game Center detail: %w", err)
 				}
@@ -518,6 +519,7 @@ func GameCenterLeaderboardSetsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	referenceName := fs.String("reference-name", "", "Reference name for the leaderboard set")
 	vendorID := fs.String("vendor-id", "", "Vendor identifier (e.g., com.example.set)")
 	output := shared.BindOutputFlags(fs)
@@ -535,7 +537,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if resolvedAppID == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -561,7 +563,7 @@ Examples:
 			defer cancel()
 
 			// Get Game Center detail ID first
-			gcDetailID, err := client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+			gcDetailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 			if err != nil {
 				return fmt.Errorf("game-center leaderboard-sets create: failed to get Game Center detail: %w", err)
 			}
@@ -615,7 +617,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -703,12 +705,14 @@ Releases control which Game Center details (apps) a leaderboard set is associate
 
 Examples:
   asc game-center leaderboard-sets releases list --set-id "SET_ID"
+  asc game-center leaderboard-sets releases get --id "RELEASE_ID"
   asc game-center leaderboard-sets releases create --app "APP_ID" --set-id "SET_ID"
   asc game-center leaderboard-sets releases delete --id "RELEASE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardSetReleasesListCommand(),
+			GameCenterLeaderboardSetReleasesGetCommand(),
 			GameCenterLeaderboardSetReleasesCreateCommand(),
 			GameCenterLeaderboardSetReleasesDeleteCommand(),
 		},
@@ -794,11 +798,54 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardSetReleasesGetCommand returns the leaderboard-sets releases get subcommand.
+func GameCenterLeaderboardSetReleasesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	id := fs.String("id", "", "Game Center leaderboard set release ID")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc game-center leaderboard-sets releases get --id \"RELEASE_ID\"",
+		ShortHelp:  "Get a Game Center leaderboard set release.",
+		LongHelp: `Get a Game Center leaderboard set release.
+
+Examples:
+  asc game-center leaderboard-sets releases get --id "RELEASE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboard-sets releases get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetGameCenterLeaderboardSetRelease(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboard-sets releases get: failed to fetch: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterLeaderboardSetReleasesCreateCommand returns the leaderboard-sets releases create subcommand.
 func GameCenterLeaderboardSetReleasesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	setID := fs.String("set-id", "", "Game Center leaderboard set ID")
 	output := shared.BindOutputFlags(fs)
 
@@ -816,7 +863,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if resolvedAppID == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -836,7 +883,7 @@ Examples:
 			defer cancel()
 
 			// Get Game Center detail ID first
-			gcDetailID, err := client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+			gcDetailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 			if err != nil {
 				return fmt.Errorf("game-center leaderboard-sets releases create: failed to get Game Center detail: %w", err)
 			}
@@ -908,16 +955,18 @@ func GameCenterLeaderboardSetGroupLeaderboardSetCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "group-leaderboard-set",
-		ShortUsage: "asc game-center leaderboard-sets group-leaderboard-set get --id \"SET_ID\"",
-		ShortHelp:  "Get the group leaderboard set for a leaderboard set.",
-		LongHelp: `Get the group leaderboard set for a Game Center leaderboard set.
+		ShortUsage: "asc game-center leaderboard-sets group-leaderboard-set <subcommand> [flags]",
+		ShortHelp:  "Get or link the group leaderboard set for a leaderboard set.",
+		LongHelp: `Get or link the group leaderboard set for a Game Center leaderboard set.
 
 Examples:
-  asc game-center leaderboard-sets group-leaderboard-set get --id "SET_ID"`,
+  asc game-center leaderboard-sets group-leaderboard-set get --id "SET_ID"
+  asc game-center leaderboard-sets group-leaderboard-set set --id "SET_ID" --group-leaderboard-set-id "GROUP_SET_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardSetGroupLeaderboardSetGetCommand(),
+			GameCenterLeaderboardSetGroupLeaderboardSetSetCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -967,6 +1016,59 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardSetGroupLeaderboardSetSetCommand returns the group leaderboard set set subcommand.
+func GameCenterLeaderboardSetGroupLeaderboardSetSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+
+	setID := fs.String("id", "", "Game Center leaderboard set ID")
+	groupSetID := fs.String("group-leaderboard-set-id", "", "Group leaderboard set ID to link")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc game-center leaderboard-sets group-leaderboard-set set --id \"SET_ID\" --group-leaderboard-set-id \"GROUP_SET_ID\"",
+		ShortHelp:  "Link a leaderboard set to a group leaderboard set.",
+		LongHelp: `Link a leaderboard set to a group leaderboard set.
+
+Examples:
+  asc game-center leaderboard-sets group-leaderboard-set set --id "SET_ID" --group-leaderboard-set-id "GROUP_SET_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*setID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			groupID := strings.TrimSpace(*groupSetID)
+			if groupID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group-leaderboard-set-id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboard-sets group-leaderboard-set set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.UpdateGameCenterLeaderboardSetGroupLeaderboardSetRelationship(requestCtx, id, groupID); err != nil {
+				return fmt.Errorf("game-center leaderboard-sets group-leaderboard-set set: failed to update: %w", err)
+			}
+
+			result := &asc.GameCenterLeaderboardSetGroupLeaderboardSetUpdateResult{
+				SetID:                 id,
+				GroupLeaderboardSetID: groupID,
+				Updated:               true,
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterLeaderboardSetLocalizationImageCommand returns the localization image command group.
 func GameCenterLeaderboardSetLocalizationImageCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("image", flag.ExitOnError)
@@ -1302,7 +1404,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required (--name)")
 				return flag.ErrHelp
 			}
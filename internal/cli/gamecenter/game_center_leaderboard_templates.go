@@ -0,0 +1,148 @@
+package gamecenter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// leaderboardTemplate is a reusable leaderboard definition loaded with
+// leaderboards create --template. Flags passed on the command line take
+// precedence over template values.
+type leaderboardTemplate struct {
+	ReferenceName   string                            `yaml:"referenceName"`
+	VendorID        string                            `yaml:"vendorId"`
+	Formatter       string                            `yaml:"formatter"`
+	Sort            string                            `yaml:"sort"`
+	SubmissionType  string                            `yaml:"submissionType"`
+	ScoreRangeStart string                            `yaml:"scoreRangeStart"`
+	ScoreRangeEnd   string                            `yaml:"scoreRangeEnd"`
+	Recurrence      *leaderboardTemplateRecurrence    `yaml:"recurrence"`
+	Localizations   []leaderboardTemplateLocalization `yaml:"localizations"`
+}
+
+type leaderboardTemplateRecurrence struct {
+	StartDate string `yaml:"startDate"`
+	Duration  string `yaml:"duration"`
+	Rule      string `yaml:"rule"`
+}
+
+type leaderboardTemplateLocalization struct {
+	Locale                  string `yaml:"locale"`
+	Name                    string `yaml:"name"`
+	FormatterOverride       string `yaml:"formatterOverride"`
+	FormatterSuffix         string `yaml:"formatterSuffix"`
+	FormatterSuffixSingular string `yaml:"formatterSuffixSingular"`
+	Description             string `yaml:"description"`
+}
+
+// leaderboardTemplateCreateResult is the output of leaderboards create
+// --template: the leaderboard plus the localizations scaffolded from the
+// template.
+type leaderboardTemplateCreateResult struct {
+	Leaderboard   asc.Resource[asc.GameCenterLeaderboardAttributes]               `json:"leaderboard"`
+	Localizations []asc.Resource[asc.GameCenterLeaderboardLocalizationAttributes] `json:"localizations"`
+}
+
+// loadLeaderboardTemplate reads a YAML (or JSON) leaderboard template.
+// Unknown keys are rejected so typos do not silently drop settings.
+func loadLeaderboardTemplate(path string) (*leaderboardTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var template leaderboardTemplate
+	if err := decoder.Decode(&template); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s: template is empty", path)
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := template.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &template, nil
+}
+
+func (t *leaderboardTemplate) validate() error {
+	if t.Recurrence != nil {
+		startDate := strings.TrimSpace(t.Recurrence.StartDate)
+		duration := strings.TrimSpace(t.Recurrence.Duration)
+		rule := strings.TrimSpace(t.Recurrence.Rule)
+		if startDate == "" || duration == "" || rule == "" {
+			return fmt.Errorf("recurrence requires startDate, duration, and rule")
+		}
+		if !strings.HasPrefix(strings.ToUpper(duration), "P") {
+			return fmt.Errorf("recurrence.duration must be an ISO 8601 duration (e.g. P7D)")
+		}
+	}
+
+	seen := make(map[string]bool, len(t.Localizations))
+	for i, localization := range t.Localizations {
+		locale := strings.TrimSpace(localization.Locale)
+		if locale == "" {
+			return fmt.Errorf("localizations[%d]: locale is required", i)
+		}
+		if err := shared.ValidateBuildLocalizationLocale(locale); err != nil {
+			return fmt.Errorf("localizations[%d]: %w", i, err)
+		}
+		if seen[strings.ToLower(locale)] {
+			return fmt.Errorf("localizations[%d]: duplicate locale %q", i, locale)
+		}
+		seen[strings.ToLower(locale)] = true
+		if strings.TrimSpace(localization.Name) == "" {
+			return fmt.Errorf("localizations[%d]: name is required", i)
+		}
+	}
+	return nil
+}
+
+// applyRecurrence copies the template's recurrence settings onto attrs.
+func (t *leaderboardTemplate) applyRecurrence(attrs *asc.GameCenterLeaderboardCreateAttributes) {
+	if t == nil || t.Recurrence == nil {
+		return
+	}
+	attrs.RecurrenceStartDate = strings.TrimSpace(t.Recurrence.StartDate)
+	attrs.RecurrenceDuration = strings.TrimSpace(t.Recurrence.Duration)
+	attrs.RecurrenceRule = strings.TrimSpace(t.Recurrence.Rule)
+}
+
+func (l leaderboardTemplateLocalization) createAttributes() asc.GameCenterLeaderboardLocalizationCreateAttributes {
+	return asc.GameCenterLeaderboardLocalizationCreateAttributes{
+		Locale:                  strings.TrimSpace(l.Locale),
+		Name:                    strings.TrimSpace(l.Name),
+		FormatterOverride:       optionalTemplateString(l.FormatterOverride),
+		FormatterSuffix:         optionalTemplateString(l.FormatterSuffix),
+		FormatterSuffixSingular: optionalTemplateString(l.FormatterSuffixSingular),
+		Description:             optionalTemplateString(l.Description),
+	}
+}
+
+// optionalTemplateString returns nil for empty values. Formatter suffixes
+// keep their surrounding spaces because they are rendered next to the score.
+func optionalTemplateString(value string) *string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return &value
+}
+
+// leaderboardTemplateValue returns the flag value when set, otherwise the
+// template value.
+func leaderboardTemplateValue(flagValue, templateValue string) string {
+	if strings.TrimSpace(flagValue) != "" {
+		return flagValue
+	}
+	return templateValue
+}
@@ -71,11 +71,13 @@ Images are attached to leaderboard localizations. Use the localization ID when u
 
 Examples:
   asc game-center leaderboards images upload --localization-id "LOC_ID" --file path/to/image.png
+  asc game-center leaderboards images get --id "IMAGE_ID"
   asc game-center leaderboards images delete --id "IMAGE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardImagesUploadCommand(),
+			GameCenterLeaderboardImagesGetCommand(),
 			GameCenterLeaderboardImagesDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -135,6 +137,48 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardImagesGetCommand returns the leaderboard images get subcommand.
+func GameCenterLeaderboardImagesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	id := fs.String("id", "", "Game Center leaderboard image ID")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc game-center leaderboards images get --id \"IMAGE_ID\"",
+		ShortHelp:  "Get a Game Center leaderboard image, including its upload state.",
+		LongHelp: `Get a Game Center leaderboard image, including its upload state.
+
+Examples:
+  asc game-center leaderboards images get --id "IMAGE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetGameCenterLeaderboardImage(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards images get: failed to fetch: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterLeaderboardImagesDeleteCommand returns the leaderboard images delete subcommand.
 func GameCenterLeaderboardImagesDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
@@ -191,6 +235,8 @@ func GameCenterLeaderboardsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(gcLeaderboardsIncludeList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -204,6 +250,7 @@ func GameCenterLeaderboardsListCommand() *ffcli.Command {
 
 Examples:
   asc game-center leaderboards list --app "APP_ID"
+  asc game-center leaderboards list --app "APP_ID" --include "localizations,gameCenterGroup" --output table
   asc game-center leaderboards list --app "APP_ID" --limit 50
   asc game-center leaderboards list --app "APP_ID" --paginate`,
 		FlagSet:   fs,
@@ -215,10 +262,14 @@ Examples:
 			if err := shared.ValidateNextURL(*next); err != nil {
 				return fmt.Errorf("game-center leaderboards list: %w", err)
 			}
+			includeValues, err := normalizeGCLeaderboardsInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			nextURL := strings.TrimSpace(*next)
-			if resolvedAppID == "" && nextURL == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" && nextURL == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -235,7 +286,7 @@ Examples:
 			if nextURL == "" {
 				// Get Game Center detail ID first
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center leaderboards list: failed to get Game Center detail: %w", err)
 				}
@@ -244,6 +295,7 @@ Examples:
 			opts := []asc.GCLeaderboardsOption{
 				asc.WithGCLeaderboardsLimit(*limit),
 				asc.WithGCLeaderboardsNextURL(*next),
+				asc.WithGCLeaderboardsInclude(includeValues),
 			}
 
 			if *paginate {
@@ -327,6 +379,7 @@ func GameCenterLeaderboardsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	referenceName := fs.String("reference-name", "", "Reference name for the leaderboard")
 	vendorID := fs.String("vendor-id", "", "Vendor identifier (e.g., com.example.leaderboard)")
 	formatter := fs.String("formatter", "", "Score formatter: INTEGER, DECIMAL_POINT_1_PLACE, DECIMAL_POINT_2_PLACE, DECIMAL_POINT_3_PLACE, ELAPSED_TIME_MILLISECOND, ELAPSED_TIME_SECOND, ELAPSED_TIME_MINUTE, MONEY_WHOLE, MONEY_POINT_2_PLACE")
@@ -335,6 +388,7 @@ func GameCenterLeaderboardsCreateCommand() *ffcli.Command {
 	scoreRangeStart := fs.String("score-range-start", "", "Score range start (optional)")
 	scoreRangeEnd := fs.String("score-range-end", "", "Score range end (optional)")
 	groupID := fs.String("group-id", "", "Game Center group ID (v2 only)")
+	templatePath := fs.String("template", "", "Path to a YAML leaderboard template; flags override template values")
 	v2 := fs.Bool("v2", false, "Use v2 leaderboards endpoint")
 	output := shared.BindOutputFlags(fs)
 
@@ -344,9 +398,34 @@ func GameCenterLeaderboardsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a new Game Center leaderboard.",
 		LongHelp: `Create a new Game Center leaderboard.
 
+--template loads leaderboard settings from a YAML file so recurring
+leaderboard shapes do not need every flag on each invocation. Flags passed
+on the command line override template values. Localizations listed in the
+template are created after the leaderboard (v1 leaderboards only).
+
+Template format:
+  referenceName: Weekly High Score
+  vendorId: com.example.weekly
+  formatter: INTEGER
+  sort: DESC
+  submissionType: BEST_SCORE
+  scoreRangeStart: "0"
+  scoreRangeEnd: "1000000"
+  recurrence:
+    startDate: "2026-01-05T00:00:00Z"
+    duration: P7D
+    rule: FREQ=WEEKLY;INTERVAL=1
+  localizations:
+    - locale: en-US
+      name: Weekly High Score
+      formatterSuffix: " points"
+      formatterSuffixSingular: " point"
+
 Examples:
   asc game-center leaderboards create --app "APP_ID" --reference-name "High Score" --vendor-id "com.example.highscore" --formatter INTEGER --sort DESC --submission-type BEST_SCORE
   asc game-center leaderboards create --app "APP_ID" --reference-name "Time Trial" --vendor-id "com.example.timetrial" --formatter ELAPSED_TIME_MILLISECOND --sort ASC --submission-type BEST_SCORE
+  asc game-center leaderboards create --app "APP_ID" --template "recurring-weekly.yaml"
+  asc game-center leaderboards create --app "APP_ID" --template "recurring-weekly.yaml" --reference-name "Week 2" --vendor-id "com.example.weekly2"
   asc game-center leaderboards create --group-id "GROUP_ID" --reference-name "Group Score" --vendor-id "grp.com.example.groupscore" --formatter INTEGER --sort DESC --submission-type BEST_SCORE --v2`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -357,19 +436,37 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			template := &leaderboardTemplate{}
+			if path := strings.TrimSpace(*templatePath); path != "" {
+				loaded, err := loadLeaderboardTemplate(path)
+				if err != nil {
+					return shared.UsageErrorf("--template: %v", err)
+				}
+				template = loaded
+			}
+			useV2 := *v2 || group != ""
+			if useV2 && len(template.Localizations) > 0 {
+				return shared.UsageError("--template localizations are only supported for v1 leaderboards; create v2 localizations with `asc game-center leaderboards v2 localizations create`")
+			}
+
+			if group != "" && strings.TrimSpace(*gcDetailIDFlag) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --gc-detail-id cannot be used with --group-id")
+				return flag.ErrHelp
+			}
+
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if group == "" && resolvedAppID == "" {
+			if group == "" && resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			name := strings.TrimSpace(*referenceName)
+			name := strings.TrimSpace(leaderboardTemplateValue(*referenceName, template.ReferenceName))
 			if name == "" {
 				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
 				return flag.ErrHelp
 			}
 
-			vendor := strings.TrimSpace(*vendorID)
+			vendor := strings.TrimSpace(leaderboardTemplateValue(*vendorID, template.VendorID))
 			if vendor == "" {
 				fmt.Fprintln(os.Stderr, "Error: --vendor-id is required")
 				return flag.ErrHelp
@@ -379,7 +476,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			formatterVal := strings.TrimSpace(strings.ToUpper(*formatter))
+			formatterVal := strings.TrimSpace(strings.ToUpper(leaderboardTemplateValue(*formatter, template.Formatter)))
 			if formatterVal == "" {
 				fmt.Fprintln(os.Stderr, "Error: --formatter is required")
 				return flag.ErrHelp
@@ -389,7 +486,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			sortVal := strings.TrimSpace(strings.ToUpper(*sortType))
+			sortVal := strings.TrimSpace(strings.ToUpper(leaderboardTemplateValue(*sortType, template.Sort)))
 			if sortVal == "" {
 				fmt.Fprintln(os.Stderr, "Error: --sort is required")
 				return flag.ErrHelp
@@ -399,7 +496,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			submissionVal := strings.TrimSpace(strings.ToUpper(*submissionType))
+			submissionVal := strings.TrimSpace(strings.ToUpper(leaderboardTemplateValue(*submissionType, template.SubmissionType)))
 			if submissionVal == "" {
 				fmt.Fprintln(os.Stderr, "Error: --submission-type is required")
 				return flag.ErrHelp
@@ -421,7 +518,7 @@ Examples:
 			if group == "" {
 				// Get Game Center detail ID first
 				var err error
-				gcDetailID, err = client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+				gcDetailID, err = shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 				if err != nil {
 					return fmt.Errorf("game-center leaderboards create: failed to get Game Center detail: %w", err)
 				}
@@ -433,11 +530,11 @@ Examples:
 				DefaultFormatter: formatterVal,
 				ScoreSortType:    sortVal,
 				SubmissionType:   submissionVal,
-				ScoreRangeStart:  strings.TrimSpace(*scoreRangeStart),
-				ScoreRangeEnd:    strings.TrimSpace(*scoreRangeEnd),
+				ScoreRangeStart:  strings.TrimSpace(leaderboardTemplateValue(*scoreRangeStart, template.ScoreRangeStart)),
+				ScoreRangeEnd:    strings.TrimSpace(leaderboardTemplateValue(*scoreRangeEnd, template.ScoreRangeEnd)),
 			}
+			template.applyRecurrence(&attrs)
 
-			useV2 := *v2 || group != ""
 			var resp *asc.GameCenterLeaderboardResponse
 			if useV2 {
 				resp, err = client.CreateGameCenterLeaderboardV2(requestCtx, gcDetailID, group, attrs)
@@ -448,7 +545,23 @@ Examples:
 				return fmt.Errorf("game-center leaderboards create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			if strings.TrimSpace(*templatePath) == "" {
+				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			}
+
+			result := leaderboardTemplateCreateResult{
+				Leaderboard:   resp.Data,
+				Localizations: make([]asc.Resource[asc.GameCenterLeaderboardLocalizationAttributes], 0, len(template.Localizations)),
+			}
+			for _, localization := range template.Localizations {
+				locResp, err := client.CreateGameCenterLeaderboardLocalization(requestCtx, resp.Data.ID, localization.createAttributes())
+				if err != nil {
+					return fmt.Errorf("game-center leaderboards create: created leaderboard %s but failed to create %s localization: %w", resp.Data.ID, strings.TrimSpace(localization.Locale), err)
+				}
+				result.Localizations = append(result.Localizations, locResp.Data)
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -501,7 +614,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -686,16 +799,21 @@ func GameCenterLeaderboardGroupLeaderboardCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "group-leaderboard",
-		ShortUsage: "asc game-center leaderboards group-leaderboard get --id \"LEADERBOARD_ID\"",
-		ShortHelp:  "Get the group leaderboard for a leaderboard.",
-		LongHelp: `Get the group leaderboard for a Game Center leaderboard.
+		ShortUsage: "asc game-center leaderboards group-leaderboard <subcommand> [flags]",
+		ShortHelp:  "Get or link the group leaderboard for a leaderboard.",
+		LongHelp: `Get or link the group leaderboard for a Game Center leaderboard.
+
+A leaderboard in an app that belongs to a Game Center group is linked to the
+group leaderboard it shares scores with.
 
 Examples:
-  asc game-center leaderboards group-leaderboard get --id "LEADERBOARD_ID"`,
+  asc game-center leaderboards group-leaderboard get --id "LEADERBOARD_ID"
+  asc game-center leaderboards group-leaderboard set --id "LEADERBOARD_ID" --group-leaderboard-id "GROUP_LEADERBOARD_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardGroupLeaderboardGetCommand(),
+			GameCenterLeaderboardGroupLeaderboardSetCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -745,6 +863,59 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardGroupLeaderboardSetCommand returns the group leaderboard set subcommand.
+func GameCenterLeaderboardGroupLeaderboardSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+
+	leaderboardID := fs.String("id", "", "Game Center leaderboard ID")
+	groupLeaderboardID := fs.String("group-leaderboard-id", "", "Group leaderboard ID to link")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc game-center leaderboards group-leaderboard set --id \"LEADERBOARD_ID\" --group-leaderboard-id \"GROUP_LEADERBOARD_ID\"",
+		ShortHelp:  "Link a leaderboard to a group leaderboard.",
+		LongHelp: `Link a leaderboard to a group leaderboard.
+
+Examples:
+  asc game-center leaderboards group-leaderboard set --id "LEADERBOARD_ID" --group-leaderboard-id "GROUP_LEADERBOARD_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*leaderboardID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			groupID := strings.TrimSpace(*groupLeaderboardID)
+			if groupID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group-leaderboard-id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards group-leaderboard set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.UpdateGameCenterLeaderboardGroupLeaderboardRelationship(requestCtx, id, groupID); err != nil {
+				return fmt.Errorf("game-center leaderboards group-leaderboard set: failed to update: %w", err)
+			}
+
+			result := &asc.GameCenterLeaderboardGroupLeaderboardUpdateResult{
+				LeaderboardID:      id,
+				GroupLeaderboardID: groupID,
+				Updated:            true,
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterLeaderboardReleasesCommand returns the releases command group.
 func GameCenterLeaderboardReleasesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("releases", flag.ExitOnError)
@@ -757,12 +928,14 @@ func GameCenterLeaderboardReleasesCommand() *ffcli.Command {
 
 Examples:
   asc game-center leaderboards releases list --leaderboard-id "LEADERBOARD_ID"
+  asc game-center leaderboards releases get --id "RELEASE_ID"
   asc game-center leaderboards releases create --app "APP_ID" --leaderboard-id "LEADERBOARD_ID"
   asc game-center leaderboards releases delete --id "RELEASE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterLeaderboardReleasesListCommand(),
+			GameCenterLeaderboardReleasesGetCommand(),
 			GameCenterLeaderboardReleasesCreateCommand(),
 			GameCenterLeaderboardReleasesDeleteCommand(),
 		},
@@ -848,11 +1021,54 @@ Examples:
 	}
 }
 
+// GameCenterLeaderboardReleasesGetCommand returns the releases get subcommand.
+func GameCenterLeaderboardReleasesGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	id := fs.String("id", "", "Game Center leaderboard release ID")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc game-center leaderboards releases get --id \"RELEASE_ID\"",
+		ShortHelp:  "Get a Game Center leaderboard release.",
+		LongHelp: `Get a Game Center leaderboard release.
+
+Examples:
+  asc game-center leaderboards releases get --id "RELEASE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards releases get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetGameCenterLeaderboardRelease(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("game-center leaderboards releases get: failed to fetch: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // GameCenterLeaderboardReleasesCreateCommand returns the releases create subcommand.
 func GameCenterLeaderboardReleasesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	gcDetailIDFlag := fs.String("gc-detail-id", "", "Game Center detail ID (skips the lookup by --app)")
 	leaderboardID := fs.String("leaderboard-id", "", "Game Center leaderboard ID")
 	output := shared.BindOutputFlags(fs)
 
@@ -870,7 +1086,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			resolvedAppID := shared.ResolveAppID(*appID)
-			if resolvedAppID == "" {
+			if resolvedAppID == "" && strings.TrimSpace(*gcDetailIDFlag) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
@@ -890,7 +1106,7 @@ Examples:
 			defer cancel()
 
 			// Get Game Center detail ID first
-			gcDetailID, err := client.GetGameCenterDetailID(requestCtx, resolvedAppID)
+			gcDetailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, *gcDetailIDFlag)
 			if err != nil {
 				return fmt.Errorf("game-center leaderboards releases create: failed to get Game Center detail: %w", err)
 			}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -29,7 +30,8 @@ Examples:
   asc game-center matchmaking rule-sets list
   asc game-center matchmaking rules list --rule-set-id "RULE_SET_ID"
   asc game-center matchmaking teams list --rule-set-id "RULE_SET_ID"
-  asc game-center matchmaking metrics queue-requests --queue-id "QUEUE_ID" --granularity P1D`,
+  asc game-center matchmaking metrics queue-requests --queue-id "QUEUE_ID" --granularity P1D
+  asc game-center matchmaking watch --queue-id "QUEUE_ID" --poll 15m --alert-expired-rate 0.2`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -38,6 +40,7 @@ Examples:
 			GameCenterMatchmakingRulesCommand(),
 			GameCenterMatchmakingTeamsCommand(),
 			GameCenterMatchmakingMetricsCommand(),
+			GameCenterMatchmakingWatchCommand(),
 			GameCenterMatchmakingRuleSetTestsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -279,7 +282,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate && strings.TrimSpace(*ruleSetID) == "" && strings.TrimSpace(*experimentRuleSetID) == "" {
+			if !hasUpdate && strings.TrimSpace(*ruleSetID) == "" && strings.TrimSpace(*experimentRuleSetID) == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -598,7 +601,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -1010,7 +1013,7 @@ Examples:
 				hasUpdate = true
 			}
 
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -1288,7 +1291,7 @@ Examples:
 				attrs.MaxPlayers = &value
 				hasUpdate = true
 			}
-			if !hasUpdate {
+			if !hasUpdate && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -1372,9 +1375,19 @@ func GameCenterMatchmakingMetricsCommand() *ffcli.Command {
 		ShortHelp:  "Fetch Game Center matchmaking metrics.",
 		LongHelp: `Fetch Game Center matchmaking metrics.
 
+Table and markdown output pivot time buckets into rows and group-by values into
+columns. CSV output writes one row per data point with RFC 3339 start/end
+timestamps and dimensions.* / values.* columns for loading into other tools.
+
+--since/--until limit output to data points in a time range (the API itself
+takes no range). When --granularity is omitted, it is picked from the range:
+PT15M up to a day, PT1H up to a week, and P1D beyond that.
+
 Examples:
   asc game-center matchmaking metrics queue-sizes --queue-id "QUEUE_ID" --granularity P1D
   asc game-center matchmaking metrics queue-requests --queue-id "QUEUE_ID" --granularity P1D --group-by result
+  asc game-center matchmaking metrics queue-requests --queue-id "QUEUE_ID" --since 24h --group-by result
+  asc game-center matchmaking metrics queue-sizes --queue-id "QUEUE_ID" --granularity P1D --output csv > queue-sizes.csv
   asc game-center matchmaking metrics rule-errors --rule-id "RULE_ID" --granularity P1D`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -1546,6 +1559,8 @@ func GameCenterMatchmakingRuleErrorsCommand() *ffcli.Command {
 }
 
 func metricsQueueCommand(name string, fs *flag.FlagSet, queueID *string, granularity *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSizesResponse, error)) *ffcli.Command {
+	window := bindMetricsWindowFlags(fs)
+
 	return &ffcli.Command{
 		Name:       name,
 		ShortUsage: "asc game-center matchmaking metrics " + name + " --queue-id \"QUEUE_ID\" --granularity P1D",
@@ -1553,16 +1568,19 @@ func metricsQueueCommand(name string, fs *flag.FlagSet, queueID *string, granula
 		LongHelp: `Fetch matchmaking queue metrics.
 
 Examples:
-  asc game-center matchmaking metrics ` + name + ` --queue-id "QUEUE_ID" --granularity P1D`,
+  asc game-center matchmaking metrics ` + name + ` --queue-id "QUEUE_ID" --granularity P1D
+  asc game-center matchmaking metrics ` + name + ` --queue-id "QUEUE_ID" --since 7d`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return runMetricsQueue(ctx, name, queueID, granularity, sort, limit, next, paginate, output, pretty, fetch, nil, "", "", "")
+			return runMetricsQueue(ctx, name, queueID, granularity, sort, limit, next, paginate, output, pretty, fetch, nil, "", "", "", window)
 		},
 	}
 }
 
 func metricsQueueCommandWithFilters(name string, fs *flag.FlagSet, queueID *string, granularity *string, groupBy *string, filterResult *string, filterDetail *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueRequestsResponse, error)) *ffcli.Command {
+	window := bindMetricsWindowFlags(fs)
+
 	return &ffcli.Command{
 		Name:       name,
 		ShortUsage: "asc game-center matchmaking metrics " + name + " --queue-id \"QUEUE_ID\" --granularity P1D",
@@ -1570,16 +1588,19 @@ func metricsQueueCommandWithFilters(name string, fs *flag.FlagSet, queueID *stri
 		LongHelp: `Fetch matchmaking queue request metrics.
 
 Examples:
-  asc game-center matchmaking metrics ` + name + ` --queue-id "QUEUE_ID" --granularity P1D --group-by result`,
+  asc game-center matchmaking metrics ` + name + ` --queue-id "QUEUE_ID" --granularity P1D --group-by result
+  asc game-center matchmaking metrics ` + name + ` --queue-id "QUEUE_ID" --since 7d`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return runMetricsQueue(ctx, name, queueID, granularity, sort, limit, next, paginate, output, pretty, nil, fetch, *groupBy, *filterResult, *filterDetail)
+			return runMetricsQueue(ctx, name, queueID, granularity, sort, limit, next, paginate, output, pretty, nil, fetch, *groupBy, *filterResult, *filterDetail, window)
 		},
 	}
 }
 
 func metricsRuleCommand(name string, fs *flag.FlagSet, ruleID *string, granularity *string, groupBy *string, filterResult *string, filterQueue *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingBooleanRuleResultsResponse, error)) *ffcli.Command {
+	window := bindMetricsWindowFlags(fs)
+
 	return &ffcli.Command{
 		Name:       name,
 		ShortUsage: "asc game-center matchmaking metrics " + name + " --rule-id \"RULE_ID\" --granularity P1D",
@@ -1587,16 +1608,17 @@ func metricsRuleCommand(name string, fs *flag.FlagSet, ruleID *string, granulari
 		LongHelp: `Fetch matchmaking rule metrics.
 
 Examples:
-  asc game-center matchmaking metrics ` + name + ` --rule-id "RULE_ID" --granularity P1D --group-by result`,
+  asc game-center matchmaking metrics ` + name + ` --rule-id "RULE_ID" --granularity P1D --group-by result
+  asc game-center matchmaking metrics ` + name + ` --rule-id "RULE_ID" --since 7d`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			return runMetricsRule(ctx, name, ruleID, granularity, groupBy, filterResult, filterQueue, sort, limit, next, paginate, output, pretty, fetch)
+			return runMetricsRule(ctx, name, ruleID, granularity, groupBy, filterResult, filterQueue, sort, limit, next, paginate, output, pretty, fetch, window)
 		},
 	}
 }
 
-func runMetricsQueue(ctx context.Context, name string, queueID *string, granularity *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetchSizes func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSizesResponse, error), fetchRequests func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueRequestsResponse, error), groupBy string, filterResult string, filterDetail string) error {
+func runMetricsQueue(ctx context.Context, name string, queueID *string, granularity *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetchSizes func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSizesResponse, error), fetchRequests func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueRequestsResponse, error), groupBy string, filterResult string, filterDetail string, windowFlags metricsWindowFlags) error {
 	if *limit != 0 && (*limit < 1 || *limit > 200) {
 		return fmt.Errorf("game-center matchmaking metrics %s: --limit must be between 1 and 200", name)
 	}
@@ -1609,13 +1631,17 @@ func runMetricsQueue(ctx context.Context, name string, queueID *string, granular
 		fmt.Fprintln(os.Stderr, "Error: --queue-id is required")
 		return flag.ErrHelp
 	}
-	gran := strings.TrimSpace(*granularity)
+	now := time.Now()
+	window, err := windowFlags.parse(now)
+	if err != nil {
+		return err
+	}
+	gran := resolveMetricsGranularity(*granularity, window, now)
 	if gran == "" && strings.TrimSpace(*next) == "" {
-		fmt.Fprintln(os.Stderr, "Error: --granularity is required")
+		fmt.Fprintln(os.Stderr, "Error: --granularity or --since is required")
 		return flag.ErrHelp
 	}
 
-	var err error
 	requestCtx, cancel := shared.ContextWithTimeout(ctx)
 	defer cancel()
 
@@ -1658,6 +1684,7 @@ func runMetricsQueue(ctx context.Context, name string, queueID *string, granular
 			return fmt.Errorf("game-center matchmaking metrics %s: %w", name, err)
 		}
 
+		window.filter(resp)
 		return shared.PrintOutput(resp, *output, *pretty)
 	}
 
@@ -1671,10 +1698,11 @@ func runMetricsQueue(ctx context.Context, name string, queueID *string, granular
 		return fmt.Errorf("game-center matchmaking metrics %s: failed to fetch: %w", name, err)
 	}
 
+	window.filter(resp)
 	return shared.PrintOutput(resp, *output, *pretty)
 }
 
-func runMetricsRule(ctx context.Context, name string, ruleID *string, granularity *string, groupBy *string, filterResult *string, filterQueue *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingBooleanRuleResultsResponse, error)) error {
+func runMetricsRule(ctx context.Context, name string, ruleID *string, granularity *string, groupBy *string, filterResult *string, filterQueue *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingBooleanRuleResultsResponse, error), windowFlags metricsWindowFlags) error {
 	if *limit != 0 && (*limit < 1 || *limit > 200) {
 		return fmt.Errorf("game-center matchmaking metrics %s: --limit must be between 1 and 200", name)
 	}
@@ -1687,9 +1715,14 @@ func runMetricsRule(ctx context.Context, name string, ruleID *string, granularit
 		fmt.Fprintln(os.Stderr, "Error: --rule-id is required")
 		return flag.ErrHelp
 	}
-	gran := strings.TrimSpace(*granularity)
+	now := time.Now()
+	window, err := windowFlags.parse(now)
+	if err != nil {
+		return err
+	}
+	gran := resolveMetricsGranularity(*granularity, window, now)
 	if gran == "" && strings.TrimSpace(*next) == "" {
-		fmt.Fprintln(os.Stderr, "Error: --granularity is required")
+		fmt.Fprintln(os.Stderr, "Error: --granularity or --since is required")
 		return flag.ErrHelp
 	}
 
@@ -1720,6 +1753,7 @@ func runMetricsRule(ctx context.Context, name string, ruleID *string, granularit
 			return fmt.Errorf("game-center matchmaking metrics %s: %w", name, err)
 		}
 
+		window.filter(resp)
 		return shared.PrintOutput(resp, *output, *pretty)
 	}
 
@@ -1728,6 +1762,7 @@ func runMetricsRule(ctx context.Context, name string, ruleID *string, granularit
 		return fmt.Errorf("game-center matchmaking metrics %s: failed to fetch: %w", name, err)
 	}
 
+	window.filter(resp)
 	return shared.PrintOutput(resp, *output, *pretty)
 }
 
@@ -1737,16 +1772,20 @@ func GameCenterMatchmakingRuleSetTestsCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "rule-set-tests",
-		ShortUsage: "asc game-center matchmaking rule-set-tests create --file payload.json",
-		ShortHelp:  "Run matchmaking rule set tests.",
-		LongHelp: `Run matchmaking rule set tests.
+		ShortUsage: "asc game-center matchmaking rule-set-tests <subcommand> [flags]",
+		ShortHelp:  "Run and lint matchmaking rule set tests.",
+		LongHelp: `Run and lint matchmaking rule set tests.
 
 Examples:
-  asc game-center matchmaking rule-set-tests create --file payload.json`,
+  asc game-center matchmaking rule-set-tests create --file payload.json
+  asc game-center matchmaking rule-set-tests simulate --rule-set-id "RULE_SET_ID" --file requests.yaml
+  asc game-center matchmaking rule-set-tests validate --rule-set-id "RULE_SET_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			GameCenterMatchmakingRuleSetTestsCreateCommand(),
+			GameCenterMatchmakingRuleSetTestsSimulateCommand(),
+			GameCenterMatchmakingRuleSetTestsValidateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,661 @@
+package gamecenter
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Matchmaking rule expressions use JMESPath syntax with arithmetic operators
+// and bare numbers. The linter below parses that grammar without evaluating
+// it, so it can report syntax errors, unknown functions, and the player
+// properties an expression reads.
+
+const (
+	matchmakingLintError   = "error"
+	matchmakingLintWarning = "warning"
+)
+
+type matchmakingRuleLintIssue struct {
+	Severity string `json:"severity"`
+	Column   int    `json:"column,omitempty"`
+	Message  string `json:"message"`
+}
+
+type matchmakingExpressionAnalysis struct {
+	Properties []string
+	Functions  []string
+	Issues     []matchmakingRuleLintIssue
+}
+
+// knownMatchmakingFunctions lists the JMESPath built-in functions.
+var knownMatchmakingFunctions = map[string]bool{
+	"abs": true, "avg": true, "ceil": true, "contains": true, "ends_with": true,
+	"floor": true, "join": true, "keys": true, "length": true, "map": true,
+	"max": true, "max_by": true, "merge": true, "min": true, "min_by": true,
+	"not_null": true, "reverse": true, "sort": true, "sort_by": true,
+	"starts_with": true, "sum": true, "to_array": true, "to_number": true,
+	"to_string": true, "type": true, "values": true,
+}
+
+// lintMatchmakingExpression checks the syntax of a rule expression and
+// collects the properties and functions it references.
+func lintMatchmakingExpression(expression string) matchmakingExpressionAnalysis {
+	var analysis matchmakingExpressionAnalysis
+	if strings.TrimSpace(expression) == "" {
+		analysis.Issues = append(analysis.Issues, matchmakingRuleLintIssue{Severity: matchmakingLintError, Message: "expression is empty"})
+		return analysis
+	}
+
+	tokens, err := lexMatchmakingExpression(expression)
+	if err != nil {
+		analysis.Issues = append(analysis.Issues, err.issue())
+		return analysis
+	}
+	parser := &matchmakingExpressionParser{tokens: tokens, properties: map[string]bool{}}
+	if err := parser.parse(); err != nil {
+		analysis.Issues = append(analysis.Issues, err.issue())
+		return analysis
+	}
+
+	for name := range parser.properties {
+		analysis.Properties = append(analysis.Properties, name)
+	}
+	slices.Sort(analysis.Properties)
+	seen := map[string]bool{}
+	for _, call := range parser.functions {
+		if !seen[call.value] {
+			seen[call.value] = true
+			analysis.Functions = append(analysis.Functions, call.value)
+		}
+		if !knownMatchmakingFunctions[call.value] {
+			analysis.Issues = append(analysis.Issues, matchmakingRuleLintIssue{
+				Severity: matchmakingLintWarning,
+				Column:   call.column,
+				Message:  fmt.Sprintf("unknown function %q", call.value),
+			})
+		}
+	}
+	return analysis
+}
+
+type matchmakingSyntaxError struct {
+	column  int
+	message string
+}
+
+func (e *matchmakingSyntaxError) issue() matchmakingRuleLintIssue {
+	return matchmakingRuleLintIssue{Severity: matchmakingLintError, Column: e.column, Message: e.message}
+}
+
+type matchmakingTokenKind int
+
+const (
+	mmTokEOF matchmakingTokenKind = iota
+	mmTokIdent
+	mmTokQuotedIdent
+	mmTokRawString
+	mmTokLiteral
+	mmTokNumber
+	mmTokDot
+	mmTokStar
+	mmTokFlatten
+	mmTokFilter
+	mmTokLBracket
+	mmTokRBracket
+	mmTokLBrace
+	mmTokRBrace
+	mmTokLParen
+	mmTokRParen
+	mmTokComma
+	mmTokColon
+	mmTokAt
+	mmTokAmp
+	mmTokPipe
+	mmTokOr
+	mmTokAnd
+	mmTokNot
+	mmTokCompare
+	mmTokPlus
+	mmTokMinus
+	mmTokDivide
+	mmTokMod
+)
+
+type matchmakingToken struct {
+	kind   matchmakingTokenKind
+	value  string
+	column int
+}
+
+func (t matchmakingToken) describe() string {
+	switch t.kind {
+	case mmTokEOF:
+		return "end of expression"
+	case mmTokIdent, mmTokQuotedIdent:
+		return fmt.Sprintf("identifier %q", t.value)
+	case mmTokNumber, mmTokRawString, mmTokLiteral:
+		return fmt.Sprintf("value %s", t.value)
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+// matchmakingBindingPower follows the JMESPath precedence table, with the
+// arithmetic operators between comparisons and flatten.
+func matchmakingBindingPower(kind matchmakingTokenKind) int {
+	switch kind {
+	case mmTokPipe:
+		return 1
+	case mmTokOr:
+		return 2
+	case mmTokAnd:
+		return 3
+	case mmTokCompare:
+		return 5
+	case mmTokPlus, mmTokMinus:
+		return 6
+	case mmTokStar, mmTokDivide, mmTokMod:
+		return 7
+	case mmTokFlatten:
+		return 9
+	case mmTokFilter:
+		return 21
+	case mmTokDot:
+		return 40
+	case mmTokLBracket:
+		return 55
+	case mmTokLParen:
+		return 60
+	default:
+		return 0
+	}
+}
+
+const (
+	// matchmakingWildcardPower binds "*" and "[*]" projections; in infix
+	// position "*" is multiplication instead.
+	matchmakingWildcardPower  = 20
+	matchmakingProjectionStop = 10
+)
+
+func lexMatchmakingExpression(expression string) ([]matchmakingToken, *matchmakingSyntaxError) {
+	var tokens []matchmakingToken
+	runes := []rune(expression)
+	emit := func(kind matchmakingTokenKind, start, end int) {
+		tokens = append(tokens, matchmakingToken{kind: kind, value: string(runes[start:end]), column: start + 1})
+	}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			i++
+		case r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			start := i
+			for i < len(runes) && (runes[i] == '_' || (runes[i] >= 'a' && runes[i] <= 'z') || (runes[i] >= 'A' && runes[i] <= 'Z') || (runes[i] >= '0' && runes[i] <= '9')) {
+				i++
+			}
+			emit(mmTokIdent, start, i)
+		case r >= '0' && r <= '9':
+			start := i
+			for i < len(runes) && runes[i] >= '0' && runes[i] <= '9' {
+				i++
+			}
+			if i+1 < len(runes) && runes[i] == '.' && runes[i+1] >= '0' && runes[i+1] <= '9' {
+				i++
+				for i < len(runes) && runes[i] >= '0' && runes[i] <= '9' {
+					i++
+				}
+			}
+			emit(mmTokNumber, start, i)
+		case r == '"' || r == '\'' || r == '`':
+			start := i
+			end, ok := scanMatchmakingQuoted(runes, i)
+			if !ok {
+				return nil, &matchmakingSyntaxError{column: start + 1, message: fmt.Sprintf("unterminated %c", r)}
+			}
+			i = end
+			body := string(runes[start+1 : end-1])
+			switch r {
+			case '"':
+				var name string
+				if err := json.Unmarshal([]byte(string(runes[start:end])), &name); err != nil {
+					return nil, &matchmakingSyntaxError{column: start + 1, message: "invalid quoted identifier"}
+				}
+				tokens = append(tokens, matchmakingToken{kind: mmTokQuotedIdent, value: name, column: start + 1})
+			case '\'':
+				emit(mmTokRawString, start, end)
+			default:
+				if !json.Valid([]byte(strings.ReplaceAll(body, "\\`", "`"))) {
+					return nil, &matchmakingSyntaxError{column: start + 1, message: fmt.Sprintf("invalid JSON literal %s", string(runes[start:end]))}
+				}
+				emit(mmTokLiteral, start, end)
+			}
+		default:
+			kind, width, err := lexMatchmakingOperator(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			emit(kind, i, i+width)
+			i += width
+		}
+	}
+	tokens = append(tokens, matchmakingToken{kind: mmTokEOF, column: utf8.RuneCountInString(expression) + 1})
+	return tokens, nil
+}
+
+// scanMatchmakingQuoted returns the index just past the closing quote that
+// matches runes[start], honoring backslash escapes.
+func scanMatchmakingQuoted(runes []rune, start int) (int, bool) {
+	quote := runes[start]
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+func lexMatchmakingOperator(runes []rune, i int) (matchmakingTokenKind, int, *matchmakingSyntaxError) {
+	next := rune(0)
+	if i+1 < len(runes) {
+		next = runes[i+1]
+	}
+	switch runes[i] {
+	case '.':
+		return mmTokDot, 1, nil
+	case '*':
+		return mmTokStar, 1, nil
+	case '[':
+		switch next {
+		case ']':
+			return mmTokFlatten, 2, nil
+		case '?':
+			return mmTokFilter, 2, nil
+		}
+		return mmTokLBracket, 1, nil
+	case ']':
+		return mmTokRBracket, 1, nil
+	case '{':
+		return mmTokLBrace, 1, nil
+	case '}':
+		return mmTokRBrace, 1, nil
+	case '(':
+		return mmTokLParen, 1, nil
+	case ')':
+		return mmTokRParen, 1, nil
+	case ',':
+		return mmTokComma, 1, nil
+	case ':':
+		return mmTokColon, 1, nil
+	case '@':
+		return mmTokAt, 1, nil
+	case '+':
+		return mmTokPlus, 1, nil
+	case '-':
+		return mmTokMinus, 1, nil
+	case '%':
+		return mmTokMod, 1, nil
+	case '/':
+		if next == '/' {
+			return mmTokDivide, 2, nil
+		}
+		return mmTokDivide, 1, nil
+	case '&':
+		if next == '&' {
+			return mmTokAnd, 2, nil
+		}
+		return mmTokAmp, 1, nil
+	case '|':
+		if next == '|' {
+			return mmTokOr, 2, nil
+		}
+		return mmTokPipe, 1, nil
+	case '!':
+		if next == '=' {
+			return mmTokCompare, 2, nil
+		}
+		return mmTokNot, 1, nil
+	case '<', '>':
+		if next == '=' {
+			return mmTokCompare, 2, nil
+		}
+		return mmTokCompare, 1, nil
+	case '=':
+		if next == '=' {
+			return mmTokCompare, 2, nil
+		}
+		return 0, 0, &matchmakingSyntaxError{column: i + 1, message: `unexpected "="; use "==" to compare`}
+	}
+	return 0, 0, &matchmakingSyntaxError{column: i + 1, message: fmt.Sprintf("unexpected character %q", runes[i])}
+}
+
+// matchmakingNode records the last field an expression selects, so a
+// following ".name" can tell whether it reads from "properties".
+type matchmakingNode struct {
+	field string
+}
+
+type matchmakingExpressionParser struct {
+	tokens     []matchmakingToken
+	pos        int
+	properties map[string]bool
+	functions  []matchmakingToken
+}
+
+func (p *matchmakingExpressionParser) parse() *matchmakingSyntaxError {
+	if _, err := p.expression(0); err != nil {
+		return err
+	}
+	if tok := p.peek(); tok.kind != mmTokEOF {
+		return p.unexpected(tok)
+	}
+	return nil
+}
+
+func (p *matchmakingExpressionParser) peek() matchmakingToken {
+	return p.tokens[p.pos]
+}
+
+func (p *matchmakingExpressionParser) peekAt(offset int) matchmakingToken {
+	if p.pos+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+offset]
+}
+
+func (p *matchmakingExpressionParser) next() matchmakingToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != mmTokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *matchmakingExpressionParser) expect(kind matchmakingTokenKind, want string) *matchmakingSyntaxError {
+	tok := p.next()
+	if tok.kind != kind {
+		return &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf("expected %s, found %s", want, tok.describe())}
+	}
+	return nil
+}
+
+func (p *matchmakingExpressionParser) unexpected(tok matchmakingToken) *matchmakingSyntaxError {
+	return &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf("unexpected %s", tok.describe())}
+}
+
+func (p *matchmakingExpressionParser) expression(rbp int) (*matchmakingNode, *matchmakingSyntaxError) {
+	left, err := p.nud()
+	if err != nil {
+		return nil, err
+	}
+	return p.continueExpression(left, rbp)
+}
+
+func (p *matchmakingExpressionParser) continueExpression(left *matchmakingNode, rbp int) (*matchmakingNode, *matchmakingSyntaxError) {
+	for rbp < matchmakingBindingPower(p.peek().kind) {
+		var err *matchmakingSyntaxError
+		left, err = p.led(left)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return left, nil
+}
+
+func (p *matchmakingExpressionParser) nud() (*matchmakingNode, *matchmakingSyntaxError) {
+	tok := p.next()
+	switch tok.kind {
+	case mmTokNumber, mmTokRawString, mmTokLiteral:
+		return &matchmakingNode{}, nil
+	case mmTokIdent:
+		if p.peek().kind == mmTokLParen {
+			return p.functionCall(tok)
+		}
+		return &matchmakingNode{field: tok.value}, nil
+	case mmTokQuotedIdent:
+		if p.peek().kind == mmTokLParen {
+			return nil, &matchmakingSyntaxError{column: tok.column, message: "quoted identifiers cannot be called as functions"}
+		}
+		return &matchmakingNode{field: tok.value}, nil
+	case mmTokAt:
+		return &matchmakingNode{}, nil
+	case mmTokStar:
+		return p.projection(matchmakingWildcardPower)
+	case mmTokFlatten:
+		return p.projection(matchmakingBindingPower(mmTokFlatten))
+	case mmTokFilter:
+		return p.filter()
+	case mmTokLBracket:
+		return p.bracket()
+	case mmTokLBrace:
+		return p.multiSelectHash()
+	case mmTokNot, mmTokMinus:
+		if _, err := p.expression(45); err != nil {
+			return nil, err
+		}
+		return &matchmakingNode{}, nil
+	case mmTokAmp:
+		if _, err := p.expression(0); err != nil {
+			return nil, err
+		}
+		return &matchmakingNode{}, nil
+	case mmTokLParen:
+		inner, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(mmTokRParen, `")"`); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	}
+	return nil, p.unexpected(tok)
+}
+
+func (p *matchmakingExpressionParser) led(left *matchmakingNode) (*matchmakingNode, *matchmakingSyntaxError) {
+	tok := p.next()
+	switch tok.kind {
+	case mmTokDot:
+		return p.dotRHS(left, matchmakingBindingPower(mmTokDot))
+	case mmTokFlatten:
+		return p.projection(matchmakingBindingPower(mmTokFlatten))
+	case mmTokFilter:
+		return p.filter()
+	case mmTokLBracket:
+		if p.peek().kind == mmTokStar && p.peekAt(1).kind == mmTokRBracket {
+			p.next()
+			p.next()
+			return p.projection(matchmakingWildcardPower)
+		}
+		isSlice, err := p.indexOrSlice()
+		if err != nil {
+			return nil, err
+		}
+		if isSlice {
+			return p.projection(matchmakingWildcardPower)
+		}
+		return left, nil
+	case mmTokPipe, mmTokOr, mmTokAnd, mmTokCompare, mmTokPlus, mmTokMinus, mmTokStar, mmTokDivide, mmTokMod:
+		if _, err := p.expression(matchmakingBindingPower(tok.kind)); err != nil {
+			return nil, err
+		}
+		return &matchmakingNode{}, nil
+	case mmTokLParen:
+		return nil, &matchmakingSyntaxError{column: tok.column, message: "only plain identifiers can be called as functions"}
+	}
+	return nil, p.unexpected(tok)
+}
+
+// dotRHS parses what follows a ".", recording the name when left selects
+// "properties".
+func (p *matchmakingExpressionParser) dotRHS(left *matchmakingNode, rbp int) (*matchmakingNode, *matchmakingSyntaxError) {
+	tok := p.peek()
+	switch tok.kind {
+	case mmTokIdent, mmTokQuotedIdent:
+		p.next()
+		if left.field == "properties" {
+			p.properties[tok.value] = true
+		}
+		return p.continueExpression(&matchmakingNode{field: tok.value}, rbp)
+	case mmTokStar:
+		p.next()
+		return p.projection(matchmakingWildcardPower)
+	case mmTokLBracket:
+		p.next()
+		return p.multiSelectList()
+	case mmTokLBrace:
+		p.next()
+		return p.multiSelectHash()
+	}
+	return nil, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf(`expected identifier after ".", found %s`, tok.describe())}
+}
+
+// projection parses the expression applied to each element of a projection.
+func (p *matchmakingExpressionParser) projection(rbp int) (*matchmakingNode, *matchmakingSyntaxError) {
+	tok := p.peek()
+	switch {
+	case matchmakingBindingPower(tok.kind) < matchmakingProjectionStop:
+	case tok.kind == mmTokLBracket || tok.kind == mmTokFilter:
+		if _, err := p.expression(rbp); err != nil {
+			return nil, err
+		}
+	case tok.kind == mmTokDot:
+		p.next()
+		if _, err := p.dotRHS(&matchmakingNode{}, rbp); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, p.unexpected(tok)
+	}
+	return &matchmakingNode{}, nil
+}
+
+func (p *matchmakingExpressionParser) filter() (*matchmakingNode, *matchmakingSyntaxError) {
+	if _, err := p.expression(0); err != nil {
+		return nil, err
+	}
+	if err := p.expect(mmTokRBracket, `"]"`); err != nil {
+		return nil, err
+	}
+	return p.projection(matchmakingBindingPower(mmTokFilter))
+}
+
+// bracket parses a "[" in prefix position: an index or slice of the current
+// value, a [*] projection, or a multi-select list.
+func (p *matchmakingExpressionParser) bracket() (*matchmakingNode, *matchmakingSyntaxError) {
+	switch tok := p.peek(); {
+	case tok.kind == mmTokNumber || tok.kind == mmTokMinus || tok.kind == mmTokColon:
+		isSlice, err := p.indexOrSlice()
+		if err != nil {
+			return nil, err
+		}
+		if isSlice {
+			return p.projection(matchmakingWildcardPower)
+		}
+		return &matchmakingNode{}, nil
+	case tok.kind == mmTokStar && p.peekAt(1).kind == mmTokRBracket:
+		p.next()
+		p.next()
+		return p.projection(matchmakingWildcardPower)
+	}
+	return p.multiSelectList()
+}
+
+// indexOrSlice parses "N]" or "start:stop:step]" after a "[".
+func (p *matchmakingExpressionParser) indexOrSlice() (bool, *matchmakingSyntaxError) {
+	parts := 0
+	for {
+		if p.peek().kind == mmTokMinus {
+			p.next()
+			if tok := p.peek(); tok.kind != mmTokNumber {
+				return false, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf("expected number after \"-\", found %s", tok.describe())}
+			}
+		}
+		if tok := p.peek(); tok.kind == mmTokNumber {
+			if strings.Contains(tok.value, ".") {
+				return false, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf("index %s must be an integer", tok.value)}
+			}
+			p.next()
+		}
+		tok := p.next()
+		switch tok.kind {
+		case mmTokRBracket:
+			return parts > 0, nil
+		case mmTokColon:
+			parts++
+			if parts > 2 {
+				return false, &matchmakingSyntaxError{column: tok.column, message: "slice takes at most three parts"}
+			}
+		default:
+			return false, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf(`expected "]", found %s`, tok.describe())}
+		}
+	}
+}
+
+func (p *matchmakingExpressionParser) multiSelectList() (*matchmakingNode, *matchmakingSyntaxError) {
+	for {
+		if _, err := p.expression(0); err != nil {
+			return nil, err
+		}
+		tok := p.next()
+		switch tok.kind {
+		case mmTokRBracket:
+			return &matchmakingNode{}, nil
+		case mmTokComma:
+		default:
+			return nil, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf(`expected "," or "]", found %s`, tok.describe())}
+		}
+	}
+}
+
+func (p *matchmakingExpressionParser) multiSelectHash() (*matchmakingNode, *matchmakingSyntaxError) {
+	for {
+		key := p.next()
+		if key.kind != mmTokIdent && key.kind != mmTokQuotedIdent {
+			return nil, &matchmakingSyntaxError{column: key.column, message: fmt.Sprintf("expected key, found %s", key.describe())}
+		}
+		if err := p.expect(mmTokColon, `":"`); err != nil {
+			return nil, err
+		}
+		if _, err := p.expression(0); err != nil {
+			return nil, err
+		}
+		tok := p.next()
+		switch tok.kind {
+		case mmTokRBrace:
+			return &matchmakingNode{}, nil
+		case mmTokComma:
+		default:
+			return nil, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf(`expected "," or "}", found %s`, tok.describe())}
+		}
+	}
+}
+
+func (p *matchmakingExpressionParser) functionCall(name matchmakingToken) (*matchmakingNode, *matchmakingSyntaxError) {
+	p.functions = append(p.functions, name)
+	p.next() // "("
+	if p.peek().kind == mmTokRParen {
+		p.next()
+		return &matchmakingNode{}, nil
+	}
+	for {
+		if _, err := p.expression(0); err != nil {
+			return nil, err
+		}
+		tok := p.next()
+		switch tok.kind {
+		case mmTokRParen:
+			return &matchmakingNode{}, nil
+		case mmTokComma:
+		default:
+			return nil, &matchmakingSyntaxError{column: tok.column, message: fmt.Sprintf(`expected "," or ")", found %s`, tok.describe())}
+		}
+	}
+}
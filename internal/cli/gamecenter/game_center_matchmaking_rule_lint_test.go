@@ -0,0 +1,83 @@
+package gamecenter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLintMatchmakingExpressionAcceptsValidExpressions(t *testing.T) {
+	tests := []struct {
+		expression string
+		properties []string
+	}{
+		{expression: "player.level > 1"},
+		{expression: "requests[0].locale == requests[1].locale"},
+		{expression: "abs(requests[0].properties.skill - requests[1].properties.skill) <= 5", properties: []string{"skill"}},
+		{expression: "avg(requests[].properties.skill) > `10`", properties: []string{"skill"}},
+		{expression: "length(requests[?properties.mode == 'ranked']) >= 2", properties: []string{"mode"}},
+		{expression: "max(requests[*].secondsInQueue) * 2 + 1 > 30 && !contains(requests[].locale, 'EN-US')"},
+		{expression: "requests[].players[].properties.\"team color\" | sort(@)", properties: []string{"team color"}},
+		{expression: "requests[0:2].{skill: properties.skill, queue: secondsInQueue}", properties: []string{"skill"}},
+		{expression: "sort_by(requests, &secondsInQueue)[-1].properties.region", properties: []string{"region"}},
+		{expression: "(requests[0].playerCount + requests[1].playerCount) % 2 == 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			analysis := lintMatchmakingExpression(test.expression)
+			if len(analysis.Issues) != 0 {
+				t.Fatalf("expected no issues, got %+v", analysis.Issues)
+			}
+			if !slices.Equal(analysis.Properties, test.properties) {
+				t.Fatalf("properties = %v, want %v", analysis.Properties, test.properties)
+			}
+		})
+	}
+}
+
+func TestLintMatchmakingExpressionReportsSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		column     int
+		message    string
+	}{
+		{expression: "   ", message: "expression is empty"},
+		{expression: "requests[0].level = 1", column: 19, message: `use "=="`},
+		{expression: "requests[0.5]", column: 10, message: "must be an integer"},
+		{expression: "avg(requests[].skill", column: 21, message: `expected "," or ")"`},
+		{expression: "requests.", column: 10, message: `expected identifier after "."`},
+		{expression: "requests[0] >", column: 14, message: "unexpected end of expression"},
+		{expression: "'ranked", column: 1, message: "unterminated '"},
+		{expression: "`{bad}`", column: 1, message: "invalid JSON literal"},
+		{expression: "level > 1 1", column: 11, message: "unexpected value 1"},
+		{expression: "requests#", column: 9, message: "unexpected character"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			analysis := lintMatchmakingExpression(test.expression)
+			if len(analysis.Issues) != 1 {
+				t.Fatalf("expected one issue, got %+v", analysis.Issues)
+			}
+			issue := analysis.Issues[0]
+			if issue.Severity != matchmakingLintError || issue.Column != test.column || !strings.Contains(issue.Message, test.message) {
+				t.Fatalf("issue = %+v, want error at column %d containing %q", issue, test.column, test.message)
+			}
+		})
+	}
+}
+
+func TestLintMatchmakingExpressionWarnsOnUnknownFunctions(t *testing.T) {
+	analysis := lintMatchmakingExpression("median(requests[].properties.skill) > 3")
+	if len(analysis.Issues) != 1 {
+		t.Fatalf("expected one issue, got %+v", analysis.Issues)
+	}
+	issue := analysis.Issues[0]
+	if issue.Severity != matchmakingLintWarning || issue.Column != 1 || !strings.Contains(issue.Message, `"median"`) {
+		t.Fatalf("unexpected issue: %+v", issue)
+	}
+	if !slices.Equal(analysis.Functions, []string{"median"}) || !slices.Equal(analysis.Properties, []string{"skill"}) {
+		t.Fatalf("unexpected analysis: %+v", analysis)
+	}
+}
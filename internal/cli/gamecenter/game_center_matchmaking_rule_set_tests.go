@@ -0,0 +1,554 @@
+package gamecenter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var matchmakingRuleTypes = []string{"COMPATIBLE", "DISTANCE", "MATCH", "TEAM"}
+
+// matchmakingTestLocales lists the locales gameCenterMatchmakingTestRequests accept.
+var matchmakingTestLocales = []string{
+	"AR-SA", "CA-ES", "CS-CZ", "DA-DK", "DE-DE", "EL-GR", "EN-AU", "EN-GB", "EN-US", "EN-KY",
+	"ES-ES", "ES-MX", "FI-FI", "FR-CA", "FR-FR", "HI-IN", "HR-HR", "HU-HU", "ID-ID", "IT-IT",
+	"IW-IL", "JA-JP", "KO-KR", "MS-MY", "NL-NL", "NO-NO", "PL-PL", "PT-BR", "PT-PT", "RO-RO",
+	"RU-RU", "SK-SK", "SV-SE", "TH-TH", "TR-TR", "UK-UA", "ZH-CN", "ZH-TW", "ZH-HK",
+}
+
+type matchmakingRuleLintResult struct {
+	ID            string                     `json:"id,omitempty"`
+	ReferenceName string                     `json:"referenceName,omitempty"`
+	Type          string                     `json:"type,omitempty"`
+	Expression    string                     `json:"expression"`
+	Properties    []string                   `json:"properties,omitempty"`
+	Functions     []string                   `json:"functions,omitempty"`
+	Issues        []matchmakingRuleLintIssue `json:"issues"`
+}
+
+type matchmakingRuleLintReport struct {
+	RuleSetID string                      `json:"ruleSetId,omitempty"`
+	Requests  string                      `json:"requests,omitempty"`
+	Rules     []matchmakingRuleLintResult `json:"rules"`
+	Errors    int                         `json:"errors"`
+	Warnings  int                         `json:"warnings"`
+}
+
+// GameCenterMatchmakingRuleSetTestsValidateCommand returns the rule set tests validate subcommand.
+func GameCenterMatchmakingRuleSetTestsValidateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+
+	ruleSetID := fs.String("rule-set-id", "", "Lint every rule in this matchmaking rule set")
+	expression := fs.String("expression", "", "Lint a single rule expression")
+	ruleType := fs.String("type", "", "Rule type for --expression: "+strings.Join(matchmakingRuleTypes, ", "))
+	requestsFile := fs.String("requests", "", "Sample requests YAML (as used by simulate); warn about properties no sample player sets")
+	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "asc game-center matchmaking rule-set-tests validate (--rule-set-id ID | --expression EXPR) [flags]",
+		ShortHelp:  "Lint matchmaking rule expressions locally.",
+		LongHelp: `Lint matchmaking rule expressions locally.
+
+Expressions are parsed with the JMESPath grammar that matchmaking rules use,
+including arithmetic operators and bare numbers. Syntax errors are reported
+with their column; calls to functions outside the JMESPath built-ins are
+warnings. Each rule lists the player properties it reads (the names after
+"properties."). With --requests, properties that no sample request sets are
+reported as warnings.
+
+Nothing is sent to App Store Connect except, with --rule-set-id, the request
+that fetches the rules.
+
+Examples:
+  asc game-center matchmaking rule-set-tests validate --rule-set-id "RULE_SET_ID"
+  asc game-center matchmaking rule-set-tests validate --rule-set-id "RULE_SET_ID" --requests requests.yaml --strict
+  asc game-center matchmaking rule-set-tests validate --expression "avg(requests[].properties.skill) > 10" --type MATCH`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*ruleSetID)
+			expr := strings.TrimSpace(*expression)
+			if id == "" && expr == "" {
+				fmt.Fprintln(os.Stderr, "Error: --rule-set-id or --expression is required")
+				return flag.ErrHelp
+			}
+			if id != "" && expr != "" {
+				return shared.UsageError("--rule-set-id and --expression are mutually exclusive")
+			}
+			normalizedType := strings.ToUpper(strings.TrimSpace(*ruleType))
+			if normalizedType != "" {
+				if id != "" {
+					return shared.UsageError("--type applies to --expression only")
+				}
+				if !slices.Contains(matchmakingRuleTypes, normalizedType) {
+					return shared.UsageErrorf("--type must be one of: %s", strings.Join(matchmakingRuleTypes, ", "))
+				}
+			}
+
+			var sampleProperties map[string]bool
+			requestsPath := strings.TrimSpace(*requestsFile)
+			if requestsPath != "" {
+				samples, err := loadMatchmakingSimulation(requestsPath)
+				if err != nil {
+					return shared.UsageErrorf("--requests: %v", err)
+				}
+				sampleProperties = samples.propertyNames()
+			}
+
+			report := &matchmakingRuleLintReport{RuleSetID: id, Requests: requestsPath}
+			if expr != "" {
+				report.Rules = append(report.Rules, matchmakingRuleLintResult{Type: normalizedType, Expression: expr})
+			} else {
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("game-center matchmaking rule-set-tests validate: %w", err)
+				}
+
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				rules, err := fetchAllGameCenterPages(requestCtx, func(ctx context.Context, nextURL string) (*asc.GameCenterMatchmakingRulesResponse, error) {
+					return client.GetGameCenterMatchmakingRules(ctx, id, asc.WithGCMatchmakingRulesLimit(200), asc.WithGCMatchmakingRulesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("game-center matchmaking rule-set-tests validate: failed to fetch rules: %w", err)
+				}
+				for _, rule := range rules {
+					report.Rules = append(report.Rules, matchmakingRuleLintResult{
+						ID:            rule.ID,
+						ReferenceName: rule.Attributes.ReferenceName,
+						Type:          rule.Attributes.Type,
+						Expression:    rule.Attributes.Expression,
+					})
+				}
+			}
+
+			for i := range report.Rules {
+				lintMatchmakingRule(&report.Rules[i], sampleProperties)
+				for _, issue := range report.Rules[i].Issues {
+					if issue.Severity == matchmakingLintError {
+						report.Errors++
+					} else {
+						report.Warnings++
+					}
+				}
+			}
+
+			if err := printMatchmakingRuleLintReport(report, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if report.Errors > 0 || (*strict && report.Warnings > 0) {
+				return shared.NewReportedError(fmt.Errorf("game-center matchmaking rule-set-tests validate: found %d error(s) and %d warning(s)", report.Errors, report.Warnings))
+			}
+			return nil
+		},
+	}
+}
+
+// lintMatchmakingRule fills in the analysis of one rule. sampleProperties is
+// nil when no sample requests were given.
+func lintMatchmakingRule(rule *matchmakingRuleLintResult, sampleProperties map[string]bool) {
+	analysis := lintMatchmakingExpression(rule.Expression)
+	rule.Properties = analysis.Properties
+	rule.Functions = analysis.Functions
+	rule.Issues = append([]matchmakingRuleLintIssue{}, analysis.Issues...)
+
+	if rule.Type != "" && !slices.Contains(matchmakingRuleTypes, rule.Type) {
+		rule.Issues = append(rule.Issues, matchmakingRuleLintIssue{
+			Severity: matchmakingLintWarning,
+			Message:  fmt.Sprintf("unknown rule type %q", rule.Type),
+		})
+	}
+	if sampleProperties == nil {
+		return
+	}
+	for _, name := range rule.Properties {
+		if !sampleProperties[name] {
+			rule.Issues = append(rule.Issues, matchmakingRuleLintIssue{
+				Severity: matchmakingLintWarning,
+				Message:  fmt.Sprintf("property %q is not set by any sample request", name),
+			})
+		}
+	}
+}
+
+func printMatchmakingRuleLintReport(report *matchmakingRuleLintReport, format string, pretty bool) error {
+	headers := []string{"Rule", "Type", "Properties", "Severity", "Column", "Message"}
+	rows := make([][]string, 0, len(report.Rules))
+	for _, rule := range report.Rules {
+		name := rule.ReferenceName
+		if name == "" {
+			name = rule.ID
+		}
+		if name == "" {
+			name = rule.Expression
+		}
+		properties := strings.Join(rule.Properties, ", ")
+		if len(rule.Issues) == 0 {
+			rows = append(rows, []string{name, rule.Type, properties, "ok", "", ""})
+			continue
+		}
+		for _, issue := range rule.Issues {
+			column := ""
+			if issue.Column > 0 {
+				column = fmt.Sprintf("%d", issue.Column)
+			}
+			rows = append(rows, []string{name, rule.Type, properties, issue.Severity, column, issue.Message})
+		}
+	}
+
+	return shared.PrintOutputWithRenderers(
+		report,
+		format,
+		pretty,
+		func() error { asc.RenderTable(headers, rows); return nil },
+		func() error { asc.RenderMarkdown(headers, rows); return nil },
+	)
+}
+
+// GameCenterMatchmakingRuleSetTestsSimulateCommand returns the rule set tests simulate subcommand.
+func GameCenterMatchmakingRuleSetTestsSimulateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+
+	ruleSetID := fs.String("rule-set-id", "", "Matchmaking rule set ID to test")
+	filePath := fs.String("file", "", "Path to a YAML file of sample match requests")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "simulate",
+		ShortUsage: "asc game-center matchmaking rule-set-tests simulate --rule-set-id ID --file requests.yaml [flags]",
+		ShortHelp:  "Run a rule set test built from sample match requests.",
+		LongHelp: `Run a rule set test built from sample match requests.
+
+The file lists match requests; simulate builds the rule set test payload
+(requests, player properties, and local IDs) and runs it. bundleId,
+appVersion, and platform at the top level apply to every request unless a
+request sets its own. A request's "properties" is shorthand for a single
+player named after the request; use "players" for requests with several.
+
+  bundleId: com.example.game
+  appVersion: "1.0"
+  platform: IOS
+  requests:
+    - name: alice
+      secondsInQueue: 5
+      locale: EN-US
+      playerCount: 1
+      properties:
+        skill: 10
+    - name: party
+      secondsInQueue: 20
+      location: {latitude: 37.33, longitude: -122.01}
+      players:
+        - id: bob
+          properties: {skill: 12}
+        - id: carol
+          properties: {skill: 9}
+
+Use --dry-run to print the generated payload without running the test.
+
+Examples:
+  asc game-center matchmaking rule-set-tests simulate --rule-set-id "RULE_SET_ID" --file requests.yaml
+  asc game-center matchmaking rule-set-tests simulate --rule-set-id "RULE_SET_ID" --file requests.yaml --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*ruleSetID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --rule-set-id is required")
+				return flag.ErrHelp
+			}
+			path := strings.TrimSpace(*filePath)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			simulation, err := loadMatchmakingSimulation(path)
+			if err != nil {
+				return shared.UsageErrorf("--file: %v", err)
+			}
+			payload, err := simulation.payload(id)
+			if err != nil {
+				return fmt.Errorf("game-center matchmaking rule-set-tests simulate: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center matchmaking rule-set-tests simulate: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateGameCenterMatchmakingRuleSetTest(requestCtx, payload)
+			if err != nil {
+				return fmt.Errorf("game-center matchmaking rule-set-tests simulate: failed to create: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+type matchmakingSimulation struct {
+	BundleID   string                         `yaml:"bundleId"`
+	AppVersion string                         `yaml:"appVersion"`
+	Platform   string                         `yaml:"platform"`
+	Requests   []matchmakingSimulationRequest `yaml:"requests"`
+}
+
+type matchmakingSimulationRequest struct {
+	Name           string                        `yaml:"name"`
+	SecondsInQueue int                           `yaml:"secondsInQueue"`
+	Locale         string                        `yaml:"locale"`
+	Location       *matchmakingSimulationPoint   `yaml:"location"`
+	MinPlayers     *int                          `yaml:"minPlayers"`
+	MaxPlayers     *int                          `yaml:"maxPlayers"`
+	PlayerCount    *int                          `yaml:"playerCount"`
+	BundleID       string                        `yaml:"bundleId"`
+	AppVersion     string                        `yaml:"appVersion"`
+	Platform       string                        `yaml:"platform"`
+	Properties     map[string]string             `yaml:"properties"`
+	Players        []matchmakingSimulationPlayer `yaml:"players"`
+}
+
+type matchmakingSimulationPoint struct {
+	Latitude  float64 `yaml:"latitude" json:"latitude"`
+	Longitude float64 `yaml:"longitude" json:"longitude"`
+}
+
+type matchmakingSimulationPlayer struct {
+	ID         string            `yaml:"id"`
+	Properties map[string]string `yaml:"properties"`
+}
+
+func loadMatchmakingSimulation(path string) (*matchmakingSimulation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var simulation matchmakingSimulation
+	if err := decoder.Decode(&simulation); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s: file is empty", path)
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := simulation.normalize(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &simulation, nil
+}
+
+// normalize applies top-level defaults, expands the properties shorthand into
+// a player, and validates every request.
+func (s *matchmakingSimulation) normalize() error {
+	if len(s.Requests) == 0 {
+		return fmt.Errorf("requests is required")
+	}
+
+	requestNames := map[string]bool{}
+	playerIDs := map[string]bool{}
+	for i := range s.Requests {
+		request := &s.Requests[i]
+		prefix := fmt.Sprintf("requests[%d]", i)
+
+		request.Name = strings.TrimSpace(request.Name)
+		if request.Name == "" {
+			return fmt.Errorf("%s: name is required", prefix)
+		}
+		if requestNames[request.Name] {
+			return fmt.Errorf("%s: duplicate name %q", prefix, request.Name)
+		}
+		requestNames[request.Name] = true
+		if request.SecondsInQueue < 0 {
+			return fmt.Errorf("%s: secondsInQueue must be 0 or greater", prefix)
+		}
+
+		request.BundleID = firstNonEmpty(request.BundleID, s.BundleID)
+		request.AppVersion = firstNonEmpty(request.AppVersion, s.AppVersion)
+		if request.BundleID == "" {
+			return fmt.Errorf("%s: bundleId is required (set it on the request or at the top level)", prefix)
+		}
+		if request.AppVersion == "" {
+			return fmt.Errorf("%s: appVersion is required (set it on the request or at the top level)", prefix)
+		}
+		platform, err := shared.NormalizePlatform(firstNonEmpty(request.Platform, s.Platform))
+		if err != nil {
+			return fmt.Errorf("%s: platform must be one of: %s", prefix, strings.Join(shared.PlatformList(), ", "))
+		}
+		request.Platform = string(platform)
+
+		if request.Locale != "" {
+			locale := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(request.Locale), "_", "-"))
+			if !slices.Contains(matchmakingTestLocales, locale) {
+				return fmt.Errorf("%s: unsupported locale %q", prefix, request.Locale)
+			}
+			request.Locale = locale
+		}
+		if request.MinPlayers != nil && request.MaxPlayers != nil && *request.MinPlayers > *request.MaxPlayers {
+			return fmt.Errorf("%s: minPlayers must not exceed maxPlayers", prefix)
+		}
+
+		if len(request.Properties) > 0 {
+			if len(request.Players) > 0 {
+				return fmt.Errorf("%s: set either properties or players, not both", prefix)
+			}
+			request.Players = []matchmakingSimulationPlayer{{ID: request.Name, Properties: request.Properties}}
+			request.Properties = nil
+		}
+		for j := range request.Players {
+			player := &request.Players[j]
+			player.ID = strings.TrimSpace(player.ID)
+			if player.ID == "" {
+				return fmt.Errorf("%s.players[%d]: id is required", prefix, j)
+			}
+			if playerIDs[player.ID] {
+				return fmt.Errorf("%s.players[%d]: duplicate player id %q", prefix, j, player.ID)
+			}
+			playerIDs[player.ID] = true
+		}
+	}
+	return nil
+}
+
+func (s *matchmakingSimulation) propertyNames() map[string]bool {
+	names := map[string]bool{}
+	for _, request := range s.Requests {
+		for _, player := range request.Players {
+			for key := range player.Properties {
+				names[key] = true
+			}
+		}
+	}
+	return names
+}
+
+type matchmakingLinkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type matchmakingInlineResource struct {
+	Type          string `json:"type"`
+	ID            string `json:"id"`
+	Attributes    any    `json:"attributes"`
+	Relationships any    `json:"relationships,omitempty"`
+}
+
+type matchmakingTestProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// payload builds a gameCenterMatchmakingRuleSetTests create request with the
+// requests and player properties included inline under local IDs.
+func (s *matchmakingSimulation) payload(ruleSetID string) (json.RawMessage, error) {
+	var requestLinks []matchmakingLinkage
+	var included []matchmakingInlineResource
+	playerIndex := 0
+	for i, request := range s.Requests {
+		var playerLinks []matchmakingLinkage
+		for _, player := range request.Players {
+			playerIndex++
+			localID := fmt.Sprintf("${local-player-%d}", playerIndex)
+			keys := make([]string, 0, len(player.Properties))
+			for key := range player.Properties {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			properties := make([]matchmakingTestProperty, 0, len(keys))
+			for _, key := range keys {
+				properties = append(properties, matchmakingTestProperty{Key: key, Value: player.Properties[key]})
+			}
+			included = append(included, matchmakingInlineResource{
+				Type: "gameCenterMatchmakingTestPlayerProperties",
+				ID:   localID,
+				Attributes: map[string]any{
+					"playerId":   player.ID,
+					"properties": properties,
+				},
+			})
+			playerLinks = append(playerLinks, matchmakingLinkage{Type: "gameCenterMatchmakingTestPlayerProperties", ID: localID})
+		}
+
+		attributes := map[string]any{
+			"requestName":    request.Name,
+			"secondsInQueue": request.SecondsInQueue,
+			"bundleId":       request.BundleID,
+			"appVersion":     request.AppVersion,
+			"platform":       request.Platform,
+		}
+		if request.Locale != "" {
+			attributes["locale"] = request.Locale
+		}
+		if request.Location != nil {
+			attributes["location"] = request.Location
+		}
+		if request.MinPlayers != nil {
+			attributes["minPlayers"] = *request.MinPlayers
+		}
+		if request.MaxPlayers != nil {
+			attributes["maxPlayers"] = *request.MaxPlayers
+		}
+		if request.PlayerCount != nil {
+			attributes["playerCount"] = *request.PlayerCount
+		}
+
+		localID := fmt.Sprintf("${local-request-%d}", i+1)
+		resource := matchmakingInlineResource{
+			Type:       "gameCenterMatchmakingTestRequests",
+			ID:         localID,
+			Attributes: attributes,
+		}
+		if len(playerLinks) > 0 {
+			resource.Relationships = map[string]any{
+				"matchmakingPlayerProperties": map[string]any{"data": playerLinks},
+			}
+		}
+		included = append(included, resource)
+		requestLinks = append(requestLinks, matchmakingLinkage{Type: "gameCenterMatchmakingTestRequests", ID: localID})
+	}
+
+	body := map[string]any{
+		"data": map[string]any{
+			"type": "gameCenterMatchmakingRuleSetTests",
+			"relationships": map[string]any{
+				"matchmakingRuleSet": map[string]any{
+					"data": matchmakingLinkage{Type: "gameCenterMatchmakingRuleSets", ID: ruleSetID},
+				},
+				"matchmakingRequests": map[string]any{"data": requestLinks},
+			},
+		},
+		"included": included,
+	}
+	return json.Marshal(body)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
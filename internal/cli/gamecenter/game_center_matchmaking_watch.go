@@ -0,0 +1,295 @@
+package gamecenter
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	matchmakingWatchDefaultPoll        = 15 * time.Minute
+	matchmakingWatchDefaultGranularity = "PT15M"
+)
+
+type matchmakingWatchSnapshot struct {
+	QueueID      string   `json:"queueId"`
+	PolledAt     string   `json:"polledAt"`
+	BucketStart  string   `json:"bucketStart,omitempty"`
+	BucketEnd    string   `json:"bucketEnd,omitempty"`
+	Total        int      `json:"total"`
+	Matched      int      `json:"matched"`
+	Canceled     int      `json:"canceled"`
+	Expired      int      `json:"expired"`
+	ExpiredRate  float64  `json:"expiredRate"`
+	CanceledRate float64  `json:"canceledRate"`
+	Alerts       []string `json:"alerts,omitempty"`
+}
+
+type matchmakingWatchThresholds struct {
+	expiredRate  float64
+	canceledRate float64
+	minRequests  int
+}
+
+// GameCenterMatchmakingWatchCommand polls queue request metrics and alerts on
+// expired and canceled request rates.
+func GameCenterMatchmakingWatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	queueID := fs.String("queue-id", "", "Matchmaking queue ID (required)")
+	poll := fs.Duration("poll", matchmakingWatchDefaultPoll, "Polling interval between metrics fetches")
+	granularity := fs.String("granularity", matchmakingWatchDefaultGranularity, "Metrics granularity (P1D, PT1H, PT15M)")
+	alertExpiredRate := fs.Float64("alert-expired-rate", 0, "Alert when the EXPIRED share of requests exceeds this rate (0-1)")
+	alertCanceledRate := fs.Float64("alert-canceled-rate", 0, "Alert when the CANCELED share of requests exceeds this rate (0-1)")
+	minRequests := fs.Int("min-requests", 1, "Minimum requests in a bucket before rates are evaluated")
+	maxPolls := fs.Int("max-polls", 0, "Stop after this many polls (0 = run until interrupted)")
+	exitOnAlert := fs.Bool("exit-on-alert", false, "Exit non-zero as soon as an alert is raised")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc game-center matchmaking watch --queue-id QUEUE_ID [flags]",
+		ShortHelp:  "Watch queue request metrics and alert on expired/canceled rates.",
+		LongHelp: `Watch queue request metrics and alert on expired/canceled rates.
+
+Every --poll interval the command fetches queue-requests metrics grouped by
+result and evaluates the most recent time bucket. One snapshot is written to
+stdout per poll. When the EXPIRED or CANCELED share of requests exceeds its
+threshold an alert line is printed to stderr, once per bucket.
+
+At least one of --alert-expired-rate or --alert-canceled-rate is required.
+Use --exit-on-alert to stop with a non-zero exit code when an alert fires,
+so wrapper scripts and CI jobs can page or notify.
+
+Examples:
+  asc game-center matchmaking watch --queue-id "QUEUE_ID" --poll 15m --alert-expired-rate 0.2
+  asc game-center matchmaking watch --queue-id "QUEUE_ID" --alert-expired-rate 0.2 --alert-canceled-rate 0.3 --min-requests 50
+  asc game-center matchmaking watch --queue-id "QUEUE_ID" --alert-expired-rate 0.1 --max-polls 1 --exit-on-alert`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*queueID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --queue-id is required")
+				return flag.ErrHelp
+			}
+			if *poll <= 0 {
+				return shared.UsageError("--poll must be greater than 0")
+			}
+			gran := strings.ToUpper(strings.TrimSpace(*granularity))
+			if gran == "" {
+				return shared.UsageError("--granularity is required")
+			}
+			if *alertExpiredRate < 0 || *alertExpiredRate > 1 {
+				return shared.UsageError("--alert-expired-rate must be between 0 and 1")
+			}
+			if *alertCanceledRate < 0 || *alertCanceledRate > 1 {
+				return shared.UsageError("--alert-canceled-rate must be between 0 and 1")
+			}
+			if *alertExpiredRate == 0 && *alertCanceledRate == 0 {
+				return shared.UsageError("at least one of --alert-expired-rate or --alert-canceled-rate is required")
+			}
+			if *minRequests < 1 {
+				return shared.UsageError("--min-requests must be at least 1")
+			}
+			if *maxPolls < 0 {
+				return shared.UsageError("--max-polls must be 0 or greater")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center matchmaking watch: %w", err)
+			}
+
+			thresholds := matchmakingWatchThresholds{
+				expiredRate:  *alertExpiredRate,
+				canceledRate: *alertCanceledRate,
+				minRequests:  *minRequests,
+			}
+			alerted := map[string]bool{}
+
+			ticker := time.NewTicker(*poll)
+			defer ticker.Stop()
+
+			for polls := 1; ; polls++ {
+				snapshot, err := fetchMatchmakingWatchSnapshot(ctx, client, id, gran)
+				if err != nil {
+					return fmt.Errorf("game-center matchmaking watch: %w", err)
+				}
+				evaluateMatchmakingWatchSnapshot(snapshot, thresholds)
+
+				// Alert once per bucket so a slow-moving bucket does not repeat
+				// the same alert on every poll.
+				fresh := snapshot.Alerts[:0:0]
+				for _, alert := range snapshot.Alerts {
+					key := snapshot.BucketStart + "|" + alert
+					if alerted[key] {
+						continue
+					}
+					alerted[key] = true
+					fresh = append(fresh, alert)
+					fmt.Fprintf(shared.WarningWriter(), "Alert: queue %s: %s\n", id, alert)
+				}
+
+				if err := printMatchmakingWatchSnapshot(snapshot, *output.Output, *output.Pretty); err != nil {
+					return err
+				}
+				if *exitOnAlert && len(fresh) > 0 {
+					return fmt.Errorf("game-center matchmaking watch: %s", strings.Join(fresh, "; "))
+				}
+				if *maxPolls > 0 && polls >= *maxPolls {
+					return nil
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+}
+
+// fetchMatchmakingWatchSnapshot fetches queue request metrics grouped by
+// result and summarizes the most recent bucket.
+func fetchMatchmakingWatchSnapshot(ctx context.Context, client *asc.Client, queueID, granularity string) (*matchmakingWatchSnapshot, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	firstPage, err := client.GetGameCenterMatchmakingQueueRequests(requestCtx, queueID,
+		asc.WithGCMatchmakingMetricsGranularity(granularity),
+		asc.WithGCMatchmakingMetricsGroupBy([]string{"result"}),
+		asc.WithGCMatchmakingMetricsLimit(200),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch queue request metrics: %w", err)
+	}
+	resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterMatchmakingQueueRequests(ctx, queueID, asc.WithGCMatchmakingMetricsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	metrics, ok := resp.(*asc.GameCenterMatchmakingQueueRequestsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected metrics response type %T", resp)
+	}
+
+	snapshot := summarizeMatchmakingRequests(metrics.Data)
+	snapshot.QueueID = queueID
+	snapshot.PolledAt = time.Now().UTC().Format(time.RFC3339)
+	return snapshot, nil
+}
+
+// summarizeMatchmakingRequests totals request counts by result for the latest
+// bucket present in the series.
+func summarizeMatchmakingRequests(series []asc.GameCenterMetricsData) *matchmakingWatchSnapshot {
+	snapshot := &matchmakingWatchSnapshot{}
+	for _, item := range series {
+		for _, point := range item.DataPoints {
+			if point.Start > snapshot.BucketStart {
+				snapshot.BucketStart = point.Start
+				snapshot.BucketEnd = point.End
+			}
+		}
+	}
+	if snapshot.BucketStart == "" {
+		return snapshot
+	}
+
+	for _, item := range series {
+		result := ""
+		if dimension, ok := item.Dimensions["result"]; ok {
+			result = strings.ToUpper(matchmakingDimensionValue(dimension.Data))
+		}
+		for _, point := range item.DataPoints {
+			if point.Start != snapshot.BucketStart {
+				continue
+			}
+			count := matchmakingMetricCount(point.Values["count"])
+			snapshot.Total += count
+			switch result {
+			case "MATCHED":
+				snapshot.Matched += count
+			case "CANCELED":
+				snapshot.Canceled += count
+			case "EXPIRED":
+				snapshot.Expired += count
+			}
+		}
+	}
+	if snapshot.Total > 0 {
+		snapshot.ExpiredRate = float64(snapshot.Expired) / float64(snapshot.Total)
+		snapshot.CanceledRate = float64(snapshot.Canceled) / float64(snapshot.Total)
+	}
+	return snapshot
+}
+
+func evaluateMatchmakingWatchSnapshot(snapshot *matchmakingWatchSnapshot, thresholds matchmakingWatchThresholds) {
+	if snapshot.Total < thresholds.minRequests {
+		return
+	}
+	if thresholds.expiredRate > 0 && snapshot.ExpiredRate > thresholds.expiredRate {
+		snapshot.Alerts = append(snapshot.Alerts, fmt.Sprintf("expired rate %.2f exceeds %.2f (%d of %d requests)", snapshot.ExpiredRate, thresholds.expiredRate, snapshot.Expired, snapshot.Total))
+	}
+	if thresholds.canceledRate > 0 && snapshot.CanceledRate > thresholds.canceledRate {
+		snapshot.Alerts = append(snapshot.Alerts, fmt.Sprintf("canceled rate %.2f exceeds %.2f (%d of %d requests)", snapshot.CanceledRate, thresholds.canceledRate, snapshot.Canceled, snapshot.Total))
+	}
+}
+
+func printMatchmakingWatchSnapshot(snapshot *matchmakingWatchSnapshot, format string, pretty bool) error {
+	headers := []string{"Polled At", "Bucket Start", "Total", "Matched", "Canceled", "Expired", "Expired Rate", "Canceled Rate", "Alerts"}
+	rows := [][]string{{
+		snapshot.PolledAt,
+		snapshot.BucketStart,
+		strconv.Itoa(snapshot.Total),
+		strconv.Itoa(snapshot.Matched),
+		strconv.Itoa(snapshot.Canceled),
+		strconv.Itoa(snapshot.Expired),
+		strconv.FormatFloat(snapshot.ExpiredRate, 'f', 2, 64),
+		strconv.FormatFloat(snapshot.CanceledRate, 'f', 2, 64),
+		strings.Join(snapshot.Alerts, "; "),
+	}}
+	return shared.PrintOutputWithRenderers(
+		snapshot,
+		format,
+		pretty,
+		func() error { asc.RenderTable(headers, rows); return nil },
+		func() error { asc.RenderMarkdown(headers, rows); return nil },
+	)
+}
+
+func matchmakingDimensionValue(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case map[string]any:
+		if id, ok := typed["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+func matchmakingMetricCount(value any) int {
+	switch typed := value.(type) {
+	case float64:
+		return int(typed)
+	case string:
+		parsed, err := strconv.Atoi(strings.TrimSpace(typed))
+		if err == nil {
+			return parsed
+		}
+	}
+	return 0
+}
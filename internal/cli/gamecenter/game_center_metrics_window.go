@@ -0,0 +1,152 @@
+package gamecenter
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Longest --since/--until span served at each automatic granularity. Wider
+// windows fall back to daily buckets.
+const (
+	metricsQuarterHourMaxSpan = 24 * time.Hour
+	metricsHourlyMaxSpan      = 7 * 24 * time.Hour
+)
+
+// metricsWindow is an optional [since, until) time range for metrics data
+// points. Zero values leave that side open.
+type metricsWindow struct {
+	since time.Time
+	until time.Time
+}
+
+// metricsWindowFlags holds the raw --since/--until values.
+type metricsWindowFlags struct {
+	since *string
+	until *string
+}
+
+func bindMetricsWindowFlags(fs *flag.FlagSet) metricsWindowFlags {
+	return metricsWindowFlags{
+		since: fs.String("since", "", "Only include data points starting at or after this time (RFC3339, YYYY-MM-DD, or a duration ago like 6h or 7d)"),
+		until: fs.String("until", "", "Only include data points starting before this time (RFC3339, YYYY-MM-DD, or a duration ago like 1h)"),
+	}
+}
+
+// parse validates the flags relative to now.
+func (f metricsWindowFlags) parse(now time.Time) (metricsWindow, error) {
+	var window metricsWindow
+	var err error
+	if window.since, err = parseMetricsTime(*f.since, now); err != nil {
+		return metricsWindow{}, shared.UsageErrorf("--since %v", err)
+	}
+	if window.until, err = parseMetricsTime(*f.until, now); err != nil {
+		return metricsWindow{}, shared.UsageErrorf("--until %v", err)
+	}
+	if !window.since.IsZero() && !window.until.IsZero() && !window.until.After(window.since) {
+		return metricsWindow{}, shared.UsageError("--until must be after --since")
+	}
+	return window, nil
+}
+
+// parseMetricsTime accepts RFC3339, YYYY-MM-DD (UTC midnight), or a duration
+// before now such as "90m", "6h", or "7d".
+func parseMetricsTime(raw string, now time.Time) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse("2006-01-02", raw); err == nil {
+		return parsed, nil
+	}
+	if days, ok := strings.CutSuffix(raw, "d"); ok {
+		if value, err := strconv.Atoi(days); err == nil && value > 0 {
+			return now.Add(-time.Duration(value) * 24 * time.Hour), nil
+		}
+	} else if duration, err := time.ParseDuration(raw); err == nil && duration > 0 {
+		return now.Add(-duration), nil
+	}
+	return time.Time{}, fmt.Errorf("must be RFC3339, YYYY-MM-DD, or a positive duration like 6h or 7d")
+}
+
+func (w metricsWindow) isSet() bool {
+	return !w.since.IsZero() || !w.until.IsZero()
+}
+
+// granularity picks the finest granularity that keeps the window to a
+// reasonable number of buckets: PT15M up to a day, PT1H up to a week, and
+// P1D beyond that. It returns "" when --since is not set.
+func (w metricsWindow) granularity(now time.Time) string {
+	if w.since.IsZero() {
+		return ""
+	}
+	until := w.until
+	if until.IsZero() {
+		until = now
+	}
+	switch span := until.Sub(w.since); {
+	case span <= metricsQuarterHourMaxSpan:
+		return "PT15M"
+	case span <= metricsHourlyMaxSpan:
+		return "PT1H"
+	default:
+		return "P1D"
+	}
+}
+
+// resolveMetricsGranularity returns the explicit granularity when given and
+// otherwise derives one from the window.
+func resolveMetricsGranularity(explicit string, window metricsWindow, now time.Time) string {
+	if gran := strings.TrimSpace(explicit); gran != "" {
+		return gran
+	}
+	return window.granularity(now)
+}
+
+// filter drops data points outside the window, and any rows left without
+// data points. The metrics endpoints take no time range, so the window is
+// applied client-side to whatever the granularity's retention returns.
+func (w metricsWindow) filter(resp any) {
+	metrics, ok := resp.(*asc.GameCenterMetricsResponse)
+	if !ok || metrics == nil || !w.isSet() {
+		return
+	}
+	rows := metrics.Data[:0]
+	for _, row := range metrics.Data {
+		points := row.DataPoints[:0]
+		for _, point := range row.DataPoints {
+			if w.contains(point.Start) {
+				points = append(points, point)
+			}
+		}
+		row.DataPoints = points
+		if len(points) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	metrics.Data = rows
+}
+
+// contains reports whether a data point starting at start falls in the
+// window. Unparseable timestamps are kept rather than silently dropped.
+func (w metricsWindow) contains(start string) bool {
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(start))
+	if err != nil {
+		return true
+	}
+	if !w.since.IsZero() && parsed.Before(w.since) {
+		return false
+	}
+	if !w.until.IsZero() && !parsed.Before(w.until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package gamecenter
+
+import (
+	"errors"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func parseTestMetricsWindow(t *testing.T, since, until string, now time.Time) (metricsWindow, error) {
+	t.Helper()
+	fs := flag.NewFlagSet("metrics", flag.ContinueOnError)
+	flags := bindMetricsWindowFlags(fs)
+	if err := fs.Parse([]string{"--since", since, "--until", until}); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+	return flags.parse(now)
+}
+
+func TestMetricsWindowGranularity(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		since string
+		until string
+		want  string
+	}{
+		{since: "6h", want: "PT15M"},
+		{since: "24h", want: "PT15M"},
+		{since: "3d", want: "PT1H"},
+		{since: "2026-10-01", until: "2026-10-08", want: "PT1H"},
+		{since: "30d", want: "P1D"},
+		{until: "1h", want: ""},
+	}
+	for _, test := range tests {
+		window, err := parseTestMetricsWindow(t, test.since, test.until, now)
+		if err != nil {
+			t.Fatalf("parse(%q, %q) error: %v", test.since, test.until, err)
+		}
+		if got := resolveMetricsGranularity("", window, now); got != test.want {
+			t.Fatalf("granularity for since=%q until=%q = %q, want %q", test.since, test.until, got, test.want)
+		}
+		if got := resolveMetricsGranularity("P1D", window, now); got != "P1D" {
+			t.Fatalf("expected explicit granularity to win, got %q", got)
+		}
+	}
+}
+
+func TestMetricsWindowParseErrors(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	for _, args := range [][2]string{{"yesterday", ""}, {"-5h", ""}, {"2026-10-10", "2026-10-09"}} {
+		if _, err := parseTestMetricsWindow(t, args[0], args[1], now); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("parse(%q, %q): expected usage error, got %v", args[0], args[1], err)
+		}
+	}
+}
+
+func TestMetricsWindowFilter(t *testing.T) {
+	window := metricsWindow{
+		since: time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC),
+		until: time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC),
+	}
+	resp := &asc.GameCenterMetricsResponse{Data: []asc.GameCenterMetricsData{
+		{DataPoints: []asc.GameCenterMetricsDataPoint{
+			{Start: "2026-10-15T09:45:00Z"},
+			{Start: "2026-10-15T10:00:00Z"},
+			{Start: "2026-10-15T10:45:00Z"},
+			{Start: "2026-10-15T11:00:00Z"},
+		}},
+		{DataPoints: []asc.GameCenterMetricsDataPoint{{Start: "2026-10-14T10:00:00Z"}}},
+	}}
+
+	window.filter(resp)
+
+	if len(resp.Data) != 1 {
+		t.Fatalf("expected empty rows to be dropped, got %d rows", len(resp.Data))
+	}
+	points := resp.Data[0].DataPoints
+	if len(points) != 2 || points[0].Start != "2026-10-15T10:00:00Z" || points[1].Start != "2026-10-15T10:45:00Z" {
+		t.Fatalf("unexpected points %+v", points)
+	}
+}
@@ -0,0 +1,316 @@
+package gamecenter
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// gameCenterGroupVendorPrefix is the prefix App Store Connect requires for
+// vendor identifiers owned by a Game Center group.
+const gameCenterGroupVendorPrefix = "grp."
+
+type gameCenterMoveItem struct {
+	Type                  string `json:"type"`
+	ID                    string `json:"id"`
+	ReferenceName         string `json:"referenceName"`
+	VendorIdentifier      string `json:"vendorIdentifier"`
+	GroupVendorIdentifier string `json:"groupVendorIdentifier"`
+	Migrated              *bool  `json:"migrated,omitempty"`
+}
+
+type gameCenterMoveResult struct {
+	AppID        string               `json:"appId"`
+	DetailID     string               `json:"detailId"`
+	FromGroupID  string               `json:"fromGroupId,omitempty"`
+	ToGroupID    string               `json:"toGroupId"`
+	DryRun       bool                 `json:"dryRun"`
+	AlreadyMoved bool                 `json:"alreadyMoved"`
+	Items        []gameCenterMoveItem `json:"items"`
+}
+
+// GameCenterMoveCommand returns the game-center move subcommand.
+func GameCenterMoveCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("move", flag.ExitOnError)
+
+	fromApp := fs.String("from-app", "", "App Store Connect app ID to migrate (or ASC_APP_ID env)")
+	toGroup := fs.String("to-group", "", "Game Center group ID to move the app into")
+	dryRun := fs.Bool("dry-run", false, "Preview the migration without changing anything")
+	confirm := fs.Bool("confirm", false, "Confirm the migration (required unless --dry-run)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "move",
+		ShortUsage: "asc game-center move --from-app APP_ID --to-group GROUP_ID [--dry-run | --confirm]",
+		ShortHelp:  "Move an app's Game Center configuration into a group.",
+		LongHelp: `Move an app's Game Center configuration into a Game Center group.
+
+The app's Game Center detail is re-associated with the target group, which
+migrates its achievements and leaderboards into the group. Group-owned
+resources use "grp."-prefixed vendor identifiers; the plan lists the
+identifier each resource is expected to have after the move, and --confirm
+verifies each one against the group afterwards.
+
+Moving an app into a group cannot be undone from App Store Connect.
+
+Examples:
+  asc game-center move --from-app "APP_ID" --to-group "GROUP_ID" --dry-run
+  asc game-center move --from-app "APP_ID" --to-group "GROUP_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*fromApp)
+			if resolvedAppID == "" {
+				return shared.UsageError("--from-app is required (or set ASC_APP_ID)")
+			}
+			groupID := strings.TrimSpace(*toGroup)
+			if groupID == "" {
+				return shared.UsageError("--to-group is required")
+			}
+			if *dryRun && *confirm {
+				return shared.UsageError("--dry-run and --confirm are mutually exclusive")
+			}
+			if !*dryRun && !*confirm {
+				return shared.UsageError("--confirm is required to move Game Center configuration (or use --dry-run)")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("game-center move: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			detailID, err := shared.ResolveGameCenterDetailID(requestCtx, client, resolvedAppID, "")
+			if err != nil {
+				return fmt.Errorf("game-center move: failed to get Game Center detail: %w", err)
+			}
+
+			fromGroupID, err := currentGameCenterGroupID(requestCtx, client, detailID)
+			if err != nil {
+				return fmt.Errorf("game-center move: failed to get current group: %w", err)
+			}
+
+			items, err := collectGameCenterMoveItems(requestCtx, client, detailID)
+			if err != nil {
+				return fmt.Errorf("game-center move: %w", err)
+			}
+
+			result := &gameCenterMoveResult{
+				AppID:        resolvedAppID,
+				DetailID:     detailID,
+				FromGroupID:  fromGroupID,
+				ToGroupID:    groupID,
+				DryRun:       *dryRun,
+				AlreadyMoved: fromGroupID == groupID,
+				Items:        items,
+			}
+
+			if *dryRun || result.AlreadyMoved {
+				return printGameCenterMoveResult(result, *output.Output, *output.Pretty)
+			}
+
+			rels := &asc.GameCenterDetailUpdateRelationships{
+				GameCenterGroup: &asc.Relationship{
+					Data: asc.ResourceData{
+						Type: asc.ResourceTypeGameCenterGroups,
+						ID:   groupID,
+					},
+				},
+			}
+			if _, err := client.UpdateGameCenterDetail(requestCtx, detailID, nil, rels); err != nil {
+				return fmt.Errorf("game-center move: failed to associate group: %w", err)
+			}
+
+			groupVendorIDs, err := collectGameCenterGroupVendorIDs(requestCtx, client, groupID)
+			if err != nil {
+				return fmt.Errorf("game-center move: group associated but verification failed: %w", err)
+			}
+			var unmigrated []string
+			for i := range result.Items {
+				migrated := groupVendorIDs[result.Items[i].Type+"|"+result.Items[i].GroupVendorIdentifier]
+				result.Items[i].Migrated = &migrated
+				if !migrated {
+					unmigrated = append(unmigrated, result.Items[i].ID)
+				}
+			}
+
+			if err := printGameCenterMoveResult(result, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if len(unmigrated) > 0 {
+				return fmt.Errorf("game-center move: %d item(s) not found in group %s after the move: %s", len(unmigrated), groupID, strings.Join(unmigrated, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// groupVendorIdentifier returns the grp.-prefixed form of a vendor identifier.
+func groupVendorIdentifier(vendorID string) string {
+	vendorID = strings.TrimSpace(vendorID)
+	if vendorID == "" || strings.HasPrefix(vendorID, gameCenterGroupVendorPrefix) {
+		return vendorID
+	}
+	return gameCenterGroupVendorPrefix + vendorID
+}
+
+func currentGameCenterGroupID(ctx context.Context, client *asc.Client, detailID string) (string, error) {
+	resp, err := client.GetGameCenterDetailGameCenterGroup(ctx, detailID)
+	if err != nil {
+		if errors.Is(err, asc.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(resp.Data.ID), nil
+}
+
+func collectGameCenterMoveItems(ctx context.Context, client *asc.Client, detailID string) ([]gameCenterMoveItem, error) {
+	items := make([]gameCenterMoveItem, 0)
+
+	achievementsFirst, err := client.GetGameCenterAchievements(ctx, detailID, asc.WithGCAchievementsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch achievements: %w", err)
+	}
+	achievementsPages, err := asc.PaginateAll(ctx, achievementsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterAchievements(ctx, detailID, asc.WithGCAchievementsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch achievements: %w", err)
+	}
+	if achievements, ok := achievementsPages.(*asc.GameCenterAchievementsResponse); ok {
+		for _, item := range achievements.Data {
+			items = append(items, gameCenterMoveItem{
+				Type:                  "achievement",
+				ID:                    item.ID,
+				ReferenceName:         item.Attributes.ReferenceName,
+				VendorIdentifier:      item.Attributes.VendorIdentifier,
+				GroupVendorIdentifier: groupVendorIdentifier(item.Attributes.VendorIdentifier),
+			})
+		}
+	}
+
+	leaderboardsFirst, err := client.GetGameCenterLeaderboards(ctx, detailID, asc.WithGCLeaderboardsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leaderboards: %w", err)
+	}
+	leaderboardsPages, err := asc.PaginateAll(ctx, leaderboardsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterLeaderboards(ctx, detailID, asc.WithGCLeaderboardsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leaderboards: %w", err)
+	}
+	if leaderboards, ok := leaderboardsPages.(*asc.GameCenterLeaderboardsResponse); ok {
+		for _, item := range leaderboards.Data {
+			items = append(items, gameCenterMoveItem{
+				Type:                  "leaderboard",
+				ID:                    item.ID,
+				ReferenceName:         item.Attributes.ReferenceName,
+				VendorIdentifier:      item.Attributes.VendorIdentifier,
+				GroupVendorIdentifier: groupVendorIdentifier(item.Attributes.VendorIdentifier),
+			})
+		}
+	}
+
+	return items, nil
+}
+
+// collectGameCenterGroupVendorIDs returns a set keyed by "type|vendorIdentifier"
+// for every achievement and leaderboard owned by the group.
+func collectGameCenterGroupVendorIDs(ctx context.Context, client *asc.Client, groupID string) (map[string]bool, error) {
+	vendorIDs := map[string]bool{}
+
+	achievementsFirst, err := client.GetGameCenterGroupAchievements(ctx, groupID, asc.WithGCAchievementsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch group achievements: %w", err)
+	}
+	achievementsPages, err := asc.PaginateAll(ctx, achievementsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterGroupAchievements(ctx, groupID, asc.WithGCAchievementsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch group achievements: %w", err)
+	}
+	if achievements, ok := achievementsPages.(*asc.GameCenterAchievementsResponse); ok {
+		for _, item := range achievements.Data {
+			vendorIDs["achievement|"+strings.TrimSpace(item.Attributes.VendorIdentifier)] = true
+		}
+	}
+
+	leaderboardsFirst, err := client.GetGameCenterGroupLeaderboards(ctx, groupID, asc.WithGCLeaderboardsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch group leaderboards: %w", err)
+	}
+	leaderboardsPages, err := asc.PaginateAll(ctx, leaderboardsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetGameCenterGroupLeaderboards(ctx, groupID, asc.WithGCLeaderboardsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch group leaderboards: %w", err)
+	}
+	if leaderboards, ok := leaderboardsPages.(*asc.GameCenterLeaderboardsResponse); ok {
+		for _, item := range leaderboards.Data {
+			vendorIDs["leaderboard|"+strings.TrimSpace(item.Attributes.VendorIdentifier)] = true
+		}
+	}
+
+	return vendorIDs, nil
+}
+
+func printGameCenterMoveResult(result *gameCenterMoveResult, format string, pretty bool) error {
+	headers := []string{"Type", "ID", "Reference Name", "Vendor ID", "Group Vendor ID", "Migrated"}
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		migrated := "n/a"
+		if item.Migrated != nil {
+			migrated = fmt.Sprintf("%t", *item.Migrated)
+		}
+		rows = append(rows, []string{
+			item.Type,
+			item.ID,
+			item.ReferenceName,
+			item.VendorIdentifier,
+			item.GroupVendorIdentifier,
+			migrated,
+		})
+	}
+
+	summary := func() {
+		fmt.Printf("App ID: %s\n", result.AppID)
+		fmt.Printf("Detail ID: %s\n", result.DetailID)
+		fmt.Printf("From Group: %s\n", valueOrNone(result.FromGroupID))
+		fmt.Printf("To Group: %s\n", result.ToGroupID)
+		fmt.Printf("Dry Run: %t\n", result.DryRun)
+		fmt.Printf("Already Moved: %t\n\n", result.AlreadyMoved)
+	}
+
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error {
+			summary()
+			asc.RenderTable(headers, rows)
+			return nil
+		},
+		func() error {
+			summary()
+			asc.RenderMarkdown(headers, rows)
+			return nil
+		},
+	)
+}
+
+func valueOrNone(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "none"
+	}
+	return value
+}
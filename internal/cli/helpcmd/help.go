@@ -0,0 +1,231 @@
+package helpcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Match locations, best first.
+const (
+	matchCommand = "command"
+	matchSummary = "summary"
+	matchHelp    = "help"
+)
+
+type searchResult struct {
+	Command string `json:"command"`
+	Summary string `json:"summary"`
+	Match   string `json:"match"`
+}
+
+type searchResults struct {
+	Query string         `json:"query"`
+	Data  []searchResult `json:"data"`
+}
+
+// HelpCommand returns the help command group. It searches the command tree
+// built from rootSubcommands and needs no auth or network access.
+func HelpCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet("help", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "help",
+		ShortUsage: "asc help <subcommand> [flags]",
+		ShortHelp:  "Search command help to find the right subcommand.",
+		LongHelp: `Search command help to find the right subcommand.
+
+Use "asc <command> --help" for the flags of a specific command.
+
+Examples:
+  asc help search screenshot
+  asc help search "review submission" --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			HelpSearchCommand(rootSubcommands),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// HelpSearchCommand returns the help search subcommand.
+func HelpSearchCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet("help search", flag.ExitOnError)
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json, table, markdown")
+
+	return &ffcli.Command{
+		Name:       "search",
+		ShortUsage: "asc help search [flags] <query>",
+		ShortHelp:  "Find commands whose name or help text matches a query.",
+		LongHelp: `Find commands whose name or help text matches a query.
+
+Every word in the query must appear (case-insensitively) in the command path,
+its summary, or its full help text. Results matching the command path come
+first, then summary matches, then matches elsewhere in the help text.
+Deprecated commands are skipped. The command exits non-zero when nothing
+matches.
+
+Examples:
+  asc help search screenshot
+  asc help search "phased release"
+  asc help search testers --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			terms, err := queryArgs(fs, args)
+			if err != nil {
+				return err
+			}
+			query := strings.TrimSpace(strings.Join(terms, " "))
+			if query == "" {
+				return shared.UsageError("query argument is required")
+			}
+
+			result := searchResults{
+				Query: query,
+				Data:  searchCommands(rootSubcommands, query),
+			}
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printResults(result.Data, asc.RenderTable) },
+				func() error { return printResults(result.Data, asc.RenderMarkdown) },
+			); err != nil {
+				return err
+			}
+			if len(result.Data) == 0 {
+				fmt.Fprintf(os.Stderr, "No commands matching %q\n", shared.SanitizeTerminal(query))
+				return shared.NewReportedError(fmt.Errorf("help search: no commands matching %q", query))
+			}
+			return nil
+		},
+	}
+}
+
+// queryArgs collects the query words, also parsing flags that follow them so
+// "asc help search screenshot --output json" works as expected.
+func queryArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var terms []string
+	for len(args) > 0 {
+		if args[0] == "--" {
+			return append(terms, args[1:]...), nil
+		}
+		if !strings.HasPrefix(args[0], "-") || args[0] == "-" {
+			terms = append(terms, args[0])
+			args = args[1:]
+			continue
+		}
+		if err := fs.Parse(args); err != nil {
+			return nil, shared.UsageError(err.Error())
+		}
+		rest := fs.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(terms, rest...), nil
+		}
+		args = rest
+	}
+	return terms, nil
+}
+
+// searchCommands walks the command tree and returns the commands whose path
+// or help text contains every query term, best matches first.
+func searchCommands(rootSubcommands []*ffcli.Command, query string) []searchResult {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	results := []searchResult{}
+	var walk func(cmd *ffcli.Command, parents []string)
+	walk = func(cmd *ffcli.Command, parents []string) {
+		if cmd == nil || isDeprecated(cmd) {
+			return
+		}
+		path := append(parents[:len(parents):len(parents)], cmd.Name)
+		if match := matchLocation(cmd, strings.Join(path, " "), terms); match != "" {
+			results = append(results, searchResult{
+				Command: "asc " + strings.Join(path, " "),
+				Summary: strings.TrimSpace(cmd.ShortHelp),
+				Match:   match,
+			})
+		}
+		for _, sub := range cmd.Subcommands {
+			walk(sub, path)
+		}
+	}
+	for _, cmd := range rootSubcommands {
+		walk(cmd, nil)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if rank(results[i].Match) != rank(results[j].Match) {
+			return rank(results[i].Match) < rank(results[j].Match)
+		}
+		return results[i].Command < results[j].Command
+	})
+	return results
+}
+
+// matchLocation reports the best location where all terms match, or "" when
+// some term does not appear anywhere.
+func matchLocation(cmd *ffcli.Command, path string, terms []string) string {
+	fields := []struct {
+		match string
+		text  string
+	}{
+		{matchCommand, strings.ToLower(path)},
+		{matchSummary, strings.ToLower(path + " " + cmd.ShortHelp)},
+		{matchHelp, strings.ToLower(path + " " + cmd.ShortHelp + " " + cmd.ShortUsage + " " + cmd.LongHelp)},
+	}
+	for _, field := range fields {
+		if containsAll(field.text, terms) {
+			return field.match
+		}
+	}
+	return ""
+}
+
+func containsAll(text string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
+
+func rank(match string) int {
+	switch match {
+	case matchCommand:
+		return 0
+	case matchSummary:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func isDeprecated(cmd *ffcli.Command) bool {
+	return strings.HasPrefix(strings.TrimSpace(cmd.ShortHelp), "DEPRECATED:")
+}
+
+func printResults(results []searchResult, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, []string{result.Command, result.Summary})
+	}
+	render([]string{"Command", "Summary"}, rows)
+	return nil
+}
@@ -0,0 +1,119 @@
+package helpcmd
+
+import (
+	"context"
+	"flag"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func newSearchTestTree() []*ffcli.Command {
+	return []*ffcli.Command{
+		{
+			Name:      "screenshots",
+			ShortHelp: "Manage App Store screenshots.",
+			Subcommands: []*ffcli.Command{
+				{Name: "upload", ShortHelp: "Upload screenshots from a directory."},
+				{Name: "sizes", ShortHelp: "List supported display sizes."},
+			},
+		},
+		{
+			Name:      "versions",
+			ShortHelp: "Manage App Store versions.",
+			Subcommands: []*ffcli.Command{
+				{
+					Name:      "phased-release",
+					ShortHelp: "Manage phased release.",
+					LongHelp:  "Pause or resume a staged rollout.",
+				},
+				{Name: "get", ShortHelp: "Get a version.", LongHelp: "Includes screenshot sets when requested."},
+			},
+		},
+		{
+			Name:      "old-screenshots",
+			ShortHelp: "DEPRECATED: use asc screenshots.",
+			Subcommands: []*ffcli.Command{
+				{Name: "list", ShortHelp: "List screenshots."},
+			},
+		},
+	}
+}
+
+func commandPaths(results []searchResult) []string {
+	paths := make([]string, 0, len(results))
+	for _, result := range results {
+		paths = append(paths, result.Command)
+	}
+	return paths
+}
+
+func TestSearchCommandsRanksCommandMatchesFirst(t *testing.T) {
+	results := searchCommands(newSearchTestTree(), "Screenshot")
+
+	want := []string{
+		"asc screenshots",
+		"asc screenshots sizes",
+		"asc screenshots upload",
+		"asc versions get",
+	}
+	if got := commandPaths(results); !reflect.DeepEqual(got, want) {
+		t.Fatalf("searchCommands() = %q, want %q", got, want)
+	}
+	if results[3].Match != matchHelp {
+		t.Fatalf("expected help match for versions get, got %q", results[3].Match)
+	}
+}
+
+func TestSearchCommandsRequiresEveryTerm(t *testing.T) {
+	if got := commandPaths(searchCommands(newSearchTestTree(), "staged rollout")); !reflect.DeepEqual(got, []string{"asc versions phased-release"}) {
+		t.Fatalf("unexpected results %q", got)
+	}
+	if got := searchCommands(newSearchTestTree(), "staged screenshots"); len(got) != 0 {
+		t.Fatalf("expected no results, got %q", commandPaths(got))
+	}
+}
+
+func TestHelpSearchFailsWhenNothingMatches(t *testing.T) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open %s: %v", os.DevNull, err)
+	}
+	defer devNull.Close()
+	originalStdout, originalStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = devNull, devNull
+	t.Cleanup(func() { os.Stdout, os.Stderr = originalStdout, originalStderr })
+
+	cmd := HelpSearchCommand(newSearchTestTree())
+	err = cmd.ParseAndRun(context.Background(), []string{"--output", "json", "staged", "screenshots"})
+	if err == nil {
+		t.Fatal("expected an error when no command matches")
+	}
+	if _, ok := err.(shared.ReportedError); !ok {
+		t.Fatalf("expected a reported error, got %T: %v", err, err)
+	}
+
+	if err := HelpSearchCommand(newSearchTestTree()).ParseAndRun(context.Background(), []string{"--output", "json", "rollout"}); err != nil {
+		t.Fatalf("expected matching search to succeed, got %v", err)
+	}
+}
+
+func TestQueryArgsParsesTrailingFlags(t *testing.T) {
+	fs := flag.NewFlagSet("help search", flag.ContinueOnError)
+	output := fs.String("output", "table", "output")
+
+	terms, err := queryArgs(fs, []string{"phased", "release", "--output", "json", "--", "--literal"})
+	if err != nil {
+		t.Fatalf("queryArgs() error: %v", err)
+	}
+	if want := []string{"phased", "release", "--literal"}; !reflect.DeepEqual(terms, want) {
+		t.Fatalf("queryArgs() = %q, want %q", terms, want)
+	}
+	if *output != "json" {
+		t.Fatalf("expected --output json, got %q", *output)
+	}
+}
@@ -324,7 +324,7 @@ Examples:
 			}
 
 			name := strings.TrimSpace(*refName)
-			if name == "" && !*familySharable {
+			if name == "" && !*familySharable && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
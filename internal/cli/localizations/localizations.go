@@ -30,7 +30,8 @@ Examples:
   asc localizations preview-sets get --id "PREVIEW_SET_ID"
   asc localizations screenshot-sets get --id "SCREENSHOT_SET_ID"
   asc localizations download --version "VERSION_ID" --path "./localizations"
-  asc localizations upload --version "VERSION_ID" --path "./localizations"`,
+  asc localizations upload --version "VERSION_ID" --path "./localizations"
+  asc localizations translate --version "VERSION_ID" --source en-US --targets de-DE,fr-FR --exec "./translate.sh"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -41,6 +42,7 @@ Examples:
 			LocalizationsScreenshotSetsCommand(),
 			LocalizationsDownloadCommand(),
 			LocalizationsUploadCommand(),
+			LocalizationsTranslateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
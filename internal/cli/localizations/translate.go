@@ -0,0 +1,302 @@
+package localizations
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const localizationsTranslateExecTimeout = 2 * time.Minute
+
+// Translatable fields per localization type. URL fields are left out because
+// they are not prose and usually stay the same across locales.
+var (
+	versionTranslatableFields = []string{"description", "keywords", "promotionalText", "whatsNew"}
+	appInfoTranslatableFields = []string{"name", "subtitle", "privacyPolicyText"}
+)
+
+type localizationTranslateResult struct {
+	Type           string   `json:"type"`
+	VersionID      string   `json:"versionId,omitempty"`
+	AppID          string   `json:"appId,omitempty"`
+	AppInfoID      string   `json:"appInfoId,omitempty"`
+	SourceLocale   string   `json:"sourceLocale"`
+	OutputPath     string   `json:"outputPath"`
+	ReviewRequired bool     `json:"reviewRequired"`
+	Fields         []string `json:"fields"`
+	asc.BulkResult
+}
+
+// LocalizationsTranslateCommand returns the translate subcommand.
+func LocalizationsTranslateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("translate", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
+	source := fs.String("source", "", "Source locale to translate from (e.g., en-US)")
+	targets := fs.String("targets", "", "Target locales, comma-separated (e.g., de-DE,fr-FR)")
+	fields := fs.String("fields", "", "Fields to translate, comma-separated (default: all prose fields for --type)")
+	execCommand := fs.String("exec", "", "Translation command; source text is piped on stdin and the translation is read from stdout")
+	path := fs.String("path", "localizations/drafts", "Output directory for draft .strings files")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "translate",
+		ShortUsage: "asc localizations translate --source LOCALE --targets LOCALES --exec COMMAND [flags]",
+		ShortHelp:  "Draft localizations with a user-provided translation command.",
+		LongHelp: `Draft localizations with a user-provided translation command.
+
+Fetches the --source localization and runs --exec once per target locale and
+field. The source text is piped on stdin and stdout is used as the
+translation. The command also receives ASC_SOURCE_LOCALE, ASC_TARGET_LOCALE,
+and ASC_LOCALIZATION_FIELD in its environment.
+
+Translations are written as draft .strings files (one per target locale) under
+--path and are never uploaded automatically. Each draft starts with a comment
+marking it as machine-translated and pending human review. After review, upload
+the drafts with "asc localizations upload".
+
+Translated fields:
+  version:  description, keywords, promotionalText, whatsNew
+  app-info: name, subtitle, privacyPolicyText
+
+Examples:
+  asc localizations translate --version "VERSION_ID" --source en-US --targets de-DE,fr-FR --exec "./translate.sh"
+  asc localizations translate --version "VERSION_ID" --source en-US --targets ja --fields whatsNew --exec "./translate.sh"
+  asc localizations translate --app "APP_ID" --type app-info --source en-US --targets es-ES --exec "./translate.sh" --path "./drafts"
+  asc localizations upload --version "VERSION_ID" --path "localizations/drafts"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			normalizedType, err := shared.NormalizeLocalizationType(*locType)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			sourceLocale := strings.TrimSpace(*source)
+			if sourceLocale == "" {
+				fmt.Fprintln(os.Stderr, "Error: --source is required")
+				return flag.ErrHelp
+			}
+			targetLocales := shared.SplitCSV(*targets)
+			if len(targetLocales) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --targets is required")
+				return flag.ErrHelp
+			}
+			if slices.Contains(targetLocales, sourceLocale) {
+				return shared.UsageErrorf("--targets must not include the source locale %q", sourceLocale)
+			}
+			command := strings.TrimSpace(*execCommand)
+			if command == "" {
+				fmt.Fprintln(os.Stderr, "Error: --exec is required")
+				return flag.ErrHelp
+			}
+			if strings.HasSuffix(strings.TrimSpace(*path), ".strings") {
+				return shared.UsageError("--path must be a directory")
+			}
+
+			allowedFields := versionTranslatableFields
+			if normalizedType == shared.LocalizationTypeAppInfo {
+				allowedFields = appInfoTranslatableFields
+			}
+			selectedFields := allowedFields
+			if strings.TrimSpace(*fields) != "" {
+				selectedFields = shared.SplitCSV(*fields)
+				for _, field := range selectedFields {
+					if !slices.Contains(allowedFields, field) {
+						return shared.UsageErrorf("--fields: %q is not translatable for --type %s (allowed: %s)", field, normalizedType, strings.Join(allowedFields, ", "))
+					}
+				}
+			}
+
+			result := &localizationTranslateResult{
+				Type:           normalizedType,
+				SourceLocale:   sourceLocale,
+				OutputPath:     *path,
+				ReviewRequired: true,
+			}
+
+			var sourceValues map[string]string
+			switch normalizedType {
+			case shared.LocalizationTypeVersion:
+				result.VersionID = strings.TrimSpace(*versionID)
+				if result.VersionID == "" {
+					fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
+					return flag.ErrHelp
+				}
+
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("localizations translate: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				resp, err := client.GetAppStoreVersionLocalizations(requestCtx, result.VersionID,
+					asc.WithAppStoreVersionLocalizationLocales([]string{sourceLocale}),
+				)
+				if err != nil {
+					return fmt.Errorf("localizations translate: failed to fetch: %w", err)
+				}
+				for _, item := range resp.Data {
+					if item.Attributes.Locale == sourceLocale {
+						sourceValues = shared.MapVersionLocalizationStrings(item.Attributes)
+						break
+					}
+				}
+			case shared.LocalizationTypeAppInfo:
+				result.AppID = shared.ResolveAppID(*appID)
+				if result.AppID == "" {
+					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
+					return flag.ErrHelp
+				}
+
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("localizations translate: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				result.AppInfoID, err = shared.ResolveAppInfoID(requestCtx, client, result.AppID, strings.TrimSpace(*appInfoID))
+				if err != nil {
+					return fmt.Errorf("localizations translate: %w", err)
+				}
+				resp, err := client.GetAppInfoLocalizations(requestCtx, result.AppInfoID,
+					asc.WithAppInfoLocalizationLocales([]string{sourceLocale}),
+				)
+				if err != nil {
+					return fmt.Errorf("localizations translate: failed to fetch: %w", err)
+				}
+				for _, item := range resp.Data {
+					if item.Attributes.Locale == sourceLocale {
+						sourceValues = shared.MapAppInfoLocalizationStrings(item.Attributes)
+						break
+					}
+				}
+			}
+			if sourceValues == nil {
+				return fmt.Errorf("localizations translate: source locale %q not found", sourceLocale)
+			}
+
+			var translatable []string
+			for _, field := range selectedFields {
+				if strings.TrimSpace(sourceValues[field]) != "" {
+					translatable = append(translatable, field)
+				}
+			}
+			if len(translatable) == 0 {
+				return fmt.Errorf("localizations translate: source locale %q has no text for fields: %s", sourceLocale, strings.Join(selectedFields, ", "))
+			}
+
+			// A failed translation only drops that target's draft; the other
+			// targets are still written.
+			result.Fields = translatable
+			result.Total = len(targetLocales)
+			drafts := make(map[string]map[string]string, len(targetLocales))
+			for _, target := range targetLocales {
+				values := make(map[string]string, len(translatable))
+				for _, field := range translatable {
+					translated, err := runLocalizationTranslateExec(ctx, command, sourceLocale, target, field, sourceValues[field])
+					if err != nil {
+						result.Fail(asc.BulkResultItem{Item: target, Detail: field}, err)
+						values = nil
+						break
+					}
+					values[field] = translated
+				}
+				if values != nil {
+					drafts[target] = values
+				}
+			}
+
+			if len(drafts) > 0 {
+				header := fmt.Sprintf("DRAFT: machine-translated from %s by asc localizations translate. Review before uploading.", sourceLocale)
+				files, err := shared.WriteLocalizationDraftStrings(*path, normalizedType, drafts, header)
+				if err != nil {
+					return fmt.Errorf("localizations translate: %w", err)
+				}
+				for _, file := range files {
+					result.Succeed(asc.BulkResultItem{Item: file.Locale, ID: file.Path, Detail: "pending review"})
+				}
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderLocalizationTranslateResult(result, false) },
+				func() error { return renderLocalizationTranslateResult(result, true) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("localizations translate: %d target(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+func runLocalizationTranslateExec(ctx context.Context, command, sourceLocale, targetLocale, field, text string) (string, error) {
+	execCtx, cancel := context.WithTimeout(ctx, localizationsTranslateExecTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(execCtx, "sh", "-c", command)
+	cmd.Env = append(os.Environ(),
+		"ASC_SOURCE_LOCALE="+sourceLocale,
+		"ASC_TARGET_LOCALE="+targetLocale,
+		"ASC_LOCALIZATION_FIELD="+field,
+	)
+	cmd.Stdin = strings.NewReader(text)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message != "" {
+			return "", fmt.Errorf("%w: %s", err, message)
+		}
+		return "", err
+	}
+
+	translated := strings.TrimRight(stdout.String(), "\r\n")
+	if strings.TrimSpace(translated) == "" {
+		return "", fmt.Errorf("translation command returned empty output")
+	}
+	return translated, nil
+}
+
+func renderLocalizationTranslateResult(result *localizationTranslateResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Source", "Fields", "Path", "Succeeded", "Failed"},
+		[][]string{{
+			result.SourceLocale,
+			strings.Join(result.Fields, ", "),
+			result.OutputPath,
+			fmt.Sprintf("%d", result.Succeeded),
+			fmt.Sprintf("%d", result.Failed),
+		}},
+	)
+	render(asc.BulkResultRows(&result.BulkResult))
+	return nil
+}
@@ -169,7 +169,7 @@ Examples:
 				visited[f.Name] = true
 			})
 
-			if !visited["catalog-url"] {
+			if !visited["catalog-url"] && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
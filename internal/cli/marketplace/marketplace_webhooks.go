@@ -249,7 +249,7 @@ Examples:
 				visited[f.Name] = true
 			})
 
-			if !visited["url"] && !visited["secret"] {
+			if !visited["url"] && !visited["secret"] && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -357,5 +357,5 @@ func marketplaceWebhookFieldsList() []string {
 }
 
 func warnMarketplaceWebhooksDeprecated() {
-	fmt.Fprintln(os.Stderr, "Warning: marketplace webhooks endpoints are deprecated in App Store Connect API.")
+	fmt.Fprintln(shared.WarningWriter(), "Warning: marketplace webhooks endpoints are deprecated in App Store Connect API.")
 }
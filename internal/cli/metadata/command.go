@@ -28,8 +28,12 @@ Keyword workflow:
   - raw App Store Connect ` + "`searchKeywords`" + ` relationship APIs remain under
     ` + "`asc apps search-keywords ...`" + ` and ` + "`asc localizations search-keywords ...`" + `
 
+Additional scopes (` + "`--include categories,version-attributes`" + ` or ` + "`all`" + ` on pull and push):
+  - categories.json: primary/secondary category and subcategory IDs
+  - version-attributes/<version>.json: copyright, releaseType, earliestReleaseDate
+
 Not yet included in this group:
-  - categories, copyright, review information, age ratings, screenshots
+  - review information, age ratings, screenshots
 
 Examples:
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata"
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
@@ -17,6 +18,7 @@ type PushExecutionOptions struct {
 	Version      string
 	Platform     string
 	Dir          string
+	Layout       string
 	Include      string
 	DryRun       bool
 	AllowDeletes bool
@@ -56,14 +58,49 @@ func ExecutePush(ctx context.Context, opts PushExecutionOptions) (PushPlanResult
 	if includeValue == "" {
 		includeValue = includeLocalizations
 	}
-	includes, err := parseIncludes(includeValue)
+	includes, err := parsePushIncludes(includeValue)
 	if err != nil {
 		return PushPlanResult{}, shared.UsageError(err.Error())
 	}
+	includesAssets := hasInclude(includes, includeScreenshots) || hasInclude(includes, includePreviews)
+	if includesAssets && !opts.DryRun {
+		return PushPlanResult{}, shared.UsageError("--include screenshots,previews requires --dry-run; upload changed assets with asc screenshots sync or asc video-previews upload")
+	}
+	// Text metadata is planned unless only asset scopes were requested.
+	includesText := !includesAssets || len(includes) > countAssetIncludes(includes)
+	includesLocalizations := hasInclude(includes, includeLocalizations)
 
-	localBundle, err := loadLocalMetadata(dirValue, versionValue)
+	layoutValue, err := normalizeMetadataLayout(opts.Layout)
 	if err != nil {
-		return PushPlanResult{}, err
+		return PushPlanResult{}, shared.UsageError(err.Error())
+	}
+
+	var localBundle localMetadataBundle
+	if includesLocalizations {
+		localBundle, err = loadLocalMetadataWithLayout(dirValue, versionValue, layoutValue)
+		if err != nil {
+			return PushPlanResult{}, err
+		}
+	}
+	var localCategories, localVersionAttributes map[string]string
+	if hasInclude(includes, includeCategories) {
+		localCategories, err = loadLocalCategories(dirValue)
+		if err != nil {
+			return PushPlanResult{}, err
+		}
+	}
+	if hasInclude(includes, includeVersionAttributes) {
+		localVersionAttributes, err = loadLocalVersionAttributes(dirValue, versionValue)
+		if err != nil {
+			return PushPlanResult{}, err
+		}
+	}
+	var localAssetSets []localAssetSet
+	if includesAssets {
+		localAssetSets, err = collectLocalAssetSets(dirValue, includes)
+		if err != nil {
+			return PushPlanResult{}, err
+		}
 	}
 
 	client, err := shared.GetASCClient()
@@ -81,89 +118,147 @@ func ExecutePush(ctx context.Context, opts PushExecutionOptions) (PushPlanResult
 		}
 		return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
 	}
-	appInfoIDValue, err := resolveMetadataPushAppInfoID(
-		requestCtx,
-		client,
-		resolvedAppID,
-		strings.TrimSpace(opts.AppInfoID),
-		versionValue,
-		platformValue,
-		dirValue,
-		versionStateValue,
-	)
-	if err != nil {
-		if errors.Is(err, flag.ErrHelp) {
-			return PushPlanResult{}, err
-		}
-		return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+	result := PushPlanResult{
+		AppID:     resolvedAppID,
+		Version:   versionValue,
+		VersionID: versionIDValue,
+		Dir:       dirValue,
+		DryRun:    opts.DryRun,
+		Includes:  includes,
+		Adds:      []PlanItem{},
+		Updates:   []PlanItem{},
+		Deletes:   []PlanItem{},
 	}
 
-	remoteAppInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
-	if err != nil {
-		return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+	var remoteAppInfoItems []asc.Resource[asc.AppInfoLocalizationAttributes]
+	if includesLocalizations || localCategories != nil {
+		appInfoIDValue, err := resolveMetadataPushAppInfoID(
+			requestCtx,
+			client,
+			resolvedAppID,
+			strings.TrimSpace(opts.AppInfoID),
+			versionValue,
+			platformValue,
+			dirValue,
+			versionStateValue,
+		)
+		if err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return PushPlanResult{}, err
+			}
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+		}
+		result.AppInfoID = appInfoIDValue
+
+		if includesLocalizations {
+			remoteAppInfoItems, err = fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
+			if err != nil {
+				return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+			}
+		}
 	}
 	remoteVersionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
 	if err != nil {
 		return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
 	}
 
-	remoteAppInfo := make(map[string]AppInfoLocalization, len(remoteAppInfoItems))
-	for _, item := range remoteAppInfoItems {
-		locale := strings.TrimSpace(item.Attributes.Locale)
-		if locale == "" {
-			continue
-		}
-		remoteAppInfo[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
-			Name:              item.Attributes.Name,
-			Subtitle:          item.Attributes.Subtitle,
-			PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
-			PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
-			PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
-		})
-	}
-
-	remoteVersion := remoteVersionItemsToVersionMap(remoteVersionItems)
-
-	localAppInfo := applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, opts.AllowDeletes)
-	localVersion := applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, opts.AllowDeletes)
-
-	adds, updates, deletes, appInfoCalls := buildScopePlan(
-		appInfoDirName,
-		"",
-		appInfoPlanFields,
-		appInfoToPlanFields(localAppInfo),
-		appInfoToFieldMap(remoteAppInfo),
-	)
-	versionAdds, versionUpdates, versionDeletes, versionCalls := buildScopePlan(
-		versionDirName,
-		versionValue,
-		versionPlanFields,
-		versionToPlanFields(localVersion),
-		versionToFieldMap(remoteVersion),
-	)
-	adds = append(adds, versionAdds...)
-	updates = append(updates, versionUpdates...)
-	deletes = append(deletes, versionDeletes...)
+	if includesAssets {
+		assetPlan, err := buildAssetPlan(requestCtx, client, localAssetSets, remoteVersionItems)
+		if err != nil {
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+		}
+		result.Assets = assetPlan
+	}
+	if !includesText {
+		return result, nil
+	}
 
-	sortPlanItems(adds)
-	sortPlanItems(updates)
-	sortPlanItems(deletes)
+	adds := make([]PlanItem, 0)
+	updates := make([]PlanItem, 0)
+	deletes := make([]PlanItem, 0)
+	var localAppInfo map[string]appInfoLocalPatch
+	var localVersion map[string]versionLocalPatch
+	var appInfoCalls, versionCalls scopeCallCounts
+	if includesLocalizations {
+		remoteAppInfo := make(map[string]AppInfoLocalization, len(remoteAppInfoItems))
+		for _, item := range remoteAppInfoItems {
+			locale := strings.TrimSpace(item.Attributes.Locale)
+			if locale == "" {
+				continue
+			}
+			remoteAppInfo[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
+				Name:              item.Attributes.Name,
+				Subtitle:          item.Attributes.Subtitle,
+				PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
+				PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
+				PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
+			})
+		}
 
+		remoteVersion := remoteVersionItemsToVersionMap(remoteVersionItems)
+
+		localAppInfo = applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, opts.AllowDeletes)
+		localVersion = applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, opts.AllowDeletes)
+
+		adds, updates, deletes, appInfoCalls = buildScopePlan(
+			appInfoDirName,
+			"",
+			appInfoPlanFields,
+			appInfoToPlanFields(localAppInfo),
+			appInfoToFieldMap(remoteAppInfo),
+		)
+		versionAdds, versionUpdates, versionDeletes, calls := buildScopePlan(
+			versionDirName,
+			versionValue,
+			versionPlanFields,
+			versionToPlanFields(localVersion),
+			versionToFieldMap(remoteVersion),
+		)
+		versionCalls = calls
+		adds = append(adds, versionAdds...)
+		updates = append(updates, versionUpdates...)
+		deletes = append(deletes, versionDeletes...)
+	}
 	apiCalls := buildAPICallSummary(appInfoCalls, versionCalls)
 
-	result := PushPlanResult{
-		AppID:     resolvedAppID,
-		AppInfoID: appInfoIDValue,
-		Version:   versionValue,
-		VersionID: versionIDValue,
-		Dir:       dirValue,
-		DryRun:    opts.DryRun,
-		Includes:  includes,
-		Adds:      adds,
-		Updates:   updates,
-		Deletes:   deletes,
-		APICalls:  apiCalls,
+	categoriesChanged := false
+	if localCategories != nil {
+		remoteCategories, err := fetchAppInfoCategories(requestCtx, client, result.AppInfoID)
+		if err != nil {
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+		}
+		categoryAdds, categoryUpdates := buildAttributePlan(includeCategories, "", appInfoCategoryRelationships, localCategories, categoriesToFieldMap(remoteCategories))
+		adds = append(adds, categoryAdds...)
+		updates = append(updates, categoryUpdates...)
+		if len(categoryAdds)+len(categoryUpdates) > 0 {
+			categoriesChanged = true
+			apiCalls = append(apiCalls, PlanAPICall{Operation: "update_categories", Scope: includeCategories, Count: 1})
+		}
 	}
+	var versionAttributeChanges []PlanItem
+	if localVersionAttributes != nil {
+		remoteVersionAttributes, err := fetchVersionAttributes(requestCtx, client, versionIDValue)
+		if err != nil {
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", err)
+		}
+		attributeAdds, attributeUpdates := buildAttributePlan(includeVersionAttributes, versionValue, versionAttributePlanFields, localVersionAttributes, versionAttributesToFieldMap(remoteVersionAttributes))
+		adds = append(adds, attributeAdds...)
+		updates = append(updates, attributeUpdates...)
+		versionAttributeChanges = append(attributeAdds, attributeUpdates...)
+		if len(versionAttributeChanges) > 0 {
+			apiCalls = append(apiCalls, PlanAPICall{Operation: "update_version", Scope: includeVersionAttributes, Count: 1})
+		}
+	}
+
+	sortPlanItems(adds)
+	sortPlanItems(updates)
+	sortPlanItems(deletes)
+	sortAPICalls(apiCalls)
+
+	result.Adds = adds
+	result.Updates = updates
+	result.Deletes = deletes
+	result.APICalls = apiCalls
 
 	if opts.DryRun {
 		return result, nil
@@ -178,20 +273,38 @@ func ExecutePush(ctx context.Context, opts PushExecutionOptions) (PushPlanResult
 		}
 	}
 
-	actions, applyErr := applyMetadataPlan(
-		requestCtx,
-		client,
-		appInfoIDValue,
-		versionIDValue,
-		versionValue,
-		localAppInfo,
-		localVersion,
-		remoteAppInfoItems,
-		remoteVersionItems,
-		opts.AllowDeletes,
-	)
-	if applyErr != nil {
-		return PushPlanResult{}, fmt.Errorf("metadata push: %w", applyErr)
+	actions := make([]ApplyAction, 0)
+	if includesLocalizations {
+		localizationActions, applyErr := applyMetadataPlan(
+			requestCtx,
+			client,
+			result.AppInfoID,
+			versionIDValue,
+			versionValue,
+			localAppInfo,
+			localVersion,
+			remoteAppInfoItems,
+			remoteVersionItems,
+			opts.AllowDeletes,
+		)
+		if applyErr != nil {
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", applyErr)
+		}
+		actions = append(actions, localizationActions...)
+	}
+	if categoriesChanged {
+		action, applyErr := applyCategoriesChanges(requestCtx, client, result.AppInfoID, localCategories)
+		if applyErr != nil {
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", applyErr)
+		}
+		actions = append(actions, action)
+	}
+	if len(versionAttributeChanges) > 0 {
+		action, applyErr := applyVersionAttributeChanges(requestCtx, client, versionIDValue, versionValue, versionAttributeChanges)
+		if applyErr != nil {
+			return PushPlanResult{}, fmt.Errorf("metadata push: %w", applyErr)
+		}
+		actions = append(actions, action)
 	}
 	result.Applied = true
 	result.Actions = actions
@@ -0,0 +1,199 @@
+package metadata
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	metadataLayoutAuto      = "auto"
+	metadataLayoutCanonical = "canonical"
+	metadataLayoutFastlane  = "fastlane"
+)
+
+// fastlaneFieldFile maps a fastlane deliver file name to a plan field.
+type fastlaneFieldFile struct {
+	file  string
+	field string
+}
+
+// fastlaneVersionFiles maps fastlane deliver file names to version plan fields.
+var fastlaneVersionFiles = []fastlaneFieldFile{
+	{file: "description.txt", field: "description"},
+	{file: "keywords.txt", field: "keywords"},
+	{file: "marketing_url.txt", field: "marketingUrl"},
+	{file: "promotional_text.txt", field: "promotionalText"},
+	{file: "support_url.txt", field: "supportUrl"},
+	{file: "release_notes.txt", field: "whatsNew"},
+}
+
+// fastlaneAppInfoFiles maps fastlane deliver file names to app-info plan fields.
+var fastlaneAppInfoFiles = []fastlaneFieldFile{
+	{file: "name.txt", field: "name"},
+	{file: "subtitle.txt", field: "subtitle"},
+	{file: "privacy_url.txt", field: "privacyPolicyUrl"},
+}
+
+// fastlaneNonLocaleDirs are deliver directories that hold non-localized data.
+var fastlaneNonLocaleDirs = map[string]struct{}{
+	"review_information":                       {},
+	"trade_representative_contact_information": {},
+	screenshotsDirName:                         {},
+	previewsDirName:                            {},
+}
+
+func normalizeMetadataLayout(value string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	switch normalized {
+	case "", metadataLayoutAuto:
+		return metadataLayoutAuto, nil
+	case metadataLayoutCanonical, metadataLayoutFastlane:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("--layout must be one of: auto, canonical, fastlane")
+	}
+}
+
+// detectMetadataLayout picks the canonical layout when app-info/ or version/
+// exists under dir, and the fastlane layout otherwise.
+func detectMetadataLayout(dir string) string {
+	for _, name := range []string{appInfoDirName, versionDirName} {
+		if info, err := os.Stat(filepath.Join(dir, name)); err == nil && info.IsDir() {
+			return metadataLayoutCanonical
+		}
+	}
+	return metadataLayoutFastlane
+}
+
+func loadLocalMetadataWithLayout(dir, version, layout string) (localMetadataBundle, error) {
+	if layout == metadataLayoutAuto {
+		layout = detectMetadataLayout(dir)
+	}
+	if layout == metadataLayoutFastlane {
+		return loadFastlaneMetadata(dir)
+	}
+	return loadLocalMetadata(dir, version)
+}
+
+// loadFastlaneMetadata reads a fastlane deliver metadata directory
+// (<dir>/<locale>/description.txt, name.txt, ...). Only files that exist are
+// treated as set fields, matching canonical push semantics where omitted
+// fields are a no-op. The "default" directory is used as the fallback locale.
+func loadFastlaneMetadata(dir string) (localMetadataBundle, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return localMetadataBundle{}, shared.UsageErrorf("metadata directory %q does not exist", dir)
+		}
+		return localMetadataBundle{}, fmt.Errorf("metadata push: failed to read %s: %w", dir, err)
+	}
+
+	bundle := localMetadataBundle{
+		appInfo: make(map[string]appInfoLocalPatch),
+		version: make(map[string]versionLocalPatch),
+	}
+	seenLocales := make(map[string]string)
+	filesSeen := 0
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, skip := fastlaneNonLocaleDirs[entry.Name()]; skip {
+			continue
+		}
+		locale, err := validateLocale(entry.Name())
+		if err != nil {
+			fmt.Fprintf(shared.WarningWriter(), "Warning: skipping non-locale directory %q: %v\n", entry.Name(), err)
+			continue
+		}
+		if err := recordCanonicalLocaleFile(seenLocales, locale, entry.Name()); err != nil {
+			return localMetadataBundle{}, shared.UsageError(err.Error())
+		}
+
+		localeDir := filepath.Join(dir, entry.Name())
+		appInfoFields, err := readFastlaneFields(localeDir, fastlaneAppInfoFiles)
+		if err != nil {
+			return localMetadataBundle{}, fmt.Errorf("metadata push: %w", err)
+		}
+		versionFields, err := readFastlaneFields(localeDir, fastlaneVersionFiles)
+		if err != nil {
+			return localMetadataBundle{}, fmt.Errorf("metadata push: %w", err)
+		}
+
+		if len(appInfoFields) > 0 {
+			patch := fastlaneAppInfoPatch(appInfoFields)
+			if locale == DefaultLocale {
+				bundle.defaultAppInfo = &patch
+			} else {
+				bundle.appInfo[locale] = patch
+			}
+			filesSeen += len(appInfoFields)
+		}
+		if len(versionFields) > 0 {
+			patch := fastlaneVersionPatch(versionFields)
+			if locale == DefaultLocale {
+				bundle.defaultVersion = &patch
+			} else {
+				bundle.version[locale] = patch
+			}
+			filesSeen += len(versionFields)
+		}
+	}
+
+	if filesSeen == 0 {
+		return localMetadataBundle{}, shared.UsageError("no fastlane metadata .txt files found")
+	}
+	return bundle, nil
+}
+
+func readFastlaneFields(localeDir string, files []fastlaneFieldFile) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, mapping := range files {
+		path := filepath.Join(localeDir, mapping.file)
+		data, err := readFileNoFollow(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		value := strings.TrimSpace(string(data))
+		if value == "" {
+			// Empty deliver files mean "leave unchanged", like omitted JSON keys.
+			continue
+		}
+		fields[mapping.field] = value
+	}
+	return fields, nil
+}
+
+func fastlaneAppInfoPatch(fields map[string]string) appInfoLocalPatch {
+	return appInfoLocalPatch{
+		localization: NormalizeAppInfoLocalization(AppInfoLocalization{
+			Name:             fields["name"],
+			Subtitle:         fields["subtitle"],
+			PrivacyPolicyURL: fields["privacyPolicyUrl"],
+		}),
+		setFields: fields,
+	}
+}
+
+func fastlaneVersionPatch(fields map[string]string) versionLocalPatch {
+	return versionLocalPatch{
+		localization: NormalizeVersionLocalization(VersionLocalization{
+			Description:     fields["description"],
+			Keywords:        fields["keywords"],
+			MarketingURL:    fields["marketingUrl"],
+			PromotionalText: fields["promotionalText"],
+			SupportURL:      fields["supportUrl"],
+			WhatsNew:        fields["whatsNew"],
+		}),
+		setFields: fields,
+	}
+}
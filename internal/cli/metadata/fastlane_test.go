@@ -0,0 +1,95 @@
+package metadata
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFastlaneFile(t *testing.T, dir, locale, name, content string) {
+	t.Helper()
+	localeDir := filepath.Join(dir, locale)
+	if err := os.MkdirAll(localeDir, 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", localeDir, err)
+	}
+	if err := os.WriteFile(filepath.Join(localeDir, name), []byte(content), 0o644); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+}
+
+func TestLoadFastlaneMetadataMapsDeliverFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFastlaneFile(t, dir, "en-US", "name.txt", "My App\n")
+	writeFastlaneFile(t, dir, "en-US", "privacy_url.txt", "https://example.com/privacy\n")
+	writeFastlaneFile(t, dir, "en-US", "description.txt", "Great app\n")
+	writeFastlaneFile(t, dir, "en-US", "release_notes.txt", "Bug fixes\n")
+	writeFastlaneFile(t, dir, "en-US", "keywords.txt", "   ")
+	writeFastlaneFile(t, dir, "default", "support_url.txt", "https://example.com/support")
+	if err := os.MkdirAll(filepath.Join(dir, "review_information"), 0o755); err != nil {
+		t.Fatalf("mkdir review_information: %v", err)
+	}
+
+	bundle, err := loadFastlaneMetadata(dir)
+	if err != nil {
+		t.Fatalf("loadFastlaneMetadata() error: %v", err)
+	}
+
+	appInfo, ok := bundle.appInfo["en-US"]
+	if !ok {
+		t.Fatalf("expected en-US app-info patch, got %+v", bundle.appInfo)
+	}
+	if appInfo.localization.Name != "My App" || appInfo.setFields["privacyPolicyUrl"] != "https://example.com/privacy" {
+		t.Fatalf("unexpected app-info patch: %+v", appInfo)
+	}
+	if _, ok := appInfo.setFields["subtitle"]; ok {
+		t.Fatal("expected missing subtitle.txt to be a no-op")
+	}
+
+	version, ok := bundle.version["en-US"]
+	if !ok {
+		t.Fatalf("expected en-US version patch, got %+v", bundle.version)
+	}
+	if version.localization.WhatsNew != "Bug fixes" || version.localization.Description != "Great app" {
+		t.Fatalf("unexpected version patch: %+v", version.localization)
+	}
+	if _, ok := version.setFields["keywords"]; ok {
+		t.Fatal("expected blank keywords.txt to be a no-op")
+	}
+
+	if bundle.defaultVersion == nil || bundle.defaultVersion.localization.SupportURL != "https://example.com/support" {
+		t.Fatalf("expected default/ to provide the fallback version patch, got %+v", bundle.defaultVersion)
+	}
+	if bundle.defaultAppInfo != nil {
+		t.Fatalf("expected no default app-info patch, got %+v", bundle.defaultAppInfo)
+	}
+}
+
+func TestLoadFastlaneMetadataRequiresFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en-US"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	_, err := loadFastlaneMetadata(dir)
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
+
+func TestDetectMetadataLayout(t *testing.T) {
+	canonical := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(canonical, versionDirName), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if got := detectMetadataLayout(canonical); got != metadataLayoutCanonical {
+		t.Fatalf("expected canonical layout, got %q", got)
+	}
+
+	fastlane := t.TempDir()
+	writeFastlaneFile(t, fastlane, "en-US", "description.txt", "Hello")
+	if got := detectMetadataLayout(fastlane); got != metadataLayoutFastlane {
+		t.Fatalf("expected fastlane layout, got %q", got)
+	}
+}
@@ -76,6 +76,7 @@ type MetadataKeywordsImportResult struct {
 	Issues              []MetadataKeywordIssue      `json:"issues,omitempty"`
 	SideDataRecordCount int                         `json:"sideDataRecordCount,omitempty"`
 	SideDataReportPath  string                      `json:"sideDataReportPath,omitempty"`
+	asc.BulkResult
 }
 
 // MetadataKeywordsLocalizeResult describes one localization-copy run.
@@ -288,16 +289,26 @@ Examples:
 				*output.Output,
 				*output.Pretty,
 				func() error {
-					return printMetadataKeywordFileResultTable("Keyword Import", result.Results, result.DetectedLocales, result.Issues, result.Dir, result.Version, result.DryRun, result.SideDataRecordCount, result.SideDataReportPath)
+					if err := printMetadataKeywordFileResultTable("Keyword Import", result.Results, result.DetectedLocales, result.Issues, result.Dir, result.Version, result.DryRun, result.SideDataRecordCount, result.SideDataReportPath); err != nil {
+						return err
+					}
+					fmt.Println()
+					asc.RenderTable(asc.BulkResultRows(&result.BulkResult))
+					return nil
 				},
 				func() error {
-					return printMetadataKeywordFileResultMarkdown("Keyword Import", result.Results, result.DetectedLocales, result.Issues, result.Dir, result.Version, result.DryRun, result.SideDataRecordCount, result.SideDataReportPath)
+					if err := printMetadataKeywordFileResultMarkdown("Keyword Import", result.Results, result.DetectedLocales, result.Issues, result.Dir, result.Version, result.DryRun, result.SideDataRecordCount, result.SideDataReportPath); err != nil {
+						return err
+					}
+					fmt.Println()
+					asc.RenderMarkdown(asc.BulkResultRows(&result.BulkResult))
+					return nil
 				},
 			); err != nil {
 				return err
 			}
 			if !result.Valid {
-				return shared.NewReportedError(fmt.Errorf("metadata keywords import: found %d issue(s)", len(result.Issues)))
+				return shared.NewReportedError(fmt.Errorf("metadata keywords import: found %d issue(s) in %d locale(s)", len(result.Issues), result.Failed))
 			}
 			return nil
 		},
@@ -310,7 +321,7 @@ func MetadataKeywordsPlanCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
-	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
 	output := shared.BindOutputFlags(fs)
 
@@ -332,7 +343,7 @@ Examples:
 			return runMetadataKeywordsPlanLikeCommand(ctx, args, "metadata keywords plan", metadataKeywordsPlanOptions{
 				AppID:    *appID,
 				Version:  *version,
-				Platform: *platform,
+				Platform: shared.ResolvePlatform(*platform, ""),
 				Dir:      *dir,
 				DryRun:   true,
 			}, output)
@@ -346,7 +357,7 @@ func MetadataKeywordsDiffCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
-	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
 	output := shared.BindOutputFlags(fs)
 
@@ -368,7 +379,7 @@ Examples:
 			return runMetadataKeywordsPlanLikeCommand(ctx, args, "metadata keywords diff", metadataKeywordsPlanOptions{
 				AppID:    *appID,
 				Version:  *version,
-				Platform: *platform,
+				Platform: shared.ResolvePlatform(*platform, ""),
 				Dir:      *dir,
 				DryRun:   true,
 			}, output)
@@ -475,7 +486,7 @@ func MetadataKeywordsApplyCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
-	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
 	confirm := fs.Bool("confirm", false, "Confirm remote keyword mutations")
 	output := shared.BindOutputFlags(fs)
@@ -501,7 +512,7 @@ Examples:
 			result, err := executeMetadataKeywordsPlan(ctx, metadataKeywordsPlanOptions{
 				AppID:    *appID,
 				Version:  *version,
-				Platform: *platform,
+				Platform: shared.ResolvePlatform(*platform, ""),
 				Dir:      *dir,
 				DryRun:   false,
 				Apply:    true,
@@ -530,7 +541,7 @@ func MetadataKeywordsSyncCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
-	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
 	input := fs.String("input", "", "Import file path or - for stdin (required)")
 	format := fs.String("format", keywordImportFormatAuto, "Input format: auto, csv, json, text, or astro-csv")
@@ -564,7 +575,7 @@ Examples:
 			if len(args) > 0 {
 				return shared.UsageError("metadata keywords sync does not accept positional arguments")
 			}
-			resolvedAppID, versionValue, dirValue, platformValue, err := validateMetadataKeywordsRemoteInputs(*appID, *version, *dir, *platform)
+			resolvedAppID, versionValue, dirValue, platformValue, err := validateMetadataKeywordsRemoteInputs(*appID, *version, *dir, shared.ResolvePlatform(*platform, ""))
 			if err != nil {
 				return fmt.Errorf("metadata keywords sync: %w", err)
 			}
@@ -603,8 +614,8 @@ Examples:
 				Version:    versionValue,
 				Platform:   platformValue,
 				Dir:        dirValue,
-				DryRun:     *dryRun || !*confirm,
-				Apply:      !*dryRun && *confirm,
+				DryRun:     *dryRun || !*confirm || asc.DryRunEnabled(),
+				Apply:      !*dryRun && *confirm && !asc.DryRunEnabled(),
 				Confirm:    *confirm,
 				LocalState: importPayload.states,
 			})
@@ -720,10 +731,33 @@ func executeMetadataKeywordsImportWithState(opts metadataKeywordsImportOptions)
 			Issues:              issues,
 			SideDataRecordCount: sideDataRecordCount,
 			SideDataReportPath:  sideDataReportPath,
+			BulkResult:          metadataKeywordImportBulkResult(results, opts.DryRun || len(issues) > 0),
 		},
 	}, nil
 }
 
+// metadataKeywordImportBulkResult reports one item per imported locale.
+// Locales with validation issues fail; when any locale fails nothing is
+// written, so the remaining changes are reported as planned only.
+func metadataKeywordImportBulkResult(results []MetadataKeywordFileResult, planned bool) asc.BulkResult {
+	bulk := asc.BulkResult{Total: len(results)}
+	for _, result := range results {
+		item := asc.BulkResultItem{Item: result.Locale, ID: result.File, Detail: result.Action}
+		switch result.Action {
+		case "invalid":
+			bulk.Fail(item, errors.New(result.Reason))
+		case "noop", "skip":
+			bulk.Skip(item, result.Reason)
+		default:
+			if planned {
+				item.Detail = "would " + result.Action
+			}
+			bulk.Succeed(item)
+		}
+	}
+	return bulk
+}
+
 func maybeWriteMetadataKeywordSideDataReport(
 	dir string,
 	version string,
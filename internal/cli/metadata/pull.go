@@ -37,10 +37,10 @@ func MetadataPullCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override for apps with multiple app-infos)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
-	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	dir := fs.String("dir", "", "Output root directory (required)")
 	force := fs.Bool("force", false, "Overwrite existing metadata files in --dir")
-	include := fs.String("include", includeLocalizations, "Included metadata scopes (comma-separated)")
+	include := fs.String("include", includeLocalizations, "Included metadata scopes (comma-separated): localizations, categories, version-attributes, all")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -49,12 +49,17 @@ func MetadataPullCommand() *ffcli.Command {
 		ShortHelp:  "Pull metadata from App Store Connect into canonical files.",
 		LongHelp: `Pull metadata from App Store Connect into canonical files.
 
-Phase 1 supports localization metadata for app-info and app-store versions.
+Scopes (--include):
+  localizations       app-info/<locale>.json and version/<version>/<locale>.json
+  categories          categories.json (app-info category and subcategory IDs)
+  version-attributes  version-attributes/<version>.json (copyright, release type)
+  all                 every scope above
 
 Examples:
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata"
   asc metadata pull --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata"
   asc metadata pull --app "APP_ID" --app-info "APP_INFO_ID" --version "1.2.3" --dir "./metadata"
+  asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --include all
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --force`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -78,7 +83,7 @@ Examples:
 				return shared.UsageError("--dir is required")
 			}
 
-			platformValue := strings.TrimSpace(*platform)
+			platformValue := shared.ResolvePlatform(*platform, "")
 			if platformValue != "" {
 				normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(platformValue)
 				if err != nil {
@@ -122,47 +127,49 @@ Examples:
 				return fmt.Errorf("metadata pull: %w", err)
 			}
 
-			appInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
-			if err != nil {
-				return fmt.Errorf("metadata pull: %w", err)
-			}
-			versionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
-			if err != nil {
-				return fmt.Errorf("metadata pull: %w", err)
-			}
-
-			appInfoByLocale := make(map[string]AppInfoLocalization, len(appInfoItems))
+			appInfoByLocale := make(map[string]AppInfoLocalization)
+			versionByLocale := make(map[string]VersionLocalization)
 			localeSet := make(map[string]struct{})
-			for _, item := range appInfoItems {
-				locale := strings.TrimSpace(item.Attributes.Locale)
-				if locale == "" {
-					continue
+			if hasInclude(includes, includeLocalizations) {
+				appInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
+				versionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
 				}
-				appInfoByLocale[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
-					Name:              item.Attributes.Name,
-					Subtitle:          item.Attributes.Subtitle,
-					PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
-					PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
-					PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
-				})
-				localeSet[locale] = struct{}{}
-			}
 
-			versionByLocale := make(map[string]VersionLocalization, len(versionItems))
-			for _, item := range versionItems {
-				locale := strings.TrimSpace(item.Attributes.Locale)
-				if locale == "" {
-					continue
+				for _, item := range appInfoItems {
+					locale := strings.TrimSpace(item.Attributes.Locale)
+					if locale == "" {
+						continue
+					}
+					appInfoByLocale[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
+						Name:              item.Attributes.Name,
+						Subtitle:          item.Attributes.Subtitle,
+						PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
+						PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
+						PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
+					})
+					localeSet[locale] = struct{}{}
+				}
+
+				for _, item := range versionItems {
+					locale := strings.TrimSpace(item.Attributes.Locale)
+					if locale == "" {
+						continue
+					}
+					versionByLocale[locale] = NormalizeVersionLocalization(VersionLocalization{
+						Description:     item.Attributes.Description,
+						Keywords:        item.Attributes.Keywords,
+						MarketingURL:    item.Attributes.MarketingURL,
+						PromotionalText: item.Attributes.PromotionalText,
+						SupportURL:      item.Attributes.SupportURL,
+						WhatsNew:        item.Attributes.WhatsNew,
+					})
+					localeSet[locale] = struct{}{}
 				}
-				versionByLocale[locale] = NormalizeVersionLocalization(VersionLocalization{
-					Description:     item.Attributes.Description,
-					Keywords:        item.Attributes.Keywords,
-					MarketingURL:    item.Attributes.MarketingURL,
-					PromotionalText: item.Attributes.PromotionalText,
-					SupportURL:      item.Attributes.SupportURL,
-					WhatsNew:        item.Attributes.WhatsNew,
-				})
-				localeSet[locale] = struct{}{}
 			}
 
 			plans, err := BuildWritePlans(
@@ -175,6 +182,33 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("metadata pull: %w", err)
 			}
+
+			if hasInclude(includes, includeCategories) {
+				categories, err := fetchAppInfoCategories(requestCtx, client, appInfoIDValue)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
+				plan, err := buildCategoriesWritePlan(dirValue, categories)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
+				plans = append(plans, plan)
+			}
+			if hasInclude(includes, includeVersionAttributes) {
+				attrs, err := fetchVersionAttributes(requestCtx, client, versionIDValue)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
+				plan, err := buildVersionAttributesWritePlan(dirValue, versionValue, attrs)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
+				plans = append(plans, plan)
+			}
+			sort.Slice(plans, func(i, j int) bool {
+				return plans[i].Path < plans[j].Path
+			})
+
 			if !*force {
 				if err := ensureNoExistingPullTargets(plans); err != nil {
 					return err
@@ -238,17 +272,21 @@ func parseIncludes(value string) ([]string, error) {
 	unique := make(map[string]struct{})
 	for _, item := range includes {
 		normalized := strings.ToLower(strings.TrimSpace(item))
-		if normalized != includeLocalizations {
-			return nil, fmt.Errorf("--include supports only %q", includeLocalizations)
+		if normalized == includeAll {
+			return append([]string(nil), supportedIncludes...), nil
+		}
+		if !hasInclude(supportedIncludes, normalized) {
+			return nil, fmt.Errorf("--include must be one of: %s, %s", strings.Join(supportedIncludes, ", "), includeAll)
 		}
 		unique[normalized] = struct{}{}
 	}
 
 	result := make([]string, 0, len(unique))
-	for item := range unique {
-		result = append(result, item)
+	for _, item := range supportedIncludes {
+		if _, ok := unique[item]; ok {
+			result = append(result, item)
+		}
 	}
-	sort.Strings(result)
 	return result, nil
 }
 
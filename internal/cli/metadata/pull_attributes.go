@@ -0,0 +1,157 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	includeCategories        = "categories"
+	includeVersionAttributes = "version-attributes"
+	includeAll               = "all"
+
+	categoriesFileName       = "categories.json"
+	versionAttributesDirName = "version-attributes"
+)
+
+// supportedIncludes lists the metadata pull scopes in output order.
+var supportedIncludes = []string{includeLocalizations, includeCategories, includeVersionAttributes}
+
+// appInfoCategoryRelationships are the app-info relationships exported to
+// categories.json, in file order.
+var appInfoCategoryRelationships = []string{
+	"primaryCategory",
+	"primarySubcategoryOne",
+	"primarySubcategoryTwo",
+	"secondaryCategory",
+	"secondarySubcategoryOne",
+	"secondarySubcategoryTwo",
+}
+
+// AppInfoCategories is the canonical app-info categories schema.
+type AppInfoCategories struct {
+	PrimaryCategory         string `json:"primaryCategory,omitempty"`
+	PrimarySubcategoryOne   string `json:"primarySubcategoryOne,omitempty"`
+	PrimarySubcategoryTwo   string `json:"primarySubcategoryTwo,omitempty"`
+	SecondaryCategory       string `json:"secondaryCategory,omitempty"`
+	SecondarySubcategoryOne string `json:"secondarySubcategoryOne,omitempty"`
+	SecondarySubcategoryTwo string `json:"secondarySubcategoryTwo,omitempty"`
+}
+
+// VersionAttributes is the canonical app store version attributes schema.
+type VersionAttributes struct {
+	Copyright           string `json:"copyright,omitempty"`
+	ReleaseType         string `json:"releaseType,omitempty"`
+	EarliestReleaseDate string `json:"earliestReleaseDate,omitempty"`
+}
+
+// CategoriesFilePath returns <dir>/categories.json.
+func CategoriesFilePath(rootDir string) (string, error) {
+	base, err := validateRootDir(rootDir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, categoriesFileName), nil
+}
+
+// VersionAttributesFilePath returns <dir>/version-attributes/<version>.json.
+func VersionAttributesFilePath(rootDir, version string) (string, error) {
+	base, err := validateRootDir(rootDir)
+	if err != nil {
+		return "", err
+	}
+	resolvedVersion, err := validatePathSegment("version", version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, versionAttributesDirName, resolvedVersion+".json"), nil
+}
+
+func hasInclude(includes []string, scope string) bool {
+	for _, include := range includes {
+		if include == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchAppInfoCategories reads the category relationships of an app info in a
+// single request by including them.
+func fetchAppInfoCategories(ctx context.Context, client *asc.Client, appInfoID string) (AppInfoCategories, error) {
+	resp, err := client.GetAppInfo(ctx, appInfoID, asc.WithAppInfoInclude(appInfoCategoryRelationships))
+	if err != nil {
+		return AppInfoCategories{}, err
+	}
+	if resp == nil || len(resp.Data.Relationships) == 0 {
+		return AppInfoCategories{}, nil
+	}
+
+	var relationships map[string]struct {
+		Data *asc.ResourceData `json:"data"`
+	}
+	if err := json.Unmarshal(resp.Data.Relationships, &relationships); err != nil {
+		return AppInfoCategories{}, fmt.Errorf("failed to parse app info relationships: %w", err)
+	}
+	id := func(name string) string {
+		relationship, ok := relationships[name]
+		if !ok || relationship.Data == nil {
+			return ""
+		}
+		return strings.TrimSpace(relationship.Data.ID)
+	}
+
+	return AppInfoCategories{
+		PrimaryCategory:         id("primaryCategory"),
+		PrimarySubcategoryOne:   id("primarySubcategoryOne"),
+		PrimarySubcategoryTwo:   id("primarySubcategoryTwo"),
+		SecondaryCategory:       id("secondaryCategory"),
+		SecondarySubcategoryOne: id("secondarySubcategoryOne"),
+		SecondarySubcategoryTwo: id("secondarySubcategoryTwo"),
+	}, nil
+}
+
+func fetchVersionAttributes(ctx context.Context, client *asc.Client, versionID string) (VersionAttributes, error) {
+	resp, err := client.GetAppStoreVersion(ctx, versionID)
+	if err != nil {
+		return VersionAttributes{}, err
+	}
+	if resp == nil {
+		return VersionAttributes{}, nil
+	}
+	attrs := resp.Data.Attributes
+	return VersionAttributes{
+		Copyright:           strings.TrimSpace(attrs.Copyright),
+		ReleaseType:         strings.TrimSpace(attrs.ReleaseType),
+		EarliestReleaseDate: strings.TrimSpace(attrs.EarliestReleaseDate),
+	}, nil
+}
+
+func buildCategoriesWritePlan(rootDir string, categories AppInfoCategories) (WritePlan, error) {
+	path, err := CategoriesFilePath(rootDir)
+	if err != nil {
+		return WritePlan{}, err
+	}
+	data, err := encodeCanonicalJSON(categories)
+	if err != nil {
+		return WritePlan{}, err
+	}
+	return WritePlan{Path: path, Contents: data}, nil
+}
+
+func buildVersionAttributesWritePlan(rootDir, version string, attrs VersionAttributes) (WritePlan, error) {
+	path, err := VersionAttributesFilePath(rootDir, version)
+	if err != nil {
+		return WritePlan{}, err
+	}
+	data, err := encodeCanonicalJSON(attrs)
+	if err != nil {
+		return WritePlan{}, err
+	}
+	return WritePlan{Path: path, Contents: data}, nil
+}
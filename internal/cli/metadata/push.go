@@ -78,6 +78,7 @@ type PushPlanResult struct {
 	Deletes   []PlanItem    `json:"deletes"`
 	APICalls  []PlanAPICall `json:"apiCalls,omitempty"`
 	Actions   []ApplyAction `json:"actions,omitempty"`
+	Assets    *AssetPlan    `json:"assets,omitempty"`
 }
 
 type scopeCallCounts struct {
@@ -115,9 +116,10 @@ func MetadataPushCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
-	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
-	include := fs.String("include", includeLocalizations, "Included metadata scopes (comma-separated)")
+	layout := fs.String("layout", metadataLayoutAuto, "Directory layout: auto, canonical, or fastlane")
+	include := fs.String("include", includeLocalizations, "Included metadata scopes (comma-separated): localizations, categories, version-attributes, all, screenshots, previews")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without mutating App Store Connect")
 	allowDeletes := fs.Bool("allow-deletes", false, "Allow destructive delete operations when applying changes (disables default locale fallback for missing locales)")
 	confirm := fs.Bool("confirm", false, "Confirm destructive operations (required with --allow-deletes)")
@@ -126,8 +128,8 @@ func MetadataPushCommand() *ffcli.Command {
 	return &ffcli.Command{
 		Name:       "push",
 		ShortUsage: "asc metadata push --app \"APP_ID\" --version \"1.2.3\" --dir \"./metadata\" [--app-info \"APP_INFO_ID\"] [--dry-run]",
-		ShortHelp:  "Push metadata changes from canonical files.",
-		LongHelp: `Push metadata changes from canonical files.
+		ShortHelp:  "Push metadata changes from canonical or fastlane files.",
+		LongHelp: `Push metadata changes from canonical or fastlane files.
 
 Examples:
   asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata" --dry-run
@@ -135,11 +137,36 @@ Examples:
   asc metadata push --app "APP_ID" --app-info "APP_INFO_ID" --version "1.2.3" --platform IOS --dir "./metadata" --dry-run
   asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata"
   asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata" --allow-deletes --confirm
+  asc metadata push --app "APP_ID" --version "1.2.3" --dir "./fastlane/metadata" --layout fastlane --dry-run
+  asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata" --include localizations,screenshots,previews --dry-run
+
+Layouts:
+  canonical  app-info/<locale>.json and version/<version>/<locale>.json
+  fastlane   <locale>/description.txt, keywords.txt, release_notes.txt,
+             promotional_text.txt, support_url.txt, marketing_url.txt,
+             name.txt, subtitle.txt, privacy_url.txt (deliver-compatible)
+  auto       canonical when app-info/ or version/ exists, fastlane otherwise
+
+Attribute scopes (--include categories, version-attributes, or all):
+  categories          categories.json (app-info category and subcategory IDs)
+  version-attributes  version-attributes/<version>.json (copyright, release type)
+  A missing file is a no-op. Category changes send every category set in
+  categories.json; version attribute changes send only the changed fields.
+
+Asset scopes (--include, dry-run only, not part of "all"):
+  screenshots  screenshots/<locale>/<display type>/<images>
+  previews     previews/<locale>/<preview type>/<videos>
+  Each local file's MD5 checksum is compared with the sourceFileChecksum of
+  the remote set of the same type. Matching files are reported as unchanged;
+  only the rest would be uploaded. Remote assets with no local match are
+  reported as remote-only.
 
 Notes:
   - default.json fallback is applied only when --allow-deletes is not set.
   - with --allow-deletes, remote locales missing locally are planned as deletes.
-  - omitted fields are treated as no-op; they do not imply deletion.`,
+  - omitted fields are treated as no-op; they do not imply deletion.
+  - fastlane: missing or empty .txt files are no-ops; the default/ directory is the fallback locale.
+  - with only asset scopes in --include, text metadata is not read or planned.`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -150,8 +177,9 @@ Notes:
 				AppID:        *appID,
 				AppInfoID:    *appInfoID,
 				Version:      *version,
-				Platform:     *platform,
+				Platform:     shared.ResolvePlatform(*platform, ""),
 				Dir:          *dir,
+				Layout:       *layout,
 				Include:      *include,
 				DryRun:       *dryRun,
 				AllowDeletes: *allowDeletes,
@@ -1062,13 +1090,17 @@ func buildAPICallSummary(appInfoCounts, versionCounts scopeCallCounts) []PlanAPI
 	appendCalls(appInfoDirName, appInfoCounts)
 	appendCalls(versionDirName, versionCounts)
 
-	sort.Slice(summary, func(i, j int) bool {
-		if summary[i].Scope == summary[j].Scope {
-			return summary[i].Operation < summary[j].Operation
+	sortAPICalls(summary)
+	return summary
+}
+
+func sortAPICalls(calls []PlanAPICall) {
+	sort.Slice(calls, func(i, j int) bool {
+		if calls[i].Scope == calls[j].Scope {
+			return calls[i].Operation < calls[j].Operation
 		}
-		return summary[i].Scope < summary[j].Scope
+		return calls[i].Scope < calls[j].Scope
 	})
-	return summary
 }
 
 func sortPlanItems(items []PlanItem) {
@@ -1098,6 +1130,14 @@ func printPushPlanTable(result PushPlanResult) error {
 		fmt.Println()
 		asc.RenderTable([]string{"scope", "locale", "version", "action", "localizationId"}, buildApplyActionRows(result.Actions))
 	}
+	if result.Assets != nil {
+		fmt.Println()
+		asc.RenderTable([]string{"uploads", "unchanged", "remoteOnly", "uploadBytes", "savedBytes"}, buildAssetPlanSummaryRows(result.Assets))
+		if len(result.Assets.Items) > 0 {
+			fmt.Println()
+			asc.RenderTable([]string{"action", "kind", "locale", "type", "fileName", "fileSize", "checksum", "assetId"}, buildAssetPlanRows(result.Assets))
+		}
+	}
 	return nil
 }
 
@@ -1122,6 +1162,14 @@ func printPushPlanMarkdown(result PushPlanResult) error {
 		fmt.Println()
 		asc.RenderMarkdown([]string{"scope", "locale", "version", "action", "localizationId"}, buildApplyActionRows(result.Actions))
 	}
+	if result.Assets != nil {
+		fmt.Println()
+		asc.RenderMarkdown([]string{"uploads", "unchanged", "remoteOnly", "uploadBytes", "savedBytes"}, buildAssetPlanSummaryRows(result.Assets))
+		if len(result.Assets.Items) > 0 {
+			fmt.Println()
+			asc.RenderMarkdown([]string{"action", "kind", "locale", "type", "fileName", "fileSize", "checksum", "assetId"}, buildAssetPlanRows(result.Assets))
+		}
+	}
 	return nil
 }
 
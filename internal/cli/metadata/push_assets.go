@@ -0,0 +1,371 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/assets"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	includeScreenshots = "screenshots"
+	includePreviews    = "previews"
+
+	screenshotsDirName = "screenshots"
+	previewsDirName    = "previews"
+
+	assetKindScreenshot = "screenshot"
+	assetKindPreview    = "preview"
+
+	assetActionUpload     = "upload"
+	assetActionUnchanged  = "unchanged"
+	assetActionRemoteOnly = "remote-only"
+)
+
+// pushAssetIncludes are push-only scopes that diff media instead of text.
+// They are opt-in and not part of "all", because they hash every local file.
+var pushAssetIncludes = []string{includeScreenshots, includePreviews}
+
+// AssetPlanItem is one screenshot or preview in a push asset diff.
+type AssetPlanItem struct {
+	Kind     string `json:"kind"`
+	Locale   string `json:"locale"`
+	Type     string `json:"type"`
+	Action   string `json:"action"`
+	FileName string `json:"fileName,omitempty"`
+	FilePath string `json:"filePath,omitempty"`
+	FileSize int64  `json:"fileSize,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+	AssetID  string `json:"assetId,omitempty"`
+}
+
+// AssetPlan compares local screenshots and previews with the remote
+// sourceFileChecksum of each asset. Only files whose checksum is not already
+// in the matching remote set are counted as uploads.
+type AssetPlan struct {
+	Uploads     int             `json:"uploads"`
+	Unchanged   int             `json:"unchanged"`
+	RemoteOnly  int             `json:"remoteOnly"`
+	UploadBytes int64           `json:"uploadBytes"`
+	SavedBytes  int64           `json:"savedBytes"`
+	Items       []AssetPlanItem `json:"items"`
+}
+
+// localAssetSet is one <locale>/<type> directory of screenshots or previews.
+type localAssetSet struct {
+	kind   string
+	locale string
+	typ    string
+	files  []string
+}
+
+// remoteAsset is the part of a remote screenshot or preview the diff needs.
+type remoteAsset struct {
+	id       string
+	fileName string
+	checksum string
+}
+
+// parsePushIncludes accepts the metadata pull scopes plus the push-only asset
+// scopes. Asset scopes alone do not imply any metadata scope.
+func parsePushIncludes(value string) ([]string, error) {
+	metadataValues := make([]string, 0)
+	assetValues := make(map[string]struct{})
+	for _, item := range shared.SplitCSV(value) {
+		normalized := strings.ToLower(strings.TrimSpace(item))
+		if hasInclude(pushAssetIncludes, normalized) {
+			assetValues[normalized] = struct{}{}
+			continue
+		}
+		metadataValues = append(metadataValues, normalized)
+	}
+
+	includes := make([]string, 0)
+	if len(metadataValues) > 0 || len(assetValues) == 0 {
+		parsed, err := parseIncludes(strings.Join(metadataValues, ","))
+		if err != nil {
+			supported := append(append([]string(nil), supportedIncludes...), pushAssetIncludes...)
+			return nil, fmt.Errorf("--include must be one of: %s, %s", strings.Join(supported, ", "), includeAll)
+		}
+		includes = parsed
+	}
+	for _, item := range pushAssetIncludes {
+		if _, ok := assetValues[item]; ok {
+			includes = append(includes, item)
+		}
+	}
+	return includes, nil
+}
+
+func countAssetIncludes(includes []string) int {
+	count := 0
+	for _, item := range includes {
+		if hasInclude(pushAssetIncludes, item) {
+			count++
+		}
+	}
+	return count
+}
+
+// collectLocalAssetSets reads <dir>/screenshots/<locale>/<display type>/ and
+// <dir>/previews/<locale>/<preview type>/ for the included asset scopes.
+// A missing scope directory is an error so a typo does not look like
+// "nothing to upload".
+func collectLocalAssetSets(dir string, includes []string) ([]localAssetSet, error) {
+	sets := make([]localAssetSet, 0)
+	if hasInclude(includes, includeScreenshots) {
+		screenshotSets, err := collectLocalAssetSetsFor(filepath.Join(dir, screenshotsDirName), assetKindScreenshot, func(value string) (string, error) {
+			displayType, err := assets.NormalizeScreenshotDisplayType(value)
+			if err != nil {
+				return "", err
+			}
+			return asc.CanonicalScreenshotDisplayTypeForAPI(displayType), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, screenshotSets...)
+	}
+	if hasInclude(includes, includePreviews) {
+		previewSets, err := collectLocalAssetSetsFor(filepath.Join(dir, previewsDirName), assetKindPreview, assets.NormalizePreviewType)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, previewSets...)
+	}
+	return sets, nil
+}
+
+func collectLocalAssetSetsFor(root, kind string, normalizeType func(string) (string, error)) ([]localAssetSet, error) {
+	localeEntries, err := os.ReadDir(root)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, shared.UsageErrorf("--include %ss requires %s", kind, root)
+		}
+		return nil, fmt.Errorf("metadata push: failed to read %s: %w", root, err)
+	}
+
+	sets := make([]localAssetSet, 0)
+	for _, localeEntry := range localeEntries {
+		if !localeEntry.IsDir() || strings.HasPrefix(localeEntry.Name(), ".") {
+			continue
+		}
+		locale, err := validateLocale(localeEntry.Name())
+		if err != nil {
+			return nil, shared.UsageErrorf("invalid %s locale directory %q: %v", kind, localeEntry.Name(), err)
+		}
+		localeDir := filepath.Join(root, localeEntry.Name())
+		typeEntries, err := os.ReadDir(localeDir)
+		if err != nil {
+			return nil, fmt.Errorf("metadata push: failed to read %s: %w", localeDir, err)
+		}
+		seen := make(map[string]string)
+		for _, typeEntry := range typeEntries {
+			if !typeEntry.IsDir() || strings.HasPrefix(typeEntry.Name(), ".") {
+				continue
+			}
+			typeDir := filepath.Join(localeDir, typeEntry.Name())
+			typ, err := normalizeType(typeEntry.Name())
+			if err != nil {
+				return nil, shared.UsageErrorf("%s: %v", typeDir, err)
+			}
+			if previous, ok := seen[typ]; ok {
+				return nil, shared.UsageErrorf("%s: directories %q and %q both map to %s", localeDir, previous, typeEntry.Name(), typ)
+			}
+			seen[typ] = typeEntry.Name()
+
+			files, err := assets.CollectAssetFiles(typeDir)
+			if err != nil {
+				return nil, fmt.Errorf("metadata push: %s: %w", typeDir, err)
+			}
+			sets = append(sets, localAssetSet{kind: kind, locale: locale, typ: typ, files: files})
+		}
+	}
+
+	sort.Slice(sets, func(i, j int) bool {
+		if sets[i].locale == sets[j].locale {
+			return sets[i].typ < sets[j].typ
+		}
+		return sets[i].locale < sets[j].locale
+	})
+	return sets, nil
+}
+
+// buildAssetPlan diffs every local asset set against the remote set of the
+// same type on the version localization for its locale. Locales without a
+// remote localization have no remote assets, so every file is an upload.
+func buildAssetPlan(ctx context.Context, client *asc.Client, localSets []localAssetSet, remoteVersionItems []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) (*AssetPlan, error) {
+	localizationIDs := make(map[string]string, len(remoteVersionItems))
+	for _, item := range remoteVersionItems {
+		if locale := strings.TrimSpace(item.Attributes.Locale); locale != "" {
+			localizationIDs[locale] = item.ID
+		}
+	}
+
+	plan := &AssetPlan{Items: make([]AssetPlanItem, 0)}
+	for _, localSet := range localSets {
+		remote, err := fetchRemoteAssets(ctx, client, localSet, localizationIDs[localSet.locale])
+		if err != nil {
+			return nil, fmt.Errorf("%ss %s/%s: %w", localSet.kind, localSet.locale, localSet.typ, err)
+		}
+		if err := planAssetSet(plan, localSet, remote); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+func fetchRemoteAssets(ctx context.Context, client *asc.Client, localSet localAssetSet, localizationID string) ([]remoteAsset, error) {
+	if localizationID == "" {
+		return nil, nil
+	}
+
+	remote := make([]remoteAsset, 0)
+	switch localSet.kind {
+	case assetKindScreenshot:
+		sets, err := client.GetAppScreenshotSets(ctx, localizationID)
+		if err != nil {
+			return nil, err
+		}
+		for _, set := range sets.Data {
+			if !strings.EqualFold(set.Attributes.ScreenshotDisplayType, localSet.typ) {
+				continue
+			}
+			screenshots, err := client.GetAppScreenshots(ctx, set.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, screenshot := range screenshots.Data {
+				remote = append(remote, remoteAsset{
+					id:       screenshot.ID,
+					fileName: strings.TrimSpace(screenshot.Attributes.FileName),
+					checksum: strings.TrimSpace(screenshot.Attributes.SourceFileChecksum),
+				})
+			}
+		}
+	case assetKindPreview:
+		sets, err := client.GetAppPreviewSets(ctx, localizationID)
+		if err != nil {
+			return nil, err
+		}
+		for _, set := range sets.Data {
+			if !strings.EqualFold(set.Attributes.PreviewType, localSet.typ) {
+				continue
+			}
+			previews, err := client.GetAppPreviews(ctx, set.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, preview := range previews.Data {
+				remote = append(remote, remoteAsset{
+					id:       preview.ID,
+					fileName: strings.TrimSpace(preview.Attributes.FileName),
+					checksum: strings.TrimSpace(preview.Attributes.SourceFileChecksum),
+				})
+			}
+		}
+	}
+	return remote, nil
+}
+
+// planAssetSet matches local files to remote assets by checksum. Each remote
+// asset matches at most one local file, so duplicates are still uploaded.
+func planAssetSet(plan *AssetPlan, localSet localAssetSet, remote []remoteAsset) error {
+	remaining := make(map[string][]remoteAsset)
+	for _, asset := range remote {
+		if asset.checksum == "" {
+			continue
+		}
+		remaining[asset.checksum] = append(remaining[asset.checksum], asset)
+	}
+
+	matched := make(map[string]struct{})
+	for _, filePath := range localSet.files {
+		checksum, err := assets.ComputeFileChecksum(filePath)
+		if err != nil {
+			return fmt.Errorf("metadata push: checksum %s: %w", filePath, err)
+		}
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return fmt.Errorf("metadata push: %w", err)
+		}
+
+		item := AssetPlanItem{
+			Kind:     localSet.kind,
+			Locale:   localSet.locale,
+			Type:     localSet.typ,
+			FileName: filepath.Base(filePath),
+			FilePath: filePath,
+			FileSize: info.Size(),
+			Checksum: checksum,
+		}
+		if matches := remaining[checksum]; len(matches) > 0 {
+			remaining[checksum] = matches[1:]
+			matched[matches[0].id] = struct{}{}
+			item.Action = assetActionUnchanged
+			item.AssetID = matches[0].id
+			plan.Unchanged++
+			plan.SavedBytes += item.FileSize
+		} else {
+			item.Action = assetActionUpload
+			plan.Uploads++
+			plan.UploadBytes += item.FileSize
+		}
+		plan.Items = append(plan.Items, item)
+	}
+
+	for _, asset := range remote {
+		if _, ok := matched[asset.id]; ok {
+			continue
+		}
+		plan.Items = append(plan.Items, AssetPlanItem{
+			Kind:     localSet.kind,
+			Locale:   localSet.locale,
+			Type:     localSet.typ,
+			Action:   assetActionRemoteOnly,
+			FileName: asset.fileName,
+			Checksum: asset.checksum,
+			AssetID:  asset.id,
+		})
+		plan.RemoteOnly++
+	}
+	return nil
+}
+
+func buildAssetPlanRows(plan *AssetPlan) [][]string {
+	rows := make([][]string, 0, len(plan.Items))
+	for _, item := range plan.Items {
+		size := ""
+		if item.FileSize > 0 {
+			size = fmt.Sprintf("%d", item.FileSize)
+		}
+		rows = append(rows, []string{
+			item.Action,
+			item.Kind,
+			item.Locale,
+			item.Type,
+			item.FileName,
+			size,
+			item.Checksum,
+			item.AssetID,
+		})
+	}
+	return rows
+}
+
+func buildAssetPlanSummaryRows(plan *AssetPlan) [][]string {
+	return [][]string{{
+		fmt.Sprintf("%d", plan.Uploads),
+		fmt.Sprintf("%d", plan.Unchanged),
+		fmt.Sprintf("%d", plan.RemoteOnly),
+		fmt.Sprintf("%d", plan.UploadBytes),
+		fmt.Sprintf("%d", plan.SavedBytes),
+	}}
+}
@@ -0,0 +1,179 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// versionAttributePlanFields are the version-attributes/<version>.json fields,
+// in file order.
+var versionAttributePlanFields = []string{
+	"copyright",
+	"releaseType",
+	"earliestReleaseDate",
+}
+
+// loadLocalCategories reads the set fields of <dir>/categories.json. A missing
+// file is a no-op, like an omitted field.
+func loadLocalCategories(dir string) (map[string]string, error) {
+	path, err := CategoriesFilePath(dir)
+	if err != nil {
+		return nil, shared.UsageError(err.Error())
+	}
+	return readAttributeFieldsFromFile(path, appInfoCategoryRelationships)
+}
+
+// loadLocalVersionAttributes reads the set fields of
+// <dir>/version-attributes/<version>.json. A missing file is a no-op.
+func loadLocalVersionAttributes(dir, version string) (map[string]string, error) {
+	path, err := VersionAttributesFilePath(dir, version)
+	if err != nil {
+		return nil, shared.UsageError(err.Error())
+	}
+	return readAttributeFieldsFromFile(path, versionAttributePlanFields)
+}
+
+func readAttributeFieldsFromFile(path string, fields []string) (map[string]string, error) {
+	data, err := readFileNoFollow(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("metadata push: failed to read %s: %w", path, err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := decodeStrictJSON(data, &raw); err != nil {
+		return nil, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+	}
+	setFields := make(map[string]string, len(raw))
+	for key, rawValue := range raw {
+		canonicalKey, err := canonicalStringFieldPatchKey(key, fields)
+		if err != nil {
+			return nil, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+		}
+		if _, exists := setFields[canonicalKey]; exists {
+			return nil, shared.UsageErrorf("invalid metadata schema in %s: json: duplicate field %q", path, canonicalKey)
+		}
+		value, err := decodeStringFieldPatch(canonicalKey, rawValue)
+		if err != nil {
+			return nil, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+		}
+		setFields[canonicalKey] = value
+	}
+	return setFields, nil
+}
+
+func categoriesToFieldMap(categories AppInfoCategories) map[string]string {
+	return nonEmptyFieldMap(map[string]string{
+		"primaryCategory":         categories.PrimaryCategory,
+		"primarySubcategoryOne":   categories.PrimarySubcategoryOne,
+		"primarySubcategoryTwo":   categories.PrimarySubcategoryTwo,
+		"secondaryCategory":       categories.SecondaryCategory,
+		"secondarySubcategoryOne": categories.SecondarySubcategoryOne,
+		"secondarySubcategoryTwo": categories.SecondarySubcategoryTwo,
+	})
+}
+
+func versionAttributesToFieldMap(attrs VersionAttributes) map[string]string {
+	return nonEmptyFieldMap(map[string]string{
+		"copyright":           attrs.Copyright,
+		"releaseType":         attrs.ReleaseType,
+		"earliestReleaseDate": attrs.EarliestReleaseDate,
+	})
+}
+
+func nonEmptyFieldMap(values map[string]string) map[string]string {
+	result := make(map[string]string, len(values))
+	for field, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result[field] = value
+		}
+	}
+	return result
+}
+
+// buildAttributePlan diffs the fields of a single resource. Fields omitted
+// locally are a no-op, so this scope never plans deletes.
+func buildAttributePlan(scope, version string, fields []string, local, remote map[string]string) ([]PlanItem, []PlanItem) {
+	adds := make([]PlanItem, 0)
+	updates := make([]PlanItem, 0)
+	for _, field := range fields {
+		localValue, localHasField := local[field]
+		if !localHasField {
+			continue
+		}
+		remoteValue, remoteHasField := remote[field]
+		item := PlanItem{
+			Key:     buildAttributePlanKey(scope, version, field),
+			Scope:   scope,
+			Version: version,
+			Field:   field,
+		}
+		switch {
+		case !remoteHasField:
+			item.Reason = "field exists locally but not remotely"
+			item.To = localValue
+			adds = append(adds, item)
+		case remoteValue != localValue:
+			item.Reason = "field value differs"
+			item.From = remoteValue
+			item.To = localValue
+			updates = append(updates, item)
+		}
+	}
+	return adds, updates
+}
+
+func buildAttributePlanKey(scope, version, field string) string {
+	if version == "" {
+		return fmt.Sprintf("%s:%s", scope, field)
+	}
+	return fmt.Sprintf("%s:%s:%s", scope, version, field)
+}
+
+// applyCategoriesChanges sends every locally set category, not only the
+// changed ones, because subcategories are validated against their category.
+func applyCategoriesChanges(ctx context.Context, client *asc.Client, appInfoID string, local map[string]string) (ApplyAction, error) {
+	_, err := client.UpdateAppInfoCategories(
+		ctx,
+		appInfoID,
+		local["primaryCategory"],
+		local["secondaryCategory"],
+		local["primarySubcategoryOne"],
+		local["primarySubcategoryTwo"],
+		local["secondarySubcategoryOne"],
+		local["secondarySubcategoryTwo"],
+	)
+	if err != nil {
+		return ApplyAction{}, fmt.Errorf("update categories: %w", err)
+	}
+	return ApplyAction{Scope: includeCategories, Action: "update"}, nil
+}
+
+// applyVersionAttributeChanges sends only the fields the plan changes.
+func applyVersionAttributeChanges(ctx context.Context, client *asc.Client, versionID, version string, changes []PlanItem) (ApplyAction, error) {
+	attrs := asc.AppStoreVersionUpdateAttributes{}
+	for _, change := range changes {
+		value := change.To
+		switch change.Field {
+		case "copyright":
+			attrs.Copyright = &value
+		case "releaseType":
+			attrs.ReleaseType = &value
+		case "earliestReleaseDate":
+			attrs.EarliestReleaseDate = &value
+		}
+	}
+	if _, err := client.UpdateAppStoreVersion(ctx, versionID, attrs); err != nil {
+		return ApplyAction{}, fmt.Errorf("update version attributes: %w", err)
+	}
+	return ApplyAction{Scope: includeVersionAttributes, Version: version, Action: "update"}, nil
+}
@@ -218,3 +218,29 @@ func TestReadVersionLocalizationPatchAcceptsCaseInsensitiveKeys(t *testing.T) {
 		t.Fatalf("expected canonical field key whatsNew in setFields, got %+v", patch.setFields)
 	}
 }
+
+func TestParsePushIncludesKeepsAssetScopesOutOfAll(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "", want: "localizations"},
+		{value: "all", want: "localizations,categories,version-attributes"},
+		{value: "screenshots", want: "screenshots"},
+		{value: "Previews,localizations,screenshots", want: "localizations,screenshots,previews"},
+		{value: "all,previews", want: "localizations,categories,version-attributes,previews"},
+	}
+	for _, test := range tests {
+		got, err := parsePushIncludes(test.value)
+		if err != nil {
+			t.Fatalf("parsePushIncludes(%q) error: %v", test.value, err)
+		}
+		if strings.Join(got, ",") != test.want {
+			t.Fatalf("parsePushIncludes(%q) = %v, want %s", test.value, got, test.want)
+		}
+	}
+
+	if _, err := parsePushIncludes("videos"); err == nil || !strings.Contains(err.Error(), "screenshots, previews") {
+		t.Fatalf("expected error listing asset scopes, got %v", err)
+	}
+}
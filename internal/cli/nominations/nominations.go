@@ -300,8 +300,8 @@ func NominationsCreateCommand() *ffcli.Command {
 	nomType := fs.String("type", "", "Nomination type (required): "+strings.Join(nominationTypeList(), ", "))
 	description := fs.String("description", "", "Nomination description (required)")
 	submitted := fs.Bool("submitted", false, "Submit nomination now (true/false)")
-	publishStartDate := fs.String("publish-start-date", "", "Publish start date (RFC3339, required)")
-	publishEndDate := fs.String("publish-end-date", "", "Publish end date (RFC3339)")
+	publishStartDate := fs.String("publish-start-date", "", "Publish start date (YYYY-MM-DD or RFC3339, required)")
+	publishEndDate := fs.String("publish-end-date", "", "Publish end date (YYYY-MM-DD or RFC3339)")
 	deviceFamilies := fs.String("device-families", "", "Device families, comma-separated: "+strings.Join(nominationDeviceFamilyList(), ", "))
 	locales := fs.String("locales", "", "Locales, comma-separated")
 	supplementalMaterialsURIs := fs.String("supplemental-materials-uris", "", "Supplemental material URIs, comma-separated")
@@ -315,13 +315,14 @@ func NominationsCreateCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "create",
-		ShortUsage: "asc nominations create --app APP_ID --name NAME --type TYPE --description DESC --submitted [true|false] --publish-start-date RFC3339 [flags]",
+		ShortUsage: "asc nominations create --app APP_ID --name NAME --type TYPE --description DESC --submitted [true|false] --publish-start-date DATE [flags]",
 		ShortHelp:  "Create a featuring nomination.",
 		LongHelp: `Create a featuring nomination.
 
 Examples:
   asc nominations create --app "APP_ID" --name "Launch" --type APP_LAUNCH --description "New launch" --submitted=false --publish-start-date "2026-02-01T08:00:00Z"
-  asc nominations create --app "APP_ID" --name "Update" --type APP_ENHANCEMENTS --description "Major update" --submitted=true --publish-start-date "2026-03-01T08:00:00Z" --publish-end-date "2026-04-01T08:00:00Z"`,
+  asc nominations create --app "APP_ID" --name "Update" --type APP_ENHANCEMENTS --description "Major update" --submitted=true --publish-start-date "2026-03-01T08:00:00Z" --publish-end-date "2026-04-01T08:00:00Z"
+  asc nominations create --app "APP_ID,OTHER_APP_ID" --name "Bundle launch" --type NEW_CONTENT --description "Cross-app event" --notes "Press embargo until launch" --publish-start-date "2026-05-01"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -456,8 +457,8 @@ func NominationsUpdateCommand() *ffcli.Command {
 	description := fs.String("description", "", "Nomination description")
 	submitted := fs.Bool("submitted", false, "Submit nomination now (true/false)")
 	archived := fs.Bool("archived", false, "Archive nomination (true/false)")
-	publishStartDate := fs.String("publish-start-date", "", "Publish start date (RFC3339)")
-	publishEndDate := fs.String("publish-end-date", "", "Publish end date (RFC3339)")
+	publishStartDate := fs.String("publish-start-date", "", "Publish start date (YYYY-MM-DD or RFC3339)")
+	publishEndDate := fs.String("publish-end-date", "", "Publish end date (YYYY-MM-DD or RFC3339)")
 	deviceFamilies := fs.String("device-families", "", "Device families, comma-separated: "+strings.Join(nominationDeviceFamilyList(), ", "))
 	locales := fs.String("locales", "", "Locales, comma-separated")
 	supplementalMaterialsURIs := fs.String("supplemental-materials-uris", "", "Supplemental material URIs, comma-separated")
@@ -512,7 +513,7 @@ Examples:
 				visited["pre-order-enabled"]
 			hasRelationshipUpdates := visited["app"] || visited["in-app-events"] || visited["supported-territories"]
 
-			if !hasAttributeUpdates && !hasRelationshipUpdates {
+			if !hasAttributeUpdates && !hasRelationshipUpdates && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -808,7 +809,11 @@ func normalizeNominationPublishDate(flagName, value string, required bool) (stri
 	if parsed, err := time.Parse(time.RFC3339Nano, trimmed); err == nil {
 		return parsed.Format(time.RFC3339Nano), nil
 	}
-	return "", fmt.Errorf("%s must be in RFC3339 format (e.g., 2026-02-01T08:00:00Z)", flagName)
+	// A bare launch date means the start of that day in UTC.
+	if parsed, err := time.Parse(time.DateOnly, trimmed); err == nil {
+		return parsed.Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("%s must be YYYY-MM-DD or RFC3339 (e.g., 2026-02-01T08:00:00Z)", flagName)
 }
 
 func normalizeNominationDeviceFamilyAttributes(values []string) []asc.DeviceFamily {
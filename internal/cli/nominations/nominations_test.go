@@ -97,6 +97,9 @@ func TestNominationHelpers(t *testing.T) {
 	if _, err := normalizeNominationPublishDate("--publish-start-date", "bad", true); err == nil {
 		t.Fatal("expected date parsing error")
 	}
+	if got, err := normalizeNominationPublishDate("--publish-start-date", "2026-05-01", true); err != nil || got != "2026-05-01T00:00:00Z" {
+		t.Fatalf("expected bare date to normalize to midnight UTC, got %q (%v)", got, err)
+	}
 
 	ids := []string{"app-1", "app-2"}
 	rel := buildNominationRelationshipList(asc.ResourceTypeApps, ids)
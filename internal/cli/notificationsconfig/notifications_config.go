@@ -0,0 +1,233 @@
+package notificationsconfig
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	environmentProduction = "PRODUCTION"
+	environmentSandbox    = "SANDBOX"
+)
+
+// NotificationsConfigCommand returns the notifications-config command group.
+func NotificationsConfigCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notifications-config", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "notifications-config",
+		ShortUsage: "asc notifications-config <subcommand> [flags]",
+		ShortHelp:  "Inspect and rotate App Store Server Notifications URLs.",
+		LongHelp: `Inspect and rotate App Store Server Notifications URLs.
+
+Each app has one production and one sandbox endpoint that App Store Server
+Notifications are sent to, plus the notification version (V1 or V2) each
+endpoint receives.
+
+Examples:
+  asc notifications-config get --app "APP_ID"
+  asc notifications-config set --app "APP_ID" --environment sandbox --url "https://example.com/asn/sandbox"
+  asc notifications-config set --app "APP_ID" --environment production --url "https://example.com/asn" --version V2`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			NotificationsConfigGetCommand(),
+			NotificationsConfigSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// NotificationsConfigGetCommand returns the notifications-config get subcommand.
+func NotificationsConfigGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notifications-config get", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	environment := fs.String("environment", "", "Only show one environment: production or sandbox")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc notifications-config get --app \"APP_ID\" [--environment production|sandbox]",
+		ShortHelp:  "Show the server notification URLs for an app.",
+		LongHelp: `Show the server notification URLs for an app.
+
+Examples:
+  asc notifications-config get --app "APP_ID"
+  asc notifications-config get --app "APP_ID" --environment sandbox --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			env := ""
+			if strings.TrimSpace(*environment) != "" {
+				normalized, err := normalizeEnvironment(*environment)
+				if err != nil {
+					return err
+				}
+				env = normalized
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("notifications-config get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			app, err := client.GetApp(requestCtx, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("notifications-config get: %w", err)
+			}
+
+			return shared.PrintOutput(buildResult(resolvedAppID, app.Data.Attributes, env), *output.Output, *output.Pretty)
+		},
+	}
+}
+
+// NotificationsConfigSetCommand returns the notifications-config set subcommand.
+func NotificationsConfigSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notifications-config set", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	environment := fs.String("environment", "", "Environment to update: production or sandbox")
+	notificationURL := fs.String("url", "", "HTTPS URL that receives App Store Server Notifications")
+	version := fs.String("version", "", "Notification version: V1 or V2")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc notifications-config set --app \"APP_ID\" --environment production|sandbox [--url URL] [--version V1|V2]",
+		ShortHelp:  "Update the server notification URL or version for one environment.",
+		LongHelp: `Update the server notification URL or version for one environment.
+
+Only the selected environment is changed. Pass --url, --version, or both.
+Use --dry-run to preview the request before rotating a production endpoint.
+
+Examples:
+  asc notifications-config set --app "APP_ID" --environment sandbox --url "https://example.com/asn/sandbox"
+  asc notifications-config set --app "APP_ID" --environment production --url "https://example.com/asn" --version V2
+  asc notifications-config set --app "APP_ID" --environment production --url "https://example.com/asn" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*environment) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --environment is required")
+				return flag.ErrHelp
+			}
+			env, err := normalizeEnvironment(*environment)
+			if err != nil {
+				return err
+			}
+
+			urlValue := strings.TrimSpace(*notificationURL)
+			versionValue := strings.ToUpper(strings.TrimSpace(*version))
+			if urlValue == "" && versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --url or --version is required")
+				return flag.ErrHelp
+			}
+			if urlValue != "" {
+				if err := validateNotificationURL(urlValue); err != nil {
+					return err
+				}
+			}
+			if versionValue != "" && versionValue != "V1" && versionValue != "V2" {
+				return shared.UsageError("--version must be V1 or V2")
+			}
+
+			attrs := asc.AppUpdateAttributes{}
+			switch env {
+			case environmentProduction:
+				if urlValue != "" {
+					attrs.SubscriptionStatusURL = &urlValue
+				}
+				if versionValue != "" {
+					attrs.SubscriptionStatusURLVersion = &versionValue
+				}
+			case environmentSandbox:
+				if urlValue != "" {
+					attrs.SubscriptionStatusURLForSandbox = &urlValue
+				}
+				if versionValue != "" {
+					attrs.SubscriptionStatusURLVersionForSandbox = &versionValue
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("notifications-config set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			app, err := client.UpdateApp(requestCtx, resolvedAppID, attrs)
+			if err != nil {
+				return fmt.Errorf("notifications-config set: %w", err)
+			}
+
+			return shared.PrintOutput(buildResult(resolvedAppID, app.Data.Attributes, env), *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func normalizeEnvironment(value string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case environmentProduction:
+		return environmentProduction, nil
+	case environmentSandbox:
+		return environmentSandbox, nil
+	default:
+		return "", shared.UsageError("--environment must be production or sandbox")
+	}
+}
+
+// validateNotificationURL enforces what App Store Connect accepts: an absolute
+// HTTPS URL with a host.
+func validateNotificationURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || !strings.EqualFold(parsed.Scheme, "https") || parsed.Host == "" {
+		return shared.UsageErrorf("--url must be an absolute https URL, got %q", value)
+	}
+	return nil
+}
+
+func buildResult(appID string, attrs asc.AppAttributes, environment string) *asc.NotificationsConfigResult {
+	result := &asc.NotificationsConfigResult{AppID: appID, Environments: []asc.NotificationsConfigEnvironment{}}
+	if environment == "" || environment == environmentProduction {
+		result.Environments = append(result.Environments, asc.NotificationsConfigEnvironment{
+			Environment: environmentProduction,
+			URL:         attrs.SubscriptionStatusURL,
+			Version:     attrs.SubscriptionStatusURLVersion,
+		})
+	}
+	if environment == "" || environment == environmentSandbox {
+		result.Environments = append(result.Environments, asc.NotificationsConfigEnvironment{
+			Environment: environmentSandbox,
+			URL:         attrs.SubscriptionStatusURLForSandbox,
+			Version:     attrs.SubscriptionStatusURLVersionForSandbox,
+		})
+	}
+	return result
+}
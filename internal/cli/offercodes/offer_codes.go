@@ -2,9 +2,12 @@ package offercodes
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -25,6 +28,7 @@ func OfferCodesGenerateCommand() *ffcli.Command {
 	quantity := fs.Int("quantity", 0, "Number of one-time use codes to generate (required)")
 	expirationDate := fs.String("expiration-date", "", "Expiration date (YYYY-MM-DD) (required)")
 	outputPath := fs.String("output", "", "Output file path for offer codes (one per line)")
+	csvOutput := fs.Bool("csv", false, "Write --output as CSV (batch_id,code) instead of one code per line")
 	output := shared.BindMetadataOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -33,9 +37,14 @@ func OfferCodesGenerateCommand() *ffcli.Command {
 		ShortHelp:  "Generate one-time use offer codes for a subscription offer.",
 		LongHelp: `Generate one-time use offer codes for a subscription offer.
 
+Offer codes replace app promo codes, which the App Store Connect API does not
+expose. Use --output to save the generated codes, adding --csv for a
+spreadsheet-friendly export.
+
 Examples:
   asc offer-codes generate --offer-code-id "OFFER_CODE_ID" --quantity 10 --expiration-date "2026-02-01"
-  asc offer-codes generate --offer-code-id "OFFER_CODE_ID" --quantity 10 --expiration-date "2026-02-01" --output "./offer-codes.txt"`,
+  asc offer-codes generate --offer-code-id "OFFER_CODE_ID" --quantity 10 --expiration-date "2026-02-01" --output "./offer-codes.txt"
+  asc offer-codes generate --offer-code-id "OFFER_CODE_ID" --quantity 50 --expiration-date "2026-02-01" --output "./offer-codes.csv" --csv`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -48,6 +57,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --quantity is required")
 				return flag.ErrHelp
 			}
+			if *csvOutput && strings.TrimSpace(*outputPath) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --csv requires --output")
+				return flag.ErrHelp
+			}
 			normalizedExpirationDate, err := normalizeOfferCodeExpirationDate(*expirationDate)
 			if err != nil {
 				fmt.Fprintln(os.Stderr, "Error:", err)
@@ -96,7 +109,7 @@ Examples:
 						writeErr = fmt.Errorf("offer-codes generate: failed to fetch values: %w", err)
 					} else if len(codes) == 0 {
 						writeErr = fmt.Errorf("offer-codes generate: no codes returned to write")
-					} else if err := writeOfferCodesFile(*outputPath, codes); err != nil {
+					} else if err := writeOfferCodesFile(*outputPath, offerCodeExport{BatchID: batchID, Codes: codes, CSV: *csvOutput}); err != nil {
 						writeErr = fmt.Errorf("offer-codes generate: %w", err)
 					}
 				}
@@ -119,6 +132,7 @@ func OfferCodesValuesCommand() *ffcli.Command {
 
 	id := fs.String("batch-id", "", "One-time use offer code batch ID (required)")
 	outputPath := fs.String("output", "", "Output file path for offer codes (one per line)")
+	csvOutput := fs.Bool("csv", false, "Print or write codes as CSV (batch_id,code)")
 
 	return &ffcli.Command{
 		Name:       "values",
@@ -128,7 +142,8 @@ func OfferCodesValuesCommand() *ffcli.Command {
 
 Examples:
   asc offer-codes values --batch-id "ONE_TIME_USE_CODE_ID"
-  asc offer-codes values --batch-id "ONE_TIME_USE_CODE_ID" --output "./offer-codes.txt"`,
+  asc offer-codes values --batch-id "ONE_TIME_USE_CODE_ID" --output "./offer-codes.txt"
+  asc offer-codes values --batch-id "ONE_TIME_USE_CODE_ID" --output "./offer-codes.csv" --csv`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -155,22 +170,13 @@ Examples:
 			}
 
 			if strings.TrimSpace(*outputPath) != "" {
-				if err := writeOfferCodesFile(*outputPath, codes); err != nil {
+				if err := writeOfferCodesFile(*outputPath, offerCodeExport{BatchID: trimmedID, Codes: codes, CSV: *csvOutput}); err != nil {
 					return fmt.Errorf("offer-codes values: %w", err)
 				}
 				return nil
 			}
 
-			for _, code := range codes {
-				trimmed := strings.TrimSpace(code)
-				if trimmed == "" {
-					continue
-				}
-				if _, err := fmt.Fprintln(os.Stdout, trimmed); err != nil {
-					return err
-				}
-			}
-			return nil
+			return writeOfferCodes(os.Stdout, offerCodeExport{BatchID: trimmedID, Codes: codes, CSV: *csvOutput})
 		},
 	}
 }
@@ -179,7 +185,16 @@ func normalizeOfferCodeExpirationDate(value string) (string, error) {
 	return shared.NormalizeDate(value, "--expiration-date")
 }
 
-func writeOfferCodesFile(path string, codes []string) error {
+// offerCodeExport describes how a batch of one-time use codes is written.
+type offerCodeExport struct {
+	BatchID string
+	Codes   []string
+	CSV     bool
+	// RedeemAppID adds a redeem_url column to CSV exports when set.
+	RedeemAppID string
+}
+
+func writeOfferCodesFile(path string, export offerCodeExport) error {
 	path = strings.TrimSpace(path)
 	if path == "" {
 		return nil
@@ -196,14 +211,56 @@ func writeOfferCodesFile(path string, codes []string) error {
 	}
 	defer file.Close()
 
-	for _, code := range codes {
+	if err := writeOfferCodes(file, export); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// writeOfferCodes writes one code per line, or a batch_id,code[,redeem_url]
+// CSV with a header row when export.CSV is set. Blank values are skipped in
+// both formats.
+func writeOfferCodes(w io.Writer, export offerCodeExport) error {
+	if !export.CSV {
+		for _, code := range export.Codes {
+			trimmed := strings.TrimSpace(code)
+			if trimmed == "" {
+				continue
+			}
+			if _, err := fmt.Fprintln(w, trimmed); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	header := []string{"batch_id", "code"}
+	if export.RedeemAppID != "" {
+		header = append(header, "redeem_url")
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, code := range export.Codes {
 		trimmed := strings.TrimSpace(code)
 		if trimmed == "" {
 			continue
 		}
-		if _, err := fmt.Fprintln(file, trimmed); err != nil {
+		record := []string{export.BatchID, trimmed}
+		if export.RedeemAppID != "" {
+			record = append(record, offerCodeRedeemURL(export.RedeemAppID, trimmed))
+		}
+		if err := writer.Write(record); err != nil {
 			return err
 		}
 	}
-	return file.Sync()
+	writer.Flush()
+	return writer.Error()
+}
+
+// offerCodeRedeemURL returns the App Store link that opens the redemption
+// sheet for code, as documented for offer codes.
+func offerCodeRedeemURL(appID, code string) string {
+	return "https://apps.apple.com/redeem?ctx=offercodes&id=" + url.QueryEscape(appID) + "&code=" + url.QueryEscape(code)
 }
@@ -0,0 +1,139 @@
+package offercodes
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type oneTimeCodesDownloadResult struct {
+	BatchID     string `json:"batchId"`
+	OutputFile  string `json:"outputFile"`
+	Codes       int    `json:"codes"`
+	RedeemAppID string `json:"redeemAppId,omitempty"`
+}
+
+// OfferCodesOneTimeCodesUpdateCommand returns the one-time use code batch update subcommand.
+func OfferCodesOneTimeCodesUpdateCommand() *ffcli.Command {
+	return newActiveUpdateCommand(activeUpdateCommandConfig{
+		FlagSetName: "one-time-codes update",
+		Name:        "update",
+		ShortUsage:  "asc offer-codes one-time-codes update [flags]",
+		ShortHelp:   "Activate or deactivate a one-time use code batch.",
+		LongHelp: `Activate or deactivate a one-time use code batch.
+
+Deactivated codes can no longer be redeemed.
+
+Examples:
+  asc offer-codes one-time-codes update --batch-id "ONE_TIME_USE_CODE_ID" --active false`,
+		IDFlag:      "batch-id",
+		IDUsage:     "One-time use code batch ID (required)",
+		ErrorPrefix: "offer-codes one-time-codes update",
+		Update: func(ctx context.Context, client *asc.Client, id string, active *bool) (any, error) {
+			return client.UpdateSubscriptionOfferCodeOneTimeUseCode(ctx, id, asc.SubscriptionOfferCodeOneTimeUseCodeUpdateAttributes{Active: active})
+		},
+	})
+}
+
+// OfferCodesOneTimeCodesDownloadCommand returns the one-time use code batch download subcommand.
+func OfferCodesOneTimeCodesDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("one-time-codes download", flag.ExitOnError)
+
+	batchID := fs.String("batch-id", "", "One-time use code batch ID (required)")
+	outputPath := fs.String("output-file", "", "CSV file to write (required; must not exist)")
+	redeemURLs := fs.Bool("redeem-urls", false, "Add a redeem_url column (requires --app or ASC_APP_ID)")
+	appID := fs.String("app", "", "App Store Connect app ID, bundle ID, or exact app name for --redeem-urls (or ASC_APP_ID env)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc offer-codes one-time-codes download --batch-id \"ONE_TIME_USE_CODE_ID\" --output-file \"./codes.csv\" [flags]",
+		ShortHelp:  "Download a one-time use code batch as CSV.",
+		LongHelp: `Download a one-time use code batch as CSV.
+
+The file has batch_id and code columns. With --redeem-urls it also has a
+redeem_url column holding the App Store link that opens the redemption sheet
+for each code, ready to paste into emails or campaigns.
+
+Examples:
+  asc offer-codes one-time-codes download --batch-id "ONE_TIME_USE_CODE_ID" --output-file "./codes.csv"
+  asc offer-codes one-time-codes download --batch-id "ONE_TIME_USE_CODE_ID" --output-file "./codes.csv" --redeem-urls --app "APP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*batchID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --batch-id is required")
+				return flag.ErrHelp
+			}
+			path := strings.TrimSpace(*outputPath)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output-file is required")
+				return flag.ErrHelp
+			}
+			appValue := ""
+			if *redeemURLs {
+				appValue = shared.ResolveAppID(*appID)
+				if appValue == "" {
+					fmt.Fprintln(os.Stderr, "Error: --redeem-urls requires --app (or set ASC_APP_ID)")
+					return flag.ErrHelp
+				}
+			} else if strings.TrimSpace(*appID) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is only used with --redeem-urls")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("offer-codes one-time-codes download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if appValue != "" {
+				appValue, err = shared.ResolveAppIDWithLookup(requestCtx, client, appValue)
+				if err != nil {
+					return fmt.Errorf("offer-codes one-time-codes download: %w", err)
+				}
+			}
+
+			codes, err := client.GetSubscriptionOfferCodeOneTimeUseCodeValues(requestCtx, trimmedID)
+			if err != nil {
+				return fmt.Errorf("offer-codes one-time-codes download: failed to fetch: %w", err)
+			}
+			if len(codes) == 0 {
+				return fmt.Errorf("offer-codes one-time-codes download: no codes returned")
+			}
+
+			export := offerCodeExport{BatchID: trimmedID, Codes: codes, CSV: true, RedeemAppID: appValue}
+			if err := writeOfferCodesFile(path, export); err != nil {
+				return fmt.Errorf("offer-codes one-time-codes download: %w", err)
+			}
+
+			result := &oneTimeCodesDownloadResult{
+				BatchID:     trimmedID,
+				OutputFile:  filepath.Clean(path),
+				Codes:       len(codes),
+				RedeemAppID: appValue,
+			}
+			headers := []string{"Batch ID", "Output File", "Codes", "Redeem App ID"}
+			rows := [][]string{{result.BatchID, result.OutputFile, fmt.Sprintf("%d", result.Codes), result.RedeemAppID}}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { asc.RenderTable(headers, rows); return nil },
+				func() error { asc.RenderMarkdown(headers, rows); return nil },
+			)
+		},
+	}
+}
@@ -21,6 +21,7 @@ func PerformanceCommand() *ffcli.Command {
 Examples:
   asc performance metrics list --app "APP_ID"
   asc performance metrics get --build "BUILD_ID"
+  asc performance metrics regressions --app "APP_ID" --metric-type LAUNCH
   asc performance diagnostics list --build "BUILD_ID"
   asc performance diagnostics get --id "SIGNATURE_ID"
   asc performance download --build "BUILD_ID" --output ./metrics.json`,
@@ -25,12 +25,14 @@ func PerformanceMetricsCommand() *ffcli.Command {
 
 Examples:
   asc performance metrics list --app "APP_ID"
-  asc performance metrics get --build "BUILD_ID"`,
+  asc performance metrics get --build "BUILD_ID"
+  asc performance metrics regressions --app "APP_ID" --metric-type LAUNCH`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PerformanceMetricsListCommand(),
 			PerformanceMetricsGetCommand(),
+			PerformanceMetricsRegressionsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -156,6 +158,90 @@ Examples:
 	}
 }
 
+// PerformanceMetricsRegressionsCommand returns the metrics regressions subcommand.
+func PerformanceMetricsRegressionsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metrics regressions", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	buildID := fs.String("build", "", "Build ID to summarize instead of the app")
+	platform := fs.String("platform", "", "Platform filter (IOS)")
+	metricType := fs.String("metric-type", "", "Metric types (comma-separated: "+strings.Join(perfPowerMetricTypeList(), ", ")+")")
+	deviceType := fs.String("device-type", "", "Device types (comma-separated, e.g., iPhone15,2)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "regressions",
+		ShortUsage: "asc performance metrics regressions (--app \"APP_ID\" | --build \"BUILD_ID\") [flags]",
+		ShortHelp:  "Summarize performance/power regressions per device class.",
+		LongHelp: `Summarize performance/power regressions per device class.
+
+Decodes the regression insights in the perfPowerMetrics payload into one row
+per metric, device class, and percentile, with the reference and latest values
+and the percentage change. Rows are sorted by the largest change first.
+
+Examples:
+  asc performance metrics regressions --app "APP_ID"
+  asc performance metrics regressions --app "APP_ID" --metric-type LAUNCH --platform IOS
+  asc performance metrics regressions --build "BUILD_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedBuildID := strings.TrimSpace(*buildID)
+			resolvedAppID := ""
+			if trimmedBuildID == "" {
+				resolvedAppID = shared.ResolveAppID(*appID)
+				if resolvedAppID == "" {
+					fmt.Fprintln(os.Stderr, "Error: --app or --build is required (or set ASC_APP_ID)")
+					return flag.ErrHelp
+				}
+			} else if strings.TrimSpace(*appID) != "" {
+				return shared.UsageError("--app and --build are mutually exclusive")
+			}
+
+			platforms, err := normalizePerfPowerMetricPlatforms(shared.SplitCSVUpper(*platform), "--platform")
+			if err != nil {
+				return fmt.Errorf("performance metrics regressions: %w", err)
+			}
+			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType))
+			if err != nil {
+				return fmt.Errorf("performance metrics regressions: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("performance metrics regressions: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.PerfPowerMetricsOption{
+				asc.WithPerfPowerMetricsPlatforms(platforms),
+				asc.WithPerfPowerMetricsMetricTypes(metricTypes),
+				asc.WithPerfPowerMetricsDeviceTypes(shared.SplitCSV(*deviceType)),
+			}
+			var resp *asc.PerfPowerMetricsResponse
+			if trimmedBuildID != "" {
+				resp, err = client.GetPerfPowerMetricsForBuild(requestCtx, trimmedBuildID, opts...)
+			} else {
+				resp, err = client.GetPerfPowerMetricsForApp(requestCtx, resolvedAppID, opts...)
+			}
+			if err != nil {
+				return fmt.Errorf("performance metrics regressions: %w", err)
+			}
+
+			result, err := asc.SummarizePerfPowerMetricRegressions(resp)
+			if err != nil {
+				return fmt.Errorf("performance metrics regressions: %w", err)
+			}
+			result.AppID = resolvedAppID
+			result.BuildID = trimmedBuildID
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 var perfPowerMetricTypes = map[string]struct{}{
 	string(asc.PerfPowerMetricTypeDisk):        {},
 	string(asc.PerfPowerMetricTypeHang):        {},
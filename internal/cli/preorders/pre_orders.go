@@ -181,7 +181,7 @@ Examples:
 			}
 
 			if availableInNewTerritories.IsSet() {
-				fmt.Fprintln(os.Stderr, "Warning: --available-in-new-territories is deprecated and ignored; pre-orders are now enabled by patching territory availabilities directly.")
+				fmt.Fprintln(shared.WarningWriter(), "Warning: --available-in-new-territories is deprecated and ignored; pre-orders are now enabled by patching territory availabilities directly.")
 			}
 
 			normalizedReleaseDate, err := normalizePreOrderReleaseDate(*releaseDate)
@@ -327,7 +327,7 @@ Examples:
 				attrs.Available = &v
 				hasAttr = true
 			}
-			if !hasAttr {
+			if !hasAttr && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one of --release-date, --pre-order-enabled, or --available is required")
 				return flag.ErrHelp
 			}
@@ -0,0 +1,313 @@
+package pricing
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const pricingExportDateLayout = "2006-01-02"
+
+var pricingExportHeader = []string{"territory", "currency", "customer_price", "proceeds", "price_point_id", "source", "start_date"}
+
+type pricingExportSummary struct {
+	AppID       string `json:"appId"`
+	OutputFile  string `json:"outputFile"`
+	AsOf        string `json:"asOf"`
+	Territories int    `json:"territories"`
+}
+
+type pricingExportEntry struct {
+	Territory    string
+	PricePointID string
+	StartDate    string
+	EndDate      string
+	Manual       bool
+}
+
+type pricingExportPricePoint struct {
+	CustomerPrice string
+	Proceeds      string
+}
+
+// PricingExportCommand returns the pricing export subcommand.
+func PricingExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	outputPath := fs.String("output", "", "Output CSV file path (required)")
+	territory := fs.String("territory", "", "Territory ID(s) to include, comma-separated (default: all)")
+	date := fs.String("date", "", "Export prices in effect on this date (YYYY-MM-DD, default: today)")
+	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc pricing export --app \"APP_ID\" --output \"./prices.csv\" [flags]",
+		ShortHelp:  "Export current app prices and proceeds per territory to CSV.",
+		LongHelp: `Export current app prices and proceeds per territory to CSV.
+
+Reads the app price schedule (manual and automatically equalized prices) and
+writes one row per territory with the price in effect on --date.
+
+CSV format:
+  territory,currency,customer_price,proceeds,price_point_id,source,start_date
+  - source is "manual" or "automatic"
+
+Examples:
+  asc pricing export --app "123456789" --output "./prices.csv"
+  asc pricing export --app "123456789" --output "./prices.csv" --territory "USA,GBR,DEU"
+  asc pricing export --app "123456789" --output "./prices-q3.csv" --date "2026-07-01"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			outputValue := strings.TrimSpace(*outputPath)
+			if outputValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output is required")
+				return flag.ErrHelp
+			}
+			if strings.HasSuffix(outputValue, string(filepath.Separator)) {
+				return shared.UsageError("--output must be a file path")
+			}
+
+			asOf := time.Now().UTC()
+			if value := strings.TrimSpace(*date); value != "" {
+				parsed, err := time.Parse(pricingExportDateLayout, value)
+				if err != nil {
+					return shared.UsageError("--date must be in YYYY-MM-DD format")
+				}
+				asOf = parsed
+			}
+			requestedTerritories := shared.SplitCSV(strings.ToUpper(*territory))
+			territoryFilter := map[string]bool{}
+			for _, value := range requestedTerritories {
+				territoryFilter[value] = true
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("pricing export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			schedule, err := client.GetAppPriceSchedule(requestCtx, resolvedAppID)
+			if err != nil {
+				if asc.IsNotFound(err) {
+					return fmt.Errorf("pricing export: app %q has no price schedule", resolvedAppID)
+				}
+				return fmt.Errorf("pricing export: failed to fetch price schedule: %w", err)
+			}
+			scheduleID := strings.TrimSpace(schedule.Data.ID)
+
+			entries := []pricingExportEntry{}
+			pricePoints := map[string]pricingExportPricePoint{}
+			currencies := map[string]string{}
+			fetchers := []func(context.Context, string, ...asc.AppPriceSchedulePricesOption) (*asc.AppPricesResponse, error){
+				client.GetAppPriceScheduleManualPrices,
+				client.GetAppPriceScheduleAutomaticPrices,
+			}
+			for _, fetch := range fetchers {
+				firstPage, err := fetch(requestCtx, scheduleID,
+					asc.WithAppPriceSchedulePricesInclude([]string{"appPricePoint", "territory"}),
+					asc.WithAppPriceSchedulePricesLimit(200),
+				)
+				if err != nil {
+					return fmt.Errorf("pricing export: failed to fetch prices: %w", err)
+				}
+				err = asc.PaginateEach(requestCtx, firstPage,
+					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+						return fetch(ctx, scheduleID, asc.WithAppPriceSchedulePricesNextURL(nextURL))
+					},
+					func(page asc.PaginatedResponse) error {
+						typed, ok := page.(*asc.AppPricesResponse)
+						if !ok {
+							return fmt.Errorf("unexpected prices response type %T", page)
+						}
+						entries = append(entries, parsePricingExportEntries(typed.Data)...)
+						return collectPricingExportIncluded(typed.Included, pricePoints, currencies)
+					},
+				)
+				if err != nil {
+					return fmt.Errorf("pricing export: %w", err)
+				}
+			}
+
+			current := selectCurrentPricingEntries(entries, asOf)
+			rows := make([][]string, 0, len(current))
+			exported := map[string]bool{}
+			for _, entry := range current {
+				if len(territoryFilter) > 0 && !territoryFilter[entry.Territory] {
+					continue
+				}
+				point, ok := pricePoints[entry.PricePointID]
+				if !ok {
+					return fmt.Errorf("pricing export: price point %q for %s missing from response", entry.PricePointID, entry.Territory)
+				}
+				source := "automatic"
+				if entry.Manual {
+					source = "manual"
+				}
+				rows = append(rows, []string{
+					entry.Territory,
+					currencies[entry.Territory],
+					point.CustomerPrice,
+					point.Proceeds,
+					entry.PricePointID,
+					source,
+					entry.StartDate,
+				})
+				exported[entry.Territory] = true
+			}
+			for _, requested := range requestedTerritories {
+				if !exported[requested] {
+					fmt.Fprintf(shared.WarningWriter(), "Warning: no price in effect for territory %s\n", requested)
+				}
+			}
+
+			var buf bytes.Buffer
+			writer := csv.NewWriter(&buf)
+			if err := writer.Write(pricingExportHeader); err != nil {
+				return fmt.Errorf("pricing export: %w", err)
+			}
+			if err := writer.WriteAll(rows); err != nil {
+				return fmt.Errorf("pricing export: %w", err)
+			}
+			if _, err := shared.WriteFileNoSymlinkOverwrite(outputValue, &buf, 0o644, ".asc-pricing-export-*.csv", ".asc-pricing-export-backup-*"); err != nil {
+				return fmt.Errorf("pricing export: %w", err)
+			}
+
+			summary := &pricingExportSummary{
+				AppID:       resolvedAppID,
+				OutputFile:  filepath.Clean(outputValue),
+				AsOf:        asOf.Format(pricingExportDateLayout),
+				Territories: len(rows),
+			}
+			headers := []string{"App ID", "Output File", "As Of", "Territories"}
+			summaryRows := [][]string{{summary.AppID, summary.OutputFile, summary.AsOf, strconv.Itoa(summary.Territories)}}
+			return shared.PrintOutputWithRenderers(
+				summary,
+				*format.Output,
+				*format.Pretty,
+				func() error { asc.RenderTable(headers, summaryRows); return nil },
+				func() error { asc.RenderMarkdown(headers, summaryRows); return nil },
+			)
+		},
+	}
+}
+
+func parsePricingExportEntries(items []asc.Resource[asc.AppPriceAttributes]) []pricingExportEntry {
+	entries := make([]pricingExportEntry, 0, len(items))
+	for _, item := range items {
+		var relationships struct {
+			AppPricePoint struct {
+				Data asc.ResourceData `json:"data"`
+			} `json:"appPricePoint"`
+			Territory struct {
+				Data asc.ResourceData `json:"data"`
+			} `json:"territory"`
+		}
+		if len(item.Relationships) > 0 {
+			if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
+				continue
+			}
+		}
+		territoryID := strings.ToUpper(strings.TrimSpace(relationships.Territory.Data.ID))
+		pricePointID := strings.TrimSpace(relationships.AppPricePoint.Data.ID)
+		if territoryID == "" || pricePointID == "" {
+			continue
+		}
+		entries = append(entries, pricingExportEntry{
+			Territory:    territoryID,
+			PricePointID: pricePointID,
+			StartDate:    strings.TrimSpace(item.Attributes.StartDate),
+			EndDate:      strings.TrimSpace(item.Attributes.EndDate),
+			Manual:       item.Attributes.Manual,
+		})
+	}
+	return entries
+}
+
+func collectPricingExportIncluded(raw json.RawMessage, pricePoints map[string]pricingExportPricePoint, currencies map[string]string) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	var included []struct {
+		Type       string `json:"type"`
+		ID         string `json:"id"`
+		Attributes struct {
+			CustomerPrice string `json:"customerPrice"`
+			Proceeds      string `json:"proceeds"`
+			Currency      string `json:"currency"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return fmt.Errorf("parse included resources: %w", err)
+	}
+	for _, item := range included {
+		switch asc.ResourceType(item.Type) {
+		case asc.ResourceTypeAppPricePoints:
+			pricePoints[item.ID] = pricingExportPricePoint{
+				CustomerPrice: strings.TrimSpace(item.Attributes.CustomerPrice),
+				Proceeds:      strings.TrimSpace(item.Attributes.Proceeds),
+			}
+		case asc.ResourceTypeTerritories:
+			currencies[strings.ToUpper(item.ID)] = strings.TrimSpace(item.Attributes.Currency)
+		}
+	}
+	return nil
+}
+
+// selectCurrentPricingEntries returns the entry in effect on asOf for each
+// territory, sorted by territory. Manual prices win over automatic ones, and
+// the most recent start date wins among entries of the same kind.
+func selectCurrentPricingEntries(entries []pricingExportEntry, asOf time.Time) []pricingExportEntry {
+	day := asOf.Format(pricingExportDateLayout)
+	byTerritory := map[string]pricingExportEntry{}
+	for _, entry := range entries {
+		if entry.StartDate != "" && entry.StartDate > day {
+			continue
+		}
+		if entry.EndDate != "" && entry.EndDate <= day {
+			continue
+		}
+		existing, ok := byTerritory[entry.Territory]
+		if ok {
+			if existing.Manual && !entry.Manual {
+				continue
+			}
+			if existing.Manual == entry.Manual && existing.StartDate >= entry.StartDate {
+				continue
+			}
+		}
+		byTerritory[entry.Territory] = entry
+	}
+
+	current := make([]pricingExportEntry, 0, len(byTerritory))
+	for _, entry := range byTerritory {
+		current = append(current, entry)
+	}
+	sort.Slice(current, func(i, j int) bool {
+		return current[i].Territory < current[j].Territory
+	})
+	return current
+}
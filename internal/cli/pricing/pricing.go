@@ -29,11 +29,13 @@ Examples:
   asc pricing price-points get --price-point "PRICE_POINT_ID"
   asc pricing price-points equalizations --price-point "PRICE_POINT_ID"
   asc pricing tiers --app "123456789" --territory "USA"
+  asc pricing export --app "123456789" --output "./prices.csv"
   asc pricing schedule get --app "123456789"
   asc pricing schedule get --id "SCHEDULE_ID"
   asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --base-territory "USA" --start-date "2024-03-01"
   asc pricing schedule manual-prices --schedule "SCHEDULE_ID"
   asc pricing schedule automatic-prices --schedule "SCHEDULE_ID"
+  asc pricing schedule base-territory --app "123456789"
   asc pricing availability get --app "123456789"
   asc pricing availability get --id "AVAILABILITY_ID"
   asc pricing availability set --app "123456789" --territory "USA,GBR,DEU" --available true --available-in-new-territories true
@@ -43,6 +45,7 @@ Examples:
 			PricingTerritoriesCommand(),
 			PricingPricePointsCommand(),
 			PricingTiersCommand(),
+			PricingExportCommand(),
 			PricingScheduleCommand(),
 			PricingAvailabilityCommand(),
 		},
@@ -321,13 +324,15 @@ Examples:
   asc pricing schedule get --id "SCHEDULE_ID"
   asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --start-date "2024-03-01"
   asc pricing schedule manual-prices --schedule "SCHEDULE_ID"
-  asc pricing schedule automatic-prices --schedule "SCHEDULE_ID"`,
+  asc pricing schedule automatic-prices --schedule "SCHEDULE_ID"
+  asc pricing schedule base-territory --app "123456789"`,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PricingScheduleGetCommand(),
 			PricingScheduleCreateCommand(),
 			PricingScheduleManualPricesCommand(),
 			PricingScheduleAutomaticPricesCommand(),
+			PricingScheduleBaseTerritoryCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -493,6 +498,72 @@ Examples:
 	}
 }
 
+// PricingScheduleBaseTerritoryCommand returns the schedule base-territory subcommand.
+func PricingScheduleBaseTerritoryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing schedule base-territory", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	scheduleID := fs.String("schedule", "", "App price schedule ID")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "base-territory",
+		ShortUsage: "asc pricing schedule base-territory --app \"APP_ID\" | asc pricing schedule base-territory --schedule \"SCHEDULE_ID\"",
+		ShortHelp:  "Get the base territory for a schedule.",
+		LongHelp: `Get the base territory for a schedule.
+
+The base territory is the territory whose price point other territories are
+equalized from. Use it as --base-territory when creating a new schedule.
+
+Examples:
+  asc pricing schedule base-territory --app "123456789"
+  asc pricing schedule base-territory --schedule "SCHEDULE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			scheduleValue := strings.TrimSpace(*scheduleID)
+			appValue := ""
+			if scheduleValue == "" {
+				appValue = shared.ResolveAppID(*appID)
+			}
+			if scheduleValue == "" && appValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app or --schedule is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if scheduleValue != "" && strings.TrimSpace(*appID) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --schedule and --app are mutually exclusive")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("pricing schedule base-territory: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if scheduleValue == "" {
+				schedule, err := client.GetAppPriceSchedule(requestCtx, appValue)
+				if err != nil {
+					return fmt.Errorf("pricing schedule base-territory: %w", err)
+				}
+				scheduleValue = strings.TrimSpace(schedule.Data.ID)
+				if scheduleValue == "" {
+					return fmt.Errorf("pricing schedule base-territory: app price schedule ID missing from response")
+				}
+			}
+
+			resp, err := client.GetAppPriceScheduleBaseTerritory(requestCtx, scheduleValue)
+			if err != nil {
+				return fmt.Errorf("pricing schedule base-territory: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // PricingAvailabilityCommand returns the availability command group.
 func PricingAvailabilityCommand() *ffcli.Command {
 	return &ffcli.Command{
@@ -95,6 +95,31 @@ func TestPricingScheduleAutomaticPricesCommand_MissingSchedule(t *testing.T) {
 	}
 }
 
+func TestPricingScheduleBaseTerritoryCommand_MissingTarget(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	cmd := PricingScheduleBaseTerritoryCommand()
+
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp when --app and --schedule are missing, got %v", err)
+	}
+}
+
+func TestPricingScheduleBaseTerritoryCommand_MutuallyExclusive(t *testing.T) {
+	cmd := PricingScheduleBaseTerritoryCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--app", "APP_ID", "--schedule", "SCHEDULE_ID"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp when --app and --schedule are both set, got %v", err)
+	}
+}
+
 func TestPricingScheduleCreateCommand_MissingFlags(t *testing.T) {
 	t.Setenv("ASC_APP_ID", "")
 
@@ -265,6 +290,7 @@ func TestPricingCommands_DefaultOutputJSON(t *testing.T) {
 		{"schedule create", PricingScheduleCreateCommand},
 		{"schedule manual-prices", PricingScheduleManualPricesCommand},
 		{"schedule automatic-prices", PricingScheduleAutomaticPricesCommand},
+		{"schedule base-territory", PricingScheduleBaseTerritoryCommand},
 		{"availability get", PricingAvailabilityGetCommand},
 		{"availability territory-availabilities", PricingAvailabilityTerritoryAvailabilitiesCommand},
 		{"availability set", PricingAvailabilitySetCommand},
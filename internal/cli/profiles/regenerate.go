@@ -0,0 +1,200 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ProfilesRegenerateCommand returns the profiles regenerate subcommand.
+func ProfilesRegenerateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("regenerate", flag.ExitOnError)
+
+	id := fs.String("id", "", "Profile ID")
+	certificates := fs.String("certificate", "", "Replacement certificate ID(s), comma-separated (default: keep current)")
+	devices := fs.String("device", "", "Replacement device ID(s), comma-separated (default: keep current)")
+	outputPath := fs.String("output", "", "Write the regenerated .mobileprovision to this path (optional)")
+	confirm := fs.Bool("confirm", false, "Confirm deleting and recreating the profile")
+	output := shared.BindMetadataOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "regenerate",
+		ShortUsage: "asc profiles regenerate --id \"PROFILE_ID\" --confirm [flags]",
+		ShortHelp:  "Regenerate a provisioning profile.",
+		LongHelp: `Regenerate a provisioning profile.
+
+App Store Connect does not allow editing profiles, so regenerating deletes the
+existing profile and creates a new one with the same name, type, and bundle ID.
+Certificates and devices are copied from the existing profile unless replaced
+with --certificate or --device. Use this after registering new devices or
+renewing a certificate.
+
+Examples:
+  asc profiles regenerate --id "PROFILE_ID" --confirm
+  asc profiles regenerate --id "PROFILE_ID" --certificate "CERT_ID" --confirm
+  asc profiles regenerate --id "PROFILE_ID" --device "DEVICE_ID,DEVICE_ID2" --confirm
+  asc profiles regenerate --id "PROFILE_ID" --output "./profile.mobileprovision" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*outputPath)
+			if pathValue != "" {
+				if _, err := os.Lstat(pathValue); err == nil {
+					return shared.UsageErrorf("--output %q already exists", pathValue)
+				} else if !errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("profiles regenerate: %w", err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("profiles regenerate: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			created, result, err := RegenerateProfile(requestCtx, client, idValue, RegenerateOptions{
+				CertificateIDs: shared.SplitCSV(*certificates),
+				DeviceIDs:      shared.SplitCSV(*devices),
+			})
+			if err != nil {
+				return fmt.Errorf("profiles regenerate: %w", err)
+			}
+
+			if pathValue != "" {
+				decoded, err := decodeProfileContent(created.Data.Attributes.ProfileContent)
+				if err != nil {
+					return fmt.Errorf("profiles regenerate: %w", err)
+				}
+				if err := shared.WriteProfileFile(pathValue, decoded); err != nil {
+					return fmt.Errorf("profiles regenerate: %w", err)
+				}
+				result.OutputPath = pathValue
+			}
+
+			return shared.PrintOutput(result, *output.OutputFormat, *output.Pretty)
+		},
+	}
+}
+
+// RegenerateOptions controls how RegenerateProfile rebuilds a profile.
+type RegenerateOptions struct {
+	// CertificateIDs and DeviceIDs replace the profile's current ones when set.
+	CertificateIDs []string
+	DeviceIDs      []string
+	// AddDeviceIDs are appended to the profile's devices.
+	AddDeviceIDs []string
+}
+
+// RegenerateProfile deletes a profile and recreates it with the same name,
+// type, and bundle ID. Profiles cannot be edited in place, so this is how new
+// devices and certificates reach an existing profile.
+func RegenerateProfile(ctx context.Context, client *asc.Client, profileID string, opts RegenerateOptions) (*asc.ProfileResponse, *asc.ProfileRegenerateResult, error) {
+	existing, err := client.GetProfile(ctx, profileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	bundleResp, err := client.GetProfileBundleIDRelationship(ctx, profileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch bundle ID: %w", err)
+	}
+	bundleValue := strings.TrimSpace(bundleResp.Data.ID)
+	if bundleValue == "" {
+		return nil, nil, fmt.Errorf("profile %q has no bundle ID", profileID)
+	}
+
+	certificateIDs := opts.CertificateIDs
+	if len(certificateIDs) == 0 {
+		certificateIDs, err = collectProfileLinkageIDs(ctx, func(ctx context.Context, opts ...asc.LinkagesOption) (*asc.LinkagesResponse, error) {
+			return client.GetProfileCertificatesRelationships(ctx, profileID, opts...)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch certificates: %w", err)
+		}
+		if len(certificateIDs) == 0 {
+			return nil, nil, fmt.Errorf("profile %q has no certificates; pass --certificate", profileID)
+		}
+	}
+	deviceIDs := opts.DeviceIDs
+	if len(deviceIDs) == 0 {
+		deviceIDs, err = collectProfileLinkageIDs(ctx, func(ctx context.Context, opts ...asc.LinkagesOption) (*asc.LinkagesResponse, error) {
+			return client.GetProfileDevicesRelationships(ctx, profileID, opts...)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch devices: %w", err)
+		}
+	}
+	for _, deviceID := range opts.AddDeviceIDs {
+		if !slices.Contains(deviceIDs, deviceID) {
+			deviceIDs = append(deviceIDs, deviceID)
+		}
+	}
+
+	attrs := asc.ProfileCreateAttributes{
+		Name:        existing.Data.Attributes.Name,
+		ProfileType: existing.Data.Attributes.ProfileType,
+	}
+
+	if err := client.DeleteProfile(ctx, profileID); err != nil {
+		return nil, nil, fmt.Errorf("failed to delete: %w", err)
+	}
+	created, err := client.CreateProfile(ctx, attrs, bundleValue, certificateIDs, deviceIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("profile %q was deleted but recreating it failed (recreate with: asc profiles create --name %q --profile-type %s --bundle %q --certificate %q): %w",
+			profileID, attrs.Name, attrs.ProfileType, bundleValue, strings.Join(certificateIDs, ","), err)
+	}
+
+	result := &asc.ProfileRegenerateResult{
+		ID:             created.Data.ID,
+		Name:           created.Data.Attributes.Name,
+		ProfileType:    created.Data.Attributes.ProfileType,
+		PreviousID:     profileID,
+		BundleID:       bundleValue,
+		CertificateIDs: certificateIDs,
+		DeviceIDs:      deviceIDs,
+		ExpirationDate: created.Data.Attributes.ExpirationDate,
+	}
+	return created, result, nil
+}
+
+func collectProfileLinkageIDs(ctx context.Context, fetch func(context.Context, ...asc.LinkagesOption) (*asc.LinkagesResponse, error)) ([]string, error) {
+	firstPage, err := fetch(ctx, asc.WithLinkagesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return fetch(ctx, asc.WithLinkagesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	linkages, ok := all.(*asc.LinkagesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected linkages response type %T", all)
+	}
+
+	ids := make([]string, 0, len(linkages.Data))
+	for _, item := range linkages.Data {
+		ids = append(ids, item.ID)
+	}
+	return ids, nil
+}
@@ -299,7 +299,7 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --promoted-purchase-id is required")
 				return flag.ErrHelp
 			}
-			if !visibleForAllUsers.IsSet() && !enabled.IsSet() {
+			if !visibleForAllUsers.IsSet() && !enabled.IsSet() && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -40,6 +40,10 @@ func setupTestAuth(t *testing.T) {
 	t.Setenv("ASC_ISSUER_ID", "TEST_ISSUER")
 	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_TOKEN_CACHE_DIR", filepath.Join(tempDir, "tokens"))
+
+	// Stub transports answer only the requests under test, not the clock probe.
+	t.Setenv("ASC_CLOCK_SKEW_CHECK", "0")
 }
 
 func writeTestECDSAPEM(t *testing.T, path string) {
@@ -476,6 +480,7 @@ func TestListAllPublishBetaGroups_Paginated(t *testing.T) {
 
 func TestListAllPublishBetaGroups_PaginationAPIError(t *testing.T) {
 	setupTestAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 
 	callCount := 0
 	swapTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
@@ -0,0 +1,288 @@
+package publish
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const defaultWhatsNewLocale = "en-US"
+
+// testFlightPipeline describes one upload-or-lookup → process → distribute run.
+// Exactly one of IPAPath, BuildID, or LookupBuildNumber selects the build.
+type testFlightPipeline struct {
+	AppID             string
+	IPAPath           string
+	IPAFileInfo       os.FileInfo
+	UploadVersion     string
+	UploadBuildNumber string
+	BuildID           string
+	LookupBuildNumber string
+	Platform          string
+	Groups            []string
+	Notify            bool
+	Wait              bool
+	PollInterval      time.Duration
+	Timeout           time.Duration
+	TestNotes         string
+	Locale            string
+	// Compliance, when set, answers export compliance on the build.
+	Compliance *shared.ComplianceFlag
+}
+
+// runTestFlightPipeline resolves groups, uploads or finds the build, waits for
+// processing when needed, sets export compliance and What to Test notes, and
+// finally adds the build to the beta groups.
+func runTestFlightPipeline(ctx context.Context, pipeline testFlightPipeline) (*asc.TestFlightPublishResult, error) {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutValue := resolvePublishTimeout(pipeline.Timeout)
+	requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
+	defer cancel()
+
+	resolvedGroups, err := resolvePublishBetaGroups(requestCtx, client, pipeline.AppID, pipeline.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := false
+	resolvedVersionValue := ""
+	resolvedBuildNumberValue := ""
+
+	var buildResp *asc.BuildResponse
+	switch {
+	case pipeline.IPAPath != "":
+		uploadResult, err := uploadBuildAndWaitForID(
+			requestCtx,
+			client,
+			pipeline.AppID,
+			pipeline.IPAPath,
+			pipeline.IPAFileInfo,
+			pipeline.UploadVersion,
+			pipeline.UploadBuildNumber,
+			asc.Platform(pipeline.Platform),
+			pipeline.PollInterval,
+			timeoutValue,
+			pipeline.Timeout > 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		buildResp = uploadResult.Build
+		uploaded = true
+		resolvedVersionValue = uploadResult.Version
+		resolvedBuildNumberValue = uploadResult.BuildNumber
+	case pipeline.BuildID != "":
+		buildResp, err = client.GetBuild(requestCtx, pipeline.BuildID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch build: %w", err)
+		}
+		resolvedBuildNumberValue = strings.TrimSpace(buildResp.Data.Attributes.Version)
+	default:
+		buildResp, err = findPublishBuildByNumber(requestCtx, client, pipeline.AppID, pipeline.LookupBuildNumber, pipeline.Platform)
+		if err != nil {
+			return nil, err
+		}
+		resolvedBuildNumberValue = strings.TrimSpace(buildResp.Data.Attributes.Version)
+	}
+
+	if pipeline.Wait || pipeline.TestNotes != "" || pipeline.Compliance.IsSet() {
+		buildResp, err = client.WaitForBuildProcessing(requestCtx, buildResp.Data.ID, pipeline.PollInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	usesNonExemptEncryption, err := shared.ApplyBuildCompliance(requestCtx, client, buildResp, pipeline.Compliance)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipeline.TestNotes != "" {
+		if _, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildResp.Data.ID, pipeline.Locale, pipeline.TestNotes); err != nil {
+			return nil, err
+		}
+	}
+
+	addResult, err := shared.AddBuildBetaGroups(requestCtx, client, buildResp.Data.ID, resolvedGroups, shared.AddBuildBetaGroupsOptions{
+		Notify: pipeline.Notify,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add groups: %w", err)
+	}
+
+	result := &asc.TestFlightPublishResult{
+		BuildID:                 buildResp.Data.ID,
+		BuildVersion:            resolvedVersionValue,
+		BuildNumber:             resolvedBuildNumberValue,
+		GroupIDs:                addResult.AddedGroupIDs,
+		Uploaded:                uploaded,
+		ProcessingState:         buildResp.Data.Attributes.ProcessingState,
+		Notified:                pipeline.Notify,
+		UsesNonExemptEncryption: usesNonExemptEncryption,
+	}
+	if pipeline.TestNotes != "" {
+		result.WhatsNewLocale = pipeline.Locale
+	}
+	return result, nil
+}
+
+// TestFlightDistributeCommand returns the one-shot TestFlight pipeline used by
+// `asc testflight distribute`.
+func TestFlightDistributeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("testflight distribute", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	ipaPath := fs.String("ipa", "", "Path to .ipa file to upload (required unless --build/--build-number is provided)")
+	buildID := fs.String("build", "", "Existing build ID to distribute (skip upload)")
+	buildNumber := fs.String("build-number", "", "CFBundleVersion (used for upload metadata with --ipa, or build lookup when --ipa is omitted)")
+	version := fs.String("version", "", "CFBundleShortVersionString (auto-extracted from IPA if not provided)")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
+	groupIDs := fs.String("group", "", "Beta group ID(s) or name(s), comma-separated")
+	whatsNew := fs.String("whats-new", "", "What to Test notes for the build")
+	locale := fs.String("locale", defaultWhatsNewLocale, "Locale for --whats-new")
+	var usesNonExemptEncryption shared.OptionalBool
+	fs.Var(&usesNonExemptEncryption, "uses-non-exempt-encryption", "Export compliance answer for the build: true or false")
+	compliance := shared.BindComplianceFlag(fs)
+	notify := fs.Bool("notify", false, "Notify testers after adding to groups")
+	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for build discovery and processing")
+	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "distribute",
+		ShortUsage: "asc testflight distribute --app APP_ID --ipa app.ipa --group GROUP [flags]",
+		ShortHelp:  "Upload, process, and distribute a build to TestFlight in one step.",
+		LongHelp: `Upload, process, and distribute a build to TestFlight in one step.
+
+Steps:
+1. Upload the IPA (or find an existing build with --build/--build-number)
+2. Wait for processing to complete
+3. Set export compliance (with --compliance or --uses-non-exempt-encryption)
+4. Set What to Test notes (with --whats-new)
+5. Add the build to the beta groups, optionally notifying testers
+
+Examples:
+  asc testflight distribute --app "123" --ipa app.ipa --group "Internal" --whats-new "Bug fixes"
+  asc testflight distribute --app "123" --ipa app.ipa --group "Internal,External" --uses-non-exempt-encryption false --notify
+  asc testflight distribute --app "123" --ipa app.ipa --group "GROUP_ID" --whats-new "Nouveautés" --locale "fr-FR"
+  asc testflight distribute --app "123" --build-number "42" --group "Internal" --whats-new "Bug fixes"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+
+			ipaValue := strings.TrimSpace(*ipaPath)
+			buildIDValue := strings.TrimSpace(*buildID)
+			buildNumberValue := strings.TrimSpace(*buildNumber)
+			versionValue := strings.TrimSpace(*version)
+
+			uploadMode := ipaValue != ""
+			if uploadMode {
+				if buildIDValue != "" {
+					return shared.UsageError("--ipa and --build are mutually exclusive")
+				}
+			} else {
+				if buildIDValue == "" && buildNumberValue == "" {
+					return shared.UsageError("--ipa is required unless --build or --build-number is provided")
+				}
+				if buildIDValue != "" && buildNumberValue != "" {
+					return shared.UsageError("--build and --build-number are mutually exclusive when --ipa is not provided")
+				}
+				if versionValue != "" {
+					return shared.UsageError("--version is only supported when --ipa is provided")
+				}
+			}
+
+			parsedGroupIDs := shared.SplitCSV(*groupIDs)
+			if len(parsedGroupIDs) == 0 {
+				return shared.UsageError("--group is required")
+			}
+
+			whatsNewValue := strings.TrimSpace(*whatsNew)
+			localeValue := strings.TrimSpace(*locale)
+			if whatsNewValue != "" {
+				if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
+					return shared.UsageError(err.Error())
+				}
+			} else if localeValue != defaultWhatsNewLocale {
+				return shared.UsageError("--locale requires --whats-new")
+			}
+
+			if usesNonExemptEncryption.IsSet() && compliance.IsSet() {
+				return shared.UsageError("--compliance and --uses-non-exempt-encryption are mutually exclusive")
+			}
+
+			if *pollInterval <= 0 {
+				return shared.UsageError("--poll-interval must be greater than 0")
+			}
+			if *timeout < 0 {
+				return shared.UsageError("--timeout must be greater than 0")
+			}
+
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			var uploadFileInfo os.FileInfo
+			uploadVersionValue := ""
+			uploadBuildNumberValue := ""
+			if uploadMode {
+				uploadFileInfo, err = validateIPAPath(ipaValue)
+				if err != nil {
+					return fmt.Errorf("testflight distribute: %w", err)
+				}
+
+				uploadVersionValue, uploadBuildNumberValue, err = resolveBundleInfoForIPA(ipaValue, versionValue, buildNumberValue)
+				if err != nil {
+					return fmt.Errorf("testflight distribute: %w", err)
+				}
+			}
+
+			pipeline := testFlightPipeline{
+				AppID:             resolvedAppID,
+				IPAPath:           ipaValue,
+				IPAFileInfo:       uploadFileInfo,
+				UploadVersion:     uploadVersionValue,
+				UploadBuildNumber: uploadBuildNumberValue,
+				BuildID:           buildIDValue,
+				LookupBuildNumber: buildNumberValue,
+				Platform:          normalizedPlatform,
+				Groups:            parsedGroupIDs,
+				Notify:            *notify,
+				Wait:              true,
+				PollInterval:      *pollInterval,
+				Timeout:           *timeout,
+				TestNotes:         whatsNewValue,
+				Locale:            localeValue,
+			}
+			if usesNonExemptEncryption.IsSet() {
+				compliance.SetUsesNonExemptEncryption(usesNonExemptEncryption.Value())
+			}
+			pipeline.Compliance = compliance
+
+			result, err := runTestFlightPipeline(ctx, pipeline)
+			if err != nil {
+				return fmt.Errorf("testflight distribute: %w", err)
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
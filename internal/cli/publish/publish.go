@@ -51,7 +51,7 @@ func PublishTestFlightCommand() *ffcli.Command {
 	buildID := fs.String("build", "", "Existing build ID to distribute (skip upload)")
 	version := fs.String("version", "", "CFBundleShortVersionString (auto-extracted from IPA if not provided)")
 	buildNumber := fs.String("build-number", "", "CFBundleVersion (used for upload metadata with --ipa, or build lookup when --ipa is omitted)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	groupIDs := fs.String("group", "", "Beta group ID(s) or name(s), comma-separated")
 	notify := fs.Bool("notify", false, "Notify testers after adding to groups")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
@@ -59,6 +59,7 @@ func PublishTestFlightCommand() *ffcli.Command {
 	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
 	testNotes := fs.String("test-notes", "", "What to Test notes for the build")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
+	compliance := shared.BindComplianceFlag(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -69,14 +70,16 @@ func PublishTestFlightCommand() *ffcli.Command {
 
 Steps:
 1. Upload IPA to App Store Connect (unless --build/--build-number is provided)
-2. Wait for processing (if --wait)
-3. Add build to specified beta groups
-4. Optionally notify testers
+2. Wait for processing (if --wait, --test-notes, or --compliance)
+3. Set export compliance (with --compliance)
+4. Add build to specified beta groups
+5. Optionally notify testers
 
 Examples:
   asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID"
   asc publish testflight --app "123" --ipa app.ipa --group "External Testers"
   asc publish testflight --app "123" --ipa app.ipa --group "G1,G2" --wait --notify
+  asc publish testflight --app "123" --ipa app.ipa --group "External Testers" --compliance uses-non-exempt-encryption=false
   asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID" --test-notes "Test instructions" --locale "en-US" --wait
   asc publish testflight --app "123" --build "BUILD_ID" --group "GROUP_ID" --wait
   asc publish testflight --app "123" --build-number "42" --group "GROUP_ID" --wait`,
@@ -140,7 +143,7 @@ Examples:
 				return shared.UsageError("--timeout must be greater than 0")
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
@@ -160,91 +163,26 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
-			if err != nil {
-				return fmt.Errorf("publish testflight: %w", err)
-			}
-
-			timeoutValue := resolvePublishTimeout(*timeout)
-			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
-			defer cancel()
-
-			resolvedGroups, err := resolvePublishBetaGroups(requestCtx, client, resolvedAppID, parsedGroupIDs)
-			if err != nil {
-				return fmt.Errorf("publish testflight: %w", err)
-			}
-
-			platformValue := asc.Platform(normalizedPlatform)
-			timeoutOverride := *timeout > 0
-			uploaded := false
-			resolvedVersionValue := ""
-			resolvedBuildNumberValue := ""
-
-			var buildResp *asc.BuildResponse
-			if uploadMode {
-				uploadResult, err := uploadBuildAndWaitForID(
-					requestCtx,
-					client,
-					resolvedAppID,
-					ipaValue,
-					uploadFileInfo,
-					uploadVersionValue,
-					uploadBuildNumberValue,
-					platformValue,
-					*pollInterval,
-					timeoutValue,
-					timeoutOverride,
-				)
-				if err != nil {
-					return fmt.Errorf("publish testflight: %w", err)
-				}
-
-				buildResp = uploadResult.Build
-				uploaded = true
-				resolvedVersionValue = uploadResult.Version
-				resolvedBuildNumberValue = uploadResult.BuildNumber
-			} else if buildIDValue != "" {
-				buildResp, err = client.GetBuild(requestCtx, buildIDValue)
-				if err != nil {
-					return fmt.Errorf("publish testflight: failed to fetch build: %w", err)
-				}
-				resolvedBuildNumberValue = strings.TrimSpace(buildResp.Data.Attributes.Version)
-			} else {
-				buildResp, err = findPublishBuildByNumber(requestCtx, client, resolvedAppID, buildNumberValue, normalizedPlatform)
-				if err != nil {
-					return fmt.Errorf("publish testflight: %w", err)
-				}
-				resolvedBuildNumberValue = strings.TrimSpace(buildResp.Data.Attributes.Version)
-			}
-
-			if *wait || testNotesValue != "" {
-				buildResp, err = client.WaitForBuildProcessing(requestCtx, buildResp.Data.ID, *pollInterval)
-				if err != nil {
-					return fmt.Errorf("publish testflight: %w", err)
-				}
-			}
-
-			if testNotesValue != "" {
-				if _, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildResp.Data.ID, localeValue, testNotesValue); err != nil {
-					return fmt.Errorf("publish testflight: %w", err)
-				}
-			}
-
-			addResult, err := shared.AddBuildBetaGroups(requestCtx, client, buildResp.Data.ID, resolvedGroups, shared.AddBuildBetaGroupsOptions{
-				Notify: *notify,
+			result, err := runTestFlightPipeline(ctx, testFlightPipeline{
+				AppID:             resolvedAppID,
+				IPAPath:           ipaValue,
+				IPAFileInfo:       uploadFileInfo,
+				UploadVersion:     uploadVersionValue,
+				UploadBuildNumber: uploadBuildNumberValue,
+				BuildID:           buildIDValue,
+				LookupBuildNumber: buildNumberValue,
+				Platform:          normalizedPlatform,
+				Groups:            parsedGroupIDs,
+				Notify:            *notify,
+				Wait:              *wait,
+				PollInterval:      *pollInterval,
+				Timeout:           *timeout,
+				TestNotes:         testNotesValue,
+				Locale:            localeValue,
+				Compliance:        compliance,
 			})
 			if err != nil {
-				return fmt.Errorf("publish testflight: failed to add groups: %w", err)
-			}
-
-			result := &asc.TestFlightPublishResult{
-				BuildID:         buildResp.Data.ID,
-				BuildVersion:    resolvedVersionValue,
-				BuildNumber:     resolvedBuildNumberValue,
-				GroupIDs:        addResult.AddedGroupIDs,
-				Uploaded:        uploaded,
-				ProcessingState: buildResp.Data.Attributes.ProcessingState,
-				Notified:        *notify,
+				return fmt.Errorf("publish testflight: %w", err)
 			}
 
 			return shared.PrintOutput(result, *output.Output, *output.Pretty)
@@ -260,10 +198,11 @@ func PublishAppStoreCommand() *ffcli.Command {
 	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
 	version := fs.String("version", "", "App Store version string (defaults to IPA version)")
 	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from IPA if not provided)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	submit := fs.Bool("submit", false, "Submit for review after attaching build")
 	confirm := fs.Bool("confirm", false, "Confirm submission (required with --submit)")
 	wait := fs.Bool("wait", false, "Wait for build processing")
+	compliance := shared.BindComplianceFlag(fs)
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and build discovery")
 	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
 	output := shared.BindOutputFlags(fs)
@@ -276,13 +215,15 @@ func PublishAppStoreCommand() *ffcli.Command {
 
 Steps:
 1. Upload IPA to App Store Connect
-2. Wait for processing (if --wait)
-3. Find or create App Store version
-4. Attach build to version
-5. Submit for review (if --submit --confirm)
+2. Wait for processing (if --wait or --compliance)
+3. Set export compliance (with --compliance)
+4. Find or create App Store version
+5. Attach build to version
+6. Submit for review (if --submit --confirm)
 
 Examples:
   asc publish appstore --app "123" --ipa app.ipa --version 1.2.3
+  asc publish appstore --app "123" --ipa app.ipa --version 1.2.3 --compliance uses-non-exempt-encryption=false
   asc publish appstore --app "123" --ipa app.ipa --version 1.2.3 --submit --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -308,7 +249,7 @@ Examples:
 				return shared.UsageError("--timeout must be greater than 0")
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
@@ -340,13 +281,18 @@ Examples:
 			}
 
 			buildResp := uploadResult.Build
-			if *wait {
+			if *wait || compliance.IsSet() {
 				buildResp, err = client.WaitForBuildProcessing(requestCtx, buildResp.Data.ID, *pollInterval)
 				if err != nil {
 					return fmt.Errorf("publish appstore: %w", err)
 				}
 			}
 
+			usesNonExemptEncryption, err := shared.ApplyBuildCompliance(requestCtx, client, buildResp, compliance)
+			if err != nil {
+				return fmt.Errorf("publish appstore: %w", err)
+			}
+
 			versionResp, err := client.FindOrCreateAppStoreVersion(requestCtx, resolvedAppID, uploadResult.Version, platformValue)
 			if err != nil {
 				return fmt.Errorf("publish appstore: %w", err)
@@ -357,11 +303,12 @@ Examples:
 			}
 
 			result := &asc.AppStorePublishResult{
-				BuildID:   buildResp.Data.ID,
-				VersionID: versionResp.Data.ID,
-				Uploaded:  true,
-				Attached:  true,
-				Submitted: false,
+				BuildID:                 buildResp.Data.ID,
+				VersionID:               versionResp.Data.ID,
+				Uploaded:                true,
+				Attached:                true,
+				Submitted:               false,
+				UsesNonExemptEncryption: usesNonExemptEncryption,
 			}
 
 			if *submit {
@@ -404,7 +351,7 @@ func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID, ipa
 		return nil, fmt.Errorf("no upload operations returned")
 	}
 
-	fmt.Fprintf(os.Stderr, "Uploading %s (%d bytes) to App Store Connect...\n", fileInfo.Name(), fileInfo.Size())
+	fmt.Fprintf(shared.WarningWriter(), "Uploading %s (%d bytes) to App Store Connect...\n", fileInfo.Name(), fileInfo.Size())
 	uploadCtx, uploadCancel := contextWithPublishUploadTimeout(ctx, uploadTimeout, overrideUploadTimeout)
 	err = asc.ExecuteUploadOperations(uploadCtx, ipaPath, fileResp.Data.Attributes.UploadOperations)
 	uploadCancel()
@@ -419,8 +366,8 @@ func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID, ipa
 		return nil, err
 	}
 
-	fmt.Fprintln(os.Stderr, "Upload committed in App Store Connect.")
-	fmt.Fprintf(os.Stderr, "Waiting for build %s (%s) to appear in App Store Connect...\n", buildNumber, version)
+	fmt.Fprintln(shared.WarningWriter(), "Upload committed in App Store Connect.")
+	fmt.Fprintf(shared.WarningWriter(), "Waiting for build %s (%s) to appear in App Store Connect...\n", buildNumber, version)
 	buildResp, err := shared.WaitForBuildByNumberOrUploadFailure(ctx, client, appID, uploadResp.Data.ID, version, buildNumber, string(platform), pollInterval)
 	if err != nil {
 		return nil, err
@@ -17,6 +17,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/audit"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/auth"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/backgroundassets"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/betaapplocalizations"
@@ -28,15 +29,20 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/categories"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/certificates"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/completion"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/configcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/crashes"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/describe"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/devices"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/diffcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/docs"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/encryption"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/enums"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/eula"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/exporter"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/feedback"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/finance"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/gamecenter"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/helpcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/iap"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/initcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/insights"
@@ -48,6 +54,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notificationsconfig"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/passtypeids"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/performance"
@@ -64,15 +71,18 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/sandbox"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/schema"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/screenshots"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/serve"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/signing"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/snitch"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/stats"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/status"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/submit"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/subscriptions"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/testflight"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/users"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/validate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/verifybinary"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/versions"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/videopreviews"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/web"
@@ -101,6 +111,7 @@ func Subcommands(version string) []*ffcli.Command {
 	subs := []*ffcli.Command{
 		auth.AuthCommand(),
 		auth.AuthDoctorCommand(),
+		configcmd.ConfigCommand(),
 		web.WebCommand(),
 		account.AccountCommand(),
 		install.InstallSkillsCommand(),
@@ -114,6 +125,7 @@ func Subcommands(version string) []*ffcli.Command {
 		crashes.CrashesCommand(),
 		reviews.ReviewsCommand(),
 		reviews.ReviewCommand(),
+		reviews.ReviewDetailsCommand(),
 		analytics.AnalyticsCommand(),
 		performance.PerformanceCommand(),
 		finance.FinanceCommand(),
@@ -125,6 +137,8 @@ func Subcommands(version string) []*ffcli.Command {
 		marketplace.MarketplaceCommand(),
 		alternativedistribution.Command(),
 		webhooks.WebhooksCommand(),
+		exporter.ExporterCommand(),
+		serve.ServeCommand(),
 		nominations.NominationsCommand(),
 		bundleids.BundleIDsCommand(),
 		merchantids.MerchantIDsCommand(),
@@ -140,6 +154,7 @@ func Subcommands(version string) []*ffcli.Command {
 		publish.PublishCommand(),
 		releasecmd.ReleaseCommand(),
 		workflow.WorkflowCommand(),
+		workflow.RunCommand(),
 		xcode.XcodeCommand(),
 		versions.VersionsCommand(),
 		productpages.ProductPagesCommand(),
@@ -165,21 +180,32 @@ func Subcommands(version string) []*ffcli.Command {
 		iap.IAPCommand(),
 		app_events.Command(),
 		subscriptions.SubscriptionsCommand(),
+		notificationsconfig.NotificationsConfigCommand(),
 		submit.SubmitCommand(),
+		submit.SubmissionsCommand(),
+		web.ResolutionCenterCommand(),
 		validate.ValidateCommand(),
 		xcodecloud.XcodeCloudCommand(),
 		categories.CategoriesCommand(),
 		agerating.AgeRatingCommand(),
+		web.PrivacyCommand(),
 		accessibility.AccessibilityCommand(),
 		encryption.EncryptionCommand(),
 		migrate.MigrateCommand(),
 		notify.NotifyCommand(),
 		gamecenter.GameCenterCommand(),
 		schema.SchemaCommand(),
+		schema.DeprecationsCommand(),
+		describe.DescribeCommand(),
+		enums.EnumsCommand(),
+		stats.StatsCommand(),
+		audit.AuditCommand(),
 		snitch.SnitchCommand(version),
+		verifybinary.VerifyBinaryCommand(),
 		VersionCommand(version),
 	}
 
 	subs = append(subs, completion.CompletionCommand(subs))
+	subs = append(subs, helpcmd.HelpCommand(subs))
 	return subs
 }
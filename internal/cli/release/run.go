@@ -116,7 +116,7 @@ func ReleaseRunCommand() *ffcli.Command {
 	version := fs.String("version", "", "App Store version string (required)")
 	buildID := fs.String("build", "", "Build ID to attach (required)")
 	metadataDir := fs.String("metadata-dir", "", "Metadata directory to apply (required)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	timeout := fs.Duration("timeout", releaseRunTimeout, "Maximum time to run the release pipeline")
 	dryRun := fs.Bool("dry-run", false, "Preview deterministic plan without mutations")
 	confirm := fs.Bool("confirm", false, "Confirm release mutations (required unless --dry-run)")
@@ -167,7 +167,7 @@ Examples:
 				return shared.UsageError("--metadata-dir is required")
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
@@ -23,7 +23,7 @@ func ReleaseStageCommand() *ffcli.Command {
 	copyMetadataFrom := fs.String("copy-metadata-from", "", "Copy localization metadata from this source version string")
 	copyFields := fs.String("copy-fields", "", "Comma-separated metadata fields to copy: description, keywords, marketingUrl, promotionalText, supportUrl, whatsNew")
 	excludeFields := fs.String("exclude-fields", "", "Comma-separated metadata fields to exclude from copy")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	timeout := fs.Duration("timeout", releaseRunTimeout, "Maximum time to run the staging pipeline")
 	dryRun := fs.Bool("dry-run", false, "Preview deterministic plan without mutations")
 	confirm := fs.Bool("confirm", false, "Confirm staging mutations (required unless --dry-run)")
@@ -72,7 +72,7 @@ Examples:
 				return shared.UsageError("--build is required")
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
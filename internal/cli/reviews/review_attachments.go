@@ -203,59 +203,18 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			info, err := os.Lstat(pathValue)
-			if err != nil {
+			if _, err := validateReviewAttachmentFile(pathValue); err != nil {
 				return fmt.Errorf("review attachments-upload: %w", err)
 			}
-			if info.Mode()&os.ModeSymlink != 0 {
-				return fmt.Errorf("review attachments-upload: refusing to read symlink %q", pathValue)
-			}
-			if info.IsDir() {
-				return fmt.Errorf("review attachments-upload: %q is a directory", pathValue)
-			}
-			if info.Size() <= 0 {
-				return fmt.Errorf("review attachments-upload: file size must be greater than 0")
-			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
 				return fmt.Errorf("review attachments-upload: %w", err)
 			}
 
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			resp, err := client.CreateAppStoreReviewAttachment(requestCtx, reviewDetailValue, filepath.Base(pathValue), info.Size())
-			if err != nil {
-				return fmt.Errorf("review attachments-upload: failed to create: %w", err)
-			}
-			if resp == nil || len(resp.Data.Attributes.UploadOperations) == 0 {
-				return fmt.Errorf("review attachments-upload: no upload operations returned")
-			}
-
-			uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
-			err = asc.ExecuteUploadOperations(uploadCtx, pathValue, resp.Data.Attributes.UploadOperations)
-			uploadCancel()
-			if err != nil {
-				return fmt.Errorf("review attachments-upload: upload failed: %w", err)
-			}
-
-			checksum, err := asc.ComputeFileChecksum(pathValue, asc.ChecksumAlgorithmMD5)
-			if err != nil {
-				return fmt.Errorf("review attachments-upload: checksum failed: %w", err)
-			}
-
-			uploaded := true
-			updateAttrs := asc.AppStoreReviewAttachmentUpdateAttributes{
-				SourceFileChecksum: &checksum.Hash,
-				Uploaded:           &uploaded,
-			}
-
-			commitCtx, commitCancel := shared.ContextWithUploadTimeout(ctx)
-			commitResp, err := client.UpdateAppStoreReviewAttachment(commitCtx, resp.Data.ID, updateAttrs)
-			commitCancel()
+			commitResp, err := uploadReviewAttachment(ctx, client, reviewDetailValue, pathValue)
 			if err != nil {
-				return fmt.Errorf("review attachments-upload: failed to commit upload: %w", err)
+				return fmt.Errorf("review attachments-upload: %w", err)
 			}
 
 			return shared.PrintOutput(commitResp, *output.Output, *output.Pretty)
@@ -394,3 +353,67 @@ func reviewDetailFieldList() []string {
 		"appStoreReviewAttachments",
 	}
 }
+
+// validateReviewAttachmentFile checks that path is a non-empty regular file
+// that can be uploaded as a review attachment.
+func validateReviewAttachmentFile(path string) (os.FileInfo, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%q is a directory", path)
+	}
+	if info.Size() <= 0 {
+		return nil, fmt.Errorf("file size must be greater than 0")
+	}
+	return info, nil
+}
+
+// uploadReviewAttachment reserves a review attachment, uploads the file, and
+// commits it with the file's checksum.
+func uploadReviewAttachment(ctx context.Context, client *asc.Client, reviewDetailID, path string) (*asc.AppStoreReviewAttachmentResponse, error) {
+	info, err := validateReviewAttachmentFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	resp, err := client.CreateAppStoreReviewAttachment(requestCtx, reviewDetailID, filepath.Base(path), info.Size())
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create: %w", err)
+	}
+	if resp == nil || len(resp.Data.Attributes.UploadOperations) == 0 {
+		return nil, fmt.Errorf("no upload operations returned")
+	}
+
+	uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
+	err = asc.ExecuteUploadOperations(uploadCtx, path, resp.Data.Attributes.UploadOperations)
+	uploadCancel()
+	if err != nil {
+		return nil, fmt.Errorf("upload failed: %w", err)
+	}
+
+	checksum, err := asc.ComputeFileChecksum(path, asc.ChecksumAlgorithmMD5)
+	if err != nil {
+		return nil, fmt.Errorf("checksum failed: %w", err)
+	}
+
+	uploaded := true
+	updateAttrs := asc.AppStoreReviewAttachmentUpdateAttributes{
+		SourceFileChecksum: &checksum.Hash,
+		Uploaded:           &uploaded,
+	}
+
+	commitCtx, commitCancel := shared.ContextWithUploadTimeout(ctx)
+	commitResp, err := client.UpdateAppStoreReviewAttachment(commitCtx, resp.Data.ID, updateAttrs)
+	commitCancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit upload: %w", err)
+	}
+	return commitResp, nil
+}
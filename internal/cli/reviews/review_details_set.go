@@ -0,0 +1,359 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const reviewDemoPasswordEnvVar = "ASC_REVIEW_DEMO_PASSWORD"
+
+type multiStringFlag []string
+
+func (m *multiStringFlag) String() string {
+	return strings.Join(*m, ",")
+}
+
+func (m *multiStringFlag) Set(value string) error {
+	*m = append(*m, value)
+	return nil
+}
+
+type reviewDetailsSetResult struct {
+	VersionID      string                         `json:"versionId"`
+	ReviewDetailID string                         `json:"reviewDetailId"`
+	Action         string                         `json:"action"`
+	Attachments    []reviewDetailsAttachmentEntry `json:"attachments,omitempty"`
+}
+
+type reviewDetailsAttachmentEntry struct {
+	File         string `json:"file"`
+	AttachmentID string `json:"attachmentId,omitempty"`
+	Action       string `json:"action"`
+}
+
+// ReviewDetailsCommand returns the review-details command group.
+func ReviewDetailsCommand() *ffcli.Command {
+	return &ffcli.Command{
+		Name:       "review-details",
+		ShortUsage: "asc review-details <subcommand> [flags]",
+		ShortHelp:  "Set App Store review information for a version.",
+		LongHelp: `Set App Store review information for a version.
+
+Examples:
+  asc review-details set --version-id "VERSION_ID" --contact "Jane Doe <jane@example.com>" --notes-file notes.txt`,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ReviewDetailsSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ReviewDetailsSetCommand creates or updates a version's review details and
+// uploads review attachments in one step.
+func ReviewDetailsSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-details set", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	contact := fs.String("contact", "", `Review contact as "First Last <email>"`)
+	contactPhone := fs.String("contact-phone", "", "Review contact phone number")
+	demoUser := fs.String("demo-user", "", "Demo account user name (implies --demo-required)")
+	demoPassword := fs.String("demo-password", "", "Demo account password (or "+reviewDemoPasswordEnvVar+")")
+	var demoRequired shared.OptionalBool
+	demoRequired.EnableBoolFlag()
+	fs.Var(&demoRequired, "demo-required", "Whether App Review needs a demo account to sign in")
+	notes := fs.String("notes", "", "Notes for App Review")
+	notesFile := fs.String("notes-file", "", "Read notes for App Review from a file")
+	var attachments multiStringFlag
+	fs.Var(&attachments, "attachment", "File to attach for App Review (repeatable)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc review-details set --version-id VERSION_ID [flags]",
+		ShortHelp:  "Create or update review details and upload attachments.",
+		LongHelp: `Create or update a version's App Store review details and upload attachments.
+
+The version's review details are created when missing and updated otherwise;
+only the fields for the flags you pass are changed. Each --attachment file is
+uploaded to the review details, unless an attachment with the same file name
+and checksum is already there, so the command is safe to rerun in CI.
+
+The demo account password can be passed with ` + reviewDemoPasswordEnvVar + ` to keep it out
+of shell history and CI logs.
+
+Examples:
+  asc review-details set --version-id "VERSION_ID" --contact "Jane Doe <jane@example.com>" --contact-phone "+1 555 0100"
+  asc review-details set --version-id "VERSION_ID" --demo-user "reviewer@example.com" --notes-file notes.txt
+  asc review-details set --version-id "VERSION_ID" --attachment demo.mp4 --attachment flows.zip
+  asc review-details set --version-id "VERSION_ID" --demo-required=false`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				return shared.UsageError("--version-id is required")
+			}
+			if strings.TrimSpace(*notes) != "" && strings.TrimSpace(*notesFile) != "" {
+				return shared.UsageError("--notes and --notes-file are mutually exclusive")
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+
+			attrs, hasUpdates, err := buildReviewDetailsSetAttributes(reviewDetailsSetInput{
+				contact:      *contact,
+				contactPhone: *contactPhone,
+				demoUser:     *demoUser,
+				demoPassword: *demoPassword,
+				demoRequired: demoRequired,
+				notes:        *notes,
+				notesFile:    *notesFile,
+				visited:      visited,
+			})
+			if err != nil {
+				return err
+			}
+			if !hasUpdates && len(attachments) == 0 {
+				return shared.UsageError("at least one review detail flag or --attachment is required")
+			}
+
+			attachmentPaths := make([]string, 0, len(attachments))
+			for _, attachment := range attachments {
+				path := strings.TrimSpace(attachment)
+				if path == "" {
+					return shared.UsageError("--attachment must not be empty")
+				}
+				if _, err := validateReviewAttachmentFile(path); err != nil {
+					return fmt.Errorf("review-details set: %w", err)
+				}
+				attachmentPaths = append(attachmentPaths, path)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("review-details set: %w", err)
+			}
+
+			result, err := upsertReviewDetails(ctx, client, versionValue, attrs, hasUpdates)
+			if err != nil {
+				return fmt.Errorf("review-details set: %w", err)
+			}
+
+			if len(attachmentPaths) > 0 {
+				result.Attachments, err = syncReviewAttachments(ctx, client, result.ReviewDetailID, attachmentPaths)
+				if err != nil {
+					return fmt.Errorf("review-details set: %w", err)
+				}
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderReviewDetailsSetResult(result, asc.RenderTable) },
+				func() error { return renderReviewDetailsSetResult(result, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+type reviewDetailsSetInput struct {
+	contact      string
+	contactPhone string
+	demoUser     string
+	demoPassword string
+	demoRequired shared.OptionalBool
+	notes        string
+	notesFile    string
+	visited      map[string]bool
+}
+
+// buildReviewDetailsSetAttributes converts the set flags into update
+// attributes, reporting whether any field changes.
+func buildReviewDetailsSetAttributes(input reviewDetailsSetInput) (asc.AppStoreReviewDetailUpdateAttributes, bool, error) {
+	attrs := asc.AppStoreReviewDetailUpdateAttributes{}
+	hasUpdates := false
+
+	if input.visited["contact"] {
+		firstName, lastName, email, err := parseReviewContact(input.contact)
+		if err != nil {
+			return attrs, false, shared.UsageErrorf("--contact %v", err)
+		}
+		attrs.ContactFirstName = &firstName
+		attrs.ContactLastName = &lastName
+		if email != "" {
+			attrs.ContactEmail = &email
+		}
+		hasUpdates = true
+	}
+	if input.visited["contact-phone"] {
+		value := strings.TrimSpace(input.contactPhone)
+		attrs.ContactPhone = &value
+		hasUpdates = true
+	}
+	if input.visited["demo-user"] {
+		value := strings.TrimSpace(input.demoUser)
+		attrs.DemoAccountName = &value
+		hasUpdates = true
+	}
+	password := input.demoPassword
+	if !input.visited["demo-password"] {
+		password = os.Getenv(reviewDemoPasswordEnvVar)
+	}
+	if input.visited["demo-password"] || strings.TrimSpace(password) != "" {
+		value := strings.TrimSpace(password)
+		attrs.DemoAccountPassword = &value
+		hasUpdates = true
+	}
+	switch {
+	case input.demoRequired.IsSet():
+		value := input.demoRequired.Value()
+		if !value && strings.TrimSpace(input.demoUser) != "" {
+			return attrs, false, shared.UsageError("--demo-user cannot be combined with --demo-required=false")
+		}
+		attrs.DemoAccountRequired = &value
+		hasUpdates = true
+	case strings.TrimSpace(input.demoUser) != "":
+		value := true
+		attrs.DemoAccountRequired = &value
+	}
+	if input.visited["notes"] {
+		value := strings.TrimSpace(input.notes)
+		attrs.Notes = &value
+		hasUpdates = true
+	}
+	if path := strings.TrimSpace(input.notesFile); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return attrs, false, fmt.Errorf("read --notes-file: %w", err)
+		}
+		value := strings.TrimSpace(string(data))
+		attrs.Notes = &value
+		hasUpdates = true
+	}
+	return attrs, hasUpdates, nil
+}
+
+// parseReviewContact splits "First Last <email>" into its parts. The email is
+// optional; a bare name sets only the first and last name.
+func parseReviewContact(value string) (string, string, string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", "", "", fmt.Errorf("must not be empty")
+	}
+
+	name, email := value, ""
+	if strings.Contains(value, "<") {
+		address, err := mail.ParseAddress(value)
+		if err != nil {
+			return "", "", "", fmt.Errorf(`must look like "First Last <email>": %v`, err)
+		}
+		name, email = address.Name, address.Address
+	}
+
+	parts := strings.Fields(name)
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("must include a first and last name")
+	}
+	return parts[0], strings.Join(parts[1:], " "), email, nil
+}
+
+// upsertReviewDetails creates the version's review details when missing and
+// otherwise applies attrs to the existing ones.
+func upsertReviewDetails(ctx context.Context, client *asc.Client, versionID string, attrs asc.AppStoreReviewDetailUpdateAttributes, hasUpdates bool) (*reviewDetailsSetResult, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	result := &reviewDetailsSetResult{VersionID: versionID}
+	existing, err := client.GetAppStoreReviewDetailForVersion(requestCtx, versionID)
+	if err != nil && !asc.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch review details: %w", err)
+	}
+	if err != nil || strings.TrimSpace(existing.Data.ID) == "" {
+		createAttrs := asc.AppStoreReviewDetailCreateAttributes(attrs)
+		created, err := client.CreateAppStoreReviewDetail(requestCtx, versionID, &createAttrs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create review details: %w", err)
+		}
+		result.ReviewDetailID = created.Data.ID
+		result.Action = "created"
+		return result, nil
+	}
+
+	result.ReviewDetailID = existing.Data.ID
+	if !hasUpdates {
+		result.Action = "unchanged"
+		return result, nil
+	}
+	if _, err := client.UpdateAppStoreReviewDetail(requestCtx, existing.Data.ID, attrs); err != nil {
+		return nil, fmt.Errorf("failed to update review details: %w", err)
+	}
+	result.Action = "updated"
+	return result, nil
+}
+
+// syncReviewAttachments uploads each file unless the review details already
+// have an attachment with the same name and checksum.
+func syncReviewAttachments(ctx context.Context, client *asc.Client, reviewDetailID string, paths []string) ([]reviewDetailsAttachmentEntry, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	existing, err := client.GetAppStoreReviewAttachmentsForReviewDetail(requestCtx, reviewDetailID, asc.WithAppStoreReviewAttachmentsLimit(200))
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review attachments: %w", err)
+	}
+	existingIDs := map[string]string{}
+	for _, attachment := range existing.Data {
+		key := attachment.Attributes.FileName + "|" + strings.ToLower(attachment.Attributes.SourceFileChecksum)
+		existingIDs[key] = attachment.ID
+	}
+
+	entries := make([]reviewDetailsAttachmentEntry, 0, len(paths))
+	for _, path := range paths {
+		checksum, err := asc.ComputeFileChecksum(path, asc.ChecksumAlgorithmMD5)
+		if err != nil {
+			return entries, fmt.Errorf("checksum failed for %q: %w", path, err)
+		}
+		if id, ok := existingIDs[filepath.Base(path)+"|"+strings.ToLower(checksum.Hash)]; ok {
+			entries = append(entries, reviewDetailsAttachmentEntry{File: path, AttachmentID: id, Action: "skipped"})
+			continue
+		}
+
+		resp, err := uploadReviewAttachment(ctx, client, reviewDetailID, path)
+		if err != nil {
+			return entries, fmt.Errorf("attachment %q: %w", path, err)
+		}
+		entries = append(entries, reviewDetailsAttachmentEntry{File: path, AttachmentID: resp.Data.ID, Action: "uploaded"})
+	}
+	return entries, nil
+}
+
+func renderReviewDetailsSetResult(result *reviewDetailsSetResult, render func([]string, [][]string)) error {
+	render(
+		[]string{"Version ID", "Review Detail ID", "Action"},
+		[][]string{{result.VersionID, result.ReviewDetailID, result.Action}},
+	)
+	if len(result.Attachments) == 0 {
+		return nil
+	}
+	rows := make([][]string, 0, len(result.Attachments))
+	for _, attachment := range result.Attachments {
+		rows = append(rows, []string{attachment.File, attachment.AttachmentID, attachment.Action})
+	}
+	render([]string{"Attachment", "Attachment ID", "Action"}, rows)
+	return nil
+}
@@ -188,7 +188,7 @@ func ReviewSubmissionsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("submissions-create", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -208,7 +208,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return fmt.Errorf("review submissions-create: %w", err)
 			}
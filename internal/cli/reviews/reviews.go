@@ -48,9 +48,11 @@ Examples:
   asc reviews ratings --app "123456789" --all
   asc reviews summarizations --app "123456789" --platform IOS --territory US
   asc reviews respond --review-id "REVIEW_ID" --response "Thanks!"
+  asc reviews delete-response --review-id "REVIEW_ID" --confirm
   asc reviews response get --id "RESPONSE_ID"
   asc reviews response delete --id "RESPONSE_ID" --confirm
-  asc reviews response for-review --review-id "REVIEW_ID"`,
+  asc reviews response for-review --review-id "REVIEW_ID"
+  asc reviews escalate --app "123456789" --max-rating 1 --create-github-issue --repo "org/app"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -59,7 +61,9 @@ Examples:
 			ReviewsRatingsCommand(),
 			ReviewsSummarizationsCommand(),
 			ReviewsRespondCommand(),
+			ReviewsDeleteResponseCommand(),
 			ReviewsResponseCommand(),
+			ReviewsEscalateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			// If no flags are set and no args, show help
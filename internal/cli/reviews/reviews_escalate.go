@@ -0,0 +1,538 @@
+package reviews
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	reviewsEscalateDefaultStateFile = ".asc/review-escalations.json"
+	reviewsEscalateDefaultLimit     = 100
+	reviewsEscalateDefaultMaxRating = 2
+	reviewsEscalateExecTimeout      = 30 * time.Second
+	reviewsEscalateMaxErrorBytes    = 8192
+	reviewsEscalateGitHubAPIBase    = "https://api.github.com"
+)
+
+var githubRepoPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+// githubReferencePattern matches the start of an @mention or a #123 issue
+// reference, which GitHub autolinks even inside a quoted block.
+var githubReferencePattern = regexp.MustCompile(`([@#])([\p{L}\p{N}])`)
+
+// reviewEscalationState records reviews that were already escalated so
+// repeated runs only act on new reviews.
+type reviewEscalationState struct {
+	Escalated map[string]reviewEscalationStateEntry `json:"escalated"`
+}
+
+type reviewEscalationStateEntry struct {
+	AppID       string `json:"appId"`
+	Rating      int    `json:"rating"`
+	EscalatedAt string `json:"escalatedAt"`
+	IssueURL    string `json:"issueUrl,omitempty"`
+}
+
+type reviewEscalation struct {
+	ReviewID    string `json:"reviewId"`
+	Rating      int    `json:"rating"`
+	Title       string `json:"title,omitempty"`
+	Territory   string `json:"territory,omitempty"`
+	CreatedDate string `json:"createdDate,omitempty"`
+	IssueURL    string `json:"issueUrl,omitempty"`
+	IssueNumber int    `json:"issueNumber,omitempty"`
+	Executed    bool   `json:"executed,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type reviewEscalationResult struct {
+	AppID            string             `json:"appId"`
+	MaxRating        int                `json:"maxRating"`
+	StateFile        string             `json:"stateFile"`
+	DryRun           bool               `json:"dryRun"`
+	Scanned          int                `json:"scanned"`
+	Matched          int                `json:"matched"`
+	AlreadyEscalated int                `json:"alreadyEscalated"`
+	Escalated        []reviewEscalation `json:"escalated"`
+	Failed           []reviewEscalation `json:"failed,omitempty"`
+}
+
+type reviewEscalationOptions struct {
+	repo        string
+	labels      []string
+	createIssue bool
+	execCommand string
+	token       string
+}
+
+// ReviewsEscalateCommand returns the reviews escalate subcommand.
+func ReviewsEscalateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("escalate", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	maxRating := fs.Int("max-rating", reviewsEscalateDefaultMaxRating, "Escalate reviews rated at or below this star rating (1-5)")
+	territory := fs.String("territory", "", "Only escalate reviews from this territory (e.g., US, GBR)")
+	since := fs.String("since", "", "Only escalate reviews created on or after this date (YYYY-MM-DD or RFC3339)")
+	limit := fs.Int("limit", reviewsEscalateDefaultLimit, "Number of most recent reviews to scan (1-200)")
+	createIssue := fs.Bool("create-github-issue", false, "Create a GitHub issue per review (requires --repo and GITHUB_TOKEN or GH_TOKEN)")
+	repo := fs.String("repo", "", "GitHub repository for issues (owner/name)")
+	labels := fs.String("labels", "", "Comma-separated labels for created GitHub issues")
+	execCommand := fs.String("exec", "", "Command to run per review (review JSON is piped on stdin)")
+	stateFile := fs.String("state-file", reviewsEscalateDefaultStateFile, "Path to the escalation state file used for deduplication")
+	dryRun := fs.Bool("dry-run", false, "List reviews that would be escalated without creating issues, running --exec, or updating state")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "escalate",
+		ShortUsage: "asc reviews escalate --app \"APP_ID\" (--create-github-issue --repo OWNER/NAME | --exec COMMAND) [flags]",
+		ShortHelp:  "Escalate new low-rating reviews to an issue tracker.",
+		LongHelp: `Escalate new low-rating reviews to an issue tracker.
+
+Scans the most recent customer reviews and escalates each review rated at or
+below --max-rating that has not been escalated before. Escalated review IDs are
+recorded in --state-file, so the command is safe to run on a schedule.
+
+Escalation targets:
+  --create-github-issue   Create one GitHub issue per review in --repo
+                          (token from GITHUB_TOKEN or GH_TOKEN).
+  --exec                  Run a shell command per review. The review JSON is
+                          piped on stdin, and ASC_APP_ID, ASC_REVIEW_ID, and
+                          ASC_REVIEW_RATING are set in the environment.
+
+A review is recorded once all selected targets succeed, or as soon as its
+GitHub issue is created so a failing --exec never files a duplicate issue.
+
+Examples:
+  asc reviews escalate --app "APP_ID" --max-rating 1 --create-github-issue --repo "org/app"
+  asc reviews escalate --app "APP_ID" --create-github-issue --repo "org/app" --labels "app-store,feedback"
+  asc reviews escalate --app "APP_ID" --exec "./scripts/file-ticket.sh" --since 2026-01-01
+  asc reviews escalate --app "APP_ID" --create-github-issue --repo "org/app" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if *maxRating < 1 || *maxRating > 5 {
+				return shared.UsageError("--max-rating must be between 1 and 5")
+			}
+			if *limit < 1 || *limit > 200 {
+				return shared.UsageError("--limit must be between 1 and 200")
+			}
+
+			opts := reviewEscalationOptions{
+				repo:        strings.TrimSpace(*repo),
+				labels:      shared.SplitCSV(*labels),
+				createIssue: *createIssue,
+				execCommand: strings.TrimSpace(*execCommand),
+			}
+			if !opts.createIssue && opts.execCommand == "" {
+				return shared.UsageError("at least one of --create-github-issue or --exec is required")
+			}
+			if opts.createIssue && opts.repo == "" {
+				return shared.UsageError("--repo is required with --create-github-issue")
+			}
+			if !opts.createIssue && (opts.repo != "" || len(opts.labels) > 0) {
+				return shared.UsageError("--repo and --labels require --create-github-issue")
+			}
+			if opts.repo != "" && !githubRepoPattern.MatchString(opts.repo) {
+				return shared.UsageError("--repo must be in owner/name format")
+			}
+
+			var sinceTime time.Time
+			if value := strings.TrimSpace(*since); value != "" {
+				parsed, err := parseReviewsEscalateSince(value)
+				if err != nil {
+					return shared.UsageError("--since must be YYYY-MM-DD or RFC3339")
+				}
+				sinceTime = parsed
+			}
+
+			statePath := strings.TrimSpace(*stateFile)
+			if statePath == "" {
+				return shared.UsageError("--state-file must not be empty")
+			}
+
+			if opts.createIssue && !*dryRun {
+				opts.token = resolveReviewsEscalateGitHubToken()
+				if opts.token == "" {
+					return fmt.Errorf("reviews escalate: GITHUB_TOKEN or GH_TOKEN is required to create issues")
+				}
+			}
+
+			state, err := loadReviewEscalationState(statePath)
+			if err != nil {
+				return fmt.Errorf("reviews escalate: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews escalate: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			reviews, err := client.GetReviews(requestCtx, resolvedAppID,
+				asc.WithTerritory(*territory),
+				asc.WithLimit(*limit),
+				asc.WithReviewSort("-createdDate"),
+			)
+			if err != nil {
+				return fmt.Errorf("reviews escalate: failed to fetch: %w", err)
+			}
+
+			result := &reviewEscalationResult{
+				AppID:     resolvedAppID,
+				MaxRating: *maxRating,
+				StateFile: statePath,
+				DryRun:    *dryRun,
+				Scanned:   len(reviews.Data),
+				Escalated: []reviewEscalation{},
+			}
+
+			candidates := selectReviewsToEscalate(reviews.Data, *maxRating, sinceTime)
+			result.Matched = len(candidates)
+			for _, review := range candidates {
+				if _, seen := state.Escalated[review.ID]; seen {
+					result.AlreadyEscalated++
+					continue
+				}
+
+				item := reviewEscalation{
+					ReviewID:    review.ID,
+					Rating:      review.Attributes.Rating,
+					Title:       review.Attributes.Title,
+					Territory:   review.Attributes.Territory,
+					CreatedDate: review.Attributes.CreatedDate,
+				}
+				if *dryRun {
+					result.Escalated = append(result.Escalated, item)
+					continue
+				}
+
+				item, record := escalateReview(ctx, resolvedAppID, review, item, opts)
+				if record {
+					state.Escalated[review.ID] = reviewEscalationStateEntry{
+						AppID:       resolvedAppID,
+						Rating:      review.Attributes.Rating,
+						EscalatedAt: time.Now().UTC().Format(time.RFC3339),
+						IssueURL:    item.IssueURL,
+					}
+					if err := saveReviewEscalationState(statePath, state); err != nil {
+						return fmt.Errorf("reviews escalate: %w", err)
+					}
+				}
+				if item.Error != "" {
+					result.Failed = append(result.Failed, item)
+					continue
+				}
+				result.Escalated = append(result.Escalated, item)
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderReviewEscalationResult(result, false) },
+				func() error { return renderReviewEscalationResult(result, true) },
+			); err != nil {
+				return err
+			}
+
+			if len(result.Failed) > 0 {
+				return shared.NewReportedError(fmt.Errorf("reviews escalate: %d review(s) failed to escalate", len(result.Failed)))
+			}
+			return nil
+		},
+	}
+}
+
+// selectReviewsToEscalate returns matching reviews oldest first, so issues are
+// filed in the order the reviews were written.
+func selectReviewsToEscalate(reviews []asc.Resource[asc.ReviewAttributes], maxRating int, since time.Time) []asc.Resource[asc.ReviewAttributes] {
+	var selected []asc.Resource[asc.ReviewAttributes]
+	for _, review := range reviews {
+		rating := review.Attributes.Rating
+		if rating < 1 || rating > maxRating {
+			continue
+		}
+		if !since.IsZero() {
+			created, err := time.Parse(time.RFC3339, strings.TrimSpace(review.Attributes.CreatedDate))
+			if err != nil || created.Before(since) {
+				continue
+			}
+		}
+		selected = append(selected, review)
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].Attributes.CreatedDate < selected[j].Attributes.CreatedDate
+	})
+	return selected
+}
+
+// escalateReview runs the selected targets for one review. It reports whether
+// the review should be recorded in the state file.
+func escalateReview(ctx context.Context, appID string, review asc.Resource[asc.ReviewAttributes], item reviewEscalation, opts reviewEscalationOptions) (reviewEscalation, bool) {
+	var failures []string
+	issueCreated := false
+
+	if opts.createIssue {
+		issue, err := createReviewEscalationIssue(ctx, opts, appID, review)
+		if err != nil {
+			failures = append(failures, "github: "+err.Error())
+		} else {
+			issueCreated = true
+			item.IssueURL = issue.HTMLURL
+			item.IssueNumber = issue.Number
+		}
+	}
+
+	if opts.execCommand != "" {
+		if err := runReviewEscalationExec(ctx, opts.execCommand, appID, review); err != nil {
+			failures = append(failures, "exec: "+err.Error())
+		} else {
+			item.Executed = true
+		}
+	}
+
+	if len(failures) > 0 {
+		item.Error = strings.Join(failures, "; ")
+		return item, issueCreated
+	}
+	return item, true
+}
+
+type reviewEscalationIssue struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+}
+
+func createReviewEscalationIssue(ctx context.Context, opts reviewEscalationOptions, appID string, review asc.Resource[asc.ReviewAttributes]) (*reviewEscalationIssue, error) {
+	payload := map[string]any{
+		"title": reviewEscalationIssueTitle(review),
+		"body":  reviewEscalationIssueBody(appID, review),
+	}
+	if len(opts.labels) > 0 {
+		payload["labels"] = opts.labels
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	issueURL := fmt.Sprintf("%s/repos/%s/issues", reviewsEscalateGitHubAPIBase, opts.repo)
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, issueURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+opts.token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, reviewsEscalateMaxErrorBytes))
+		return nil, fmt.Errorf("GitHub returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var issue reviewEscalationIssue
+	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
+		return nil, fmt.Errorf("failed to decode issue response: %w", err)
+	}
+	return &issue, nil
+}
+
+func reviewEscalationIssueTitle(review asc.Resource[asc.ReviewAttributes]) string {
+	title := strings.TrimSpace(review.Attributes.Title)
+	if title == "" {
+		title = "Untitled review"
+	}
+	return fmt.Sprintf("[%d★ review] %s", review.Attributes.Rating, escapeGitHubReferences(title))
+}
+
+func reviewEscalationIssueBody(appID string, review asc.Resource[asc.ReviewAttributes]) string {
+	attrs := review.Attributes
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Rating:** %d/5\n", attrs.Rating)
+	if attrs.Territory != "" {
+		fmt.Fprintf(&b, "**Territory:** %s\n", attrs.Territory)
+	}
+	if attrs.ReviewerNickname != "" {
+		fmt.Fprintf(&b, "**Reviewer:** %s\n", escapeGitHubReferences(attrs.ReviewerNickname))
+	}
+	if attrs.CreatedDate != "" {
+		fmt.Fprintf(&b, "**Created:** %s\n", attrs.CreatedDate)
+	}
+	fmt.Fprintf(&b, "**App ID:** %s\n", appID)
+	fmt.Fprintf(&b, "**Review ID:** %s\n", review.ID)
+	b.WriteString("\n")
+	if body := strings.TrimSpace(attrs.Body); body != "" {
+		for _, line := range strings.Split(body, "\n") {
+			b.WriteString("> " + escapeGitHubReferences(line) + "\n")
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "_Filed by `asc reviews escalate`. Reply with `asc reviews respond --review-id \"%s\" --response \"...\"`._\n", review.ID)
+	return b.String()
+}
+
+// escapeGitHubReferences puts a zero-width space after @ and # so review text
+// cannot notify users or link issues in the escalation repository.
+func escapeGitHubReferences(text string) string {
+	return githubReferencePattern.ReplaceAllString(text, "${1}\u200b${2}")
+}
+
+func runReviewEscalationExec(ctx context.Context, command, appID string, review asc.Resource[asc.ReviewAttributes]) error {
+	payload, err := json.Marshal(review)
+	if err != nil {
+		return err
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, reviewsEscalateExecTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(execCtx, "sh", "-c", command)
+	cmd.Env = append(os.Environ(),
+		"ASC_APP_ID="+appID,
+		"ASC_REVIEW_ID="+review.ID,
+		"ASC_REVIEW_RATING="+strconv.Itoa(review.Attributes.Rating),
+	)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = io.Discard
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message != "" {
+			return fmt.Errorf("%w: %s", err, message)
+		}
+		return err
+	}
+	return nil
+}
+
+func loadReviewEscalationState(path string) (*reviewEscalationState, error) {
+	state := &reviewEscalationState{Escalated: map[string]reviewEscalationStateEntry{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, nil
+		}
+		return nil, fmt.Errorf("failed to read state file: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file %s: %w", path, err)
+	}
+	if state.Escalated == nil {
+		state.Escalated = map[string]reviewEscalationStateEntry{}
+	}
+	return state, nil
+}
+
+func saveReviewEscalationState(path string, state *reviewEscalationState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	if _, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(append(data, '\n')), 0o600, ".asc-review-escalations-*", ".asc-review-escalations-backup-*"); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+	return nil
+}
+
+func parseReviewsEscalateSince(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+func resolveReviewsEscalateGitHubToken() string {
+	for _, name := range []string{"GITHUB_TOKEN", "GH_TOKEN"} {
+		if value := strings.TrimSpace(os.Getenv(name)); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func renderReviewEscalationResult(result *reviewEscalationResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"App ID", "Max Rating", "Scanned", "Matched", "Already Escalated", "Escalated", "Failed", "Dry Run"},
+		[][]string{{
+			result.AppID,
+			strconv.Itoa(result.MaxRating),
+			strconv.Itoa(result.Scanned),
+			strconv.Itoa(result.Matched),
+			strconv.Itoa(result.AlreadyEscalated),
+			strconv.Itoa(len(result.Escalated)),
+			strconv.Itoa(len(result.Failed)),
+			strconv.FormatBool(result.DryRun),
+		}},
+	)
+
+	items := append(append([]reviewEscalation{}, result.Escalated...), result.Failed...)
+	if len(items) == 0 {
+		return nil
+	}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		status := "escalated"
+		switch {
+		case item.Error != "":
+			status = "failed: " + item.Error
+		case result.DryRun:
+			status = "would escalate"
+		}
+		rows = append(rows, []string{
+			item.ReviewID,
+			strconv.Itoa(item.Rating),
+			item.Territory,
+			item.CreatedDate,
+			item.Title,
+			item.IssueURL,
+			status,
+		})
+	}
+	render([]string{"Review ID", "Rating", "Territory", "Created", "Title", "Issue", "Status"}, rows)
+	return nil
+}
@@ -45,8 +45,9 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if strings.TrimSpace(*appID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --app is required")
+			appValue := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
+			if appValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
@@ -55,7 +56,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			return executeRatings(ctx, *appID, *country, *all, *workers, *output.Output, *output.Pretty)
+			return executeRatings(ctx, appValue, *country, *all, *workers, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -19,7 +19,7 @@ func ReviewsRespondCommand() *ffcli.Command {
 
 	reviewID := fs.String("review-id", "", "Customer review ID (required)")
 	response := fs.String("response", "", "Response body text (required)")
-	output := shared.BindOutputFlags(fs)
+	output := shared.BindGHAOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "respond",
@@ -32,7 +32,8 @@ Responses are visible to all App Store users.
 
 Examples:
   asc reviews respond --review-id "REVIEW_ID" --response "Thanks for your feedback!"
-  asc reviews respond --review-id "REVIEW_ID" --response "We appreciate your review." --output table`,
+  asc reviews respond --review-id "REVIEW_ID" --response "We appreciate your review." --output table
+  asc reviews respond --review-id "REVIEW_ID" --response "We appreciate your review." --output gha`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -58,7 +59,80 @@ Examples:
 				return fmt.Errorf("reviews respond: failed to create response: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutputWithGHA(resp, *output.Output, *output.Pretty, "Review response", reviewResponseAnnotation(resp, strings.TrimSpace(*reviewID)))
+		},
+	}
+}
+
+// ReviewsDeleteResponseCommand returns the reviews delete-response subcommand.
+func ReviewsDeleteResponseCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("delete-response", flag.ExitOnError)
+
+	reviewID := fs.String("review-id", "", "Customer review ID whose response should be deleted")
+	responseID := fs.String("id", "", "Customer review response ID (alternative to --review-id)")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "delete-response",
+		ShortUsage: "asc reviews delete-response (--review-id REVIEW_ID | --id RESPONSE_ID) --confirm [flags]",
+		ShortHelp:  "Delete the developer response to a customer review.",
+		LongHelp: `Delete the developer response to a customer review.
+
+With --review-id, the response attached to the review is looked up first, so
+automation only needs the review ID it already has from "asc reviews list".
+
+This action removes your response from the review and cannot be undone.
+
+Examples:
+  asc reviews delete-response --review-id "REVIEW_ID" --confirm
+  asc reviews delete-response --id "RESPONSE_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedReviewID := strings.TrimSpace(*reviewID)
+			trimmedResponseID := strings.TrimSpace(*responseID)
+			if trimmedReviewID == "" && trimmedResponseID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --review-id or --id is required")
+				return flag.ErrHelp
+			}
+			if trimmedReviewID != "" && trimmedResponseID != "" {
+				return shared.UsageError("--review-id and --id are mutually exclusive")
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews delete-response: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if trimmedResponseID == "" {
+				linkage, err := client.GetCustomerReviewResponseRelationshipForReview(requestCtx, trimmedReviewID)
+				if err != nil {
+					return fmt.Errorf("reviews delete-response: failed to find response: %w", err)
+				}
+				trimmedResponseID = strings.TrimSpace(linkage.Data.ID)
+				if trimmedResponseID == "" {
+					return fmt.Errorf("reviews delete-response: review %q has no response", trimmedReviewID)
+				}
+			}
+
+			if err := client.DeleteCustomerReviewResponse(requestCtx, trimmedResponseID); err != nil {
+				return fmt.Errorf("reviews delete-response: failed to delete: %w", err)
+			}
+
+			result := &asc.CustomerReviewResponseDeleteResult{
+				ID:      trimmedResponseID,
+				Deleted: true,
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -95,7 +169,7 @@ func ReviewsResponseGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	responseID := fs.String("id", "", "Customer review response ID (required)")
-	output := shared.BindOutputFlags(fs)
+	output := shared.BindGHAOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -105,7 +179,8 @@ func ReviewsResponseGetCommand() *ffcli.Command {
 
 Examples:
   asc reviews response get --id "RESPONSE_ID"
-  asc reviews response get --id "RESPONSE_ID" --output table`,
+  asc reviews response get --id "RESPONSE_ID" --output table
+  asc reviews response get --id "RESPONSE_ID" --output gha`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -127,7 +202,7 @@ Examples:
 				return fmt.Errorf("reviews response get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutputWithGHA(resp, *output.Output, *output.Pretty, "Review response", reviewResponseAnnotation(resp, ""))
 		},
 	}
 }
@@ -189,7 +264,7 @@ func ReviewsResponseForReviewCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("for-review", flag.ExitOnError)
 
 	reviewID := fs.String("review-id", "", "Customer review ID (required)")
-	output := shared.BindOutputFlags(fs)
+	output := shared.BindGHAOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "for-review",
@@ -201,7 +276,8 @@ This command fetches the existing response (if any) for a given review ID.
 
 Examples:
   asc reviews response for-review --review-id "REVIEW_ID"
-  asc reviews response for-review --review-id "REVIEW_ID" --output table`,
+  asc reviews response for-review --review-id "REVIEW_ID" --output table
+  asc reviews response for-review --review-id "REVIEW_ID" --output gha`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -223,7 +299,20 @@ Examples:
 				return fmt.Errorf("reviews response for-review: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutputWithGHA(resp, *output.Output, *output.Pretty, "Review response", reviewResponseAnnotation(resp, strings.TrimSpace(*reviewID)))
 		},
 	}
 }
+
+// reviewResponseAnnotation describes a customer review response for
+// --output gha.
+func reviewResponseAnnotation(resp *asc.CustomerReviewResponseResponse, reviewID string) shared.GHAAnnotation {
+	message := fmt.Sprintf("Response %s", resp.Data.ID)
+	if reviewID != "" {
+		message = fmt.Sprintf("%s to review %s", message, reviewID)
+	}
+	if state := strings.TrimSpace(resp.Data.Attributes.State); state != "" {
+		message = fmt.Sprintf("%s is %s", message, state)
+	}
+	return shared.GHANotice("Review response", message)
+}
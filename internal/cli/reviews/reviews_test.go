@@ -1,6 +1,11 @@
 package reviews
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
 
 func TestReviewsCommandConstructors(t *testing.T) {
 	top := ReviewsCommand()
@@ -31,3 +36,26 @@ func TestReviewsCommandConstructors(t *testing.T) {
 		}
 	}
 }
+
+func TestReviewEscalationIssueEscapesMentionsAndReferences(t *testing.T) {
+	review := asc.Resource[asc.ReviewAttributes]{
+		ID: "rev-1",
+		Attributes: asc.ReviewAttributes{
+			Rating:           1,
+			Title:            "Broken like #12",
+			Body:             "cc @octocat, see org/repo#34\nemail me at a@b.com",
+			ReviewerNickname: "@mallory",
+		},
+	}
+
+	title := reviewEscalationIssueTitle(review)
+	body := reviewEscalationIssueBody("app-1", review)
+	for _, reference := range []string{"#12", "@octocat", "#34", "@mallory", "@b"} {
+		if strings.Contains(title, reference) || strings.Contains(body, reference) {
+			t.Fatalf("expected %q to be escaped, got title %q body %q", reference, title, body)
+		}
+	}
+	if !strings.Contains(body, "> cc @\u200boctocat, see org/repo#\u200b34\n") {
+		t.Fatalf("expected escaped quoted body, got %q", body)
+	}
+}
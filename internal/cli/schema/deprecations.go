@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type deprecatedEndpoint struct {
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	ClientPath bool   `json:"clientPath"`
+}
+
+type deprecationsResult struct {
+	Data []deprecatedEndpoint `json:"data"`
+}
+
+// DeprecationsCommand returns the deprecations command.
+func DeprecationsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("deprecations", flag.ExitOnError)
+	all := fs.Bool("all", false, "Include deprecated endpoints asc does not call")
+	method := fs.String("method", "", "Filter by HTTP method (GET, POST, PATCH, DELETE)")
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json, table, markdown")
+
+	return &ffcli.Command{
+		Name:       "deprecations",
+		ShortUsage: "asc deprecations [flags] [query]",
+		ShortHelp:  "List App Store Connect API endpoints asc wraps that Apple marks deprecated.",
+		LongHelp: `List App Store Connect API endpoints asc wraps that Apple marks deprecated.
+
+Reads the bundled OpenAPI index, so it works offline. By default only
+endpoints whose paths the asc client calls are listed; use --all for every
+deprecated endpoint in the spec. An optional query filters by path substring.
+
+At runtime asc also warns on stderr, naming the command, when App Store
+Connect answers with Deprecation or Sunset headers or reports an endpoint as
+deprecated in an error.
+
+Examples:
+  asc deprecations
+  asc deprecations gameCenterLeaderboards
+  asc deprecations --method PATCH --output table
+  asc deprecations --all --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			methodFilter, err := normalizeMethodFilter(*method)
+			if err != nil {
+				return err
+			}
+			endpoints, err := loadIndex()
+			if err != nil {
+				return err
+			}
+
+			result := deprecationsResult{Data: filterDeprecated(endpoints, strings.Join(args, " "), methodFilter, *all)}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printDeprecations(result.Data, asc.RenderTable) },
+				func() error { return printDeprecations(result.Data, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+func filterDeprecated(endpoints []Endpoint, query, methodFilter string, all bool) []deprecatedEndpoint {
+	query = strings.TrimSpace(query)
+	results := []deprecatedEndpoint{}
+	for _, e := range endpoints {
+		if !e.Deprecated || (!all && !e.ClientPath) {
+			continue
+		}
+		if methodFilter != "" && e.Method != methodFilter {
+			continue
+		}
+		if query != "" && !matchEndpoint(e, query) {
+			continue
+		}
+		results = append(results, deprecatedEndpoint{Method: e.Method, Path: e.Path, ClientPath: e.ClientPath})
+	}
+	return results
+}
+
+func printDeprecations(endpoints []deprecatedEndpoint, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		used := "no"
+		if e.ClientPath {
+			used = "yes"
+		}
+		rows = append(rows, []string{e.Method, e.Path, used})
+	}
+	render([]string{"Method", "Path", "Called by asc"}, rows)
+	return nil
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"testing"
+)
+
+func TestLoadIndex_MarksDeprecatedClientEndpoints(t *testing.T) {
+	endpoints, err := loadIndex()
+	if err != nil {
+		t.Fatalf("loadIndex() error: %v", err)
+	}
+
+	deprecated := filterDeprecated(endpoints, "", "", true)
+	wrapped := filterDeprecated(endpoints, "", "", false)
+	if len(wrapped) == 0 || len(wrapped) > len(deprecated) {
+		t.Fatalf("expected wrapped deprecated endpoints to be a non-empty subset, got %d of %d", len(wrapped), len(deprecated))
+	}
+	for _, e := range wrapped {
+		if !e.ClientPath {
+			t.Fatalf("expected only client paths by default, got %+v", e)
+		}
+	}
+
+	found := false
+	for _, e := range wrapped {
+		if e.Method == "GET" && e.Path == "/v1/apps/{id}/inAppPurchases" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected GET /v1/apps/{id}/inAppPurchases to be listed")
+	}
+}
+
+func TestFilterDeprecated(t *testing.T) {
+	endpoints := []Endpoint{
+		{Method: "GET", Path: "/v1/apps"},
+		{Method: "GET", Path: "/v1/apps/{id}/inAppPurchases", Deprecated: true, ClientPath: true},
+		{Method: "PATCH", Path: "/v1/gameCenterGroups/{id}/relationships/gameCenterLeaderboards", Deprecated: true, ClientPath: true},
+		{Method: "GET", Path: "/v1/gameCenterGroups/{id}/gameCenterLeaderboardSets", Deprecated: true},
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		method string
+		all    bool
+		want   []string
+	}{
+		{name: "client paths", want: []string{"GET /v1/apps/{id}/inAppPurchases", "PATCH /v1/gameCenterGroups/{id}/relationships/gameCenterLeaderboards"}},
+		{name: "all", all: true, want: []string{"GET /v1/apps/{id}/inAppPurchases", "PATCH /v1/gameCenterGroups/{id}/relationships/gameCenterLeaderboards", "GET /v1/gameCenterGroups/{id}/gameCenterLeaderboardSets"}},
+		{name: "method", method: "PATCH", want: []string{"PATCH /v1/gameCenterGroups/{id}/relationships/gameCenterLeaderboards"}},
+		{name: "query", query: "gameCenter", all: true, want: []string{"PATCH /v1/gameCenterGroups/{id}/relationships/gameCenterLeaderboards", "GET /v1/gameCenterGroups/{id}/gameCenterLeaderboardSets"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := filterDeprecated(endpoints, test.query, test.method, test.all)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %d endpoints, want %d: %+v", len(got), len(test.want), got)
+			}
+			for i, e := range got {
+				if key := e.Method + " " + e.Path; key != test.want[i] {
+					t.Fatalf("endpoint %d = %q, want %q", i, key, test.want[i])
+				}
+			}
+		})
+	}
+}
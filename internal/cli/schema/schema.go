@@ -26,6 +26,8 @@ type Endpoint struct {
 	RequestSchema     string         `json:"requestSchema,omitempty"`
 	RequestAttributes map[string]any `json:"requestAttributes,omitempty"`
 	ResponseSchema    string         `json:"responseSchema,omitempty"`
+	Deprecated        bool           `json:"deprecated,omitempty"`
+	ClientPath        bool           `json:"clientPath,omitempty"`
 }
 
 // Parameter describes a query/path parameter.
@@ -40,6 +40,7 @@ App Store workflow:
   asc screenshots sizes --all
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots/iphone" --device-type "IPHONE_65"
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots/ipad" --device-type "IPAD_PRO_3GEN_129"
+  asc screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --confirm
   asc screenshots download --version-localization "LOC_ID" --output-dir "./screenshots/downloaded"
   asc screenshots delete --id "SCREENSHOT_ID" --confirm
 
@@ -59,6 +60,7 @@ default; use --all only when you need the full matrix.`,
 			assets.AssetsScreenshotsListCommand(),
 			assets.AssetsScreenshotsSizesCommand(),
 			assets.AssetsScreenshotsUploadCommand(),
+			assets.AssetsScreenshotsSyncCommand(),
 			assets.AssetsScreenshotsDownloadCommand(),
 			assets.AssetsScreenshotsDeleteCommand(),
 		},
@@ -0,0 +1,393 @@
+package serve
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	serveDefaultListen = "127.0.0.1:8080"
+	serveTokenEnvVar   = "ASC_SERVE_TOKEN"
+
+	// serveMaxBodyBytes caps request bodies forwarded to App Store Connect.
+	// JSON:API payloads are small; uploads go straight to Apple's upload URLs.
+	serveMaxBodyBytes = 10 << 20
+)
+
+// serveClient is the subset of *asc.Client used by the gateway.
+type serveClient interface {
+	Do(ctx context.Context, method, path string, body io.Reader) ([]byte, error)
+}
+
+type serveOptions struct {
+	token       string
+	allowWrites bool
+	// listenHost is the host from --listen; requests naming it in their Host
+	// header are accepted alongside loopback names.
+	listenHost string
+	log        io.Writer
+}
+
+type gateway struct {
+	client serveClient
+	opts   serveOptions
+}
+
+// ServeCommand returns the serve command.
+func ServeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	listen := fs.String("listen", serveDefaultListen, "Address to listen on (host:port)")
+	allowRemote := fs.Bool("allow-remote", false, "Allow listening on non-loopback addresses (requires --token)")
+	token := fs.String("token", "", "Bearer token clients must send (or "+serveTokenEnvVar+" env)")
+	allowWrites := fs.Bool("allow-writes", false, "Forward POST, PATCH, and DELETE requests (requires --token; default: GET and HEAD only)")
+	rateLimit := fs.String("rate-limit", "", "Throttle App Store Connect requests to N per second across all clients (overrides --rate-limit/ASC_RATE_LIMIT)")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc serve [flags]",
+		ShortHelp:  "Run a local REST gateway to the App Store Connect API.",
+		LongHelp: `Run a local REST gateway to the App Store Connect API.
+
+Requests to /v1/..., /v2/..., and /v3/... are forwarded to App Store Connect
+with the same path and query, signed with the active asc credentials. Every
+client shares one cached API token, the retry policy, and one rate-limit
+budget, so several internal tools can use a single credentialed gateway
+without each holding the private key.
+
+Responses are passed through as JSON with the upstream HTTP status. API
+errors keep their status and are returned as a JSON:API "errors" document.
+GET /healthz reports readiness.
+
+Security note:
+  The default address is loopback-only and only GET and HEAD are forwarded.
+  Listening on non-loopback addresses requires --allow-remote and --token.
+  Mutating requests require --allow-writes and --token.
+  Requests must name a loopback host or the --listen host in their Host
+  header, and browser requests carrying an Origin header are rejected, so
+  web pages cannot reach the gateway through DNS rebinding or cross-site
+  form posts.
+
+Examples:
+  asc serve
+  asc serve --listen 127.0.0.1:8080 --rate-limit 5
+  asc serve --listen :8080 --allow-remote --token "$ASC_SERVE_TOKEN" --allow-writes
+  curl -s "http://127.0.0.1:8080/v1/apps?limit=5"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: serve does not accept positional arguments")
+				return flag.ErrHelp
+			}
+
+			address := strings.TrimSpace(*listen)
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return shared.UsageErrorf("--listen must be host:port: %v", err)
+			}
+			tokenValue := strings.TrimSpace(*token)
+			if tokenValue == "" {
+				tokenValue = strings.TrimSpace(os.Getenv(serveTokenEnvVar))
+			}
+			if !isLoopbackServeHost(host) {
+				if !*allowRemote {
+					return shared.UsageErrorf("listening on non-loopback address %q requires --allow-remote", address)
+				}
+				if tokenValue == "" {
+					return shared.UsageErrorf("listening on non-loopback address %q requires --token (or %s)", address, serveTokenEnvVar)
+				}
+			}
+			if *allowWrites && tokenValue == "" {
+				return shared.UsageErrorf("--allow-writes requires --token (or %s)", serveTokenEnvVar)
+			}
+			if raw := strings.TrimSpace(*rateLimit); raw != "" {
+				value, err := asc.ParseRateLimit(raw)
+				if err != nil {
+					return shared.UsageErrorf("--rate-limit %v", err)
+				}
+				asc.SetRateLimitOverride(&value)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("serve: %w", err)
+			}
+
+			listener, err := net.Listen("tcp", address)
+			if err != nil {
+				return fmt.Errorf("serve: failed to listen on %s: %w", address, err)
+			}
+			defer listener.Close()
+
+			gw := &gateway{
+				client: client,
+				opts: serveOptions{
+					token:       tokenValue,
+					allowWrites: *allowWrites,
+					listenHost:  host,
+					log:         os.Stderr,
+				},
+			}
+			return runGateway(ctx, gw, listener)
+		},
+	}
+}
+
+// runGateway serves gw on listener until ctx is cancelled.
+func runGateway(ctx context.Context, gw *gateway, listener net.Listener) error {
+	timeout := asc.ResolveTimeout()
+	server := &http.Server{
+		Handler:           gw.newHandler(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		// Forwarded requests may retry and wait on the rate limiter.
+		WriteTimeout: timeout + 30*time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErrCh <- err
+			return
+		}
+		serveErrCh <- nil
+	}()
+
+	fmt.Fprintf(os.Stdout, "Serving App Store Connect API gateway on http://%s\n", listener.Addr().String())
+
+	select {
+	case err := <-serveErrCh:
+		if err != nil {
+			return fmt.Errorf("serve: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+		if err := <-serveErrCh; err != nil {
+			return fmt.Errorf("serve: %w", err)
+		}
+		return nil
+	}
+}
+
+func (g *gateway) newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc("/", g.forward)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !g.allowedHost(req.Host) {
+			writeError(w, http.StatusForbidden, "FORBIDDEN_HOST", fmt.Sprintf("host %q is not served by this gateway", req.Host))
+			return
+		}
+		if req.Header.Get("Origin") != "" {
+			writeError(w, http.StatusForbidden, "FORBIDDEN_ORIGIN", "browser cross-origin requests are not accepted")
+			return
+		}
+		mux.ServeHTTP(w, req)
+	})
+}
+
+// allowedHost reports whether a request's Host header names this gateway:
+// a loopback name, the --listen host, or an IP literal when listening on all
+// interfaces. Other names are rejected so a rebound DNS name cannot reach it.
+func (g *gateway) allowedHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if host == "" {
+		return false
+	}
+	if isLoopbackServeHost(host) {
+		return true
+	}
+	listenHost := strings.Trim(strings.TrimSpace(g.opts.listenHost), "[]")
+	if strings.EqualFold(host, listenHost) {
+		return true
+	}
+	if listenHost == "" || net.ParseIP(listenHost).IsUnspecified() {
+		return net.ParseIP(host) != nil
+	}
+	return false
+}
+
+func (g *gateway) forward(w http.ResponseWriter, req *http.Request) {
+	started := time.Now()
+	status := g.handle(w, req)
+	if g.opts.log != nil {
+		fmt.Fprintf(g.opts.log, "%s %s %d %s\n", req.Method, req.URL.Path, status, time.Since(started).Round(time.Millisecond))
+	}
+}
+
+// handle forwards one request and returns the status written to the client.
+func (g *gateway) handle(w http.ResponseWriter, req *http.Request) int {
+	if !g.authorized(req) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		return writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing or invalid bearer token")
+	}
+	if !isAPIPath(req.URL.Path) {
+		return writeError(w, http.StatusNotFound, "NOT_FOUND", "only /v1/, /v2/, and /v3/ API paths are forwarded")
+	}
+	if strings.ContainsAny(req.URL.Path, "%\\") {
+		return writeError(w, http.StatusBadRequest, "INVALID_PATH", "API path contains an unsafe character")
+	}
+
+	method := strings.ToUpper(req.Method)
+	switch method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPost, http.MethodPatch, http.MethodDelete:
+		if !g.opts.allowWrites {
+			w.Header().Set("Allow", "GET, HEAD")
+			return writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "mutating requests require asc serve --allow-writes")
+		}
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST, PATCH, DELETE")
+		return writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("method %s is not supported", req.Method))
+	}
+
+	var body io.Reader
+	if method != http.MethodGet && method != http.MethodHead {
+		data, err := io.ReadAll(http.MaxBytesReader(w, req.Body, serveMaxBodyBytes))
+		if err != nil {
+			return writeError(w, http.StatusRequestEntityTooLarge, "BODY_TOO_LARGE", err.Error())
+		}
+		if len(data) > 0 {
+			body = bytes.NewReader(data)
+		}
+	}
+
+	path := req.URL.Path
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(asc.WithResponseStatus(req.Context()))
+	defer cancel()
+	data, err := g.client.Do(requestCtx, method, path, body)
+	if err != nil {
+		return writeUpstreamError(w, err)
+	}
+
+	status := asc.ResponseStatus(requestCtx)
+	if len(bytes.TrimSpace(data)) == 0 {
+		if status == 0 {
+			status = http.StatusNoContent
+		}
+		w.WriteHeader(status)
+		return status
+	}
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if method != http.MethodHead {
+		_, _ = w.Write(data)
+	}
+	return status
+}
+
+func (g *gateway) authorized(req *http.Request) bool {
+	if g.opts.token == "" {
+		return true
+	}
+	provided, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(provided)), []byte(g.opts.token)) == 1
+}
+
+func isAPIPath(path string) bool {
+	for _, prefix := range []string{"/v1/", "/v2/", "/v3/"} {
+		if strings.HasPrefix(path, prefix) && len(path) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUpstreamError maps a client error onto an HTTP response, keeping the
+// App Store Connect status where one is known.
+func writeUpstreamError(w http.ResponseWriter, err error) int {
+	if errors.Is(err, asc.ErrDryRun) {
+		writeJSON(w, http.StatusAccepted, map[string]bool{"dryRun": true})
+		return http.StatusAccepted
+	}
+	if apiErr, ok := errors.AsType[*asc.APIError](err); ok && apiErr.StatusCode != 0 {
+		code := apiErr.Code
+		if code == "" {
+			code = "API_ERROR"
+		}
+		return writeErrorDocument(w, apiErr.StatusCode, code, apiErr.Title, apiErr.Detail)
+	}
+	if retryErr, ok := errors.AsType[*asc.RetryableError](err); ok {
+		if retryErr.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryErr.RetryAfter.Round(time.Second).Seconds())))
+		}
+		return writeError(w, http.StatusServiceUnavailable, "UPSTREAM_UNAVAILABLE", err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return writeError(w, http.StatusGatewayTimeout, "UPSTREAM_TIMEOUT", err.Error())
+	}
+	return writeError(w, http.StatusBadGateway, "UPSTREAM_ERROR", err.Error())
+}
+
+func writeError(w http.ResponseWriter, status int, code, detail string) int {
+	return writeErrorDocument(w, status, code, http.StatusText(status), detail)
+}
+
+func writeErrorDocument(w http.ResponseWriter, status int, code, title, detail string) int {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  title,
+			"detail": detail,
+		}},
+	})
+	return status
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+func isLoopbackServeHost(host string) bool {
+	normalized := strings.TrimSpace(host)
+	if normalized == "" {
+		return false
+	}
+	if strings.EqualFold(normalized, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(normalized, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
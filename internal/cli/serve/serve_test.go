@@ -0,0 +1,389 @@
+package serve
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   string
+}
+
+type fakeServeClient struct {
+	requests []recordedRequest
+	response []byte
+	err      error
+}
+
+func (f *fakeServeClient) Do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	var data []byte
+	if body != nil {
+		data, _ = io.ReadAll(body)
+	}
+	f.requests = append(f.requests, recordedRequest{method: method, path: path, body: string(data)})
+	return f.response, f.err
+}
+
+func newTestGateway(client *fakeServeClient, opts serveOptions) *httptest.Server {
+	gw := &gateway{client: client, opts: opts}
+	return httptest.NewServer(gw.newHandler())
+}
+
+func doRequest(t *testing.T, method, url, body string, header http.Header) (*http.Response, map[string]any) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	var decoded map[string]any
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			t.Fatalf("decode body %q: %v", raw, err)
+		}
+	}
+	return resp, decoded
+}
+
+func errorCode(t *testing.T, body map[string]any) string {
+	t.Helper()
+	errs, ok := body["errors"].([]any)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("expected one error, got %#v", body)
+	}
+	return errs[0].(map[string]any)["code"].(string)
+}
+
+func TestGatewayForwardsGETWithQuery(t *testing.T) {
+	client := &fakeServeClient{response: []byte(`{"data":[{"type":"apps","id":"123"}]}`)}
+	server := newTestGateway(client, serveOptions{})
+	defer server.Close()
+
+	resp, body := doRequest(t, http.MethodGet, server.URL+"/v1/apps?limit=5&fields[apps]=name", "", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("expected JSON content type, got %q", got)
+	}
+	data, ok := body["data"].([]any)
+	if !ok || len(data) != 1 || data[0].(map[string]any)["id"] != "123" {
+		t.Fatalf("unexpected body: %#v", body)
+	}
+	if len(client.requests) != 1 {
+		t.Fatalf("expected 1 forwarded request, got %d", len(client.requests))
+	}
+	got := client.requests[0]
+	if got.method != http.MethodGet || got.path != "/v1/apps?limit=5&fields[apps]=name" {
+		t.Fatalf("unexpected forwarded request: %#v", got)
+	}
+}
+
+func TestGatewayRejectsWritesUnlessAllowed(t *testing.T) {
+	client := &fakeServeClient{response: []byte(`{"data":{"type":"betaGroups","id":"g1"}}`)}
+	server := newTestGateway(client, serveOptions{})
+	defer server.Close()
+
+	resp, body := doRequest(t, http.MethodPost, server.URL+"/v1/betaGroups", `{"data":{}}`, nil)
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405, got %d", resp.StatusCode)
+	}
+	if code := errorCode(t, body); code != "METHOD_NOT_ALLOWED" {
+		t.Fatalf("expected METHOD_NOT_ALLOWED, got %q", code)
+	}
+	if len(client.requests) != 0 {
+		t.Fatalf("expected no forwarded requests, got %#v", client.requests)
+	}
+
+	writable := newTestGateway(client, serveOptions{allowWrites: true})
+	defer writable.Close()
+	resp, _ = doRequest(t, http.MethodPost, writable.URL+"/v1/betaGroups", `{"data":{}}`, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 with --allow-writes, got %d", resp.StatusCode)
+	}
+	if len(client.requests) != 1 || client.requests[0].body != `{"data":{}}` {
+		t.Fatalf("expected forwarded body, got %#v", client.requests)
+	}
+}
+
+func TestGatewayRequiresBearerToken(t *testing.T) {
+	client := &fakeServeClient{response: []byte(`{"data":[]}`)}
+	server := newTestGateway(client, serveOptions{token: "secret"})
+	defer server.Close()
+
+	resp, body := doRequest(t, http.MethodGet, server.URL+"/v1/apps", "", nil)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+	if code := errorCode(t, body); code != "UNAUTHORIZED" {
+		t.Fatalf("expected UNAUTHORIZED, got %q", code)
+	}
+
+	resp, _ = doRequest(t, http.MethodGet, server.URL+"/v1/apps", "", http.Header{"Authorization": {"Bearer secret"}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 with token, got %d", resp.StatusCode)
+	}
+	if len(client.requests) != 1 {
+		t.Fatalf("expected 1 forwarded request, got %d", len(client.requests))
+	}
+}
+
+func TestGatewayRejectsNonAPIPaths(t *testing.T) {
+	client := &fakeServeClient{}
+	server := newTestGateway(client, serveOptions{})
+	defer server.Close()
+
+	for _, path := range []string{"/", "/v1/", "/metrics", "/v4/apps"} {
+		resp, body := doRequest(t, http.MethodGet, server.URL+path, "", nil)
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("%s: expected 404, got %d", path, resp.StatusCode)
+		}
+		if code := errorCode(t, body); code != "NOT_FOUND" {
+			t.Fatalf("%s: expected NOT_FOUND, got %q", path, code)
+		}
+	}
+	if len(client.requests) != 0 {
+		t.Fatalf("expected no forwarded requests, got %#v", client.requests)
+	}
+}
+
+func TestGatewayMapsUpstreamErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "api error keeps status",
+			err:        &asc.APIError{Code: "NOT_FOUND", Title: "Not Found", Detail: "no app", StatusCode: http.StatusNotFound},
+			wantStatus: http.StatusNotFound,
+			wantCode:   "NOT_FOUND",
+		},
+		{
+			name:       "retries exhausted",
+			err:        &asc.RetryableError{Err: errors.New("rate limited"), RetryAfter: 30 * time.Second},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   "UPSTREAM_UNAVAILABLE",
+		},
+		{
+			name:       "transport failure",
+			err:        errors.New("request failed: connection refused"),
+			wantStatus: http.StatusBadGateway,
+			wantCode:   "UPSTREAM_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestGateway(&fakeServeClient{err: tt.err}, serveOptions{})
+			defer server.Close()
+
+			resp, body := doRequest(t, http.MethodGet, server.URL+"/v1/apps/123", "", nil)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if code := errorCode(t, body); code != tt.wantCode {
+				t.Fatalf("expected %q, got %q", tt.wantCode, code)
+			}
+		})
+	}
+}
+
+func TestGatewayDeleteReturnsNoContent(t *testing.T) {
+	client := &fakeServeClient{}
+	server := newTestGateway(client, serveOptions{allowWrites: true})
+	defer server.Close()
+
+	resp, body := doRequest(t, http.MethodDelete, server.URL+"/v1/betaGroups/g1", "", nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	if body != nil {
+		t.Fatalf("expected empty body, got %#v", body)
+	}
+}
+
+func TestGatewayPassesThroughUpstreamStatus(t *testing.T) {
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/betaGroups" {
+			t.Fatalf("unexpected upstream request %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"betaGroups","id":"g1"}}`)),
+		}, nil
+	})
+
+	gw := &gateway{client: newServeTestClient(t), opts: serveOptions{token: "secret", allowWrites: true}}
+	server := httptest.NewServer(gw.newHandler())
+	defer server.Close()
+
+	resp, body := doRequest(t, http.MethodPost, server.URL+"/v1/betaGroups", `{"data":{}}`, http.Header{"Authorization": {"Bearer secret"}})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected upstream 201, got %d", resp.StatusCode)
+	}
+	if data, ok := body["data"].(map[string]any); !ok || data["id"] != "g1" {
+		t.Fatalf("unexpected body: %#v", body)
+	}
+}
+
+func TestGatewayRejectsForeignHostAndOrigin(t *testing.T) {
+	client := &fakeServeClient{response: []byte(`{"data":[]}`)}
+	server := newTestGateway(client, serveOptions{listenHost: "127.0.0.1"})
+	defer server.Close()
+
+	send := func(host string, header http.Header) int {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/apps", nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		if host != "" {
+			req.Host = host
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if status := send("", nil); status != http.StatusOK {
+		t.Fatalf("expected loopback host to be served, got %d", status)
+	}
+	if status := send("localhost:8080", nil); status != http.StatusOK {
+		t.Fatalf("expected localhost to be served, got %d", status)
+	}
+	if status := send("attacker.example:8080", nil); status != http.StatusForbidden {
+		t.Fatalf("expected rebound host to be rejected, got %d", status)
+	}
+	if status := send("", http.Header{"Origin": {"https://attacker.example"}}); status != http.StatusForbidden {
+		t.Fatalf("expected cross-origin request to be rejected, got %d", status)
+	}
+	if len(client.requests) != 2 {
+		t.Fatalf("expected only the two allowed requests forwarded, got %#v", client.requests)
+	}
+}
+
+func TestGatewayAllowedHost(t *testing.T) {
+	tests := []struct {
+		listenHost string
+		host       string
+		want       bool
+	}{
+		{listenHost: "127.0.0.1", host: "127.0.0.1:8080", want: true},
+		{listenHost: "127.0.0.1", host: "[::1]:8080", want: true},
+		{listenHost: "127.0.0.1", host: "rebind.example:8080", want: false},
+		{listenHost: "10.0.0.5", host: "10.0.0.5:8080", want: true},
+		{listenHost: "10.0.0.5", host: "10.0.0.6:8080", want: false},
+		{listenHost: "gateway.internal", host: "gateway.internal:8080", want: true},
+		{listenHost: "", host: "10.0.0.6:8080", want: true},
+		{listenHost: "0.0.0.0", host: "rebind.example", want: false},
+		{listenHost: "127.0.0.1", host: "", want: false},
+	}
+	for _, tt := range tests {
+		gw := &gateway{opts: serveOptions{listenHost: tt.listenHost}}
+		if got := gw.allowedHost(tt.host); got != tt.want {
+			t.Fatalf("allowedHost(%q) with --listen host %q = %t, want %t", tt.host, tt.listenHost, got, tt.want)
+		}
+	}
+}
+
+func TestServeCommandAllowWritesRequiresToken(t *testing.T) {
+	t.Setenv(serveTokenEnvVar, "")
+	os.Unsetenv(serveTokenEnvVar)
+
+	cmd := ServeCommand()
+	if err := cmd.FlagSet.Parse([]string{"--allow-writes"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	err := cmd.Exec(context.Background(), nil)
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected --allow-writes usage error, got %v", err)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func newServeTestClient(t *testing.T) *asc.Client {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	client, err := asc.NewClientFromPEM("KEY_ID", "ISSUER_ID", string(pemBytes))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	return client
+}
+
+func TestIsLoopbackServeHost(t *testing.T) {
+	for host, want := range map[string]bool{
+		"127.0.0.1": true,
+		"localhost": true,
+		"::1":       true,
+		"":          false,
+		"0.0.0.0":   false,
+		"10.0.0.5":  false,
+	} {
+		if got := isLoopbackServeHost(host); got != want {
+			t.Fatalf("isLoopbackServeHost(%q) = %t, want %t", host, got, want)
+		}
+	}
+}
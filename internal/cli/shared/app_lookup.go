@@ -28,13 +28,18 @@ func ResolveAppIDWithExactLookup(ctx context.Context, client appLookupClient, ap
 	if client == nil {
 		return "", fmt.Errorf("app lookup client is required for non-numeric --app values")
 	}
+	if cachedID, ok := cachedAppID(client, resolved); ok {
+		return cachedID, nil
+	}
 
 	byBundle, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{resolved}), asc.WithAppsLimit(2))
 	if err != nil {
 		return "", fmt.Errorf("resolve app by bundle ID: %w", err)
 	}
 	if len(byBundle.Data) == 1 {
-		return strings.TrimSpace(byBundle.Data[0].ID), nil
+		id := strings.TrimSpace(byBundle.Data[0].ID)
+		rememberAppID(client, idCacheKindBundleID, resolved, id)
+		return id, nil
 	}
 	if len(byBundle.Data) > 1 {
 		return "", fmt.Errorf("multiple apps found for bundle ID %q; use --app with App Store Connect app ID", resolved)
@@ -45,6 +50,7 @@ func ResolveAppIDWithExactLookup(ctx context.Context, client appLookupClient, ap
 		return "", fmt.Errorf("resolve app by name: %w", err)
 	}
 	if len(nameMatchIDs) == 1 {
+		rememberAppID(client, idCacheKindAppName, resolved, nameMatchIDs[0])
 		return nameMatchIDs[0], nil
 	}
 	if len(nameMatchIDs) > 1 {
@@ -57,6 +63,7 @@ func ResolveAppIDWithExactLookup(ctx context.Context, client appLookupClient, ap
 		return "", fmt.Errorf("resolve app by name: %w", err)
 	}
 	if len(nameMatchIDs) == 1 {
+		rememberAppID(client, idCacheKindAppName, resolved, nameMatchIDs[0])
 		return nameMatchIDs[0], nil
 	}
 	if len(nameMatchIDs) > 1 {
@@ -80,13 +87,18 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 	if client == nil {
 		return "", fmt.Errorf("app lookup client is required for non-numeric --app values")
 	}
+	if cachedID, ok := cachedAppID(client, resolved); ok {
+		return cachedID, nil
+	}
 
 	byBundle, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{resolved}), asc.WithAppsLimit(2))
 	if err != nil {
 		return "", fmt.Errorf("resolve app by bundle ID: %w", err)
 	}
 	if len(byBundle.Data) == 1 {
-		return strings.TrimSpace(byBundle.Data[0].ID), nil
+		id := strings.TrimSpace(byBundle.Data[0].ID)
+		rememberAppID(client, idCacheKindBundleID, resolved, id)
+		return id, nil
 	}
 	if len(byBundle.Data) > 1 {
 		return "", fmt.Errorf("multiple apps found for bundle ID %q; use --app with App Store Connect app ID", resolved)
@@ -97,6 +109,7 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 		return "", fmt.Errorf("resolve app by name: %w", err)
 	}
 	if len(nameMatchIDs) == 1 {
+		rememberAppID(client, idCacheKindAppName, resolved, nameMatchIDs[0])
 		return nameMatchIDs[0], nil
 	}
 	if len(nameMatchIDs) > 1 {
@@ -109,6 +122,7 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 		return "", fmt.Errorf("resolve app by name: %w", err)
 	}
 	if len(nameMatchIDs) == 1 {
+		rememberAppID(client, idCacheKindAppName, resolved, nameMatchIDs[0])
 		return nameMatchIDs[0], nil
 	}
 	if len(nameMatchIDs) > 1 {
@@ -151,10 +165,14 @@ func findExactAppNameMatches(ctx context.Context, client appLookupClient, name s
 
 	seen := map[string]struct{}{}
 	matchIDs := make([]string, 0, 1)
+	var listed []asc.Resource[asc.AppAttributes]
 	collect := func(resp *asc.AppsResponse) {
 		if resp == nil {
 			return
 		}
+		if !useNameFilter {
+			listed = append(listed, resp.Data...)
+		}
 		for _, app := range resp.Data {
 			if !strings.EqualFold(strings.TrimSpace(app.Attributes.Name), name) {
 				continue
@@ -188,6 +206,10 @@ func findExactAppNameMatches(ctx context.Context, client appLookupClient, name s
 	); err != nil {
 		return nil, err
 	}
+	// Only the full unfiltered listing shows whether a name is unique.
+	if !useNameFilter {
+		RememberApps(client, listed, true)
+	}
 
 	sort.Strings(matchIDs)
 	return matchIDs, nil
@@ -0,0 +1,146 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	appFlagName         = "app"
+	appBundleIDFlagName = "bundle-id"
+	appNameFlagName     = "app-name"
+)
+
+// WrapAppLookupFlags adds --bundle-id and --app-name to every command that
+// takes --app, so an app can be selected without first looking up its
+// numeric ID:
+//
+//	asc builds list --bundle-id com.example.app
+//	asc versions list --app-name "My App"
+//
+// The value is resolved by exact match (cached per team) and passed to the
+// command as --app. Commands that already define either flag keep their own.
+func WrapAppLookupFlags(cmd *ffcli.Command) {
+	if cmd == nil {
+		return
+	}
+	for _, sub := range cmd.Subcommands {
+		WrapAppLookupFlags(sub)
+	}
+
+	if cmd.Exec == nil || !acceptsAppLookupFlags(cmd) {
+		return
+	}
+
+	appFlag := cmd.FlagSet.Lookup(appFlagName)
+	bundleID := cmd.FlagSet.String(appBundleIDFlagName, "", "Select the app by exact bundle ID instead of --app")
+	appName := cmd.FlagSet.String(appNameFlagName, "", "Select the app by exact name instead of --app")
+
+	originalExec := cmd.Exec
+	cmd.Exec = func(ctx context.Context, args []string) error {
+		bundleValue := strings.TrimSpace(*bundleID)
+		nameValue := strings.TrimSpace(*appName)
+		if bundleValue == "" && nameValue == "" {
+			return originalExec(ctx, args)
+		}
+
+		selected := 0
+		for _, name := range []string{appFlagName, appBundleIDFlagName, appNameFlagName} {
+			if flagWasSet(cmd.FlagSet, name) {
+				selected++
+			}
+		}
+		if selected > 1 {
+			return UsageError("--app, --bundle-id, and --app-name are mutually exclusive")
+		}
+
+		return withReusedASCClient(func() error {
+			client, err := GetASCClient()
+			if err != nil {
+				return fmt.Errorf("%s: %w", cmd.Name, err)
+			}
+
+			lookupCtx, cancel := ContextWithTimeout(ctx)
+			appID, err := resolveAppLookupFlag(lookupCtx, client, bundleValue, nameValue)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("%s: %w", cmd.Name, err)
+			}
+			if err := appFlag.Value.Set(appID); err != nil {
+				return UsageErrorf("invalid --app %q: %v", appID, err)
+			}
+			return originalExec(ctx, args)
+		})
+	}
+}
+
+func acceptsAppLookupFlags(cmd *ffcli.Command) bool {
+	if cmd == nil || cmd.FlagSet == nil || len(cmd.Subcommands) > 0 {
+		return false
+	}
+	if cmd.FlagSet.Lookup(appFlagName) == nil {
+		return false
+	}
+	return cmd.FlagSet.Lookup(appBundleIDFlagName) == nil && cmd.FlagSet.Lookup(appNameFlagName) == nil
+}
+
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// resolveAppLookupFlag resolves --bundle-id or --app-name to an app ID.
+// Unlike ResolveAppIDWithLookup, each flag only matches its own attribute.
+func resolveAppLookupFlag(ctx context.Context, client appLookupClient, bundleID, name string) (string, error) {
+	if bundleID != "" {
+		if id, ok := cachedAppIDOfKind(client, idCacheKindBundleID, bundleID, idCacheBundleIDTTL); ok {
+			return id, nil
+		}
+		resp, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{bundleID}), asc.WithAppsLimit(2))
+		if err != nil {
+			return "", fmt.Errorf("resolve app by bundle ID: %w", err)
+		}
+		switch len(resp.Data) {
+		case 0:
+			return "", fmt.Errorf("no app found with bundle ID %q", bundleID)
+		case 1:
+			id := strings.TrimSpace(resp.Data[0].ID)
+			rememberAppID(client, idCacheKindBundleID, bundleID, id)
+			return id, nil
+		default:
+			return "", fmt.Errorf("multiple apps found for bundle ID %q; use --app with App Store Connect app ID", bundleID)
+		}
+	}
+
+	if id, ok := cachedAppIDOfKind(client, idCacheKindAppName, name, idCacheAppNameTTL); ok {
+		return id, nil
+	}
+	matches, err := findExactAppNameMatches(ctx, client, name, true)
+	if err == nil && len(matches) == 0 {
+		// ASC name filtering is fuzzy in practice; full-scan fallback preserves exact-name semantics.
+		matches, err = findExactAppNameMatches(ctx, client, name, false)
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolve app by name: %w", err)
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no app found named %q", name)
+	case 1:
+		rememberAppID(client, idCacheKindAppName, name, matches[0])
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("multiple apps found for name %q (%s); use --app with App Store Connect app ID", name, strings.Join(matches, ", "))
+	}
+}
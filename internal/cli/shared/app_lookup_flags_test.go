@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestWrapAppLookupFlagsSkipsCommandsWithOwnFlags(t *testing.T) {
+	plain := flag.NewFlagSet("list", flag.ContinueOnError)
+	plain.String("app", "", "")
+	own := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	own.String("app", "", "")
+	own.String("bundle-id", "", "Bundle identifier")
+	noApp := flag.NewFlagSet("view", flag.ContinueOnError)
+
+	exec := func(context.Context, []string) error { return nil }
+	root := &ffcli.Command{
+		Name: "group",
+		Subcommands: []*ffcli.Command{
+			{Name: "list", FlagSet: plain, Exec: exec},
+			{Name: "fetch", FlagSet: own, Exec: exec},
+			{Name: "view", FlagSet: noApp, Exec: exec},
+		},
+	}
+	WrapAppLookupFlags(root)
+
+	if plain.Lookup("bundle-id") == nil || plain.Lookup("app-name") == nil {
+		t.Fatal("expected --bundle-id and --app-name on a command with --app")
+	}
+	if own.Lookup("bundle-id").Usage != "Bundle identifier" || own.Lookup("app-name") != nil {
+		t.Fatal("expected a command with its own --bundle-id to be left alone")
+	}
+	if noApp.Lookup("bundle-id") != nil {
+		t.Fatal("expected no lookup flags without --app")
+	}
+}
+
+func TestResolveAppLookupFlagMatchesOnlyTheRequestedAttribute(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	byBundle := &sequenceAppLookupStub{responses: []*asc.AppsResponse{{}}}
+	if _, err := resolveAppLookupFlag(context.Background(), byBundle, "My App", ""); err == nil || !strings.Contains(err.Error(), `no app found with bundle ID "My App"`) {
+		t.Fatalf("expected bundle ID miss, got %v", err)
+	}
+	if byBundle.calls != 1 {
+		t.Fatalf("expected a single bundle ID lookup, got %d calls", byBundle.calls)
+	}
+
+	byName := &sequenceAppLookupStub{responses: []*asc.AppsResponse{
+		appsResponseFromApps([]appFixture{{id: "2", name: "My App Pro"}}),
+		appsResponseFromApps([]appFixture{{id: "1", name: "my app"}, {id: "2", name: "My App Pro"}}),
+	}}
+	got, err := resolveAppLookupFlag(context.Background(), byName, "", "My App")
+	if err != nil {
+		t.Fatalf("resolveAppLookupFlag() error: %v", err)
+	}
+	if got != "1" {
+		t.Fatalf("expected exact name match, got %q", got)
+	}
+}
@@ -3,7 +3,6 @@ package shared
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -57,7 +56,7 @@ func ResolveAppInfoID(ctx context.Context, client *asc.Client, appID, appInfoID
 			candidates := asc.FormatAppInfoCandidates(asc.AppInfoCandidates(resp.Data))
 			return "", fmt.Errorf("multiple app infos found for app %q (%s); run `asc apps info list --app %q` to inspect candidates, then pass the explicit app info ID", appID, candidates, appID)
 		}
-		fmt.Fprintf(os.Stderr, "Multiple app infos found for app %s, auto-selected %s (%s).\n", appID, selected, reason)
+		fmt.Fprintf(WarningWriter(), "Multiple app infos found for app %s, auto-selected %s (%s).\n", appID, selected, reason)
 		return selected, nil
 	}
 	return resp.Data[0].ID, nil
@@ -2,9 +2,12 @@ package shared
 
 import (
 	"fmt"
+	"os"
 	"strings"
 )
 
+const defaultPlatformEnvVar = "ASC_DEFAULT_PLATFORM"
+
 var appStoreVersionPlatforms = map[string]struct{}{
 	"IOS":       {},
 	"MAC_OS":    {},
@@ -35,6 +38,19 @@ var appStoreVersionStates = map[string]struct{}{
 	"NOT_APPLICABLE":                {},
 }
 
+// ResolvePlatform returns the --platform value for version-scoped commands,
+// falling back to ASC_DEFAULT_PLATFORM and then to fallback when neither is set.
+// The result is not validated; callers normalize it like a typed value.
+func ResolvePlatform(value, fallback string) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return trimmed
+	}
+	if env := strings.TrimSpace(os.Getenv(defaultPlatformEnvVar)); env != "" {
+		return env
+	}
+	return fallback
+}
+
 // NormalizeAppStoreVersionPlatform validates a single platform value.
 func NormalizeAppStoreVersionPlatform(value string) (string, error) {
 	normalized := strings.ToUpper(strings.TrimSpace(value))
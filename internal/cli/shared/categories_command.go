@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -24,7 +23,7 @@ type CategoriesSetCommandConfig struct {
 func NewCategoriesSetCommand(config CategoriesSetCommandConfig) *ffcli.Command {
 	fs := flag.NewFlagSet(config.FlagSetName, flag.ExitOnError)
 
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	var appInfoID *string
 	if config.IncludeAppInfo {
 		appInfoID = fs.String("app-info", "", "App Info ID (optional override)")
@@ -45,7 +44,7 @@ func NewCategoriesSetCommand(config CategoriesSetCommandConfig) *ffcli.Command {
 		FlagSet:    fs,
 		UsageFunc:  DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			appIDValue := strings.TrimSpace(*appID)
+			appIDValue := strings.TrimSpace(resolveAppID(strings.TrimSpace(*appID)))
 			primaryValue := strings.TrimSpace(*primary)
 			secondaryValue := strings.TrimSpace(*secondary)
 			primarySubOneValue := strings.TrimSpace(*primarySubOne)
@@ -59,7 +58,7 @@ func NewCategoriesSetCommand(config CategoriesSetCommandConfig) *ffcli.Command {
 			}
 
 			if appIDValue == "" {
-				return fmt.Errorf("%s: --app is required", config.ErrorPrefix)
+				return fmt.Errorf("%s: --app is required (or set ASC_APP_ID)", config.ErrorPrefix)
 			}
 			if primaryValue == "" {
 				return fmt.Errorf("%s: --primary is required", config.ErrorPrefix)
@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// ExpandCommandAliases replaces a leading alias from the config file's
+// aliases with the command line it stands for, keeping any root flags before
+// it and any args after it. Built-in commands always win over aliases with the
+// same name, and expansions are not expanded again.
+//
+// It runs before ExpandFlagArgs so alias expansions get the same flag
+// canonicalization as typed args.
+func ExpandCommandAliases(root *ffcli.Command, args []string) ([]string, error) {
+	if root == nil || len(args) == 0 {
+		return args, nil
+	}
+
+	idx := -1
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return args, nil
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			idx = i
+			break
+		}
+		name, _, hasValue := splitFlagArg(arg)
+		f, err := resolveFlag(root.FlagSet, name)
+		if err != nil || f == nil || hasValue || isBoolFlag(f) {
+			continue
+		}
+		i++
+	}
+	if idx < 0 || findSubcommand(root, args[idx]) != nil {
+		return args, nil
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		// Missing or invalid config is reported by the commands that need it.
+		return args, nil
+	}
+	expansion, ok := cfg.Aliases[args[idx]]
+	if !ok {
+		return args, nil
+	}
+	tokens, err := splitAliasCommandLine(expansion)
+	if err != nil {
+		return nil, UsageErrorf("config alias %q: %v", args[idx], err)
+	}
+	if len(tokens) > 0 && strings.EqualFold(tokens[0], root.Name) {
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return nil, UsageErrorf("config alias %q: expansion must not be empty", args[idx])
+	}
+
+	expanded := make([]string, 0, len(args)+len(tokens)-1)
+	expanded = append(expanded, args[:idx]...)
+	expanded = append(expanded, tokens...)
+	return append(expanded, args[idx+1:]...), nil
+}
+
+// splitAliasCommandLine splits an alias expansion into args using shell-style
+// single quotes, double quotes, and backslash escapes. It does not expand
+// variables or globs.
+func splitAliasCommandLine(line string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		inToken bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+			inToken = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inToken = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash")
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
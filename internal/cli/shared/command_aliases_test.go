@@ -0,0 +1,105 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestExpandCommandAliases(t *testing.T) {
+	writeCommandDefaultsConfig(t, `{
+		"aliases": {
+			"latest": "builds list --app 123 --output json",
+			"mine": "asc builds own --a 'display name'",
+			"builds": "builds own"
+		}
+	}`)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "expands alias and keeps trailing args",
+			args: []string{"latest", "--limit", "5"},
+			want: []string{"builds", "list", "--app", "123", "--output", "json", "--limit", "5"},
+		},
+		{
+			name: "keeps root flags before alias",
+			args: []string{"--profile", "ci", "latest"},
+			want: []string{"--profile", "ci", "builds", "list", "--app", "123", "--output", "json"},
+		},
+		{
+			name: "strips leading asc and honors quotes",
+			args: []string{"mine"},
+			want: []string{"builds", "own", "--a", "display name"},
+		},
+		{
+			name: "built-in commands win",
+			args: []string{"builds", "list"},
+			want: []string{"builds", "list"},
+		},
+		{
+			name: "unknown names are left alone",
+			args: []string{"nope"},
+			want: []string{"nope"},
+		},
+		{
+			name: "alias after double dash is left alone",
+			args: []string{"--", "latest"},
+			want: []string{"--", "latest"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ExpandCommandAliases(newFlagAliasTestTree(), test.args)
+			if err != nil {
+				t.Fatalf("ExpandCommandAliases() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("ExpandCommandAliases() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExpandCommandAliasesRejectsUnterminatedQuote(t *testing.T) {
+	writeCommandDefaultsConfig(t, `{"aliases": {"broken": "builds list --app \"123"}}`)
+
+	_, err := ExpandCommandAliases(newFlagAliasTestTree(), []string{"broken"})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
+
+func TestSplitAliasCommandLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{line: "builds list", want: []string{"builds", "list"}},
+		{line: "  builds\tlist  ", want: []string{"builds", "list"}},
+		{line: `apps get --name "My App"`, want: []string{"apps", "get", "--name", "My App"}},
+		{line: `apps get --name 'it"s'`, want: []string{"apps", "get", "--name", `it"s`}},
+		{line: `apps get --name My\ App`, want: []string{"apps", "get", "--name", "My App"}},
+		{line: `apps get --name ""`, want: []string{"apps", "get", "--name", ""}},
+	}
+	for _, test := range tests {
+		got, err := splitAliasCommandLine(test.line)
+		if err != nil {
+			t.Fatalf("splitAliasCommandLine(%q) error: %v", test.line, err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("splitAliasCommandLine(%q) = %q, want %q", test.line, got, test.want)
+		}
+	}
+
+	for _, line := range []string{`apps 'get`, `apps get\`} {
+		if _, err := splitAliasCommandLine(line); err == nil {
+			t.Fatalf("splitAliasCommandLine(%q) expected error", line)
+		}
+	}
+}
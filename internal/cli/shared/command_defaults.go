@@ -0,0 +1,181 @@
+package shared
+
+import (
+	"flag"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// commandDefaultsEntry is one command_defaults key with its flag values.
+type commandDefaultsEntry struct {
+	pattern  []string
+	literals int
+	key      string
+	flags    config.FlagDefaults
+}
+
+// ApplyCommandDefaults inserts flag defaults from the config file's
+// command_defaults (and the selected profile's) for the command args resolve
+// to. Flags passed explicitly are never overridden. Keys are command paths
+// below asc, such as "apps list"; "*" matches one command and "**" matches any
+// number, so "** list" covers every list command. More specific keys win, and
+// profile entries win over top-level ones.
+//
+// args should already be canonicalized by ExpandFlagArgs.
+func ApplyCommandDefaults(root *ffcli.Command, args []string) ([]string, error) {
+	if root == nil || len(args) == 0 {
+		return args, nil
+	}
+
+	cmd := root
+	var path []string
+	profile := ""
+	insertAt := -1
+	explicit := map[string]struct{}{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			sub := findSubcommand(cmd, arg)
+			if sub == nil {
+				break
+			}
+			cmd = sub
+			path = append(path, strings.ToLower(sub.Name))
+			insertAt = i + 1
+			clear(explicit)
+			continue
+		}
+
+		name, value, hasValue := splitFlagArg(arg)
+		explicit[name] = struct{}{}
+		f := lookupFlag(cmd, name)
+		if f == nil || hasValue || isBoolFlag(f) || i+1 >= len(args) {
+			if cmd == root && name == "profile" && hasValue {
+				profile = value
+			}
+			continue
+		}
+		i++
+		if cmd == root && name == "profile" {
+			profile = args[i]
+		}
+	}
+	if len(path) == 0 || cmd.FlagSet == nil {
+		return args, nil
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		// Missing or invalid config is reported by the commands that need it.
+		return args, nil
+	}
+	if strings.TrimSpace(profile) == "" {
+		profile = os.Getenv(profileEnvVar)
+	}
+	entries := matchingCommandDefaults(cfg.CommandDefaults, path)
+	if settings, ok := cfg.Profiles[strings.TrimSpace(profile)]; ok {
+		entries = append(entries, matchingCommandDefaults(settings.CommandDefaults, path)...)
+	}
+	if len(entries) == 0 {
+		return args, nil
+	}
+
+	values := map[string]string{}
+	for _, entry := range entries {
+		wildcard := entry.literals != len(entry.pattern)
+		for rawName, value := range entry.flags {
+			name := strings.TrimLeft(strings.TrimSpace(rawName), "-")
+			if cmd.FlagSet.Lookup(name) == nil {
+				if wildcard {
+					continue
+				}
+				return nil, UsageErrorf("config command_defaults %q: unknown flag --%s for %q", entry.key, name, strings.Join(path, " "))
+			}
+			values[name] = value
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if _, ok := explicit[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return args, nil
+	}
+	sort.Strings(names)
+
+	withDefaults := make([]string, 0, len(args)+len(names))
+	withDefaults = append(withDefaults, args[:insertAt]...)
+	for _, name := range names {
+		withDefaults = append(withDefaults, "--"+name+"="+values[name])
+	}
+	return append(withDefaults, args[insertAt:]...), nil
+}
+
+func lookupFlag(cmd *ffcli.Command, name string) *flag.Flag {
+	if cmd.FlagSet == nil {
+		return nil
+	}
+	return cmd.FlagSet.Lookup(name)
+}
+
+// matchingCommandDefaults returns the entries whose pattern matches path,
+// least specific first so later entries override earlier ones.
+func matchingCommandDefaults(defaults map[string]config.FlagDefaults, path []string) []commandDefaultsEntry {
+	var entries []commandDefaultsEntry
+	for key, flags := range defaults {
+		pattern := strings.Fields(strings.ToLower(key))
+		if len(pattern) > 0 && pattern[0] == "asc" {
+			pattern = pattern[1:]
+		}
+		if len(pattern) == 0 || !matchCommandPattern(pattern, path) {
+			continue
+		}
+		literals := 0
+		for _, segment := range pattern {
+			if segment != "*" && segment != "**" {
+				literals++
+			}
+		}
+		entries = append(entries, commandDefaultsEntry{pattern: pattern, literals: literals, key: key, flags: flags})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].literals != entries[j].literals {
+			return entries[i].literals < entries[j].literals
+		}
+		if len(entries[i].pattern) != len(entries[j].pattern) {
+			return len(entries[i].pattern) < len(entries[j].pattern)
+		}
+		return entries[i].key < entries[j].key
+	})
+	return entries
+}
+
+func matchCommandPattern(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	switch pattern[0] {
+	case "**":
+		for skip := 0; skip <= len(path); skip++ {
+			if matchCommandPattern(pattern[1:], path[skip:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(path) > 0 && matchCommandPattern(pattern[1:], path[1:])
+	default:
+		return len(path) > 0 && pattern[0] == path[0] && matchCommandPattern(pattern[1:], path[1:])
+	}
+}
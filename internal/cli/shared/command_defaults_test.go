@@ -0,0 +1,120 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeCommandDefaultsConfig(t *testing.T, body string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", path)
+	t.Setenv("ASC_PROFILE", "")
+}
+
+func TestApplyCommandDefaults(t *testing.T) {
+	writeCommandDefaultsConfig(t, `{
+		"command_defaults": {
+			"** list": {"limit": 200, "pretty": true, "nope": "ignored"},
+			"builds list": {"limit": 50}
+		},
+		"profiles": {
+			"ci": {"command_defaults": {"**": {"output": "table"}}}
+		}
+	}`)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "specific key beats wildcard",
+			args: []string{"builds", "list", "--app", "123"},
+			want: []string{"builds", "list", "--limit=50", "--pretty=true", "--app", "123"},
+		},
+		{
+			name: "explicit flags win",
+			args: []string{"builds", "list", "--limit", "5", "--pretty=false"},
+			want: []string{"builds", "list", "--limit", "5", "--pretty=false"},
+		},
+		{
+			name: "profile defaults apply after top-level ones",
+			args: []string{"--profile", "ci", "builds", "list"},
+			want: []string{"--profile", "ci", "builds", "list", "--limit=50", "--output=table", "--pretty=true"},
+		},
+		{
+			name: "group commands are left alone",
+			args: []string{"builds"},
+			want: []string{"builds"},
+		},
+		{
+			name: "non-matching commands are left alone",
+			args: []string{"builds", "own", "--app", "x"},
+			want: []string{"builds", "own", "--app", "x"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ApplyCommandDefaults(newFlagAliasTestTree(), test.args)
+			if err != nil {
+				t.Fatalf("ApplyCommandDefaults() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("ApplyCommandDefaults() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestApplyCommandDefaultsProfileFromEnv(t *testing.T) {
+	writeCommandDefaultsConfig(t, `{"profiles": {"ci": {"command_defaults": {"builds list": {"paginate": true}}}}}`)
+	t.Setenv("ASC_PROFILE", "ci")
+
+	got, err := ApplyCommandDefaults(newFlagAliasTestTree(), []string{"builds", "list"})
+	if err != nil {
+		t.Fatalf("ApplyCommandDefaults() error: %v", err)
+	}
+	want := []string{"builds", "list", "--paginate=true"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ApplyCommandDefaults() = %q, want %q", got, want)
+	}
+}
+
+func TestApplyCommandDefaultsRejectsUnknownFlagForExactCommand(t *testing.T) {
+	writeCommandDefaultsConfig(t, `{"command_defaults": {"builds list": {"nope": "1"}}}`)
+
+	_, err := ApplyCommandDefaults(newFlagAliasTestTree(), []string{"builds", "list"})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
+
+func TestMatchCommandPattern(t *testing.T) {
+	tests := []struct {
+		pattern []string
+		path    []string
+		want    bool
+	}{
+		{pattern: []string{"apps", "list"}, path: []string{"apps", "list"}, want: true},
+		{pattern: []string{"*", "list"}, path: []string{"apps", "list"}, want: true},
+		{pattern: []string{"*", "list"}, path: []string{"apps", "info", "list"}, want: false},
+		{pattern: []string{"**", "list"}, path: []string{"apps", "info", "list"}, want: true},
+		{pattern: []string{"**", "list"}, path: []string{"list"}, want: true},
+		{pattern: []string{"apps", "**"}, path: []string{"apps"}, want: true},
+		{pattern: []string{"apps", "get"}, path: []string{"apps", "list"}, want: false},
+	}
+	for _, test := range tests {
+		if got := matchCommandPattern(test.pattern, test.path); got != test.want {
+			t.Fatalf("matchCommandPattern(%q, %q) = %v, want %v", test.pattern, test.path, got, test.want)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package shared
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -46,7 +45,7 @@ func DeprecatedAliasLeafCommand(cmd *ffcli.Command, name, shortUsage, newCommand
 
 	origExec := cmd.Exec
 	clone.Exec = func(ctx context.Context, args []string) error {
-		fmt.Fprintln(os.Stderr, warning)
+		fmt.Fprintln(WarningWriter(), warning)
 		return origExec(ctx, args)
 	}
 
@@ -0,0 +1,107 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const complianceUsesNonExemptEncryption = "uses-non-exempt-encryption"
+
+// ComplianceFlag holds export compliance answers given as key=value pairs,
+// e.g. --compliance uses-non-exempt-encryption=false. Pairs may be
+// comma-separated or passed in repeated flags.
+type ComplianceFlag struct {
+	usesNonExemptEncryption *bool
+}
+
+// BindComplianceFlag registers --compliance on fs.
+func BindComplianceFlag(fs *flag.FlagSet) *ComplianceFlag {
+	compliance := &ComplianceFlag{}
+	fs.Var(compliance, "compliance", "Export compliance answers to set on the build, e.g. "+complianceUsesNonExemptEncryption+"=false")
+	return compliance
+}
+
+func (f *ComplianceFlag) Set(value string) error {
+	for _, pair := range SplitCSV(value) {
+		key, raw, ok := strings.Cut(pair, "=")
+		if !ok {
+			return fmt.Errorf("expected key=value (e.g. %s=false), got %q", complianceUsesNonExemptEncryption, pair)
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		switch key {
+		case complianceUsesNonExemptEncryption:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(raw))
+			if err != nil {
+				return fmt.Errorf("%s must be true or false", key)
+			}
+			f.usesNonExemptEncryption = &parsed
+		default:
+			return fmt.Errorf("unknown compliance key %q (supported: %s)", key, complianceUsesNonExemptEncryption)
+		}
+	}
+	return nil
+}
+
+func (f *ComplianceFlag) String() string {
+	if f == nil || f.usesNonExemptEncryption == nil {
+		return ""
+	}
+	return complianceUsesNonExemptEncryption + "=" + strconv.FormatBool(*f.usesNonExemptEncryption)
+}
+
+// SetUsesNonExemptEncryption sets the usesNonExemptEncryption answer, for
+// commands that also accept it as a standalone flag.
+func (f *ComplianceFlag) SetUsesNonExemptEncryption(value bool) {
+	f.usesNonExemptEncryption = &value
+}
+
+// IsSet reports whether any compliance answer was given.
+func (f *ComplianceFlag) IsSet() bool {
+	return f != nil && f.usesNonExemptEncryption != nil
+}
+
+// UsesNonExemptEncryption returns the usesNonExemptEncryption answer, or nil
+// when it was not given.
+func (f *ComplianceFlag) UsesNonExemptEncryption() *bool {
+	if f == nil || f.usesNonExemptEncryption == nil {
+		return nil
+	}
+	value := *f.usesNonExemptEncryption
+	return &value
+}
+
+// ApplyBuildCompliance sets the answers from compliance on a processed build,
+// skipping the update when the build already has the same answer. It returns
+// the answer the build now has, or nil when compliance is not set.
+func ApplyBuildCompliance(ctx context.Context, client *asc.Client, build *asc.BuildResponse, compliance *ComplianceFlag) (*bool, error) {
+	value := compliance.UsesNonExemptEncryption()
+	if value == nil {
+		return nil, nil
+	}
+	current := build.Data.Attributes.UsesNonExemptEncryption
+	if current != nil && *current == *value {
+		return value, nil
+	}
+	if _, err := client.UpdateBuildUsesNonExemptEncryption(ctx, build.Data.ID, *value); err != nil {
+		return nil, fmt.Errorf("failed to set encryption compliance: %w", err)
+	}
+	return value, nil
+}
+
+// ApplyBuildComplianceByID fetches a build and applies compliance to it. It
+// makes no requests when compliance is not set.
+func ApplyBuildComplianceByID(ctx context.Context, client *asc.Client, buildID string, compliance *ComplianceFlag) (*bool, error) {
+	if !compliance.IsSet() {
+		return nil, nil
+	}
+	build, err := client.GetBuild(ctx, buildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch build: %w", err)
+	}
+	return ApplyBuildCompliance(ctx, client, build, compliance)
+}
@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComplianceFlagSet(t *testing.T) {
+	var compliance ComplianceFlag
+	if compliance.IsSet() || compliance.UsesNonExemptEncryption() != nil {
+		t.Fatal("expected empty compliance flag to be unset")
+	}
+
+	if err := compliance.Set(" Uses-Non-Exempt-Encryption = false "); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	value := compliance.UsesNonExemptEncryption()
+	if !compliance.IsSet() || value == nil || *value {
+		t.Fatalf("expected usesNonExemptEncryption=false, got %v", value)
+	}
+	if got := compliance.String(); got != "uses-non-exempt-encryption=false" {
+		t.Fatalf("String() = %q", got)
+	}
+
+	// A later value wins, as with repeated flags.
+	if err := compliance.Set("uses-non-exempt-encryption=true"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if value := compliance.UsesNonExemptEncryption(); value == nil || !*value {
+		t.Fatalf("expected usesNonExemptEncryption=true, got %v", value)
+	}
+}
+
+func TestComplianceFlagSetErrors(t *testing.T) {
+	tests := map[string]string{
+		"encryption=false":                 `unknown compliance key "encryption"`,
+		"uses-non-exempt-encryption=maybe": "uses-non-exempt-encryption must be true or false",
+		"uses-non-exempt-encryption":       "expected key=value",
+	}
+	for input, wantErr := range tests {
+		var compliance ComplianceFlag
+		err := compliance.Set(input)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Set(%q) error = %v, want %q", input, err, wantErr)
+		}
+		if compliance.IsSet() {
+			t.Fatalf("Set(%q) should leave the flag unset", input)
+		}
+	}
+}
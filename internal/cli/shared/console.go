@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"flag"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const asciiEnvVar = "ASC_ASCII"
+
+var (
+	asciiMode      bool
+	terminalSizeFn = term.GetSize
+)
+
+// BindConsoleFlags registers --ascii for consoles without Unicode box-drawing
+// glyphs, such as legacy Windows consoles and some CI log viewers.
+func BindConsoleFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&asciiMode, "ascii", false, "Draw tables and spinners with ASCII characters instead of Unicode (or ASC_ASCII)")
+}
+
+// ASCIIEnabled reports whether tables and spinners should avoid Unicode glyphs.
+func ASCIIEnabled() bool {
+	return asciiMode || envFlagEnabled(asciiEnvVar)
+}
+
+// SetASCII sets ASCII console mode (tests only).
+func SetASCII(value bool) {
+	asciiMode = value
+}
+
+// TableColorEnabled reports whether tables may color status values, which
+// requires color support on stdout.
+func TableColorEnabled() bool {
+	return colorSupported(int(os.Stdout.Fd()))
+}
+
+// TableWidth returns the width tables are fitted to: COLUMNS when set, else
+// the terminal's width. It is 0 when stdout is not a terminal, so piped
+// tables are never truncated.
+func TableWidth() int {
+	fd := int(os.Stdout.Fd())
+	if !isTerminal(fd) {
+		return 0
+	}
+	if columns, err := strconv.Atoi(strings.TrimSpace(os.Getenv("COLUMNS"))); err == nil && columns > 0 {
+		return columns
+	}
+	width, _, err := terminalSizeFn(fd)
+	if err != nil || width <= 0 {
+		return 0
+	}
+	return width
+}
+
+// ApplyConsoleSettings pushes console rendering flags into the shared ASC
+// table renderer. Call it after the root flags are parsed.
+func ApplyConsoleSettings() {
+	asc.SetTableASCII(ASCIIEnabled())
+	asc.SetTableColor(TableColorEnabled())
+	asc.SetTableWidth(TableWidth())
+}
@@ -0,0 +1,11 @@
+//go:build !windows
+
+package shared
+
+func enableANSI() bool {
+	return true
+}
+
+func legacyConsole() bool {
+	return false
+}
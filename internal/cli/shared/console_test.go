@@ -0,0 +1,81 @@
+package shared
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+func TestASCIIEnabled(t *testing.T) {
+	t.Setenv(asciiEnvVar, "")
+	SetASCII(false)
+	t.Cleanup(func() { SetASCII(false) })
+
+	if ASCIIEnabled() {
+		t.Fatal("expected ASCII mode off by default")
+	}
+
+	t.Setenv(asciiEnvVar, "1")
+	if !ASCIIEnabled() {
+		t.Fatalf("expected %s=1 to enable ASCII mode", asciiEnvVar)
+	}
+
+	t.Setenv(asciiEnvVar, "")
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	BindConsoleFlags(fs)
+	if err := fs.Parse([]string{"--ascii"}); err != nil {
+		t.Fatalf("parse --ascii: %v", err)
+	}
+	if !ASCIIEnabled() {
+		t.Fatal("expected --ascii to enable ASCII mode")
+	}
+}
+
+func TestTableColorEnabled(t *testing.T) {
+	setTerminalDetection(t, func(int) bool { return true })
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("NO_COLOR", "")
+	os.Unsetenv("NO_COLOR")
+	if !TableColorEnabled() {
+		t.Fatal("expected color on a terminal")
+	}
+
+	t.Setenv("NO_COLOR", "")
+	if TableColorEnabled() {
+		t.Fatal("expected NO_COLOR to disable color even when empty")
+	}
+	os.Unsetenv("NO_COLOR")
+
+	t.Setenv("TERM", "dumb")
+	if TableColorEnabled() {
+		t.Fatal("expected TERM=dumb to disable color")
+	}
+
+	t.Setenv("TERM", "xterm-256color")
+	setTerminalDetection(t, func(int) bool { return false })
+	if TableColorEnabled() {
+		t.Fatal("expected no color when stdout is piped")
+	}
+}
+
+func TestTableWidth(t *testing.T) {
+	previous := terminalSizeFn
+	terminalSizeFn = func(int) (int, int, error) { return 100, 40, nil }
+	t.Cleanup(func() { terminalSizeFn = previous })
+	t.Setenv("COLUMNS", "")
+
+	setTerminalDetection(t, func(int) bool { return false })
+	if got := TableWidth(); got != 0 {
+		t.Fatalf("expected no width limit when piped, got %d", got)
+	}
+
+	setTerminalDetection(t, func(int) bool { return true })
+	if got := TableWidth(); got != 100 {
+		t.Fatalf("expected terminal width 100, got %d", got)
+	}
+
+	t.Setenv("COLUMNS", "72")
+	if got := TableWidth(); got != 72 {
+		t.Fatalf("expected COLUMNS to win, got %d", got)
+	}
+}
@@ -0,0 +1,50 @@
+//go:build windows
+
+package shared
+
+import (
+	"os"
+	"sync"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	enableANSIOnce   sync.Once
+	enableANSIResult bool
+)
+
+// enableANSI turns on virtual terminal processing for stdout and stderr so
+// ANSI styling renders instead of printing escape codes. It reports false on
+// consoles that cannot enable it (pre-Windows 10 conhost).
+func enableANSI() bool {
+	enableANSIOnce.Do(func() {
+		enableANSIResult = enableVirtualTerminal(os.Stderr) && enableVirtualTerminal(os.Stdout)
+	})
+	return enableANSIResult
+}
+
+func enableVirtualTerminal(file *os.File) bool {
+	handle := windows.Handle(file.Fd())
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err != nil {
+		// Not a console (pipe, file, or a mintty pty): leave the stream as is.
+		return true
+	}
+	if mode&windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING != 0 {
+		return true
+	}
+	return windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING) == nil
+}
+
+// legacyConsole reports whether output goes to the classic Windows console
+// host, whose default fonts lack braille and other spinner glyphs. Windows
+// Terminal, VS Code, and mintty-based shells set one of these variables.
+func legacyConsole() bool {
+	for _, name := range []string{"WT_SESSION", "TERM_PROGRAM", "TERM"} {
+		if os.Getenv(name) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -64,4 +64,132 @@ func resetRootLoggingFlagsForTest() {
 	retryLog = OptionalBool{}
 	debug = OptionalBool{}
 	apiDebug = OptionalBool{}
+	maxRetries = maxRetriesFlag{}
+	rateLimit = rateLimitFlag{}
+	uploadConcurrency = uploadConcurrencyFlag{}
+	paginateWorkers = paginateWorkersFlag{}
+	progressMode = progressModeFlag{}
+}
+
+func TestApplyRootLoggingOverridesMaxRetries(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "7")
+	resetRootLoggingFlagsForTest()
+	asc.SetMaxRetriesOverride(nil)
+	t.Cleanup(func() {
+		resetRootLoggingFlagsForTest()
+		asc.SetMaxRetriesOverride(nil)
+	})
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{args: nil, want: 7},
+		{args: []string{"--max-retries", "1"}, want: 1},
+		{args: []string{"--no-retry"}, want: 0},
+	}
+	for _, test := range tests {
+		resetRootLoggingFlagsForTest()
+		fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		BindRootFlags(fs)
+		if err := fs.Parse(test.args); err != nil {
+			t.Fatalf("parse root flags %v: %v", test.args, err)
+		}
+
+		ApplyRootLoggingOverrides()
+		if got := asc.ResolveRetryOptions().MaxRetries; got != test.want {
+			t.Fatalf("args %v: expected MaxRetries %d, got %d", test.args, test.want, got)
+		}
+	}
+}
+
+func TestBindRootFlagsRejectsInvalidRetryFlags(t *testing.T) {
+	tests := [][]string{
+		{"--max-retries", "-1"},
+		{"--max-retries", "many"},
+		{"--no-retry", "--max-retries", "2"},
+		{"--max-retries", "2", "--no-retry"},
+		{"--rate-limit", "-1"},
+		{"--rate-limit", "fast"},
+		{"--upload-concurrency", "0"},
+		{"--upload-concurrency", "many"},
+		{"--paginate-workers", "-1"},
+		{"--paginate-workers", "many"},
+	}
+	for _, args := range tests {
+		resetRootLoggingFlagsForTest()
+		fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		BindRootFlags(fs)
+		if err := fs.Parse(args); err == nil {
+			t.Fatalf("expected parse error for %v", args)
+		}
+	}
+	resetRootLoggingFlagsForTest()
+}
+
+func TestApplyRootLoggingOverridesUploadConcurrency(t *testing.T) {
+	t.Setenv("ASC_UPLOAD_CONCURRENCY", "6")
+	resetRootLoggingFlagsForTest()
+	asc.SetUploadConcurrencyOverride(nil)
+	t.Cleanup(func() {
+		resetRootLoggingFlagsForTest()
+		asc.SetUploadConcurrencyOverride(nil)
+	})
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{args: nil, want: 6},
+		{args: []string{"--upload-concurrency", "2"}, want: 2},
+	}
+	for _, test := range tests {
+		resetRootLoggingFlagsForTest()
+		fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		BindRootFlags(fs)
+		if err := fs.Parse(test.args); err != nil {
+			t.Fatalf("parse root flags %v: %v", test.args, err)
+		}
+
+		ApplyRootLoggingOverrides()
+		if got := asc.ResolveUploadConcurrency(); got != test.want {
+			t.Fatalf("args %v: expected upload concurrency %d, got %d", test.args, test.want, got)
+		}
+	}
+}
+
+func TestApplyRootLoggingOverridesPaginateWorkers(t *testing.T) {
+	t.Setenv("ASC_PAGINATE_WORKERS", "3")
+	resetRootLoggingFlagsForTest()
+	asc.SetPaginateWorkersOverride(nil)
+	t.Cleanup(func() {
+		resetRootLoggingFlagsForTest()
+		asc.SetPaginateWorkersOverride(nil)
+	})
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{args: nil, want: 3},
+		{args: []string{"--paginate-workers", "8"}, want: 8},
+		{args: []string{"--paginate-workers", "0"}, want: 0},
+	}
+	for _, test := range tests {
+		resetRootLoggingFlagsForTest()
+		fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		BindRootFlags(fs)
+		if err := fs.Parse(test.args); err != nil {
+			t.Fatalf("parse root flags %v: %v", test.args, err)
+		}
+
+		ApplyRootLoggingOverrides()
+		if got := asc.ResolvePaginateWorkers(); got != test.want {
+			t.Fatalf("args %v: expected paginate workers %d, got %d", test.args, test.want, got)
+		}
+	}
 }
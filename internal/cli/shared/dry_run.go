@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+const dryRunFlagName = "dry-run"
+
+// hoistedRootFlags are root flags accepted after a subcommand, mapped to
+// whether they take a value.
+var hoistedRootFlags = map[string]bool{
+	dryRunFlagName:       false,
+	outputFilterFlagName: true,
+}
+
+// isHoistedRootFlag reports whether name is a hoisted root flag that cmd
+// does not define itself.
+func isHoistedRootFlag(root, cmd *ffcli.Command, name string) bool {
+	if _, ok := hoistedRootFlags[name]; !ok {
+		return false
+	}
+	return cmd != root && lookupFlag(cmd, name) == nil
+}
+
+// HoistRootFlags moves --dry-run and --filter to the root flags when they
+// follow a command that does not define its own, so "asc apps update
+// --dry-run ..." turns on the global dry-run mode and "asc builds list
+// --filter ..." filters its output. Commands with their own flag keep it.
+//
+// args should already be canonicalized by ExpandFlagArgs.
+func HoistRootFlags(root *ffcli.Command, args []string) []string {
+	if root == nil || len(args) == 0 {
+		return args
+	}
+
+	var hoisted []string
+	rest := make([]string, 0, len(args))
+	cmd := root
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			rest = append(rest, args[i:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			sub := findSubcommand(cmd, arg)
+			if sub == nil {
+				rest = append(rest, args[i:]...)
+				break
+			}
+			cmd = sub
+			rest = append(rest, arg)
+			continue
+		}
+
+		name, _, hasValue := splitFlagArg(arg)
+		if isHoistedRootFlag(root, cmd, name) {
+			hoisted = append(hoisted, arg)
+			if hoistedRootFlags[name] && !hasValue && i+1 < len(args) {
+				i++
+				hoisted = append(hoisted, args[i])
+			}
+			continue
+		}
+		rest = append(rest, arg)
+		if f := lookupFlag(cmd, name); f != nil && !hasValue && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			rest = append(rest, args[i])
+		}
+	}
+	if len(hoisted) == 0 {
+		return args
+	}
+	return append(hoisted, rest...)
+}
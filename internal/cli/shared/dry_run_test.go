@@ -0,0 +1,86 @@
+package shared
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func dryRunTestRoot() *ffcli.Command {
+	rootFS := flag.NewFlagSet("asc", flag.ContinueOnError)
+	rootFS.Bool("dry-run", false, "")
+
+	updateFS := flag.NewFlagSet("apps update", flag.ContinueOnError)
+	updateFS.String("id", "", "")
+
+	expireFS := flag.NewFlagSet("builds expire-all", flag.ContinueOnError)
+	expireFS.Bool("dry-run", false, "")
+
+	return &ffcli.Command{
+		Name:    "asc",
+		FlagSet: rootFS,
+		Subcommands: []*ffcli.Command{
+			{Name: "apps", FlagSet: flag.NewFlagSet("apps", flag.ContinueOnError), Subcommands: []*ffcli.Command{
+				{Name: "update", FlagSet: updateFS},
+			}},
+			{Name: "builds", FlagSet: flag.NewFlagSet("builds", flag.ContinueOnError), Subcommands: []*ffcli.Command{
+				{Name: "expire-all", FlagSet: expireFS},
+			}},
+		},
+	}
+}
+
+func TestHoistRootFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "leaf without flag",
+			args: []string{"apps", "update", "--id", "--dry-run", "--dry-run"},
+			want: []string{"--dry-run", "apps", "update", "--id", "--dry-run"},
+		},
+		{
+			name: "inline value",
+			args: []string{"apps", "update", "--dry-run=true", "--id", "1"},
+			want: []string{"--dry-run=true", "apps", "update", "--id", "1"},
+		},
+		{
+			name: "command defines flag",
+			args: []string{"builds", "expire-all", "--dry-run"},
+			want: []string{"builds", "expire-all", "--dry-run"},
+		},
+		{
+			name: "already at root",
+			args: []string{"--dry-run", "apps", "update", "--id", "1"},
+			want: []string{"--dry-run", "apps", "update", "--id", "1"},
+		},
+		{
+			name: "filter with separate value",
+			args: []string{"apps", "update", "--filter", "data.id", "--id", "1"},
+			want: []string{"--filter", "data.id", "apps", "update", "--id", "1"},
+		},
+		{
+			name: "filter inline value",
+			args: []string{"apps", "update", "--id", "1", "--filter=data.id"},
+			want: []string{"--filter=data.id", "apps", "update", "--id", "1"},
+		},
+		{
+			name: "after terminator",
+			args: []string{"apps", "update", "--", "--dry-run"},
+			want: []string{"apps", "update", "--", "--dry-run"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := HoistRootFlags(dryRunTestRoot(), test.args)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("HoistRootFlags(%q) = %q, want %q", test.args, got, test.want)
+			}
+		})
+	}
+}
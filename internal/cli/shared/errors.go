@@ -38,6 +38,45 @@ func NewReportedError(err error) error {
 	return reportedError{err: err}
 }
 
+// Exit codes reserved for command outcomes that are not failures of the CLI
+// itself, such as an App Store review rejection observed by a watch command.
+const (
+	ExitCodeReviewRejected = 6
+	ExitCodeReviewCanceled = 7
+)
+
+// ExitCoder is implemented by errors that carry the process exit code the
+// CLI should use instead of the one derived from the error's type.
+type ExitCoder interface {
+	error
+	ExitCode() int
+}
+
+type exitCodeError struct {
+	err  error
+	code int
+}
+
+func (e exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e exitCodeError) Unwrap() error {
+	return e.err
+}
+
+func (e exitCodeError) ExitCode() int {
+	return e.code
+}
+
+// NewExitCodeError wraps err so the CLI exits with code.
+func NewExitCodeError(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return exitCodeError{err: err, code: code}
+}
+
 // UsageError prints a CLI validation error and returns flag.ErrHelp so callers
 // map the failure to usage exit code semantics.
 func UsageError(message string) error {
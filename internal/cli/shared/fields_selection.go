@@ -0,0 +1,138 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const fieldsSelectionFlagName = "fields"
+
+var fieldsSelectionTypePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+// fieldsSelectionFlag collects repeated --fields TYPE=FIELD,... values.
+type fieldsSelectionFlag []string
+
+func (f *fieldsSelectionFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func (f *fieldsSelectionFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(*f, ";")
+}
+
+// WrapFieldsSelection adds --fields to list, get, and view commands. The flag
+// takes sparse fieldsets as TYPE=FIELD[,FIELD...] and sends them as the
+// API's fields[TYPE] query parameters on the GET requests that return TYPE:
+//
+//	asc apps list --fields apps=name,bundleId
+//
+// Commands that already define their own --fields keep it; a value in the
+// TYPE=FIELD form is passed through the same way instead.
+func WrapFieldsSelection(cmd *ffcli.Command) {
+	if cmd == nil {
+		return
+	}
+	for _, sub := range cmd.Subcommands {
+		WrapFieldsSelection(sub)
+	}
+
+	if cmd.Exec == nil || !acceptsFieldsSelection(cmd) {
+		return
+	}
+
+	var readValues func() []string
+	var clearValues func() error
+	if existing := cmd.FlagSet.Lookup(fieldsSelectionFlagName); existing != nil {
+		readValues = func() []string {
+			if value := existing.Value.String(); strings.Contains(value, "=") {
+				return []string{value}
+			}
+			return nil
+		}
+		clearValues = func() error { return existing.Value.Set("") }
+	} else {
+		var values fieldsSelectionFlag
+		cmd.FlagSet.Var(&values, fieldsSelectionFlagName, "Sparse fieldsets as TYPE=FIELD[,FIELD...] sent as fields[TYPE] (repeatable), e.g. apps=name,bundleId")
+		readValues = func() []string { return values }
+		clearValues = func() error { return nil }
+	}
+
+	originalExec := cmd.Exec
+	cmd.Exec = func(ctx context.Context, args []string) error {
+		values := readValues()
+		if len(values) == 0 {
+			return originalExec(ctx, args)
+		}
+
+		fields, err := parseFieldsSelection(values)
+		if err != nil {
+			return UsageError(err.Error())
+		}
+		if err := clearValues(); err != nil {
+			return err
+		}
+
+		asc.SetRequestFields(fields)
+		defer asc.SetRequestFields(nil)
+
+		if err := originalExec(ctx, args); err != nil {
+			return err
+		}
+		if asc.RequestFieldsApplied() == 0 {
+			fmt.Fprintln(WarningWriter(), "Warning: --fields was not applied; the command sent no GET request")
+		}
+		return nil
+	}
+}
+
+func acceptsFieldsSelection(cmd *ffcli.Command) bool {
+	if cmd == nil || cmd.FlagSet == nil || len(cmd.Subcommands) > 0 {
+		return false
+	}
+	switch cmd.Name {
+	case "list", "get", "view":
+		return true
+	}
+	return false
+}
+
+// parseFieldsSelection parses TYPE=FIELD[,FIELD...] values, each of which may
+// hold several selections separated by ";". Fields for a repeated type are
+// merged.
+func parseFieldsSelection(values []string) (map[string][]string, error) {
+	fields := map[string][]string{}
+	for _, value := range values {
+		for _, selection := range strings.Split(value, ";") {
+			selection = strings.TrimSpace(selection)
+			if selection == "" {
+				continue
+			}
+			resourceType, list, ok := strings.Cut(selection, "=")
+			resourceType = strings.TrimSpace(resourceType)
+			names := splitUniqueCSV(list)
+			if !ok || !fieldsSelectionTypePattern.MatchString(resourceType) || len(names) == 0 {
+				return nil, fmt.Errorf("--fields must be TYPE=FIELD[,FIELD...], e.g. apps=name,bundleId (got %q)", selection)
+			}
+			for _, name := range names {
+				if !slices.Contains(fields[resourceType], name) {
+					fields[resourceType] = append(fields[resourceType], name)
+				}
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("--fields must be TYPE=FIELD[,FIELD...], e.g. apps=name,bundleId")
+	}
+	return fields, nil
+}
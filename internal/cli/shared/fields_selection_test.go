@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFieldsSelection(t *testing.T) {
+	got, err := parseFieldsSelection([]string{"apps=name,bundleId", "builds=version; apps=sku,name"})
+	if err != nil {
+		t.Fatalf("parseFieldsSelection() error: %v", err)
+	}
+	want := map[string][]string{
+		"apps":   {"name", "bundleId", "sku"},
+		"builds": {"version"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseFieldsSelection() = %v, want %v", got, want)
+	}
+}
+
+func TestParseFieldsSelectionRejectsInvalidValues(t *testing.T) {
+	for _, value := range []string{"name,bundleId", "apps=", "=name", "app store=name", ";"} {
+		if _, err := parseFieldsSelection([]string{value}); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package shared
+
+import (
+	"flag"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// shortFlagAliases maps single-letter aliases to the long flag they expand to.
+// An alias only applies when the command defines the long flag and does not
+// define the single-letter flag itself.
+var shortFlagAliases = map[string]string{
+	"a": "app",
+	"l": "limit",
+	"o": "output",
+}
+
+// minFlagPrefixLength is the shortest flag prefix that is expanded. Single
+// letters are reserved for explicit short aliases.
+const minFlagPrefixLength = 2
+
+// ExpandFlagArgs rewrites short aliases (-a, -o, -l) and unambiguous long-flag
+// prefixes (--out, --lim) to their canonical --name form while walking the
+// command tree the same way ffcli does. Tokens that match nothing are left
+// untouched so the flag package reports them as usual.
+func ExpandFlagArgs(root *ffcli.Command, args []string) ([]string, error) {
+	if root == nil || len(args) == 0 {
+		return args, nil
+	}
+
+	expanded := make([]string, 0, len(args))
+	cmd := root
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(expanded, args[i:]...), nil
+		}
+
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			sub := findSubcommand(cmd, arg)
+			if sub == nil {
+				// First positional argument ends flag parsing for this command.
+				return append(expanded, args[i:]...), nil
+			}
+			cmd = sub
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		name, value, hasValue := splitFlagArg(arg)
+		if isHoistedRootFlag(root, cmd, name) {
+			// Root flags given after a subcommand are exact names, never
+			// prefixes of the command's own flags.
+			expanded = append(expanded, arg)
+			if hoistedRootFlags[name] && !hasValue && i+1 < len(args) {
+				i++
+				expanded = append(expanded, args[i])
+			}
+			continue
+		}
+		f, err := resolveFlag(cmd.FlagSet, name)
+		if err != nil {
+			return nil, err
+		}
+		if f == nil {
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		if f.Name == name {
+			expanded = append(expanded, arg)
+		} else {
+			rewritten := "--" + f.Name
+			if hasValue {
+				rewritten += "=" + value
+			}
+			expanded = append(expanded, rewritten)
+		}
+
+		if !hasValue && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			expanded = append(expanded, args[i])
+		}
+	}
+	return expanded, nil
+}
+
+func findSubcommand(cmd *ffcli.Command, name string) *ffcli.Command {
+	for _, sub := range cmd.Subcommands {
+		if sub != nil && strings.EqualFold(sub.Name, name) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func splitFlagArg(arg string) (name, value string, hasValue bool) {
+	name = strings.TrimLeft(arg, "-")
+	if idx := strings.Index(name, "="); idx >= 0 {
+		return name[:idx], name[idx+1:], true
+	}
+	return name, "", false
+}
+
+// resolveFlag returns the flag a name refers to, or nil when the name is not
+// an exact flag, short alias, or unambiguous prefix in fs.
+func resolveFlag(fs *flag.FlagSet, name string) (*flag.Flag, error) {
+	if fs == nil || name == "" {
+		return nil, nil
+	}
+	if f := fs.Lookup(name); f != nil {
+		return f, nil
+	}
+	if long, ok := shortFlagAliases[name]; ok {
+		return fs.Lookup(long), nil
+	}
+	if len(name) < minFlagPrefixLength {
+		return nil, nil
+	}
+
+	var matches []*flag.Flag
+	fs.VisitAll(func(f *flag.Flag) {
+		if len(f.Name) >= minFlagPrefixLength && strings.HasPrefix(f.Name, name) {
+			matches = append(matches, f)
+		}
+	})
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, 0, len(matches))
+		for _, f := range matches {
+			names = append(names, "--"+f.Name)
+		}
+		sort.Strings(names)
+		return nil, UsageErrorf("ambiguous flag --%s: could be %s", name, strings.Join(names, ", "))
+	}
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
+// shortFlagAlias returns the short alias ExpandFlagArgs accepts for name in fs.
+func shortFlagAlias(fs *flag.FlagSet, name string) string {
+	for alias, long := range shortFlagAliases {
+		if long == name && fs.Lookup(alias) == nil {
+			return alias
+		}
+	}
+	return ""
+}
@@ -0,0 +1,119 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func newFlagAliasTestTree() *ffcli.Command {
+	rootFS := flag.NewFlagSet("asc", flag.ContinueOnError)
+	rootFS.String("profile", "", "profile")
+
+	listFS := flag.NewFlagSet("builds list", flag.ContinueOnError)
+	listFS.String("app", "", "app")
+	listFS.String("output", "json", "output")
+	listFS.Bool("paginate", false, "paginate")
+	listFS.Bool("pretty", false, "pretty")
+	listFS.Int("limit", 0, "limit")
+	listFS.String("limit-type", "", "limit type")
+
+	ownFS := flag.NewFlagSet("builds own", flag.ContinueOnError)
+	ownFS.String("app", "", "app")
+	ownFS.String("a", "", "attribute")
+
+	return &ffcli.Command{
+		Name:    "asc",
+		FlagSet: rootFS,
+		Subcommands: []*ffcli.Command{
+			{
+				Name:    "builds",
+				FlagSet: flag.NewFlagSet("builds", flag.ContinueOnError),
+				Subcommands: []*ffcli.Command{
+					{Name: "list", FlagSet: listFS},
+					{Name: "own", FlagSet: ownFS},
+				},
+			},
+		},
+	}
+}
+
+func TestExpandFlagArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "short aliases",
+			args: []string{"builds", "list", "-a", "123", "-o", "table", "-l", "5"},
+			want: []string{"builds", "list", "--app", "123", "--output", "table", "--limit", "5"},
+		},
+		{
+			name: "prefixes with inline values",
+			args: []string{"--prof=ci", "builds", "list", "--ap=123", "--out", "json", "--pag"},
+			want: []string{"--profile=ci", "builds", "list", "--app=123", "--output", "json", "--paginate"},
+		},
+		{
+			name: "exact name wins over longer match",
+			args: []string{"builds", "list", "--limit", "5"},
+			want: []string{"builds", "list", "--limit", "5"},
+		},
+		{
+			name: "flag values are not rewritten",
+			args: []string{"builds", "list", "--app", "-o"},
+			want: []string{"builds", "list", "--app", "-o"},
+		},
+		{
+			name: "command-defined short flag is kept",
+			args: []string{"builds", "own", "-a", "x"},
+			want: []string{"builds", "own", "-a", "x"},
+		},
+		{
+			name: "unknown and single-letter prefixes are left alone",
+			args: []string{"builds", "list", "--nope", "-p"},
+			want: []string{"builds", "list", "--nope", "-p"},
+		},
+		{
+			name: "positional arguments stop expansion",
+			args: []string{"builds", "list", "extra", "--out", "json"},
+			want: []string{"builds", "list", "extra", "--out", "json"},
+		},
+		{
+			name: "end of flags separator stops expansion",
+			args: []string{"builds", "list", "--", "--out"},
+			want: []string{"builds", "list", "--", "--out"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ExpandFlagArgs(newFlagAliasTestTree(), test.args)
+			if err != nil {
+				t.Fatalf("ExpandFlagArgs() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("ExpandFlagArgs() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExpandFlagArgsAmbiguousPrefix(t *testing.T) {
+	_, err := ExpandFlagArgs(newFlagAliasTestTree(), []string{"builds", "list", "--li", "5"})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
+
+func TestDefaultUsageFuncShowsShortAlias(t *testing.T) {
+	cmd := newFlagAliasTestTree().Subcommands[0].Subcommands[0]
+	usage := DefaultUsageFunc(cmd)
+	if !strings.Contains(usage, "(short: -a)") || !strings.Contains(usage, "(short: -o)") {
+		t.Fatalf("expected short aliases in help, got %q", usage)
+	}
+}
@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type gameCenterDetailClient interface {
+	GetGameCenterDetailID(ctx context.Context, appID string) (string, error)
+}
+
+// ResolveGameCenterDetailID returns the Game Center detail ID for a command.
+// An explicit --gc-detail-id wins and skips the lookup entirely; otherwise the
+// app's detail ID comes from the on-disk ID cache or, on a miss, the API.
+func ResolveGameCenterDetailID(ctx context.Context, client gameCenterDetailClient, appID, detailID string) (string, error) {
+	if trimmed := strings.TrimSpace(detailID); trimmed != "" {
+		return trimmed, nil
+	}
+	appID = strings.TrimSpace(appID)
+	if appID == "" {
+		return "", fmt.Errorf("app ID is required to look up the Game Center detail")
+	}
+
+	scope := idCacheScope(client)
+	key := idCacheKey(idCacheKindGameCenterDetail, appID)
+	if cached, ok := lookupCachedID(scope, key, idCacheGameCenterDetailTTL); ok {
+		return cached, nil
+	}
+
+	id, err := client.GetGameCenterDetailID(ctx, appID)
+	if err != nil {
+		return "", err
+	}
+	if id = strings.TrimSpace(id); id != "" {
+		rememberIDs(scope, map[string]string{key: id})
+	}
+	return id, nil
+}
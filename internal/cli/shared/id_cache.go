@@ -0,0 +1,212 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	idCacheEnabledEnv = "ASC_ID_CACHE"
+	idCacheVersion    = 1
+
+	// Bundle IDs never move between apps, so their mappings live longer than
+	// app names, which can be changed and reused.
+	idCacheBundleIDTTL = 30 * 24 * time.Hour
+	idCacheAppNameTTL  = 24 * time.Hour
+	// An app's Game Center detail is created once and never replaced.
+	idCacheGameCenterDetailTTL = 30 * 24 * time.Hour
+
+	idCacheKindBundleID         = "app.bundleId"
+	idCacheKindAppName          = "app.name"
+	idCacheKindGameCenterDetail = "app.gameCenterDetail"
+)
+
+// idCacheFile maps lookup keys to resource IDs per API team. Scopes are keyed
+// by a hash of the issuer ID so one team's names never resolve for another.
+type idCacheFile struct {
+	Version int                                `json:"version"`
+	Scopes  map[string]map[string]idCacheEntry `json:"scopes"`
+}
+
+type idCacheEntry struct {
+	ID     string    `json:"id"`
+	SeenAt time.Time `json:"seenAt"`
+}
+
+var idCacheMu sync.Mutex
+
+// idCacheScoper is implemented by *asc.Client. Lookup clients that do not
+// identify their team (such as test fakes) bypass the cache.
+type idCacheScoper interface {
+	IssuerID() string
+}
+
+func idCacheEnabled() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(idCacheEnabledEnv))) {
+	case "0", "false", "no", "off":
+		return false
+	default:
+		return true
+	}
+}
+
+func idCacheScope(client any) string {
+	scoper, ok := client.(idCacheScoper)
+	if !ok || !idCacheEnabled() {
+		return ""
+	}
+	issuerID := strings.TrimSpace(scoper.IssuerID())
+	if issuerID == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(issuerID))
+	return hex.EncodeToString(sum[:8])
+}
+
+func idCachePath() (string, error) {
+	dir, err := tierCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "ids.json"), nil
+}
+
+func idCacheKey(kind, value string) string {
+	return kind + ":" + strings.ToLower(strings.TrimSpace(value))
+}
+
+func loadIDCache(path string) idCacheFile {
+	cache := idCacheFile{Version: idCacheVersion, Scopes: map[string]map[string]idCacheEntry{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	var loaded idCacheFile
+	if err := json.Unmarshal(data, &loaded); err != nil || loaded.Version != idCacheVersion || loaded.Scopes == nil {
+		return cache
+	}
+	return loaded
+}
+
+// lookupCachedID returns a cached ID for key when it is younger than ttl.
+func lookupCachedID(scope, key string, ttl time.Duration) (string, bool) {
+	if scope == "" {
+		return "", false
+	}
+	path, err := idCachePath()
+	if err != nil {
+		return "", false
+	}
+
+	idCacheMu.Lock()
+	defer idCacheMu.Unlock()
+	entry, ok := loadIDCache(path).Scopes[scope][key]
+	if !ok || entry.ID == "" || time.Since(entry.SeenAt) > ttl {
+		return "", false
+	}
+	return entry.ID, true
+}
+
+// rememberIDs merges key→ID mappings into the cache. Keys mapped to "" are
+// removed. Failures are ignored: the cache only saves lookups.
+func rememberIDs(scope string, ids map[string]string) {
+	if scope == "" || len(ids) == 0 {
+		return
+	}
+	path, err := idCachePath()
+	if err != nil {
+		return
+	}
+
+	idCacheMu.Lock()
+	defer idCacheMu.Unlock()
+	cache := loadIDCache(path)
+	entries := cache.Scopes[scope]
+	if entries == nil {
+		entries = map[string]idCacheEntry{}
+		cache.Scopes[scope] = entries
+	}
+	now := time.Now().UTC()
+	for key, id := range ids {
+		if id == "" {
+			delete(entries, key)
+			continue
+		}
+		entries[key] = idCacheEntry{ID: id, SeenAt: now}
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	_, _ = WriteFileNoSymlinkOverwrite(path, bytes.NewReader(data), 0o600, ".asc-ids-*", ".asc-ids-backup-*")
+}
+
+// RememberApps records bundle ID and name mappings seen in an apps listing
+// so later --app lookups by bundle ID or name skip the API. Bundle IDs are
+// unique, so any listing can record them. Names are recorded only when
+// complete is true, meaning apps is every app in the team: a name that is
+// unique on one page or in a filtered listing may still be shared by another
+// app. Names shared by more than one app are not cached.
+func RememberApps(client any, apps []asc.Resource[asc.AppAttributes], complete bool) {
+	scope := idCacheScope(client)
+	if scope == "" || len(apps) == 0 {
+		return
+	}
+
+	ids := map[string]string{}
+	names := map[string]string{}
+	for _, app := range apps {
+		id := strings.TrimSpace(app.ID)
+		if id == "" {
+			continue
+		}
+		if bundleID := strings.TrimSpace(app.Attributes.BundleID); bundleID != "" {
+			ids[idCacheKey(idCacheKindBundleID, bundleID)] = id
+		}
+		if name := strings.TrimSpace(app.Attributes.Name); complete && name != "" {
+			key := idCacheKey(idCacheKindAppName, name)
+			if existing, ok := names[key]; ok && existing != id {
+				names[key] = ""
+				continue
+			}
+			names[key] = id
+		}
+	}
+	for key, id := range names {
+		ids[key] = id
+	}
+	rememberIDs(scope, ids)
+}
+
+// cachedAppID returns a cached app ID for a bundle ID or app name.
+func cachedAppID(client any, value string) (string, bool) {
+	scope := idCacheScope(client)
+	if scope == "" {
+		return "", false
+	}
+	if id, ok := lookupCachedID(scope, idCacheKey(idCacheKindBundleID, value), idCacheBundleIDTTL); ok {
+		return id, true
+	}
+	return lookupCachedID(scope, idCacheKey(idCacheKindAppName, value), idCacheAppNameTTL)
+}
+
+// cachedAppIDOfKind returns a cached app ID only when value was recorded
+// under kind, such as a bundle ID that must not match an app name.
+func cachedAppIDOfKind(client any, kind, value string, ttl time.Duration) (string, bool) {
+	return lookupCachedID(idCacheScope(client), idCacheKey(kind, value), ttl)
+}
+
+// rememberAppID records a resolved --app value under the kind it matched.
+func rememberAppID(client any, kind, value, id string) {
+	rememberIDs(idCacheScope(client), map[string]string{idCacheKey(kind, value): id})
+}
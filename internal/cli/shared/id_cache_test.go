@@ -0,0 +1,179 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// scopedAppLookupStub identifies its team like *asc.Client so the ID cache
+// applies.
+type scopedAppLookupStub struct {
+	sequenceAppLookupStub
+	issuerID string
+}
+
+func (s *scopedAppLookupStub) IssuerID() string {
+	return s.issuerID
+}
+
+func useTempIDCache(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("ASC_ID_CACHE", "")
+}
+
+func TestResolveAppIDWithLookup_CachesBundleIDMatch(t *testing.T) {
+	useTempIDCache(t)
+	bundleMatch := &asc.AppsResponse{Data: []asc.Resource[asc.AppAttributes]{{ID: "111", Attributes: asc.AppAttributes{BundleID: "com.example.app"}}}}
+	client := &scopedAppLookupStub{sequenceAppLookupStub: sequenceAppLookupStub{responses: []*asc.AppsResponse{bundleMatch}}, issuerID: "team-a"}
+
+	for range 2 {
+		got, err := ResolveAppIDWithLookup(context.Background(), client, "com.example.app")
+		if err != nil {
+			t.Fatalf("ResolveAppIDWithLookup() error: %v", err)
+		}
+		if got != "111" {
+			t.Fatalf("expected 111, got %q", got)
+		}
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected second lookup to hit the cache, got %d API calls", client.calls)
+	}
+
+	otherTeam := &scopedAppLookupStub{issuerID: "team-b"}
+	if _, ok := cachedAppID(otherTeam, "com.example.app"); ok {
+		t.Fatal("expected cache entries to be scoped per team")
+	}
+}
+
+func TestRememberAppsPopulatesNameLookups(t *testing.T) {
+	useTempIDCache(t)
+	client := &scopedAppLookupStub{issuerID: "team-a"}
+
+	RememberApps(client, []asc.Resource[asc.AppAttributes]{
+		{ID: "1", Attributes: asc.AppAttributes{Name: "Alpha", BundleID: "com.example.alpha"}},
+		{ID: "2", Attributes: asc.AppAttributes{Name: "Twin", BundleID: "com.example.twin1"}},
+		{ID: "3", Attributes: asc.AppAttributes{Name: "twin", BundleID: "com.example.twin2"}},
+	}, true)
+
+	got, err := ResolveAppIDWithExactLookup(context.Background(), client, "alpha")
+	if err != nil || got != "1" {
+		t.Fatalf("expected cached name match 1, got %q (%v)", got, err)
+	}
+	if id, ok := cachedAppID(client, "COM.EXAMPLE.TWIN2"); !ok || id != "3" {
+		t.Fatalf("expected cached bundle ID match 3, got %q %v", id, ok)
+	}
+	if _, ok := cachedAppID(client, "Twin"); ok {
+		t.Fatal("expected ambiguous names not to be cached")
+	}
+	if client.calls != 0 {
+		t.Fatalf("expected no API calls, got %d", client.calls)
+	}
+}
+
+func TestRememberAppsSkipsNamesFromIncompleteListing(t *testing.T) {
+	useTempIDCache(t)
+	client := &scopedAppLookupStub{issuerID: "team-a"}
+
+	RememberApps(client, []asc.Resource[asc.AppAttributes]{
+		{ID: "1", Attributes: asc.AppAttributes{Name: "Alpha", BundleID: "com.example.alpha"}},
+	}, false)
+
+	if _, ok := cachedAppID(client, "Alpha"); ok {
+		t.Fatal("expected names from an incomplete listing not to be cached")
+	}
+	if id, ok := cachedAppID(client, "com.example.alpha"); !ok || id != "1" {
+		t.Fatalf("expected cached bundle ID match 1, got %q %v", id, ok)
+	}
+}
+
+func TestResolveAppIDWithLookup_DoesNotCacheNameDuplicatedOnLaterPage(t *testing.T) {
+	useTempIDCache(t)
+	firstPage := appsResponseFromApps([]appFixture{{id: "1", name: "Alpha"}})
+	firstPage.Links.Next = "https://api.appstoreconnect.apple.com/v1/apps?cursor=2"
+	secondPage := appsResponseFromApps([]appFixture{{id: "2", name: "Alpha"}})
+	client := &scopedAppLookupStub{
+		sequenceAppLookupStub: sequenceAppLookupStub{responses: []*asc.AppsResponse{{}, {}, firstPage, secondPage}},
+		issuerID:              "team-a",
+	}
+
+	if _, err := ResolveAppIDWithLookup(context.Background(), client, "Alpha"); err == nil {
+		t.Fatal("expected duplicate app names to be rejected")
+	}
+	if id, ok := cachedAppID(client, "Alpha"); ok {
+		t.Fatalf("expected duplicated name not to be cached, got %q", id)
+	}
+}
+
+func TestIDCacheDisabledByEnv(t *testing.T) {
+	useTempIDCache(t)
+	t.Setenv("ASC_ID_CACHE", "0")
+	client := &scopedAppLookupStub{issuerID: "team-a"}
+
+	RememberApps(client, []asc.Resource[asc.AppAttributes]{{ID: "1", Attributes: asc.AppAttributes{Name: "Alpha"}}}, true)
+	t.Setenv("ASC_ID_CACHE", "")
+	if _, ok := cachedAppID(client, "Alpha"); ok {
+		t.Fatal("expected nothing cached while ASC_ID_CACHE=0")
+	}
+}
+
+type gameCenterDetailStub struct {
+	issuerID string
+	ids      map[string]string
+	calls    int
+}
+
+func (s *gameCenterDetailStub) IssuerID() string {
+	return s.issuerID
+}
+
+func (s *gameCenterDetailStub) GetGameCenterDetailID(_ context.Context, appID string) (string, error) {
+	s.calls++
+	return s.ids[appID], nil
+}
+
+func TestResolveGameCenterDetailID_CachesPerApp(t *testing.T) {
+	useTempIDCache(t)
+	client := &gameCenterDetailStub{issuerID: "team-a", ids: map[string]string{"111": "gc-1"}}
+
+	for range 2 {
+		got, err := ResolveGameCenterDetailID(context.Background(), client, "111", "")
+		if err != nil {
+			t.Fatalf("ResolveGameCenterDetailID() error: %v", err)
+		}
+		if got != "gc-1" {
+			t.Fatalf("expected gc-1, got %q", got)
+		}
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected second lookup to hit the cache, got %d API calls", client.calls)
+	}
+
+	// Apps without a detail are looked up again next time.
+	for range 2 {
+		if got, err := ResolveGameCenterDetailID(context.Background(), client, "222", ""); err != nil || got != "" {
+			t.Fatalf("expected empty detail ID, got %q (%v)", got, err)
+		}
+	}
+	if client.calls != 3 {
+		t.Fatalf("expected empty detail IDs not to be cached, got %d API calls", client.calls)
+	}
+}
+
+func TestResolveGameCenterDetailID_ExplicitIDSkipsLookup(t *testing.T) {
+	useTempIDCache(t)
+	client := &gameCenterDetailStub{issuerID: "team-a"}
+
+	got, err := ResolveGameCenterDetailID(context.Background(), client, "", " gc-9 ")
+	if err != nil || got != "gc-9" {
+		t.Fatalf("expected gc-9, got %q (%v)", got, err)
+	}
+	if client.calls != 0 {
+		t.Fatalf("expected no API calls, got %d", client.calls)
+	}
+	if _, err := ResolveGameCenterDetailID(context.Background(), client, "", ""); err == nil {
+		t.Fatal("expected error without app ID or detail ID")
+	}
+}
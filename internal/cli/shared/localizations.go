@@ -102,7 +102,23 @@ func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc
 	return writeLocalizationStrings(outputPath, byLocale, appInfoLocalizationKeys)
 }
 
+// WriteLocalizationDraftStrings writes draft values (for example machine
+// translations) to .strings files that start with header as a comment, so
+// reviewers can tell drafts apart from downloaded metadata. The comment is
+// ignored when the files are read back for upload.
+func WriteLocalizationDraftStrings(outputPath, locType string, valuesByLocale map[string]map[string]string, header string) ([]asc.LocalizationFileResult, error) {
+	order := versionLocalizationKeys
+	if locType == LocalizationTypeAppInfo {
+		order = appInfoLocalizationKeys
+	}
+	return writeLocalizationStringsWithHeader(outputPath, valuesByLocale, order, header)
+}
+
 func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[string]string, order []string) ([]asc.LocalizationFileResult, error) {
+	return writeLocalizationStringsWithHeader(outputPath, valuesByLocale, order, "")
+}
+
+func writeLocalizationStringsWithHeader(outputPath string, valuesByLocale map[string]map[string]string, order []string, header string) ([]asc.LocalizationFileResult, error) {
 	if len(valuesByLocale) == 0 {
 		return nil, fmt.Errorf("no localizations returned")
 	}
@@ -124,7 +140,7 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 		if !ok {
 			continue
 		}
-		if err := writeStringsFile(path, valuesByLocale[locale], order); err != nil {
+		if err := writeStringsFileWithHeader(path, valuesByLocale[locale], order, header); err != nil {
 			return nil, err
 		}
 		results = append(results, asc.LocalizationFileResult{
@@ -197,6 +213,11 @@ func MapVersionLocalizationStrings(attrs asc.AppStoreVersionLocalizationAttribut
 	return mapVersionLocalizationStrings(attrs)
 }
 
+// MapAppInfoLocalizationStrings converts app-info localization attributes into .strings keys.
+func MapAppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[string]string {
+	return mapAppInfoLocalizationStrings(attrs)
+}
+
 func mapAppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[string]string {
 	values := make(map[string]string)
 	setIfNotEmpty(values, "name", attrs.Name)
@@ -321,7 +342,7 @@ func UploadVersionLocalizations(ctx context.Context, client versionLocalizationC
 		// If the API rejects whatsNew (e.g. on an initial v1.0 release where
 		// there is no previous version), retry without it and warn the user.
 		if err != nil && strings.TrimSpace(attributes.WhatsNew) != "" && isWhatsNewUnsupportedError(err) {
-			fmt.Fprintln(os.Stderr, "Warning: 'whatsNew' cannot be set for this version (initial releases have no What's New section). Retrying without it.")
+			fmt.Fprintln(WarningWriter(), "Warning: 'whatsNew' cannot be set for this version (initial releases have no What's New section). Retrying without it.")
 			attributes.WhatsNew = ""
 			resp, err = client.UpdateAppStoreVersionLocalization(ctx, existingID, attributes)
 		}
@@ -705,11 +726,18 @@ func (p *stringsParser) errorf(message string) error {
 }
 
 func writeStringsFile(path string, values map[string]string, order []string) error {
+	return writeStringsFileWithHeader(path, values, order, "")
+}
+
+func writeStringsFileWithHeader(path string, values map[string]string, order []string, header string) error {
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return err
 	}
 
 	var b strings.Builder
+	if header = strings.TrimSpace(header); header != "" {
+		fmt.Fprintf(&b, "/* %s */\n\n", strings.ReplaceAll(header, "*/", "* /"))
+	}
 	for _, key := range order {
 		value, ok := values[key]
 		if !ok {
@@ -55,6 +55,37 @@ func TestWriteStringsFile(t *testing.T) {
 	}
 }
 
+func TestWriteLocalizationDraftStrings_HeaderRoundTrips(t *testing.T) {
+	dir := t.TempDir()
+	values := map[string]map[string]string{
+		"de-DE": {"whatsNew": "Fehlerbehebungen", "description": "Hallo"},
+	}
+
+	files, err := WriteLocalizationDraftStrings(dir, LocalizationTypeVersion, values, "DRAFT: review */ first")
+	if err != nil {
+		t.Fatalf("WriteLocalizationDraftStrings() error: %v", err)
+	}
+	if len(files) != 1 || files[0].Path != filepath.Join(dir, "de-DE.strings") {
+		t.Fatalf("unexpected files: %+v", files)
+	}
+
+	data, err := os.ReadFile(files[0].Path)
+	if err != nil {
+		t.Fatalf("read file error: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "/* DRAFT: review * / first */\n\n\"description\"") {
+		t.Fatalf("expected draft header before values, got: %s", data)
+	}
+
+	parsed, err := ReadLocalizationStrings(dir, nil)
+	if err != nil {
+		t.Fatalf("ReadLocalizationStrings() error: %v", err)
+	}
+	if parsed["de-DE"]["whatsNew"] != "Fehlerbehebungen" || len(parsed["de-DE"]) != 2 {
+		t.Fatalf("unexpected parsed values: %+v", parsed)
+	}
+}
+
 func TestReadLocalizationStrings_FileLocale(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "en-US.strings")
@@ -0,0 +1,20 @@
+package shared
+
+import (
+	"net"
+	"strings"
+)
+
+// IsLoopbackBindHost reports whether a --listen or --host value binds to
+// loopback only. Bracketed IPv6 hosts are accepted.
+func IsLoopbackBindHost(host string) bool {
+	normalized := strings.TrimSpace(host)
+	if normalized == "" {
+		return false
+	}
+	if strings.EqualFold(normalized, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(normalized, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
@@ -59,3 +59,27 @@ func TestParseBoolFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestResolvePlatform(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		env      string
+		fallback string
+		want     string
+	}{
+		{name: "flag wins", value: " MAC_OS ", env: "TV_OS", fallback: "IOS", want: "MAC_OS"},
+		{name: "env fallback", value: "", env: " vision_os ", fallback: "IOS", want: "vision_os"},
+		{name: "default fallback", value: " ", env: "", fallback: "IOS", want: "IOS"},
+		{name: "optional platform", value: "", env: "", fallback: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ASC_DEFAULT_PLATFORM", tt.env)
+			if got := ResolvePlatform(tt.value, tt.fallback); got != tt.want {
+				t.Fatalf("ResolvePlatform(%q, %q) = %q, want %q", tt.value, tt.fallback, got, tt.want)
+			}
+		})
+	}
+}
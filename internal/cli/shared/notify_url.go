@@ -0,0 +1,204 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	notifyURLEnvVar               = "ASC_NOTIFY_URL"
+	notifyURLMaxResponseBodyBytes = 4096
+)
+
+// WaitNotification describes the outcome of a watch or wait command, posted
+// to --notify-url when the command finishes.
+type WaitNotification struct {
+	Event   string // machine-readable event name, e.g. build.processing.finished
+	Title   string
+	Summary string
+	Success bool
+	Fields  []NotificationField
+}
+
+// NotificationField is a named detail shown in a notification, such as a
+// build number or processing state.
+type NotificationField struct {
+	Name  string
+	Value string
+}
+
+// BindNotifyURLFlag registers --notify-url.
+func BindNotifyURLFlag(fs *flag.FlagSet) *string {
+	return fs.String("notify-url", "", "Webhook URL to notify when waiting finishes: Slack, Microsoft Teams, or any JSON endpoint (or set "+notifyURLEnvVar+")")
+}
+
+// ResolveNotifyURL returns the --notify-url value, falling back to
+// ASC_NOTIFY_URL. It returns "" when neither is set and a usage error when
+// the URL is not a usable webhook.
+func ResolveNotifyURL(flagValue string) (string, error) {
+	value := strings.TrimSpace(flagValue)
+	if value == "" {
+		value = strings.TrimSpace(os.Getenv(notifyURLEnvVar))
+	}
+	if value == "" {
+		return "", nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" || parsed.User != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return "", UsageError("--notify-url must be a valid http(s) webhook URL")
+	}
+	if parsed.Scheme == "http" && !isLoopbackHost(parsed.Hostname()) {
+		return "", UsageError("--notify-url must use https")
+	}
+	return value, nil
+}
+
+// SendWaitNotification posts n to notifyURL, formatted for Slack or Microsoft
+// Teams when the URL points at one of them and as plain JSON otherwise. The
+// wait has already finished, so a failed delivery is reported as a warning
+// rather than changing the command's result.
+func SendWaitNotification(ctx context.Context, notifyURL string, n WaitNotification) {
+	if strings.TrimSpace(notifyURL) == "" {
+		return
+	}
+	if err := postWaitNotification(ctx, notifyURL, n); err != nil {
+		fmt.Fprintf(WarningWriter(), "Warning: --notify-url: %v\n", err)
+	}
+}
+
+func postWaitNotification(ctx context.Context, notifyURL string, n WaitNotification) error {
+	body, err := json.Marshal(waitNotificationPayload(notifyURL, n))
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	// The wait may have used up the command's deadline; give delivery its own.
+	requestCtx, cancel := ContextWithTimeout(context.WithoutCancel(ctx))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, notifyURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: asc.ResolveTimeout()}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, notifyURLMaxResponseBodyBytes))
+		if message := strings.TrimSpace(string(respBody)); message != "" {
+			return fmt.Errorf("unexpected response %d: %s", resp.StatusCode, message)
+		}
+		return fmt.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func waitNotificationPayload(notifyURL string, n WaitNotification) any {
+	host := ""
+	if parsed, err := url.Parse(notifyURL); err == nil {
+		host = strings.ToLower(parsed.Hostname())
+	}
+	switch {
+	case host == "hooks.slack.com" || host == "hooks.slack-gov.com":
+		return slackWaitNotification(n)
+	case strings.HasSuffix(host, ".webhook.office.com") || strings.HasSuffix(host, ".logic.azure.com") || strings.HasSuffix(host, ".powerplatform.com"):
+		return teamsWaitNotification(n)
+	default:
+		return genericWaitNotification(n)
+	}
+}
+
+func slackWaitNotification(n WaitNotification) map[string]any {
+	color := "danger"
+	if n.Success {
+		color = "good"
+	}
+	fields := make([]map[string]any, 0, len(n.Fields))
+	for _, field := range n.Fields {
+		fields = append(fields, map[string]any{
+			"title": field.Name,
+			"value": field.Value,
+			"short": true,
+		})
+	}
+	return map[string]any{
+		"text": n.Summary,
+		"attachments": []map[string]any{{
+			"fallback": n.Summary,
+			"color":    color,
+			"title":    n.Title,
+			"fields":   fields,
+		}},
+	}
+}
+
+// teamsWaitNotification builds an Adaptive Card message, which both Teams
+// incoming webhooks and Workflows (Power Automate) webhooks accept.
+func teamsWaitNotification(n WaitNotification) map[string]any {
+	color := "Attention"
+	if n.Success {
+		color = "Good"
+	}
+	facts := make([]map[string]any, 0, len(n.Fields))
+	for _, field := range n.Fields {
+		facts = append(facts, map[string]any{"title": field.Name, "value": field.Value})
+	}
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content": map[string]any{
+				"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+				"type":    "AdaptiveCard",
+				"version": "1.4",
+				"body": []map[string]any{
+					{"type": "TextBlock", "text": n.Title, "weight": "Bolder", "size": "Medium", "color": color, "wrap": true},
+					{"type": "TextBlock", "text": n.Summary, "wrap": true},
+					{"type": "FactSet", "facts": facts},
+				},
+			},
+		}},
+	}
+}
+
+func genericWaitNotification(n WaitNotification) map[string]any {
+	fields := make(map[string]string, len(n.Fields))
+	for _, field := range n.Fields {
+		fields[field.Name] = field.Value
+	}
+	return map[string]any{
+		"event":     n.Event,
+		"title":     n.Title,
+		"summary":   n.Summary,
+		"success":   n.Success,
+		"fields":    fields,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
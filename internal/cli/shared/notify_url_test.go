@@ -0,0 +1,89 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWaitNotificationPayloadFormatsForTeams(t *testing.T) {
+	n := WaitNotification{
+		Title:   "Build 42 is VALID",
+		Summary: "Build 42 finished processing.",
+		Success: true,
+		Fields:  []NotificationField{{Name: "Build ID", Value: "build-1"}},
+	}
+
+	encoded, err := json.Marshal(waitNotificationPayload("https://contoso.webhook.office.com/webhookb2/abc", n))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var payload struct {
+		Type        string `json:"type"`
+		Attachments []struct {
+			ContentType string `json:"contentType"`
+			Content     struct {
+				Type string `json:"type"`
+				Body []struct {
+					Type  string `json:"type"`
+					Text  string `json:"text"`
+					Facts []struct {
+						Title string `json:"title"`
+						Value string `json:"value"`
+					} `json:"facts"`
+				} `json:"body"`
+			} `json:"content"`
+		} `json:"attachments"`
+	}
+	if err := json.Unmarshal(encoded, &payload); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if payload.Type != "message" || len(payload.Attachments) != 1 {
+		t.Fatalf("unexpected payload: %s", encoded)
+	}
+	card := payload.Attachments[0]
+	if card.ContentType != "application/vnd.microsoft.card.adaptive" || card.Content.Type != "AdaptiveCard" {
+		t.Fatalf("unexpected card: %s", encoded)
+	}
+	if len(card.Content.Body) != 3 || card.Content.Body[0].Text != "Build 42 is VALID" || card.Content.Body[2].Facts[0].Value != "build-1" {
+		t.Fatalf("unexpected card body: %s", encoded)
+	}
+}
+
+func TestResolveNotifyURLUsesEnvAndAllowsLoopbackHTTP(t *testing.T) {
+	t.Setenv(notifyURLEnvVar, "http://127.0.0.1:8080/hook")
+
+	got, err := ResolveNotifyURL("")
+	if err != nil {
+		t.Fatalf("ResolveNotifyURL() error = %v", err)
+	}
+	if got != "http://127.0.0.1:8080/hook" {
+		t.Fatalf("ResolveNotifyURL() = %q", got)
+	}
+
+	got, err = ResolveNotifyURL(" https://ci.example.com/hook ")
+	if err != nil || got != "https://ci.example.com/hook" {
+		t.Fatalf("ResolveNotifyURL() = %q, %v", got, err)
+	}
+}
+
+func TestSendWaitNotificationWarningHonorsQuiet(t *testing.T) {
+	t.Setenv(quietEnvVar, "")
+	SetQuiet(true)
+	t.Cleanup(func() { SetQuiet(false) })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	stderr := captureStderr(t, func() {
+		SendWaitNotification(context.Background(), server.URL, WaitNotification{})
+	})
+	if stderr != "" {
+		t.Fatalf("expected no warning with --quiet, got %q", stderr)
+	}
+}
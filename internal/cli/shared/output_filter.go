@@ -0,0 +1,139 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+const outputFilterFlagName = "filter"
+
+var outputFilter outputFilterFlag
+
+// outputFilterFlag backs --filter and holds the compiled expression.
+type outputFilterFlag struct {
+	raw  string
+	expr *jmespath.JMESPath
+	set  bool
+}
+
+func (f *outputFilterFlag) Set(value string) error {
+	f.raw = value
+	f.expr = nil
+	f.set = true
+	return nil
+}
+
+func (f *outputFilterFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.raw
+}
+
+// BindOutputFilterFlags registers --filter, a JMESPath expression applied to
+// command output before it is rendered.
+func BindOutputFilterFlags(fs *flag.FlagSet) {
+	outputFilter = outputFilterFlag{}
+	fs.Var(&outputFilter, outputFilterFlagName, `JMESPath expression applied to output before rendering, e.g. "data[].attributes.version" or "length(data)"`)
+}
+
+// ValidateOutputFilter compiles the --filter expression. Call it after the
+// root flags are parsed.
+func ValidateOutputFilter() error {
+	if !outputFilter.set {
+		return nil
+	}
+	trimmed := strings.TrimSpace(outputFilter.raw)
+	if trimmed == "" {
+		return errors.New("--filter must not be empty")
+	}
+	expr, err := jmespath.Compile(trimmed)
+	if err != nil {
+		return fmt.Errorf("invalid --filter expression: %w", err)
+	}
+	outputFilter.expr = expr
+	return nil
+}
+
+// SetOutputFilter sets and compiles the --filter expression (tests only). An
+// empty expression clears it.
+func SetOutputFilter(expression string) error {
+	outputFilter = outputFilterFlag{}
+	if strings.TrimSpace(expression) == "" {
+		return nil
+	}
+	_ = outputFilter.Set(expression)
+	return ValidateOutputFilter()
+}
+
+// OutputFilterEnabled reports whether --filter is set.
+func OutputFilterEnabled() bool {
+	return outputFilter.expr != nil
+}
+
+// applyOutputFilter evaluates --filter against the JSON form of data.
+func applyOutputFilter(data any) (any, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("--filter: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(encoded, &value); err != nil {
+		return nil, fmt.Errorf("--filter: %w", err)
+	}
+	result, err := outputFilter.expr.Search(value)
+	if err != nil {
+		return nil, fmt.Errorf("--filter: %w", err)
+	}
+	return result, nil
+}
+
+// printFilteredOutput renders a --filter result. JSON output prints the
+// result as JSON; table and markdown print one value per line with strings
+// unquoted, like jq -r, since the result no longer has the command's shape.
+func printFilteredOutput(data any, format string, pretty bool) error {
+	result, err := applyOutputFilter(data)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "json":
+		return printJSONOutput(result, pretty)
+	case "table", "markdown":
+		values, ok := result.([]any)
+		if !ok {
+			values = []any{result}
+		}
+		for _, value := range values {
+			line, err := filteredValueLine(value)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(os.Stdout, line)
+		}
+		return nil
+	default:
+		return UsageErrorf("--filter is not supported with --output %s", format)
+	}
+}
+
+func filteredValueLine(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
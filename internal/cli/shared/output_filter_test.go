@@ -0,0 +1,188 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+const outputFilterTestDocument = `{
+	"data": [
+		{"id": "1", "attributes": {"version": "1.0", "platform": "IOS", "size": 10, "tags": ["a", "b"]}},
+		{"id": "2", "attributes": {"version": "1.1", "platform": "MAC_OS", "size": 25, "tags": ["c"]}},
+		{"id": "3", "attributes": {"version": "2.0", "platform": "IOS", "size": 40}}
+	],
+	"links": {"self": "https://example.com"}
+}`
+
+func TestOutputFilterExpressions(t *testing.T) {
+	var document any
+	if err := json.Unmarshal([]byte(outputFilterTestDocument), &document); err != nil {
+		t.Fatalf("decode document: %v", err)
+	}
+
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"links.self", `"https://example.com"`},
+		{"data[].attributes.version", `["1.0","1.1","2.0"]`},
+		{"data[*].id", `["1","2","3"]`},
+		{"data[0].id", `"1"`},
+		{"data[-1].id", `"3"`},
+		{"data[:2].id", `["1","2"]`},
+		{"data[:2][].id", `["1","2"]`},
+		{"data[::-1] | [0].id", `"3"`},
+		{"data[].attributes.tags[]", `["a","b","c"]`},
+		{"data[?attributes.platform == 'IOS'].id", `["1","3"]`},
+		{"data[?attributes.size > `20` && attributes.platform != 'MAC_OS'].id", `["3"]`},
+		{"data[?!(attributes.tags)].id", `["3"]`},
+		{"data[].{id: id, version: attributes.version} | [1]", `{"id":"2","version":"1.1"}`},
+		{"data[0].[id, attributes.platform]", `["1","IOS"]`},
+		{"missing || links.self", `"https://example.com"`},
+		{`"links"."self"`, `"https://example.com"`},
+		{"data[].missing", `[]`},
+		{"length(data)", `3`},
+		{"length(data[0].attributes.tags)", `2`},
+		{"data[?length(attributes.tags || `[]`) > `1`].id", `["1"]`},
+		{"keys(links)", `["self"]`},
+		{"sum(data[].attributes.size)", `75`},
+		{"avg(data[].attributes.size)", `25`},
+		{"max(data[].attributes.size)", `40`},
+		{"min_by(data, &attributes.size).id", `"1"`},
+		{"sort_by(data, &attributes.version)[-1].id", `"3"`},
+		{"reverse(sort(data[].attributes.platform))", `["MAC_OS","IOS","IOS"]`},
+		{"join(', ', data[].id)", `"1, 2, 3"`},
+		{"data[?contains(attributes.tags || `[]`, 'c')].id", `["2"]`},
+		{"data[?starts_with(attributes.version, '1.')].id", `["1","2"]`},
+		{"map(&attributes.size, data)", `[10,25,40]`},
+		{"not_null(missing, links.self)", `"https://example.com"`},
+		{"type(data[0].attributes.size)", `"number"`},
+		{"to_string(data[0].attributes.size)", `"10"`},
+		{"to_number('1.5')", `1.5`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			expr, err := jmespath.Compile(test.expression)
+			if err != nil {
+				t.Fatalf("jmespath.Compile(%q) error: %v", test.expression, err)
+			}
+			result, err := expr.Search(document)
+			if err != nil {
+				t.Fatalf("%s: search error: %v", test.expression, err)
+			}
+			got, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("marshal result: %v", err)
+			}
+			if string(got) != test.want {
+				t.Fatalf("%s = %s, want %s", test.expression, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOutputFilterRejectsInvalidExpressions(t *testing.T) {
+	for _, expression := range []string{"data[", "data.", "data[?id ==]", "{id}", "'open", "a $ b", "sort_by(data, &)"} {
+		if err := SetOutputFilter(expression); err == nil || !strings.Contains(err.Error(), "invalid --filter expression") {
+			t.Fatalf("expected invalid expression error for %q, got %v", expression, err)
+		}
+	}
+	t.Cleanup(func() { _ = SetOutputFilter("") })
+}
+
+func TestApplyOutputFilterReturnsEvaluationErrors(t *testing.T) {
+	t.Cleanup(func() { _ = SetOutputFilter("") })
+	for _, expression := range []string{"nope(data)", "length(data, data)", "sort(data[].attributes.tags)"} {
+		if err := SetOutputFilter(expression); err != nil {
+			t.Fatalf("SetOutputFilter(%q) error: %v", expression, err)
+		}
+		var document any
+		if err := json.Unmarshal([]byte(outputFilterTestDocument), &document); err != nil {
+			t.Fatalf("decode document: %v", err)
+		}
+		if _, err := applyOutputFilter(document); err == nil || !strings.Contains(err.Error(), "--filter:") {
+			t.Fatalf("expected evaluation error for %q, got %v", expression, err)
+		}
+	}
+}
+
+func TestValidateOutputFilter(t *testing.T) {
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindOutputFilterFlags(fs)
+	t.Cleanup(func() { _ = SetOutputFilter("") })
+
+	if err := fs.Parse([]string{"--filter", "data["}); err != nil {
+		t.Fatalf("parse --filter: %v", err)
+	}
+	if err := ValidateOutputFilter(); err == nil || !strings.Contains(err.Error(), "invalid --filter expression") {
+		t.Fatalf("expected invalid expression error, got %v", err)
+	}
+	if err := fs.Parse([]string{"--filter", " "}); err != nil {
+		t.Fatalf("parse --filter: %v", err)
+	}
+	if err := ValidateOutputFilter(); err == nil {
+		t.Fatal("expected error for empty --filter")
+	}
+	if err := fs.Parse([]string{"--filter", "data[].id"}); err != nil {
+		t.Fatalf("parse --filter: %v", err)
+	}
+	if err := ValidateOutputFilter(); err != nil {
+		t.Fatalf("ValidateOutputFilter() error: %v", err)
+	}
+	if !OutputFilterEnabled() {
+		t.Fatal("expected --filter to be enabled")
+	}
+}
+
+func TestPrintOutputAppliesFilter(t *testing.T) {
+	t.Cleanup(func() { _ = SetOutputFilter("") })
+	if err := SetOutputFilter("data[].attributes.version"); err != nil {
+		t.Fatalf("SetOutputFilter() error: %v", err)
+	}
+
+	var document any
+	if err := json.Unmarshal([]byte(outputFilterTestDocument), &document); err != nil {
+		t.Fatalf("decode document: %v", err)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(document, "json", false); err != nil {
+			t.Fatalf("PrintOutput(json) error: %v", err)
+		}
+	})
+	var versions []string
+	if err := json.Unmarshal([]byte(stdout), &versions); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if strings.Join(versions, ",") != "1.0,1.1,2.0" {
+		t.Fatalf("unexpected versions: %v", versions)
+	}
+
+	stdout, _ = captureOutput(t, func() {
+		tableRenderer := func() error { return errors.New("table renderer should not run") }
+		if err := PrintOutputWithRenderers(document, "table", false, tableRenderer, tableRenderer); err != nil {
+			t.Fatalf("PrintOutputWithRenderers(table) error: %v", err)
+		}
+	})
+	if stdout != "1.0\n1.1\n2.0\n" {
+		t.Fatalf("unexpected table output: %q", stdout)
+	}
+
+	_, stderr := captureOutput(t, func() {
+		err := PrintOutput(document, "csv", false)
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected usage error for csv output, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--filter is not supported with --output csv") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
@@ -0,0 +1,151 @@
+package shared
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	outputFormatGHA         = "gha"
+	githubStepSummaryEnvVar = "GITHUB_STEP_SUMMARY"
+)
+
+// GHAAnnotation is a GitHub Actions workflow command such as ::notice or
+// ::error, emitted by commands that support --output gha.
+type GHAAnnotation struct {
+	Level   string // notice, warning, or error
+	Title   string
+	Message string
+}
+
+// GHANotice returns a ::notice annotation.
+func GHANotice(title, message string) GHAAnnotation {
+	return GHAAnnotation{Level: "notice", Title: title, Message: message}
+}
+
+// GHAWarning returns a ::warning annotation.
+func GHAWarning(title, message string) GHAAnnotation {
+	return GHAAnnotation{Level: "warning", Title: title, Message: message}
+}
+
+// GHAError returns an ::error annotation.
+func GHAError(title, message string) GHAAnnotation {
+	return GHAAnnotation{Level: "error", Title: title, Message: message}
+}
+
+// BindGHAOutputFlags registers --output, --pretty, and --columns like
+// BindOutputFlags, and additionally accepts --output gha.
+func BindGHAOutputFlags(fs *flag.FlagSet) OutputFlags {
+	output := BindOutputFlagsWithAllowed(fs, "output", DefaultOutputFormat(), "Output format: json, table, markdown, csv, gha", "json", "table", "markdown", "csv", outputFormatGHA)
+	csvColumns = nil
+	fs.Var(&csvColumnsValue{output: output.Output}, "columns", "Comma-separated columns for CSV output (default: all, id and type first)")
+	return output
+}
+
+// IsGHAOutput reports whether format selects GitHub Actions output.
+func IsGHAOutput(format string) bool {
+	return NormalizeOutputFormat(format) == outputFormatGHA
+}
+
+// PrintOutputWithGHA prints data like PrintOutput. With --output gha it
+// instead writes the annotations and a Markdown table to stdout, and appends
+// the table under summaryTitle to the file named by GITHUB_STEP_SUMMARY when
+// that variable is set.
+func PrintOutputWithGHA(data any, format string, pretty bool, summaryTitle string, annotations ...GHAAnnotation) error {
+	if !IsGHAOutput(format) {
+		return printOutput(data, format, pretty)
+	}
+	if pretty {
+		return fmt.Errorf("--pretty is only valid with JSON output")
+	}
+	if OutputFilterEnabled() {
+		return UsageErrorf("--filter is not supported with --output %s", outputFormatGHA)
+	}
+
+	var table bytes.Buffer
+	if _, err := asc.WriteMarkdown(&table, data); err != nil {
+		return err
+	}
+
+	for _, annotation := range annotations {
+		writeGHAAnnotation(os.Stdout, annotation)
+	}
+	if _, err := os.Stdout.Write(table.Bytes()); err != nil {
+		return err
+	}
+
+	summaryPath := strings.TrimSpace(os.Getenv(githubStepSummaryEnvVar))
+	if summaryPath == "" {
+		return nil
+	}
+	if err := appendGHAStepSummary(summaryPath, summaryTitle, annotations, table.Bytes()); err != nil {
+		return fmt.Errorf("write %s: %w", githubStepSummaryEnvVar, err)
+	}
+	return nil
+}
+
+func writeGHAAnnotation(w io.Writer, annotation GHAAnnotation) {
+	level := strings.TrimSpace(annotation.Level)
+	if level == "" {
+		level = "notice"
+	}
+	if title := strings.TrimSpace(annotation.Title); title != "" {
+		fmt.Fprintf(w, "::%s title=%s::%s\n", level, escapeGHAProperty(title), escapeGHAData(annotation.Message))
+		return
+	}
+	fmt.Fprintf(w, "::%s::%s\n", level, escapeGHAData(annotation.Message))
+}
+
+func appendGHAStepSummary(path, title string, annotations []GHAAnnotation, table []byte) error {
+	var b strings.Builder
+	if title = strings.TrimSpace(title); title != "" {
+		fmt.Fprintf(&b, "### %s\n\n", title)
+	}
+	for _, annotation := range annotations {
+		fmt.Fprintf(&b, "%s %s\n", ghaSummaryMarker(annotation.Level), strings.TrimSpace(annotation.Message))
+	}
+	if len(annotations) > 0 {
+		b.WriteString("\n")
+	}
+	if len(table) > 0 {
+		b.Write(table)
+		b.WriteString("\n")
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(b.String()); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func ghaSummaryMarker(level string) string {
+	switch level {
+	case "error":
+		return ":x:"
+	case "warning":
+		return ":warning:"
+	default:
+		return ":white_check_mark:"
+	}
+}
+
+// escapeGHAData escapes an annotation message as GitHub Actions expects.
+func escapeGHAData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+// escapeGHAProperty escapes an annotation property value such as title.
+func escapeGHAProperty(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(value)
+}
@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteGHAAnnotationEscapesValues(t *testing.T) {
+	var buf bytes.Buffer
+	writeGHAAnnotation(&buf, GHAError("Build: 1, failed", "line one\nline two 100%"))
+
+	want := "::error title=Build%3A 1%2C failed::line one%0Aline two 100%25\n"
+	if buf.String() != want {
+		t.Fatalf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWriteGHAAnnotationWithoutTitle(t *testing.T) {
+	var buf bytes.Buffer
+	writeGHAAnnotation(&buf, GHAAnnotation{Message: "done"})
+
+	if buf.String() != "::notice::done\n" {
+		t.Fatalf("unexpected annotation: %q", buf.String())
+	}
+}
+
+func TestAppendGHAStepSummaryAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.md")
+	if err := os.WriteFile(path, []byte("existing\n"), 0o644); err != nil {
+		t.Fatalf("write summary: %v", err)
+	}
+
+	table := []byte("| ID |\n|----|\n| 1  |\n")
+	if err := appendGHAStepSummary(path, "Builds", []GHAAnnotation{GHAWarning("", "Build not valid")}, table); err != nil {
+		t.Fatalf("appendGHAStepSummary() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read summary: %v", err)
+	}
+	want := "existing\n### Builds\n\n:warning: Build not valid\n\n| ID |\n|----|\n| 1  |\n\n"
+	if string(data) != want {
+		t.Fatalf("got %q, want %q", data, want)
+	}
+}
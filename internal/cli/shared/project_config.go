@@ -0,0 +1,211 @@
+package shared
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectConfigFileName is the per-directory project file. The nearest one
+// found walking up from the working directory applies.
+const ProjectConfigFileName = ".asc.yaml"
+
+// ProjectConfig pins defaults for invocations inside a project directory.
+type ProjectConfig struct {
+	// App is the App Store Connect app ID used when --app is not passed.
+	App string `yaml:"app"`
+	// BundleID selects the app when App is unset; it is resolved like a
+	// non-numeric --app value.
+	BundleID string `yaml:"bundle_id"`
+	// Locales fill comma-separated --locale and --locales flags.
+	Locales []string `yaml:"locales"`
+	// Output is the default output format below ASC_DEFAULT_OUTPUT.
+	Output string `yaml:"output"`
+
+	path string
+}
+
+// Path returns the file the config was loaded from.
+func (c *ProjectConfig) Path() string {
+	if c == nil {
+		return ""
+	}
+	return c.path
+}
+
+// FindProjectConfig returns the nearest .asc.yaml at or above the working
+// directory, or nil when there is none.
+func FindProjectConfig() (*ProjectConfig, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, nil
+	}
+	for {
+		path := filepath.Join(dir, ProjectConfigFileName)
+		info, err := os.Stat(path)
+		if err == nil && !info.IsDir() {
+			cfg, err := loadProjectConfig(path)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			return cfg, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+func loadProjectConfig(path string) (*ProjectConfig, error) {
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &ProjectConfig{path: path}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	cfg.App = strings.TrimSpace(cfg.App)
+	cfg.BundleID = strings.TrimSpace(cfg.BundleID)
+	cfg.Output = strings.ToLower(strings.TrimSpace(cfg.Output))
+	if cfg.App != "" && !isNumericAppID(cfg.App) {
+		return nil, fmt.Errorf("app must be a numeric App Store Connect app ID (use bundle_id for %q)", cfg.App)
+	}
+	locales := make([]string, 0, len(cfg.Locales))
+	for _, locale := range cfg.Locales {
+		locale = strings.TrimSpace(locale)
+		if locale == "" {
+			return nil, fmt.Errorf("locales must not contain empty entries")
+		}
+		if !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+	cfg.Locales = locales
+	switch cfg.Output {
+	case "", "json", "table", "markdown", "md", "csv":
+	default:
+		return nil, fmt.Errorf("output must be json, table, markdown, md, or csv (got %q)", cfg.Output)
+	}
+	return cfg, nil
+}
+
+// ApplyProjectConfig validates the nearest .asc.yaml and inserts its locale
+// list on comma-separated --locale and --locales flags of the command args
+// resolve to. Flags passed explicitly are never overridden. The project's app
+// is not inserted here; ResolveAppID falls back to it like ASC_APP_ID, so
+// commands that take --app alongside another selector keep working.
+//
+// args should already be canonicalized by ExpandFlagArgs.
+func ApplyProjectConfig(root *ffcli.Command, args []string) ([]string, error) {
+	if root == nil || len(args) == 0 {
+		return args, nil
+	}
+
+	cmd := root
+	insertAt := -1
+	explicit := map[string]struct{}{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			sub := findSubcommand(cmd, arg)
+			if sub == nil {
+				break
+			}
+			cmd = sub
+			insertAt = i + 1
+			clear(explicit)
+			continue
+		}
+
+		name, _, hasValue := splitFlagArg(arg)
+		explicit[name] = struct{}{}
+		f := lookupFlag(cmd, name)
+		if f != nil && !hasValue && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+		}
+	}
+	if cmd == root || cmd.FlagSet == nil {
+		return args, nil
+	}
+
+	cfg, err := FindProjectConfig()
+	if err != nil {
+		return nil, UsageErrorf("project config %v", err)
+	}
+	if cfg == nil {
+		return args, nil
+	}
+
+	var inserted []string
+	if len(cfg.Locales) > 0 {
+		for _, name := range []string{"locale", "locales"} {
+			f := cmd.FlagSet.Lookup(name)
+			if _, ok := explicit[name]; ok || f == nil || !acceptsLocaleList(f.Usage) {
+				continue
+			}
+			inserted = append(inserted, "--"+name+"="+strings.Join(cfg.Locales, ","))
+		}
+	}
+	if len(inserted) == 0 {
+		return args, nil
+	}
+
+	withProject := make([]string, 0, len(args)+len(inserted))
+	withProject = append(withProject, args[:insertAt]...)
+	withProject = append(withProject, inserted...)
+	return append(withProject, args[insertAt:]...), nil
+}
+
+// acceptsLocaleList reports whether a locale flag takes several locales;
+// single-locale flags (such as the locale to update) are left alone.
+func acceptsLocaleList(usage string) bool {
+	return strings.Contains(strings.ToLower(usage), "comma-separated")
+}
+
+// projectDefaultApp returns the nearest .asc.yaml app ID, or its bundle ID
+// when no app ID is pinned. Invalid project files are reported when flags are
+// applied, not here.
+func projectDefaultApp() string {
+	cfg, err := FindProjectConfig()
+	if err != nil || cfg == nil {
+		return ""
+	}
+	if cfg.App != "" {
+		return cfg.App
+	}
+	return cfg.BundleID
+}
+
+// projectDefaultOutput returns the nearest .asc.yaml output format, if any.
+// Invalid project files are reported when flags are applied, not here.
+func projectDefaultOutput() string {
+	cfg, err := FindProjectConfig()
+	if err != nil || cfg == nil {
+		return ""
+	}
+	return cfg.Output
+}
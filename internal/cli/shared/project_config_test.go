@@ -0,0 +1,188 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// writeProjectConfig writes .asc.yaml to a temp project and changes into a
+// nested directory so discovery has to walk up.
+func writeProjectConfig(t *testing.T, body string) string {
+	t.Helper()
+	project := t.TempDir()
+	path := filepath.Join(project, ProjectConfigFileName)
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write project config: %v", err)
+	}
+	nested := filepath.Join(project, "ios", "App")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	t.Chdir(nested)
+	return path
+}
+
+func newProjectConfigTestTree() *ffcli.Command {
+	listFS := flag.NewFlagSet("localizations list", flag.ContinueOnError)
+	listFS.String("app", "", "app")
+	listFS.String("bundle-id", "", "bundle id")
+	listFS.String("locale", "", "Filter by locale(s), comma-separated")
+
+	updateFS := flag.NewFlagSet("localizations update", flag.ContinueOnError)
+	updateFS.String("app", "", "app")
+	updateFS.String("locale", "", "Locale to update (required, e.g., en-US)")
+
+	exportFS := flag.NewFlagSet("signing export", flag.ContinueOnError)
+	exportFS.String("bundle-id", "", "bundle id")
+
+	return &ffcli.Command{
+		Name:    "asc",
+		FlagSet: flag.NewFlagSet("asc", flag.ContinueOnError),
+		Subcommands: []*ffcli.Command{
+			{
+				Name:    "localizations",
+				FlagSet: flag.NewFlagSet("localizations", flag.ContinueOnError),
+				Subcommands: []*ffcli.Command{
+					{Name: "list", FlagSet: listFS},
+					{Name: "update", FlagSet: updateFS},
+				},
+			},
+			{
+				Name:    "signing",
+				FlagSet: flag.NewFlagSet("signing", flag.ContinueOnError),
+				Subcommands: []*ffcli.Command{
+					{Name: "export", FlagSet: exportFS},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyProjectConfig(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	os.Unsetenv("ASC_APP_ID")
+	writeProjectConfig(t, "app: \"123\"\nbundle_id: com.example.app\nlocales: [en-US, de-DE]\n")
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "locale list without inserting app selectors",
+			args: []string{"localizations", "list"},
+			want: []string{"localizations", "list", "--locale=en-US,de-DE"},
+		},
+		{
+			name: "explicit locale wins",
+			args: []string{"localizations", "list", "--locale", "fr-FR"},
+			want: []string{"localizations", "list", "--locale", "fr-FR"},
+		},
+		{
+			name: "single-locale flags are left alone",
+			args: []string{"localizations", "update"},
+			want: []string{"localizations", "update"},
+		},
+		{
+			name: "commands without locale flags are left alone",
+			args: []string{"signing", "export"},
+			want: []string{"signing", "export"},
+		},
+		{
+			name: "group commands are left alone",
+			args: []string{"localizations"},
+			want: []string{"localizations"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ApplyProjectConfig(newProjectConfigTestTree(), test.args)
+			if err != nil {
+				t.Fatalf("ApplyProjectConfig() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("ApplyProjectConfig() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestResolveAppIDFallsBackToProjectConfig(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+	os.Unsetenv("ASC_APP_ID")
+
+	writeProjectConfig(t, "app: \"123\"\nbundle_id: com.example.app\n")
+	if got := ResolveAppID(""); got != "123" {
+		t.Fatalf("ResolveAppID() = %q, want project app 123", got)
+	}
+	if got := ResolveAppID("789"); got != "789" {
+		t.Fatalf("ResolveAppID() = %q, want explicit app 789", got)
+	}
+
+	writeProjectConfig(t, "bundle_id: com.example.app\n")
+	if got := ResolveAppID(""); got != "com.example.app" {
+		t.Fatalf("ResolveAppID() = %q, want project bundle ID", got)
+	}
+}
+
+func TestResolveAppIDPrefersEnvOverProjectConfig(t *testing.T) {
+	writeProjectConfig(t, "app: \"123\"\n")
+	t.Setenv("ASC_APP_ID", "456")
+
+	if got := ResolveAppID(""); got != "456" {
+		t.Fatalf("ResolveAppID() = %q, want ASC_APP_ID 456", got)
+	}
+}
+
+func TestApplyProjectConfigRejectsInvalidFile(t *testing.T) {
+	tests := map[string]string{
+		"unknown key":    "app_id: \"123\"\n",
+		"non-numeric":    "app: com.example.app\n",
+		"bad output":     "output: yaml\n",
+		"empty locale":   "locales: [en-US, \"\"]\n",
+		"malformed yaml": "app: [\n",
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeProjectConfig(t, body)
+			_, err := ApplyProjectConfig(newProjectConfigTestTree(), []string{"localizations", "list"})
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", err)
+			}
+			if _, err := FindProjectConfig(); err == nil || !strings.Contains(err.Error(), path) {
+				t.Fatalf("expected error naming %s, got %v", path, err)
+			}
+		})
+	}
+}
+
+func TestProjectDefaultOutput(t *testing.T) {
+	writeProjectConfig(t, "output: Markdown\n")
+	t.Setenv(defaultOutputEnvVar, "")
+	ResetDefaultOutputFormat()
+	t.Cleanup(ResetDefaultOutputFormat)
+
+	if got := DefaultOutputFormat(); got != "markdown" {
+		t.Fatalf("DefaultOutputFormat() = %q, want markdown", got)
+	}
+}
+
+func TestProjectDefaultOutputCSV(t *testing.T) {
+	writeProjectConfig(t, "output: csv\n")
+	t.Setenv(defaultOutputEnvVar, "")
+	ResetDefaultOutputFormat()
+	t.Cleanup(ResetDefaultOutputFormat)
+
+	if got := DefaultOutputFormat(); got != "csv" {
+		t.Fatalf("DefaultOutputFormat() = %q, want csv", got)
+	}
+}
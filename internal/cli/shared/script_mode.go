@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"flag"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	quietEnvVar   = "ASC_QUIET"
+	noInputEnvVar = "ASC_NO_INPUT"
+)
+
+var (
+	quiet   bool
+	noInput bool
+)
+
+// BindScriptModeFlags registers --quiet and --no-input for CI and cron runs.
+func BindScriptModeFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&quiet, "quiet", false, "Suppress non-essential stderr output: warnings, progress, spinners (or ASC_QUIET)")
+	fs.BoolVar(&noInput, "no-input", false, "Never prompt for input; fail when a value would be prompted for (or ASC_NO_INPUT)")
+}
+
+// QuietEnabled reports whether non-essential stderr output is suppressed.
+func QuietEnabled() bool {
+	return quiet || envFlagEnabled(quietEnvVar)
+}
+
+// NoInputEnabled reports whether interactive prompts are disallowed.
+func NoInputEnabled() bool {
+	return noInput || envFlagEnabled(noInputEnvVar)
+}
+
+// SetQuiet sets quiet mode (tests only).
+func SetQuiet(value bool) {
+	quiet = value
+}
+
+// SetNoInput sets no-input mode (tests only).
+func SetNoInput(value bool) {
+	noInput = value
+}
+
+// WarningWriter returns the writer for warnings and other non-essential
+// stderr messages. It discards output when --quiet is set; errors should keep
+// writing to os.Stderr directly.
+func WarningWriter() io.Writer {
+	if QuietEnabled() {
+		return io.Discard
+	}
+	return os.Stderr
+}
+
+// RequireInputAllowed returns a usage error naming the flags to pass instead
+// when --no-input forbids prompting.
+func RequireInputAllowed(hint string) error {
+	if !NoInputEnabled() {
+		return nil
+	}
+	return UsageErrorf("input required but --no-input is set: %s", hint)
+}
+
+func envFlagEnabled(name string) bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(name))) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true
+	default:
+		return false
+	}
+}
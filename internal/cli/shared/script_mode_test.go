@@ -0,0 +1,44 @@
+package shared
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+func TestWarningWriterHonorsQuiet(t *testing.T) {
+	t.Setenv(quietEnvVar, "")
+	t.Cleanup(func() { SetQuiet(false) })
+
+	if WarningWriter() != os.Stderr {
+		t.Fatal("expected warnings on stderr by default")
+	}
+
+	SetQuiet(true)
+	if WarningWriter() != io.Discard {
+		t.Fatal("expected warnings to be discarded with --quiet")
+	}
+	if ProgressEnabled() {
+		t.Fatal("expected progress to be disabled with --quiet")
+	}
+
+	SetQuiet(false)
+	t.Setenv(quietEnvVar, "true")
+	if !QuietEnabled() {
+		t.Fatalf("expected %s=true to enable quiet mode", quietEnvVar)
+	}
+}
+
+func TestRequireInputAllowed(t *testing.T) {
+	t.Setenv(noInputEnvVar, "")
+	t.Cleanup(func() { SetNoInput(false) })
+
+	if err := RequireInputAllowed("pass --name"); err != nil {
+		t.Fatalf("expected prompts to be allowed by default, got %v", err)
+	}
+
+	SetNoInput(true)
+	if err := RequireInputAllowed("pass --name"); err == nil {
+		t.Fatal("expected error with --no-input")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"text/tabwriter"
@@ -69,6 +70,11 @@ var (
 	retryLog            OptionalBool
 	debug               OptionalBool
 	apiDebug            OptionalBool
+	maxRetries          maxRetriesFlag
+	rateLimit           rateLimitFlag
+	uploadConcurrency   uploadConcurrencyFlag
+	paginateWorkers     paginateWorkersFlag
+	dryRun              bool
 
 	getCredentialsWithSourceFn = auth.GetCredentialsWithSource
 )
@@ -91,6 +97,16 @@ func BindRootFlags(fs *flag.FlagSet) {
 	fs.Var(&retryLog, "retry-log", "Enable retry logging to stderr (overrides ASC_RETRY_LOG/config when set)")
 	fs.Var(&debug, "debug", "Enable debug logging to stderr")
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
+	fs.Var(&maxRetries, "max-retries", "Retry attempts for rate-limited and transient server errors (overrides ASC_MAX_RETRIES/config when set)")
+	fs.Var(&rateLimit, "rate-limit", "Throttle API requests to N per second across concurrent workers, 0 disables (overrides ASC_RATE_LIMIT when set)")
+	fs.Var(&uploadConcurrency, "upload-concurrency", "Upload parts to send in parallel for file uploads (overrides ASC_UPLOAD_CONCURRENCY when set; default 4)")
+	fs.Var(&paginateWorkers, "paginate-workers", "Pages to fetch ahead in parallel for --paginate when the total is known, 0 or 1 fetches sequentially (overrides ASC_PAGINATE_WORKERS/config when set)")
+	fs.BoolVar(&dryRun, "dry-run", false, "Print create/update/delete requests (method, URL, redacted body) instead of sending them")
+	fs.BoolFunc("no-retry", "Disable retries for rate-limited and transient server errors (same as --max-retries 0)", maxRetries.disable)
+	BindScriptModeFlags(fs)
+	BindConsoleFlags(fs)
+	BindProgressFlags(fs)
+	BindOutputFilterFlags(fs)
 	BindCIFlags(fs)
 }
 
@@ -102,7 +118,7 @@ func SelectedProfile() string {
 // ProgressEnabled reports whether it's safe/appropriate to emit progress messages.
 // Progress must be stderr-only and must not appear when stderr is non-interactive.
 func ProgressEnabled() bool {
-	if noProgress {
+	if noProgress || QuietEnabled() {
 		return false
 	}
 	return isTerminal(int(os.Stderr.Fd()))
@@ -127,7 +143,7 @@ func ResetDefaultOutputFormat() {
 
 // Bold returns the string wrapped in ANSI bold codes
 func Bold(s string) string {
-	if !supportsANSI() {
+	if !colorSupported(int(os.Stderr.Fd())) {
 		return s
 	}
 	return bold + s + reset
@@ -156,14 +172,16 @@ func RenderSection(title string, headers []string, rows [][]string, markdown boo
 	fmt.Fprintln(os.Stdout)
 }
 
-func supportsANSI() bool {
+// colorSupported reports whether ANSI codes may be written to fd: it must be
+// a color-capable terminal, and NO_COLOR and TERM=dumb turn color off.
+func colorSupported(fd int) bool {
 	if _, ok := os.LookupEnv("NO_COLOR"); ok {
 		return false
 	}
 	if strings.EqualFold(os.Getenv("TERM"), "dumb") {
 		return false
 	}
-	return isTerminal(int(os.Stderr.Fd()))
+	return isTerminal(fd) && enableANSI()
 }
 
 // DefaultUsageFunc returns a usage string with bold section headers
@@ -235,6 +253,12 @@ func DefaultUsageFunc(c *ffcli.Command) string {
 				if f.Name == "output" {
 					usage = strings.Replace(usage, "json (default),", "json,", 1)
 				}
+				if alias := shortFlagAlias(c.FlagSet, f.Name); alias != "" {
+					usage += " (short: -" + alias + ")"
+				}
+				if f.Name == "id" && acceptsStdinIDs(c) {
+					usage += " (use - to read IDs from stdin)"
+				}
 				if def != "" {
 					fmt.Fprintf(tw, "  --%-12s %s (default: %s)\n", f.Name, usage, def)
 					continue
@@ -343,6 +367,41 @@ func (v *validatedOutputValue) Validate() error {
 	return err
 }
 
+// csvColumns holds the --columns selection for the command being executed.
+var csvColumns []string
+
+type csvColumnsValue struct {
+	output *string
+	raw    string
+}
+
+func (v *csvColumnsValue) String() string {
+	if v == nil {
+		return ""
+	}
+	return v.raw
+}
+
+func (v *csvColumnsValue) Set(value string) error {
+	columns := splitCSV(value)
+	if len(columns) == 0 {
+		return fmt.Errorf("--columns must include at least one column")
+	}
+	v.raw = value
+	csvColumns = columns
+	return nil
+}
+
+func (v *csvColumnsValue) Validate() error {
+	if v == nil || v.raw == "" || v.output == nil {
+		return nil
+	}
+	if NormalizeOutputFormat(*v.output) != "csv" {
+		return fmt.Errorf("--columns requires --output csv")
+	}
+	return nil
+}
+
 // MetadataOutputFlags stores pointers to metadata output-related flag values.
 type MetadataOutputFlags struct {
 	OutputFormat *string
@@ -483,20 +542,182 @@ func allowsEnvFallbackForStoredError(err error) bool {
 }
 
 func getASCClient() (*asc.Client, error) {
+	mockMode, err := asc.MockMode()
+	if err != nil {
+		return nil, err
+	}
+	if mockMode == asc.MockModeReplay {
+		// Recordings replay without credentials, so offline demos and script
+		// tests need no API key.
+		ApplyRootLoggingOverrides()
+		asc.SetWarningOutput(WarningWriter())
+		return asc.NewMockReplayClient()
+	}
+
 	resolved, err := resolveCredentials()
 	if err != nil {
 		return nil, err
 	}
 	ApplyRootLoggingOverrides()
+	asc.SetWarningOutput(WarningWriter())
+	var client *asc.Client
 	if strings.TrimSpace(resolved.keyPEM) != "" {
-		return asc.NewClientFromPEM(resolved.keyID, resolved.issuerID, resolved.keyPEM)
+		client, err = asc.NewClientFromPEM(resolved.keyID, resolved.issuerID, resolved.keyPEM)
+	} else {
+		client, err = asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	client.EnableClockProbe()
+	client.EnablePersistentTokenCache()
+	return client, nil
+}
+
+// maxRetriesFlag backs --max-retries and --no-retry, which both set the
+// retry count and are therefore mutually exclusive.
+type maxRetriesFlag struct {
+	set     bool
+	value   int
+	noRetry bool
+}
+
+func (m *maxRetriesFlag) Set(value string) error {
+	if m.noRetry {
+		return fmt.Errorf("cannot be combined with --no-retry")
+	}
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("must be a non-negative integer")
+	}
+	m.value = parsed
+	m.set = true
+	return nil
+}
+
+func (m *maxRetriesFlag) String() string {
+	if m == nil || !m.set {
+		return ""
+	}
+	return strconv.Itoa(m.value)
+}
+
+func (m *maxRetriesFlag) disable(value string) error {
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("must be true or false")
+	}
+	if !enabled {
+		return nil
+	}
+	if m.set && !m.noRetry {
+		return fmt.Errorf("cannot be combined with --max-retries")
+	}
+	m.set = true
+	m.value = 0
+	m.noRetry = true
+	return nil
+}
+
+// uploadConcurrencyFlag backs --upload-concurrency.
+type uploadConcurrencyFlag struct {
+	set   bool
+	value int
+}
+
+func (u *uploadConcurrencyFlag) Set(value string) error {
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || parsed < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	u.value = parsed
+	u.set = true
+	return nil
+}
+
+func (u *uploadConcurrencyFlag) String() string {
+	if u == nil || !u.set {
+		return ""
+	}
+	return strconv.Itoa(u.value)
+}
+
+// paginateWorkersFlag backs --paginate-workers.
+type paginateWorkersFlag struct {
+	set   bool
+	value int
+}
+
+func (p *paginateWorkersFlag) Set(value string) error {
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("must be a non-negative integer")
+	}
+	p.value = parsed
+	p.set = true
+	return nil
+}
+
+func (p *paginateWorkersFlag) String() string {
+	if p == nil || !p.set {
+		return ""
+	}
+	return strconv.Itoa(p.value)
+}
+
+// rateLimitFlag backs --rate-limit (requests per second).
+type rateLimitFlag struct {
+	set   bool
+	value float64
+}
+
+func (r *rateLimitFlag) Set(value string) error {
+	parsed, err := asc.ParseRateLimit(value)
+	if err != nil {
+		return err
+	}
+	r.value = parsed
+	r.set = true
+	return nil
+}
+
+func (r *rateLimitFlag) String() string {
+	if r == nil || !r.set {
+		return ""
 	}
-	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
+	return strconv.FormatFloat(r.value, 'f', -1, 64)
 }
 
-// ApplyRootLoggingOverrides applies root-level logging flag overrides
-// (--retry-log, --debug, --api-debug) into the shared ASC runtime.
+// ApplyRootLoggingOverrides applies root-level logging, retry, rate-limit,
+// upload, pagination, and dry-run flag overrides (--retry-log, --debug,
+// --api-debug, --max-retries, --no-retry, --rate-limit, --upload-concurrency,
+// --paginate-workers, --dry-run) into the shared ASC runtime, and installs the
+// upload progress bar.
 func ApplyRootLoggingOverrides() {
+	if rateLimit.set {
+		value := rateLimit.value
+		asc.SetRateLimitOverride(&value)
+	} else {
+		asc.SetRateLimitOverride(nil)
+	}
+	if uploadConcurrency.set {
+		value := uploadConcurrency.value
+		asc.SetUploadConcurrencyOverride(&value)
+	} else {
+		asc.SetUploadConcurrencyOverride(nil)
+	}
+	if paginateWorkers.set {
+		value := paginateWorkers.value
+		asc.SetPaginateWorkersOverride(&value)
+	} else {
+		asc.SetPaginateWorkersOverride(nil)
+	}
+	if maxRetries.set {
+		value := maxRetries.value
+		asc.SetMaxRetriesOverride(&value)
+	} else {
+		asc.SetMaxRetriesOverride(nil)
+	}
 	if retryLog.IsSet() {
 		value := retryLog.Value()
 		asc.SetRetryLogOverride(&value)
@@ -515,6 +736,13 @@ func ApplyRootLoggingOverrides() {
 	} else {
 		asc.SetDebugHTTPOverride(nil)
 	}
+	if dryRun {
+		value := true
+		asc.SetDryRunOverride(&value)
+	} else {
+		asc.SetDryRunOverride(nil)
+	}
+	asc.SetUploadProgressHandler(uploadProgressHandler())
 }
 
 func checkMixedCredentialSources(sources credentialSource) error {
@@ -537,7 +765,7 @@ func checkMixedCredentialSources(sources credentialSource) error {
 	if strictAuthEnabled() {
 		return fmt.Errorf("mixed authentication sources detected:\n  Key ID: %s\n  Issuer ID: %s\n  Private Key: %s", keyIDSource, issuerSource, keyMaterialSource)
 	}
-	fmt.Fprint(os.Stderr, message)
+	fmt.Fprint(WarningWriter(), message)
 	return nil
 }
 
@@ -609,15 +837,20 @@ func writeTempPrivateKey(data []byte, cacheKey string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// CreateTemp already uses 0600; Chmod is a no-op for these bits on Windows,
+	// where the file inherits the per-user temp directory ACL instead.
 	if err := file.Chmod(0o600); err != nil {
 		_ = file.Close()
+		_ = os.Remove(file.Name())
 		return "", err
 	}
 	if _, err := file.Write(data); err != nil {
 		_ = file.Close()
+		_ = os.Remove(file.Name())
 		return "", err
 	}
 	if err := file.Close(); err != nil {
+		_ = os.Remove(file.Name())
 		return "", err
 	}
 	registerTempPrivateKey(file.Name(), cacheKey)
@@ -689,7 +922,7 @@ func warnInvalidStrictAuthValueOnce(value string) {
 	strictAuthWarnMu.Unlock()
 
 	fmt.Fprintf(
-		os.Stderr,
+		WarningWriter(),
 		"Warning: invalid %s value %q (expected true/false, 1/0, yes/no, y/n, or on/off); strict auth disabled\n",
 		strictAuthEnvVar,
 		value,
@@ -707,9 +940,14 @@ func printOutput(data any, format string, pretty bool) error {
 	if err != nil {
 		return err
 	}
+	if OutputFilterEnabled() {
+		return printFilteredOutput(data, format, pretty)
+	}
 	switch format {
 	case "json":
 		return printJSONOutput(data, pretty)
+	case "csv":
+		return asc.PrintCSV(data, csvColumns)
 	case "markdown":
 		return asc.PrintMarkdown(data)
 	case "table":
@@ -724,9 +962,14 @@ func printOutputWithRenderers(data any, format string, pretty bool, tableRendere
 	if err != nil {
 		return err
 	}
+	if OutputFilterEnabled() {
+		return printFilteredOutput(data, format, pretty)
+	}
 	switch format {
 	case "json":
 		return printJSONOutput(data, pretty)
+	case "csv":
+		return asc.PrintCSV(data, csvColumns)
 	case "table":
 		if tableRenderer == nil {
 			return fmt.Errorf("table renderer is required")
@@ -760,7 +1003,7 @@ func NormalizeOutputFormat(format string) string {
 }
 
 func validateOutputFormat(format string, pretty bool) (string, error) {
-	return validateOutputFormatAllowed(format, pretty, "json", "table", "markdown")
+	return validateOutputFormatAllowed(format, pretty, "json", "table", "markdown", "csv")
 }
 
 func validateOutputFormatAllowed(format string, pretty bool, allowed ...string) (string, error) {
@@ -787,6 +1030,9 @@ func validateOutputFormatAllowed(format string, pretty bool, allowed ...string)
 }
 
 func printStreamPage(data any) error {
+	if OutputFilterEnabled() {
+		return printFilteredOutput(data, "json", false)
+	}
 	return asc.PrintJSON(data)
 }
 
@@ -840,8 +1086,9 @@ var (
 )
 
 // DefaultOutputFormat returns the default output format for CLI commands.
-// It checks ASC_DEFAULT_OUTPUT first. When unset, interactive terminals default
-// to table output and non-interactive contexts default to JSON.
+// It checks ASC_DEFAULT_OUTPUT first, then the output in the nearest .asc.yaml.
+// When neither is set, interactive terminals default to table output and
+// non-interactive contexts default to JSON.
 // Valid ASC_DEFAULT_OUTPUT values are "json", "table", "markdown", and "md".
 func DefaultOutputFormat() string {
 	defaultOutputOnce.Do(func() {
@@ -853,6 +1100,9 @@ func DefaultOutputFormat() string {
 func resolveDefaultOutput() string {
 	env := strings.TrimSpace(os.Getenv(defaultOutputEnvVar))
 	if env == "" {
+		if project := projectDefaultOutput(); project != "" {
+			return project
+		}
 		if isTerminal(int(os.Stdout.Fd())) {
 			return "table"
 		}
@@ -860,10 +1110,10 @@ func resolveDefaultOutput() string {
 	}
 	normalized := strings.ToLower(env)
 	switch normalized {
-	case "json", "table", "markdown", "md":
+	case "json", "table", "markdown", "md", "csv":
 		return normalized
 	default:
-		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, or md); using json\n", defaultOutputEnvVar, env)
+		fmt.Fprintf(WarningWriter(), "Warning: invalid %s value %q (expected json, table, markdown, md, or csv); using json\n", defaultOutputEnvVar, env)
 		return "json"
 	}
 }
@@ -885,7 +1135,12 @@ func BindOutputFlagsWithAllowed(fs *flag.FlagSet, flagName, defaultValue, usage
 		allowed = []string{"json", "table", "markdown"}
 	}
 
+	// A default that this command cannot render, such as csv from
+	// ASC_DEFAULT_OUTPUT, falls back to json instead of failing validation.
 	outputValue := defaultValue
+	if _, err := validateOutputFormatAllowed(defaultValue, false, allowed...); err != nil && slices.Contains(allowed, "json") {
+		outputValue = "json"
+	}
 	prettyValue := false
 	fs.Var(&validatedOutputValue{
 		value:   &outputValue,
@@ -910,9 +1165,12 @@ func bindPrettyJSONFlagWithValue(fs *flag.FlagSet, value *bool) *bool {
 	return value
 }
 
-// BindOutputFlags registers --output and --pretty flags on the provided flagset.
+// BindOutputFlags registers --output, --pretty, and --columns flags on the provided flagset.
 func BindOutputFlags(fs *flag.FlagSet) OutputFlags {
-	return BindOutputFlagsWith(fs, "output", DefaultOutputFormat(), "Output format: json, table, markdown")
+	output := BindOutputFlagsWithAllowed(fs, "output", DefaultOutputFormat(), "Output format: json, table, markdown, csv", "json", "table", "markdown", "csv")
+	csvColumns = nil
+	fs.Var(&csvColumnsValue{output: output.Output}, "columns", "Comma-separated columns for CSV output (default: all, id and type first)")
+	return output
 }
 
 // BindMetadataOutputFlags registers --output-format and --pretty flags on the provided flagset.
@@ -995,10 +1253,20 @@ func resolveAppID(appID string) string {
 	if env, ok := os.LookupEnv("ASC_APP_ID"); ok {
 		return strings.TrimSpace(env)
 	}
+	if project := projectDefaultApp(); project != "" {
+		return project
+	}
 	cfg, err := config.Load()
 	if err != nil || cfg == nil {
 		return ""
 	}
+	profile := resolveProfileName()
+	if profile == "" {
+		profile = strings.TrimSpace(cfg.DefaultKeyName)
+	}
+	if profileAppID := cfg.ProfileAppID(profile); profileAppID != "" {
+		return profileAppID
+	}
 	return strings.TrimSpace(cfg.AppID)
 }
 
@@ -1090,6 +1358,10 @@ func validateSort(value string, allowed ...string) error {
 
 // Exported wrappers for shared helpers.
 func GetASCClient() (*asc.Client, error) {
+	if client := cachedASCClient(); client != nil {
+		return client, nil
+	}
+
 	// Auth resolution can block on macOS keychain prompts. Show a subtle spinner on stderr
 	// (interactive runs only) so the CLI doesn’t look “stuck”.
 	const authSpinnerDelay = 200 * time.Millisecond
@@ -1099,6 +1371,9 @@ func GetASCClient() (*asc.Client, error) {
 		client, innerErr = getASCClient()
 		return innerErr
 	})
+	if err == nil {
+		cacheASCClient(client)
+	}
 	return client, err
 }
 
@@ -148,6 +148,37 @@ func TestDefaultOutputFormat_MD(t *testing.T) {
 	}
 }
 
+func TestDefaultOutputFormat_CSV(t *testing.T) {
+	resetDefaultOutput(t)
+	t.Setenv("ASC_DEFAULT_OUTPUT", "csv")
+	if got := DefaultOutputFormat(); got != "csv" {
+		t.Fatalf("expected csv, got %q", got)
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	output := BindOutputFlags(fs)
+	if *output.Output != "csv" {
+		t.Fatalf("expected csv --output default, got %q", *output.Output)
+	}
+	if err := ValidateBoundOutputFlags(fs); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+}
+
+func TestDefaultOutputFormat_CSVFallsBackToJSONWithoutCSVSupport(t *testing.T) {
+	resetDefaultOutput(t)
+	t.Setenv("ASC_DEFAULT_OUTPUT", "csv")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	output := BindOutputFlagsWith(fs, "output", DefaultOutputFormat(), "Output format: json, table, markdown")
+	if *output.Output != "json" {
+		t.Fatalf("expected json --output default, got %q", *output.Output)
+	}
+	if err := ValidateBoundOutputFlags(fs); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+}
+
 func TestDefaultOutputFormat_JSON(t *testing.T) {
 	resetDefaultOutput(t)
 	setTerminalDetection(t, func(int) bool { return true })
@@ -1318,3 +1349,47 @@ func TestSetNoProgress(t *testing.T) {
 		t.Fatal("expected noProgress to be false after SetNoProgress(false)")
 	}
 }
+
+func TestResolveAppID_PrefersSelectedProfileAppID(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := &config.Config{
+		AppID:          "GLOBAL_APP",
+		DefaultKeyName: "personal",
+		Profiles: map[string]config.ProfileSettings{
+			"personal": {AppID: "PERSONAL_APP"},
+			"client":   {AppID: "CLIENT_APP"},
+		},
+	}
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	t.Setenv("ASC_APP_ID", "")
+	os.Unsetenv("ASC_APP_ID")
+	t.Setenv("ASC_PROFILE", "")
+
+	previousProfile := selectedProfile
+	selectedProfile = ""
+	t.Cleanup(func() {
+		selectedProfile = previousProfile
+	})
+
+	if got := resolveAppID(""); got != "PERSONAL_APP" {
+		t.Fatalf("expected default profile app ID, got %q", got)
+	}
+
+	selectedProfile = "client"
+	if got := resolveAppID(""); got != "CLIENT_APP" {
+		t.Fatalf("expected selected profile app ID, got %q", got)
+	}
+
+	selectedProfile = "other"
+	if got := resolveAppID(""); got != "GLOBAL_APP" {
+		t.Fatalf("expected global app ID fallback, got %q", got)
+	}
+
+	if got := resolveAppID("FLAG_APP"); got != "FLAG_APP" {
+		t.Fatalf("expected flag value to win, got %q", got)
+	}
+}
@@ -16,6 +16,9 @@ const spinnerDisabledEnvVar = "ASC_SPINNER_DISABLED"
 
 var spinnerFrames = []string{"⣾", "⣽", "⣻", "⢿", "⡿", "⣟", "⣯", "⣷"}
 
+// asciiSpinnerFrames are used with --ascii and on legacy Windows consoles.
+var asciiSpinnerFrames = []string{"|", "/", "-", "\\"}
+
 const spinnerTickRate = 120 * time.Millisecond
 
 // SpinnerEnabled reports whether the CLI should render an indeterminate spinner
@@ -179,7 +182,8 @@ func debugOrRetryLogsEnabled() bool {
 }
 
 type spinner struct {
-	w io.Writer
+	w      io.Writer
+	frames []string
 
 	stopOnce sync.Once
 	stopCh   chan struct{}
@@ -193,8 +197,13 @@ func newSpinner(w io.Writer) *spinner {
 	if w == nil {
 		w = io.Discard
 	}
+	frames := spinnerFrames
+	if ASCIIEnabled() || legacyConsole() {
+		frames = asciiSpinnerFrames
+	}
 	return &spinner{
 		w:      w,
+		frames: frames,
 		stopCh: make(chan struct{}),
 		doneCh: make(chan struct{}),
 	}
@@ -204,7 +213,7 @@ func (s *spinner) Start(label string) {
 	label = strings.TrimSpace(label)
 
 	// Render immediately (helps with short-running operations).
-	s.renderLine(spinnerLine(spinnerFrames[0], label))
+	s.renderLine(spinnerLine(s.frames[0], label))
 
 	go func() {
 		ticker := time.NewTicker(spinnerTickRate)
@@ -218,7 +227,7 @@ func (s *spinner) Start(label string) {
 				close(s.doneCh)
 				return
 			case <-ticker.C:
-				frame := spinnerFrames[i%len(spinnerFrames)]
+				frame := s.frames[i%len(s.frames)]
 				i++
 				s.renderLine(spinnerLine(frame, label))
 			}
@@ -241,7 +250,7 @@ func spinnerLine(frame, label string) string {
 }
 
 func (s *spinner) renderLine(line string) {
-	// Use rune count for display width (frames are single-column braille runes).
+	// Use rune count for display width (frames are single-column runes).
 	curLen := utf8.RuneCountInString(line)
 
 	s.mu.Lock()
@@ -259,6 +259,28 @@ func TestWithSpinner_EnabledWritesAndClearsLine_NoANSI(t *testing.T) {
 	}
 }
 
+func TestWithSpinner_ASCIIModeUsesASCIIFrames(t *testing.T) {
+	resetSpinnerTestState(t)
+	t.Setenv(spinnerDisabledEnvVar, "0")
+	SetASCII(true)
+	t.Cleanup(func() { SetASCII(false) })
+
+	_, stderr := captureOutput(t, func() {
+		withTTYStub(t, true, true)
+
+		if err := WithSpinner("Working", func() error { return nil }); err != nil {
+			t.Fatalf("WithSpinner() error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "\r"+asciiSpinnerFrames[0]+" Working") {
+		t.Fatalf("expected ASCII spinner frame, got %q", stderr)
+	}
+	if strings.Contains(stderr, spinnerFrames[0]) {
+		t.Fatalf("expected no braille frames in ASCII mode, got %q", stderr)
+	}
+}
+
 func TestWithSpinner_ReturnsError(t *testing.T) {
 	resetSpinnerTestState(t)
 	t.Setenv(spinnerDisabledEnvVar, "0")
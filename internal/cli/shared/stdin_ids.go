@@ -0,0 +1,139 @@
+package shared
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// StdinIDValue is the --id value that reads IDs from stdin, one per line.
+const StdinIDValue = "-"
+
+// stdinIDCommandNames are the leaf commands whose --id flag accepts "-".
+var stdinIDCommandNames = map[string]struct{}{
+	"delete": {},
+	"edit":   {},
+	"get":    {},
+	"update": {},
+	"view":   {},
+}
+
+// reusedASCClient holds the client shared across a stdin ID batch so auth is
+// resolved once rather than per ID.
+var reusedASCClient struct {
+	sync.Mutex
+	active bool
+	client *asc.Client
+}
+
+// WrapStdinIDs lets get/view/update/edit/delete commands accept `--id -`.
+// The command runs once per non-empty stdin line (lines starting with # are
+// skipped), stopping at the first error. Requests withheld by --dry-run do
+// not stop the batch.
+func WrapStdinIDs(cmd *ffcli.Command) {
+	if cmd == nil {
+		return
+	}
+	for _, sub := range cmd.Subcommands {
+		WrapStdinIDs(sub)
+	}
+
+	if cmd.Exec == nil || !acceptsStdinIDs(cmd) {
+		return
+	}
+	idFlag := cmd.FlagSet.Lookup("id")
+
+	originalExec := cmd.Exec
+	cmd.Exec = func(ctx context.Context, args []string) error {
+		if strings.TrimSpace(idFlag.Value.String()) != StdinIDValue {
+			return originalExec(ctx, args)
+		}
+
+		ids, err := readStdinIDs(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("--id -: failed to read stdin: %w", err)
+		}
+		if len(ids) == 0 {
+			return UsageError("--id - requires at least one ID on stdin")
+		}
+
+		return withReusedASCClient(func() error {
+			for _, id := range ids {
+				if err := idFlag.Value.Set(id); err != nil {
+					return UsageErrorf("invalid --id %q: %v", id, err)
+				}
+				if err := originalExec(ctx, args); err != nil && !errors.Is(err, asc.ErrDryRun) {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+}
+
+func acceptsStdinIDs(cmd *ffcli.Command) bool {
+	if cmd == nil || cmd.FlagSet == nil || len(cmd.Subcommands) > 0 {
+		return false
+	}
+	if _, ok := stdinIDCommandNames[cmd.Name]; !ok {
+		return false
+	}
+	return cmd.FlagSet.Lookup("id") != nil
+}
+
+func readStdinIDs(r io.Reader) ([]string, error) {
+	var ids []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func withReusedASCClient(fn func() error) error {
+	reusedASCClient.Lock()
+	reusedASCClient.active = true
+	reusedASCClient.Unlock()
+
+	defer func() {
+		reusedASCClient.Lock()
+		reusedASCClient.active = false
+		reusedASCClient.client = nil
+		reusedASCClient.Unlock()
+	}()
+
+	return fn()
+}
+
+func cachedASCClient() *asc.Client {
+	reusedASCClient.Lock()
+	defer reusedASCClient.Unlock()
+	if !reusedASCClient.active {
+		return nil
+	}
+	return reusedASCClient.client
+}
+
+func cacheASCClient(client *asc.Client) {
+	reusedASCClient.Lock()
+	defer reusedASCClient.Unlock()
+	if reusedASCClient.active {
+		reusedASCClient.client = client
+	}
+}
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestReadStdinIDsSkipsBlankAndCommentLines(t *testing.T) {
+	ids, err := readStdinIDs(strings.NewReader("a\n\n  b \n# c\r\nd\r\n"))
+	if err != nil {
+		t.Fatalf("readStdinIDs() error: %v", err)
+	}
+	if want := []string{"a", "b", "d"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("readStdinIDs() = %v, want %v", ids, want)
+	}
+}
+
+func TestWithReusedASCClientCachesOnlyDuringBatch(t *testing.T) {
+	client := &asc.Client{}
+
+	cacheASCClient(client)
+	if cachedASCClient() != nil {
+		t.Fatal("expected no caching outside a batch")
+	}
+
+	err := withReusedASCClient(func() error {
+		if cachedASCClient() != nil {
+			t.Fatal("expected empty cache at batch start")
+		}
+		cacheASCClient(client)
+		if cachedASCClient() != client {
+			t.Fatal("expected client to be reused within the batch")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("withReusedASCClient() error: %v", err)
+	}
+	if cachedASCClient() != nil {
+		t.Fatal("expected cache to be cleared after the batch")
+	}
+}
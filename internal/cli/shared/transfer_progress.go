@@ -0,0 +1,353 @@
+package shared
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const progressEnvVar = "ASC_PROGRESS"
+
+// Progress display modes for uploads and downloads.
+const (
+	ProgressModeAuto  = "auto"
+	ProgressModeBar   = "bar"
+	ProgressModePlain = "plain"
+	ProgressModeNone  = "none"
+)
+
+const (
+	transferBarWidth = 24
+
+	// transferPlainStep is the percentage between lines in plain mode.
+	transferPlainStep = 25
+
+	// transferRedrawInterval limits how often the bar is redrawn.
+	transferRedrawInterval = 100 * time.Millisecond
+)
+
+var progressMode progressModeFlag
+
+// progressModeFlag backs --progress.
+type progressModeFlag struct {
+	value string
+}
+
+func (p *progressModeFlag) Set(value string) error {
+	normalized, err := parseProgressMode(value)
+	if err != nil {
+		return err
+	}
+	p.value = normalized
+	return nil
+}
+
+func (p *progressModeFlag) String() string {
+	if p == nil {
+		return ""
+	}
+	return p.value
+}
+
+func parseProgressMode(value string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	switch normalized {
+	case ProgressModeAuto, ProgressModeBar, ProgressModePlain, ProgressModeNone:
+		return normalized, nil
+	}
+	return "", fmt.Errorf("must be one of: auto, bar, plain, none")
+}
+
+// BindProgressFlags registers --progress for upload and download reporting.
+func BindProgressFlags(fs *flag.FlagSet) {
+	progressMode = progressModeFlag{}
+	fs.Var(&progressMode, "progress", "Transfer progress for uploads and downloads: auto, bar, plain, none (or ASC_PROGRESS; auto draws a bar on a terminal)")
+}
+
+// SetProgressMode sets the --progress value (tests only).
+func SetProgressMode(value string) {
+	progressMode.value = value
+}
+
+// ResolvedProgressMode returns how transfers report progress: bar, plain, or
+// none. --quiet always wins; auto draws a bar only on an interactive stderr
+// without debug or retry logs competing for it.
+func ResolvedProgressMode() string {
+	if QuietEnabled() {
+		return ProgressModeNone
+	}
+	mode := progressMode.value
+	if mode == "" {
+		if parsed, err := parseProgressMode(os.Getenv(progressEnvVar)); err == nil {
+			mode = parsed
+		}
+	}
+	switch mode {
+	case ProgressModeBar, ProgressModePlain, ProgressModeNone:
+		return mode
+	}
+	if ProgressEnabled() && !debugOrRetryLogsEnabled() {
+		return ProgressModeBar
+	}
+	return ProgressModeNone
+}
+
+// TransferProgress reports bytes, speed, and ETA for one upload or download
+// on stderr. A nil *TransferProgress is valid and reports nothing.
+type TransferProgress struct {
+	mu        sync.Mutex
+	w         io.Writer
+	mode      string
+	ascii     bool
+	label     string
+	total     int64
+	done      int64
+	start     time.Time
+	lastDraw  time.Time
+	lastStep  int
+	finished  bool
+	now       func() time.Time
+	lineDrawn bool
+}
+
+// NewTransferProgress starts reporting a transfer of total bytes (0 or less
+// when unknown). It returns nil when progress is disabled.
+func NewTransferProgress(label string, total int64) *TransferProgress {
+	mode := ResolvedProgressMode()
+	if mode == ProgressModeNone {
+		return nil
+	}
+	return newTransferProgress(os.Stderr, mode, ASCIIEnabled(), label, total, time.Now)
+}
+
+func newTransferProgress(w io.Writer, mode string, ascii bool, label string, total int64, now func() time.Time) *TransferProgress {
+	return &TransferProgress{
+		w:     w,
+		mode:  mode,
+		ascii: ascii,
+		label: label,
+		total: total,
+		start: now(),
+		now:   now,
+	}
+}
+
+// Set records the number of bytes transferred so far.
+func (p *TransferProgress) Set(done int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished {
+		return
+	}
+	p.done = done
+	p.render(false)
+}
+
+// Add records n more transferred bytes.
+func (p *TransferProgress) Add(n int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished {
+		return
+	}
+	p.done += n
+	p.render(false)
+}
+
+// Finish draws the final state and ends the progress line.
+func (p *TransferProgress) Finish() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished {
+		return
+	}
+	p.finished = true
+	p.render(true)
+}
+
+// Abort ends an open progress line without reporting completion, so a
+// following error message starts on its own line.
+func (p *TransferProgress) Abort() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished {
+		return
+	}
+	p.finished = true
+	if p.lineDrawn {
+		fmt.Fprintln(p.w)
+	}
+}
+
+// Reader wraps r so bytes read from it count as transferred.
+func (p *TransferProgress) Reader(r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &transferProgressReader{reader: r, progress: p}
+}
+
+type transferProgressReader struct {
+	reader   io.Reader
+	progress *TransferProgress
+}
+
+func (r *transferProgressReader) Read(b []byte) (int, error) {
+	n, err := r.reader.Read(b)
+	if n > 0 {
+		r.progress.Add(int64(n))
+	}
+	return n, err
+}
+
+func (p *TransferProgress) render(final bool) {
+	now := p.now()
+	elapsed := now.Sub(p.start)
+	switch p.mode {
+	case ProgressModeBar:
+		if !final && p.lineDrawn && now.Sub(p.lastDraw) < transferRedrawInterval {
+			return
+		}
+		p.lastDraw = now
+		p.lineDrawn = true
+		fmt.Fprintf(p.w, "\r%s %s", p.label, p.summary(elapsed, true))
+		if final {
+			fmt.Fprintln(p.w)
+		}
+	case ProgressModePlain:
+		if final {
+			fmt.Fprintf(p.w, "%s: done, %s in %s\n", p.label, formatTransferBytes(p.done), formatTransferDuration(elapsed))
+			return
+		}
+		if p.total <= 0 {
+			return
+		}
+		step := int(p.done*100/p.total) / transferPlainStep * transferPlainStep
+		if step <= p.lastStep || step >= 100 {
+			return
+		}
+		p.lastStep = step
+		fmt.Fprintf(p.w, "%s: %s\n", p.label, p.summary(elapsed, false))
+	}
+}
+
+// summary formats "42% 1.2 MB/2.9 MB 1.1 MB/s ETA 2s", with a bar in front
+// when withBar is set. Unknown totals drop the percentage, bar, and ETA.
+func (p *TransferProgress) summary(elapsed time.Duration, withBar bool) string {
+	var parts []string
+	speed := 0.0
+	if seconds := elapsed.Seconds(); seconds > 0 {
+		speed = float64(p.done) / seconds
+	}
+	if p.total > 0 {
+		fraction := min(float64(p.done)/float64(p.total), 1)
+		if withBar {
+			fill, empty := "█", "░"
+			if p.ascii {
+				fill, empty = "#", "-"
+			}
+			filled := int(fraction * transferBarWidth)
+			parts = append(parts, strings.Repeat(fill, filled)+strings.Repeat(empty, transferBarWidth-filled))
+		}
+		parts = append(parts,
+			fmt.Sprintf("%3d%%", int(fraction*100)),
+			formatTransferBytes(p.done)+"/"+formatTransferBytes(p.total),
+		)
+	} else {
+		parts = append(parts, formatTransferBytes(p.done))
+	}
+	if speed > 0 {
+		parts = append(parts, formatTransferBytes(int64(speed))+"/s")
+		if p.total > p.done {
+			eta := time.Duration(float64(p.total-p.done) / speed * float64(time.Second))
+			parts = append(parts, "ETA "+formatTransferDuration(eta))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// uploadProgressHandler adapts asc upload progress to one TransferProgress
+// per file, or returns nil when progress is disabled for this run.
+func uploadProgressHandler() asc.UploadProgressFunc {
+	if ResolvedProgressMode() == ProgressModeNone {
+		return nil
+	}
+	var mu sync.Mutex
+	active := map[string]*TransferProgress{}
+	return func(update asc.UploadProgress) {
+		mu.Lock()
+		progress, ok := active[update.Name]
+		if !ok {
+			progress = NewTransferProgress("Uploading "+update.Name, update.TotalBytes)
+			active[update.Name] = progress
+		}
+		done := update.CompletedParts >= update.TotalParts
+		if done {
+			delete(active, update.Name)
+		}
+		mu.Unlock()
+
+		progress.Set(update.UploadedBytes)
+		if done {
+			progress.Finish()
+		}
+	}
+}
+
+// WriteReportDownload streams a report download to path, reporting progress
+// against its Content-Length.
+func WriteReportDownload(path string, download *asc.ReportDownload) (int64, error) {
+	progress := NewTransferProgress("Downloading "+filepath.Base(path), download.ContentLength)
+	written, err := WriteStreamToFile(path, progress.Reader(download.Body))
+	if err != nil {
+		progress.Abort()
+		return 0, err
+	}
+	progress.Finish()
+	return written, nil
+}
+
+// formatTransferBytes formats a byte count with binary units, e.g. "1.5 MB".
+func formatTransferBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	value := float64(n)
+	suffix := ""
+	for _, s := range []string{"KB", "MB", "GB", "TB"} {
+		value /= unit
+		suffix = s
+		if value < unit {
+			break
+		}
+	}
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
+
+// formatTransferDuration rounds to whole seconds, e.g. "1m5s".
+func formatTransferDuration(d time.Duration) string {
+	if d < time.Second {
+		return "0s"
+	}
+	return d.Round(time.Second).String()
+}
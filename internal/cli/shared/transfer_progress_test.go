@@ -0,0 +1,186 @@
+package shared
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type fakeTransferClock struct {
+	now time.Time
+}
+
+func (c *fakeTransferClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeTransferClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestTransferProgressBarShowsSpeedAndETA(t *testing.T) {
+	clock := &fakeTransferClock{now: time.Unix(0, 0)}
+	var buf bytes.Buffer
+	progress := newTransferProgress(&buf, ProgressModeBar, true, "Uploading app.ipa", 4<<20, clock.Now)
+
+	clock.Advance(2 * time.Second)
+	progress.Set(2 << 20)
+	want := "\rUploading app.ipa ############------------  50% 2.0 MB/4.0 MB 1.0 MB/s ETA 2s"
+	if buf.String() != want {
+		t.Fatalf("bar = %q, want %q", buf.String(), want)
+	}
+
+	// Updates inside the redraw interval are coalesced.
+	clock.Advance(10 * time.Millisecond)
+	progress.Add(1)
+	if buf.String() != want {
+		t.Fatalf("expected no redraw within the interval, got %q", buf.String())
+	}
+
+	clock.Advance(2*time.Second - 10*time.Millisecond)
+	progress.Set(4 << 20)
+	progress.Finish()
+	if !strings.HasSuffix(buf.String(), "100% 4.0 MB/4.0 MB 1.0 MB/s\n") {
+		t.Fatalf("expected the final update to end the line, got %q", buf.String())
+	}
+}
+
+func TestTransferProgressPlainPrintsSteps(t *testing.T) {
+	clock := &fakeTransferClock{now: time.Unix(0, 0)}
+	var buf bytes.Buffer
+	progress := newTransferProgress(&buf, ProgressModePlain, false, "Downloading report.tsv.gz", 1000, clock.Now)
+
+	for i := 0; i < 10; i++ {
+		clock.Advance(time.Second)
+		progress.Add(100)
+	}
+	progress.Finish()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	want := []string{
+		"Downloading report.tsv.gz:  30% 300 B/1000 B 100 B/s ETA 7s",
+		"Downloading report.tsv.gz:  50% 500 B/1000 B 100 B/s ETA 5s",
+		"Downloading report.tsv.gz:  80% 800 B/1000 B 100 B/s ETA 2s",
+		"Downloading report.tsv.gz: done, 1000 B in 10s",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("plain output:\n%s\nwant:\n%s", buf.String(), strings.Join(want, "\n"))
+	}
+}
+
+func TestTransferProgressUnknownTotal(t *testing.T) {
+	clock := &fakeTransferClock{now: time.Unix(0, 0)}
+	var buf bytes.Buffer
+	progress := newTransferProgress(&buf, ProgressModeBar, false, "Downloading", 0, clock.Now)
+
+	clock.Advance(time.Second)
+	progress.Add(2048)
+	progress.Finish()
+	if buf.String() != "\rDownloading 2.0 KB 2.0 KB/s\rDownloading 2.0 KB 2.0 KB/s\n" {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestNilTransferProgressIsNoOp(t *testing.T) {
+	var progress *TransferProgress
+	progress.Set(1)
+	progress.Add(1)
+	progress.Finish()
+	progress.Abort()
+	reader := strings.NewReader("data")
+	if progress.Reader(reader) != io.Reader(reader) {
+		t.Fatal("expected nil progress to return the reader unchanged")
+	}
+}
+
+func TestResolvedProgressMode(t *testing.T) {
+	t.Cleanup(func() {
+		SetProgressMode("")
+		SetQuiet(false)
+	})
+
+	t.Setenv("ASC_PROGRESS", "")
+	SetProgressMode("")
+	if got := ResolvedProgressMode(); got != ProgressModeNone {
+		t.Fatalf("expected auto to resolve to none without a terminal, got %q", got)
+	}
+
+	t.Setenv("ASC_PROGRESS", "plain")
+	if got := ResolvedProgressMode(); got != ProgressModePlain {
+		t.Fatalf("expected ASC_PROGRESS=plain, got %q", got)
+	}
+
+	SetProgressMode(ProgressModeBar)
+	if got := ResolvedProgressMode(); got != ProgressModeBar {
+		t.Fatalf("expected --progress to override env, got %q", got)
+	}
+
+	SetQuiet(true)
+	if got := ResolvedProgressMode(); got != ProgressModeNone {
+		t.Fatalf("expected --quiet to disable progress, got %q", got)
+	}
+}
+
+func TestBindProgressFlagsRejectsUnknownMode(t *testing.T) {
+	t.Cleanup(func() { SetProgressMode("") })
+
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindProgressFlags(fs)
+	if err := fs.Parse([]string{"--progress", "fancy"}); err == nil {
+		t.Fatal("expected parse error for --progress fancy")
+	}
+	if err := fs.Parse([]string{"--progress", "PLAIN"}); err != nil {
+		t.Fatalf("parse --progress PLAIN: %v", err)
+	}
+	if progressMode.value != ProgressModePlain {
+		t.Fatalf("expected plain, got %q", progressMode.value)
+	}
+}
+
+func TestWriteReportDownloadReportsProgress(t *testing.T) {
+	t.Cleanup(func() { SetProgressMode("") })
+	SetProgressMode(ProgressModePlain)
+
+	path := filepath.Join(t.TempDir(), "report.tsv.gz")
+	download := &asc.ReportDownload{Body: io.NopCloser(strings.NewReader("report")), ContentLength: 6}
+
+	stderr := captureStderr(t, func() {
+		written, err := WriteReportDownload(path, download)
+		if err != nil {
+			t.Fatalf("WriteReportDownload() error: %v", err)
+		}
+		if written != 6 {
+			t.Fatalf("expected 6 bytes written, got %d", written)
+		}
+	})
+
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "report" {
+		t.Fatalf("unexpected file contents %q (%v)", data, err)
+	}
+	if !strings.Contains(stderr, "Downloading report.tsv.gz: done, 6 B in") {
+		t.Fatalf("expected completion line, got %q", stderr)
+	}
+}
+
+func TestFormatTransferBytes(t *testing.T) {
+	tests := map[int64]string{
+		512:     "512 B",
+		1536:    "1.5 KB",
+		5 << 20: "5.0 MB",
+		3 << 30: "3.0 GB",
+	}
+	for input, want := range tests {
+		if got := formatTransferBytes(input); got != want {
+			t.Fatalf("formatTransferBytes(%d) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const updatePatchFlagName = "patch"
+
+// WrapUpdatePatch adds a --patch flag to update commands. The flag takes an
+// RFC 7386 JSON merge patch that is applied to the "data" object of the PATCH
+// request for the updated resource, so any attribute or relationship can be
+// set without a dedicated flag:
+//
+//	asc subscriptions update --id "SUB_ID" --patch '{"attributes":{"reviewNote":"New"}}'
+//
+// Values from the patch override values built from the command's own flags.
+// Relationship linkage PATCHes the command sends are left unchanged. The
+// command fails if it sent no PATCH the patch could apply to.
+func WrapUpdatePatch(cmd *ffcli.Command) {
+	if cmd == nil {
+		return
+	}
+	for _, sub := range cmd.Subcommands {
+		WrapUpdatePatch(sub)
+	}
+
+	if cmd.Exec == nil || !acceptsUpdatePatch(cmd) {
+		return
+	}
+	patch := cmd.FlagSet.String(updatePatchFlagName, "", `JSON merge patch (RFC 7386) applied to the request "data" object, e.g. '{"attributes":{...}}'`)
+
+	originalExec := cmd.Exec
+	cmd.Exec = func(ctx context.Context, args []string) error {
+		value := strings.TrimSpace(*patch)
+		if value == "" {
+			return originalExec(ctx, args)
+		}
+
+		var document map[string]any
+		if err := json.Unmarshal([]byte(value), &document); err != nil || document == nil {
+			return UsageError("--patch must be a JSON object")
+		}
+
+		ctx = asc.WithRequestPatch(ctx, document)
+		if err := originalExec(ctx, args); err != nil {
+			return err
+		}
+		if asc.RequestPatchApplied(ctx) == 0 {
+			return fmt.Errorf("%s: --patch was not applied; the command sent no PATCH request for a resource", cmd.Name)
+		}
+		return nil
+	}
+}
+
+// UpdatePatchRequested reports whether the running update command was given
+// --patch. Update commands use it to accept a patch as the only change.
+func UpdatePatchRequested(ctx context.Context) bool {
+	return asc.HasRequestPatch(ctx)
+}
+
+func acceptsUpdatePatch(cmd *ffcli.Command) bool {
+	if cmd == nil || cmd.FlagSet == nil || len(cmd.Subcommands) > 0 {
+		return false
+	}
+	if cmd.Name != "update" {
+		return false
+	}
+	return cmd.FlagSet.Lookup(updatePatchFlagName) == nil
+}
@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func TestWrapUpdatePatchFailsWhenPatchIsNotApplied(t *testing.T) {
+	ran := false
+	cmd := &ffcli.Command{
+		Name:    "update",
+		FlagSet: flag.NewFlagSet("update", flag.ContinueOnError),
+		Exec: func(ctx context.Context, args []string) error {
+			ran = true
+			return nil
+		},
+	}
+	WrapUpdatePatch(cmd)
+
+	if err := cmd.FlagSet.Parse([]string{"--patch", `{"attributes":{"name":"New"}}`}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	err := cmd.Exec(context.Background(), nil)
+	if !ran {
+		t.Fatal("expected the wrapped command to run")
+	}
+	if err == nil || !strings.Contains(err.Error(), "--patch was not applied") {
+		t.Fatalf("expected unapplied --patch error, got %v", err)
+	}
+}
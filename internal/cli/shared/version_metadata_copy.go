@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
+// PreviousVersionMetadataSource selects the most recently created other
+// version on the same platform as the metadata copy source.
+const PreviousVersionMetadataSource = "previous"
+
 // VersionMetadataCopyOptions defines a metadata carry-forward operation between App Store versions.
 type VersionMetadataCopyOptions struct {
 	AppID                string
@@ -107,7 +112,7 @@ func CopyVersionMetadataFromSource(
 	}
 
 	summary := &asc.AppStoreVersionMetadataCopySummary{
-		SourceVersion:   strings.TrimSpace(opts.SourceVersion),
+		SourceVersion:   strings.TrimSpace(sourceVersion.Attributes.VersionString),
 		SourceVersionID: sourceVersion.ID,
 		SelectedFields:  append([]string(nil), opts.SelectedFields...),
 	}
@@ -151,6 +156,9 @@ func findSourceAppStoreVersion(
 ) (*asc.Resource[asc.AppStoreVersionAttributes], error) {
 	versionValue := strings.TrimSpace(sourceVersionString)
 	platformValue := strings.TrimSpace(platform)
+	if strings.EqualFold(versionValue, PreviousVersionMetadataSource) {
+		return findPreviousAppStoreVersion(ctx, client, appID, platformValue, excludeVersionID)
+	}
 
 	resp, err := client.GetAppStoreVersions(
 		ctx,
@@ -187,6 +195,51 @@ func findSourceAppStoreVersion(
 	return &matches[0], nil
 }
 
+// findPreviousAppStoreVersion returns the most recently created version of
+// the app on platform other than excludeVersionID.
+func findPreviousAppStoreVersion(
+	ctx context.Context,
+	client *asc.Client,
+	appID string,
+	platform string,
+	excludeVersionID string,
+) (*asc.Resource[asc.AppStoreVersionAttributes], error) {
+	resp, err := client.GetAppStoreVersions(
+		ctx,
+		appID,
+		asc.WithAppStoreVersionsPlatforms([]string{platform}),
+		asc.WithAppStoreVersionsLimit(200),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup previous version: %w", err)
+	}
+
+	var previous *asc.Resource[asc.AppStoreVersionAttributes]
+	var previousCreated time.Time
+	for i := range resp.Data {
+		version := &resp.Data[i]
+		if strings.TrimSpace(version.ID) == strings.TrimSpace(excludeVersionID) {
+			continue
+		}
+		if strings.TrimSpace(string(version.Attributes.Platform)) != platform {
+			continue
+		}
+		created, err := time.Parse(time.RFC3339, strings.TrimSpace(version.Attributes.CreatedDate))
+		if err != nil {
+			continue
+		}
+		if previous == nil || created.After(previousCreated) {
+			previous = version
+			previousCreated = created
+		}
+	}
+
+	if previous == nil {
+		return nil, fmt.Errorf("no previous version found for platform %s", platform)
+	}
+	return previous, nil
+}
+
 func listAllAppStoreVersionLocalizations(
 	ctx context.Context,
 	client *asc.Client,
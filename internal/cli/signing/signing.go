@@ -20,12 +20,14 @@ func SigningCommand() *ffcli.Command {
 
 Examples:
   asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing
+  asc signing export --bundle-id com.example.app --output ./signing.zip
   asc signing sync push --bundle-id com.example.app --profile-type IOS_APP_STORE --repo git@github.com:team/certs.git
   asc signing sync pull --repo git@github.com:team/certs.git --output-dir ./signing`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SigningFetchCommand(),
+			SigningExportCommand(),
 			SigningSyncCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
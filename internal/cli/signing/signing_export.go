@@ -0,0 +1,350 @@
+package signing
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const signingExportManifestName = "manifest.json"
+
+// signingExportManifest is written to the archive root and printed as the
+// command result. File paths are relative to the archive root.
+type signingExportManifest struct {
+	BundleID         string                     `json:"bundleId"`
+	BundleIDResource string                     `json:"bundleIdResourceId"`
+	ExportedAt       string                     `json:"exportedAt"`
+	Profiles         []signingExportProfile     `json:"profiles"`
+	Certificates     []signingExportCertificate `json:"certificates"`
+}
+
+type signingExportProfile struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	ProfileType    string   `json:"profileType"`
+	UUID           string   `json:"uuid,omitempty"`
+	ExpirationDate string   `json:"expirationDate,omitempty"`
+	CertificateIDs []string `json:"certificateIds"`
+	File           string   `json:"file"`
+}
+
+type signingExportCertificate struct {
+	ID              string `json:"id"`
+	Name            string `json:"name,omitempty"`
+	CertificateType string `json:"certificateType"`
+	SerialNumber    string `json:"serialNumber,omitempty"`
+	ExpirationDate  string `json:"expirationDate,omitempty"`
+	File            string `json:"file"`
+}
+
+type signingExportResult struct {
+	OutputPath string `json:"outputPath"`
+	signingExportManifest
+}
+
+// signingExportFile is one archive entry.
+type signingExportFile struct {
+	name string
+	data []byte
+}
+
+// SigningExportCommand returns the signing export subcommand.
+func SigningExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	bundleID := fs.String("bundle-id", "", "Bundle identifier (e.g., com.example.app) - required")
+	profileTypes := fs.String("profile-type", "", "Only export these profile type(s), comma-separated (default: all)")
+	outputPath := fs.String("output", "", "Path of the .zip archive to write (required)")
+	overwrite := fs.Bool("overwrite", false, "Replace an existing archive")
+	output := shared.BindOutputFlagsWith(fs, "format", "json", "Output format for metadata: json (default), table, markdown")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc signing export --bundle-id BUNDLE_ID --output ./signing.zip [flags]",
+		ShortHelp:  "Export a bundle ID's active profiles and certificates to a zip archive.",
+		LongHelp: `Export a bundle ID's active profiles and certificates to a zip archive.
+
+The archive holds every active provisioning profile for the bundle ID and the
+public part (.cer) of each certificate those profiles are signed with. Private
+keys are never available from App Store Connect and are not included. This is
+meant for ephemeral CI runners that need fresh profiles on every run.
+
+Archive layout:
+  manifest.json
+  profiles/<profile name>.mobileprovision
+  certificates/<serial number>.cer
+
+The manifest lists each file with its App Store Connect ID, type, UUID, and
+expiration date, and is also printed as the command result.
+
+Examples:
+  asc signing export --bundle-id com.example.app --output ./signing.zip
+  asc signing export --bundle-id com.example.app --profile-type IOS_APP_STORE,IOS_APP_ADHOC --output ./signing.zip
+  asc signing export --bundle-id com.example.app --output ./signing.zip --overwrite`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			bundle := strings.TrimSpace(*bundleID)
+			if bundle == "" {
+				return shared.UsageError("--bundle-id is required")
+			}
+			archivePath := strings.TrimSpace(*outputPath)
+			if archivePath == "" {
+				return shared.UsageError("--output is required")
+			}
+			if !strings.EqualFold(filepath.Ext(archivePath), ".zip") {
+				return shared.UsageError("--output must end in .zip")
+			}
+			if !*overwrite {
+				if _, err := os.Lstat(archivePath); err == nil {
+					return shared.UsageErrorf("--output %q already exists (use --overwrite to replace it)", archivePath)
+				} else if !errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("signing export: %w", err)
+				}
+			}
+			typeFilter := make([]string, 0)
+			for _, value := range shared.SplitCSV(*profileTypes) {
+				typeFilter = append(typeFilter, strings.ToUpper(value))
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("signing export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			manifest, files, err := collectSigningExport(requestCtx, client, bundle, typeFilter, time.Now().UTC())
+			if err != nil {
+				return fmt.Errorf("signing export: %w", err)
+			}
+			if err := writeSigningExportArchive(archivePath, manifest, files, *overwrite); err != nil {
+				return fmt.Errorf("signing export: %w", err)
+			}
+
+			result := &signingExportResult{OutputPath: archivePath, signingExportManifest: *manifest}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderSigningExportResult(result, false) },
+				func() error { return renderSigningExportResult(result, true) },
+			)
+		},
+	}
+}
+
+// collectSigningExport downloads the active profiles for a bundle ID and the
+// certificates they use, returning the manifest and the archive entries.
+func collectSigningExport(ctx context.Context, client *asc.Client, bundle string, profileTypes []string, exportedAt time.Time) (*signingExportManifest, []signingExportFile, error) {
+	bundleIDResp, err := findBundleID(ctx, client, bundle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	firstPage, err := client.GetBundleIDProfiles(ctx, bundleIDResp.Data.ID, asc.WithBundleIDProfilesLimit(200))
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch profiles: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDProfiles(ctx, bundleIDResp.Data.ID, asc.WithBundleIDProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch profiles: %w", err)
+	}
+	profilesResp, ok := all.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected profiles response type %T", all)
+	}
+
+	manifest := &signingExportManifest{
+		BundleID:         bundle,
+		BundleIDResource: bundleIDResp.Data.ID,
+		ExportedAt:       exportedAt.Format(time.RFC3339),
+		Profiles:         make([]signingExportProfile, 0),
+		Certificates:     make([]signingExportCertificate, 0),
+	}
+	files := make([]signingExportFile, 0)
+	usedNames := make(map[string]struct{})
+	exportedCerts := make(map[string]struct{})
+
+	for _, profile := range profilesResp.Data {
+		if profile.Attributes.ProfileState != asc.ProfileStateActive {
+			continue
+		}
+		if len(profileTypes) > 0 && !slices.Contains(profileTypes, strings.ToUpper(profile.Attributes.ProfileType)) {
+			continue
+		}
+
+		content, err := decodeBase64Content("profile", profile.Attributes.ProfileContent)
+		if err != nil {
+			return nil, nil, fmt.Errorf("profile %s: %w", profile.ID, err)
+		}
+		name := uniqueSigningExportName(usedNames, "profiles", safeFileName(profile.Attributes.Name, profile.ID), ".mobileprovision")
+		files = append(files, signingExportFile{name: name, data: content})
+
+		certs, err := fetchProfileCertificates(ctx, client, profile.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("profile %s: fetch certificates: %w", profile.ID, err)
+		}
+		entry := signingExportProfile{
+			ID:             profile.ID,
+			Name:           profile.Attributes.Name,
+			ProfileType:    profile.Attributes.ProfileType,
+			UUID:           profile.Attributes.UUID,
+			ExpirationDate: profile.Attributes.ExpirationDate,
+			CertificateIDs: extractIDs(certs),
+			File:           name,
+		}
+		manifest.Profiles = append(manifest.Profiles, entry)
+
+		for _, cert := range certs {
+			if _, ok := exportedCerts[cert.ID]; ok {
+				continue
+			}
+			exportedCerts[cert.ID] = struct{}{}
+
+			certContent, err := decodeBase64Content("certificate", cert.Attributes.CertificateContent)
+			if err != nil {
+				return nil, nil, fmt.Errorf("certificate %s: %w", cert.ID, err)
+			}
+			certName := uniqueSigningExportName(usedNames, "certificates", safeFileName(cert.Attributes.SerialNumber, cert.ID), ".cer")
+			files = append(files, signingExportFile{name: certName, data: certContent})
+			manifest.Certificates = append(manifest.Certificates, signingExportCertificate{
+				ID:              cert.ID,
+				Name:            cert.Attributes.Name,
+				CertificateType: cert.Attributes.CertificateType,
+				SerialNumber:    cert.Attributes.SerialNumber,
+				ExpirationDate:  cert.Attributes.ExpirationDate,
+				File:            certName,
+			})
+		}
+	}
+
+	if len(manifest.Profiles) == 0 {
+		return nil, nil, fmt.Errorf("no active profiles found for bundle ID %s", bundle)
+	}
+	return manifest, files, nil
+}
+
+func fetchProfileCertificates(ctx context.Context, client *asc.Client, profileID string) ([]asc.Resource[asc.CertificateAttributes], error) {
+	firstPage, err := client.GetProfileCertificates(ctx, profileID, asc.WithProfileCertificatesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfileCertificates(ctx, profileID, asc.WithProfileCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	certs, ok := all.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", all)
+	}
+	return certs.Data, nil
+}
+
+// uniqueSigningExportName returns dir/base+ext, suffixing the base when two
+// assets share a name.
+func uniqueSigningExportName(used map[string]struct{}, dir, base, ext string) string {
+	name := path.Join(dir, base+ext)
+	for i := 2; ; i++ {
+		if _, ok := used[name]; !ok {
+			used[name] = struct{}{}
+			return name
+		}
+		name = path.Join(dir, fmt.Sprintf("%s-%d%s", base, i, ext))
+	}
+}
+
+func writeSigningExportArchive(archivePath string, manifest *signingExportManifest, files []signingExportFile, overwrite bool) error {
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	modified, err := time.Parse(time.RFC3339, manifest.ExportedAt)
+	if err != nil {
+		return err
+	}
+	entries := append([]signingExportFile{{name: signingExportManifestName, data: append(manifestData, '\n')}}, files...)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		w, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     entry.name,
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(entry.data); err != nil {
+			return err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	_, err = shared.SafeWriteFileNoSymlink(
+		filepath.Clean(archivePath),
+		0o600,
+		overwrite,
+		".asc-signing-export-*",
+		".asc-signing-export-backup-*",
+		func(f *os.File) (int64, error) {
+			return buf.WriteTo(f)
+		},
+	)
+	return err
+}
+
+func renderSigningExportResult(result *signingExportResult, markdown bool) error {
+	if result == nil {
+		return fmt.Errorf("result is nil")
+	}
+
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Bundle ID", "Output", "Profiles", "Certificates", "Exported At"},
+		[][]string{{
+			result.BundleID,
+			result.OutputPath,
+			fmt.Sprintf("%d", len(result.Profiles)),
+			fmt.Sprintf("%d", len(result.Certificates)),
+			result.ExportedAt,
+		}},
+	)
+
+	rows := make([][]string, 0, len(result.Profiles)+len(result.Certificates))
+	for _, profile := range result.Profiles {
+		rows = append(rows, []string{"profile", profile.ID, profile.ProfileType, profile.ExpirationDate, profile.File})
+	}
+	for _, cert := range result.Certificates {
+		rows = append(rows, []string{"certificate", cert.ID, cert.CertificateType, cert.ExpirationDate, cert.File})
+	}
+	render([]string{"Kind", "ID", "Type", "Expires", "File"}, rows)
+	return nil
+}
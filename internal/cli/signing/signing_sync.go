@@ -126,7 +126,7 @@ func syncPushCommand() *ffcli.Command {
 			defer cancel()
 
 			// Fetch signing assets from ASC.
-			fmt.Fprintln(os.Stderr, "Fetching signing assets from App Store Connect...")
+			fmt.Fprintln(shared.WarningWriter(), "Fetching signing assets from App Store Connect...")
 
 			bundleIDResp, err := findBundleID(requestCtx, client, bundle)
 			if err != nil {
@@ -149,7 +149,7 @@ func syncPushCommand() *ffcli.Command {
 				return fmt.Errorf("signing sync push: %w", err)
 			}
 			if created {
-				fmt.Fprintln(os.Stderr, "Created new profile")
+				fmt.Fprintln(shared.WarningWriter(), "Created new profile")
 			}
 
 			// Clone git repo.
@@ -165,7 +165,7 @@ func syncPushCommand() *ffcli.Command {
 			}
 			defer func() { _ = store.Cleanup() }()
 
-			fmt.Fprintln(os.Stderr, "Cloning signing repo...")
+			fmt.Fprintln(shared.WarningWriter(), "Cloning signing repo...")
 			if err := store.Clone(ctx, true); err != nil {
 				return fmt.Errorf("signing sync push: %w", err)
 			}
@@ -184,7 +184,7 @@ func syncPushCommand() *ffcli.Command {
 					return fmt.Errorf("signing sync push: encrypt cert: %w", err)
 				}
 				files = append(files, relPath)
-				fmt.Fprintf(os.Stderr, "  Encrypted %s\n", relPath)
+				fmt.Fprintf(shared.WarningWriter(), "  Encrypted %s\n", relPath)
 			}
 
 			profileContent, err := base64.StdEncoding.DecodeString(strings.TrimSpace(profile.Data.Attributes.ProfileContent))
@@ -197,16 +197,16 @@ func syncPushCommand() *ffcli.Command {
 				return fmt.Errorf("signing sync push: encrypt profile: %w", err)
 			}
 			files = append(files, profileRelPath)
-			fmt.Fprintf(os.Stderr, "  Encrypted %s\n", profileRelPath)
+			fmt.Fprintf(shared.WarningWriter(), "  Encrypted %s\n", profileRelPath)
 
 			// Commit and push.
 			commitMsg := fmt.Sprintf("Update signing assets for %s (%s)", bundle, profType)
-			fmt.Fprintln(os.Stderr, "Pushing to git...")
+			fmt.Fprintln(shared.WarningWriter(), "Pushing to git...")
 			if err := store.CommitAndPush(ctx, commitMsg); err != nil {
 				return fmt.Errorf("signing sync push: %w", err)
 			}
 
-			fmt.Fprintln(os.Stderr, "Done")
+			fmt.Fprintln(shared.WarningWriter(), "Done")
 
 			result := SyncResult{
 				Operation:   "push",
@@ -267,7 +267,7 @@ func syncPullCommand() *ffcli.Command {
 			}
 			defer func() { _ = store.Cleanup() }()
 
-			fmt.Fprintln(os.Stderr, "Cloning signing repo...")
+			fmt.Fprintln(shared.WarningWriter(), "Cloning signing repo...")
 			if err := store.Clone(ctx, false); err != nil {
 				return fmt.Errorf("signing sync pull: %w", err)
 			}
@@ -279,7 +279,7 @@ func syncPullCommand() *ffcli.Command {
 			}
 
 			if len(encryptedFiles) == 0 {
-				fmt.Fprintln(os.Stderr, "No encrypted signing files found in repo")
+				fmt.Fprintln(shared.WarningWriter(), "No encrypted signing files found in repo")
 				result := SyncResult{
 					Operation: "pull",
 					RepoURL:   sanitizeRepoURLForOutput(repo),
@@ -312,10 +312,10 @@ func syncPullCommand() *ffcli.Command {
 				}
 
 				files = append(files, relPath)
-				fmt.Fprintf(os.Stderr, "  Decrypted %s\n", relPath)
+				fmt.Fprintf(shared.WarningWriter(), "  Decrypted %s\n", relPath)
 			}
 
-			fmt.Fprintf(os.Stderr, "Done — %d files written to %s\n", len(files), outDir)
+			fmt.Fprintf(shared.WarningWriter(), "Done — %d files written to %s\n", len(files), outDir)
 
 			result := SyncResult{
 				Operation: "pull",
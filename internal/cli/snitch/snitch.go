@@ -142,7 +142,7 @@ Examples:
 					if errors.Is(err, flag.ErrHelp) {
 						return err
 					}
-					fmt.Fprintf(os.Stderr, "Warning: %v; continuing without preflight label validation.\n", err)
+					fmt.Fprintf(shared.WarningWriter(), "Warning: %v; continuing without preflight label validation.\n", err)
 					entry.Labels = dedupeLabels(entry.Labels)
 				} else {
 					entry.Labels = validatedLabels
@@ -161,7 +161,7 @@ Examples:
 				var err error
 				duplicates, err = searchIssues(requestCtx, token, issueTitle(entry))
 				if err != nil {
-					fmt.Fprintf(os.Stderr, "Warning: duplicate search failed: %v\n", err)
+					fmt.Fprintf(shared.WarningWriter(), "Warning: duplicate search failed: %v\n", err)
 				}
 			} else {
 				fmt.Fprintln(os.Stderr, "Note: skipping duplicate search because GITHUB_TOKEN or GH_TOKEN is not set.")
@@ -184,7 +184,7 @@ Examples:
 			}
 			if labels := issueLabels(entry); len(labels) > 0 {
 				if err := addIssueLabels(requestCtx, token, issue.Number, labels); err != nil {
-					fmt.Fprintf(os.Stderr, "Warning: issue created, but labels could not be applied: %v\n", err)
+					fmt.Fprintf(shared.WarningWriter(), "Warning: issue created, but labels could not be applied: %v\n", err)
 				}
 			}
 
@@ -0,0 +1,321 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type commandUsageSummary struct {
+	Command       string  `json:"command"`
+	Runs          int     `json:"runs"`
+	Failures      int     `json:"failures"`
+	FailureRate   float64 `json:"failureRate"`
+	AvgDurationMS int64   `json:"avgDurationMs"`
+	P95DurationMS int64   `json:"p95DurationMs"`
+	LastRun       string  `json:"lastRun"`
+}
+
+type usageSummaryResult struct {
+	Enabled  bool                  `json:"enabled"`
+	LogPath  string                `json:"logPath"`
+	Since    string                `json:"since,omitempty"`
+	Runs     int                   `json:"runs"`
+	Failures int                   `json:"failures"`
+	Commands []commandUsageSummary `json:"commands"`
+}
+
+type usageClearResult struct {
+	LogPath string `json:"logPath"`
+	Cleared bool   `json:"cleared"`
+}
+
+// StatsCommand returns the stats command group.
+func StatsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "stats",
+		ShortUsage: "asc stats <subcommand> [flags]",
+		ShortHelp:  "Summarize local command usage (opt-in).",
+		LongHelp: `Summarize local command usage (opt-in).
+
+When ASC_USAGE_LOG=1 is set, every asc invocation appends its command path,
+duration, and exit code to a local log file. Flag values and arguments are
+never recorded, and the log is never sent anywhere.
+
+The log defaults to ~/.asc/usage.jsonl; set ASC_USAGE_LOG_PATH to use a
+different file (for example, one per CI runner).
+
+Examples:
+  export ASC_USAGE_LOG=1
+  asc stats summary
+  asc stats summary --since 2026-01-01 --sort failure-rate
+  asc stats clear --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			StatsSummaryCommand(),
+			StatsClearCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// StatsSummaryCommand returns the stats summary subcommand.
+func StatsSummaryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summary", flag.ExitOnError)
+
+	since := fs.String("since", "", "Only include runs on or after this date (YYYY-MM-DD or RFC3339)")
+	command := fs.String("command", "", "Only include commands starting with this path (e.g., \"builds\" or \"asc builds upload\")")
+	sortBy := fs.String("sort", "runs", "Sort by: runs, failures, failure-rate, duration")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "summary",
+		ShortUsage: "asc stats summary [flags]",
+		ShortHelp:  "Show run counts, failure rates, and durations per command.",
+		LongHelp: `Show run counts, failure rates, and durations per command.
+
+Examples:
+  asc stats summary
+  asc stats summary --since 2026-01-01
+  asc stats summary --command builds --sort duration
+  asc stats summary --sort failure-rate --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			var sinceTime time.Time
+			if value := strings.TrimSpace(*since); value != "" {
+				parsed, err := parseStatsSince(value)
+				if err != nil {
+					return shared.UsageError("--since must be YYYY-MM-DD or RFC3339")
+				}
+				sinceTime = parsed
+			}
+			sortValue := strings.ToLower(strings.TrimSpace(*sortBy))
+			if !slices.Contains([]string{"runs", "failures", "failure-rate", "duration"}, sortValue) {
+				return shared.UsageError("--sort must be one of: runs, failures, failure-rate, duration")
+			}
+			commandPrefix := normalizeCommandPrefix(*command)
+
+			path, err := UsageLogPath()
+			if err != nil {
+				return fmt.Errorf("stats summary: %w", err)
+			}
+			entries, err := readUsageEntries(path)
+			if err != nil {
+				return fmt.Errorf("stats summary: %w", err)
+			}
+
+			enabled := UsageLogEnabled()
+			if !enabled && len(entries) == 0 {
+				fmt.Fprintf(shared.WarningWriter(), "Warning: usage logging is off; set %s=1 to start recording\n", usageLogEnabledEnv)
+			}
+
+			result := summarizeUsage(entries, sinceTime, commandPrefix, sortValue)
+			result.Enabled = enabled
+			result.LogPath = path
+			if !sinceTime.IsZero() {
+				result.Since = sinceTime.Format(time.RFC3339)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderUsageSummary(result, false) },
+				func() error { return renderUsageSummary(result, true) },
+			)
+		},
+	}
+}
+
+// StatsClearCommand returns the stats clear subcommand.
+func StatsClearCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("clear", flag.ExitOnError)
+
+	confirm := fs.Bool("confirm", false, "Confirm deleting the local usage log")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "clear",
+		ShortUsage: "asc stats clear --confirm",
+		ShortHelp:  "Delete the local usage log.",
+		LongHelp: `Delete the local usage log.
+
+Examples:
+  asc stats clear --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			path, err := UsageLogPath()
+			if err != nil {
+				return fmt.Errorf("stats clear: %w", err)
+			}
+			result := &usageClearResult{LogPath: path}
+			if err := os.Remove(path); err != nil {
+				if !errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("stats clear: %w", err)
+				}
+			} else {
+				result.Cleared = true
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderUsageClear(result, false) },
+				func() error { return renderUsageClear(result, true) },
+			)
+		},
+	}
+}
+
+func parseStatsSince(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// normalizeCommandPrefix accepts "builds upload" or "asc builds upload".
+func normalizeCommandPrefix(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" || value == "asc" || strings.HasPrefix(value, "asc ") {
+		return value
+	}
+	return "asc " + value
+}
+
+func summarizeUsage(entries []usageEntry, since time.Time, commandPrefix, sortBy string) *usageSummaryResult {
+	type aggregate struct {
+		durations []int64
+		failures  int
+		lastRun   time.Time
+	}
+
+	byCommand := map[string]*aggregate{}
+	result := &usageSummaryResult{Commands: []commandUsageSummary{}}
+	for _, entry := range entries {
+		if !since.IsZero() && entry.Time.Before(since) {
+			continue
+		}
+		if commandPrefix != "" && entry.Command != commandPrefix && !strings.HasPrefix(entry.Command, commandPrefix+" ") {
+			continue
+		}
+		agg, ok := byCommand[entry.Command]
+		if !ok {
+			agg = &aggregate{}
+			byCommand[entry.Command] = agg
+		}
+		agg.durations = append(agg.durations, entry.DurationMS)
+		if entry.ExitCode != 0 {
+			agg.failures++
+			result.Failures++
+		}
+		if entry.Time.After(agg.lastRun) {
+			agg.lastRun = entry.Time
+		}
+		result.Runs++
+	}
+
+	for command, agg := range byCommand {
+		runs := len(agg.durations)
+		var total int64
+		for _, duration := range agg.durations {
+			total += duration
+		}
+		sorted := slices.Clone(agg.durations)
+		slices.Sort(sorted)
+		p95Index := int(math.Ceil(0.95*float64(runs))) - 1
+		result.Commands = append(result.Commands, commandUsageSummary{
+			Command:       command,
+			Runs:          runs,
+			Failures:      agg.failures,
+			FailureRate:   math.Round(float64(agg.failures)/float64(runs)*1000) / 1000,
+			AvgDurationMS: total / int64(runs),
+			P95DurationMS: sorted[max(p95Index, 0)],
+			LastRun:       agg.lastRun.UTC().Format(time.RFC3339),
+		})
+	}
+
+	slices.SortFunc(result.Commands, func(a, b commandUsageSummary) int {
+		var diff float64
+		switch sortBy {
+		case "failures":
+			diff = float64(b.Failures - a.Failures)
+		case "failure-rate":
+			diff = b.FailureRate - a.FailureRate
+		case "duration":
+			diff = float64(b.AvgDurationMS - a.AvgDurationMS)
+		}
+		if diff == 0 {
+			diff = float64(b.Runs - a.Runs)
+		}
+		switch {
+		case diff > 0:
+			return 1
+		case diff < 0:
+			return -1
+		default:
+			return strings.Compare(a.Command, b.Command)
+		}
+	})
+	return result
+}
+
+func renderUsageSummary(result *usageSummaryResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	rows := make([][]string, 0, len(result.Commands))
+	for _, item := range result.Commands {
+		rows = append(rows, []string{
+			item.Command,
+			strconv.Itoa(item.Runs),
+			strconv.Itoa(item.Failures),
+			strconv.FormatFloat(item.FailureRate*100, 'f', 1, 64) + "%",
+			formatDurationMS(item.AvgDurationMS),
+			formatDurationMS(item.P95DurationMS),
+			item.LastRun,
+		})
+	}
+	render([]string{"Command", "Runs", "Failures", "Failure Rate", "Avg", "P95", "Last Run"}, rows)
+	return nil
+}
+
+func renderUsageClear(result *usageClearResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+	render([]string{"Log Path", "Cleared"}, [][]string{{result.LogPath, strconv.FormatBool(result.Cleared)}})
+	return nil
+}
+
+func formatDurationMS(ms int64) string {
+	return (time.Duration(ms) * time.Millisecond).String()
+}
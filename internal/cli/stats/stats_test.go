@@ -0,0 +1,83 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecordUsageIsOptIn(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+	t.Setenv(usageLogPathEnv, path)
+	t.Setenv(usageLogEnabledEnv, "")
+
+	RecordUsage("asc builds list", time.Second, 0)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no usage log when disabled, got err=%v", err)
+	}
+
+	t.Setenv(usageLogEnabledEnv, "1")
+	RecordUsage("asc builds list", 1500*time.Millisecond, 0)
+	RecordUsage("asc stats summary", time.Second, 0)
+
+	entries, err := readUsageEntries(path)
+	if err != nil {
+		t.Fatalf("readUsageEntries() error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Command != "asc builds list" || entries[0].DurationMS != 1500 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat usage log: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("expected 0600 permissions, got %o", perm)
+	}
+}
+
+func TestSummarizeUsage(t *testing.T) {
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	entries := []usageEntry{
+		{Time: base, Command: "asc builds upload", DurationMS: 1000, ExitCode: 0},
+		{Time: base.Add(time.Hour), Command: "asc builds upload", DurationMS: 3000, ExitCode: 1},
+		{Time: base.Add(2 * time.Hour), Command: "asc builds list", DurationMS: 200, ExitCode: 0},
+		{Time: base.Add(3 * time.Hour), Command: "asc builds list", DurationMS: 400, ExitCode: 0},
+		{Time: base.Add(4 * time.Hour), Command: "asc builds list", DurationMS: 600, ExitCode: 0},
+		{Time: base.Add(-24 * time.Hour), Command: "asc apps list", DurationMS: 100, ExitCode: 0},
+	}
+
+	result := summarizeUsage(entries, base, "", "failure-rate")
+	if result.Runs != 5 || result.Failures != 1 {
+		t.Fatalf("unexpected totals: runs=%d failures=%d", result.Runs, result.Failures)
+	}
+	if len(result.Commands) != 2 || result.Commands[0].Command != "asc builds upload" {
+		t.Fatalf("expected builds upload first by failure rate, got %+v", result.Commands)
+	}
+	upload := result.Commands[0]
+	if upload.FailureRate != 0.5 || upload.AvgDurationMS != 2000 || upload.P95DurationMS != 3000 {
+		t.Fatalf("unexpected upload summary: %+v", upload)
+	}
+
+	filtered := summarizeUsage(entries, time.Time{}, normalizeCommandPrefix("apps"), "runs")
+	if len(filtered.Commands) != 1 || filtered.Commands[0].Command != "asc apps list" {
+		t.Fatalf("expected only apps commands, got %+v", filtered.Commands)
+	}
+}
+
+func TestNormalizeCommandPrefix(t *testing.T) {
+	tests := map[string]string{
+		"":                   "",
+		"builds":             "asc builds",
+		"asc builds  upload": "asc builds upload",
+		"asc":                "asc",
+		"ascii":              "asc ascii",
+	}
+	for input, want := range tests {
+		if got := normalizeCommandPrefix(input); got != want {
+			t.Fatalf("normalizeCommandPrefix(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
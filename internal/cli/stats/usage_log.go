@@ -0,0 +1,123 @@
+package stats
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	usageLogEnabledEnv = "ASC_USAGE_LOG"
+	usageLogPathEnv    = "ASC_USAGE_LOG_PATH"
+)
+
+// usageEntry is one line of the local usage log. Only the command path is
+// recorded; flag values and arguments are never written.
+type usageEntry struct {
+	Time       time.Time `json:"time"`
+	Command    string    `json:"command"`
+	DurationMS int64     `json:"durationMs"`
+	ExitCode   int       `json:"exitCode"`
+}
+
+// UsageLogEnabled reports whether local usage logging is turned on.
+// Logging is opt-in via ASC_USAGE_LOG=1.
+func UsageLogEnabled() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(usageLogEnabledEnv))) {
+	case "1", "true", "yes", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+// UsageLogPath returns the local usage log path. ASC_USAGE_LOG_PATH overrides
+// the default of ~/.asc/usage.jsonl.
+func UsageLogPath() (string, error) {
+	if custom := strings.TrimSpace(os.Getenv(usageLogPathEnv)); custom != "" {
+		return custom, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".asc", "usage.jsonl"), nil
+}
+
+// RecordUsage appends a command run to the local usage log when logging is
+// enabled. The log is never sent anywhere, and failures to write it are
+// ignored so they cannot affect the command that ran.
+func RecordUsage(command string, elapsed time.Duration, exitCode int) {
+	if !UsageLogEnabled() {
+		return
+	}
+	command = strings.TrimSpace(command)
+	if command == "" || command == "asc stats" || strings.HasPrefix(command, "asc stats ") {
+		return
+	}
+	path, err := UsageLogPath()
+	if err != nil {
+		return
+	}
+	_ = appendUsageEntry(path, usageEntry{
+		Time:       time.Now().UTC(),
+		Command:    command,
+		DurationMS: elapsed.Milliseconds(),
+		ExitCode:   exitCode,
+	})
+}
+
+func appendUsageEntry(path string, entry usageEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// readUsageEntries loads all entries from the usage log. A missing log is
+// treated as empty, and malformed lines are skipped.
+func readUsageEntries(path string) ([]usageEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []usageEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var entry usageEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.Command == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
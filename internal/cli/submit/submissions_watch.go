@@ -0,0 +1,506 @@
+package submit
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
+)
+
+const (
+	submissionsWatchDefaultTimeout  = 24 * time.Hour
+	submissionsWatchDefaultInterval = time.Minute
+
+	submissionsWatchOutcomeAccepted = "accepted"
+	submissionsWatchOutcomeReleased = "released"
+	submissionsWatchOutcomeRejected = "rejected"
+	submissionsWatchOutcomeCanceled = "canceled"
+)
+
+// submissionsWatchAcceptedStates are version states reached once App Review
+// has approved the version.
+var submissionsWatchAcceptedStates = map[string]bool{
+	"ACCEPTED":                    true,
+	"PENDING_DEVELOPER_RELEASE":   true,
+	"PENDING_APPLE_RELEASE":       true,
+	"PROCESSING_FOR_DISTRIBUTION": true,
+	"PREORDER_READY_FOR_SALE":     true,
+	"READY_FOR_SALE":              true,
+	"READY_FOR_DISTRIBUTION":      true,
+}
+
+// submissionsWatchReleasedStates are accepted version states in which the
+// version is available on the App Store (or for pre-order).
+var submissionsWatchReleasedStates = map[string]bool{
+	"PREORDER_READY_FOR_SALE": true,
+	"READY_FOR_SALE":          true,
+	"READY_FOR_DISTRIBUTION":  true,
+}
+
+// submissionsWatchActiveStates are review submission states worth watching
+// when no version or submission is given.
+var submissionsWatchActiveStates = []string{
+	string(asc.ReviewSubmissionStateWaitingForReview),
+	string(asc.ReviewSubmissionStateInReview),
+	string(asc.ReviewSubmissionStateUnresolvedIssues),
+}
+
+// fetchReviewMessagesFn is overridden in tests.
+var fetchReviewMessagesFn = fetchResolutionCenterMessages
+
+var errNoWebSession = errors.New("no cached web session; run `asc web auth login` first")
+
+// SubmissionsCommand returns the submissions command group.
+func SubmissionsCommand() *ffcli.Command {
+	return &ffcli.Command{
+		Name:       "submissions",
+		ShortUsage: "asc submissions <subcommand> [flags]",
+		ShortHelp:  "Follow App Store review submissions.",
+		LongHelp: `Follow App Store review submissions.
+
+Examples:
+  asc submissions watch --app "123456789"
+  asc submissions watch --app "123456789" --version "1.2.0" --until-released`,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			SubmissionsWatchCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// SubmissionsWatchCommand polls a review submission and its App Store version
+// and reports state transitions until App Review reaches a decision.
+func SubmissionsWatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("submissions watch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	submissionID := fs.String("id", "", "Review submission ID")
+	version := fs.String("version", "", "App Store version string")
+	versionID := fs.String("version-id", "", "App Store version ID")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS with --version, or ASC_DEFAULT_PLATFORM env)")
+	interval := fs.Duration("interval", submissionsWatchDefaultInterval, "Polling interval for status checks")
+	timeout := fs.Duration("timeout", submissionsWatchDefaultTimeout, "Maximum time to watch before giving up")
+	untilReleased := fs.Bool("until-released", false, "Keep watching an accepted version until it is ready for sale")
+	messages := fs.Bool("messages", false, "On rejection, fetch App Review messages from the Resolution Center (requires asc web auth login)")
+	notifyURL := shared.BindNotifyURLFlag(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc submissions watch --app APP_ID [flags]",
+		ShortHelp:  "Watch a review submission until App Review decides.",
+		LongHelp: `Watch a review submission until App Review decides.
+
+Polls the review submission and its App Store version, printing each state
+transition to stderr as it is observed, for example:
+
+  Version 1.2.0 (IOS): WAITING_FOR_REVIEW -> IN_REVIEW
+  Version 1.2.0 (IOS): IN_REVIEW -> PENDING_DEVELOPER_RELEASE
+
+Without --id, --version, or --version-id the app's most recent active review
+submission is watched. The final status is written to stdout, and the exit
+code reports the outcome:
+  - 0 -> accepted (PENDING_DEVELOPER_RELEASE, READY_FOR_SALE, ...)
+  - 6 -> rejected (REJECTED, METADATA_REJECTED, INVALID_BINARY, UNRESOLVED_ISSUES)
+  - 7 -> canceled by the developer (DEVELOPER_REJECTED)
+
+With --until-released an accepted version is watched until it reaches
+READY_FOR_SALE (or READY_FOR_DISTRIBUTION) instead of stopping at acceptance.
+
+With --messages a rejection also fetches App Review's messages from the
+Resolution Center. This uses the cached web session from "asc web auth login";
+without one a warning is printed and the messages are skipped.
+
+With --notify-url the outcome is posted to a Slack, Microsoft Teams, or generic
+JSON webhook.
+
+Examples:
+  asc submissions watch --app "123456789"
+  asc submissions watch --app "123456789" --version "1.2.0" --platform IOS
+  asc submissions watch --app "123456789" --version-id "VERSION_ID" --until-released
+  asc submissions watch --app "123456789" --id "SUBMISSION_ID" --messages
+  asc submissions watch --app "123456789" --interval 5m --timeout 72h --notify-url "$SLACK_WEBHOOK_URL"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+
+			submissionValue := strings.TrimSpace(*submissionID)
+			versionValue := strings.TrimSpace(*version)
+			versionIDValue := strings.TrimSpace(*versionID)
+			selectors := 0
+			for _, value := range []string{submissionValue, versionValue, versionIDValue} {
+				if value != "" {
+					selectors++
+				}
+			}
+			if selectors > 1 {
+				return shared.UsageError("--id, --version, and --version-id are mutually exclusive")
+			}
+			if strings.TrimSpace(*platform) != "" && (submissionValue != "" || versionIDValue != "") {
+				return shared.UsageError("--platform can only be used with --version or when watching the latest submission")
+			}
+			if *interval <= 0 {
+				return shared.UsageError("--interval must be greater than 0")
+			}
+			if *timeout <= 0 {
+				return shared.UsageError("--timeout must be greater than 0")
+			}
+
+			normalizedPlatform := ""
+			if versionValue != "" || strings.TrimSpace(*platform) != "" {
+				defaultPlatform := ""
+				if versionValue != "" {
+					defaultPlatform = "IOS"
+				}
+				if value := shared.ResolvePlatform(*platform, defaultPlatform); value != "" {
+					var err error
+					normalizedPlatform, err = shared.NormalizeAppStoreVersionPlatform(value)
+					if err != nil {
+						return shared.UsageError(err.Error())
+					}
+				}
+			}
+
+			notifyTarget, err := shared.ResolveNotifyURL(*notifyURL)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("submissions watch: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+			defer cancel()
+
+			target := submissionWatchSnapshot{SubmissionID: submissionValue, VersionID: versionIDValue}
+			switch {
+			case submissionValue != "":
+				target, err = resolveSubmissionWatchTargetBySubmission(requestCtx, client, submissionValue)
+			case versionValue != "":
+				target.VersionID, err = shared.ResolveAppStoreVersionID(requestCtx, client, resolvedAppID, versionValue, normalizedPlatform)
+			case versionIDValue == "":
+				target, err = resolveLatestSubmissionWatchTarget(requestCtx, client, resolvedAppID, normalizedPlatform)
+			}
+			if err != nil {
+				return fmt.Errorf("submissions watch: %w", err)
+			}
+
+			start := time.Now()
+			result := &asc.ReviewSubmissionWatchResult{
+				AppID:        resolvedAppID,
+				SubmissionID: target.SubmissionID,
+				VersionID:    target.VersionID,
+				Transitions:  []asc.ReviewSubmissionWatchTransition{},
+			}
+			final, err := watchReviewSubmission(requestCtx, client, resolvedAppID, target, *interval, *untilReleased, func(subject, label, previous, current string) {
+				result.Transitions = append(result.Transitions, asc.ReviewSubmissionWatchTransition{
+					Subject:    subject,
+					From:       previous,
+					To:         current,
+					ObservedAt: time.Now().UTC().Format(time.RFC3339),
+				})
+				printSubmissionWatchTransition(label, previous, current)
+			})
+			if err != nil {
+				if errors.Is(err, context.DeadlineExceeded) {
+					return fmt.Errorf("submissions watch: timed out watching version %s after %s", target.VersionID, (*timeout).Round(time.Second))
+				}
+				return fmt.Errorf("submissions watch: %w", err)
+			}
+
+			final.applyTo(result)
+			result.Outcome = submissionWatchOutcome(final.VersionState, final.SubmissionState, *untilReleased)
+			result.Elapsed = time.Since(start).Round(time.Second).String()
+
+			if *messages && result.Outcome == submissionsWatchOutcomeRejected {
+				if result.SubmissionID == "" {
+					fmt.Fprintln(shared.WarningWriter(), "Warning: --messages: no review submission found for this version")
+				} else {
+					fetched, fetchErr := fetchReviewMessagesFn(ctx, result.SubmissionID)
+					if fetchErr != nil {
+						fmt.Fprintf(shared.WarningWriter(), "Warning: --messages: %v\n", fetchErr)
+					}
+					result.Messages = fetched
+				}
+			}
+
+			shared.SendWaitNotification(ctx, notifyTarget, submissionWatchNotification(result))
+			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+
+			label := submissionWatchVersionLabel(final)
+			switch result.Outcome {
+			case submissionsWatchOutcomeRejected:
+				state := final.VersionState
+				if submitStatusFailureStates[final.SubmissionState] {
+					state = final.SubmissionState
+				}
+				return shared.NewExitCodeError(fmt.Errorf("submissions watch: version %s was rejected (%s)", label, state), shared.ExitCodeReviewRejected)
+			case submissionsWatchOutcomeCanceled:
+				return shared.NewExitCodeError(fmt.Errorf("submissions watch: version %s was removed from review (%s)", label, final.VersionState), shared.ExitCodeReviewCanceled)
+			}
+			return nil
+		},
+	}
+}
+
+// submissionWatchSnapshot is the submission and version state seen by one poll.
+type submissionWatchSnapshot struct {
+	SubmissionID    string
+	SubmissionState string
+	VersionID       string
+	VersionString   string
+	Platform        string
+	VersionState    string
+}
+
+func (s submissionWatchSnapshot) applyTo(result *asc.ReviewSubmissionWatchResult) {
+	result.SubmissionID = s.SubmissionID
+	result.SubmissionState = s.SubmissionState
+	result.VersionID = s.VersionID
+	result.VersionString = s.VersionString
+	result.Platform = s.Platform
+	result.VersionState = s.VersionState
+}
+
+func resolveSubmissionWatchTargetBySubmission(ctx context.Context, client *asc.Client, submissionID string) (submissionWatchSnapshot, error) {
+	resp, err := client.GetReviewSubmission(ctx, submissionID)
+	if err != nil {
+		return submissionWatchSnapshot{}, fmt.Errorf("failed to fetch review submission %q: %w", submissionID, err)
+	}
+	versionID, err := resolveReviewSubmissionVersionID(ctx, client, &resp.Data)
+	if err != nil {
+		return submissionWatchSnapshot{}, err
+	}
+	if versionID == "" {
+		return submissionWatchSnapshot{}, fmt.Errorf("review submission %q has no App Store version", submissionID)
+	}
+	return submissionWatchSnapshot{SubmissionID: strings.TrimSpace(resp.Data.ID), VersionID: versionID}, nil
+}
+
+// resolveLatestSubmissionWatchTarget picks the app's most relevant active
+// review submission, preferring ones further along in review.
+func resolveLatestSubmissionWatchTarget(ctx context.Context, client *asc.Client, appID, platform string) (submissionWatchSnapshot, error) {
+	opts := []asc.ReviewSubmissionsOption{
+		asc.WithReviewSubmissionsStates(submissionsWatchActiveStates),
+		asc.WithReviewSubmissionsInclude([]string{"appStoreVersionForReview"}),
+		asc.WithReviewSubmissionsLimit(200),
+	}
+	if platform != "" {
+		opts = append(opts, asc.WithReviewSubmissionsPlatforms([]string{platform}))
+	}
+	resp, err := client.GetReviewSubmissions(ctx, appID, opts...)
+	if err != nil {
+		return submissionWatchSnapshot{}, err
+	}
+	if len(resp.Data) == 0 {
+		return submissionWatchSnapshot{}, fmt.Errorf("no active review submission found for app %q; pass --version or --version-id to watch a specific version", appID)
+	}
+
+	candidates := append([]asc.ReviewSubmissionResource(nil), resp.Data...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return reviewSubmissionSortKey(candidates[i]).less(reviewSubmissionSortKey(candidates[j]))
+	})
+	for i := range candidates {
+		versionID, err := resolveReviewSubmissionVersionID(ctx, client, &candidates[i])
+		if err != nil {
+			if shouldIgnoreReviewSubmissionVersionLookupError(err) {
+				continue
+			}
+			return submissionWatchSnapshot{}, err
+		}
+		if versionID != "" {
+			return submissionWatchSnapshot{SubmissionID: strings.TrimSpace(candidates[i].ID), VersionID: versionID}, nil
+		}
+	}
+	return submissionWatchSnapshot{}, fmt.Errorf("no active review submission with an App Store version found for app %q; pass --version or --version-id", appID)
+}
+
+// watchReviewSubmission polls target until App Review reaches an outcome,
+// invoking onTransition each time the version or submission state changes.
+func watchReviewSubmission(
+	ctx context.Context,
+	client *asc.Client,
+	appID string,
+	target submissionWatchSnapshot,
+	interval time.Duration,
+	untilReleased bool,
+	onTransition func(subject, label, previous, current string),
+) (submissionWatchSnapshot, error) {
+	previous := submissionWatchSnapshot{}
+	return asc.PollUntil(ctx, interval, func(ctx context.Context) (submissionWatchSnapshot, bool, error) {
+		current, err := fetchSubmissionWatchSnapshot(ctx, client, appID, target)
+		if err != nil {
+			return submissionWatchSnapshot{}, false, err
+		}
+		// Keep following the submission once it has been found.
+		target.SubmissionID = current.SubmissionID
+
+		if onTransition != nil {
+			if current.VersionState != previous.VersionState {
+				onTransition("version", "Version "+submissionWatchVersionLabel(current), previous.VersionState, current.VersionState)
+			}
+			if current.SubmissionState != previous.SubmissionState && current.SubmissionState != "" {
+				onTransition("submission", "Submission "+current.SubmissionID, previous.SubmissionState, current.SubmissionState)
+			}
+		}
+		previous = current
+		return current, submissionWatchOutcome(current.VersionState, current.SubmissionState, untilReleased) != "", nil
+	})
+}
+
+func fetchSubmissionWatchSnapshot(ctx context.Context, client *asc.Client, appID string, target submissionWatchSnapshot) (submissionWatchSnapshot, error) {
+	snapshot := submissionWatchSnapshot{SubmissionID: target.SubmissionID, VersionID: target.VersionID}
+
+	if snapshot.SubmissionID != "" {
+		resp, err := client.GetReviewSubmission(ctx, snapshot.SubmissionID)
+		if err != nil {
+			return snapshot, fmt.Errorf("failed to fetch review submission %q: %w", snapshot.SubmissionID, err)
+		}
+		snapshot.SubmissionState = strings.ToUpper(strings.TrimSpace(string(resp.Data.Attributes.SubmissionState)))
+	} else {
+		// The version may not have been submitted yet; look again on each poll.
+		submission, err := findReviewSubmissionForVersion(ctx, client, appID, snapshot.VersionID)
+		if err != nil && !shouldIgnoreReviewSubmissionVersionLookupError(err) {
+			return snapshot, err
+		}
+		if submission != nil {
+			snapshot.SubmissionID = strings.TrimSpace(submission.ID)
+			snapshot.SubmissionState = strings.ToUpper(strings.TrimSpace(string(submission.Attributes.SubmissionState)))
+		}
+	}
+
+	versionResp, err := client.GetAppStoreVersion(ctx, snapshot.VersionID)
+	if err != nil {
+		return snapshot, fmt.Errorf("failed to fetch version %q: %w", snapshot.VersionID, err)
+	}
+	snapshot.VersionString = strings.TrimSpace(versionResp.Data.Attributes.VersionString)
+	snapshot.Platform = strings.TrimSpace(string(versionResp.Data.Attributes.Platform))
+	snapshot.VersionState = strings.ToUpper(strings.TrimSpace(shared.ResolveAppStoreVersionState(versionResp.Data.Attributes)))
+	return snapshot, nil
+}
+
+// submissionWatchOutcome returns the review outcome for the observed states,
+// or "" while the review is still in progress.
+func submissionWatchOutcome(versionState, submissionState string, untilReleased bool) string {
+	switch {
+	case submitStatusFailureStates[versionState] || submitStatusFailureStates[submissionState]:
+		return submissionsWatchOutcomeRejected
+	case versionState == "DEVELOPER_REJECTED":
+		return submissionsWatchOutcomeCanceled
+	case submissionsWatchReleasedStates[versionState]:
+		return submissionsWatchOutcomeReleased
+	case submissionsWatchAcceptedStates[versionState] && !untilReleased:
+		return submissionsWatchOutcomeAccepted
+	default:
+		return ""
+	}
+}
+
+func submissionWatchVersionLabel(snapshot submissionWatchSnapshot) string {
+	label := snapshot.VersionID
+	if snapshot.VersionString != "" {
+		label = snapshot.VersionString
+	}
+	if snapshot.Platform != "" {
+		label = fmt.Sprintf("%s (%s)", label, snapshot.Platform)
+	}
+	return label
+}
+
+func printSubmissionWatchTransition(label, previous, current string) {
+	if current == "" {
+		current = "UNKNOWN"
+	}
+	if previous == "" {
+		fmt.Fprintf(shared.WarningWriter(), "%s: %s\n", label, current)
+		return
+	}
+	fmt.Fprintf(shared.WarningWriter(), "%s: %s -> %s\n", label, previous, current)
+}
+
+// submissionWatchNotification describes a review outcome for --notify-url.
+func submissionWatchNotification(result *asc.ReviewSubmissionWatchResult) shared.WaitNotification {
+	label := submissionWatchVersionLabel(submissionWatchSnapshot{
+		VersionID:     result.VersionID,
+		VersionString: result.VersionString,
+		Platform:      result.Platform,
+	})
+	fields := []shared.NotificationField{
+		{Name: "App ID", Value: result.AppID},
+		{Name: "Version", Value: label},
+		{Name: "Version State", Value: result.VersionState},
+	}
+	if result.SubmissionID != "" {
+		fields = append(fields,
+			shared.NotificationField{Name: "Submission ID", Value: result.SubmissionID},
+			shared.NotificationField{Name: "Submission State", Value: result.SubmissionState},
+		)
+	}
+	return shared.WaitNotification{
+		Event:   "review.submission.finished",
+		Title:   fmt.Sprintf("Version %s was %s", label, result.Outcome),
+		Summary: fmt.Sprintf("App Review finished for version %s: %s (%s).", label, result.Outcome, result.VersionState),
+		Success: result.Outcome == submissionsWatchOutcomeAccepted || result.Outcome == submissionsWatchOutcomeReleased,
+		Fields:  fields,
+	}
+}
+
+// fetchResolutionCenterMessages reads App Review's messages for a submission
+// using the cached web session.
+func fetchResolutionCenterMessages(ctx context.Context, submissionID string) ([]asc.ReviewSubmissionWatchMessage, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	session, ok, err := webcore.TryResumeLastSession(requestCtx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || session == nil {
+		return nil, errNoWebSession
+	}
+
+	client := webcore.NewClient(session)
+	threads, err := client.ListResolutionCenterThreadsBySubmission(requestCtx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]asc.ReviewSubmissionWatchMessage, 0)
+	for _, thread := range threads {
+		threadMessages, err := client.ListResolutionCenterMessages(requestCtx, thread.ID, true)
+		if err != nil {
+			return messages, err
+		}
+		for _, message := range threadMessages {
+			body := strings.TrimSpace(message.MessageBodyPlain)
+			if body == "" {
+				body = strings.TrimSpace(message.MessageBody)
+			}
+			messages = append(messages, asc.ReviewSubmissionWatchMessage{
+				ID:          message.ID,
+				CreatedDate: message.CreatedDate,
+				Body:        body,
+			})
+		}
+	}
+	return messages, nil
+}
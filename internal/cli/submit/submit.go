@@ -45,7 +45,7 @@ func SubmitCreateCommand() *ffcli.Command {
 	version := fs.String("version", "", "App Store version string")
 	versionID := fs.String("version-id", "", "App Store version ID")
 	buildID := fs.String("build", "", "Build ID to attach")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	confirm := fs.Bool("confirm", false, "Confirm submission (required)")
 	output := shared.BindOutputFlags(fs)
 
@@ -83,7 +83,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
@@ -250,7 +250,7 @@ func SubmitStatusCommand() *ffcli.Command {
 
 	submissionID := fs.String("id", "", "Submission ID")
 	versionID := fs.String("version-id", "", "App Store version ID")
-	output := shared.BindOutputFlags(fs)
+	output := shared.BindGHAOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "status",
@@ -258,9 +258,14 @@ func SubmitStatusCommand() *ffcli.Command {
 		ShortHelp:  "Check submission status.",
 		LongHelp: `Check submission status.
 
+With --output gha the state is reported as a GitHub Actions annotation (::error
+for rejected submissions, ::notice otherwise) and appended to the job summary
+in GITHUB_STEP_SUMMARY.
+
 Examples:
   asc submit status --id "SUBMISSION_ID"
-  asc submit status --version-id "VERSION_ID"`,
+  asc submit status --version-id "VERSION_ID"
+  asc submit status --version-id "VERSION_ID" --output gha`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -320,7 +325,7 @@ Examples:
 						}
 					} else if reviewSubmission != nil {
 						applyReviewSubmissionStatus(result, reviewSubmission)
-						return shared.PrintOutput(result, *output.Output, *output.Pretty)
+						return printSubmitStatus(result, output)
 					}
 				}
 
@@ -331,7 +336,7 @@ Examples:
 					return fmt.Errorf("submit status: %w", legacyErr)
 				}
 
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return printSubmitStatus(result, output)
 			}
 
 			if resolvedVersionID != "" {
@@ -342,11 +347,46 @@ Examples:
 				applyVersionStatus(result, versionResp)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return printSubmitStatus(result, output)
 		},
 	}
 }
 
+// submitStatusFailureStates are submission and version states reported as
+// ::error annotations with --output gha.
+var submitStatusFailureStates = map[string]bool{
+	string(asc.ReviewSubmissionStateUnresolvedIssues): true,
+	"REJECTED":          true,
+	"METADATA_REJECTED": true,
+	"INVALID_BINARY":    true,
+}
+
+func printSubmitStatus(result *asc.AppStoreVersionSubmissionStatusResult, output shared.OutputFlags) error {
+	return shared.PrintOutputWithGHA(result, *output.Output, *output.Pretty, "App Store submission", submitStatusAnnotation(result))
+}
+
+func submitStatusAnnotation(result *asc.AppStoreVersionSubmissionStatusResult) shared.GHAAnnotation {
+	subject := "Submission"
+	if result.ID != "" {
+		subject = fmt.Sprintf("Submission %s", result.ID)
+	}
+	if result.VersionString != "" {
+		subject = fmt.Sprintf("%s for version %s", subject, result.VersionString)
+		if result.Platform != "" {
+			subject = fmt.Sprintf("%s (%s)", subject, result.Platform)
+		}
+	}
+	state := strings.ToUpper(strings.TrimSpace(result.State))
+	if state == "" {
+		state = "UNKNOWN"
+	}
+	message := fmt.Sprintf("%s is %s", subject, state)
+	if submitStatusFailureStates[state] {
+		return shared.GHAError("Submission needs attention", message)
+	}
+	return shared.GHANotice("Submission status", message)
+}
+
 type submitStatusVersionRelationships struct {
 	App *asc.Relationship `json:"app"`
 }
@@ -690,7 +730,7 @@ func runSubmitCreateSubscriptionPreflight(ctx context.Context, client *asc.Clien
 	groups, warning := fetchSubscriptionPreflightGroups(ctx, client, appID)
 	if warning != "" {
 		fmt.Fprintln(os.Stderr, "")
-		fmt.Fprintf(os.Stderr, "Warning: subscription preflight could not check subscriptions: %s.\n", warning)
+		fmt.Fprintf(shared.WarningWriter(), "Warning: subscription preflight could not check subscriptions: %s.\n", warning)
 		return
 	}
 	if len(groups) == 0 {
@@ -735,7 +775,7 @@ func runSubmitCreateSubscriptionPreflight(ctx context.Context, client *asc.Clien
 
 	if len(missingMetadata) > 0 {
 		fmt.Fprintln(os.Stderr, "")
-		fmt.Fprintln(os.Stderr, "Warning: the following subscriptions are MISSING_METADATA and will not be included in review:")
+		fmt.Fprintln(shared.WarningWriter(), "Warning: the following subscriptions are MISSING_METADATA and will not be included in review:")
 		for _, name := range missingMetadata {
 			fmt.Fprintf(os.Stderr, "  - %s\n", name)
 		}
@@ -744,7 +784,7 @@ func runSubmitCreateSubscriptionPreflight(ctx context.Context, client *asc.Clien
 
 	if len(readyToSubmit) > 0 {
 		fmt.Fprintln(os.Stderr, "")
-		fmt.Fprintln(os.Stderr, "Warning: the following subscriptions are READY_TO_SUBMIT but are not automatically included in this submission:")
+		fmt.Fprintln(shared.WarningWriter(), "Warning: the following subscriptions are READY_TO_SUBMIT but are not automatically included in this submission:")
 		for _, name := range readyToSubmit {
 			fmt.Fprintf(os.Stderr, "  - %s\n", name)
 		}
@@ -754,7 +794,7 @@ func runSubmitCreateSubscriptionPreflight(ctx context.Context, client *asc.Clien
 
 	if len(skippedGroups) > 0 {
 		fmt.Fprintln(os.Stderr, "")
-		fmt.Fprintln(os.Stderr, "Warning: some subscription groups could not be fully checked during preflight:")
+		fmt.Fprintln(shared.WarningWriter(), "Warning: some subscription groups could not be fully checked during preflight:")
 		for _, skipped := range skippedGroups {
 			fmt.Fprintf(os.Stderr, "  - %s\n", skipped)
 		}
@@ -925,7 +965,7 @@ func cleanupEmptyReviewSubmission(ctx context.Context, client *asc.Client, submi
 		return
 	}
 	if _, cancelErr := client.CancelReviewSubmission(ctx, submissionID); cancelErr != nil && !isExpectedNonCancellableReviewSubmissionError(cancelErr) {
-		fmt.Fprintf(os.Stderr, "Warning: failed to cancel empty submission %s: %v\n", submissionID, cancelErr)
+		fmt.Fprintf(shared.WarningWriter(), "Warning: failed to cancel empty submission %s: %v\n", submissionID, cancelErr)
 	}
 }
 
@@ -938,7 +978,7 @@ func cancelStaleReviewSubmissions(ctx context.Context, client *asc.Client, appID
 		asc.WithReviewSubmissionsPlatforms([]string{platform}),
 	)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: failed to query stale review submissions: %v\n", err)
+		fmt.Fprintf(shared.WarningWriter(), "Warning: failed to query stale review submissions: %v\n", err)
 		return nil
 	}
 	if len(existing.Data) == 0 {
@@ -960,7 +1000,7 @@ func cancelStaleReviewSubmissions(ctx context.Context, client *asc.Client, appID
 			if isExpectedNonCancellableReviewSubmissionError(cancelErr) {
 				fmt.Fprintf(os.Stderr, "Skipped stale submission %s: already transitioned to a non-cancellable state\n", sub.ID)
 			} else {
-				fmt.Fprintf(os.Stderr, "Warning: failed to cancel stale submission %s: %v\n", sub.ID, cancelErr)
+				fmt.Fprintf(shared.WarningWriter(), "Warning: failed to cancel stale submission %s: %v\n", sub.ID, cancelErr)
 			}
 			continue
 		}
@@ -681,6 +681,10 @@ func setupSubmitAuth(t *testing.T) {
 	t.Setenv("ASC_KEY_ID", "TEST_KEY")
 	t.Setenv("ASC_ISSUER_ID", "TEST_ISSUER")
 	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
+	t.Setenv("ASC_TOKEN_CACHE_DIR", filepath.Join(tempDir, "tokens"))
+
+	// Stub transports answer only the requests under test, not the clock probe.
+	t.Setenv("ASC_CLOCK_SKEW_CHECK", "0")
 }
 
 func writeSubmitECDSAPEM(t *testing.T, path string) {
@@ -0,0 +1,17 @@
+package submit
+
+import (
+	"context"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SetFetchReviewMessages replaces the Resolution Center message lookup used
+// by submissions watch --messages. It returns a restore function.
+func SetFetchReviewMessages(fn func(context.Context, string) ([]asc.ReviewSubmissionWatchMessage, error)) func() {
+	prev := fetchReviewMessagesFn
+	fetchReviewMessagesFn = fn
+	return func() {
+		fetchReviewMessagesFn = prev
+	}
+}
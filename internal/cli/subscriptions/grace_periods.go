@@ -23,8 +23,13 @@ func SubscriptionsGracePeriodsCommand() *ffcli.Command {
 		ShortHelp:  "Inspect subscription grace periods.",
 		LongHelp: `Inspect subscription grace periods.
 
+Grace periods are app-level settings. Use --app to resolve the grace period
+for an app without looking up its ID first.
+
 Examples:
   asc subscriptions grace-periods get --id "GRACE_PERIOD_ID"
+  asc subscriptions grace-periods get --app "APP_ID"
+  asc subscriptions grace-periods update --app "APP_ID" --opt-in true
   asc subscriptions grace-periods update --id "GRACE_PERIOD_ID" --duration SIXTEEN_DAYS --opt-in true`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -43,23 +48,24 @@ func SubscriptionsGracePeriodsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("grace-periods get", flag.ExitOnError)
 
 	gracePeriodID := fs.String("id", "", "Subscription grace period ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env); alternative to --id")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "get",
-		ShortUsage: "asc subscriptions grace-periods get --id \"GRACE_PERIOD_ID\"",
-		ShortHelp:  "Get a subscription grace period by ID.",
-		LongHelp: `Get a subscription grace period by ID.
+		ShortUsage: "asc subscriptions grace-periods get (--id \"GRACE_PERIOD_ID\" | --app \"APP_ID\")",
+		ShortHelp:  "Get a subscription grace period by ID or app.",
+		LongHelp: `Get a subscription grace period by ID or app.
 
 Examples:
-  asc subscriptions grace-periods get --id "GRACE_PERIOD_ID"`,
+  asc subscriptions grace-periods get --id "GRACE_PERIOD_ID"
+  asc subscriptions grace-periods get --app "APP_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			id := strings.TrimSpace(*gracePeriodID)
-			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+			id, resolvedAppID, err := subscriptionGracePeriodTarget(*gracePeriodID, *appID)
+			if err != nil {
+				return err
 			}
 
 			client, err := shared.GetASCClient()
@@ -70,7 +76,12 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.GetSubscriptionGracePeriod(requestCtx, id)
+			var resp *asc.SubscriptionGracePeriodResponse
+			if id != "" {
+				resp, err = client.GetSubscriptionGracePeriod(requestCtx, id)
+			} else {
+				resp, err = client.GetAppSubscriptionGracePeriod(requestCtx, resolvedAppID)
+			}
 			if err != nil {
 				return fmt.Errorf("subscriptions grace-periods get: failed to fetch: %w", err)
 			}
@@ -85,6 +96,7 @@ func SubscriptionsGracePeriodsUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("grace-periods update", flag.ExitOnError)
 
 	gracePeriodID := fs.String("id", "", "Subscription grace period ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env); alternative to --id")
 	var optIn shared.OptionalBool
 	fs.Var(&optIn, "opt-in", "Enable grace period opt-in: true or false")
 	var sandboxOptIn shared.OptionalBool
@@ -100,14 +112,14 @@ func SubscriptionsGracePeriodsUpdateCommand() *ffcli.Command {
 		LongHelp: `Update a subscription grace period.
 
 Examples:
-  asc subscriptions grace-periods update --id "GRACE_PERIOD_ID" --duration SIXTEEN_DAYS --opt-in true`,
+  asc subscriptions grace-periods update --id "GRACE_PERIOD_ID" --duration SIXTEEN_DAYS --opt-in true
+  asc subscriptions grace-periods update --app "APP_ID" --renewal-type ALL_RENEWALS`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			id := strings.TrimSpace(*gracePeriodID)
-			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
+			id, resolvedAppID, err := subscriptionGracePeriodTarget(*gracePeriodID, *appID)
+			if err != nil {
+				return err
 			}
 
 			durationValue, err := normalizeSubscriptionGracePeriodDuration(*duration, false)
@@ -120,7 +132,7 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error:", err.Error())
 				return flag.ErrHelp
 			}
-			if !optIn.IsSet() && !sandboxOptIn.IsSet() && durationValue == "" && renewalTypeValue == "" {
+			if !optIn.IsSet() && !sandboxOptIn.IsSet() && durationValue == "" && renewalTypeValue == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -133,6 +145,17 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if id == "" {
+				linkage, err := client.GetAppSubscriptionGracePeriodRelationship(requestCtx, resolvedAppID)
+				if err != nil {
+					return fmt.Errorf("subscriptions grace-periods update: failed to resolve grace period for app %q: %w", resolvedAppID, err)
+				}
+				id = strings.TrimSpace(linkage.Data.ID)
+				if id == "" {
+					return fmt.Errorf("subscriptions grace-periods update: app %q has no subscription grace period", resolvedAppID)
+				}
+			}
+
 			attrs := asc.SubscriptionGracePeriodUpdateAttributes{}
 			if optIn.IsSet() {
 				value := optIn.Value()
@@ -160,3 +183,23 @@ Examples:
 		},
 	}
 }
+
+// subscriptionGracePeriodTarget validates --id/--app and returns either the
+// grace period ID or the app ID to resolve it from. ASC_APP_ID is only used
+// when --id is not set.
+func subscriptionGracePeriodTarget(idValue, appValue string) (string, string, error) {
+	id := strings.TrimSpace(idValue)
+	explicitApp := strings.TrimSpace(appValue)
+	if id != "" && explicitApp != "" {
+		return "", "", shared.UsageError("--id and --app are mutually exclusive")
+	}
+	if id != "" {
+		return id, "", nil
+	}
+	resolvedAppID := shared.ResolveAppID(explicitApp)
+	if resolvedAppID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --id or --app is required (or set ASC_APP_ID)")
+		return "", "", flag.ErrHelp
+	}
+	return "", resolvedAppID, nil
+}
@@ -251,7 +251,7 @@ Examples:
 
 			nameValue := strings.TrimSpace(*name)
 			customValue := strings.TrimSpace(*customAppName)
-			if nameValue == "" && customValue == "" {
+			if nameValue == "" && customValue == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -267,7 +267,7 @@ Examples:
 			}
 
 			checksumValue := strings.TrimSpace(*checksum)
-			if checksumValue == "" && !uploaded.IsSet() {
+			if checksumValue == "" && !uploaded.IsSet() && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
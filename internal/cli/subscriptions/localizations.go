@@ -251,7 +251,7 @@ Examples:
 
 			nameValue := strings.TrimSpace(*name)
 			descriptionValue := strings.TrimSpace(*description)
-			if nameValue == "" && descriptionValue == "" {
+			if nameValue == "" && descriptionValue == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -348,12 +348,16 @@ func SubscriptionsOfferCodesOneTimeCodesCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions offer-codes one-time-codes list --offer-code-id "OFFER_CODE_ID"
-  asc subscriptions offer-codes one-time-codes get --batch-id "ONE_TIME_USE_CODE_ID"`,
+  asc subscriptions offer-codes one-time-codes get --batch-id "ONE_TIME_USE_CODE_ID"
+  asc subscriptions offer-codes one-time-codes update --batch-id "ONE_TIME_USE_CODE_ID" --active false
+  asc subscriptions offer-codes one-time-codes download --batch-id "ONE_TIME_USE_CODE_ID" --output-file "./codes.csv" --redeem-urls --app "APP_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SubscriptionsOfferCodesOneTimeCodesListCommand(),
 			SubscriptionsOfferCodesOneTimeCodesGetCommand(),
+			SubscriptionsOfferCodesOneTimeCodesUpdateCommand(),
+			SubscriptionsOfferCodesOneTimeCodesDownloadCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -569,3 +573,21 @@ func SubscriptionsOfferCodesValuesCommand() *ffcli.Command {
 		"asc subscriptions offer-codes",
 	)
 }
+
+// SubscriptionsOfferCodesOneTimeCodesUpdateCommand returns the nested one-time code batch update shim.
+func SubscriptionsOfferCodesOneTimeCodesUpdateCommand() *ffcli.Command {
+	return shared.RewriteCommandTreePath(
+		offercodes.OfferCodesOneTimeCodesUpdateCommand(),
+		"asc offer-codes",
+		"asc subscriptions offer-codes",
+	)
+}
+
+// SubscriptionsOfferCodesOneTimeCodesDownloadCommand returns the nested one-time code batch download shim.
+func SubscriptionsOfferCodesOneTimeCodesDownloadCommand() *ffcli.Command {
+	return shared.RewriteCommandTreePath(
+		offercodes.OfferCodesOneTimeCodesDownloadCommand(),
+		"asc offer-codes",
+		"asc subscriptions offer-codes",
+	)
+}
@@ -31,10 +31,9 @@ type subscriptionPriceImportSummary struct {
 	ContinueOnError bool                                  `json:"continueOnError"`
 	DefaultStart    string                                `json:"defaultStartDate,omitempty"`
 	DefaultPreserve bool                                  `json:"defaultPreserved"`
-	Total           int                                   `json:"total"`
 	Created         int                                   `json:"created"`
-	Failed          int                                   `json:"failed"`
 	Failures        []subscriptionPriceImportSummaryError `json:"failures,omitempty"`
+	asc.BulkResult
 }
 
 type subscriptionPriceImportSummaryError struct {
@@ -162,15 +161,16 @@ Examples:
 				return fmt.Errorf("subscriptions prices import: %w", err)
 			}
 
+			planOnly := *dryRun || asc.DryRunEnabled()
 			summary := &subscriptionPriceImportSummary{
 				SubscriptionID:  id,
 				InputFile:       filepath.Clean(inputValue),
-				DryRun:          *dryRun,
+				DryRun:          planOnly,
 				ContinueOnError: *continueOnError,
 				DefaultStart:    defaultStartDate,
 				DefaultPreserve: *preserved,
-				Total:           len(rows),
 			}
+			summary.Total = len(rows)
 
 			lookupCache := &subscriptionPricePointLookupCache{
 				byTerritory: make(map[string]map[string][]string),
@@ -198,8 +198,9 @@ Examples:
 					}
 				}
 
-				if *dryRun {
+				if planOnly {
 					summary.Created++
+					summary.Succeed(subscriptionPriceImportItem(resolvedRow, ""))
 					continue
 				}
 
@@ -211,7 +212,7 @@ Examples:
 				}
 
 				createCtx, createCancel := shared.ContextWithTimeout(ctx)
-				_, rowErr = client.CreateSubscriptionPrice(createCtx, id, pricePointID, resolvedRow.territoryID, attrs)
+				created, rowErr := client.CreateSubscriptionPrice(createCtx, id, pricePointID, resolvedRow.territoryID, attrs)
 				createCancel()
 				if rowErr != nil {
 					appendSubscriptionPriceImportFailure(summary, resolvedRow, rowErr)
@@ -222,6 +223,7 @@ Examples:
 				}
 
 				summary.Created++
+				summary.Succeed(subscriptionPriceImportItem(resolvedRow, strings.TrimSpace(created.Data.ID)))
 			}
 
 			if err := shared.PrintOutputWithRenderers(
@@ -264,17 +266,8 @@ func renderSubscriptionPriceImportSummaryTables(summary *subscriptionPriceImport
 		}},
 	)
 
-	if len(summary.Failures) > 0 {
-		rows := make([][]string, 0, len(summary.Failures))
-		for _, failure := range summary.Failures {
-			rows = append(rows, []string{
-				fmt.Sprintf("%d", failure.Row),
-				failure.Territory,
-				failure.Price,
-				failure.Error,
-			})
-		}
-		render([]string{"Row", "Territory", "Price", "Error"}, rows)
+	if len(summary.Items) > 0 {
+		render(asc.BulkResultRows(&summary.BulkResult))
 	}
 
 	return nil
@@ -284,7 +277,11 @@ func appendSubscriptionPriceImportFailure(summary *subscriptionPriceImportSummar
 	if summary == nil || err == nil {
 		return
 	}
-	summary.Failed++
+	failed := summary.Failed
+	summary.Fail(subscriptionPriceImportItem(row, ""), err)
+	if summary.Failed == failed {
+		return
+	}
 	summary.Failures = append(summary.Failures, subscriptionPriceImportSummaryError{
 		Row:       row.row,
 		Territory: row.territoryID,
@@ -293,6 +290,15 @@ func appendSubscriptionPriceImportFailure(summary *subscriptionPriceImportSummar
 	})
 }
 
+// subscriptionPriceImportItem identifies a CSV row as "TERRITORY PRICE".
+func subscriptionPriceImportItem(row subscriptionPriceImportResolvedRow, priceID string) asc.BulkResultItem {
+	return asc.BulkResultItem{
+		Row:  row.row,
+		Item: strings.TrimSpace(row.territoryID + " " + row.price),
+		ID:   priceID,
+	}
+}
+
 func readSubscriptionPricesImportCSV(path string) ([]subscriptionPriceImportCSVRow, error) {
 	file, err := shared.OpenExistingNoFollow(path)
 	if err != nil {
@@ -73,7 +73,8 @@ Examples:
 			if territory == "" {
 				territory = "USA"
 			}
-			if !*dryRun && !*confirm {
+			planOnly := *dryRun || asc.DryRunEnabled()
+			if !planOnly && !*confirm {
 				return shared.UsageError("--confirm is required unless --dry-run is set")
 			}
 			numWorkers := *workers
@@ -110,7 +111,7 @@ Examples:
 			})
 			allTerritories = append(allTerritories, equalizations...)
 
-			if *dryRun {
+			if planOnly {
 				return printEqualizeResult(&equalizeResult{
 					SubscriptionID: subID,
 					BaseTerritory:  territory,
@@ -27,13 +27,15 @@ func TestFlightFeedbackCommand() *ffcli.Command {
 Examples:
   asc testflight feedback list --app "APP_ID"
   asc testflight feedback view --submission-id "SUBMISSION_ID"
-  asc testflight feedback delete --submission-id "SUBMISSION_ID" --confirm`,
+  asc testflight feedback delete --submission-id "SUBMISSION_ID" --confirm
+  asc testflight feedback download --app "APP_ID" --output-dir "./feedback"`,
 		FlagSet:   fs,
 		UsageFunc: testflightVisibleUsageFunc,
 		Subcommands: []*ffcli.Command{
 			TestFlightFeedbackListCommand(),
 			TestFlightFeedbackViewCommand(),
 			TestFlightFeedbackDeleteCommand(),
+			TestFlightFeedbackDownloadCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -131,7 +133,8 @@ Examples:
   asc testflight crashes view --submission-id "SUBMISSION_ID"
   asc testflight crashes delete --submission-id "SUBMISSION_ID" --confirm
   asc testflight crashes log --submission-id "SUBMISSION_ID"
-  asc testflight crashes log --crash-log-id "CRASH_LOG_ID"`,
+  asc testflight crashes log --crash-log-id "CRASH_LOG_ID"
+  asc testflight crashes download --app "APP_ID" --output-dir "./crashes"`,
 		FlagSet:   fs,
 		UsageFunc: testflightVisibleUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -139,6 +142,7 @@ Examples:
 			TestFlightCrashesViewCommand(),
 			TestFlightCrashesDeleteCommand(),
 			TestFlightCrashesLogCommand(),
+			TestFlightCrashesDownloadCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,321 @@
+package testflight
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/assets"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	feedbackDownloadKindScreenshot = "screenshot"
+	feedbackDownloadKindCrashLog   = "crashLog"
+)
+
+type feedbackDownloadFlags struct {
+	appID          *string
+	buildID        *string
+	deviceModel    *string
+	osVersion      *string
+	appPlatform    *string
+	devicePlatform *string
+	tester         *string
+	outputDir      *string
+	overwrite      *bool
+	output         shared.OutputFlags
+}
+
+func bindFeedbackDownloadFlags(fs *flag.FlagSet, defaultDir string) feedbackDownloadFlags {
+	return feedbackDownloadFlags{
+		appID:          fs.String("app", "", "App Store Connect app ID, bundle ID, or exact app name (or ASC_APP_ID env)"),
+		buildID:        fs.String("build", "", "Filter by build ID(s), comma-separated"),
+		deviceModel:    fs.String("device-model", "", "Filter by device model(s), comma-separated"),
+		osVersion:      fs.String("os-version", "", "Filter by OS version(s), comma-separated"),
+		appPlatform:    fs.String("app-platform", "", "Filter by app platform(s), comma-separated (IOS, MAC_OS, TV_OS, VISION_OS)"),
+		devicePlatform: fs.String("device-platform", "", "Filter by device platform(s), comma-separated (IOS, MAC_OS, TV_OS, VISION_OS)"),
+		tester:         fs.String("tester", "", "Filter by tester ID(s), comma-separated"),
+		outputDir:      fs.String("output-dir", defaultDir, "Directory to write downloaded files to"),
+		overwrite:      fs.Bool("overwrite", false, "Replace files that already exist instead of skipping them"),
+		output:         shared.BindOutputFlags(fs),
+	}
+}
+
+// resolve validates the shared flags and returns the app ID and output directory.
+func (f feedbackDownloadFlags) resolve() (string, string, error) {
+	appID := shared.ResolveAppID(*f.appID)
+	if appID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+		return "", "", flag.ErrHelp
+	}
+	dir := strings.TrimSpace(*f.outputDir)
+	if dir == "" {
+		fmt.Fprintln(os.Stderr, "Error: --output-dir must not be empty")
+		return "", "", flag.ErrHelp
+	}
+	return appID, dir, nil
+}
+
+func TestFlightFeedbackDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+	flags := bindFeedbackDownloadFlags(fs, "testflight-feedback")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc testflight feedback download --app \"APP_ID\" [flags]",
+		ShortHelp:  "Download screenshots attached to TestFlight feedback.",
+		LongHelp: `Download screenshots attached to TestFlight feedback.
+
+Fetches every matching feedback submission and saves its screenshots as
+<submission-id>-<n>.<ext> in --output-dir. Files that already exist are
+skipped unless --overwrite is set, so repeated runs only fetch new feedback.
+
+Examples:
+  asc testflight feedback download --app "123456789"
+  asc testflight feedback download --app "123456789" --build "BUILD_ID" --output-dir "./feedback"
+  asc testflight feedback download --app "123456789" --device-model "iPhone15,3" --os-version "17.2"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			appID, dir, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight feedback download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			appID, err = shared.ResolveAppIDWithLookup(requestCtx, client, appID)
+			if err != nil {
+				return fmt.Errorf("testflight feedback download: %w", err)
+			}
+
+			firstPage, err := client.GetFeedback(requestCtx, appID,
+				asc.WithFeedbackDeviceModels(shared.SplitCSV(*flags.deviceModel)),
+				asc.WithFeedbackOSVersions(shared.SplitCSV(*flags.osVersion)),
+				asc.WithFeedbackAppPlatforms(shared.SplitCSVUpper(*flags.appPlatform)),
+				asc.WithFeedbackDevicePlatforms(shared.SplitCSVUpper(*flags.devicePlatform)),
+				asc.WithFeedbackBuildIDs(shared.SplitCSV(*flags.buildID)),
+				asc.WithFeedbackTesterIDs(shared.SplitCSV(*flags.tester)),
+				asc.WithFeedbackIncludeScreenshots(),
+				asc.WithFeedbackLimit(200),
+			)
+			if err != nil {
+				return fmt.Errorf("testflight feedback download: failed to fetch: %w", err)
+			}
+			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetFeedback(ctx, appID, asc.WithFeedbackNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("testflight feedback download: %w", err)
+			}
+			feedback, ok := paginated.(*asc.FeedbackResponse)
+			if !ok {
+				return fmt.Errorf("testflight feedback download: unexpected pagination response type %T", paginated)
+			}
+
+			result := &asc.BetaFeedbackDownloadResult{
+				AppID:       appID,
+				Dir:         dir,
+				Submissions: len(feedback.Data),
+				Files:       []asc.BetaFeedbackDownloadFile{},
+			}
+			for _, item := range feedback.Data {
+				for i, image := range item.Attributes.Screenshots {
+					if strings.TrimSpace(image.URL) == "" {
+						continue
+					}
+					file := asc.BetaFeedbackDownloadFile{
+						SubmissionID: item.ID,
+						Kind:         feedbackDownloadKindScreenshot,
+						CreatedDate:  item.Attributes.CreatedDate,
+						DeviceModel:  item.Attributes.DeviceModel,
+						OSVersion:    item.Attributes.OSVersion,
+						FilePath:     filepath.Join(dir, fmt.Sprintf("%s-%d%s", feedbackFileStem(item.ID), i+1, screenshotExtension(image.URL))),
+					}
+					if skipExistingFeedbackFile(&file, *flags.overwrite) {
+						result.Files = append(result.Files, file)
+						continue
+					}
+					if err := os.MkdirAll(dir, 0o755); err != nil {
+						return fmt.Errorf("testflight feedback download: failed to create output directory: %w", err)
+					}
+					size, err := assets.DownloadURL(requestCtx, image.URL, file.FilePath, *flags.overwrite)
+					if err != nil {
+						return fmt.Errorf("testflight feedback download: failed to download screenshot for %s: %w", item.ID, err)
+					}
+					file.FileSize = size
+					result.Files = append(result.Files, file)
+				}
+			}
+
+			return shared.PrintOutput(result, *flags.output.Output, *flags.output.Pretty)
+		},
+	}
+}
+
+func TestFlightCrashesDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+	flags := bindFeedbackDownloadFlags(fs, "testflight-crashes")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc testflight crashes download --app \"APP_ID\" [flags]",
+		ShortHelp:  "Download crash logs for TestFlight crash submissions.",
+		LongHelp: `Download crash logs for TestFlight crash submissions.
+
+Fetches every matching crash submission and saves its crash log as
+<submission-id>.crash in --output-dir. Files that already exist are skipped
+unless --overwrite is set, so repeated runs only fetch new crashes.
+
+Examples:
+  asc testflight crashes download --app "123456789"
+  asc testflight crashes download --app "123456789" --build "BUILD_ID" --output-dir "./crashes"
+  asc testflight crashes download --app "123456789" --device-model "iPhone15,3" --os-version "17.2"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			appID, dir, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight crashes download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			appID, err = shared.ResolveAppIDWithLookup(requestCtx, client, appID)
+			if err != nil {
+				return fmt.Errorf("testflight crashes download: %w", err)
+			}
+
+			firstPage, err := client.GetCrashes(requestCtx, appID,
+				asc.WithCrashDeviceModels(shared.SplitCSV(*flags.deviceModel)),
+				asc.WithCrashOSVersions(shared.SplitCSV(*flags.osVersion)),
+				asc.WithCrashAppPlatforms(shared.SplitCSVUpper(*flags.appPlatform)),
+				asc.WithCrashDevicePlatforms(shared.SplitCSVUpper(*flags.devicePlatform)),
+				asc.WithCrashBuildIDs(shared.SplitCSV(*flags.buildID)),
+				asc.WithCrashTesterIDs(shared.SplitCSV(*flags.tester)),
+				asc.WithCrashLimit(200),
+			)
+			if err != nil {
+				return fmt.Errorf("testflight crashes download: failed to fetch: %w", err)
+			}
+			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetCrashes(ctx, appID, asc.WithCrashNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("testflight crashes download: %w", err)
+			}
+			crashes, ok := paginated.(*asc.CrashesResponse)
+			if !ok {
+				return fmt.Errorf("testflight crashes download: unexpected pagination response type %T", paginated)
+			}
+
+			result := &asc.BetaFeedbackDownloadResult{
+				AppID:       appID,
+				Dir:         dir,
+				Submissions: len(crashes.Data),
+				Files:       []asc.BetaFeedbackDownloadFile{},
+			}
+			for _, item := range crashes.Data {
+				file := asc.BetaFeedbackDownloadFile{
+					SubmissionID: item.ID,
+					Kind:         feedbackDownloadKindCrashLog,
+					CreatedDate:  item.Attributes.CreatedDate,
+					DeviceModel:  item.Attributes.DeviceModel,
+					OSVersion:    item.Attributes.OSVersion,
+					FilePath:     filepath.Join(dir, feedbackFileStem(item.ID)+".crash"),
+				}
+				if skipExistingFeedbackFile(&file, *flags.overwrite) {
+					result.Files = append(result.Files, file)
+					continue
+				}
+
+				logResp, err := client.GetBetaFeedbackCrashSubmissionCrashLog(requestCtx, item.ID)
+				if err != nil {
+					return fmt.Errorf("testflight crashes download: failed to fetch crash log for %s: %w", item.ID, err)
+				}
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					return fmt.Errorf("testflight crashes download: failed to create output directory: %w", err)
+				}
+				size, err := shared.SafeWriteFileNoSymlink(
+					file.FilePath,
+					0o600,
+					*flags.overwrite,
+					".asc-crash-*",
+					".asc-crash-backup-*",
+					func(f *os.File) (int64, error) {
+						return io.Copy(f, strings.NewReader(logResp.Data.Attributes.LogText))
+					},
+				)
+				if err != nil {
+					return fmt.Errorf("testflight crashes download: failed to write crash log for %s: %w", item.ID, err)
+				}
+				file.FileSize = size
+				result.Files = append(result.Files, file)
+			}
+
+			return shared.PrintOutput(result, *flags.output.Output, *flags.output.Pretty)
+		},
+	}
+}
+
+// skipExistingFeedbackFile marks file as skipped when it is already on disk and
+// overwrite is off.
+func skipExistingFeedbackFile(file *asc.BetaFeedbackDownloadFile, overwrite bool) bool {
+	if overwrite {
+		return false
+	}
+	info, err := os.Lstat(file.FilePath)
+	if err != nil {
+		return false
+	}
+	file.FileSize = info.Size()
+	file.Skipped = true
+	return true
+}
+
+// feedbackFileStem keeps API-provided submission IDs from escaping the output
+// directory.
+func feedbackFileStem(id string) string {
+	if name := assets.SanitizeBaseFileName(id); name != "" {
+		return name
+	}
+	return "submission"
+}
+
+// screenshotExtension picks the file extension from the screenshot URL path,
+// falling back to .png for signed URLs without one.
+func screenshotExtension(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ".png"
+	}
+	ext := strings.ToLower(path.Ext(parsed.Path))
+	switch ext {
+	case ".png", ".jpg", ".jpeg", ".heic":
+		return ext
+	default:
+		return ".png"
+	}
+}
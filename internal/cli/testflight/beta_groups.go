@@ -389,7 +389,7 @@ Examples:
 				visited["public-link-limit-enabled"] ||
 				visited["public-link-limit"] ||
 				visited["feedback-enabled"]
-			if !hasUpdates {
+			if !hasUpdates && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -497,17 +497,22 @@ func BetaGroupsAddTestersCommand() *ffcli.Command {
 	group := fs.String("group", "", "Beta group ID")
 	tester := fs.String("tester", "", "Beta tester ID(s), comma-separated")
 	email := fs.String("email", "", "Beta tester email(s), comma-separated")
+	file := fs.String("file", "", "CSV file of existing tester emails to add (email column)")
 
 	return &ffcli.Command{
 		Name:       "add-testers",
-		ShortUsage: "asc testflight beta-groups add-testers --group \"GROUP_ID\" [--tester \"TESTER_ID[,TESTER_ID...]\" | --email \"EMAIL[,EMAIL...]\"]",
+		ShortUsage: "asc testflight beta-groups add-testers --group \"GROUP_ID\" [--tester \"TESTER_ID[,TESTER_ID...]\" | --email \"EMAIL[,EMAIL...]\" | --file \"./testers.csv\"]",
 		ShortHelp:  "Add beta testers to a beta group.",
 		LongHelp: `Add beta testers to a beta group.
 
+Testers are sent in batches of 100 per request. Emails from --file are
+resolved with a single paginated listing of the group's app testers.
+
 Examples:
   asc testflight beta-groups add-testers --group "GROUP_ID" --tester "TESTER_ID"
   asc testflight beta-groups add-testers --group "GROUP_ID" --tester "TESTER_ID1,TESTER_ID2"
-  asc testflight beta-groups add-testers --group "GROUP_ID" --email "tester@example.com"`,
+  asc testflight beta-groups add-testers --group "GROUP_ID" --email "tester@example.com"
+  asc testflight beta-groups add-testers --group "GROUP_ID" --file "./testers.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -519,8 +524,9 @@ Examples:
 
 			testerIDs := shared.SplitCSV(*tester)
 			testerEmails := shared.SplitCSV(*email)
-			if len(testerIDs) == 0 && len(testerEmails) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --tester or --email is required")
+			fileValue := strings.TrimSpace(*file)
+			if len(testerIDs) == 0 && len(testerEmails) == 0 && fileValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --tester or --email is required (or use --file)")
 				return flag.ErrHelp
 			}
 
@@ -532,6 +538,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if fileValue != "" {
+				ids, err := resolveBetaGroupTesterFile(requestCtx, client, groupID, fileValue)
+				if err != nil {
+					return fmt.Errorf("beta-groups add-testers: %w", err)
+				}
+				testerIDs = append(testerIDs, ids...)
+			}
+
 			if len(testerEmails) > 0 {
 				groupApp, err := client.GetBetaGroupApp(requestCtx, groupID)
 				if err != nil {
@@ -580,8 +594,10 @@ Examples:
 			}
 			testerIDs = deduped
 
-			if err := client.AddBetaTestersToGroup(requestCtx, groupID, testerIDs); err != nil {
-				return fmt.Errorf("beta-groups add-testers: failed to add testers: %w", err)
+			for _, batch := range batchValues(testerIDs, betaTestersBatchSize) {
+				if err := client.AddBetaTestersToGroup(requestCtx, groupID, batch); err != nil {
+					return fmt.Errorf("beta-groups add-testers: failed to add testers: %w", err)
+				}
 			}
 
 			fmt.Fprintf(os.Stderr, "Successfully added %d tester(s) to group %s\n", len(testerIDs), groupID)
@@ -596,17 +612,21 @@ func BetaGroupsRemoveTestersCommand() *ffcli.Command {
 
 	group := fs.String("group", "", "Beta group ID")
 	tester := fs.String("tester", "", "Beta tester ID(s), comma-separated")
+	file := fs.String("file", "", "CSV file of tester emails to remove (email column)")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
 
 	return &ffcli.Command{
 		Name:       "remove-testers",
-		ShortUsage: "asc testflight beta-groups remove-testers --group \"GROUP_ID\" --tester \"TESTER_ID[,TESTER_ID...]\" --confirm",
+		ShortUsage: "asc testflight beta-groups remove-testers --group \"GROUP_ID\" [--tester \"TESTER_ID[,TESTER_ID...]\" | --file \"./testers.csv\"] --confirm",
 		ShortHelp:  "Remove beta testers from a beta group.",
 		LongHelp: `Remove beta testers from a beta group.
 
+Testers are sent in batches of 100 per request.
+
 Examples:
   asc testflight beta-groups remove-testers --group "GROUP_ID" --tester "TESTER_ID" --confirm
-  asc testflight beta-groups remove-testers --group "GROUP_ID" --tester "TESTER_ID1,TESTER_ID2" --confirm`,
+  asc testflight beta-groups remove-testers --group "GROUP_ID" --tester "TESTER_ID1,TESTER_ID2" --confirm
+  asc testflight beta-groups remove-testers --group "GROUP_ID" --file "./testers.csv" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -617,8 +637,9 @@ Examples:
 			}
 
 			testerIDs := shared.SplitCSV(*tester)
-			if len(testerIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --tester is required")
+			fileValue := strings.TrimSpace(*file)
+			if len(testerIDs) == 0 && fileValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --tester is required (or use --file)")
 				return flag.ErrHelp
 			}
 			if !*confirm {
@@ -634,8 +655,21 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			if err := client.RemoveBetaTestersFromGroup(requestCtx, groupID, testerIDs); err != nil {
-				return fmt.Errorf("beta-groups remove-testers: failed to remove testers: %w", err)
+			if fileValue != "" {
+				ids, err := resolveBetaGroupTesterFile(requestCtx, client, groupID, fileValue)
+				if err != nil {
+					return fmt.Errorf("beta-groups remove-testers: %w", err)
+				}
+				testerIDs = uniqueSortedStrings(append(testerIDs, ids...))
+			}
+			if len(testerIDs) == 0 {
+				return fmt.Errorf("beta-groups remove-testers: no tester IDs resolved")
+			}
+
+			for _, batch := range batchValues(testerIDs, betaTestersBatchSize) {
+				if err := client.RemoveBetaTestersFromGroup(requestCtx, groupID, batch); err != nil {
+					return fmt.Errorf("beta-groups remove-testers: failed to remove testers: %w", err)
+				}
 			}
 
 			fmt.Fprintf(os.Stderr, "Successfully removed %d tester(s) from group %s\n", len(testerIDs), groupID)
@@ -213,6 +213,7 @@ func BetaTestersAddCommand() *ffcli.Command {
 	firstName := fs.String("first-name", "", "Tester first name")
 	lastName := fs.String("last-name", "", "Tester last name")
 	group := fs.String("group", "", "Beta group name or ID")
+	file := fs.String("file", "", "CSV file of testers to add (email,first_name,last_name,groups)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -221,8 +222,14 @@ func BetaTestersAddCommand() *ffcli.Command {
 		ShortHelp:  "Add a TestFlight beta tester.",
 		LongHelp: `Add a TestFlight beta tester.
 
+With --file, every tester in the CSV file is added. The file uses the same
+formats as "beta-testers import"; --group is then optional and is applied to
+every row in addition to the groups column. Existing testers are added to the
+groups, and a summary with per-row failures is printed.
+
 Examples:
-  asc testflight beta-testers add --app "APP_ID" --email "tester@example.com" --group "Beta"`,
+  asc testflight beta-testers add --app "APP_ID" --email "tester@example.com" --group "Beta"
+  asc testflight beta-testers add --app "APP_ID" --file "./testers.csv" --group "Beta"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -231,13 +238,20 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*email) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --email is required")
-				return flag.ErrHelp
-			}
-			if strings.TrimSpace(*group) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --group is required")
-				return flag.ErrHelp
+			fileValue := strings.TrimSpace(*file)
+			if fileValue != "" {
+				if strings.TrimSpace(*email) != "" || strings.TrimSpace(*firstName) != "" || strings.TrimSpace(*lastName) != "" {
+					return shared.UsageError("--file cannot be combined with --email, --first-name, or --last-name")
+				}
+			} else {
+				if strings.TrimSpace(*email) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --email is required (or use --file)")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*group) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --group is required")
+					return flag.ErrHelp
+				}
 			}
 
 			client, err := shared.GetASCClient()
@@ -248,6 +262,31 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if fileValue != "" {
+				summary, err := runBetaTestersImport(requestCtx, client, betaTestersImportOptions{
+					appID:           resolvedAppID,
+					inputPath:       fileValue,
+					group:           *group,
+					continueOnError: true,
+				})
+				if err != nil {
+					return fmt.Errorf("beta-testers add: %w", err)
+				}
+				if err := shared.PrintOutputWithRenderers(
+					summary,
+					*output.Output,
+					*output.Pretty,
+					func() error { return renderImportSummaryTables(summary, false) },
+					func() error { return renderImportSummaryTables(summary, true) },
+				); err != nil {
+					return err
+				}
+				if summary.Failed > 0 {
+					return shared.NewReportedError(fmt.Errorf("beta-testers add: %d row(s) failed", summary.Failed))
+				}
+				return nil
+			}
+
 			groupID, err := resolveBetaGroupID(requestCtx, client, resolvedAppID, *group)
 			if err != nil {
 				return fmt.Errorf("beta-testers add: %w", err)
@@ -269,6 +308,10 @@ func BetaTestersRemoveCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	email := fs.String("email", "", "Tester email address")
+	file := fs.String("file", "", "CSV file of testers to remove (requires --confirm)")
+	group := fs.String("group", "", "With --file: remove testers from this beta group (name or ID) instead of deleting them")
+	dryRun := fs.Bool("dry-run", false, "With --file: report what would be removed without mutating")
+	confirm := fs.Bool("confirm", false, "Confirm bulk removal with --file")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -277,8 +320,16 @@ func BetaTestersRemoveCommand() *ffcli.Command {
 		ShortHelp:  "Remove a TestFlight beta tester.",
 		LongHelp: `Remove a TestFlight beta tester.
 
+With --file, every tester email in the CSV file is removed and a summary is
+printed. Emails without a matching tester are counted as not found. With
+--group, testers are only removed from that group, in batches of 100 per
+request; otherwise each tester is deleted.
+
 Examples:
-  asc testflight beta-testers remove --app "APP_ID" --email "tester@example.com"`,
+  asc testflight beta-testers remove --app "APP_ID" --email "tester@example.com"
+  asc testflight beta-testers remove --app "APP_ID" --file "./testers.csv" --dry-run
+  asc testflight beta-testers remove --app "APP_ID" --file "./testers.csv" --confirm
+  asc testflight beta-testers remove --app "APP_ID" --file "./testers.csv" --group "Beta" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -287,9 +338,23 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*email) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --email is required")
-				return flag.ErrHelp
+			fileValue := strings.TrimSpace(*file)
+			if fileValue != "" {
+				if strings.TrimSpace(*email) != "" {
+					return shared.UsageError("--file cannot be combined with --email")
+				}
+				if !*confirm && !*dryRun {
+					fmt.Fprintln(os.Stderr, "Error: --confirm is required to remove testers from --file")
+					return flag.ErrHelp
+				}
+			} else {
+				if strings.TrimSpace(*email) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --email is required (or use --file)")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*group) != "" || *dryRun {
+					return shared.UsageError("--group and --dry-run require --file")
+				}
 			}
 
 			client, err := shared.GetASCClient()
@@ -300,6 +365,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if fileValue != "" {
+				summary, err := removeBetaTestersFromFile(requestCtx, client, resolvedAppID, fileValue, *group, *dryRun)
+				if err != nil {
+					return fmt.Errorf("beta-testers remove: %w", err)
+				}
+				return printBulkSummary(summary, *output.Output, *output.Pretty, "beta-testers remove")
+			}
+
 			testerID, err := findBetaTesterIDByEmail(requestCtx, client, resolvedAppID, *email)
 			if err != nil {
 				if errors.Is(err, errBetaTesterNotFound) {
@@ -645,6 +718,8 @@ func BetaTestersInviteCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	email := fs.String("email", "", "Tester email address")
 	group := fs.String("group", "", "Beta group name or ID (optional, creates tester if missing)")
+	file := fs.String("file", "", "CSV file of existing testers to invite or re-invite")
+	dryRun := fs.Bool("dry-run", false, "With --file: report who would be invited without sending invitations")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -653,9 +728,15 @@ func BetaTestersInviteCommand() *ffcli.Command {
 		ShortHelp:  "Invite a TestFlight beta tester.",
 		LongHelp: `Invite a TestFlight beta tester.
 
+Inviting an existing tester re-sends the TestFlight invitation email. With
+--file, an invitation is sent to every tester email in the CSV file; emails
+without a tester are reported as failures (add them first with
+"beta-testers add --file").
+
 Examples:
   asc testflight beta-testers invite --app "APP_ID" --email "tester@example.com"
-  asc testflight beta-testers invite --app "APP_ID" --email "tester@example.com" --group "Beta"`,
+  asc testflight beta-testers invite --app "APP_ID" --email "tester@example.com" --group "Beta"
+  asc testflight beta-testers invite --app "APP_ID" --file "./testers.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -664,9 +745,19 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*email) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --email is required")
-				return flag.ErrHelp
+			fileValue := strings.TrimSpace(*file)
+			if fileValue != "" {
+				if strings.TrimSpace(*email) != "" || strings.TrimSpace(*group) != "" {
+					return shared.UsageError("--file cannot be combined with --email or --group")
+				}
+			} else {
+				if strings.TrimSpace(*email) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --email is required (or use --file)")
+					return flag.ErrHelp
+				}
+				if *dryRun {
+					return shared.UsageError("--dry-run requires --file")
+				}
 			}
 
 			client, err := shared.GetASCClient()
@@ -677,6 +768,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if fileValue != "" {
+				summary, err := inviteBetaTestersFromFile(requestCtx, client, resolvedAppID, fileValue, *dryRun)
+				if err != nil {
+					return fmt.Errorf("beta-testers invite: %w", err)
+				}
+				return printBulkSummary(summary, *output.Output, *output.Pretty, "beta-testers invite")
+			}
+
 			emailValue := strings.TrimSpace(*email)
 			groupValue := strings.TrimSpace(*group)
 			testerID, err := findBetaTesterIDByEmail(requestCtx, client, resolvedAppID, emailValue)
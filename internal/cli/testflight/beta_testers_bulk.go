@@ -0,0 +1,296 @@
+package testflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const betaTesterNotFoundDetail = "no tester found for this app"
+
+// betaTestersBatchSize caps the number of testers sent in a single group
+// relationship request so large CSV files are applied in several requests.
+const betaTestersBatchSize = 100
+
+type betaTestersBulkSummary struct {
+	AppID     string `json:"appId"`
+	InputFile string `json:"inputFile"`
+	Action    string `json:"action"`
+	Group     string `json:"group,omitempty"`
+	DryRun    bool   `json:"dryRun"`
+	NotFound  int    `json:"notFound"`
+	asc.BulkResult
+}
+
+type betaTesterBulkTarget struct {
+	row      int
+	email    string
+	testerID string
+}
+
+func (s *betaTestersBulkSummary) fail(row int, email string, err error) {
+	s.Fail(asc.BulkResultItem{Row: row, Item: email}, err)
+}
+
+func (s *betaTestersBulkSummary) succeed(target betaTesterBulkTarget) {
+	s.Succeed(asc.BulkResultItem{Row: target.row, Item: target.email, ID: target.testerID})
+}
+
+func (s *betaTestersBulkSummary) notFound(row int, email string) {
+	s.NotFound++
+	s.Skip(asc.BulkResultItem{Row: row, Item: email}, betaTesterNotFoundDetail)
+}
+
+// loadBetaTesterBulkTargets reads tester emails from a CSV file and resolves
+// them to tester IDs with a single paginated listing of the app's testers.
+// Invalid and duplicate rows are recorded as failures. Emails without a
+// tester are counted as not found and recorded as skipped, or as failures
+// when missingIsFailure is set.
+func loadBetaTesterBulkTargets(ctx context.Context, client *asc.Client, appID, inputPath string, summary *betaTestersBulkSummary, missingIsFailure bool) ([]betaTesterBulkTarget, error) {
+	rows, err := readBetaTestersCSV(inputPath)
+	if err != nil {
+		return nil, err
+	}
+	summary.InputFile = filepath.Clean(inputPath)
+	summary.Total = len(rows)
+
+	existingByEmail, err := fetchExistingTestersByEmail(ctx, client, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]int)
+	targets := make([]betaTesterBulkTarget, 0, len(rows))
+	for idx, row := range rows {
+		rowNumber := idx + 1
+		emailValue := strings.TrimSpace(row.email)
+		if emailValue == "" {
+			summary.fail(rowNumber, "", errors.New("email is required"))
+			continue
+		}
+		if !isValidTesterEmail(emailValue) {
+			summary.fail(rowNumber, emailValue, errors.New("invalid email format"))
+			continue
+		}
+		emailLower := strings.ToLower(emailValue)
+		if firstSeen, exists := seen[emailLower]; exists {
+			summary.fail(rowNumber, emailValue, fmt.Errorf("duplicate email in input (already seen at row %d)", firstSeen))
+			continue
+		}
+		seen[emailLower] = rowNumber
+
+		testerID, ok := existingByEmail[emailLower]
+		if !ok {
+			if missingIsFailure {
+				summary.NotFound++
+				summary.fail(rowNumber, emailValue, errors.New(betaTesterNotFoundDetail))
+			} else {
+				summary.notFound(rowNumber, emailValue)
+			}
+			continue
+		}
+		targets = append(targets, betaTesterBulkTarget{row: rowNumber, email: emailValue, testerID: testerID})
+	}
+	return targets, nil
+}
+
+// batchValues splits values into chunks of at most size entries.
+func batchValues[T any](values []T, size int) [][]T {
+	if size <= 0 {
+		size = betaTestersBatchSize
+	}
+	batches := make([][]T, 0, (len(values)+size-1)/size)
+	for start := 0; start < len(values); start += size {
+		end := min(start+size, len(values))
+		batches = append(batches, values[start:end])
+	}
+	return batches
+}
+
+func betaTesterTargetIDs(targets []betaTesterBulkTarget) []string {
+	ids := make([]string, 0, len(targets))
+	for _, target := range targets {
+		ids = append(ids, target.testerID)
+	}
+	return ids
+}
+
+func renderBulkSummaryTables(summary *betaTestersBulkSummary, markdown bool) error {
+	if summary == nil {
+		return fmt.Errorf("summary is nil")
+	}
+
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"App ID", "Input File", "Action", "Group", "Dry Run", "Total", "Succeeded", "Not Found", "Failed", "Skipped"},
+		[][]string{{
+			summary.AppID,
+			summary.InputFile,
+			summary.Action,
+			summary.Group,
+			fmt.Sprintf("%t", summary.DryRun),
+			fmt.Sprintf("%d", summary.Total),
+			fmt.Sprintf("%d", summary.Succeeded),
+			fmt.Sprintf("%d", summary.NotFound),
+			fmt.Sprintf("%d", summary.Failed),
+			fmt.Sprintf("%d", summary.Skipped),
+		}},
+	)
+
+	if len(summary.Items) > 0 {
+		render(asc.BulkResultRows(&summary.BulkResult))
+	}
+
+	return nil
+}
+
+// printBulkSummary writes the summary and returns a reported error when any
+// row failed, so the failure is not printed twice.
+func printBulkSummary(summary *betaTestersBulkSummary, format string, pretty bool, commandName string) error {
+	if err := shared.PrintOutputWithRenderers(
+		summary,
+		format,
+		pretty,
+		func() error { return renderBulkSummaryTables(summary, false) },
+		func() error { return renderBulkSummaryTables(summary, true) },
+	); err != nil {
+		return err
+	}
+	if summary.Failed > 0 {
+		return shared.NewReportedError(fmt.Errorf("%s: %d row(s) failed", commandName, summary.Failed))
+	}
+	return nil
+}
+
+// removeBetaTestersFromFile deletes the testers listed in a CSV file, or
+// removes them from groupValue in batched relationship requests when a group
+// is given.
+func removeBetaTestersFromFile(ctx context.Context, client *asc.Client, appID, inputPath, groupValue string, dryRun bool) (*betaTestersBulkSummary, error) {
+	summary := &betaTestersBulkSummary{
+		AppID:  appID,
+		Action: "delete",
+		DryRun: dryRun,
+	}
+
+	groupID := ""
+	if strings.TrimSpace(groupValue) != "" {
+		resolved, err := resolveBetaGroupID(ctx, client, appID, groupValue)
+		if err != nil {
+			return nil, err
+		}
+		groupID = resolved
+		summary.Action = "remove-from-group"
+		summary.Group = strings.TrimSpace(groupValue)
+	}
+
+	targets, err := loadBetaTesterBulkTargets(ctx, client, appID, inputPath, summary, false)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		for _, target := range targets {
+			summary.succeed(target)
+		}
+		return summary, nil
+	}
+
+	if groupID != "" {
+		for _, batch := range batchValues(targets, betaTestersBatchSize) {
+			if err := client.RemoveBetaTestersFromGroup(ctx, groupID, betaTesterTargetIDs(batch)); err != nil {
+				for _, target := range batch {
+					summary.fail(target.row, target.email, err)
+				}
+				continue
+			}
+			for _, target := range batch {
+				summary.succeed(target)
+			}
+		}
+		return summary, nil
+	}
+
+	for _, target := range targets {
+		if err := client.DeleteBetaTester(ctx, target.testerID); err != nil {
+			if asc.IsNotFound(err) {
+				summary.notFound(target.row, target.email)
+				continue
+			}
+			summary.fail(target.row, target.email, err)
+			continue
+		}
+		summary.succeed(target)
+	}
+	return summary, nil
+}
+
+// inviteBetaTestersFromFile sends (or re-sends) TestFlight invitations to the
+// existing testers listed in a CSV file.
+func inviteBetaTestersFromFile(ctx context.Context, client *asc.Client, appID, inputPath string, dryRun bool) (*betaTestersBulkSummary, error) {
+	summary := &betaTestersBulkSummary{
+		AppID:  appID,
+		Action: "invite",
+		DryRun: dryRun,
+	}
+
+	targets, err := loadBetaTesterBulkTargets(ctx, client, appID, inputPath, summary, true)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		for _, target := range targets {
+			summary.succeed(target)
+		}
+		return summary, nil
+	}
+
+	for _, target := range targets {
+		invitation, err := client.CreateBetaTesterInvitation(ctx, appID, target.testerID)
+		if err != nil {
+			summary.fail(target.row, target.email, err)
+			continue
+		}
+		if invitation == nil || strings.TrimSpace(invitation.Data.ID) == "" {
+			summary.fail(target.row, target.email, errors.New("invitation returned empty id"))
+			continue
+		}
+		summary.succeed(target)
+	}
+	return summary, nil
+}
+
+// resolveBetaGroupTesterFile resolves the tester emails in a CSV file to
+// tester IDs for the app that owns groupID. Unknown or invalid emails are an
+// error so partial group changes are never applied.
+func resolveBetaGroupTesterFile(ctx context.Context, client *asc.Client, groupID, inputPath string) ([]string, error) {
+	groupApp, err := client.GetBetaGroupApp(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve app for group: %w", err)
+	}
+	appID := strings.TrimSpace(groupApp.Data.ID)
+	if appID == "" {
+		return nil, fmt.Errorf("group %q has empty app ID", groupID)
+	}
+
+	summary := &betaTestersBulkSummary{AppID: appID}
+	targets, err := loadBetaTesterBulkTargets(ctx, client, appID, inputPath, summary, true)
+	if err != nil {
+		return nil, err
+	}
+	if failures := summary.FailedItems(); len(failures) > 0 {
+		problems := make([]string, 0, len(failures))
+		for _, failure := range failures {
+			problems = append(problems, fmt.Sprintf("row %d %s: %s", failure.Row, failure.Item, failure.Error))
+		}
+		return nil, fmt.Errorf("%s: %s", filepath.Clean(inputPath), strings.Join(problems, "; "))
+	}
+	return betaTesterTargetIDs(targets), nil
+}
@@ -33,27 +33,19 @@ type betaTestersExportSummary struct {
 	IncludeGroups bool   `json:"includeGroups"`
 }
 
-type betaTestersImportFailure struct {
-	Row   int    `json:"row"`
-	Email string `json:"email,omitempty"`
-	Error string `json:"error"`
-}
-
 type betaTestersImportSummary struct {
-	AppID           string                     `json:"appId"`
-	InputFile       string                     `json:"inputFile"`
-	DryRun          bool                       `json:"dryRun"`
-	Invite          bool                       `json:"invite"`
-	SkipExisting    bool                       `json:"skipExisting"`
-	ContinueOnError bool                       `json:"continueOnError"`
-	AppliedGroup    string                     `json:"appliedGroup,omitempty"`
-	Total           int                        `json:"total"`
-	Created         int                        `json:"created"`
-	Existed         int                        `json:"existed"`
-	Updated         int                        `json:"updated"`
-	Invited         int                        `json:"invited"`
-	Failed          int                        `json:"failed"`
-	Failures        []betaTestersImportFailure `json:"failures,omitempty"`
+	AppID           string `json:"appId"`
+	InputFile       string `json:"inputFile"`
+	DryRun          bool   `json:"dryRun"`
+	Invite          bool   `json:"invite"`
+	SkipExisting    bool   `json:"skipExisting"`
+	ContinueOnError bool   `json:"continueOnError"`
+	AppliedGroup    string `json:"appliedGroup,omitempty"`
+	Created         int    `json:"created"`
+	Existed         int    `json:"existed"`
+	Updated         int    `json:"updated"`
+	Invited         int    `json:"invited"`
+	asc.BulkResult
 }
 
 // BetaTestersExportCommand writes beta testers to a CSV file.
@@ -300,236 +292,231 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			parsedRows, err := readBetaTestersCSV(inputValue)
+			summary, err := runBetaTestersImport(requestCtx, client, betaTestersImportOptions{
+				appID:           resolvedAppID,
+				inputPath:       inputValue,
+				group:           *group,
+				dryRun:          *dryRun,
+				invite:          *invite,
+				skipExisting:    *skipExisting,
+				continueOnError: *continueOnError,
+			})
 			if err != nil {
 				return fmt.Errorf("beta-testers import: %w", err)
 			}
 
-			appliedGroupValue := strings.TrimSpace(*group)
-			needsGroups := appliedGroupValue != ""
-			if !needsGroups {
-				for _, r := range parsedRows {
-					if len(r.groups) > 0 {
-						needsGroups = true
-						break
-					}
-				}
+			// Always print a machine-readable summary. If any rows failed, return an error
+			// that won't be re-printed by the main entrypoint.
+			if err := shared.PrintOutputWithRenderers(
+				summary,
+				*format.Output,
+				*format.Pretty,
+				func() error { return renderImportSummaryTables(summary, false) },
+				func() error { return renderImportSummaryTables(summary, true) },
+			); err != nil {
+				return err
 			}
 
-			var groupResolver *betaGroupResolver
-			appliedGroupID := ""
-			if needsGroups {
-				groupResolver, err = newBetaGroupResolver(requestCtx, client, resolvedAppID)
-				if err != nil {
-					return fmt.Errorf("beta-testers import: %w", err)
-				}
+			if summary.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("beta-testers import: %d row(s) failed", summary.Failed))
+			}
+			return nil
+		},
+	}
+}
 
-				if appliedGroupValue != "" {
-					id, err := groupResolver.Resolve(appliedGroupValue)
-					if err != nil {
-						return fmt.Errorf("beta-testers import: %w", err)
-					}
-					appliedGroupID = id
-				}
+type betaTestersImportOptions struct {
+	appID           string
+	inputPath       string
+	group           string
+	dryRun          bool
+	invite          bool
+	skipExisting    bool
+	continueOnError bool
+}
+
+// runBetaTestersImport applies the testers in a CSV file to an app and
+// returns a per-row summary. Row failures are recorded in the summary rather
+// than returned as errors.
+func runBetaTestersImport(ctx context.Context, client *asc.Client, opts betaTestersImportOptions) (*betaTestersImportSummary, error) {
+	parsedRows, err := readBetaTestersCSV(opts.inputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedGroupValue := strings.TrimSpace(opts.group)
+	needsGroups := appliedGroupValue != ""
+	if !needsGroups {
+		for _, r := range parsedRows {
+			if len(r.groups) > 0 {
+				needsGroups = true
+				break
 			}
+		}
+	}
+
+	var groupResolver *betaGroupResolver
+	appliedGroupID := ""
+	if needsGroups {
+		groupResolver, err = newBetaGroupResolver(ctx, client, opts.appID)
+		if err != nil {
+			return nil, err
+		}
 
-			existingByEmail, err := fetchExistingTestersByEmail(requestCtx, client, resolvedAppID)
+		if appliedGroupValue != "" {
+			id, err := groupResolver.Resolve(appliedGroupValue)
 			if err != nil {
-				return fmt.Errorf("beta-testers import: %w", err)
+				return nil, err
 			}
+			appliedGroupID = id
+		}
+	}
+
+	existingByEmail, err := fetchExistingTestersByEmail(ctx, client, opts.appID)
+	if err != nil {
+		return nil, err
+	}
+
+	seenInput := make(map[string]int) // emailLower -> first row index seen
+	summary := &betaTestersImportSummary{
+		AppID:           opts.appID,
+		InputFile:       filepath.Clean(opts.inputPath),
+		DryRun:          opts.dryRun,
+		Invite:          opts.invite,
+		SkipExisting:    opts.skipExisting,
+		ContinueOnError: opts.continueOnError,
+		AppliedGroup:    appliedGroupValue,
+	}
+	summary.Total = len(parsedRows)
+
+	for idx, row := range parsedRows {
+		rowNumber := idx + 1 // 1-based data row index (excluding header)
 
-			seenInput := make(map[string]int) // emailLower -> first row index seen
-			summary := &betaTestersImportSummary{
-				AppID:           resolvedAppID,
-				InputFile:       filepath.Clean(inputValue),
-				DryRun:          *dryRun,
-				Invite:          *invite,
-				SkipExisting:    *skipExisting,
-				ContinueOnError: *continueOnError,
-				AppliedGroup:    appliedGroupValue,
-				Total:           len(parsedRows),
+		emailValue := strings.TrimSpace(row.email)
+		if emailValue == "" {
+			summary.Fail(asc.BulkResultItem{Row: rowNumber}, errors.New("email is required"))
+			if !opts.continueOnError {
+				break
+			}
+			continue
+		}
+		if !isValidTesterEmail(emailValue) {
+			summary.Fail(asc.BulkResultItem{Row: rowNumber, Item: emailValue}, errors.New("invalid email format"))
+			if !opts.continueOnError {
+				break
 			}
+			continue
+		}
 
-			for idx, row := range parsedRows {
-				rowNumber := idx + 1 // 1-based data row index (excluding header)
-
-				emailValue := strings.TrimSpace(row.email)
-				if emailValue == "" {
-					summary.Failed++
-					summary.Failures = append(summary.Failures, betaTestersImportFailure{
-						Row:   rowNumber,
-						Error: "email is required",
-					})
-					if !*continueOnError {
-						break
-					}
-					continue
-				}
-				if !isValidTesterEmail(emailValue) {
-					summary.Failed++
-					summary.Failures = append(summary.Failures, betaTestersImportFailure{
-						Row:   rowNumber,
-						Email: emailValue,
-						Error: "invalid email format",
-					})
-					if !*continueOnError {
-						break
-					}
-					continue
-				}
+		emailLower := strings.ToLower(emailValue)
+		if firstSeen, exists := seenInput[emailLower]; exists {
+			summary.Fail(asc.BulkResultItem{Row: rowNumber, Item: emailValue}, fmt.Errorf("duplicate email in input (already seen at row %d)", firstSeen))
+			if !opts.continueOnError {
+				break
+			}
+			continue
+		}
+		seenInput[emailLower] = rowNumber
 
-				emailLower := strings.ToLower(emailValue)
-				if firstSeen, exists := seenInput[emailLower]; exists {
-					summary.Failed++
-					summary.Failures = append(summary.Failures, betaTestersImportFailure{
-						Row:   rowNumber,
-						Email: emailValue,
-						Error: fmt.Sprintf("duplicate email in input (already seen at row %d)", firstSeen),
-					})
-					if !*continueOnError {
-						break
-					}
-					continue
-				}
-				seenInput[emailLower] = rowNumber
-
-				var groupIDs []string
-				if needsGroups {
-					groupIDs, err = groupResolver.ResolveAll(row.groups)
-					if err != nil {
-						summary.Failed++
-						summary.Failures = append(summary.Failures, betaTestersImportFailure{
-							Row:   rowNumber,
-							Email: emailValue,
-							Error: err.Error(),
-						})
-						if !*continueOnError {
-							break
-						}
-						continue
-					}
-				}
-				if appliedGroupID != "" {
-					groupIDs = append(groupIDs, appliedGroupID)
-					groupIDs = uniqueSortedStrings(groupIDs)
+		var groupIDs []string
+		if needsGroups {
+			groupIDs, err = groupResolver.ResolveAll(row.groups)
+			if err != nil {
+				summary.Fail(asc.BulkResultItem{Row: rowNumber, Item: emailValue}, err)
+				if !opts.continueOnError {
+					break
 				}
+				continue
+			}
+		}
+		if appliedGroupID != "" {
+			groupIDs = append(groupIDs, appliedGroupID)
+			groupIDs = uniqueSortedStrings(groupIDs)
+		}
 
-				if testerID, ok := existingByEmail[emailLower]; ok {
-					summary.Existed++
+		if testerID, ok := existingByEmail[emailLower]; ok {
+			summary.Existed++
+			item := asc.BulkResultItem{Row: rowNumber, Item: emailValue, ID: testerID, Detail: "updated"}
 
-					if *skipExisting || len(groupIDs) == 0 {
-						continue
-					}
+			if opts.skipExisting || len(groupIDs) == 0 {
+				summary.Skip(item, "tester already exists")
+				continue
+			}
 
-					if *dryRun {
-						summary.Updated++
-						continue
-					}
+			if opts.dryRun {
+				summary.Updated++
+				summary.Succeed(item)
+				continue
+			}
 
-					if err := client.AddBetaTesterToGroups(requestCtx, testerID, groupIDs); err != nil {
-						if errors.Is(err, asc.ErrConflict) {
-							// Relationship already exists; treat as idempotent success.
-							summary.Updated++
-							continue
-						}
-						summary.Failed++
-						summary.Failures = append(summary.Failures, betaTestersImportFailure{
-							Row:   rowNumber,
-							Email: emailValue,
-							Error: err.Error(),
-						})
-						if !*continueOnError {
-							break
-						}
-						continue
-					}
+			if err := client.AddBetaTesterToGroups(ctx, testerID, groupIDs); err != nil {
+				if errors.Is(err, asc.ErrConflict) {
+					// Relationship already exists; treat as idempotent success.
 					summary.Updated++
+					summary.Succeed(item)
 					continue
 				}
-
-				if *dryRun {
-					summary.Created++
-					continue
+				summary.Fail(asc.BulkResultItem{Row: rowNumber, Item: emailValue, ID: testerID}, err)
+				if !opts.continueOnError {
+					break
 				}
+				continue
+			}
+			summary.Updated++
+			summary.Succeed(item)
+			continue
+		}
 
-				created, err := client.CreateBetaTester(requestCtx, emailValue, row.firstName, row.lastName, groupIDs)
-				if err != nil {
-					summary.Failed++
-					summary.Failures = append(summary.Failures, betaTestersImportFailure{
-						Row:   rowNumber,
-						Email: emailValue,
-						Error: err.Error(),
-					})
-					if !*continueOnError {
-						break
-					}
-					continue
-				}
+		if opts.dryRun {
+			summary.Created++
+			summary.Succeed(asc.BulkResultItem{Row: rowNumber, Item: emailValue, Detail: "created"})
+			continue
+		}
 
-				testerID := strings.TrimSpace(created.Data.ID)
-				if testerID == "" {
-					summary.Failed++
-					summary.Failures = append(summary.Failures, betaTestersImportFailure{
-						Row:   rowNumber,
-						Email: emailValue,
-						Error: "created tester returned empty id",
-					})
-					if !*continueOnError {
-						break
-					}
-					continue
-				}
-				summary.Created++
-				existingByEmail[emailLower] = testerID
-
-				if *invite {
-					invitation, err := client.CreateBetaTesterInvitation(requestCtx, resolvedAppID, testerID)
-					if err != nil {
-						summary.Failed++
-						summary.Failures = append(summary.Failures, betaTestersImportFailure{
-							Row:   rowNumber,
-							Email: emailValue,
-							Error: err.Error(),
-						})
-						if !*continueOnError {
-							break
-						}
-						continue
-					}
-					if invitation == nil || strings.TrimSpace(invitation.Data.ID) == "" {
-						summary.Failed++
-						summary.Failures = append(summary.Failures, betaTestersImportFailure{
-							Row:   rowNumber,
-							Email: emailValue,
-							Error: "invitation returned empty id",
-						})
-						if !*continueOnError {
-							break
-						}
-						continue
-					}
-					summary.Invited++
-				}
+		created, err := client.CreateBetaTester(ctx, emailValue, row.firstName, row.lastName, groupIDs)
+		if err != nil {
+			summary.Fail(asc.BulkResultItem{Row: rowNumber, Item: emailValue}, err)
+			if !opts.continueOnError {
+				break
 			}
+			continue
+		}
 
-			// Always print a machine-readable summary. If any rows failed, return an error
-			// that won't be re-printed by the main entrypoint.
-			if err := shared.PrintOutputWithRenderers(
-				summary,
-				*format.Output,
-				*format.Pretty,
-				func() error { return renderImportSummaryTables(summary, false) },
-				func() error { return renderImportSummaryTables(summary, true) },
-			); err != nil {
-				return err
+		testerID := strings.TrimSpace(created.Data.ID)
+		if testerID == "" {
+			summary.Fail(asc.BulkResultItem{Row: rowNumber, Item: emailValue}, errors.New("created tester returned empty id"))
+			if !opts.continueOnError {
+				break
 			}
+			continue
+		}
+		summary.Created++
+		existingByEmail[emailLower] = testerID
+		item := asc.BulkResultItem{Row: rowNumber, Item: emailValue, ID: testerID, Detail: "created"}
 
-			if summary.Failed > 0 {
-				return shared.NewReportedError(fmt.Errorf("beta-testers import: %d row(s) failed", summary.Failed))
+		if opts.invite {
+			invitation, err := client.CreateBetaTesterInvitation(ctx, opts.appID, testerID)
+			if err != nil {
+				summary.Fail(item, err)
+				if !opts.continueOnError {
+					break
+				}
+				continue
 			}
-			return nil
-		},
+			if invitation == nil || strings.TrimSpace(invitation.Data.ID) == "" {
+				summary.Fail(item, errors.New("invitation returned empty id"))
+				if !opts.continueOnError {
+					break
+				}
+				continue
+			}
+			summary.Invited++
+			item.Detail = "created, invited"
+		}
+		summary.Succeed(item)
 	}
+
+	return summary, nil
 }
 
 func renderImportSummaryTables(summary *betaTestersImportSummary, markdown bool) error {
@@ -543,7 +530,7 @@ func renderImportSummaryTables(summary *betaTestersImportSummary, markdown bool)
 	}
 
 	render(
-		[]string{"App ID", "Input File", "Dry Run", "Total", "Created", "Existed", "Updated", "Invited", "Failed"},
+		[]string{"App ID", "Input File", "Dry Run", "Total", "Created", "Existed", "Updated", "Invited", "Failed", "Skipped"},
 		[][]string{{
 			summary.AppID,
 			summary.InputFile,
@@ -554,19 +541,12 @@ func renderImportSummaryTables(summary *betaTestersImportSummary, markdown bool)
 			fmt.Sprintf("%d", summary.Updated),
 			fmt.Sprintf("%d", summary.Invited),
 			fmt.Sprintf("%d", summary.Failed),
+			fmt.Sprintf("%d", summary.Skipped),
 		}},
 	)
 
-	if len(summary.Failures) > 0 {
-		rows := make([][]string, 0, len(summary.Failures))
-		for _, f := range summary.Failures {
-			rows = append(rows, []string{
-				fmt.Sprintf("%d", f.Row),
-				f.Email,
-				f.Error,
-			})
-		}
-		render([]string{"Row", "Email", "Error"}, rows)
+	if len(summary.Items) > 0 {
+		render(asc.BulkResultRows(&summary.BulkResult))
 	}
 
 	return nil
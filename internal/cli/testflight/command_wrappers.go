@@ -972,7 +972,7 @@ func deprecatedPreReleaseRelationshipsAliasCommand() *ffcli.Command {
 		viewCmd.UsageFunc = shared.DeprecatedUsageFunc
 		origExec := viewCmd.Exec
 		viewCmd.Exec = func(ctx context.Context, args []string) error {
-			fmt.Fprintln(os.Stderr, "Warning: `asc testflight pre-release relationships view` is deprecated. Use `asc testflight pre-release links view`.")
+			fmt.Fprintln(shared.WarningWriter(), "Warning: `asc testflight pre-release relationships view` is deprecated. Use `asc testflight pre-release links view`.")
 			return origExec(ctx, args)
 		}
 	}
@@ -5,6 +5,8 @@ import (
 	"flag"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/publish"
 )
 
 // TestFlightCommand returns the testflight command with subcommands.
@@ -18,8 +20,13 @@ func TestFlightCommand() *ffcli.Command {
 		LongHelp: `Manage TestFlight workflows.
 
 Examples:
+  asc testflight distribute --app "APP_ID" --ipa app.ipa --group "Internal" --whats-new "Bug fixes"
+  asc testflight promote --app "APP_ID" --build "BUILD_ID" --group "Public Beta" --confirm
+  asc testflight review-detail set --app "APP_ID" --contact-email "dev@example.com"
+  asc testflight submit --build "BUILD_ID" --confirm --wait
   asc testflight groups list --app "APP_ID"
   asc testflight testers list --app "APP_ID"
+  asc testflight whats-new set --build "BUILD_ID" --dir "./whatsnew"
   asc testflight feedback list --app "APP_ID"
   asc testflight crashes view --submission-id "SUBMISSION_ID"
   asc testflight crashes log --submission-id "SUBMISSION_ID"
@@ -37,7 +44,12 @@ Examples:
 		Subcommands: []*ffcli.Command{
 			RemovedTestFlightAppsCommand(),
 			TestFlightGroupsCommand(),
+			publish.TestFlightDistributeCommand(),
+			TestFlightPromoteCommand(),
+			TestFlightSubmitCommand(),
+			TestFlightReviewDetailCommand(),
 			TestFlightTestersCommand(),
+			TestFlightWhatsNewCommand(),
 			TestFlightFeedbackCommand(),
 			TestFlightCrashesCommand(),
 			TestFlightAgreementsCommand(),
@@ -0,0 +1,273 @@
+package testflight
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const defaultPromoteLocale = "en-US"
+
+// External build states that mean beta app review has already been requested
+// or granted, so promotion must not submit again.
+var promoteReviewedExternalStates = map[string]bool{
+	"WAITING_FOR_BETA_REVIEW": true,
+	"IN_BETA_REVIEW":          true,
+	"BETA_APPROVED":           true,
+	"READY_FOR_BETA_TESTING":  true,
+	"IN_BETA_TESTING":         true,
+}
+
+// External build states that cannot be promoted.
+var promoteBlockedExternalStates = map[string]bool{
+	"BETA_REJECTED":        true,
+	"EXPIRED":              true,
+	"PROCESSING_EXCEPTION": true,
+}
+
+type testFlightPromoteResult struct {
+	BuildID                 string   `json:"buildId"`
+	BuildNumber             string   `json:"buildNumber,omitempty"`
+	From                    string   `json:"from"`
+	To                      string   `json:"to"`
+	UsesNonExemptEncryption *bool    `json:"usesNonExemptEncryption,omitempty"`
+	ComplianceUpdated       bool     `json:"complianceUpdated"`
+	WhatsNewLocale          string   `json:"whatsNewLocale,omitempty"`
+	AddedGroupIDs           []string `json:"addedGroupIds"`
+	RemovedGroupIDs         []string `json:"removedGroupIds"`
+	Notified                bool     `json:"notified"`
+	ExternalBuildState      string   `json:"externalBuildState,omitempty"`
+	ReviewSubmitted         bool     `json:"reviewSubmitted"`
+	ReviewSubmissionID      string   `json:"reviewSubmissionId,omitempty"`
+}
+
+// TestFlightPromoteCommand returns the testflight promote subcommand.
+func TestFlightPromoteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promote", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	buildID := fs.String("build", "", "Build ID to promote")
+	from := fs.String("from", "internal", "Current stage: internal")
+	to := fs.String("to", "external", "Target stage: external")
+	groups := fs.String("group", "", "External beta group ID(s) or name(s) to add the build to, comma-separated")
+	removeGroups := fs.String("remove-group", "", "Beta group ID(s) or name(s) to remove the build from, comma-separated")
+	var usesNonExemptEncryption shared.OptionalBool
+	fs.Var(&usesNonExemptEncryption, "uses-non-exempt-encryption", "Export compliance answer for the build: true or false (required if not yet answered)")
+	whatsNew := fs.String("whats-new", "", "What to Test notes for the build")
+	locale := fs.String("locale", defaultPromoteLocale, "Locale for --whats-new")
+	notify := fs.Bool("notify", false, "Notify testers after adding to groups")
+	confirm := fs.Bool("confirm", false, "Confirm promoting the build (may submit for beta app review)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "promote",
+		ShortUsage: "asc testflight promote --app APP_ID --build BUILD_ID --group GROUP --confirm [flags]",
+		ShortHelp:  "Promote a build from internal to external testing in one step.",
+		LongHelp: `Promote a build from internal to external testing in one step.
+
+Steps:
+1. Check the build finished processing and has not expired
+2. Answer export compliance (with --uses-non-exempt-encryption) if needed
+3. Set What to Test notes (with --whats-new)
+4. Add the build to the external beta groups, optionally notifying testers
+5. Submit for beta app review unless the build is already in or past review
+6. Remove the build from --remove-group groups
+
+Only the internal to external promotion is currently supported.
+
+Examples:
+  asc testflight promote --app "APP_ID" --build "BUILD_ID" --group "Public Beta" --confirm
+  asc testflight promote --app "APP_ID" --build "BUILD_ID" --from internal --to external --group "Public Beta" --uses-non-exempt-encryption false --confirm
+  asc testflight promote --app "APP_ID" --build "BUILD_ID" --group "Public Beta" --whats-new "New onboarding" --notify --confirm
+  asc testflight promote --app "APP_ID" --build "BUILD_ID" --group "Public Beta" --remove-group "QA" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			buildValue := strings.TrimSpace(*buildID)
+			if buildValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --build is required")
+				return flag.ErrHelp
+			}
+			fromValue := strings.ToLower(strings.TrimSpace(*from))
+			toValue := strings.ToLower(strings.TrimSpace(*to))
+			if fromValue != "internal" || toValue != "external" {
+				return shared.UsageErrorf("unsupported promotion %q -> %q (supported: --from internal --to external)", fromValue, toValue)
+			}
+			groupValues := shared.SplitCSV(*groups)
+			if len(groupValues) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --group is required")
+				return flag.ErrHelp
+			}
+			removeValues := shared.SplitCSV(*removeGroups)
+
+			whatsNewValue := strings.TrimSpace(*whatsNew)
+			localeValue := strings.TrimSpace(*locale)
+			if whatsNewValue != "" {
+				if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
+					return shared.UsageError(err.Error())
+				}
+			} else if localeValue != defaultPromoteLocale {
+				return shared.UsageError("--locale requires --whats-new")
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight promote: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			targetGroups, err := shared.ResolveBetaGroups(requestCtx, client, resolvedAppID, groupValues, shared.ResolveBetaGroupsOptions{})
+			if err != nil {
+				return fmt.Errorf("testflight promote: %w", err)
+			}
+			for _, group := range targetGroups {
+				if group.IsInternalGroup {
+					return shared.UsageErrorf("--group %q is an internal group; promotion targets external groups", group.NameForDisplay())
+				}
+			}
+			var groupsToRemove []shared.ResolvedBetaGroup
+			if len(removeValues) > 0 {
+				groupsToRemove, err = shared.ResolveBetaGroups(requestCtx, client, resolvedAppID, removeValues, shared.ResolveBetaGroupsOptions{})
+				if err != nil {
+					return fmt.Errorf("testflight promote: %w", err)
+				}
+			}
+
+			build, err := client.GetBuild(requestCtx, buildValue)
+			if err != nil {
+				return fmt.Errorf("testflight promote: failed to fetch build: %w", err)
+			}
+			attrs := build.Data.Attributes
+			if attrs.Expired {
+				return fmt.Errorf("testflight promote: build %q has expired", buildValue)
+			}
+			if state := strings.TrimSpace(attrs.ProcessingState); state != "" && state != asc.BuildProcessingStateValid {
+				return fmt.Errorf("testflight promote: build %q is %s; wait for processing to finish", buildValue, state)
+			}
+
+			result := &testFlightPromoteResult{
+				BuildID:         buildValue,
+				BuildNumber:     attrs.Version,
+				From:            fromValue,
+				To:              toValue,
+				AddedGroupIDs:   []string{},
+				RemovedGroupIDs: []string{},
+				Notified:        *notify,
+			}
+
+			// External testing requires an export compliance answer.
+			switch {
+			case usesNonExemptEncryption.IsSet():
+				previous := attrs.UsesNonExemptEncryption
+				compliance := &shared.ComplianceFlag{}
+				compliance.SetUsesNonExemptEncryption(usesNonExemptEncryption.Value())
+				value, err := shared.ApplyBuildCompliance(requestCtx, client, build, compliance)
+				if err != nil {
+					return fmt.Errorf("testflight promote: %w", err)
+				}
+				result.ComplianceUpdated = previous == nil || *previous != *value
+				result.UsesNonExemptEncryption = value
+			case attrs.UsesNonExemptEncryption == nil:
+				return shared.UsageErrorf("build %q has no export compliance answer; pass --uses-non-exempt-encryption", buildValue)
+			default:
+				result.UsesNonExemptEncryption = attrs.UsesNonExemptEncryption
+			}
+
+			betaDetail, err := client.GetBuildBuildBetaDetail(requestCtx, buildValue)
+			if err != nil {
+				return fmt.Errorf("testflight promote: failed to fetch beta details: %w", err)
+			}
+			externalState := strings.TrimSpace(betaDetail.Data.Attributes.ExternalBuildState)
+			if promoteBlockedExternalStates[externalState] {
+				return fmt.Errorf("testflight promote: build %q cannot be promoted (external state %s)", buildValue, externalState)
+			}
+			result.ExternalBuildState = externalState
+
+			if whatsNewValue != "" {
+				if _, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildValue, localeValue, whatsNewValue); err != nil {
+					return fmt.Errorf("testflight promote: %w", err)
+				}
+				result.WhatsNewLocale = localeValue
+			}
+
+			addResult, err := shared.AddBuildBetaGroups(requestCtx, client, buildValue, targetGroups, shared.AddBuildBetaGroupsOptions{
+				Notify: *notify,
+			})
+			if err != nil {
+				return fmt.Errorf("testflight promote: failed to add groups: %w", err)
+			}
+			result.AddedGroupIDs = addResult.AddedGroupIDs
+
+			if !promoteReviewedExternalStates[externalState] {
+				submission, err := client.CreateBetaAppReviewSubmission(requestCtx, buildValue)
+				if err != nil {
+					return fmt.Errorf("testflight promote: build was added to groups but beta review submission failed (retry with: asc testflight review submit --build %q --confirm): %w", buildValue, err)
+				}
+				result.ReviewSubmitted = true
+				result.ReviewSubmissionID = submission.Data.ID
+			}
+
+			if len(groupsToRemove) > 0 {
+				removeIDs := make([]string, 0, len(groupsToRemove))
+				for _, group := range groupsToRemove {
+					removeIDs = append(removeIDs, group.ID)
+				}
+				if err := client.RemoveBetaGroupsFromBuild(requestCtx, buildValue, removeIDs); err != nil {
+					return fmt.Errorf("testflight promote: failed to remove groups: %w", err)
+				}
+				result.RemovedGroupIDs = removeIDs
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderTestFlightPromoteResult(result, false) },
+				func() error { return renderTestFlightPromoteResult(result, true) },
+			)
+		},
+	}
+}
+
+func renderTestFlightPromoteResult(result *testFlightPromoteResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	compliance := ""
+	if result.UsesNonExemptEncryption != nil {
+		compliance = strconv.FormatBool(*result.UsesNonExemptEncryption)
+	}
+	render(
+		[]string{"Build ID", "Promotion", "Uses Non-Exempt Encryption", "Added Groups", "Removed Groups", "Review Submitted", "Submission ID"},
+		[][]string{{
+			result.BuildID,
+			result.From + " -> " + result.To,
+			compliance,
+			strings.Join(result.AddedGroupIDs, ","),
+			strings.Join(result.RemovedGroupIDs, ","),
+			strconv.FormatBool(result.ReviewSubmitted),
+			result.ReviewSubmissionID,
+		}},
+	)
+	return nil
+}
@@ -2,11 +2,13 @@ package testflight
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -107,14 +109,7 @@ func TestFlightReviewUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta app review detail ID")
-	contactFirstName := fs.String("contact-first-name", "", "Contact first name")
-	contactLastName := fs.String("contact-last-name", "", "Contact last name")
-	contactEmail := fs.String("contact-email", "", "Contact email")
-	contactPhone := fs.String("contact-phone", "", "Contact phone")
-	demoAccountName := fs.String("demo-account-name", "", "Demo account name")
-	demoAccountPassword := fs.String("demo-account-password", "", "Demo account password")
-	demoAccountRequired := fs.Bool("demo-account-required", false, "Demo account required")
-	notes := fs.String("notes", "", "Review notes")
+	detailFlags := bindBetaAppReviewDetailFlags(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -135,58 +130,12 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			visited := map[string]bool{}
-			fs.Visit(func(f *flag.Flag) {
-				visited[f.Name] = true
-			})
-
-			hasUpdates := visited["contact-first-name"] ||
-				visited["contact-last-name"] ||
-				visited["contact-email"] ||
-				visited["contact-phone"] ||
-				visited["demo-account-name"] ||
-				visited["demo-account-password"] ||
-				visited["demo-account-required"] ||
-				visited["notes"]
-			if !hasUpdates {
+			attrs, hasUpdates := detailFlags.attributes(fs)
+			if !hasUpdates && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
 
-			attrs := asc.BetaAppReviewDetailUpdateAttributes{}
-			if visited["contact-first-name"] {
-				value := strings.TrimSpace(*contactFirstName)
-				attrs.ContactFirstName = &value
-			}
-			if visited["contact-last-name"] {
-				value := strings.TrimSpace(*contactLastName)
-				attrs.ContactLastName = &value
-			}
-			if visited["contact-email"] {
-				value := strings.TrimSpace(*contactEmail)
-				attrs.ContactEmail = &value
-			}
-			if visited["contact-phone"] {
-				value := strings.TrimSpace(*contactPhone)
-				attrs.ContactPhone = &value
-			}
-			if visited["demo-account-name"] {
-				value := strings.TrimSpace(*demoAccountName)
-				attrs.DemoAccountName = &value
-			}
-			if visited["demo-account-password"] {
-				value := strings.TrimSpace(*demoAccountPassword)
-				attrs.DemoAccountPassword = &value
-			}
-			if visited["demo-account-required"] {
-				value := *demoAccountRequired
-				attrs.DemoAccountRequired = &value
-			}
-			if visited["notes"] {
-				value := strings.TrimSpace(*notes)
-				attrs.Notes = &value
-			}
-
 			client, err := shared.GetASCClient()
 			if err != nil {
 				return fmt.Errorf("testflight review update: %w", err)
@@ -207,46 +156,105 @@ Examples:
 
 // TestFlightReviewSubmitCommand submits a build for beta app review.
 func TestFlightReviewSubmitCommand() *ffcli.Command {
+	return newBetaAppReviewSubmitCommand(
+		"testflight review submit",
+		"asc testflight review submit --build BUILD_ID --confirm [--wait]",
+		"Submit a build for beta app review.",
+	)
+}
+
+// newBetaAppReviewSubmitCommand builds 'testflight review submit' and its
+// 'testflight submit' shortcut. An existing submission for the build is
+// reused, and --wait polls it until review approves or rejects the build.
+func newBetaAppReviewSubmitCommand(commandName, shortUsage, shortHelp string) *ffcli.Command {
 	fs := flag.NewFlagSet("submit", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
 	confirm := fs.Bool("confirm", false, "Confirm submission")
+	wait := fs.Bool("wait", false, "Wait until beta app review approves or rejects the build")
+	pollInterval := fs.Duration("poll-interval", testflightSubmitDefaultPollInterval, "Polling interval for review state checks (with --wait)")
+	timeout := fs.Duration("timeout", testflightSubmitDefaultTimeout, "Maximum time to wait for review (with --wait)")
 	output := shared.BindOutputFlags(fs)
 
+	example := strings.Replace(strings.TrimSuffix(shortUsage, " [--wait]"), "BUILD_ID", `"BUILD_ID"`, 1)
 	return &ffcli.Command{
 		Name:       "submit",
-		ShortUsage: "asc testflight review submit --build BUILD_ID --confirm",
-		ShortHelp:  "Submit a build for beta app review.",
-		LongHelp: `Submit a build for beta app review.
+		ShortUsage: shortUsage,
+		ShortHelp:  shortHelp,
+		LongHelp: shortHelp + `
+
+If the build already has a beta app review submission, that submission is
+reused instead of submitting again, so the command is safe to rerun.
+
+With --wait the command polls the submission until review finishes:
+  - APPROVED -> exits 0
+  - REJECTED -> prints the submission and exits non-zero
 
 Examples:
-  asc testflight review submit --build "BUILD_ID" --confirm`,
+  ` + example + `
+  ` + example + ` --wait
+  ` + example + ` --wait --poll-interval 5m --timeout 48h`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if strings.TrimSpace(*buildID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --build is required")
-				return flag.ErrHelp
+			buildValue := strings.TrimSpace(*buildID)
+			if buildValue == "" {
+				return shared.UsageError("--build is required")
 			}
 			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
+				return shared.UsageError("--confirm is required")
+			}
+			if !*wait {
+				var waitFlagErr error
+				fs.Visit(func(f *flag.Flag) {
+					if waitFlagErr == nil && (f.Name == "poll-interval" || f.Name == "timeout") {
+						waitFlagErr = shared.UsageErrorf("--%s requires --wait", f.Name)
+					}
+				})
+				if waitFlagErr != nil {
+					return waitFlagErr
+				}
+			}
+			if *pollInterval <= 0 {
+				return shared.UsageError("--poll-interval must be greater than 0")
+			}
+			if *timeout <= 0 {
+				return shared.UsageError("--timeout must be greater than 0")
 			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
-				return fmt.Errorf("testflight review submit: %w", err)
+				return fmt.Errorf("%s: %w", commandName, err)
 			}
 
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			submission, err := client.CreateBetaAppReviewSubmission(requestCtx, strings.TrimSpace(*buildID))
+			submission, err := submitBetaAppReview(requestCtx, client, buildValue)
 			if err != nil {
-				return fmt.Errorf("testflight review submit: failed to submit: %w", err)
+				return fmt.Errorf("%s: %w", commandName, err)
+			}
+
+			if *wait {
+				waitCtx, waitCancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+				defer waitCancel()
+
+				submission, err = waitForBetaAppReview(waitCtx, client, submission, *pollInterval)
+				if err != nil {
+					if errors.Is(err, context.DeadlineExceeded) {
+						return fmt.Errorf("%s: timed out waiting for beta app review of build %s after %s", commandName, buildValue, (*timeout).Round(time.Second))
+					}
+					return fmt.Errorf("%s: %w", commandName, err)
+				}
 			}
 
-			return shared.PrintOutput(submission, *output.Output, *output.Pretty)
+			if err := shared.PrintOutput(submission, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if *wait && betaReviewState(submission) == betaReviewStateRejected {
+				return fmt.Errorf("%s: beta app review rejected build %s (submission %s)", commandName, buildValue, submission.Data.ID)
+			}
+			return nil
 		},
 	}
 }
@@ -689,7 +697,7 @@ Examples:
 			})
 
 			hasUpdates := visited["auto-notify"] || visited["external-testing"]
-			if !hasUpdates {
+			if !hasUpdates && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
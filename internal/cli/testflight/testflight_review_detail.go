@@ -0,0 +1,168 @@
+package testflight
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// betaAppReviewDetailFlags are the editable beta app review detail fields.
+type betaAppReviewDetailFlags struct {
+	contactFirstName    *string
+	contactLastName     *string
+	contactEmail        *string
+	contactPhone        *string
+	demoAccountName     *string
+	demoAccountPassword *string
+	demoAccountRequired *bool
+	notes               *string
+}
+
+func bindBetaAppReviewDetailFlags(fs *flag.FlagSet) *betaAppReviewDetailFlags {
+	return &betaAppReviewDetailFlags{
+		contactFirstName:    fs.String("contact-first-name", "", "Contact first name"),
+		contactLastName:     fs.String("contact-last-name", "", "Contact last name"),
+		contactEmail:        fs.String("contact-email", "", "Contact email"),
+		contactPhone:        fs.String("contact-phone", "", "Contact phone"),
+		demoAccountName:     fs.String("demo-account-name", "", "Demo account name"),
+		demoAccountPassword: fs.String("demo-account-password", "", "Demo account password"),
+		demoAccountRequired: fs.Bool("demo-account-required", false, "Demo account required"),
+		notes:               fs.String("notes", "", "Review notes"),
+	}
+}
+
+// attributes returns update attributes for the flags that were passed, and
+// whether any were.
+func (f *betaAppReviewDetailFlags) attributes(fs *flag.FlagSet) (asc.BetaAppReviewDetailUpdateAttributes, bool) {
+	visited := map[string]bool{}
+	fs.Visit(func(fl *flag.Flag) {
+		visited[fl.Name] = true
+	})
+
+	attrs := asc.BetaAppReviewDetailUpdateAttributes{}
+	hasUpdates := false
+	setString := func(name string, value *string, target **string) {
+		if !visited[name] {
+			return
+		}
+		trimmed := strings.TrimSpace(*value)
+		*target = &trimmed
+		hasUpdates = true
+	}
+	setString("contact-first-name", f.contactFirstName, &attrs.ContactFirstName)
+	setString("contact-last-name", f.contactLastName, &attrs.ContactLastName)
+	setString("contact-email", f.contactEmail, &attrs.ContactEmail)
+	setString("contact-phone", f.contactPhone, &attrs.ContactPhone)
+	setString("demo-account-name", f.demoAccountName, &attrs.DemoAccountName)
+	setString("demo-account-password", f.demoAccountPassword, &attrs.DemoAccountPassword)
+	setString("notes", f.notes, &attrs.Notes)
+	if visited["demo-account-required"] {
+		value := *f.demoAccountRequired
+		attrs.DemoAccountRequired = &value
+		hasUpdates = true
+	}
+	return attrs, hasUpdates
+}
+
+// TestFlightReviewDetailCommand returns the review-detail command group.
+func TestFlightReviewDetailCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-detail", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "review-detail",
+		ShortUsage: "asc testflight review-detail <subcommand> [flags]",
+		ShortHelp:  "Set the beta app review contact, demo account, and notes.",
+		LongHelp: `Set the beta app review contact, demo account, and notes.
+
+Examples:
+  asc testflight review-detail set --app "APP_ID" --contact-email "dev@example.com" --notes "Sign in with the demo account"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			TestFlightReviewDetailSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// TestFlightReviewDetailSetCommand updates an app's beta app review detail.
+func TestFlightReviewDetailSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID, bundle ID, or exact app name (or ASC_APP_ID env)")
+	id := fs.String("id", "", "Beta app review detail ID (instead of --app)")
+	detailFlags := bindBetaAppReviewDetailFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc testflight review-detail set (--app APP_ID | --id DETAIL_ID) [flags]",
+		ShortHelp:  "Set beta app review details for an app.",
+		LongHelp: `Set beta app review details for an app.
+
+Each app has one beta app review detail; --app looks it up so no detail ID
+is needed. Only the flags you pass are changed.
+
+Examples:
+  asc testflight review-detail set --app "APP_ID" --contact-first-name "Jane" --contact-last-name "Doe" --contact-email "dev@example.com" --contact-phone "+1 555 0100"
+  asc testflight review-detail set --app "APP_ID" --demo-account-required --demo-account-name "review@example.com" --demo-account-password "$DEMO_PASSWORD"
+  asc testflight review-detail set --id "DETAIL_ID" --notes "Use the demo account to sign in"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			detailID := strings.TrimSpace(*id)
+			resolvedAppID := ""
+			if detailID == "" {
+				resolvedAppID = shared.ResolveAppID(*appID)
+			} else if strings.TrimSpace(*appID) != "" {
+				return shared.UsageError("--app and --id are mutually exclusive")
+			}
+			if detailID == "" && resolvedAppID == "" {
+				return shared.UsageError("--app or --id is required (or set ASC_APP_ID)")
+			}
+
+			attrs, hasUpdates := detailFlags.attributes(fs)
+			if !hasUpdates {
+				return shared.UsageError("at least one detail flag is required")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight review-detail set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if detailID == "" {
+				resolvedAppID, err = shared.ResolveAppIDWithLookup(requestCtx, client, resolvedAppID)
+				if err != nil {
+					return fmt.Errorf("testflight review-detail set: %w", err)
+				}
+				details, err := client.GetBetaAppReviewDetails(requestCtx, resolvedAppID, asc.WithBetaAppReviewDetailsLimit(1))
+				if err != nil {
+					return fmt.Errorf("testflight review-detail set: failed to fetch review detail: %w", err)
+				}
+				if len(details.Data) == 0 {
+					return fmt.Errorf("testflight review-detail set: no beta app review detail found for app %s", resolvedAppID)
+				}
+				detailID = details.Data[0].ID
+			}
+
+			detail, err := client.UpdateBetaAppReviewDetail(requestCtx, detailID, attrs)
+			if err != nil {
+				return fmt.Errorf("testflight review-detail set: failed to update: %w", err)
+			}
+
+			return shared.PrintOutput(detail, *output.Output, *output.Pretty)
+		},
+	}
+}
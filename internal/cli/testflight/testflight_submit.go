@@ -0,0 +1,94 @@
+package testflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	testflightSubmitDefaultTimeout      = 24 * time.Hour
+	testflightSubmitDefaultPollInterval = time.Minute
+
+	betaReviewStateApproved = "APPROVED"
+	betaReviewStateRejected = "REJECTED"
+)
+
+// TestFlightSubmitCommand is a shortcut for 'testflight review submit'.
+func TestFlightSubmitCommand() *ffcli.Command {
+	return newBetaAppReviewSubmitCommand(
+		"testflight submit",
+		"asc testflight submit --build BUILD_ID --confirm [--wait]",
+		"Submit a build for external TestFlight beta app review.",
+	)
+}
+
+// submitBetaAppReview returns the build's existing beta app review
+// submission, or creates one.
+func submitBetaAppReview(ctx context.Context, client *asc.Client, buildID string) (*asc.BetaAppReviewSubmissionResponse, error) {
+	existing, err := client.GetBetaAppReviewSubmissions(
+		ctx,
+		asc.WithBetaAppReviewSubmissionsBuildIDs([]string{buildID}),
+		asc.WithBetaAppReviewSubmissionsLimit(1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing submissions: %w", err)
+	}
+	if len(existing.Data) > 0 {
+		submission := &asc.BetaAppReviewSubmissionResponse{Data: existing.Data[0]}
+		fmt.Fprintf(shared.WarningWriter(), "Build %s already submitted for beta app review (submission %s, %s)\n",
+			buildID, submission.Data.ID, betaReviewState(submission))
+		return submission, nil
+	}
+
+	submission, err := client.CreateBetaAppReviewSubmission(ctx, buildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit: %w", err)
+	}
+	return submission, nil
+}
+
+// waitForBetaAppReview polls a submission until it is approved or rejected,
+// reporting each state change on stderr unless --quiet is set.
+func waitForBetaAppReview(
+	ctx context.Context,
+	client *asc.Client,
+	submission *asc.BetaAppReviewSubmissionResponse,
+	pollInterval time.Duration,
+) (*asc.BetaAppReviewSubmissionResponse, error) {
+	submissionID := submission.Data.ID
+	lastState := ""
+	current := submission
+	return asc.PollUntil(ctx, pollInterval, func(ctx context.Context) (*asc.BetaAppReviewSubmissionResponse, bool, error) {
+		if current == nil {
+			resp, err := client.GetBetaAppReviewSubmission(ctx, submissionID)
+			if err != nil {
+				return nil, false, err
+			}
+			current = resp
+		}
+		resp := current
+		current = nil
+
+		state := betaReviewState(resp)
+		if state != lastState {
+			fmt.Fprintf(shared.WarningWriter(), "Beta app review for submission %s: %s\n", submissionID, state)
+			lastState = state
+		}
+		return resp, state == betaReviewStateApproved || state == betaReviewStateRejected, nil
+	})
+}
+
+func betaReviewState(submission *asc.BetaAppReviewSubmissionResponse) string {
+	state := strings.ToUpper(strings.TrimSpace(submission.Data.Attributes.BetaReviewState))
+	if state == "" {
+		return "UNKNOWN"
+	}
+	return state
+}
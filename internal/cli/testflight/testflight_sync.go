@@ -86,7 +86,7 @@ type testFlightPullOptions struct {
 }
 
 type testFlightSyncClient interface {
-	GetApp(ctx context.Context, appID string) (*asc.AppResponse, error)
+	GetApp(ctx context.Context, appID string, opts ...asc.AppOption) (*asc.AppResponse, error)
 	GetBetaGroups(ctx context.Context, appID string, opts ...asc.BetaGroupsOption) (*asc.BetaGroupsResponse, error)
 	GetBetaGroupBuilds(ctx context.Context, groupID string, opts ...asc.BetaGroupBuildsOption) (*asc.BuildsResponse, error)
 	GetBetaGroupTesters(ctx context.Context, groupID string, opts ...asc.BetaGroupTestersOption) (*asc.BetaTestersResponse, error)
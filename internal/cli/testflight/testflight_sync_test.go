@@ -19,7 +19,7 @@ type testFlightSyncStub struct {
 	testersByGroup map[string]*asc.BetaTestersResponse
 }
 
-func (s *testFlightSyncStub) GetApp(ctx context.Context, appID string) (*asc.AppResponse, error) {
+func (s *testFlightSyncStub) GetApp(ctx context.Context, appID string, opts ...asc.AppOption) (*asc.AppResponse, error) {
 	return s.app, nil
 }
 
@@ -0,0 +1,332 @@
+package testflight
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// whatsNewFileExtension is the extension of per-locale What to Test files
+// read by whats-new set --dir (for example en-US.txt).
+const whatsNewFileExtension = ".txt"
+
+// whatsNewMaxLength is the App Store Connect limit for What to Test notes.
+const whatsNewMaxLength = 4000
+
+type whatsNewSetResult struct {
+	BuildID string `json:"buildId"`
+	Source  string `json:"source"`
+	DryRun  bool   `json:"dryRun"`
+	asc.BulkResult
+}
+
+type whatsNewExistingLocalization struct {
+	id     string
+	locale string
+}
+
+// TestFlightWhatsNewCommand returns the testflight whats-new command group.
+func TestFlightWhatsNewCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("whats-new", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "whats-new",
+		ShortUsage: "asc testflight whats-new <subcommand> [flags]",
+		ShortHelp:  "Set What to Test notes for a build in every locale.",
+		LongHelp: `Set TestFlight "What to Test" notes for a build in every locale.
+
+Use ` + "`asc builds test-notes`" + ` to manage a single localization.
+
+Examples:
+  asc testflight whats-new set --build "BUILD_ID" --dir "./whatsnew"
+  asc testflight whats-new set --build "BUILD_ID" --all-locales "Bug fixes and improvements"`,
+		FlagSet:   fs,
+		UsageFunc: testflightVisibleUsageFunc,
+		Subcommands: []*ffcli.Command{
+			TestFlightWhatsNewSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// TestFlightWhatsNewSetCommand returns the whats-new set subcommand.
+func TestFlightWhatsNewSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+
+	buildID := fs.String("build", "", "Build ID")
+	dir := fs.String("dir", "", "Directory of per-locale text files named <locale>.txt (e.g. en-US.txt)")
+	allLocales := fs.String("all-locales", "", "What to Test text to set for every locale of the build")
+	locale := fs.String("locale", "", "Additional locale(s) to create with --all-locales, comma-separated")
+	dryRun := fs.Bool("dry-run", false, "Preview creates and updates without applying them")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc testflight whats-new set --build \"BUILD_ID\" (--dir DIR | --all-locales TEXT) [flags]",
+		ShortHelp:  "Create or update What to Test notes for many locales.",
+		LongHelp: `Create or update What to Test notes for many locales at once.
+
+With --dir, each <locale>.txt file in the directory provides the notes for
+that locale: existing localizations are updated and missing ones are created.
+Other files are ignored.
+
+With --all-locales, the same text is set for every locale that already has
+notes on the build, plus any locales listed in --locale.
+
+Examples:
+  asc testflight whats-new set --build "BUILD_ID" --dir "./whatsnew"
+  asc testflight whats-new set --build "BUILD_ID" --dir "./whatsnew" --dry-run
+  asc testflight whats-new set --build "BUILD_ID" --all-locales "Bug fixes and improvements"
+  asc testflight whats-new set --build "BUILD_ID" --all-locales "New onboarding" --locale "en-US,de-DE,ja"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			build := strings.TrimSpace(*buildID)
+			if build == "" {
+				fmt.Fprintln(os.Stderr, "Error: --build is required")
+				return flag.ErrHelp
+			}
+
+			dirValue := strings.TrimSpace(*dir)
+			textValue := strings.TrimSpace(*allLocales)
+			if dirValue == "" && textValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir or --all-locales is required")
+				return flag.ErrHelp
+			}
+			if dirValue != "" && textValue != "" {
+				return shared.UsageError("--dir and --all-locales are mutually exclusive")
+			}
+
+			extraLocales := shared.SplitCSV(*locale)
+			if len(extraLocales) > 0 && textValue == "" {
+				return shared.UsageError("--locale can only be used with --all-locales")
+			}
+			if err := shared.ValidateBuildLocalizationLocales(extraLocales); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if len([]rune(textValue)) > whatsNewMaxLength {
+				return shared.UsageErrorf("--all-locales must be at most %d characters", whatsNewMaxLength)
+			}
+
+			var notesByLocale map[string]string
+			if dirValue != "" {
+				var err error
+				notesByLocale, err = readWhatsNewDir(dirValue)
+				if err != nil {
+					return fmt.Errorf("testflight whats-new set: %w", err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight whats-new set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			existingByLocale, err := fetchWhatsNewLocalizations(requestCtx, client, build)
+			if err != nil {
+				return fmt.Errorf("testflight whats-new set: failed to fetch localizations: %w", err)
+			}
+
+			planOnly := *dryRun || asc.DryRunEnabled()
+			result := &whatsNewSetResult{
+				BuildID: build,
+				Source:  "all-locales",
+				DryRun:  planOnly,
+			}
+			if dirValue != "" {
+				result.Source = filepath.Clean(dirValue)
+			} else {
+				notesByLocale = make(map[string]string, len(existingByLocale)+len(extraLocales))
+				for _, localization := range existingByLocale {
+					notesByLocale[localization.locale] = textValue
+				}
+				for _, localeValue := range extraLocales {
+					notesByLocale[canonicalWhatsNewLocale(localeValue, existingByLocale)] = textValue
+				}
+				if len(notesByLocale) == 0 {
+					return fmt.Errorf("testflight whats-new set: build %q has no localizations yet; pass --locale to choose locales", build)
+				}
+			}
+
+			locales := make([]string, 0, len(notesByLocale))
+			for localeValue := range notesByLocale {
+				locales = append(locales, localeValue)
+			}
+			sort.Strings(locales)
+			result.Total = len(locales)
+
+			for _, localeValue := range locales {
+				item := asc.BulkResultItem{Item: localeValue}
+				existing, exists := existingByLocale[strings.ToLower(localeValue)]
+				action := "create"
+				if exists {
+					action = "update"
+					item.ID = existing.id
+				}
+
+				if planOnly {
+					item.Detail = "would " + action
+					result.Succeed(item)
+					continue
+				}
+
+				item.Detail = action
+				var resp *asc.BetaBuildLocalizationResponse
+				if exists {
+					resp, err = client.UpdateBetaBuildLocalization(requestCtx, existing.id, asc.BetaBuildLocalizationAttributes{
+						WhatsNew: notesByLocale[localeValue],
+					})
+				} else {
+					resp, err = client.CreateBetaBuildLocalization(requestCtx, build, asc.BetaBuildLocalizationAttributes{
+						Locale:   localeValue,
+						WhatsNew: notesByLocale[localeValue],
+					})
+				}
+				if err != nil {
+					result.Fail(item, err)
+					continue
+				}
+				if resp != nil {
+					item.ID = resp.Data.ID
+				}
+				result.Succeed(item)
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderWhatsNewSetResult(result, asc.RenderTable) },
+				func() error { return renderWhatsNewSetResult(result, asc.RenderMarkdown) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("testflight whats-new set: %d locale(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+// readWhatsNewDir reads <locale>.txt files from dir and returns the notes
+// keyed by locale.
+func readWhatsNewDir(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	notesByLocale := make(map[string]string)
+	seen := make(map[string]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || !strings.EqualFold(filepath.Ext(name), whatsNewFileExtension) {
+			continue
+		}
+		localeValue := strings.TrimSuffix(name, filepath.Ext(name))
+		if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if previous, ok := seen[strings.ToLower(localeValue)]; ok {
+			return nil, fmt.Errorf("%s: locale %q is also provided by %s", name, localeValue, previous)
+		}
+		seen[strings.ToLower(localeValue)] = name
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		notes := strings.TrimSpace(string(data))
+		if notes == "" {
+			return nil, fmt.Errorf("%s: file is empty", name)
+		}
+		if len([]rune(notes)) > whatsNewMaxLength {
+			return nil, fmt.Errorf("%s: notes must be at most %d characters", name, whatsNewMaxLength)
+		}
+		notesByLocale[localeValue] = notes
+	}
+	if len(notesByLocale) == 0 {
+		return nil, fmt.Errorf("no <locale>%s files found in %s", whatsNewFileExtension, dir)
+	}
+	return notesByLocale, nil
+}
+
+// fetchWhatsNewLocalizations returns the build's localizations keyed by
+// lowercased locale.
+func fetchWhatsNewLocalizations(ctx context.Context, client *asc.Client, buildID string) (map[string]whatsNewExistingLocalization, error) {
+	existing := make(map[string]whatsNewExistingLocalization)
+	firstPage, err := client.GetBetaBuildLocalizations(ctx, buildID, asc.WithBetaBuildLocalizationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	err = asc.PaginateEach(
+		ctx,
+		firstPage,
+		func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetBetaBuildLocalizations(ctx, buildID, asc.WithBetaBuildLocalizationsNextURL(nextURL))
+		},
+		func(page asc.PaginatedResponse) error {
+			resp, ok := page.(*asc.BetaBuildLocalizationsResponse)
+			if !ok {
+				return fmt.Errorf("unexpected beta build localizations response type %T", page)
+			}
+			for _, localization := range resp.Data {
+				localeValue := strings.TrimSpace(localization.Attributes.Locale)
+				if localeValue == "" {
+					continue
+				}
+				existing[strings.ToLower(localeValue)] = whatsNewExistingLocalization{
+					id:     strings.TrimSpace(localization.ID),
+					locale: localeValue,
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// canonicalWhatsNewLocale returns the locale spelling of an existing
+// localization that matches localeValue case-insensitively, or localeValue.
+func canonicalWhatsNewLocale(localeValue string, existing map[string]whatsNewExistingLocalization) string {
+	if localization, ok := existing[strings.ToLower(localeValue)]; ok {
+		return localization.locale
+	}
+	return localeValue
+}
+
+func renderWhatsNewSetResult(result *whatsNewSetResult, render func([]string, [][]string)) error {
+	render(
+		[]string{"Build", "Source", "Dry Run", "Total", "Succeeded", "Failed"},
+		[][]string{{
+			result.BuildID,
+			result.Source,
+			fmt.Sprintf("%t", result.DryRun),
+			fmt.Sprintf("%d", result.Total),
+			fmt.Sprintf("%d", result.Succeeded),
+			fmt.Sprintf("%d", result.Failed),
+		}},
+	)
+	if len(result.Items) > 0 {
+		render(asc.BulkResultRows(&result.BulkResult))
+	}
+	return nil
+}
@@ -33,7 +33,7 @@ func ValidateCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	version := fs.String("version", "", "App Store version string")
 	versionID := fs.String("version-id", "", "App Store version ID")
-	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (or ASC_DEFAULT_PLATFORM env)")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
 	output := shared.BindOutputFlags(fs)
 
@@ -97,8 +97,8 @@ Subscriptions:
 			}
 
 			var normalizedPlatform string
-			if strings.TrimSpace(*platform) != "" {
-				value, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			if platformValue := shared.ResolvePlatform(*platform, ""); platformValue != "" {
+				value, err := shared.NormalizeAppStoreVersionPlatform(platformValue)
 				if err != nil {
 					return fmt.Errorf("validate: %w", err)
 				}
@@ -0,0 +1,236 @@
+package verifybinary
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// maxManifestBytes caps the checksums manifest download. Real manifests list
+// a handful of assets and are well under a kilobyte.
+const maxManifestBytes = 1 << 20
+
+// releaseDownloadBase is a variable so tests can point it at httptest servers.
+var releaseDownloadBase = "https://github.com/rudrankriyam/App-Store-Connect-CLI/releases/download"
+
+// releaseHTTPClient is a package-level var for testability.
+var releaseHTTPClient = func() *http.Client {
+	return &http.Client{Timeout: asc.ResolveTimeout()}
+}
+
+// assetVersionPattern extracts the version from release asset names such as
+// asc_1.2.0_macOS_arm64 or asc_1.2.0_linux_amd64.tar.gz.
+var assetVersionPattern = regexp.MustCompile(`^asc_(\d+\.\d+\.\d+)_`)
+
+type verifyResult struct {
+	File     string `json:"file"`
+	Asset    string `json:"asset"`
+	Version  string `json:"version,omitempty"`
+	Manifest string `json:"manifest"`
+	Expected string `json:"expectedSha256"`
+	Actual   string `json:"actualSha256"`
+	Verified bool   `json:"verified"`
+}
+
+// VerifyBinaryCommand returns the verify-binary command.
+func VerifyBinaryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("verify-binary", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to the downloaded release artifact")
+	checksums := fs.String("checksums", "", "Path to a local checksums manifest (default: download it from the GitHub release)")
+	version := fs.String("version", "", "Release version to verify against (default: parsed from the file name)")
+	asset := fs.String("asset", "", "Asset name to look up in the manifest (default: the file's base name)")
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json, table, markdown")
+
+	return &ffcli.Command{
+		Name:       "verify-binary",
+		ShortUsage: "asc verify-binary --file PATH [flags]",
+		ShortHelp:  "Verify a downloaded asc release artifact against its checksums manifest.",
+		LongHelp: `Verify a downloaded asc release artifact against its checksums manifest.
+
+Computes the SHA-256 of --file and compares it with the entry for the asset in
+the release's asc_<version>_checksums.txt. The manifest is downloaded from the
+GitHub release for the version in the file name unless --checksums points at a
+local copy (for example, one mirrored into an internal artifact store).
+
+Exits non-zero when the asset is missing from the manifest or the checksum
+does not match. macOS binaries are additionally Developer ID signed; check
+them with "codesign --verify --strict" after this command passes.
+
+Examples:
+  asc verify-binary --file ./asc_1.2.0_macOS_arm64
+  asc verify-binary --file ./asc_1.2.0_linux_amd64.tar.gz --checksums ./asc_1.2.0_checksums.txt
+  asc verify-binary --file ./asc --asset asc_1.2.0_linux_amd64 --version 1.2.0 --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument %q", args[0])
+			}
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				return shared.UsageError("--file is required")
+			}
+			if _, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "table", "markdown"); err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			assetName := strings.TrimSpace(*asset)
+			if assetName == "" {
+				assetName = filepath.Base(path)
+			}
+			versionValue := strings.TrimSpace(*version)
+			if versionValue == "" {
+				if match := assetVersionPattern.FindStringSubmatch(assetName); match != nil {
+					versionValue = match[1]
+				}
+			}
+			manifestPath := strings.TrimSpace(*checksums)
+			if manifestPath == "" && versionValue == "" {
+				return shared.UsageError("--version is required when the file name does not include one (or pass --checksums)")
+			}
+
+			actual, err := fileSHA256(path)
+			if err != nil {
+				return fmt.Errorf("verify-binary: %w", err)
+			}
+
+			var manifest []byte
+			manifestSource := manifestPath
+			if manifestPath != "" {
+				manifest, err = os.ReadFile(manifestPath)
+				if err != nil {
+					return fmt.Errorf("verify-binary: read checksums: %w", err)
+				}
+			} else {
+				manifestSource = manifestURL(versionValue)
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				manifest, err = downloadManifest(requestCtx, manifestSource)
+				cancel()
+				if err != nil {
+					return fmt.Errorf("verify-binary: %w", err)
+				}
+			}
+
+			expected, ok := parseManifest(manifest)[assetName]
+			if !ok {
+				return fmt.Errorf("verify-binary: asset %q is not listed in %s", assetName, manifestSource)
+			}
+
+			result := verifyResult{
+				File:     path,
+				Asset:    assetName,
+				Version:  versionValue,
+				Manifest: manifestSource,
+				Expected: expected,
+				Actual:   actual,
+				Verified: strings.EqualFold(expected, actual),
+			}
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printResult(result, asc.RenderTable) },
+				func() error { return printResult(result, asc.RenderMarkdown) },
+			); err != nil {
+				return err
+			}
+			if !result.Verified {
+				return shared.NewReportedError(fmt.Errorf("verify-binary: checksum mismatch for %s", assetName))
+			}
+			return nil
+		},
+	}
+}
+
+func manifestURL(version string) string {
+	return fmt.Sprintf("%s/%s/asc_%s_checksums.txt", strings.TrimRight(releaseDownloadBase, "/"), version, version)
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("read %s: %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func downloadManifest(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("download checksums: %w", err)
+	}
+	resp, err := releaseHTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download checksums: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download checksums: %s returned status %d", url, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("download checksums: %w", err)
+	}
+	if len(data) > maxManifestBytes {
+		return nil, fmt.Errorf("download checksums: manifest exceeds %d bytes", maxManifestBytes)
+	}
+	return data, nil
+}
+
+// parseManifest reads shasum/sha256sum output ("<hex>  <name>", or
+// "<hex> *<name>" in binary mode) into a name→checksum map.
+func parseManifest(data []byte) map[string]string {
+	entries := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		sum, name, ok := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+		if !ok || len(sum) != sha256.Size*2 {
+			continue
+		}
+		name = strings.TrimPrefix(strings.TrimSpace(name), "*")
+		if name == "" {
+			continue
+		}
+		entries[filepath.Base(name)] = strings.ToLower(sum)
+	}
+	return entries
+}
+
+func printResult(result verifyResult, render func([]string, [][]string)) error {
+	status := "verified"
+	if !result.Verified {
+		status = "MISMATCH"
+	}
+	render([]string{"Field", "Value"}, [][]string{
+		{"File", result.File},
+		{"Asset", result.Asset},
+		{"Manifest", result.Manifest},
+		{"Expected SHA-256", result.Expected},
+		{"Actual SHA-256", result.Actual},
+		{"Status", status},
+	})
+	return nil
+}
@@ -0,0 +1,134 @@
+package verifybinary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func writeArtifact(t *testing.T, name, content string) (string, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	sum := sha256.Sum256([]byte(content))
+	return path, hex.EncodeToString(sum[:])
+}
+
+func runVerify(t *testing.T, args ...string) (verifyResult, error) {
+	t.Helper()
+	cmd := VerifyBinaryCommand()
+	if err := cmd.FlagSet.Parse(append(args, "--output", "json")); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe() error: %v", err)
+	}
+	original := os.Stdout
+	os.Stdout = writer
+	runErr := cmd.Exec(context.Background(), cmd.FlagSet.Args())
+	os.Stdout = original
+	_ = writer.Close()
+	stdout, _ := io.ReadAll(reader)
+
+	var result verifyResult
+	if len(stdout) > 0 {
+		if err := json.Unmarshal(stdout, &result); err != nil {
+			t.Fatalf("failed to parse output %q: %v", stdout, err)
+		}
+	}
+	return result, runErr
+}
+
+func serveManifest(t *testing.T, manifest string) *[]string {
+	t.Helper()
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		_, _ = io.WriteString(w, manifest)
+	}))
+	t.Cleanup(server.Close)
+
+	originalBase := releaseDownloadBase
+	releaseDownloadBase = server.URL
+	t.Cleanup(func() { releaseDownloadBase = originalBase })
+	return &requested
+}
+
+func TestVerifyBinaryDownloadsManifestForFileVersion(t *testing.T) {
+	path, sum := writeArtifact(t, "asc_1.2.0_macOS_arm64", "binary")
+	requested := serveManifest(t, fmt.Sprintf("%s  asc_1.2.0_macOS_arm64\n%s  asc_1.2.0_linux_amd64\n", sum, strings.Repeat("0", 64)))
+
+	result, err := runVerify(t, "--file", path)
+	if err != nil {
+		t.Fatalf("Exec() error: %v", err)
+	}
+	if !result.Verified || result.Version != "1.2.0" || result.Actual != sum {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if len(*requested) != 1 || (*requested)[0] != "/1.2.0/asc_1.2.0_checksums.txt" {
+		t.Fatalf("unexpected manifest requests %v", *requested)
+	}
+}
+
+func TestVerifyBinaryReportsMismatch(t *testing.T) {
+	path, _ := writeArtifact(t, "asc_1.2.0_linux_amd64", "tampered")
+	manifest := filepath.Join(t.TempDir(), "checksums.txt")
+	if err := os.WriteFile(manifest, []byte(strings.Repeat("a", 64)+" *asc_1.2.0_linux_amd64\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	result, err := runVerify(t, "--file", path, "--checksums", manifest)
+	if _, ok := errors.AsType[shared.ReportedError](err); !ok {
+		t.Fatalf("expected reported error, got %v", err)
+	}
+	if result.Verified || result.Expected != strings.Repeat("a", 64) {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestVerifyBinaryMissingAsset(t *testing.T) {
+	path, _ := writeArtifact(t, "asc", "binary")
+	serveManifest(t, strings.Repeat("b", 64)+"  asc_1.2.0_macOS_amd64\n")
+
+	_, err := runVerify(t, "--file", path, "--asset", "asc_1.2.0_macOS_arm64")
+	if err == nil || !strings.Contains(err.Error(), `asset "asc_1.2.0_macOS_arm64" is not listed`) {
+		t.Fatalf("expected missing asset error, got %v", err)
+	}
+}
+
+func TestVerifyBinaryValidation(t *testing.T) {
+	path, _ := writeArtifact(t, "asc", "binary")
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing file", args: nil},
+		{name: "no version", args: []string{"--file", path}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := runVerify(t, test.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", err)
+			}
+		})
+	}
+}
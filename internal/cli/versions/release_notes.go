@@ -0,0 +1,333 @@
+package versions
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// releaseNotesTemplateExtension is the extension of per-locale override
+// templates read by release-notes set --locale-dir (for example de-DE.tmpl).
+const releaseNotesTemplateExtension = ".tmpl"
+
+// releaseNotesMaxLength is the App Store Connect limit for What's New text.
+const releaseNotesMaxLength = 4000
+
+// releaseNotesLocaleKey is the template variable holding the locale being
+// rendered.
+const releaseNotesLocaleKey = "locale"
+
+type releaseNotesSetResult struct {
+	VersionID string                     `json:"versionId"`
+	Template  string                     `json:"template"`
+	DryRun    bool                       `json:"dryRun"`
+	Results   []releaseNotesLocaleResult `json:"results"`
+}
+
+type releaseNotesLocaleResult struct {
+	Locale         string `json:"locale"`
+	Action         string `json:"action"`
+	LocalizationID string `json:"localizationId"`
+	Template       string `json:"template"`
+	WhatsNew       string `json:"whatsNew"`
+}
+
+// VersionsReleaseNotesCommand returns the versions release-notes command group.
+func VersionsReleaseNotesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("release-notes", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "release-notes",
+		ShortUsage: "asc versions release-notes <subcommand> [flags]",
+		ShortHelp:  "Render What's New text for every locale of a version from a template.",
+		LongHelp: `Render What's New text for every locale of a version from a template.
+
+Examples:
+  asc versions release-notes set --version-id "VERSION_ID" --template "notes.tmpl" --vars "version=2.3.0,date=2026-10-15"
+  asc versions release-notes set --version-id "VERSION_ID" --template "notes.tmpl" --locale-dir "./notes" --vars "version=2.3.0" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			VersionsReleaseNotesSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// VersionsReleaseNotesSetCommand returns the release-notes set subcommand.
+func VersionsReleaseNotesSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	templatePath := fs.String("template", "", "Go text/template file rendered for every locale (required)")
+	localeDir := fs.String("locale-dir", "", "Directory of per-locale override templates named <locale>.tmpl (e.g. de-DE.tmpl)")
+	vars := fs.String("vars", "", "Template variables as comma-separated key=value pairs (e.g. version=2.3.0,date=2026-10-15)")
+	dryRun := fs.Bool("dry-run", false, "Render and preview updates without applying them")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc versions release-notes set --version-id \"VERSION_ID\" --template FILE [flags]",
+		ShortHelp:  "Set What's New on every localization of a version from a template.",
+		LongHelp: `Set What's New on every localization of a version from a template.
+
+The template is rendered once per existing localization of the version using
+Go text/template syntax. Variables from --vars are available by key (for
+example {{.version}}), and {{.locale}} holds the locale being rendered.
+Referencing a variable that was not passed is an error.
+
+With --locale-dir, a <locale>.tmpl file in the directory replaces --template
+for that locale. Every override must match a localization of the version.
+
+All locales are rendered and validated before any localization is updated.
+Locales whose What's New text already matches are left unchanged.
+
+Examples:
+  asc versions release-notes set --version-id "VERSION_ID" --template "notes.tmpl" --vars "version=2.3.0,date=2026-10-15"
+  asc versions release-notes set --version-id "VERSION_ID" --template "notes.tmpl" --locale-dir "./notes" --vars "version=2.3.0"
+  asc versions release-notes set --version-id "VERSION_ID" --template "notes.tmpl" --vars "version=2.3.0" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			version := strings.TrimSpace(*versionID)
+			if version == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			templateValue := strings.TrimSpace(*templatePath)
+			if templateValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --template is required")
+				return flag.ErrHelp
+			}
+
+			data, err := parseReleaseNotesVars(*vars)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			baseTemplate, err := readReleaseNotesTemplate(templateValue)
+			if err != nil {
+				return fmt.Errorf("versions release-notes set: %w", err)
+			}
+			overrides := map[string]*template.Template{}
+			overrideFiles := map[string]string{}
+			if dirValue := strings.TrimSpace(*localeDir); dirValue != "" {
+				overrides, overrideFiles, err = readReleaseNotesLocaleDir(dirValue)
+				if err != nil {
+					return fmt.Errorf("versions release-notes set: %w", err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("versions release-notes set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			localizations, err := fetchReleaseNotesLocalizations(requestCtx, client, version)
+			if err != nil {
+				return fmt.Errorf("versions release-notes set: failed to fetch localizations: %w", err)
+			}
+			if len(localizations) == 0 {
+				return fmt.Errorf("versions release-notes set: version %q has no localizations", version)
+			}
+
+			byLocale := make(map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes], len(localizations))
+			for _, localization := range localizations {
+				byLocale[strings.ToLower(strings.TrimSpace(localization.Attributes.Locale))] = localization
+			}
+			for key, file := range overrideFiles {
+				if _, ok := byLocale[key]; !ok {
+					return fmt.Errorf("versions release-notes set: %s: version %q has no such localization", filepath.Base(file), version)
+				}
+			}
+
+			result := &releaseNotesSetResult{
+				VersionID: version,
+				Template:  filepath.Clean(templateValue),
+				DryRun:    *dryRun,
+			}
+			for _, localization := range localizations {
+				localeValue := strings.TrimSpace(localization.Attributes.Locale)
+				key := strings.ToLower(localeValue)
+
+				tmpl := baseTemplate
+				file := result.Template
+				if override, ok := overrides[key]; ok {
+					tmpl = override
+					file = overrideFiles[key]
+				}
+
+				notes, err := renderReleaseNotes(tmpl, data, localeValue)
+				if err != nil {
+					return fmt.Errorf("versions release-notes set: %s: %w", localeValue, err)
+				}
+
+				action := "update"
+				if notes == localization.Attributes.WhatsNew {
+					action = "unchanged"
+				}
+				result.Results = append(result.Results, releaseNotesLocaleResult{
+					Locale:         localeValue,
+					Action:         action,
+					LocalizationID: localization.ID,
+					Template:       file,
+					WhatsNew:       notes,
+				})
+			}
+			sort.Slice(result.Results, func(i, j int) bool {
+				return result.Results[i].Locale < result.Results[j].Locale
+			})
+
+			if !*dryRun {
+				for _, item := range result.Results {
+					if item.Action != "update" {
+						continue
+					}
+					if _, err := client.UpdateAppStoreVersionLocalization(requestCtx, item.LocalizationID, asc.AppStoreVersionLocalizationAttributes{
+						WhatsNew: item.WhatsNew,
+					}); err != nil {
+						return fmt.Errorf("versions release-notes set: failed to update %s: %w", item.Locale, err)
+					}
+				}
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderReleaseNotesSetResult(result, asc.RenderTable) },
+				func() error { return renderReleaseNotesSetResult(result, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+// parseReleaseNotesVars parses comma-separated key=value template variables.
+func parseReleaseNotesVars(value string) (map[string]string, error) {
+	data := map[string]string{}
+	for _, pair := range shared.SplitCSV(value) {
+		key, raw, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("--vars expects key=value pairs, got %q", pair)
+		}
+		if key == releaseNotesLocaleKey {
+			return nil, fmt.Errorf("--vars cannot set %q; it is set to each localization's locale", releaseNotesLocaleKey)
+		}
+		if _, exists := data[key]; exists {
+			return nil, fmt.Errorf("--vars sets %q more than once", key)
+		}
+		data[key] = strings.TrimSpace(raw)
+	}
+	return data, nil
+}
+
+func readReleaseNotesTemplate(path string) (*template.Template, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(contents))
+	if err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// readReleaseNotesLocaleDir reads <locale>.tmpl files from dir and returns the
+// parsed templates and source files keyed by lowercased locale.
+func readReleaseNotesLocaleDir(dir string) (map[string]*template.Template, map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	templates := make(map[string]*template.Template)
+	files := make(map[string]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || !strings.EqualFold(filepath.Ext(name), releaseNotesTemplateExtension) {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSuffix(name, filepath.Ext(name)))
+		if previous, ok := files[key]; ok {
+			return nil, nil, fmt.Errorf("%s: locale is also provided by %s", name, filepath.Base(previous))
+		}
+		path := filepath.Join(dir, name)
+		tmpl, err := readReleaseNotesTemplate(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		templates[key] = tmpl
+		files[key] = path
+	}
+	if len(templates) == 0 {
+		return nil, nil, fmt.Errorf("no <locale>%s files found in %s", releaseNotesTemplateExtension, dir)
+	}
+	return templates, files, nil
+}
+
+// renderReleaseNotes executes tmpl for one locale and validates the result.
+func renderReleaseNotes(tmpl *template.Template, vars map[string]string, localeValue string) (string, error) {
+	data := make(map[string]string, len(vars)+1)
+	for key, value := range vars {
+		data[key] = value
+	}
+	data[releaseNotesLocaleKey] = localeValue
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	notes := strings.TrimSpace(buf.String())
+	if notes == "" {
+		return "", fmt.Errorf("rendered notes are empty")
+	}
+	if len([]rune(notes)) > releaseNotesMaxLength {
+		return "", fmt.Errorf("rendered notes must be at most %d characters", releaseNotesMaxLength)
+	}
+	return notes, nil
+}
+
+func fetchReleaseNotesLocalizations(ctx context.Context, client *asc.Client, versionID string) ([]asc.Resource[asc.AppStoreVersionLocalizationAttributes], error) {
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := allPages.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected localizations response type: %T", allPages)
+	}
+	return resp.Data, nil
+}
+
+func renderReleaseNotesSetResult(result *releaseNotesSetResult, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(result.Results))
+	for _, item := range result.Results {
+		rows = append(rows, []string{item.Locale, item.Action, item.LocalizationID, item.Template})
+	}
+	render([]string{"Locale", "Action", "Localization ID", "Template"}, rows)
+	return nil
+}
@@ -40,6 +40,7 @@ func VersionsCommand() *ffcli.Command {
 			VersionsDeleteCommand(),
 			VersionsAttachBuildCommand(),
 			VersionsReleaseCommand(),
+			VersionsReleaseNotesCommand(),
 			PhasedReleaseCommand(),
 			VersionsPromotionsCommand(),
 		},
@@ -56,6 +57,7 @@ func VersionsListCommand() *ffcli.Command {
 	version := fs.String("version", "", "Filter by version string (comma-separated)")
 	platform := fs.String("platform", "", "Filter by platform: IOS, MAC_OS, TV_OS, VISION_OS (comma-separated)")
 	state := fs.String("state", "", "Filter by state (comma-separated)")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(appStoreVersionsListIncludeList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Next page URL from a previous response")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -71,6 +73,7 @@ Examples:
   asc versions list --app "123456789"
   asc versions list --app "123456789" --version "1.0.0"
   asc versions list --app "123456789" --platform IOS --state READY_FOR_REVIEW
+  asc versions list --app "123456789" --include "build,appStoreVersionPhasedRelease" --output table
   asc versions list --app "123456789" --paginate`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -90,6 +93,10 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("versions list: %w", err)
 			}
+			includeValues, err := normalizeAppStoreVersionsListInclude(*include)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
@@ -111,6 +118,7 @@ Examples:
 				asc.WithAppStoreVersionsVersionStrings(shared.SplitCSV(*version)),
 				asc.WithAppStoreVersionsStates(states),
 				asc.WithAppStoreVersionsNextURL(*next),
+				asc.WithAppStoreVersionsInclude(includeValues),
 			}
 
 			if *paginate {
@@ -255,10 +263,11 @@ func VersionsCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	versionString := fs.String("version", "", "Version string (e.g., 1.0.0) (required)")
-	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (default IOS, or ASC_DEFAULT_PLATFORM env)")
 	copyright := fs.String("copyright", "", "Copyright text (e.g., '2026 My Company')")
 	releaseType := fs.String("release-type", "", "Release type: MANUAL, AFTER_APPROVAL, SCHEDULED")
-	copyMetadataFrom := fs.String("copy-metadata-from", "", "Copy localization metadata from this source version string")
+	buildID := fs.String("build", "", "Build ID to attach to the new version")
+	copyMetadataFrom := fs.String("copy-metadata-from", "", "Copy localization metadata from this source version string, or \"previous\" for the latest other version")
 	copyFields := fs.String("copy-fields", "", "Comma-separated metadata fields to copy: description, keywords, marketingUrl, promotionalText, supportUrl, whatsNew")
 	excludeFields := fs.String("exclude-fields", "", "Comma-separated metadata fields to exclude from copy")
 	output := shared.BindOutputFlags(fs)
@@ -269,10 +278,15 @@ func VersionsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a new app store version.",
 		LongHelp: `Create a new app store version.
 
+Use --build to attach a build in the same step, and --copy-metadata-from to
+carry localization metadata forward from an earlier version ("previous" picks
+the most recently created version on the same platform).
+
 Examples:
   asc versions create --app "123456789" --version "2.0.0"
   asc versions create --app "123456789" --version "2.0.0" --platform IOS
   asc versions create --app "123456789" --version "2.0.0" --copyright "2026 My Company" --release-type MANUAL
+  asc versions create --app "123456789" --version "2.3.0" --platform IOS --build "BUILD_ID" --copy-metadata-from previous
   asc versions create --app "123456789" --version "2.4.0" --platform IOS --copy-metadata-from "2.3.2"
   asc versions create --app "123456789" --version "2.4.0" --copy-metadata-from "2.3.2" --copy-fields "description,keywords,supportUrl" --exclude-fields "whatsNew"`,
 		FlagSet:   fs,
@@ -283,7 +297,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(shared.ResolvePlatform(*platform, "IOS"))
 			if err != nil {
 				return fmt.Errorf("versions create: %w", err)
 			}
@@ -349,6 +363,12 @@ Examples:
 				Platform:      string(resp.Data.Attributes.Platform),
 				State:         shared.ResolveAppStoreVersionState(resp.Data.Attributes),
 			}
+			if buildValue := strings.TrimSpace(*buildID); buildValue != "" {
+				if err := client.AttachBuildToVersion(requestCtx, resp.Data.ID, buildValue); err != nil {
+					return fmt.Errorf("versions create: created version %s but failed to attach build: %w", resp.Data.ID, err)
+				}
+				result.BuildID = buildValue
+			}
 			if copyMetadataFromValue != "" {
 				copySummary, err := copyVersionMetadataFromSource(
 					requestCtx,
@@ -360,10 +380,10 @@ Examples:
 					selectedCopyFields,
 				)
 				if err != nil {
-					return fmt.Errorf("versions create: %w", err)
+					return fmt.Errorf("versions create: created version %s but failed to copy metadata: %w", resp.Data.ID, err)
 				}
 				if len(copySummary.SkippedLocales) > 0 {
-					fmt.Fprintf(os.Stderr, "Warning: skipped source locales not enabled on destination: %s\n", strings.Join(copySummary.SkippedLocales, ", "))
+					fmt.Fprintf(shared.WarningWriter(), "Warning: skipped source locales not enabled on destination: %s\n", strings.Join(copySummary.SkippedLocales, ", "))
 				}
 				result.MetadataCopy = copySummary
 			}
@@ -403,7 +423,7 @@ Examples:
 			}
 
 			// Check that at least one update field is provided
-			if *copyright == "" && *releaseType == "" && *earliestReleaseDate == "" && *versionString == "" {
+			if *copyright == "" && *releaseType == "" && *earliestReleaseDate == "" && *versionString == "" && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one of --copyright, --release-type, --earliest-release-date, or --version is required")
 				return flag.ErrHelp
 			}
@@ -504,6 +524,7 @@ func VersionsAttachBuildCommand() *ffcli.Command {
 
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	buildID := fs.String("build", "", "Build ID to attach (required)")
+	compliance := shared.BindComplianceFlag(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -513,7 +534,8 @@ func VersionsAttachBuildCommand() *ffcli.Command {
 		LongHelp: `Attach a build to an app store version.
 
 Examples:
-  asc versions attach-build --version-id "VERSION_ID" --build "BUILD_ID"`,
+  asc versions attach-build --version-id "VERSION_ID" --build "BUILD_ID"
+  asc versions attach-build --version-id "VERSION_ID" --build "BUILD_ID" --compliance uses-non-exempt-encryption=false`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -534,14 +556,20 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			usesNonExemptEncryption, err := shared.ApplyBuildComplianceByID(requestCtx, client, strings.TrimSpace(*buildID), compliance)
+			if err != nil {
+				return fmt.Errorf("versions attach-build: %w", err)
+			}
+
 			if err := client.AttachBuildToVersion(requestCtx, strings.TrimSpace(*versionID), strings.TrimSpace(*buildID)); err != nil {
 				return fmt.Errorf("versions attach-build: %w", err)
 			}
 
 			result := &asc.AppStoreVersionAttachBuildResult{
-				VersionID: strings.TrimSpace(*versionID),
-				BuildID:   strings.TrimSpace(*buildID),
-				Attached:  true,
+				VersionID:               strings.TrimSpace(*versionID),
+				BuildID:                 strings.TrimSpace(*buildID),
+				Attached:                true,
+				UsesNonExemptEncryption: usesNonExemptEncryption,
 			}
 
 			return shared.PrintOutput(result, *output.Output, *output.Pretty)
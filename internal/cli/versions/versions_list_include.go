@@ -0,0 +1,25 @@
+package versions
+
+import "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+
+func normalizeAppStoreVersionsListInclude(value string) ([]string, error) {
+	return shared.NormalizeSelection(value, appStoreVersionsListIncludeList(), "--include")
+}
+
+func appStoreVersionsListIncludeList() []string {
+	return []string{
+		"ageRatingDeclaration",
+		"alternativeDistributionPackage",
+		"app",
+		"appClipDefaultExperience",
+		"appStoreReviewDetail",
+		"appStoreVersionExperiments",
+		"appStoreVersionExperimentsV2",
+		"appStoreVersionLocalizations",
+		"appStoreVersionPhasedRelease",
+		"appStoreVersionSubmission",
+		"build",
+		"gameCenterAppVersion",
+		"routingAppCoverage",
+	}
+}
@@ -0,0 +1,333 @@
+package web
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
+)
+
+type privacyValidateOutput struct {
+	File        string                 `json:"file"`
+	Valid       bool                   `json:"valid"`
+	Tuples      int                    `json:"tuples"`
+	Declaration privacyDeclarationFile `json:"declaration"`
+}
+
+type privacyPushOutput struct {
+	privacyApplyOutput
+	PublishState *privacyPublishState `json:"publishState,omitempty"`
+}
+
+// PrivacyCommand returns the privacy command group.
+func PrivacyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("privacy", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "privacy",
+		ShortUsage: "asc privacy <subcommand> [flags]",
+		ShortHelp:  "[experimental] Pull, validate, and push app privacy labels as YAML.",
+		LongHelp: `Pull, validate, and push App Store privacy details ("nutrition labels").
+
+The declaration is a YAML document listing data usages by category, purpose,
+and data protection, so it can be reviewed in pull requests and applied from
+CI. Files ending in .json are read and written as JSON instead. Run
+"asc web privacy catalog" to list the accepted tokens.
+
+The public App Store Connect API does not expose app privacy details, so pull
+and push use the same web session as "asc web" (sign in once with
+"asc web auth login"). validate works offline.
+
+Subcommands:
+  validate  Check a declaration file without contacting App Store Connect
+  pull      Write the app's current privacy details to a declaration file
+  push      Apply a declaration file, optionally publishing the result
+
+Examples:
+  asc privacy pull --app "123456789" --file privacy.yaml
+  asc privacy validate --file privacy.yaml
+  asc privacy push --app "123456789" --file privacy.yaml --dry-run
+  asc privacy push --app "123456789" --file privacy.yaml --publish --confirm
+
+` + webWarningText,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			PrivacyValidateCommand(),
+			PrivacyPullCommand(),
+			PrivacyPushCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// PrivacyValidateCommand validates a privacy declaration file offline.
+func PrivacyValidateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("privacy validate", flag.ExitOnError)
+
+	filePath := fs.String("file", "", "Path to the privacy declaration (.yaml/.yml, or .json)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "asc privacy validate --file FILE [flags]",
+		ShortHelp:  "Validate a privacy declaration file.",
+		LongHelp: `Validate a privacy declaration file without contacting App Store Connect.
+
+Unknown keys, unsupported data protections, and invalid combinations (such as
+DATA_NOT_COLLECTED alongside collected data) are reported with the offending
+entry. The normalized declaration is printed on success.
+
+Examples:
+  asc privacy validate --file privacy.yaml`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("privacy validate does not accept positional arguments")
+			}
+			resolvedFilePath := strings.TrimSpace(*filePath)
+			if resolvedFilePath == "" {
+				return shared.UsageError("--file is required")
+			}
+
+			declaration, err := parsePrivacyDeclarationFile(resolvedFilePath)
+			if err != nil {
+				return fmt.Errorf("privacy validate: %w", err)
+			}
+			tuples, err := declarationToTupleSet(declaration)
+			if err != nil {
+				return fmt.Errorf("privacy validate: %w", err)
+			}
+
+			payload := privacyValidateOutput{
+				File:        resolvedFilePath,
+				Valid:       true,
+				Tuples:      len(tuples),
+				Declaration: declaration,
+			}
+			return shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderPrivacyValidate(payload, asc.RenderTable) },
+				func() error { return renderPrivacyValidate(payload, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+// PrivacyPullCommand writes the app's privacy details to a declaration file.
+func PrivacyPullCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("privacy pull", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App ID (or ASC_APP_ID env)")
+	filePath := fs.String("file", "", "Write the declaration to this file (.yaml/.yml, or .json)")
+	authFlags := bindWebSessionFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "pull",
+		ShortUsage: "asc privacy pull --app APP_ID [--file FILE] [flags]",
+		ShortHelp:  "[experimental] Pull app privacy details into a declaration file.",
+		LongHelp: `Pull the app's current privacy details.
+
+With --file, the canonical declaration is written there (replacing any
+existing file) so it can be committed and pushed back with "asc privacy push".
+
+Examples:
+  asc privacy pull --app "123456789" --file privacy.yaml
+  asc privacy pull --app "123456789" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("privacy pull does not accept positional arguments")
+			}
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			session, err := resolveWebSessionForCommand(requestCtx, authFlags)
+			if err != nil {
+				return err
+			}
+			client := webcore.NewClient(session)
+
+			payload, err := pullPrivacyDeclaration(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return withWebAuthHint(err, "privacy pull")
+			}
+			if outPath := strings.TrimSpace(*filePath); outPath != "" {
+				if err := writePrivacyDeclarationFile(outPath, payload.Declaration); err != nil {
+					return fmt.Errorf("privacy pull: %w", err)
+				}
+				payload.Out = outPath
+			}
+
+			return shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderPrivacyPullTable(payload) },
+				func() error { return renderPrivacyPullMarkdown(payload) },
+			)
+		},
+	}
+}
+
+// PrivacyPushCommand applies a declaration file and optionally publishes it.
+func PrivacyPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("privacy push", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App ID (or ASC_APP_ID env)")
+	filePath := fs.String("file", "", "Path to the privacy declaration (.yaml/.yml, or .json)")
+	dryRun := fs.Bool("dry-run", false, "Show the planned changes without applying them")
+	allowDeletes := fs.Bool("allow-deletes", false, "Delete remote data usages missing from the file")
+	publish := fs.Bool("publish", false, "Publish the privacy details after applying them")
+	confirm := fs.Bool("confirm", false, "Confirm deletes (--allow-deletes) and publishing (--publish)")
+	authFlags := bindWebSessionFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc privacy push --app APP_ID --file FILE [--allow-deletes] [--publish] [--confirm] [flags]",
+		ShortHelp:  "[experimental] Apply a privacy declaration file.",
+		LongHelp: `Apply a privacy declaration file to the app's privacy details.
+
+The file is validated before anything is sent, then compared with the app's
+current data usages. Missing usages are added and changed ones updated. Remote
+usages that are not in the file are only deleted with --allow-deletes --confirm;
+otherwise push stops before making changes. Use --dry-run to review the plan.
+
+Pushed details stay unpublished until you pass --publish --confirm (or run
+"asc web privacy publish").
+
+Examples:
+  asc privacy push --app "123456789" --file privacy.yaml --dry-run
+  asc privacy push --app "123456789" --file privacy.yaml
+  asc privacy push --app "123456789" --file privacy.yaml --allow-deletes --publish --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("privacy push does not accept positional arguments")
+			}
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			resolvedFilePath := strings.TrimSpace(*filePath)
+			if resolvedFilePath == "" {
+				return shared.UsageError("--file is required")
+			}
+			// The root --dry-run only withholds App Store Connect API requests,
+			// so honor it here too rather than writing through the web session.
+			planOnly := *dryRun || asc.DryRunEnabled()
+			if planOnly && *publish {
+				return shared.UsageError("--dry-run and --publish are mutually exclusive")
+			}
+			if (*allowDeletes || *publish) && !planOnly && !*confirm {
+				return shared.UsageError("--confirm is required with --allow-deletes or --publish")
+			}
+
+			declaration, err := parsePrivacyDeclarationFile(resolvedFilePath)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			desiredTuples, err := declarationToTupleSet(declaration)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			session, err := resolveWebSessionForCommand(requestCtx, authFlags)
+			if err != nil {
+				return err
+			}
+			client := webcore.NewClient(session)
+
+			plan, err := planPrivacyChanges(requestCtx, client, resolvedAppID, resolvedFilePath, desiredTuples)
+			if err != nil {
+				return withWebAuthHint(err, "privacy push")
+			}
+
+			payload := privacyPushOutput{privacyApplyOutput: privacyApplyOutput{
+				AppID:          resolvedAppID,
+				File:           resolvedFilePath,
+				Updates:        plan.Updates,
+				Adds:           plan.Adds,
+				Deletes:        plan.Deletes,
+				SkippedDeletes: plan.SkippedDeletes,
+				APICalls:       plan.APICalls,
+			}}
+
+			if !planOnly {
+				if len(plan.Deletes) > 0 && !*allowDeletes {
+					return shared.UsageErrorf("%d remote data usage(s) are missing from %s; pass --allow-deletes --confirm to delete them", len(plan.Deletes), resolvedFilePath)
+				}
+
+				actions, err := withWebSpinnerValue("Applying privacy changes", func() ([]privacyApplyAction, error) {
+					return applyPrivacyPlan(requestCtx, client, resolvedAppID, plan)
+				})
+				if err != nil {
+					return withWebAuthHint(err, "privacy push")
+				}
+				payload.Applied = true
+				payload.Actions = actions
+
+				if *publish {
+					published, err := publishPrivacyDeclarations(requestCtx, client, resolvedAppID)
+					if err != nil {
+						return withWebAuthHint(err, "privacy push")
+					}
+					payload.PublishState = &published.PublishState
+				}
+			}
+
+			return shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderPrivacyPush(payload, renderPrivacyApplyTable) },
+				func() error { return renderPrivacyPush(payload, renderPrivacyApplyMarkdown) },
+			)
+		},
+	}
+}
+
+func renderPrivacyValidate(payload privacyValidateOutput, render func([]string, [][]string)) error {
+	render(
+		[]string{"File", "Valid", "Tuples"},
+		[][]string{{payload.File, fmt.Sprintf("%t", payload.Valid), fmt.Sprintf("%d", payload.Tuples)}},
+	)
+	render(
+		[]string{"Category", "Purposes", "Data Protections"},
+		buildPrivacyRows(payload.Declaration.DataUsages),
+	)
+	return nil
+}
+
+func renderPrivacyPush(payload privacyPushOutput, renderApply func(privacyApplyOutput) error) error {
+	if err := renderApply(payload.privacyApplyOutput); err != nil {
+		return err
+	}
+	if payload.PublishState != nil {
+		fmt.Printf("\nPublished: %t\n", payload.PublishState.Published)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
+)
+
+const privacyTestRemoteUsages = `{
+	"data": [{
+		"id": "usage-1",
+		"type": "appDataUsages",
+		"relationships": {
+			"category": {"data": {"type":"appDataUsageCategories","id":"NAME"}},
+			"purpose": {"data": {"type":"appDataUsagePurposes","id":"APP_FUNCTIONALITY"}},
+			"dataProtection": {"data": {"type":"appDataUsageDataProtections","id":"DATA_LINKED_TO_YOU"}}
+		}
+	}]
+}`
+
+const privacyTestYAML = `schemaVersion: 1
+dataUsages:
+  - category: NAME
+    purposes:
+      - APP_FUNCTIONALITY
+    dataProtections:
+      - DATA_LINKED_TO_YOU
+  - category: EMAIL_ADDRESS
+    purposes:
+      - ANALYTICS
+    dataProtections:
+      - DATA_NOT_LINKED_TO_YOU
+`
+
+// stubPrivacySession serves "METHOD /path" keyed bodies and records the
+// requests it receives.
+func stubPrivacySession(t *testing.T, bodies map[string]string) *[]string {
+	t.Helper()
+	origResolveSession := resolveSessionFn
+	t.Cleanup(func() { resolveSessionFn = origResolveSession })
+
+	var requests []string
+	resolveSessionFn = func(ctx context.Context, appleID, password, twoFactorCode string) (*webcore.AuthSession, string, error) {
+		return &webcore.AuthSession{
+			Client: &http.Client{
+				Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					key := req.Method + " " + req.URL.Path
+					requests = append(requests, key)
+					body, ok := bodies[key]
+					if !ok {
+						t.Fatalf("unexpected request: %s", key)
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Content-Type": []string{"application/json"}},
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Request:    req,
+					}, nil
+				}),
+			},
+		}, "cache", nil
+	}
+	return &requests
+}
+
+func writePrivacyTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	return path
+}
+
+func TestPrivacyDeclarationYAMLRoundTrip(t *testing.T) {
+	declaration, err := parsePrivacyDeclarationFile(writePrivacyTestFile(t, "privacy.yaml", privacyTestYAML))
+	if err != nil {
+		t.Fatalf("parsePrivacyDeclarationFile() error = %v", err)
+	}
+	if len(declaration.DataUsages) != 2 {
+		t.Fatalf("expected two data usages, got %#v", declaration.DataUsages)
+	}
+
+	out := filepath.Join(t.TempDir(), "out.yml")
+	if err := writePrivacyDeclarationFile(out, declaration); err != nil {
+		t.Fatalf("writePrivacyDeclarationFile() error = %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "schemaVersion: 1\ndataUsages:\n") {
+		t.Fatalf("expected YAML output, got %q", data)
+	}
+	reparsed, err := parsePrivacyDeclarationFile(out)
+	if err != nil {
+		t.Fatalf("reparse error = %v", err)
+	}
+	if usageKey(reparsed.DataUsages[0]) != usageKey(declaration.DataUsages[0]) || len(reparsed.DataUsages) != 2 {
+		t.Fatalf("round trip changed declaration: %#v", reparsed)
+	}
+}
+
+func TestParsePrivacyDeclarationFileRejectsUnknownYAMLFields(t *testing.T) {
+	path := writePrivacyTestFile(t, "privacy.yaml", "schemaVersion: 1\ndataUsages:\n  - dataProtections: [DATA_NOT_COLLECTED]\n    purpose: ANALYTICS\n")
+	_, err := parsePrivacyDeclarationFile(path)
+	if err == nil || !strings.Contains(err.Error(), "invalid privacy declaration YAML") || !strings.Contains(err.Error(), "purpose") {
+		t.Fatalf("expected unknown field error, got %v", err)
+	}
+}
+
+func TestPrivacyValidateReportsInvalidDeclaration(t *testing.T) {
+	path := writePrivacyTestFile(t, "privacy.yaml", "dataUsages:\n  - category: NAME\n    dataProtections: [DATA_LINKED_TO_YOU]\n")
+	cmd := PrivacyValidateCommand()
+	if err := cmd.FlagSet.Parse([]string{"--file", path}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	err := cmd.Exec(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "dataUsages[0].purposes is required") {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	valid := writePrivacyTestFile(t, "valid.yaml", privacyTestYAML)
+	cmd = PrivacyValidateCommand()
+	if err := cmd.FlagSet.Parse([]string{"--file", valid, "--output", "json"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); err != nil {
+			t.Fatalf("exec error: %v", err)
+		}
+	})
+	var payload privacyValidateOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if !payload.Valid || payload.Tuples != 2 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestPrivacyPullWritesYAML(t *testing.T) {
+	stubPrivacySession(t, map[string]string{
+		"GET /iris/v1/apps/app-1/dataUsages":            privacyTestRemoteUsages,
+		"GET /iris/v1/apps/app-1/dataUsagePublishState": `{"data":{"id":"state-1","type":"appDataUsagesPublishState","attributes":{"published":true}}}`,
+	})
+
+	out := filepath.Join(t.TempDir(), "privacy.yaml")
+	cmd := PrivacyPullCommand()
+	if err := cmd.FlagSet.Parse([]string{"--app", "app-1", "--file", out, "--output", "json"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); err != nil {
+			t.Fatalf("exec error: %v", err)
+		}
+	})
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	want := "schemaVersion: 1\ndataUsages:\n  - category: NAME\n    purposes:\n      - APP_FUNCTIONALITY\n    dataProtections:\n      - DATA_LINKED_TO_YOU\n"
+	if string(data) != want {
+		t.Fatalf("unexpected YAML:\n%s", data)
+	}
+}
+
+func TestPrivacyPushDryRunDoesNotMutate(t *testing.T) {
+	requests := stubPrivacySession(t, map[string]string{
+		"GET /iris/v1/apps/app-1/dataUsages": privacyTestRemoteUsages,
+	})
+
+	cmd := PrivacyPushCommand()
+	path := writePrivacyTestFile(t, "privacy.yaml", privacyTestYAML)
+	if err := cmd.FlagSet.Parse([]string{"--app", "app-1", "--file", path, "--dry-run", "--output", "json"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); err != nil {
+			t.Fatalf("exec error: %v", err)
+		}
+	})
+
+	var payload privacyPushOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if payload.Applied || len(payload.Adds) != 1 || payload.Adds[0].Category != "EMAIL_ADDRESS" {
+		t.Fatalf("unexpected dry-run payload: %+v", payload)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("expected only the read request, got %v", *requests)
+	}
+}
+
+func TestPrivacyPushAppliesAndPublishes(t *testing.T) {
+	requests := stubPrivacySession(t, map[string]string{
+		"GET /iris/v1/apps/app-1/dataUsages":               privacyTestRemoteUsages,
+		"POST /iris/v1/appDataUsages":                      `{"data":{"id":"usage-2","type":"appDataUsages"}}`,
+		"GET /iris/v1/apps/app-1/dataUsagePublishState":    `{"data":{"id":"state-1","type":"appDataUsagesPublishState","attributes":{"published":false}}}`,
+		"PATCH /iris/v1/appDataUsagesPublishState/state-1": `{"data":{"id":"state-1","type":"appDataUsagesPublishState","attributes":{"published":true}}}`,
+	})
+
+	cmd := PrivacyPushCommand()
+	path := writePrivacyTestFile(t, "privacy.yaml", privacyTestYAML)
+	if err := cmd.FlagSet.Parse([]string{"--app", "app-1", "--file", path, "--publish", "--confirm", "--output", "json"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); err != nil {
+			t.Fatalf("exec error: %v", err)
+		}
+	})
+
+	var payload privacyPushOutput
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if !payload.Applied || len(payload.Actions) != 1 || payload.PublishState == nil || !payload.PublishState.Published {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if got := (*requests)[len(*requests)-1]; got != "PATCH /iris/v1/appDataUsagesPublishState/state-1" {
+		t.Fatalf("expected publish to run last, got %v", *requests)
+	}
+}
+
+func TestPrivacyPushValidation(t *testing.T) {
+	path := writePrivacyTestFile(t, "privacy.yaml", privacyTestYAML)
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			args:    []string{"--app", "app-1"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "publish without confirm",
+			args:    []string{"--app", "app-1", "--file", path, "--publish"},
+			wantErr: "--confirm is required with --allow-deletes or --publish",
+		},
+		{
+			name:    "dry run with publish",
+			args:    []string{"--app", "app-1", "--file", path, "--dry-run", "--publish"},
+			wantErr: "--dry-run and --publish are mutually exclusive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_APP_ID", "")
+			cmd := PrivacyPushCommand()
+			if err := cmd.FlagSet.Parse(test.args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			_, stderr := captureOutput(t, func() {
+				if err := cmd.Exec(context.Background(), nil); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
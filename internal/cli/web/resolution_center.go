@@ -0,0 +1,384 @@
+package web
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
+)
+
+type resolutionCenterThreadEntry struct {
+	webcore.ResolutionCenterThread
+	SubmissionState string `json:"submissionState,omitempty"`
+	Version         string `json:"version,omitempty"`
+	Platform        string `json:"platform,omitempty"`
+}
+
+type resolutionCenterThreadOutput struct {
+	ThreadID         string                            `json:"threadId"`
+	Messages         []webcore.ResolutionCenterMessage `json:"messages"`
+	Rejections       []webcore.ReviewRejection         `json:"rejections"`
+	Attachments      []webcore.ReviewAttachment        `json:"attachments"`
+	OutputDirectory  string                            `json:"outputDirectory,omitempty"`
+	Downloads        []reviewAttachmentDownloadResult  `json:"downloads,omitempty"`
+	DownloadFailures []string                          `json:"downloadFailures,omitempty"`
+}
+
+// ResolutionCenterCommand returns the resolution-center command group.
+func ResolutionCenterCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("resolution-center", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "resolution-center",
+		ShortUsage: "asc resolution-center <subcommand> [flags]",
+		ShortHelp:  "[experimental] Read App Review rejection threads, reasons, and attachments.",
+		LongHelp: `Read App Review rejection threads, reasons, and attachments.
+
+The public App Store Connect API does not expose Resolution Center messages or
+rejection reasons, so these commands use the same web session as "asc web"
+(sign in once with "asc web auth login"). Attachments you provided to App
+Review yourself are managed with "asc review attachments-list".
+
+Subcommands:
+  list  List Resolution Center threads for an app's review submissions
+  get   Show a thread's messages, rejection reasons, and attachments
+
+Examples:
+  asc resolution-center list --app "123456789" --state UNRESOLVED_ISSUES
+  asc resolution-center get --id "THREAD_ID" --plain-text --out ./rejection
+
+` + webWarningText,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ResolutionCenterListCommand(),
+			ResolutionCenterGetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ResolutionCenterListCommand lists Resolution Center threads for an app.
+func ResolutionCenterListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("resolution-center list", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	submissionID := fs.String("submission", "", "Only list threads for this review submission ID")
+	stateCSV := fs.String("state", "", "Only list threads for submissions in these states (comma-separated, e.g. UNRESOLVED_ISSUES)")
+	authFlags := bindWebSessionFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc resolution-center list --app APP_ID [--submission ID | --state CSV] [flags]",
+		ShortHelp:  "[experimental] List Resolution Center threads for an app.",
+		LongHelp: `List Resolution Center threads for an app's review submissions.
+
+Each thread is one conversation with App Review, usually opened by a rejection.
+Pass a thread ID to "asc resolution-center get" for its messages, rejection
+reasons, and attachments.
+
+Examples:
+  asc resolution-center list --app "123456789"
+  asc resolution-center list --app "123456789" --state UNRESOLVED_ISSUES
+  asc resolution-center list --app "123456789" --submission "SUBMISSION_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			if trimmedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			trimmedSubmissionID := strings.TrimSpace(*submissionID)
+			if trimmedSubmissionID != "" && strings.TrimSpace(*stateCSV) != "" {
+				return shared.UsageError("--submission and --state are mutually exclusive")
+			}
+			states, err := parseSubmissionStates(*stateCSV)
+			if err != nil {
+				return err
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			session, err := resolveWebSessionForCommand(requestCtx, authFlags)
+			if err != nil {
+				return err
+			}
+			client := webcore.NewClient(session)
+
+			entries := make([]resolutionCenterThreadEntry, 0)
+			err = withWebSpinner("Loading Resolution Center threads", func() error {
+				submissions, err := client.ListReviewSubmissions(requestCtx, trimmedAppID)
+				if err != nil {
+					return err
+				}
+				if trimmedSubmissionID != "" {
+					selected, _, err := chooseSubmissionForShow(submissions, trimmedSubmissionID)
+					if err != nil {
+						return err
+					}
+					submissions = []webcore.ReviewSubmission{*selected}
+				} else {
+					submissions = filterSubmissionsByState(submissions, states)
+				}
+
+				for _, submission := range submissions {
+					threads, err := client.ListResolutionCenterThreadsBySubmission(requestCtx, submission.ID)
+					if err != nil {
+						return err
+					}
+					for _, thread := range threads {
+						entries = append(entries, newResolutionCenterThreadEntry(thread, submission))
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return withWebAuthHint(err, "resolution-center list")
+			}
+
+			return shared.PrintOutputWithRenderers(
+				entries,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderResolutionCenterListTable(entries) },
+				func() error { return renderResolutionCenterListMarkdown(entries) },
+			)
+		},
+	}
+}
+
+// ResolutionCenterGetCommand shows one Resolution Center thread.
+func ResolutionCenterGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("resolution-center get", flag.ExitOnError)
+
+	threadID := fs.String("id", "", "Resolution Center thread ID (required)")
+	plainText := fs.Bool("plain-text", false, "Project messageBody HTML into plain text")
+	outDir := fs.String("out", "", "Download attachments to this directory")
+	pattern := fs.String("pattern", "", "Only download attachments whose filename matches this glob (requires --out)")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files instead of suffixing (requires --out)")
+	authFlags := bindWebSessionFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc resolution-center get --id THREAD_ID [--out DIR] [flags]",
+		ShortHelp:  "[experimental] Show a thread's messages, rejection reasons, and attachments.",
+		LongHelp: `Show a Resolution Center thread's messages, rejection reasons, and attachments.
+
+Attachment metadata is always included; signed download URLs are redacted.
+With --out the downloadable attachments (such as App Review screenshots) are
+saved to that directory.
+
+Examples:
+  asc resolution-center get --id "THREAD_ID"
+  asc resolution-center get --id "THREAD_ID" --plain-text --output table
+  asc resolution-center get --id "THREAD_ID" --out ./rejection --pattern "*.png"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedThreadID := strings.TrimSpace(*threadID)
+			if trimmedThreadID == "" {
+				return shared.UsageError("--id is required")
+			}
+			trimmedOutDir := strings.TrimSpace(*outDir)
+			trimmedPattern := strings.TrimSpace(*pattern)
+			if trimmedOutDir == "" && (trimmedPattern != "" || *overwrite) {
+				return shared.UsageError("--pattern and --overwrite require --out")
+			}
+			if trimmedPattern != "" {
+				if _, err := filepath.Match(trimmedPattern, "sample.png"); err != nil {
+					return shared.UsageErrorf("--pattern is invalid: %v", err)
+				}
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			session, err := resolveWebSessionForCommand(requestCtx, authFlags)
+			if err != nil {
+				return err
+			}
+			client := webcore.NewClient(session)
+
+			var details webcore.ReviewThreadDetails
+			err = withWebSpinner("Loading Resolution Center thread", func() error {
+				var err error
+				details, err = client.ListReviewThreadDetails(requestCtx, trimmedThreadID, *plainText, trimmedOutDir != "")
+				return err
+			})
+			if err != nil {
+				return withWebAuthHint(err, "resolution-center get")
+			}
+
+			payload := resolutionCenterThreadOutput{
+				ThreadID:    trimmedThreadID,
+				Messages:    details.Messages,
+				Rejections:  details.Rejections,
+				Attachments: redactAttachmentURLs(details.Attachments),
+			}
+			if payload.Messages == nil {
+				payload.Messages = []webcore.ResolutionCenterMessage{}
+			}
+			if payload.Rejections == nil {
+				payload.Rejections = []webcore.ReviewRejection{}
+			}
+
+			if trimmedOutDir != "" {
+				err = withWebSpinner("Downloading review attachments", func() error {
+					var err error
+					payload.Downloads, payload.DownloadFailures, err = downloadReviewAttachments(
+						requestCtx,
+						client,
+						details.Attachments,
+						func(ctx context.Context) ([]webcore.ReviewAttachment, error) {
+							return client.ListReviewAttachmentsByThread(ctx, trimmedThreadID, true)
+						},
+						trimmedOutDir,
+						trimmedPattern,
+						*overwrite,
+					)
+					return err
+				})
+				if err != nil {
+					return err
+				}
+				if len(payload.Downloads) > 0 {
+					payload.OutputDirectory = trimmedOutDir
+				}
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderResolutionCenterThreadTable(payload) },
+				func() error { return renderResolutionCenterThreadMarkdown(payload) },
+			); err != nil {
+				return err
+			}
+			if len(payload.DownloadFailures) > 0 {
+				return fmt.Errorf("resolution-center get completed with %d download failure(s)", len(payload.DownloadFailures))
+			}
+			return nil
+		},
+	}
+}
+
+func newResolutionCenterThreadEntry(thread webcore.ResolutionCenterThread, submission webcore.ReviewSubmission) resolutionCenterThreadEntry {
+	if strings.TrimSpace(thread.ReviewSubmissionID) == "" {
+		thread.ReviewSubmissionID = submission.ID
+	}
+	entry := resolutionCenterThreadEntry{
+		ResolutionCenterThread: thread,
+		SubmissionState:        submission.State,
+		Platform:               submission.Platform,
+	}
+	if submission.AppStoreVersionForReview != nil {
+		entry.Version = submission.AppStoreVersionForReview.Version
+		if entry.Platform == "" {
+			entry.Platform = submission.AppStoreVersionForReview.Platform
+		}
+	}
+	return entry
+}
+
+func buildResolutionCenterListRows(entries []resolutionCenterThreadEntry) [][]string {
+	rows := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, []string{
+			normalizeReviewShowValue(entry.ID),
+			normalizeReviewShowValue(entry.ReviewSubmissionID),
+			normalizeReviewShowValue(entry.SubmissionState),
+			normalizeReviewShowValue(entry.Version),
+			normalizeReviewShowValue(entry.Platform),
+			normalizeReviewShowValue(entry.ThreadType),
+			normalizeReviewShowValue(entry.State),
+			normalizeReviewShowValue(entry.CreatedDate),
+			normalizeReviewShowValue(entry.LastMessageResponseDate),
+		})
+	}
+	return rows
+}
+
+var resolutionCenterListHeaders = []string{"Thread ID", "Submission ID", "Submission State", "Version", "Platform", "Thread Type", "State", "Created Date", "Last Response"}
+
+func renderResolutionCenterListTable(entries []resolutionCenterThreadEntry) error {
+	asc.RenderTable(resolutionCenterListHeaders, buildResolutionCenterListRows(entries))
+	return nil
+}
+
+func renderResolutionCenterListMarkdown(entries []resolutionCenterThreadEntry) error {
+	asc.RenderMarkdown(resolutionCenterListHeaders, buildResolutionCenterListRows(entries))
+	return nil
+}
+
+func buildResolutionCenterThreadRows(payload resolutionCenterThreadOutput) [][]string {
+	rows := make([][]string, 0)
+	addRow := func(section, field, value string) {
+		rows = append(rows, []string{
+			normalizeReviewShowValue(section),
+			normalizeReviewShowValue(field),
+			normalizeReviewShowValue(value),
+		})
+	}
+
+	addRow("Thread", "Thread ID", payload.ThreadID)
+	addRow("Thread", "Messages Count", fmt.Sprintf("%d", len(payload.Messages)))
+	addRow("Thread", "Rejections Count", fmt.Sprintf("%d", len(payload.Rejections)))
+	addRow("Thread", "Attachments Count", fmt.Sprintf("%d", len(payload.Attachments)))
+	if strings.TrimSpace(payload.OutputDirectory) != "" {
+		addRow("Thread", "Output Directory", payload.OutputDirectory)
+	}
+
+	for index, message := range payload.Messages {
+		addRow("Messages", fmt.Sprintf("Message %d", index+1), summarizeMessageForTable(message))
+	}
+	reasonIndex := 0
+	for _, rejection := range payload.Rejections {
+		for _, reason := range rejection.Reasons {
+			reasonIndex++
+			addRow("Rejections", fmt.Sprintf("Reason %d", reasonIndex), summarizeReasonForTable(reason))
+		}
+	}
+	for index, attachment := range payload.Attachments {
+		addRow(
+			"Attachments",
+			fmt.Sprintf("Attachment %d", index+1),
+			fmt.Sprintf(
+				"id=%s file=%s size=%d downloadable=%t",
+				attachment.AttachmentID,
+				normalizeAttachmentFilename(attachment),
+				attachment.FileSize,
+				attachment.Downloadable,
+			),
+		)
+	}
+	for index, download := range payload.Downloads {
+		addRow("Downloads", fmt.Sprintf("Downloaded %d", index+1), fmt.Sprintf("id=%s path=%s", download.AttachmentID, download.Path))
+	}
+	for index, failure := range payload.DownloadFailures {
+		addRow("Download Failures", fmt.Sprintf("Failure %d", index+1), failure)
+	}
+	return rows
+}
+
+func renderResolutionCenterThreadTable(payload resolutionCenterThreadOutput) error {
+	asc.RenderTable([]string{"Section", "Field", "Value"}, buildResolutionCenterThreadRows(payload))
+	return nil
+}
+
+func renderResolutionCenterThreadMarkdown(payload resolutionCenterThreadOutput) error {
+	asc.RenderMarkdown([]string{"Section", "Field", "Value"}, buildResolutionCenterThreadRows(payload))
+	return nil
+}
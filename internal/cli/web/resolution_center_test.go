@@ -0,0 +1,234 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
+)
+
+func stubResolutionCenterSession(t *testing.T, bodies map[string]string) *[]string {
+	t.Helper()
+	origResolveSession := resolveSessionFn
+	t.Cleanup(func() { resolveSessionFn = origResolveSession })
+
+	var requests []string
+	resolveSessionFn = func(ctx context.Context, appleID, password, twoFactorCode string) (*webcore.AuthSession, string, error) {
+		return &webcore.AuthSession{
+			Client: &http.Client{
+				Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					key := req.URL.Path
+					if submission := req.URL.Query().Get("filter[reviewSubmission]"); submission != "" {
+						key += "?" + submission
+					}
+					requests = append(requests, key)
+					body, ok := bodies[key]
+					if !ok {
+						t.Fatalf("unexpected request: %s", req.URL.String())
+					}
+					contentType := "application/json"
+					if strings.HasPrefix(key, "/download/") {
+						contentType = "image/png"
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Content-Type": []string{contentType}},
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Request:    req,
+					}, nil
+				}),
+			},
+		}, "cache", nil
+	}
+	return &requests
+}
+
+func TestResolutionCenterListFiltersSubmissionsByState(t *testing.T) {
+	requests := stubResolutionCenterSession(t, map[string]string{
+		"/iris/v1/apps/app-1/reviewSubmissions": `{
+			"data": [
+				{"id":"sub-1","type":"reviewSubmissions","attributes":{"state":"UNRESOLVED_ISSUES","platform":"IOS"}},
+				{"id":"sub-2","type":"reviewSubmissions","attributes":{"state":"COMPLETE","platform":"IOS"}}
+			]
+		}`,
+		"/iris/v1/resolutionCenterThreads?sub-1": `{
+			"data": [{
+				"id":"thread-1",
+				"type":"resolutionCenterThreads",
+				"attributes":{"threadType":"REJECTION_REVIEW_SUBMISSION","state":"ACTIVE","createdDate":"2026-02-25T00:00:00Z"}
+			}]
+		}`,
+	})
+
+	cmd := ResolutionCenterListCommand()
+	if err := cmd.FlagSet.Parse([]string{"--app", "app-1", "--state", "UNRESOLVED_ISSUES", "--output", "json"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); err != nil {
+			t.Fatalf("exec error: %v", err)
+		}
+	})
+
+	var entries []struct {
+		ID                 string `json:"id"`
+		ReviewSubmissionID string `json:"reviewSubmissionId"`
+		SubmissionState    string `json:"submissionState"`
+		Platform           string `json:"platform"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &entries); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if len(entries) != 1 || entries[0].ID != "thread-1" || entries[0].ReviewSubmissionID != "sub-1" || entries[0].SubmissionState != "UNRESOLVED_ISSUES" || entries[0].Platform != "IOS" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	for _, request := range *requests {
+		if strings.HasSuffix(request, "?sub-2") {
+			t.Fatalf("expected filtered submission to be skipped, got requests %v", *requests)
+		}
+	}
+}
+
+func TestResolutionCenterGetDownloadsAttachments(t *testing.T) {
+	stubResolutionCenterSession(t, map[string]string{
+		"/iris/v1/resolutionCenterThreads/thread-1/resolutionCenterMessages": `{
+			"data": [{
+				"id": "m1",
+				"type": "resolutionCenterMessages",
+				"attributes": {"createdDate":"2026-02-25T10:00:00Z","messageBody":"<p>Guideline 2.1</p>"},
+				"relationships": {
+					"resolutionCenterMessageAttachments": {"data": [{"type":"resolutionCenterMessageAttachments","id":"att-1"}]}
+				}
+			}],
+			"included": [{
+				"id":"att-1",
+				"type":"resolutionCenterMessageAttachments",
+				"attributes":{
+					"fileName":"Screenshot-1.png",
+					"fileSize":3,
+					"assetDeliveryState":"AVAILABLE",
+					"downloadUrl":"https://iosapps-ssl.itunes.apple.com/download/att-1"
+				}
+			}]
+		}`,
+		"/iris/v1/reviewRejections": `{
+			"data": [{
+				"id": "rej-1",
+				"type": "reviewRejections",
+				"attributes": {"reasons":[{"reasonSection":"2.1","reasonDescription":"App Completeness","reasonCode":"2.1.0"}]}
+			}]
+		}`,
+		"/download/att-1": "png",
+	})
+
+	outDir := filepath.Join(t.TempDir(), "rejection")
+	cmd := ResolutionCenterGetCommand()
+	if err := cmd.FlagSet.Parse([]string{"--id", "thread-1", "--plain-text", "--out", outDir, "--output", "json"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); err != nil {
+			t.Fatalf("exec error: %v", err)
+		}
+	})
+
+	var payload struct {
+		ThreadID string `json:"threadId"`
+		Messages []struct {
+			MessageBodyPlain string `json:"messageBodyPlain"`
+		} `json:"messages"`
+		Rejections []struct {
+			Reasons []struct {
+				ReasonSection string `json:"reasonSection"`
+			} `json:"reasons"`
+		} `json:"rejections"`
+		Attachments []struct {
+			AttachmentID string `json:"attachmentId"`
+			DownloadURL  string `json:"downloadUrl"`
+		} `json:"attachments"`
+		Downloads []struct {
+			Path string `json:"path"`
+		} `json:"downloads"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout, err)
+	}
+	if payload.ThreadID != "thread-1" || len(payload.Messages) != 1 || payload.Messages[0].MessageBodyPlain != "Guideline 2.1" {
+		t.Fatalf("unexpected messages: %+v", payload)
+	}
+	if len(payload.Rejections) != 1 || len(payload.Rejections[0].Reasons) != 1 || payload.Rejections[0].Reasons[0].ReasonSection != "2.1" {
+		t.Fatalf("unexpected rejections: %+v", payload.Rejections)
+	}
+	if len(payload.Attachments) != 1 || payload.Attachments[0].DownloadURL != "" {
+		t.Fatalf("expected redacted attachment, got %+v", payload.Attachments)
+	}
+	if len(payload.Downloads) != 1 {
+		t.Fatalf("expected one download, got %+v", payload.Downloads)
+	}
+	data, err := os.ReadFile(payload.Downloads[0].Path)
+	if err != nil || string(data) != "png" {
+		t.Fatalf("expected downloaded attachment, got %q (%v)", data, err)
+	}
+}
+
+func TestResolutionCenterValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     func() error
+		wantErr string
+	}{
+		{
+			name: "list conflicting selectors",
+			cmd: func() error {
+				cmd := ResolutionCenterListCommand()
+				if err := cmd.FlagSet.Parse([]string{"--app", "app-1", "--submission", "sub-1", "--state", "COMPLETE"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				return cmd.Exec(context.Background(), nil)
+			},
+			wantErr: "--submission and --state are mutually exclusive",
+		},
+		{
+			name: "get missing id",
+			cmd: func() error {
+				cmd := ResolutionCenterGetCommand()
+				return cmd.Exec(context.Background(), nil)
+			},
+			wantErr: "--id is required",
+		},
+		{
+			name: "get pattern without out",
+			cmd: func() error {
+				cmd := ResolutionCenterGetCommand()
+				if err := cmd.FlagSet.Parse([]string{"--id", "thread-1", "--pattern", "*.png"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				return cmd.Exec(context.Background(), nil)
+			},
+			wantErr: "--pattern and --overwrite require --out",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, stderr := captureOutput(t, func() {
+				if err := test.cmd(); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -45,6 +45,9 @@ func readPasswordFromInput() (string, error) {
 	if password != "" {
 		return password, nil
 	}
+	if err := shared.RequireInputAllowed("set " + webPasswordEnv); err != nil {
+		return "", err
+	}
 	password, err := promptPasswordFn()
 	if err != nil {
 		return "", err
@@ -116,6 +119,9 @@ func readTwoFactorCodeFromTerminalFD(fd int, writer io.Writer) (string, error) {
 }
 
 func promptTwoFactorCodeInteractive() (string, error) {
+	if shared.NoInputEnabled() {
+		return "", fmt.Errorf("2fa required: re-run with --two-factor-code")
+	}
 	if tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0); err == nil {
 		defer func() { _ = tty.Close() }()
 		return readTwoFactorCodeFromTerminalFD(int(tty.Fd()), tty)
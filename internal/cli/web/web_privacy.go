@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
@@ -41,14 +42,14 @@ var (
 )
 
 type privacyDeclarationFile struct {
-	SchemaVersion int            `json:"schemaVersion"`
-	DataUsages    []privacyUsage `json:"dataUsages"`
+	SchemaVersion int            `json:"schemaVersion" yaml:"schemaVersion"`
+	DataUsages    []privacyUsage `json:"dataUsages" yaml:"dataUsages"`
 }
 
 type privacyUsage struct {
-	Category        string   `json:"category,omitempty"`
-	Purposes        []string `json:"purposes,omitempty"`
-	DataProtections []string `json:"dataProtections"`
+	Category        string   `json:"category,omitempty" yaml:"category,omitempty"`
+	Purposes        []string `json:"purposes,omitempty" yaml:"purposes,omitempty"`
+	DataProtections []string `json:"dataProtections" yaml:"dataProtections"`
 }
 
 type privacyTuple struct {
@@ -723,6 +724,81 @@ func validateApplyPlanUsageIDs(plan privacyPlanOutput) error {
 	return nil
 }
 
+// pullPrivacyDeclaration loads the app's data usages as a canonical
+// declaration along with its publish state.
+func pullPrivacyDeclaration(ctx context.Context, client *webcore.Client, appID string) (privacyPullOutput, error) {
+	var (
+		remoteUsages []webcore.AppDataUsage
+		publishState *webcore.AppDataUsagesPublishState
+	)
+	err := withWebSpinner("Loading app privacy state", func() error {
+		var err error
+		remoteUsages, err = client.ListAppDataUsages(ctx, appID)
+		if err != nil {
+			return err
+		}
+		publishState, err = client.GetAppDataUsagesPublishState(ctx, appID)
+		return err
+	})
+	if err != nil {
+		return privacyPullOutput{}, err
+	}
+
+	return privacyPullOutput{
+		AppID:       appID,
+		Declaration: declarationFromRemoteDataUsages(remoteUsages),
+		PublishState: privacyPublishState{
+			ID:        strings.TrimSpace(publishState.ID),
+			Published: publishState.Published,
+		},
+	}, nil
+}
+
+// planPrivacyChanges diffs the desired tuples against the app's remote data
+// usages.
+func planPrivacyChanges(ctx context.Context, client *webcore.Client, appID, filePath string, desired map[string]privacyTuple) (privacyPlanOutput, error) {
+	plan := privacyPlanOutput{}
+	err := withWebSpinner("Planning privacy changes", func() error {
+		remoteUsages, err := client.ListAppDataUsages(ctx, appID)
+		if err != nil {
+			return err
+		}
+		plan = planFromDesiredAndRemote(appID, filePath, desired, remoteStateFromDataUsages(remoteUsages))
+		return nil
+	})
+	return plan, err
+}
+
+// publishPrivacyDeclarations publishes the app's data usages unless they are
+// already published.
+func publishPrivacyDeclarations(ctx context.Context, client *webcore.Client, appID string) (privacyPublishOutput, error) {
+	stateBefore, err := withWebSpinnerValue("Loading privacy publish state", func() (*webcore.AppDataUsagesPublishState, error) {
+		return client.GetAppDataUsagesPublishState(ctx, appID)
+	})
+	if err != nil {
+		return privacyPublishOutput{}, err
+	}
+	stateAfter := stateBefore
+	if !stateBefore.Published {
+		stateAfter, err = withWebSpinnerValue("Publishing app privacy declarations", func() (*webcore.AppDataUsagesPublishState, error) {
+			return client.SetAppDataUsagesPublished(ctx, stateBefore.ID, true)
+		})
+		if err != nil {
+			return privacyPublishOutput{}, err
+		}
+	}
+
+	return privacyPublishOutput{
+		AppID: appID,
+		PublishState: privacyPublishState{
+			ID:        strings.TrimSpace(stateAfter.ID),
+			Published: stateAfter.Published,
+		},
+		WasPublished: stateBefore.Published,
+		Changed:      !stateBefore.Published && stateAfter.Published,
+	}, nil
+}
+
 func parsePrivacyDeclarationFile(path string) (privacyDeclarationFile, error) {
 	path = strings.TrimSpace(path)
 	if path == "" {
@@ -732,6 +808,35 @@ func parsePrivacyDeclarationFile(path string) (privacyDeclarationFile, error) {
 	if err != nil {
 		return privacyDeclarationFile{}, fmt.Errorf("failed to read %s: %w", path, err)
 	}
+	var declaration privacyDeclarationFile
+	if isPrivacyYAMLPath(path) {
+		declaration, err = decodePrivacyDeclarationYAML(data)
+	} else {
+		declaration, err = decodePrivacyDeclarationJSON(data)
+	}
+	if err != nil {
+		return privacyDeclarationFile{}, err
+	}
+
+	tuples, err := declarationToTupleSet(declaration)
+	if err != nil {
+		return privacyDeclarationFile{}, err
+	}
+	return declarationFromTupleSet(tuples), nil
+}
+
+// isPrivacyYAMLPath reports whether a declaration file path uses YAML; any
+// other extension is read and written as JSON.
+func isPrivacyYAMLPath(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+func decodePrivacyDeclarationJSON(data []byte) (privacyDeclarationFile, error) {
 	var declaration privacyDeclarationFile
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	decoder.DisallowUnknownFields()
@@ -745,12 +850,47 @@ func parsePrivacyDeclarationFile(path string) (privacyDeclarationFile, error) {
 		}
 		return privacyDeclarationFile{}, fmt.Errorf("invalid privacy declaration JSON: %w", err)
 	}
+	return declaration, nil
+}
 
-	tuples, err := declarationToTupleSet(declaration)
+func decodePrivacyDeclarationYAML(data []byte) (privacyDeclarationFile, error) {
+	var declaration privacyDeclarationFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&declaration); err != nil {
+		if err == io.EOF {
+			return privacyDeclarationFile{}, fmt.Errorf("invalid privacy declaration YAML: file is empty")
+		}
+		return privacyDeclarationFile{}, fmt.Errorf("invalid privacy declaration YAML: %w", err)
+	}
+	var trailing yaml.Node
+	if err := decoder.Decode(&trailing); err != io.EOF {
+		if err == nil {
+			return privacyDeclarationFile{}, fmt.Errorf("invalid privacy declaration YAML: multiple YAML documents found")
+		}
+		return privacyDeclarationFile{}, fmt.Errorf("invalid privacy declaration YAML: %w", err)
+	}
+	return declaration, nil
+}
+
+func marshalPrivacyDeclaration(path string, declaration privacyDeclarationFile) ([]byte, error) {
+	if isPrivacyYAMLPath(path) {
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(declaration); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	data, err := json.MarshalIndent(declaration, "", "  ")
 	if err != nil {
-		return privacyDeclarationFile{}, err
+		return nil, err
 	}
-	return declarationFromTupleSet(tuples), nil
+	return append(data, '\n'), nil
 }
 
 func writePrivacyDeclarationFile(path string, declaration privacyDeclarationFile) error {
@@ -761,12 +901,11 @@ func writePrivacyDeclarationFile(path string, declaration privacyDeclarationFile
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
-	jsonData, err := json.MarshalIndent(declaration, "", "  ")
+	data, err := marshalPrivacyDeclaration(path, declaration)
 	if err != nil {
 		return fmt.Errorf("failed to marshal privacy declaration: %w", err)
 	}
-	jsonData = append(jsonData, '\n')
-	if err := os.WriteFile(path, jsonData, 0o600); err != nil {
+	if err := os.WriteFile(path, data, 0o600); err != nil {
 		return fmt.Errorf("failed to write %s: %w", path, err)
 	}
 	return nil
@@ -1224,7 +1363,7 @@ func WebPrivacyPullCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("web privacy pull", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App ID (or ASC_APP_ID env)")
-	out := fs.String("out", "", "Optional output file path for canonical declaration JSON")
+	out := fs.String("out", "", "Optional output file path for the canonical declaration (.json, or .yaml/.yml for YAML)")
 	authFlags := bindWebSessionFlags(fs)
 	output := shared.BindOutputFlags(fs)
 
@@ -1235,7 +1374,8 @@ func WebPrivacyPullCommand() *ffcli.Command {
 		LongHelp: `EXPERIMENTAL / UNOFFICIAL / DISCOURAGED
 
 Fetch current app data usage declarations from web-session endpoints and emit
-canonical JSON that can be used with plan/apply.
+canonical JSON that can be used with plan/apply. Use a .yaml or .yml --out
+path to write the declaration as YAML.
 
 Examples:
   asc web privacy pull --app "123456789"
@@ -1260,39 +1400,15 @@ Examples:
 			}
 			client := webcore.NewClient(session)
 
-			var (
-				remoteUsages []webcore.AppDataUsage
-				publishState *webcore.AppDataUsagesPublishState
-			)
-			err = withWebSpinner("Loading app privacy state", func() error {
-				var err error
-				remoteUsages, err = client.ListAppDataUsages(requestCtx, resolvedAppID)
-				if err != nil {
-					return err
-				}
-				publishState, err = client.GetAppDataUsagesPublishState(requestCtx, resolvedAppID)
-				return err
-			})
+			payload, err := pullPrivacyDeclaration(requestCtx, client, resolvedAppID)
 			if err != nil {
 				return withWebAuthHint(err, "web privacy pull")
 			}
-
-			declaration := declarationFromRemoteDataUsages(remoteUsages)
-			outPath := strings.TrimSpace(*out)
-			if outPath != "" {
-				if err := writePrivacyDeclarationFile(outPath, declaration); err != nil {
+			if outPath := strings.TrimSpace(*out); outPath != "" {
+				if err := writePrivacyDeclarationFile(outPath, payload.Declaration); err != nil {
 					return err
 				}
-			}
-
-			payload := privacyPullOutput{
-				AppID:       resolvedAppID,
-				Declaration: declaration,
-				PublishState: privacyPublishState{
-					ID:        strings.TrimSpace(publishState.ID),
-					Published: publishState.Published,
-				},
-				Out: outPath,
+				payload.Out = outPath
 			}
 			return shared.PrintOutputWithRenderers(
 				payload,
@@ -1310,7 +1426,7 @@ func WebPrivacyPlanCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("web privacy plan", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App ID (or ASC_APP_ID env)")
-	filePath := fs.String("file", "", "Path to canonical privacy declaration JSON")
+	filePath := fs.String("file", "", "Path to the privacy declaration (.json, or .yaml/.yml for YAML)")
 	authFlags := bindWebSessionFlags(fs)
 	output := shared.BindOutputFlags(fs)
 
@@ -1357,16 +1473,7 @@ Examples:
 				return err
 			}
 			client := webcore.NewClient(session)
-			plan := privacyPlanOutput{}
-			err = withWebSpinner("Planning privacy changes", func() error {
-				remoteUsages, err := client.ListAppDataUsages(requestCtx, resolvedAppID)
-				if err != nil {
-					return err
-				}
-				remoteState := remoteStateFromDataUsages(remoteUsages)
-				plan = planFromDesiredAndRemote(resolvedAppID, resolvedFilePath, desiredTuples, remoteState)
-				return nil
-			})
+			plan, err := planPrivacyChanges(requestCtx, client, resolvedAppID, resolvedFilePath, desiredTuples)
 			if err != nil {
 				return withWebAuthHint(err, "web privacy plan")
 			}
@@ -1387,7 +1494,7 @@ func WebPrivacyApplyCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("web privacy apply", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App ID (or ASC_APP_ID env)")
-	filePath := fs.String("file", "", "Path to canonical privacy declaration JSON")
+	filePath := fs.String("file", "", "Path to the privacy declaration (.json, or .yaml/.yml for YAML)")
 	allowDeletes := fs.Bool("allow-deletes", false, "Allow delete operations when remote tuples are missing locally")
 	confirm := fs.Bool("confirm", false, "Confirm delete operations (required with --allow-deletes)")
 	authFlags := bindWebSessionFlags(fs)
@@ -1440,16 +1547,7 @@ Examples:
 				return err
 			}
 			client := webcore.NewClient(session)
-			plan := privacyPlanOutput{}
-			err = withWebSpinner("Planning privacy changes", func() error {
-				remoteUsages, err := client.ListAppDataUsages(requestCtx, resolvedAppID)
-				if err != nil {
-					return err
-				}
-				remoteState := remoteStateFromDataUsages(remoteUsages)
-				plan = planFromDesiredAndRemote(resolvedAppID, resolvedFilePath, desiredTuples, remoteState)
-				return nil
-			})
+			plan, err := planPrivacyChanges(requestCtx, client, resolvedAppID, resolvedFilePath, desiredTuples)
 			if err != nil {
 				return withWebAuthHint(err, "web privacy apply")
 			}
@@ -1532,31 +1630,10 @@ Examples:
 			}
 			client := webcore.NewClient(session)
 
-			stateBefore, err := withWebSpinnerValue("Loading privacy publish state", func() (*webcore.AppDataUsagesPublishState, error) {
-				return client.GetAppDataUsagesPublishState(requestCtx, resolvedAppID)
-			})
+			payload, err := publishPrivacyDeclarations(requestCtx, client, resolvedAppID)
 			if err != nil {
 				return withWebAuthHint(err, "web privacy publish")
 			}
-			stateAfter := stateBefore
-			if !stateBefore.Published {
-				stateAfter, err = withWebSpinnerValue("Publishing app privacy declarations", func() (*webcore.AppDataUsagesPublishState, error) {
-					return client.SetAppDataUsagesPublished(requestCtx, stateBefore.ID, true)
-				})
-				if err != nil {
-					return withWebAuthHint(err, "web privacy publish")
-				}
-			}
-
-			payload := privacyPublishOutput{
-				AppID: resolvedAppID,
-				PublishState: privacyPublishState{
-					ID:        strings.TrimSpace(stateAfter.ID),
-					Published: stateAfter.Published,
-				},
-				WasPublished: stateBefore.Published,
-				Changed:      !stateBefore.Published && stateAfter.Published,
-			}
 			return shared.PrintOutputWithRenderers(
 				payload,
 				*output.Output,
@@ -603,11 +603,13 @@ func buildThreadDetails(ctx context.Context, client *webcore.Client, threads []w
 	return details, attachments, nil
 }
 
-func downloadAttachmentsForShow(
+// downloadReviewAttachments saves downloadable attachments to outDir. Signed
+// URLs expire, so on 403/410 refresh is called once to fetch fresh ones.
+func downloadReviewAttachments(
 	ctx context.Context,
 	client *webcore.Client,
 	attachments []webcore.ReviewAttachment,
-	submissionID string,
+	refresh func(context.Context) ([]webcore.ReviewAttachment, error),
 	outDir string,
 	pattern string,
 	overwrite bool,
@@ -646,7 +648,7 @@ func downloadAttachmentsForShow(
 
 		if downloadErr != nil && (statusCode == http.StatusForbidden || statusCode == http.StatusGone) {
 			if refreshedIndex == nil {
-				refreshedAttachments, refreshErr := client.ListReviewAttachmentsBySubmission(ctx, submissionID, true)
+				refreshedAttachments, refreshErr := refresh(ctx)
 				if refreshErr != nil {
 					failures = append(failures, fmt.Sprintf("%s: refresh failed (%v)", attachment.FileName, refreshErr))
 					continue
@@ -727,9 +729,9 @@ func WebReviewListCommand() *ffcli.Command {
 		FlagSet:    fs,
 		UsageFunc:  shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			trimmedAppID := strings.TrimSpace(*appID)
+			trimmedAppID := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
 			if trimmedAppID == "" {
-				return shared.UsageError("--app is required")
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
 			states, err := parseSubmissionStates(*stateCSV)
 			if err != nil {
@@ -796,9 +798,9 @@ Selection:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			trimmedAppID := strings.TrimSpace(*appID)
+			trimmedAppID := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
 			if trimmedAppID == "" {
-				return shared.UsageError("--app is required")
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
 			trimmedPattern := strings.TrimSpace(*pattern)
 			if trimmedPattern != "" {
@@ -866,11 +868,13 @@ Selection:
 			)
 			err = withWebSpinner("Downloading review attachments", func() error {
 				var err error
-				downloads, downloadFailures, err = downloadAttachmentsForShow(
+				downloads, downloadFailures, err = downloadReviewAttachments(
 					requestCtx,
 					client,
 					attachmentsWithURL,
-					selectedSubmission.ID,
+					func(ctx context.Context) ([]webcore.ReviewAttachment, error) {
+						return client.ListReviewAttachmentsBySubmission(ctx, selectedSubmission.ID, true)
+					},
 					outDirResolved,
 					trimmedPattern,
 					*overwrite,
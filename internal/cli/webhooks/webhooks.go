@@ -32,10 +32,11 @@ Examples:
   asc webhooks update --webhook-id "WEBHOOK_ID" --url "https://new-url.com/webhook" --enabled false
   asc webhooks delete --webhook-id "WEBHOOK_ID" --confirm
   asc webhooks serve --port 8787 --dir ./webhook-events
-  asc webhooks deliveries --webhook-id "WEBHOOK_ID"
-  asc webhooks deliveries relationships --webhook-id "WEBHOOK_ID"
+  asc webhooks deliveries list --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z"
+  asc webhooks deliveries links --webhook-id "WEBHOOK_ID"
   asc webhooks deliveries redeliver --delivery-id "DELIVERY_ID"
-  asc webhooks ping --webhook-id "WEBHOOK_ID"`,
+  asc webhooks ping --webhook-id "WEBHOOK_ID"
+  asc webhooks verify --secret "secret123" --payload ./event.json --signature "hmacsha256=3f2a..."`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -47,6 +48,7 @@ Examples:
 			WebhooksServeCommand(),
 			WebhookDeliveriesCommand(),
 			WebhookPingCommand(),
+			WebhooksVerifyCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -390,28 +392,26 @@ Examples:
 // WebhookDeliveriesCommand returns the webhook deliveries command.
 func WebhookDeliveriesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("deliveries", flag.ExitOnError)
-
-	webhookID := fs.String("webhook-id", "", "Webhook ID")
-	createdAfter := fs.String("created-after", "", "Filter deliveries created after or equal to a timestamp")
-	createdBefore := fs.String("created-before", "", "Filter deliveries created before a timestamp")
-	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
-	next := fs.String("next", "", "Fetch next page using a links.next URL")
-	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := shared.BindOutputFlags(fs)
+	listFlags := bindWebhookDeliveriesListFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "deliveries",
-		ShortUsage: "asc webhooks deliveries --webhook-id WEBHOOK_ID [flags]",
-		ShortHelp:  "List webhook deliveries.",
-		LongHelp: `List webhook deliveries.
+		ShortUsage: "asc webhooks deliveries [list|links|redeliver] --webhook-id WEBHOOK_ID [flags]",
+		ShortHelp:  "List and redeliver webhook deliveries.",
+		LongHelp: `List and redeliver webhook deliveries.
+
+Running "asc webhooks deliveries" without a subcommand is the same as
+"asc webhooks deliveries list".
 
 Examples:
-  asc webhooks deliveries --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z"
-  asc webhooks deliveries --webhook-id "WEBHOOK_ID" --limit 10
-  asc webhooks deliveries --webhook-id "WEBHOOK_ID" --paginate`,
+  asc webhooks deliveries list --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z"
+  asc webhooks deliveries --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z" --limit 10
+  asc webhooks deliveries --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z" --paginate
+  asc webhooks deliveries redeliver --delivery-id "DELIVERY_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.VisibleUsageFunc,
 		Subcommands: []*ffcli.Command{
+			WebhookDeliveriesListCommand(),
 			WebhookDeliveriesRelationshipsCommand(),
 			shared.DeprecatedAliasLeafCommand(
 				WebhookDeliveriesRelationshipsCommand(),
@@ -423,92 +423,141 @@ Examples:
 			WebhookDeliveriesRedeliverCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
-			trimmedID := strings.TrimSpace(*webhookID)
-			trimmedNext := strings.TrimSpace(*next)
-			if trimmedID == "" && trimmedNext == "" {
-				fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-				return flag.ErrHelp
-			}
-			filterCount := 0
-			if strings.TrimSpace(*createdAfter) != "" {
-				filterCount++
-			}
-			if strings.TrimSpace(*createdBefore) != "" {
-				filterCount++
-			}
-			if trimmedNext == "" {
-				if filterCount == 0 {
-					fmt.Fprintln(os.Stderr, "Error: --created-after or --created-before is required")
-					return flag.ErrHelp
-				}
-				if filterCount > 1 {
-					fmt.Fprintln(os.Stderr, "Error: only one of --created-after or --created-before can be used")
-					return flag.ErrHelp
-				}
-			}
-			if *limit != 0 && (*limit < 1 || *limit > webhooksMaxLimit) {
-				return fmt.Errorf("webhooks deliveries: --limit must be between 1 and %d", webhooksMaxLimit)
-			}
-			if err := shared.ValidateNextURL(*next); err != nil {
-				return fmt.Errorf("webhooks deliveries: %w", err)
-			}
+			return listFlags.exec(ctx, "webhooks deliveries")
+		},
+	}
+}
 
-			client, err := shared.GetASCClient()
-			if err != nil {
-				return fmt.Errorf("webhooks deliveries: %w", err)
-			}
+// WebhookDeliveriesListCommand returns the webhook deliveries list subcommand.
+func WebhookDeliveriesListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	listFlags := bindWebhookDeliveriesListFlags(fs)
 
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc webhooks deliveries list --webhook-id WEBHOOK_ID [flags]",
+		ShortHelp:  "List webhook deliveries.",
+		LongHelp: `List webhook deliveries.
 
-			opts := []asc.WebhookDeliveriesOption{
-				asc.WithWebhookDeliveriesLimit(*limit),
-				asc.WithWebhookDeliveriesNextURL(*next),
-			}
-			if strings.TrimSpace(*createdAfter) != "" {
-				values := shared.SplitCSV(*createdAfter)
-				if len(values) == 0 {
-					fmt.Fprintln(os.Stderr, "Error: --created-after must include at least one value")
-					return flag.ErrHelp
-				}
-				opts = append(opts, asc.WithWebhookDeliveriesCreatedAfter(values))
-			}
-			if strings.TrimSpace(*createdBefore) != "" {
-				values := shared.SplitCSV(*createdBefore)
-				if len(values) == 0 {
-					fmt.Fprintln(os.Stderr, "Error: --created-before must include at least one value")
-					return flag.ErrHelp
-				}
-				opts = append(opts, asc.WithWebhookDeliveriesCreatedBefore(values))
-			}
+Examples:
+  asc webhooks deliveries list --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z"
+  asc webhooks deliveries list --webhook-id "WEBHOOK_ID" --created-before "2026-02-01T00:00:00Z" --limit 10
+  asc webhooks deliveries list --webhook-id "WEBHOOK_ID" --created-after "2026-01-01T00:00:00Z" --paginate`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return listFlags.exec(ctx, "webhooks deliveries list")
+		},
+	}
+}
 
-			if *paginate {
-				if trimmedID == "" {
-					fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
-					return flag.ErrHelp
-				}
-				paginateOpts := append(opts, asc.WithWebhookDeliveriesLimit(webhooksMaxLimit))
-				firstPage, err := client.GetWebhookDeliveries(requestCtx, trimmedID, paginateOpts...)
-				if err != nil {
-					return fmt.Errorf("webhooks deliveries: failed to fetch: %w", err)
-				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-					return client.GetWebhookDeliveries(ctx, trimmedID, asc.WithWebhookDeliveriesNextURL(nextURL))
-				})
-				if err != nil {
-					return fmt.Errorf("webhooks deliveries: %w", err)
-				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
-			}
+type webhookDeliveriesListFlags struct {
+	webhookID     *string
+	createdAfter  *string
+	createdBefore *string
+	limit         *int
+	next          *string
+	paginate      *bool
+	output        shared.OutputFlags
+}
 
-			deliveries, err := client.GetWebhookDeliveries(requestCtx, trimmedID, opts...)
-			if err != nil {
-				return fmt.Errorf("webhooks deliveries: failed to fetch: %w", err)
-			}
+func bindWebhookDeliveriesListFlags(fs *flag.FlagSet) webhookDeliveriesListFlags {
+	return webhookDeliveriesListFlags{
+		webhookID:     fs.String("webhook-id", "", "Webhook ID"),
+		createdAfter:  fs.String("created-after", "", "Filter deliveries created after or equal to a timestamp"),
+		createdBefore: fs.String("created-before", "", "Filter deliveries created before a timestamp"),
+		limit:         fs.Int("limit", 0, "Maximum results per page (1-200)"),
+		next:          fs.String("next", "", "Fetch next page using a links.next URL"),
+		paginate:      fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)"),
+		output:        shared.BindOutputFlags(fs),
+	}
+}
 
-			return shared.PrintOutput(deliveries, *output.Output, *output.Pretty)
-		},
+func (f webhookDeliveriesListFlags) exec(ctx context.Context, commandName string) error {
+	trimmedID := strings.TrimSpace(*f.webhookID)
+	trimmedNext := strings.TrimSpace(*f.next)
+	if trimmedID == "" && trimmedNext == "" {
+		fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
+		return flag.ErrHelp
+	}
+	filterCount := 0
+	if strings.TrimSpace(*f.createdAfter) != "" {
+		filterCount++
+	}
+	if strings.TrimSpace(*f.createdBefore) != "" {
+		filterCount++
+	}
+	if trimmedNext == "" {
+		if filterCount == 0 {
+			fmt.Fprintln(os.Stderr, "Error: --created-after or --created-before is required")
+			return flag.ErrHelp
+		}
+		if filterCount > 1 {
+			fmt.Fprintln(os.Stderr, "Error: only one of --created-after or --created-before can be used")
+			return flag.ErrHelp
+		}
+	}
+	if *f.limit != 0 && (*f.limit < 1 || *f.limit > webhooksMaxLimit) {
+		return fmt.Errorf("%s: --limit must be between 1 and %d", commandName, webhooksMaxLimit)
+	}
+	if err := shared.ValidateNextURL(*f.next); err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	opts := []asc.WebhookDeliveriesOption{
+		asc.WithWebhookDeliveriesLimit(*f.limit),
+		asc.WithWebhookDeliveriesNextURL(*f.next),
+	}
+	if strings.TrimSpace(*f.createdAfter) != "" {
+		values := shared.SplitCSV(*f.createdAfter)
+		if len(values) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: --created-after must include at least one value")
+			return flag.ErrHelp
+		}
+		opts = append(opts, asc.WithWebhookDeliveriesCreatedAfter(values))
+	}
+	if strings.TrimSpace(*f.createdBefore) != "" {
+		values := shared.SplitCSV(*f.createdBefore)
+		if len(values) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: --created-before must include at least one value")
+			return flag.ErrHelp
+		}
+		opts = append(opts, asc.WithWebhookDeliveriesCreatedBefore(values))
 	}
+
+	if *f.paginate {
+		if trimmedID == "" {
+			fmt.Fprintln(os.Stderr, "Error: --webhook-id is required")
+			return flag.ErrHelp
+		}
+		paginateOpts := append(opts, asc.WithWebhookDeliveriesLimit(webhooksMaxLimit))
+		firstPage, err := client.GetWebhookDeliveries(requestCtx, trimmedID, paginateOpts...)
+		if err != nil {
+			return fmt.Errorf("%s: failed to fetch: %w", commandName, err)
+		}
+		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetWebhookDeliveries(ctx, trimmedID, asc.WithWebhookDeliveriesNextURL(nextURL))
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", commandName, err)
+		}
+		return shared.PrintOutput(resp, *f.output.Output, *f.output.Pretty)
+	}
+
+	deliveries, err := client.GetWebhookDeliveries(requestCtx, trimmedID, opts...)
+	if err != nil {
+		return fmt.Errorf("%s: failed to fetch: %w", commandName, err)
+	}
+
+	return shared.PrintOutput(deliveries, *f.output.Output, *f.output.Pretty)
 }
 
 // WebhookDeliveriesRelationshipsCommand returns the webhook deliveries links subcommand.
@@ -108,7 +108,7 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --host is required")
 				return flag.ErrHelp
 			}
-			if !*allowRemote && !isLoopbackWebhookBindHost(bindHost) {
+			if !*allowRemote && !shared.IsLoopbackBindHost(bindHost) {
 				return shared.UsageErrorf("binding to non-loopback host %q requires --allow-remote", bindHost)
 			}
 			if *port < 0 || *port > 65535 {
@@ -525,15 +525,3 @@ func firstNonEmpty(values ...string) string {
 	}
 	return ""
 }
-
-func isLoopbackWebhookBindHost(host string) bool {
-	normalized := strings.TrimSpace(host)
-	if normalized == "" {
-		return false
-	}
-	if strings.EqualFold(normalized, "localhost") {
-		return true
-	}
-	ip := net.ParseIP(strings.Trim(normalized, "[]"))
-	return ip != nil && ip.IsLoopback()
-}
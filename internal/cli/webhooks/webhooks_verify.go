@@ -0,0 +1,175 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// webhookSignaturePrefix is the algorithm prefix App Store Connect uses in the
+// X-Apple-SIGNATURE header, e.g. "hmacsha256=<hex digest>".
+const webhookSignaturePrefix = "hmacsha256="
+
+type webhookVerifyResult struct {
+	Valid             bool   `json:"valid"`
+	Checked           bool   `json:"checked"`
+	Algorithm         string `json:"algorithm"`
+	ComputedSignature string `json:"computedSignature"`
+	ProvidedSignature string `json:"providedSignature,omitempty"`
+	PayloadBytes      int    `json:"payloadBytes"`
+}
+
+// WebhooksVerifyCommand returns the webhooks verify subcommand.
+func WebhooksVerifyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+
+	secret := fs.String("secret", "", "Webhook secret configured in App Store Connect")
+	payloadPath := fs.String("payload", "", "Path to the raw request body (use - for stdin)")
+	signature := fs.String("signature", "", "X-Apple-SIGNATURE header value to check (hmacsha256=HEX or HEX)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "verify",
+		ShortUsage: "asc webhooks verify --secret SECRET --payload FILE [--signature SIGNATURE] [flags]",
+		ShortHelp:  "Verify or compute a webhook payload signature.",
+		LongHelp: `Verify or compute a webhook payload signature.
+
+App Store Connect signs each delivery with HMAC-SHA256 of the raw request body
+using the webhook secret, and sends it in the X-Apple-SIGNATURE header.
+
+With --signature, the command compares it against the computed signature and
+exits non-zero on mismatch. Without --signature, it prints the computed
+signature so you can test your own receiver.
+
+Examples:
+  asc webhooks verify --secret "secret123" --payload ./event.json --signature "hmacsha256=3f2a..."
+  asc webhooks verify --secret "secret123" --payload ./event.json
+  cat event.json | asc webhooks verify --secret "secret123" --payload - --signature "3f2a..."`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *secret == "" {
+				fmt.Fprintln(os.Stderr, "Error: --secret is required")
+				return flag.ErrHelp
+			}
+			trimmedPath := strings.TrimSpace(*payloadPath)
+			if trimmedPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --payload is required")
+				return flag.ErrHelp
+			}
+
+			payload, err := readWebhookVerifyPayload(trimmedPath)
+			if err != nil {
+				return fmt.Errorf("webhooks verify: %w", err)
+			}
+
+			result := webhookVerifyResult{
+				Algorithm:         "HMAC-SHA256",
+				ComputedSignature: computeWebhookSignature(*secret, payload),
+				PayloadBytes:      len(payload),
+			}
+
+			provided := strings.TrimSpace(*signature)
+			if provided != "" {
+				result.Checked = true
+				result.ProvidedSignature = provided
+				result.Valid = webhookSignatureMatches(provided, result.ComputedSignature)
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printWebhookVerifyTable(result) },
+				func() error { return printWebhookVerifyMarkdown(result) },
+			); err != nil {
+				return err
+			}
+
+			if result.Checked && !result.Valid {
+				return shared.NewReportedError(fmt.Errorf("webhooks verify: signature does not match payload"))
+			}
+			return nil
+		},
+	}
+}
+
+func readWebhookVerifyPayload(path string) ([]byte, error) {
+	if path == "-" {
+		payload, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read payload from stdin: %w", err)
+		}
+		return payload, nil
+	}
+
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+	return payload, nil
+}
+
+// computeWebhookSignature returns the hex-encoded HMAC-SHA256 of payload.
+func computeWebhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookSignatureMatches compares a header value (with or without the
+// hmacsha256= prefix) against the computed hex digest in constant time.
+func webhookSignatureMatches(provided, computed string) bool {
+	value := strings.TrimSpace(provided)
+	if len(value) >= len(webhookSignaturePrefix) && strings.EqualFold(value[:len(webhookSignaturePrefix)], webhookSignaturePrefix) {
+		value = value[len(webhookSignaturePrefix):]
+	}
+
+	providedBytes, err := hex.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return false
+	}
+	computedBytes, err := hex.DecodeString(computed)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(providedBytes, computedBytes)
+}
+
+func webhookVerifyRows(result webhookVerifyResult) [][]string {
+	status := "computed"
+	if result.Checked {
+		status = "invalid"
+		if result.Valid {
+			status = "valid"
+		}
+	}
+	return [][]string{
+		{"Status", status},
+		{"Algorithm", result.Algorithm},
+		{"Computed Signature", webhookSignaturePrefix + result.ComputedSignature},
+		{"Provided Signature", result.ProvidedSignature},
+		{"Payload Bytes", fmt.Sprintf("%d", result.PayloadBytes)},
+	}
+}
+
+func printWebhookVerifyTable(result webhookVerifyResult) error {
+	asc.RenderTable([]string{"Field", "Value"}, webhookVerifyRows(result))
+	return nil
+}
+
+func printWebhookVerifyMarkdown(result webhookVerifyResult) error {
+	asc.RenderMarkdown([]string{"Field", "Value"}, webhookVerifyRows(result))
+	return nil
+}
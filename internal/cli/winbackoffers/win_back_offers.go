@@ -583,7 +583,7 @@ Examples:
 				hasUpdates = true
 			}
 
-			if !hasUpdates {
+			if !hasUpdates && !shared.UpdatePatchRequested(ctx) {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
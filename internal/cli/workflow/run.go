@@ -0,0 +1,136 @@
+package workflow
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+// RunCommand returns the top-level run command, which executes a workflow
+// file given by path.
+func RunCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Preview steps without executing")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	resume := fs.String("resume", "", "Resume a prior workflow run by run ID")
+
+	return &ffcli.Command{
+		Name:       "run",
+		ShortUsage: "asc run [flags] <file> [workflow] [KEY:VALUE ...]",
+		ShortHelp:  "Run a workflow file (YAML or JSON).",
+		LongHelp: `Run a declarative workflow file directly by path.
+
+The file uses the same format as asc workflow (see "asc workflow --help"), written
+as YAML (.yaml/.yml) or JSON. When the file defines a single public workflow the
+workflow name may be omitted; otherwise pass it after the file path.
+
+Steps support:
+  - templating with $VAR, ${VAR}, and ${steps.NAME.OUTPUT}
+  - "if" conditions such as "${steps.check.STATE} == READY_FOR_REVIEW" or "$TRACK != beta"
+  - "retries" and "retry_delay" (for example 3 and "30s") to rerun a failing step
+
+stdout is JSON-only; step and hook output streams to stderr. Run state is stored in
+a runs directory next to the file, so an interrupted run can continue with --resume.
+
+Security note:
+  Workflow files intentionally execute arbitrary shell commands.
+  Only run workflow files you trust.
+
+Example release.yaml:
+
+  env:
+    APP_ID: "123456789"
+  workflows:
+    release:
+      steps:
+        - name: latest_build
+          run: asc builds latest --app $APP_ID --version $VERSION --output json
+          outputs:
+            BUILD_ID: $.id
+            STATE: $.attributes.processingState
+          retries: 5
+          retry_delay: 1m
+        - name: submit
+          if: ${steps.latest_build.STATE} == VALID
+          run: asc submit create --app $APP_ID --version $VERSION --build ${steps.latest_build.BUILD_ID} --confirm
+
+Examples:
+  asc run release.yaml VERSION:2.1.0
+  asc run pipeline.yaml beta GROUP_ID:abcdef
+  asc run --dry-run release.yaml
+  asc run release.yaml --resume release-20260312T120000Z-deadbeef`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) == 0 {
+				return shared.UsageError("workflow file path is required")
+			}
+
+			filePath := strings.TrimSpace(args[0])
+			if filePath == "" || strings.HasPrefix(filePath, "-") {
+				return shared.UsageError("workflow file path is required")
+			}
+			tail, err := parseRunTailArgs(args[1:], fs)
+			if err != nil {
+				return err
+			}
+
+			absPath, err := filepath.Abs(filePath)
+			if err != nil {
+				return fmt.Errorf("run: resolve path: %w", err)
+			}
+			def, err := wf.Load(absPath)
+			if err != nil {
+				return fmt.Errorf("run: %w", err)
+			}
+
+			workflowName, paramArgs, err := selectRunWorkflow(def, tail)
+			if err != nil {
+				return err
+			}
+
+			return runDefinition(ctx, "run", def, absPath, runRequest{
+				FilePath:     absPath,
+				WorkflowName: workflowName,
+				ParamArgs:    paramArgs,
+				DryRun:       *dryRun,
+				Pretty:       *pretty,
+				ResumeRunID:  *resume,
+			})
+		},
+	}
+}
+
+// selectRunWorkflow picks the workflow to run from the arguments after the file
+// path. A leading argument without a colon names the workflow; otherwise the
+// file must define exactly one public workflow.
+func selectRunWorkflow(def *wf.Definition, args []string) (string, []string, error) {
+	if len(args) > 0 && !strings.Contains(args[0], ":") {
+		return args[0], args[1:], nil
+	}
+
+	var public []string
+	for name, workflow := range def.Workflows {
+		if !workflow.Private {
+			public = append(public, name)
+		}
+	}
+	slices.Sort(public)
+
+	switch len(public) {
+	case 1:
+		return public[0], args, nil
+	case 0:
+		return "", nil, shared.UsageError("workflow file defines no public workflows")
+	default:
+		return "", nil, shared.UsageErrorf("workflow name is required (available: %s)", strings.Join(public, ", "))
+	}
+}
@@ -27,7 +27,7 @@ func WorkflowCommand() *ffcli.Command {
 		ShortHelp:  "Run multi-step automation workflows.",
 		LongHelp: `Define named, multi-step automation sequences in .asc/workflow.json.
 Each workflow composes existing asc commands and shell commands.
-The file supports JSONC comments ('//' and '/* */').
+The file supports JSONC comments ('//' and '/* */'); files ending in .yaml or .yml are read as YAML.
 Hooks are supported at the definition level: before_all, after_all, and error.
 stdout is JSON-only; step/hook command output streams to stderr.
 Commands run via bash (with pipefail) when available, otherwise sh; at least one must be in PATH.
@@ -47,6 +47,9 @@ Tips:
   Use asc workflow validate before running a new workflow file.
   Preview the plan with asc workflow run --dry-run <name>.
   Run-step outputs can be referenced later as ${steps.resolve_build.BUILD_ID}.
+  Step "if" conditions accept an env var name or a comparison such as "${steps.resolve_build.STATE} == VALID".
+  Set "retries" and "retry_delay" (e.g. 3 and "30s") on run steps that may fail transiently.
+  Run a workflow file directly by path with asc run <file> [workflow].
   For asc commands that declare outputs, usually pass --output json.
   A proven local Xcode -> TestFlight shape is: asc builds latest --next -> asc xcode archive -> asc xcode export -> asc publish testflight --group ... --wait.
 
@@ -144,7 +147,7 @@ Examples:
 
 func workflowRunCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("workflow run", flag.ExitOnError)
-	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow file (JSON or YAML)")
 	dryRun := fs.Bool("dry-run", false, "Preview steps without executing")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	resume := fs.String("resume", "", "Resume a prior workflow run by run ID")
@@ -190,56 +193,85 @@ Examples:
 				return err
 			}
 
-			absPath, err := filepath.Abs(strings.TrimSpace(*filePath))
-			if err != nil {
-				return fmt.Errorf("workflow run: resolve path: %w", err)
-			}
+			return executeRun(ctx, "workflow run", runRequest{
+				FilePath:     *filePath,
+				WorkflowName: workflowName,
+				ParamArgs:    paramArgs,
+				DryRun:       *dryRun,
+				Pretty:       *pretty,
+				ResumeRunID:  *resume,
+			})
+		},
+	}
+}
 
-			def, err := wf.Load(absPath)
-			if err != nil {
-				return fmt.Errorf("workflow run: %w", err)
-			}
+// runRequest holds the parsed arguments shared by "asc workflow run" and
+// "asc run".
+type runRequest struct {
+	FilePath     string
+	WorkflowName string
+	ParamArgs    []string
+	DryRun       bool
+	Pretty       bool
+	ResumeRunID  string
+}
 
-			params, err := wf.ParseParams(paramArgs)
-			if err != nil {
-				return shared.UsageErrorf("%s", err)
-			}
-			if *dryRun && strings.TrimSpace(*resume) != "" {
-				return shared.UsageError("--resume cannot be used with --dry-run")
-			}
-			if strings.TrimSpace(*resume) != "" && len(paramArgs) > 0 {
-				return shared.UsageError("resume runs do not accept additional KEY:VALUE parameters")
-			}
+// executeRun loads the workflow file, runs the requested workflow, and prints
+// the run result as JSON. label prefixes errors that are not usage errors.
+func executeRun(ctx context.Context, label string, req runRequest) error {
+	absPath, err := filepath.Abs(strings.TrimSpace(req.FilePath))
+	if err != nil {
+		return fmt.Errorf("%s: resolve path: %w", label, err)
+	}
 
-			stateDir := filepath.Join(filepath.Dir(absPath), "runs")
+	def, err := wf.Load(absPath)
+	if err != nil {
+		return fmt.Errorf("%s: %w", label, err)
+	}
 
-			result, err := wf.Run(ctx, def, wf.RunOptions{
-				WorkflowName: workflowName,
-				Params:       params,
-				DryRun:       *dryRun,
-				WorkflowFile: absPath,
-				StateDir:     stateDir,
-				ResumeRunID:  strings.TrimSpace(*resume),
-				// Keep stdout machine-parseable JSON; stream step output to stderr.
-				Stdout: os.Stderr,
-				Stderr: os.Stderr,
-			})
-			if err != nil {
-				if result != nil {
-					_ = printJSON(os.Stdout, result, *pretty)
-					return shared.NewReportedError(err)
-				}
-				return fmt.Errorf("workflow run: %w", err)
-			}
+	return runDefinition(ctx, label, def, absPath, req)
+}
 
-			return printJSON(os.Stdout, result, *pretty)
-		},
+func runDefinition(ctx context.Context, label string, def *wf.Definition, absPath string, req runRequest) error {
+	params, err := wf.ParseParams(req.ParamArgs)
+	if err != nil {
+		return shared.UsageErrorf("%s", err)
 	}
+	resumeRunID := strings.TrimSpace(req.ResumeRunID)
+	if req.DryRun && resumeRunID != "" {
+		return shared.UsageError("--resume cannot be used with --dry-run")
+	}
+	if resumeRunID != "" && len(req.ParamArgs) > 0 {
+		return shared.UsageError("resume runs do not accept additional KEY:VALUE parameters")
+	}
+
+	stateDir := filepath.Join(filepath.Dir(absPath), "runs")
+
+	result, err := wf.Run(ctx, def, wf.RunOptions{
+		WorkflowName: req.WorkflowName,
+		Params:       params,
+		DryRun:       req.DryRun,
+		WorkflowFile: absPath,
+		StateDir:     stateDir,
+		ResumeRunID:  resumeRunID,
+		// Keep stdout machine-parseable JSON; stream step output to stderr.
+		Stdout: os.Stderr,
+		Stderr: os.Stderr,
+	})
+	if err != nil {
+		if result != nil {
+			_ = printJSON(os.Stdout, result, req.Pretty)
+			return shared.NewReportedError(err)
+		}
+		return fmt.Errorf("%s: %w", label, err)
+	}
+
+	return printJSON(os.Stdout, result, req.Pretty)
 }
 
 func workflowValidateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("workflow validate", flag.ExitOnError)
-	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow file (JSON or YAML)")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -296,7 +328,7 @@ Examples:
 
 func workflowListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("workflow list", flag.ExitOnError)
-	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow file (JSON or YAML)")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	all := fs.Bool("all", false, "Include private workflows in listing")
 
@@ -368,6 +400,9 @@ func parseRunTailArgs(args []string, fs *flag.FlagSet) ([]string, error) {
 		if strings.HasPrefix(token, "--") {
 			nameValue := strings.TrimPrefix(token, "--")
 			name, value, hasValue := strings.Cut(nameValue, "=")
+			if fs.Lookup(name) == nil {
+				return nil, shared.UsageErrorf("unknown flag %q", token)
+			}
 
 			switch name {
 			case "dry-run", "pretty":
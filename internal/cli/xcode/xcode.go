@@ -287,7 +287,7 @@ Examples:
 				if strings.TrimSpace(uploadID) == "" {
 					return fmt.Errorf("xcode export: failed to resolve build upload for version %q build %q", result.Version, result.BuildNumber)
 				}
-				fmt.Fprintf(os.Stderr, "Waiting for build %s (%s) to appear in App Store Connect...\n", result.BuildNumber, result.Version)
+				fmt.Fprintf(shared.WarningWriter(), "Waiting for build %s (%s) to appear in App Store Connect...\n", result.BuildNumber, result.Version)
 				buildResp, err := waitForBuildByNumberOrUploadFailureFn(waitCtx, client, appID, uploadID, result.Version, result.BuildNumber, platform, *pollInterval)
 				if err != nil {
 					return fmt.Errorf("xcode export: %w", err)
@@ -296,7 +296,7 @@ Examples:
 					return fmt.Errorf("xcode export: failed to resolve build for version %q build %q", result.Version, result.BuildNumber)
 				}
 				discoveredBuildID := strings.TrimSpace(buildResp.Data.ID)
-				fmt.Fprintf(os.Stderr, "Build %s discovered; waiting for processing...\n", discoveredBuildID)
+				fmt.Fprintf(shared.WarningWriter(), "Build %s discovered; waiting for processing...\n", discoveredBuildID)
 				buildResp, err = waitForBuildProcessingFn(waitCtx, client, discoveredBuildID, *pollInterval)
 				if err != nil {
 					return fmt.Errorf("xcode export: %w", err)
@@ -125,6 +125,45 @@ type KeychainMetadata struct {
 	ModifiedAt string `json:"modified_at,omitempty"`
 }
 
+// ProfileSettings stores per-profile defaults that apply when the profile is selected.
+type ProfileSettings struct {
+	AppID           string                  `json:"app_id,omitempty"`
+	CommandDefaults map[string]FlagDefaults `json:"command_defaults,omitempty"`
+}
+
+// FlagDefaults maps flag names (without leading dashes) to default values.
+// JSON strings, numbers, and booleans are accepted so configs can write
+// {"limit": 200, "pretty": true}.
+type FlagDefaults map[string]string
+
+// UnmarshalJSON accepts scalar JSON values and stores them as flag strings.
+func (f *FlagDefaults) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	values := make(FlagDefaults, len(raw))
+	for name, value := range raw {
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			values[name] = text
+			continue
+		}
+		var scalar any
+		if err := json.Unmarshal(value, &scalar); err != nil {
+			return err
+		}
+		switch scalar.(type) {
+		case bool, float64:
+			values[name] = strings.TrimSpace(string(value))
+		default:
+			return fmt.Errorf("flag %q: value must be a string, number, or boolean", name)
+		}
+	}
+	*f = values
+	return nil
+}
+
 // Config holds the application configuration
 type Config struct {
 	KeyID            string             `json:"key_id"`
@@ -136,6 +175,16 @@ type Config struct {
 	KeychainMetadata []KeychainMetadata `json:"keychain_metadata,omitempty"`
 	AppID            string             `json:"app_id"`
 
+	Profiles map[string]ProfileSettings `json:"profiles,omitempty"`
+
+	// CommandDefaults sets default flag values per command path, e.g.
+	// {"apps list": {"limit": 200}} or {"** get": {"pretty": true}}.
+	CommandDefaults map[string]FlagDefaults `json:"command_defaults,omitempty"`
+
+	// Aliases maps a name to the command line it expands to, e.g.
+	// {"latest-build": "builds latest --app 123 --output json"}.
+	Aliases map[string]string `json:"aliases,omitempty"`
+
 	VendorNumber          string `json:"vendor_number"`
 	AnalyticsVendorNumber string `json:"analytics_vendor_number"`
 	SkillsCheckedAt       string `json:"skills_checked_at,omitempty"`
@@ -147,10 +196,23 @@ type Config struct {
 	MaxRetries           string        `json:"max_retries"`
 	BaseDelay            string        `json:"base_delay"`
 	MaxDelay             string        `json:"max_delay"`
+	PaginateWorkers      string        `json:"paginate_workers"`
 	RetryLog             string        `json:"retry_log"`
 	Debug                string        `json:"debug"`
 }
 
+// ProfileAppID returns the default app ID configured for the named profile.
+func (c *Config) ProfileAppID(name string) string {
+	if c == nil {
+		return ""
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ""
+	}
+	return strings.TrimSpace(c.Profiles[name].AppID)
+}
+
 // ErrNotFound is returned when the config file doesn't exist
 var ErrNotFound = fmt.Errorf("configuration not found")
 
@@ -345,6 +407,9 @@ func (c *Config) Validate() error {
 	if err := validateMaxRetries(c.MaxRetries); err != nil {
 		return wrapInvalidConfig(err)
 	}
+	if err := validatePaginateWorkers(c.PaginateWorkers); err != nil {
+		return wrapInvalidConfig(err)
+	}
 
 	baseDelay, baseSet, err := parseOptionalDuration("base_delay", c.BaseDelay)
 	if err != nil {
@@ -357,6 +422,47 @@ func (c *Config) Validate() error {
 	if baseSet && maxSet && maxDelay < baseDelay {
 		return wrapInvalidConfig(fmt.Errorf("max_delay must be >= base_delay"))
 	}
+	if err := validateCommandDefaults("command_defaults", c.CommandDefaults); err != nil {
+		return wrapInvalidConfig(err)
+	}
+	for name, profile := range c.Profiles {
+		if err := validateCommandDefaults(fmt.Sprintf("profiles.%s.command_defaults", name), profile.CommandDefaults); err != nil {
+			return wrapInvalidConfig(err)
+		}
+	}
+	if err := validateAliases(c.Aliases); err != nil {
+		return wrapInvalidConfig(err)
+	}
+	return nil
+}
+
+func validateAliases(aliases map[string]string) error {
+	for name, expansion := range aliases {
+		trimmed := strings.TrimSpace(name)
+		if trimmed == "" {
+			return fmt.Errorf("aliases: alias name must not be empty")
+		}
+		if strings.HasPrefix(trimmed, "-") || strings.ContainsAny(trimmed, " \t") {
+			return fmt.Errorf("aliases[%q]: alias name must be a single word not starting with -", name)
+		}
+		if strings.TrimSpace(expansion) == "" {
+			return fmt.Errorf("aliases[%q]: expansion must not be empty", name)
+		}
+	}
+	return nil
+}
+
+func validateCommandDefaults(field string, defaults map[string]FlagDefaults) error {
+	for path, flags := range defaults {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("%s: command path must not be empty", field)
+		}
+		for name := range flags {
+			if strings.TrimSpace(strings.TrimLeft(name, "-")) == "" {
+				return fmt.Errorf("%s[%q]: flag name must not be empty", field, path)
+			}
+		}
+	}
 	return nil
 }
 
@@ -394,6 +500,18 @@ func validateMaxRetries(raw string) error {
 	return nil
 }
 
+func validatePaginateWorkers(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("paginate_workers must be a non-negative integer")
+	}
+	return nil
+}
+
 func parseOptionalDuration(field, raw string) (time.Duration, bool, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
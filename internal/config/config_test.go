@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -454,3 +455,87 @@ func TestLoadAtRejectsMaxDelayBelowBaseDelay(t *testing.T) {
 		t.Fatalf("expected ErrInvalidConfig, got %v", err)
 	}
 }
+
+func TestLoadAtParsesCommandDefaults(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	body := `{"command_defaults":{"** list":{"limit":200,"pretty":true,"output":"table"}},"profiles":{"ci":{"command_defaults":{"apps get":{"--pretty":false}}}}}`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	cfg, err := LoadAt(path)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	want := FlagDefaults{"limit": "200", "pretty": "true", "output": "table"}
+	if got := cfg.CommandDefaults["** list"]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := cfg.Profiles["ci"].CommandDefaults["apps get"]["--pretty"]; got != "false" {
+		t.Fatalf("expected profile default false, got %q", got)
+	}
+}
+
+func TestLoadAtRejectsInvalidCommandDefaults(t *testing.T) {
+	tests := map[string]string{
+		"empty path":    `{"command_defaults":{" ":{"limit":1}}}`,
+		"empty flag":    `{"command_defaults":{"apps list":{"--":"1"}}}`,
+		"profile empty": `{"profiles":{"ci":{"command_defaults":{"apps list":{"":"1"}}}}}`,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+				t.Fatalf("write config: %v", err)
+			}
+			if _, err := LoadAt(path); !errors.Is(err, ErrInvalidConfig) {
+				t.Fatalf("expected ErrInvalidConfig, got %v", err)
+			}
+		})
+	}
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"command_defaults":{"apps list":{"limit":[1]}}}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := LoadAt(path); err == nil {
+		t.Fatal("expected error for non-scalar flag default")
+	}
+}
+
+func TestLoadAtParsesAliases(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	body := `{"aliases":{"latest-build":"builds latest --app 123 --output json"}}`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	cfg, err := LoadAt(path)
+	if err != nil {
+		t.Fatalf("LoadAt() error: %v", err)
+	}
+	if got := cfg.Aliases["latest-build"]; got != "builds latest --app 123 --output json" {
+		t.Fatalf("unexpected alias expansion %q", got)
+	}
+}
+
+func TestLoadAtRejectsInvalidAliases(t *testing.T) {
+	tests := map[string]string{
+		"empty name":      `{"aliases":{" ":"builds list"}}`,
+		"flag name":       `{"aliases":{"--latest":"builds list"}}`,
+		"multi-word name": `{"aliases":{"latest build":"builds list"}}`,
+		"empty expansion": `{"aliases":{"latest":"  "}}`,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+				t.Fatalf("write config: %v", err)
+			}
+			if _, err := LoadAt(path); !errors.Is(err, ErrInvalidConfig) {
+				t.Fatalf("expected ErrInvalidConfig, got %v", err)
+			}
+		})
+	}
+}
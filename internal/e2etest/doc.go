@@ -0,0 +1,19 @@
+// Package e2etest runs asc commands end to end against a real App Store
+// Connect team.
+//
+// The tests are compiled only with the e2e build tag and skip unless
+// ASC_E2E=1 is set, so they never run as part of "go test ./...". Point them
+// at a dedicated sandbox team: every test creates throwaway resources named
+// with the asc-e2e- prefix and deletes them when it finishes.
+//
+// Run them with "make e2e". Required environment:
+//
+//	ASC_E2E=1
+//	ASC_KEY_ID, ASC_ISSUER_ID, ASC_PRIVATE_KEY_PATH (or ASC_PRIVATE_KEY/_B64)
+//	ASC_E2E_APP_ID               app in the sandbox team used for app-scoped writes
+//
+// Optional:
+//
+//	ASC_E2E_BUNDLE_ID_PREFIX     prefix for throwaway bundle identifiers
+//	                             (default "dev.asccli.e2e")
+package e2etest
@@ -0,0 +1,152 @@
+//go:build e2e
+
+package e2etest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// resourcePrefix marks everything the suite creates so leftovers from an
+// interrupted run are easy to spot and remove by hand.
+const resourcePrefix = "asc-e2e-"
+
+// ascBinary is the asc binary built once by TestMain.
+var ascBinary string
+
+func TestMain(m *testing.M) {
+	os.Exit(runMain(m))
+}
+
+func runMain(m *testing.M) int {
+	if os.Getenv("ASC_E2E") != "1" {
+		fmt.Fprintln(os.Stderr, "e2etest: set ASC_E2E=1 to run end-to-end tests against a sandbox team")
+		return m.Run()
+	}
+
+	dir, err := os.MkdirTemp("", "asc-e2e-bin-")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "e2etest: %v\n", err)
+		return 1
+	}
+	defer os.RemoveAll(dir)
+
+	ascBinary = filepath.Join(dir, "asc")
+	build := exec.Command("go", "build", "-o", ascBinary, "github.com/rudrankriyam/App-Store-Connect-CLI")
+	build.Stdout = os.Stderr
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "e2etest: build asc: %v\n", err)
+		return 1
+	}
+	return m.Run()
+}
+
+// env holds the sandbox configuration for a test.
+type env struct {
+	appID          string
+	bundleIDPrefix string
+}
+
+// requireEnv skips the test unless the suite is enabled and credentials for
+// the sandbox team are present.
+func requireEnv(t *testing.T) env {
+	t.Helper()
+
+	if os.Getenv("ASC_E2E") != "1" || ascBinary == "" {
+		t.Skip("end-to-end tests require ASC_E2E=1")
+	}
+	if os.Getenv("ASC_KEY_ID") == "" || os.Getenv("ASC_ISSUER_ID") == "" {
+		t.Skip("end-to-end tests require ASC_KEY_ID and ASC_ISSUER_ID")
+	}
+	if os.Getenv("ASC_PRIVATE_KEY_PATH") == "" && os.Getenv("ASC_PRIVATE_KEY") == "" && os.Getenv("ASC_PRIVATE_KEY_B64") == "" {
+		t.Skip("end-to-end tests require ASC_PRIVATE_KEY_PATH, ASC_PRIVATE_KEY, or ASC_PRIVATE_KEY_B64")
+	}
+
+	prefix := strings.TrimSpace(os.Getenv("ASC_E2E_BUNDLE_ID_PREFIX"))
+	if prefix == "" {
+		prefix = "dev.asccli.e2e"
+	}
+	return env{
+		appID:          strings.TrimSpace(os.Getenv("ASC_E2E_APP_ID")),
+		bundleIDPrefix: strings.TrimSuffix(prefix, "."),
+	}
+}
+
+// requireApp skips the test when no sandbox app is configured.
+func (e env) requireApp(t *testing.T) string {
+	t.Helper()
+	if e.appID == "" {
+		t.Skip("test requires ASC_E2E_APP_ID")
+	}
+	return e.appID
+}
+
+// uniqueName returns a throwaway resource name carrying resourcePrefix.
+func uniqueName(t *testing.T, label string) string {
+	t.Helper()
+	return fmt.Sprintf("%s%s-%d", resourcePrefix, label, time.Now().UnixNano())
+}
+
+// runASC runs the asc binary and returns stdout, stderr, and the exit error.
+// The keychain is bypassed so only the environment credentials are used.
+func runASC(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	cmd := exec.Command(ascBinary, args...)
+	cmd.Env = append(os.Environ(), "ASC_BYPASS_KEYCHAIN=1")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	return stdout.String(), stderr.String(), err
+}
+
+// mustRunJSON runs asc with --output json and decodes stdout into target.
+func mustRunJSON(t *testing.T, target any, args ...string) {
+	t.Helper()
+
+	args = append(args, "--output", "json")
+	stdout, stderr, err := runASC(t, args...)
+	if err != nil {
+		t.Fatalf("asc %s: %v\nstderr: %s", strings.Join(args, " "), err, stderr)
+	}
+	if err := json.Unmarshal([]byte(stdout), target); err != nil {
+		t.Fatalf("asc %s: decode output: %v\nstdout: %s", strings.Join(args, " "), err, stdout)
+	}
+}
+
+// cleanup registers a command that deletes a resource created by the test.
+// Failures are reported as test errors so leaked resources are not missed.
+func cleanup(t *testing.T, args ...string) {
+	t.Helper()
+	t.Cleanup(func() {
+		if _, stderr, err := runASC(t, args...); err != nil {
+			t.Errorf("cleanup asc %s: %v\nstderr: %s", strings.Join(args, " "), err, stderr)
+		}
+	})
+}
+
+// resource is the single-resource JSON:API shape returned by create/get/update.
+type resource struct {
+	Data struct {
+		ID         string         `json:"id"`
+		Type       string         `json:"type"`
+		Attributes map[string]any `json:"attributes"`
+	} `json:"data"`
+}
+
+// resourceList is the list JSON:API shape returned by list commands.
+type resourceList struct {
+	Data []struct {
+		ID         string         `json:"id"`
+		Attributes map[string]any `json:"attributes"`
+	} `json:"data"`
+}
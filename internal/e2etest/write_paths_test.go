@@ -0,0 +1,102 @@
+//go:build e2e
+
+package e2etest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestE2EAppsGet(t *testing.T) {
+	appID := requireEnv(t).requireApp(t)
+
+	var app resource
+	mustRunJSON(t, &app, "apps", "get", "--id", appID)
+	if app.Data.ID != appID {
+		t.Fatalf("expected app %q, got %q", appID, app.Data.ID)
+	}
+}
+
+func TestE2EBetaGroupLifecycle(t *testing.T) {
+	appID := requireEnv(t).requireApp(t)
+	name := uniqueName(t, "group")
+
+	var created resource
+	mustRunJSON(t, &created, "testflight", "beta-groups", "create", "--app", appID, "--name", name)
+	groupID := created.Data.ID
+	if groupID == "" {
+		t.Fatal("expected created beta group ID")
+	}
+	cleanup(t, "testflight", "beta-groups", "delete", "--id", groupID, "--confirm")
+
+	renamed := name + "-renamed"
+	var updated resource
+	mustRunJSON(t, &updated, "testflight", "beta-groups", "update", "--id", groupID, "--name", renamed)
+	if got := updated.Data.Attributes["name"]; got != renamed {
+		t.Fatalf("expected renamed group %q, got %v", renamed, got)
+	}
+
+	var groups resourceList
+	mustRunJSON(t, &groups, "testflight", "beta-groups", "list", "--app", appID, "--paginate")
+	if !containsID(groups, groupID) {
+		t.Fatalf("expected beta group %s in list", groupID)
+	}
+}
+
+func TestE2EBundleIDLifecycle(t *testing.T) {
+	e := requireEnv(t)
+	name := uniqueName(t, "bundle")
+	identifier := fmt.Sprintf("%s.t%d", e.bundleIDPrefix, time.Now().UnixNano())
+
+	var created resource
+	mustRunJSON(t, &created, "bundle-ids", "create", "--identifier", identifier, "--name", name, "--platform", "IOS")
+	bundleID := created.Data.ID
+	if bundleID == "" {
+		t.Fatal("expected created bundle ID resource ID")
+	}
+	cleanup(t, "bundle-ids", "delete", "--id", bundleID, "--confirm")
+
+	renamed := name + "-renamed"
+	var updated resource
+	mustRunJSON(t, &updated, "bundle-ids", "update", "--id", bundleID, "--name", renamed)
+	if got := updated.Data.Attributes["name"]; got != renamed {
+		t.Fatalf("expected renamed bundle ID %q, got %v", renamed, got)
+	}
+
+	var fetched resource
+	mustRunJSON(t, &fetched, "bundle-ids", "get", "--id", bundleID)
+	if got := fetched.Data.Attributes["identifier"]; got != identifier {
+		t.Fatalf("expected identifier %q, got %v", identifier, got)
+	}
+}
+
+func TestE2EDryRunSendsNoWrites(t *testing.T) {
+	appID := requireEnv(t).requireApp(t)
+	name := uniqueName(t, "dryrun")
+
+	stdout, stderr, err := runASC(t, "testflight", "beta-groups", "create", "--app", appID, "--name", name, "--dry-run")
+	if err != nil {
+		t.Fatalf("dry run: %v\nstderr: %s", err, stderr)
+	}
+	if stdout == "" {
+		t.Fatal("expected dry-run plan on stdout")
+	}
+
+	var groups resourceList
+	mustRunJSON(t, &groups, "testflight", "beta-groups", "list", "--app", appID, "--paginate")
+	for _, group := range groups.Data {
+		if group.Attributes["name"] == name {
+			t.Fatalf("dry run created beta group %s", group.ID)
+		}
+	}
+}
+
+func containsID(list resourceList, id string) bool {
+	for _, item := range list.Data {
+		if item.ID == id {
+			return true
+		}
+	}
+	return false
+}
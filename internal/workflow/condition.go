@@ -0,0 +1,117 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	conditionEnvName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// conditionRefPattern matches ${steps.NAME.OUTPUT}, ${VAR}, and $VAR.
+	conditionRefPattern = regexp.MustCompile(`\$\{steps\.([a-zA-Z0-9_-]+)\.([a-zA-Z0-9_]+)\}|\$\{([A-Za-z_][A-Za-z0-9_]*)\}|\$([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// condition is a parsed step "if" value.
+type condition struct {
+	envName  string // set for the plain env var form
+	left     string
+	operator string // "==", "!=", or "" for a truthiness check
+	right    string
+}
+
+// parseCondition parses a step "if" value. A bare name is an env var that must
+// be truthy (the original form); anything else is one operand checked for
+// truthiness, or two operands compared with == or !=. Operands may reference
+// ${steps.NAME.OUTPUT}, ${VAR}, or $VAR, and may be quoted.
+func parseCondition(value string) (condition, error) {
+	trimmed := strings.TrimSpace(value)
+	if conditionEnvName.MatchString(trimmed) {
+		return condition{envName: trimmed}, nil
+	}
+
+	if left, operator, right, found := cutConditionOperator(trimmed); found {
+		left, right = strings.TrimSpace(left), strings.TrimSpace(right)
+		if left == "" || right == "" {
+			return condition{}, fmt.Errorf("condition %q needs a value on both sides of %s", value, operator)
+		}
+		if _, _, _, again := cutConditionOperator(right); again {
+			return condition{}, fmt.Errorf("condition %q has more than one comparison", value)
+		}
+		return condition{left: left, operator: operator, right: right}, nil
+	}
+
+	if trimmed == "" {
+		return condition{}, fmt.Errorf("condition is empty")
+	}
+	return condition{left: trimmed}, nil
+}
+
+// cutConditionOperator splits s around the first == or != outside quotes.
+func cutConditionOperator(s string) (string, string, string, bool) {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case strings.HasPrefix(s[i:], "=="), strings.HasPrefix(s[i:], "!="):
+			return s[:i], s[i : i+2], s[i+2:], true
+		}
+	}
+	return s, "", "", false
+}
+
+// referencesStepOutputs reports whether the condition reads step outputs,
+// which are unknown during a dry run.
+func (c condition) referencesStepOutputs() bool {
+	return strings.Contains(c.left, "${steps.") || strings.Contains(c.right, "${steps.")
+}
+
+// evaluate reports whether the condition holds. Unknown step outputs and
+// unset env vars resolve to an empty string, so a condition can test the
+// outputs of a step that was skipped.
+func (c condition) evaluate(env map[string]string, outputs map[string]map[string]string) bool {
+	if c.envName != "" {
+		return isTruthy(lookupConditionEnv(c.envName, env))
+	}
+	left := resolveConditionOperand(c.left, env, outputs)
+	switch c.operator {
+	case "==":
+		return left == resolveConditionOperand(c.right, env, outputs)
+	case "!=":
+		return left != resolveConditionOperand(c.right, env, outputs)
+	default:
+		return isTruthy(left)
+	}
+}
+
+func resolveConditionOperand(operand string, env map[string]string, outputs map[string]map[string]string) string {
+	operand = strings.TrimSpace(operand)
+	if len(operand) >= 2 && (operand[0] == '\'' || operand[0] == '"') && operand[len(operand)-1] == operand[0] {
+		operand = operand[1 : len(operand)-1]
+	}
+	return conditionRefPattern.ReplaceAllStringFunc(operand, func(ref string) string {
+		match := conditionRefPattern.FindStringSubmatch(ref)
+		switch {
+		case match[1] != "":
+			return outputs[match[1]][match[2]]
+		case match[3] != "":
+			return lookupConditionEnv(match[3], env)
+		default:
+			return lookupConditionEnv(match[4], env)
+		}
+	})
+}
+
+func lookupConditionEnv(name string, env map[string]string) string {
+	if value, ok := env[name]; ok {
+		return value
+	}
+	return os.Getenv(name)
+}
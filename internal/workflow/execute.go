@@ -35,6 +35,7 @@ type StepResult struct {
 	Workflow       string            `json:"workflow,omitempty"`
 	ParentWorkflow string            `json:"parent_workflow,omitempty"`
 	Status         string            `json:"status"`
+	Attempts       int               `json:"attempts,omitempty"`
 	DurationMS     int64             `json:"duration_ms"`
 	Error          string            `json:"error,omitempty"`
 	Outputs        map[string]string `json:"outputs,omitempty"`
@@ -343,12 +344,21 @@ func (r *runner) executeSteps(ctx context.Context, workflowName string, steps []
 			sr.ParentWorkflow = workflowName
 		}
 
-		if ifVar := strings.TrimSpace(step.If); ifVar != "" {
-			val, ok := env[ifVar]
-			if !ok {
-				val = os.Getenv(ifVar)
+		if strings.TrimSpace(step.If) != "" {
+			cond, err := parseCondition(step.If)
+			if err != nil {
+				wrapped := fmt.Errorf("workflow: %s step %d: %w", workflowName, idx, err)
+				sr.Status = "error"
+				sr.Error = err.Error()
+				sr.DurationMS = time.Since(stepStart).Milliseconds()
+				r.result.Steps = append(r.result.Steps, sr)
+				r.result.FailedStep = failedStepName(step.Name, stepKey)
+				return wrapped
 			}
-			if !isTruthy(val) {
+			// Step outputs do not exist in a dry run, so such steps are shown
+			// as if their condition held.
+			dryRunUnknown := r.opts.DryRun && cond.referencesStepOutputs()
+			if !dryRunUnknown && !cond.evaluate(env, r.outputs) {
 				sr.Status = "skipped"
 				sr.DurationMS = time.Since(stepStart).Milliseconds()
 				r.result.Steps = append(r.result.Steps, sr)
@@ -443,7 +453,14 @@ func (r *runner) executeSteps(ctx context.Context, workflowName string, steps []
 			stdout = io.MultiWriter(r.opts.Stdout, &captured)
 		}
 
-		if err := runShellCommand(ctx, command, env, stdout, r.opts.Stderr); err != nil {
+		attempts, err := r.runWithRetries(ctx, step, fmt.Sprintf("%s step %d", workflowName, idx), func() error {
+			captured.Reset()
+			return runShellCommand(ctx, command, env, stdout, r.opts.Stderr)
+		})
+		if attempts > 1 {
+			sr.Attempts = attempts
+		}
+		if err != nil {
 			wrapped := fmt.Errorf("workflow: %s step %d: %w", workflowName, idx, err)
 			sr.Status = "error"
 			sr.Error = err.Error()
@@ -483,6 +500,42 @@ func (r *runner) executeSteps(ctx context.Context, workflowName string, steps []
 	return nil
 }
 
+// runWithRetries calls run until it succeeds or the step's retries are used
+// up, waiting the step's retry delay between attempts. It returns the number
+// of attempts made.
+func (r *runner) runWithRetries(ctx context.Context, step Step, label string, run func() error) (int, error) {
+	delay, err := parseRetryDelay(step.RetryDelay)
+	if err != nil {
+		return 0, err
+	}
+	maxAttempts := max(step.Retries, 0) + 1
+	for attempt := 1; ; attempt++ {
+		err := run()
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil {
+			return attempt, err
+		}
+		fmt.Fprintf(r.opts.Stderr, "%s failed (attempt %d/%d): %v; retrying in %s\n", label, attempt, maxAttempts, err, delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func parseRetryDelay(value string) (time.Duration, error) {
+	if strings.TrimSpace(value) == "" {
+		return 0, nil
+	}
+	delay, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || delay < 0 {
+		return 0, fmt.Errorf("invalid retry_delay %q: must be a duration such as 30s", value)
+	}
+	return delay, nil
+}
+
 func (r *runner) persistStep(stepKey string, sr StepResult) error {
 	if r.state == nil || sr.Status != "ok" {
 		return nil
@@ -1567,3 +1567,123 @@ func TestRun_DurationMS_IncludesAfterAll(t *testing.T) {
 		t.Fatalf("expected DurationMS >= 100 (must include after_all time), got %d", result.DurationMS)
 	}
 }
+
+func TestRun_ConditionOnStepOutputs(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {
+				Steps: []Step{
+					{
+						Name:    "build",
+						Run:     `printf '{"state":"VALID"}'`,
+						Outputs: map[string]string{"STATE": "$.state"},
+					},
+					{Name: "valid", If: "${steps.build.STATE} == VALID", Run: "echo is_valid"},
+					{Name: "invalid", If: "${steps.build.STATE} != 'VALID'", Run: "echo is_invalid"},
+					{Name: "skipped_output", If: "${steps.missing.STATE} == ''", Run: "echo missing_is_empty"},
+				},
+			},
+		},
+	}
+
+	opts := runOpts("release")
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	got := []string{result.Steps[1].Status, result.Steps[2].Status, result.Steps[3].Status}
+	if strings.Join(got, ",") != "ok,skipped,ok" {
+		t.Fatalf("unexpected step statuses: %v", got)
+	}
+	stdout := opts.Stdout.(*bytes.Buffer).String()
+	if !strings.Contains(stdout, "is_valid") || strings.Contains(stdout, "is_invalid") || !strings.Contains(stdout, "missing_is_empty") {
+		t.Fatalf("unexpected stdout: %q", stdout)
+	}
+}
+
+func TestRun_ConditionComparesEnv(t *testing.T) {
+	def := &Definition{
+		Env: map[string]string{"TRACK": "beta"},
+		Workflows: map[string]Workflow{
+			"main": {Steps: []Step{
+				{If: "$TRACK == beta", Run: "echo beta_track"},
+				{If: `"${TRACK}" == "production"`, Run: "echo production_track"},
+			}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("main"))
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps[0].Status != "ok" || result.Steps[1].Status != "skipped" {
+		t.Fatalf("unexpected statuses: %q, %q", result.Steps[0].Status, result.Steps[1].Status)
+	}
+}
+
+func TestRun_DryRunDoesNotSkipOutputConditions(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"main": {Steps: []Step{
+				{Name: "build", Run: `printf '{"state":"VALID"}'`, Outputs: map[string]string{"STATE": "$.state"}},
+				{If: "${steps.build.STATE} == VALID", Run: "echo submit"},
+			}},
+		},
+	}
+
+	opts := runOpts("main")
+	opts.DryRun = true
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps[1].Status != "dry-run" {
+		t.Fatalf("expected dry-run status, got %q", result.Steps[1].Status)
+	}
+}
+
+func TestRun_RetriesFailedStep(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "attempts")
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"main": {Steps: []Step{{
+				Name:       "flaky",
+				Run:        fmt.Sprintf(`echo x >> %s; [ "$(wc -l < %s)" -ge 3 ] && printf '{"ok":"yes"}'`, counter, counter),
+				Outputs:    map[string]string{"OK": "$.ok"},
+				Retries:    3,
+				RetryDelay: "1ms",
+			}}},
+		},
+	}
+
+	opts := runOpts("main")
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps[0].Attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", result.Steps[0].Attempts)
+	}
+	if result.Outputs["flaky"]["OK"] != "yes" {
+		t.Fatalf("expected output from the successful attempt, got %#v", result.Outputs)
+	}
+	if stderr := opts.Stderr.(*bytes.Buffer).String(); !strings.Contains(stderr, "failed (attempt 1/4)") {
+		t.Fatalf("expected retry notice on stderr, got %q", stderr)
+	}
+}
+
+func TestRun_RetriesExhausted(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"main": {Steps: []Step{{Name: "broken", Run: "exit 7", Retries: 1}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("main"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if result.Steps[0].Status != "error" || result.Steps[0].Attempts != 2 {
+		t.Fatalf("unexpected step result: %+v", result.Steps[0])
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/tidwall/jsonc"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -16,6 +19,8 @@ var (
 	ErrWorkflowRead = errors.New("read workflow")
 	// ErrWorkflowParseJSON indicates workflow JSON decode failure.
 	ErrWorkflowParseJSON = errors.New("parse workflow JSON")
+	// ErrWorkflowParseYAML indicates workflow YAML decode failure.
+	ErrWorkflowParseYAML = errors.New("parse workflow YAML")
 )
 
 // DefaultPath is the default location for the workflow definition file.
@@ -38,14 +43,22 @@ func Load(path string) (*Definition, error) {
 }
 
 // LoadUnvalidated reads and parses a workflow definition file without validation.
+// Files ending in .yaml or .yml are read as YAML; anything else as JSONC.
 func LoadUnvalidated(path string) (*Definition, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrWorkflowRead, err)
 	}
 
-	// Allow JSONC-style comments (// and /* */) in workflow files.
-	data = jsonc.ToJSON(data)
+	if isYAMLPath(path) {
+		data, err = yamlToJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrWorkflowParseYAML, err)
+		}
+	} else {
+		// Allow JSONC-style comments (// and /* */) in workflow files.
+		data = jsonc.ToJSON(data)
+	}
 
 	var def Definition
 	dec := json.NewDecoder(bytes.NewReader(data))
@@ -60,3 +73,56 @@ func LoadUnvalidated(path string) (*Definition, error) {
 
 	return &def, nil
 }
+
+func isYAMLPath(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// yamlToJSON re-encodes a YAML document as JSON so YAML workflow files go
+// through the same strict decoding as workflow.json.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, errors.New("document is empty")
+	}
+	normalized, err := normalizeYAMLValue(doc)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(normalized)
+}
+
+func normalizeYAMLValue(value any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			normalized, err := normalizeYAMLValue(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = normalized
+		}
+		return v, nil
+	case map[any]any:
+		return nil, errors.New("mapping keys must be strings")
+	case []any:
+		for i, item := range v {
+			normalized, err := normalizeYAMLValue(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = normalized
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
@@ -26,6 +26,10 @@ const (
 	ErrDuplicateOutputProducerName ValidationCode = "duplicate_output_producer_name"
 	ErrInvalidOutputName           ValidationCode = "invalid_output_name"
 	ErrInvalidOutputExpr           ValidationCode = "invalid_output_expr"
+	ErrInvalidCondition            ValidationCode = "invalid_condition"
+	ErrInvalidRetries              ValidationCode = "invalid_retries"
+	ErrInvalidRetryDelay           ValidationCode = "invalid_retry_delay"
+	ErrStepRetriesOnWorkflow       ValidationCode = "step_retries_on_workflow"
 )
 
 // ValidationError describes a structured workflow validation failure.
@@ -179,6 +183,52 @@ func Validate(def *Definition) []*ValidationError {
 				}
 			}
 
+			if strings.TrimSpace(step.If) != "" {
+				if _, err := parseCondition(step.If); err != nil {
+					errs = append(errs, &ValidationError{
+						Code:     ErrInvalidCondition,
+						Workflow: name,
+						Step:     idx,
+						Message:  fmt.Sprintf("workflow %q step %d has invalid 'if': %v", name, idx, err),
+					})
+				}
+			}
+
+			hasRetryDelay := strings.TrimSpace(step.RetryDelay) != ""
+			if hasWorkflow && (step.Retries != 0 || hasRetryDelay) {
+				errs = append(errs, &ValidationError{
+					Code:     ErrStepRetriesOnWorkflow,
+					Workflow: name,
+					Step:     idx,
+					Message:  fmt.Sprintf("workflow %q step %d has 'retries' on a workflow step (only allowed on run steps)", name, idx),
+				})
+			}
+			if step.Retries < 0 {
+				errs = append(errs, &ValidationError{
+					Code:     ErrInvalidRetries,
+					Workflow: name,
+					Step:     idx,
+					Message:  fmt.Sprintf("workflow %q step %d 'retries' must not be negative", name, idx),
+				})
+			}
+			if hasRetryDelay {
+				if _, err := parseRetryDelay(step.RetryDelay); err != nil {
+					errs = append(errs, &ValidationError{
+						Code:     ErrInvalidRetryDelay,
+						Workflow: name,
+						Step:     idx,
+						Message:  fmt.Sprintf("workflow %q step %d has %v", name, idx, err),
+					})
+				} else if step.Retries == 0 {
+					errs = append(errs, &ValidationError{
+						Code:     ErrInvalidRetryDelay,
+						Workflow: name,
+						Step:     idx,
+						Message:  fmt.Sprintf("workflow %q step %d sets 'retry_delay' without 'retries'", name, idx),
+					})
+				}
+			}
+
 			if hasWorkflow {
 				ref := strings.TrimSpace(step.Workflow)
 				if _, ok := def.Workflows[ref]; !ok {
@@ -635,3 +635,85 @@ func TestLoad_ReturnsAllValidationErrors(t *testing.T) {
 		t.Fatalf("expected errors.As to find ValidationError, got %T: %v", err, err)
 	}
 }
+
+func TestLoad_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "release.yaml")
+	content := `env:
+  APP_ID: "123456789"
+workflows:
+  release:
+    description: Ship a build
+    steps:
+      - echo start
+      - name: resolve_build
+        run: asc builds latest --app $APP_ID --output json
+        outputs:
+          BUILD_ID: $.id
+        retries: 2
+        retry_delay: 30s
+      - if: ${steps.resolve_build.BUILD_ID} != ''
+        run: echo ${steps.resolve_build.BUILD_ID}
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write workflow file: %v", err)
+	}
+
+	def, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	steps := def.Workflows["release"].Steps
+	if len(steps) != 3 || steps[0].Run != "echo start" {
+		t.Fatalf("unexpected steps: %+v", steps)
+	}
+	if steps[1].Retries != 2 || steps[1].RetryDelay != "30s" || steps[1].Outputs["BUILD_ID"] != "$.id" {
+		t.Fatalf("unexpected retry step: %+v", steps[1])
+	}
+	if steps[2].If != "${steps.resolve_build.BUILD_ID} != ''" {
+		t.Fatalf("unexpected if: %q", steps[2].If)
+	}
+}
+
+func TestLoad_YAMLRejectsUnknownFieldsAndNonStringValues(t *testing.T) {
+	for name, content := range map[string]string{
+		"unknown field": "workflows:\n  beta:\n    steps:\n      - run: echo hi\n        retry: 3\n",
+		"boolean env":   "env:\n  SUBMIT: true\nworkflows:\n  beta:\n    steps: [echo hi]\n",
+		"empty":         "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "workflow.yml")
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatalf("write workflow file: %v", err)
+			}
+			if _, err := LoadUnvalidated(path); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestValidate_ConditionsAndRetries(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {
+				Steps: []Step{
+					{Run: "echo a", If: "${steps.x.STATE} == "},
+					{Run: "echo b", Retries: -1},
+					{Run: "echo c", Retries: 1, RetryDelay: "soon"},
+					{Run: "echo d", RetryDelay: "5s"},
+					{Workflow: "helper", Retries: 2},
+				},
+			},
+			"helper": {Steps: []Step{{Run: "echo helper"}}},
+		},
+	}
+
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrInvalidCondition)
+	assertValidationCode(t, errs, ErrInvalidRetries)
+	assertValidationCode(t, errs, ErrInvalidRetryDelay)
+	assertValidationCode(t, errs, ErrStepRetriesOnWorkflow)
+	if len(errs) != 5 {
+		t.Fatalf("expected 5 errors, got %d: %v", len(errs), errs)
+	}
+}
@@ -1,6 +1,6 @@
-// Package workflow is a standalone workflow runner for .asc/workflow.json files.
-// It has zero imports from the rest of the codebase. Only depends on Go stdlib
-// plus tidwall/jsonc for JSONC comment support in load.go.
+// Package workflow is a standalone workflow runner for .asc/workflow.json (or
+// YAML) files. It has zero imports from the rest of the codebase. Only depends
+// on Go stdlib plus tidwall/jsonc and yaml.v3 for file parsing in load.go.
 package workflow
 
 import (
@@ -29,13 +29,20 @@ type Workflow struct {
 
 // Step is one executable action in a workflow.
 // Bare JSON strings unmarshal to Step{Run: "..."} as shorthand.
+//
+// If is either an env var name that must be truthy, or a condition such as
+// "${steps.build.STATE} == VALID" comparing interpolated values with == or !=.
+// Retries re-runs a failed run step up to that many more times, waiting
+// RetryDelay (a Go duration such as "30s") between attempts.
 type Step struct {
-	Run      string            `json:"run,omitempty"`
-	Workflow string            `json:"workflow,omitempty"`
-	Name     string            `json:"name,omitempty"`
-	If       string            `json:"if,omitempty"`
-	With     map[string]string `json:"with,omitempty"`
-	Outputs  map[string]string `json:"outputs,omitempty"`
+	Run        string            `json:"run,omitempty"`
+	Workflow   string            `json:"workflow,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	If         string            `json:"if,omitempty"`
+	With       map[string]string `json:"with,omitempty"`
+	Outputs    map[string]string `json:"outputs,omitempty"`
+	Retries    int               `json:"retries,omitempty"`
+	RetryDelay string            `json:"retry_delay,omitempty"`
 }
 
 // UnmarshalJSON handles the flexible step format:
@@ -5,18 +5,51 @@ This produces a compact JSON index of every API endpoint with pre-resolved
 parameters, request attributes, and response schema names. The index is
 embedded in the asc binary for runtime schema introspection (`asc schema`).
 
+Operations the spec marks deprecated carry "deprecated": true, plus
+"clientPath": true when a path literal in internal/asc builds that path, so
+`asc deprecations` can list the deprecated endpoints asc wraps. Regenerate
+after adding client calls to deprecated endpoints.
+
 Usage:
     python3 scripts/generate-schema-index.py [--check]
 """
 
 import argparse
 import json
+import re
 import sys
 from pathlib import Path
 
 REPO_ROOT = Path(__file__).resolve().parent.parent
 SPEC_PATH = REPO_ROOT / "docs" / "openapi" / "latest.json"
 EMBED_PATH = REPO_ROOT / "internal" / "cli" / "schema" / "schema_index.json"
+CLIENT_DIR = REPO_ROOT / "internal" / "asc"
+CLIENT_PATH_LITERAL = re.compile(r'"(/v[0-9]+/[^"\s]*)"')
+
+
+def client_path_patterns() -> set[tuple[str, ...]]:
+    """Return the API paths built by the asc client, with %s segments as "*"."""
+    patterns = set()
+    for source in sorted(CLIENT_DIR.glob("*.go")):
+        if source.name.endswith("_test.go"):
+            continue
+        for literal in CLIENT_PATH_LITERAL.findall(source.read_text()):
+            segments = literal.split("?", 1)[0].strip("/").split("/")
+            patterns.add(tuple("*" if "%" in seg else seg for seg in segments))
+    return patterns
+
+
+def is_client_path(path: str, patterns: set[tuple[str, ...]]) -> bool:
+    segments = path.strip("/").split("/")
+    for pattern in patterns:
+        if len(pattern) != len(segments):
+            continue
+        if all(
+            p == "*" or s.startswith("{") or p == s
+            for p, s in zip(pattern, segments)
+        ):
+            return True
+    return False
 
 
 def resolve_ref(schemas: dict, ref: str):
@@ -110,7 +143,7 @@ def compact_parameters(
     return compact
 
 
-def build_index(spec: dict) -> list[dict]:
+def build_index(spec: dict, client_paths: set[tuple[str, ...]]) -> list[dict]:
     schemas = spec.get("components", {}).get("schemas", {})
     parameter_components = spec.get("components", {}).get("parameters", {})
     index = []
@@ -122,6 +155,10 @@ def build_index(spec: dict) -> list[dict]:
                 continue
 
             entry: dict = {"method": method.upper(), "path": path}
+            if details.get("deprecated"):
+                entry["deprecated"] = True
+                if is_client_path(path, client_paths):
+                    entry["clientPath"] = True
 
             params = compact_parameters(
                 parameter_components, path_params, details.get("parameters", [])
@@ -177,7 +214,7 @@ def main() -> int:
     with open(SPEC_PATH) as f:
         spec = json.load(f)
 
-    index = build_index(spec)
+    index = build_index(spec, client_path_patterns())
     generated = json.dumps(index, separators=(",", ":"), ensure_ascii=False)
 
     if args.check: